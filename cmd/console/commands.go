@@ -133,6 +133,12 @@ If the parameter is not specified, then all scripts of the specified driver are
 			Flags:       []cli.Flag{cfgPathsFlag()},
 			Commands:    prepareTransactionsCommands(currentAppVersion),
 		}, // transactions
+		{
+			Name:        "outbox",
+			Description: "Event outbox management",
+			Flags:       []cli.Flag{cfgPathsFlag()},
+			Commands:    prepareOutboxCommands(currentAppVersion),
+		}, // outbox
 		{
 			Name:        "users",
 			Description: "Users management",
@@ -333,7 +339,8 @@ func prepareTransactionsCommands(currentAppVersion string) []*cli.Command {
 				}
 
 				currService := currency.New(conf, st)
-				exrateService, err := exrate.New(conf, currService, lg, st)
+				eventListener := event.New()
+				exrateService, err := exrate.New(conf, currService, lg, st, eventListener, nil)
 				if err != nil {
 					return fmt.Errorf("init exrate service failed: %w", err)
 				}
@@ -347,7 +354,6 @@ func prepareTransactionsCommands(currentAppVersion string) []*cli.Command {
 				}
 
 				eProxyService := eproxy.New(eprCl)
-				eventListener := event.New()
 
 				transactionService := transactions.New(lg, st, eProxyService, currConvService, eventListener, nil)
 
@@ -0,0 +1,120 @@
+package console
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/dv-net/dv-merchant/internal/event"
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/outbox"
+	"github.com/dv-net/dv-merchant/internal/storage"
+	"github.com/dv-net/dv-merchant/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/urfave/cli/v3"
+)
+
+func prepareOutboxCommands(currentAppVersion string) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:        "replay",
+			Usage:       "publish stored events again",
+			Description: "Returns matching events to pending state, running application relay publishes them to handlers",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "id",
+					Usage: "event id",
+				},
+				&cli.StringFlag{
+					Name:    "type",
+					Aliases: []string{"t"},
+					Usage:   "event type, e.g. deposit_received",
+				},
+				&cli.StringFlag{
+					Name:  "from",
+					Usage: "events created at or after, RFC3339",
+				},
+				&cli.StringFlag{
+					Name:  "to",
+					Usage: "events created before, RFC3339",
+				},
+				&cli.StringSliceFlag{
+					Name:    "status",
+					Aliases: []string{"s"},
+					Usage:   "event statuses to replay: failed, processed",
+					Value:   []string{models.EventOutboxStatusFailed.String()},
+				},
+			},
+			Action: func(ctx context.Context, c *cli.Command) error {
+				dto, err := prepareOutboxReplayDTO(c)
+				if err != nil {
+					return err
+				}
+
+				conf, err := loadConfig(c.Args().Slice(), c.StringSlice("configs"))
+				if err != nil {
+					return fmt.Errorf("failed to load config: %w", err)
+				}
+
+				lg := logger.New(currentAppVersion, conf.Log)
+				st, err := storage.InitStore(ctx, conf)
+				if err != nil {
+					return fmt.Errorf("storage init: %w", err)
+				}
+				defer func() {
+					if storageCloseErr := st.Close(); storageCloseErr != nil {
+						lg.Errorw("storage close error", "error", storageCloseErr)
+					}
+				}()
+
+				replayed, err := outbox.New(conf.Outbox, st, lg, event.New()).Replay(ctx, dto)
+				if err != nil {
+					return fmt.Errorf("replay outbox events: %w", err)
+				}
+
+				_, err = fmt.Fprintf(os.Stdout, "%d events queued for replay\n", replayed)
+				return err
+			},
+		}, // outbox.replay
+	}
+}
+
+func prepareOutboxReplayDTO(c *cli.Command) (outbox.ReplayDTO, error) {
+	dto := outbox.ReplayDTO{}
+
+	if id := c.String("id"); id != "" {
+		parsed, err := uuid.Parse(id)
+		if err != nil {
+			return dto, fmt.Errorf("invalid event id: %w", err)
+		}
+		dto.ID = &parsed
+	}
+
+	if eventType := c.String("type"); eventType != "" {
+		dto.EventType = &eventType
+	}
+
+	if from := c.String("from"); from != "" {
+		parsed, err := time.Parse(time.RFC3339, from)
+		if err != nil {
+			return dto, fmt.Errorf("invalid from: %w", err)
+		}
+		dto.CreatedFrom = &parsed
+	}
+
+	if to := c.String("to"); to != "" {
+		parsed, err := time.Parse(time.RFC3339, to)
+		if err != nil {
+			return dto, fmt.Errorf("invalid to: %w", err)
+		}
+		dto.CreatedTo = &parsed
+	}
+
+	for _, status := range c.StringSlice("status") {
+		dto.Statuses = append(dto.Statuses, models.EventOutboxStatus(status))
+	}
+
+	return dto, nil
+}
@@ -1151,6 +1151,77 @@ const docTemplate = `{
                 }
             }
         },
+        "/v1/dv-admin/currencies/rate/{source}/history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get rate of the latest snapshot taken at or before the requested time",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Currency"
+                ],
+                "summary": "Get historical Currency rate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Currency Source example binance",
+                        "name": "source",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "example": "2026-01-01T00:00:00Z",
+                        "name": "at",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "BTC",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "USDT",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-ExchangeRate"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
         "/v1/dv-admin/currencies/{id}": {
             "get": {
                 "security": [
@@ -1461,6 +1532,52 @@ const docTemplate = `{
                 }
             }
         },
+        "/v1/dv-admin/exchange/{exchange_slug}/key-permissions": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Rights of the stored exchange key with warnings for rights missing or unused by enabled swaps and withdrawals",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exchange"
+                ],
+                "summary": "Get exchange key permissions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exchange slug",
+                        "name": "exchange_slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-ExchangeKeyPermissionsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
         "/v1/dv-admin/exchange/{exchange_slug}/keys": {
             "post": {
                 "description": "Update or create exchange user keys",
@@ -1948,7 +2065,11 @@ const docTemplate = `{
                                 "user_remind_verification",
                                 "user_update_setting_verification",
                                 "user_test_email",
-                                "user_crypto_receipt"
+                                "user_crypto_receipt",
+                                "exrate_source_stale",
+                                "withdrawal_limit_exceeded",
+                                "address_book_entry_added",
+                                "withdrawal_allowlist_disabling"
                             ],
                             "type": "string"
                         },
@@ -2679,14 +2800,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/root-setting/": {
+        "/v1/dv-admin/refunds": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get root settings",
+                "description": "Load refunds of all stores, available for root and finance manager",
                 "consumes": [
                     "application/json"
                 ],
@@ -2694,14 +2815,71 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Setting"
+                    "Refund"
+                ],
+                "summary": "Load refunds",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "enum": [
+                                "aml_blocked",
+                                "overpayment",
+                                "manual"
+                            ],
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "reasons",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "enum": [
+                                "pending_approval",
+                                "approved",
+                                "processing",
+                                "completed",
+                                "rejected",
+                                "failed",
+                                "cancelled"
+                            ],
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "statuses",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "store_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "transaction_id",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get root settings",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-array_SettingResponse"
+                            "$ref": "#/definitions/JSONResponse-ResponseWithFullPagination-RefundResponse"
                         }
                     },
                     "401": {
@@ -2709,16 +2887,24 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/v1/dv-admin/refunds/{refundId}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create or update root settings",
+                "description": "Load refund with status history, available for root and finance manager",
                 "consumes": [
                     "application/json"
                 ],
@@ -2726,25 +2912,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Setting"
+                    "Refund"
                 ],
-                "summary": "Create or update root settings",
+                "summary": "Load refund",
                 "parameters": [
                     {
-                        "description": "Create or update root setting",
-                        "name": "register",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/CreateSettingRequest"
-                        }
+                        "type": "string",
+                        "description": "Refund ID",
+                        "name": "refundId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-string"
+                            "$ref": "#/definitions/JSONResponse-RefundWithHistoryResponse"
                         }
                     },
                     "401": {
@@ -2753,14 +2937,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -2768,14 +2952,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/root-setting/list": {
-            "get": {
+        "/v1/dv-admin/refunds/{refundId}/approve": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "List available root settings",
+                "description": "Approve refund and withdraw it from processing wallet of store owner",
                 "consumes": [
                     "application/json"
                 ],
@@ -2783,14 +2967,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Setting"
+                    "Refund"
+                ],
+                "summary": "Approve refund",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Refund ID",
+                        "name": "refundId",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "List available root settings",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-array_github_com_dv-net_dv-merchant_internal_service_setting_Dto"
+                            "$ref": "#/definitions/JSONResponse-RefundResponse"
                         }
                     },
                     "401": {
@@ -2798,51 +2991,21 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
-                    }
-                }
-            }
-        },
-        "/v1/dv-admin/root/ban": {
-            "patch": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Issue ban to user",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Admin"
-                ],
-                "summary": "Issue ban to user",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "format": "uuid",
-                        "name": "user_id",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-BanUserResponse"
+                            "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "503": {
-                        "description": "Service Unavailable",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -2850,14 +3013,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/root/invite": {
+        "/v1/dv-admin/refunds/{refundId}/reject": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Invite user with role",
+                "description": "Reject refund pending approval",
                 "consumes": [
                     "application/json"
                 ],
@@ -2865,17 +3028,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Admin"
+                    "Refund"
                 ],
-                "summary": "Invite user with role",
+                "summary": "Reject refund",
                 "parameters": [
                     {
-                        "description": "Invite user with specific role",
+                        "type": "string",
+                        "description": "Refund ID",
+                        "name": "refundId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Rejection",
                         "name": "register",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/InviteUserWithRoleRequest"
+                            "$ref": "#/definitions/RejectRefundRequest"
                         }
                     }
                 ],
@@ -2883,7 +3053,241 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-string"
+                            "$ref": "#/definitions/JSONResponse-RefundResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/dv-admin/root-setting/": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get root settings",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Setting"
+                ],
+                "summary": "Get root settings",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-array_SettingResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create or update root settings",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Setting"
+                ],
+                "summary": "Create or update root settings",
+                "parameters": [
+                    {
+                        "description": "Create or update root setting",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateSettingRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/dv-admin/root-setting/list": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List available root settings",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Setting"
+                ],
+                "summary": "List available root settings",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-array_github_com_dv-net_dv-merchant_internal_service_setting_Dto"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/dv-admin/root/ban": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Issue ban to user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Issue ban to user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "name": "user_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-BanUserResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/dv-admin/root/invite": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Invite user with role",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Invite user with role",
+                "parameters": [
+                    {
+                        "description": "Invite user with specific role",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/InviteUserWithRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-string"
                         }
                     },
                     "400": {
@@ -4154,21 +4558,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/store/{id}/resend-verify": {
-            "post": {
+        "/v1/dv-admin/store/{id}/invoices": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Resend store verification",
+                "description": "Load store invoices",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Store"
+                    "Invoice"
                 ],
-                "summary": "Resend store verification",
+                "summary": "Load store invoices",
                 "parameters": [
                     {
                         "type": "string",
@@ -4176,20 +4583,49 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/JSONResponse-any"
-                        }
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
+                    {
+                        "type": "string",
+                        "name": "order_id",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "enum": [
+                                "pending",
+                                "partially_paid",
+                                "paid",
+                                "overpaid",
+                                "expired",
+                                "cancelled"
+                            ],
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "statuses",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-ResponseWithFullPagination-InvoiceResponse"
+                        }
                     },
                     "401": {
                         "description": "Unauthorized",
@@ -4197,30 +4633,31 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     }
                 }
-            }
-        },
-        "/v1/dv-admin/store/{id}/secret": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Fetch store webhook secret",
+                "description": "Create invoice with fixed amount and expiry",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Store"
+                    "Invoice"
                 ],
-                "summary": "Fetch store webhook secret",
+                "summary": "Create store invoice",
                 "parameters": [
                     {
                         "type": "string",
@@ -4228,13 +4665,28 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Create invoice",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateInvoiceRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-StoreSecretResponse"
+                            "$ref": "#/definitions/JSONResponse-InvoiceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
                         }
                     },
                     "401": {
@@ -4243,21 +4695,23 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/v1/dv-admin/store/{id}/invoices/{invoiceId}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Generate store webhook secret",
+                "description": "Load store invoice with status history",
                 "consumes": [
                     "application/json"
                 ],
@@ -4265,9 +4719,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Store"
+                    "Invoice"
                 ],
-                "summary": "Generate store webhook secret",
+                "summary": "Load store invoice",
                 "parameters": [
                     {
                         "type": "string",
@@ -4275,13 +4729,20 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Invoice ID",
+                        "name": "invoiceId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-StoreSecretResponse"
+                            "$ref": "#/definitions/JSONResponse-InvoiceWithHistoryResponse"
                         }
                     },
                     "401": {
@@ -4290,21 +4751,23 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/dv-admin/store/{id}/invoices/{invoiceId}/cancel": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Remove store webhook secret",
+                "description": "Cancel pending or partially paid invoice",
                 "consumes": [
                     "application/json"
                 ],
@@ -4312,9 +4775,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Store"
+                    "Invoice"
                 ],
-                "summary": "Remove store webhook secret",
+                "summary": "Cancel store invoice",
                 "parameters": [
                     {
                         "type": "string",
@@ -4322,13 +4785,20 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Invoice ID",
+                        "name": "invoiceId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/JSONResponse-InvoiceResponse"
                         }
                     },
                     "401": {
@@ -4337,8 +4807,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -4346,14 +4822,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/store/{id}/transactions/": {
+        "/v1/dv-admin/store/{id}/payment-policy": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Load store transactions",
+                "description": "Load store under/overpayment tolerance policy",
                 "consumes": [
                     "application/json"
                 ],
@@ -4363,7 +4839,7 @@ const docTemplate = `{
                 "tags": [
                     "Store"
                 ],
-                "summary": "Load store transactions",
+                "summary": "Load store payment policy",
                 "parameters": [
                     {
                         "type": "string",
@@ -4371,19 +4847,13 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "page number",
-                        "name": "page",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-array_StoreTransactionResponse"
+                            "$ref": "#/definitions/JSONResponse-StorePaymentPolicyResponse"
                         }
                     },
                     "401": {
@@ -4399,16 +4869,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/dv-admin/store/{id}/unarchive": {
-            "post": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete store",
+                "description": "Update store under/overpayment tolerance policy",
                 "consumes": [
                     "application/json"
                 ],
@@ -4418,7 +4886,7 @@ const docTemplate = `{
                 "tags": [
                     "Store"
                 ],
-                "summary": "Delete store",
+                "summary": "Update store payment policy",
                 "parameters": [
                     {
                         "type": "string",
@@ -4426,13 +4894,28 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Payment policy",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateStorePaymentPolicyRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-any"
+                            "$ref": "#/definitions/JSONResponse-StorePaymentPolicyResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
                         }
                     },
                     "401": {
@@ -4441,8 +4924,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -4450,14 +4933,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/store/{id}/webhooks/": {
+        "/v1/dv-admin/store/{id}/refunds": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Load store webhooks",
+                "description": "Load store refunds",
                 "consumes": [
                     "application/json"
                 ],
@@ -4465,9 +4948,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Store"
+                    "Refund"
                 ],
-                "summary": "Load store webhooks",
+                "summary": "Load store refunds",
                 "parameters": [
                     {
                         "type": "string",
@@ -4475,13 +4958,63 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "enum": [
+                                "aml_blocked",
+                                "overpayment",
+                                "manual"
+                            ],
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "reasons",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "enum": [
+                                "pending_approval",
+                                "approved",
+                                "processing",
+                                "completed",
+                                "rejected",
+                                "failed",
+                                "cancelled"
+                            ],
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "statuses",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "transaction_id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-array_StoreWebhookResponse"
+                            "$ref": "#/definitions/JSONResponse-ResponseWithFullPagination-RefundResponse"
                         }
                     },
                     "401": {
@@ -4490,8 +5023,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -4504,7 +5037,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create store webhooks",
+                "description": "Request refund of a deposit, it is executed after approval by root or finance manager",
                 "consumes": [
                     "application/json"
                 ],
@@ -4512,9 +5045,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Store"
+                    "Refund"
                 ],
-                "summary": "Create store webhooks",
+                "summary": "Create store refund",
                 "parameters": [
                     {
                         "type": "string",
@@ -4524,12 +5057,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Create store webhook",
+                        "description": "Create refund",
                         "name": "register",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/CreateStoreWebhookRequest"
+                            "$ref": "#/definitions/CreateRefundRequest"
                         }
                     }
                 ],
@@ -4537,7 +5070,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-StoreWebhookResponse"
+                            "$ref": "#/definitions/JSONResponse-RefundResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
                         }
                     },
                     "401": {
@@ -4546,8 +5085,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -4555,14 +5100,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/store/{id}/webhooks/{webhookId}": {
+        "/v1/dv-admin/store/{id}/refunds/{refundId}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Load store webhook",
+                "description": "Load store refund with status history",
                 "consumes": [
                     "application/json"
                 ],
@@ -4570,9 +5115,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Store"
+                    "Refund"
                 ],
-                "summary": "Load store webhook",
+                "summary": "Load store refund",
                 "parameters": [
                     {
                         "type": "string",
@@ -4583,8 +5128,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Webhook ID",
-                        "name": "webhookId",
+                        "description": "Refund ID",
+                        "name": "refundId",
                         "in": "path",
                         "required": true
                     }
@@ -4593,7 +5138,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-StoreWebhookResponse"
+                            "$ref": "#/definitions/JSONResponse-RefundWithHistoryResponse"
                         }
                     },
                     "401": {
@@ -4602,21 +5147,23 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/v1/dv-admin/store/{id}/refunds/{refundId}/address": {
             "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update store webhooks",
+                "description": "Change destination address of refund pending approval",
                 "consumes": [
                     "application/json"
                 ],
@@ -4624,9 +5171,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Store"
+                    "Refund"
                 ],
-                "summary": "Update store webhooks",
+                "summary": "Update store refund address",
                 "parameters": [
                     {
                         "type": "string",
@@ -4637,18 +5184,18 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Webhook ID",
-                        "name": "webhookId",
+                        "description": "Refund ID",
+                        "name": "refundId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Create store webhook",
+                        "description": "Refund address",
                         "name": "register",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/UpdateStoreWebhookRequest"
+                            "$ref": "#/definitions/UpdateRefundAddressRequest"
                         }
                     }
                 ],
@@ -4656,7 +5203,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-StoreWebhookResponse"
+                            "$ref": "#/definitions/JSONResponse-RefundResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
                         }
                     },
                     "401": {
@@ -4665,21 +5218,29 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/dv-admin/store/{id}/refunds/{refundId}/cancel": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete store webhooks",
+                "description": "Cancel refund pending approval",
                 "consumes": [
                     "application/json"
                 ],
@@ -4687,9 +5248,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Store"
+                    "Refund"
                 ],
-                "summary": "Delete store webhooks",
+                "summary": "Cancel store refund",
                 "parameters": [
                     {
                         "type": "string",
@@ -4700,8 +5261,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Webhook ID",
-                        "name": "webhookId",
+                        "description": "Refund ID",
+                        "name": "refundId",
                         "in": "path",
                         "required": true
                     }
@@ -4710,7 +5271,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-any"
+                            "$ref": "#/definitions/JSONResponse-RefundResponse"
                         }
                     },
                     "401": {
@@ -4719,8 +5280,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -4728,24 +5295,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/store/{id}/whitelists/": {
-            "get": {
+        "/v1/dv-admin/store/{id}/resend-verify": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Load store whitelist",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Resend store verification",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Store"
                 ],
-                "summary": "Load store whitelist",
+                "summary": "Resend store verification",
                 "parameters": [
                     {
                         "type": "string",
@@ -4759,7 +5323,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-StoreWhitelistResponse"
+                            "$ref": "#/definitions/JSONResponse-any"
                         }
                     },
                     "400": {
@@ -4773,26 +5337,37 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/v1/dv-admin/store/{id}/secret": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update store whitelist",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Fetch store webhook secret",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Store"
                 ],
-                "summary": "Update store whitelist",
+                "summary": "Fetch store webhook secret",
                 "parameters": [
                     {
                         "type": "string",
@@ -4800,45 +5375,36 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Update store whitelist",
-                        "name": "register",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/UpdateWhitelistRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-any"
+                            "$ref": "#/definitions/JSONResponse-StoreSecretResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     }
                 }
             },
-            "patch": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Patch store whitelist",
+                "description": "Generate store webhook secret, the replaced secret keeps signing webhooks during rotation window",
                 "consumes": [
                     "application/json"
                 ],
@@ -4848,48 +5414,51 @@ const docTemplate = `{
                 "tags": [
                     "Store"
                 ],
-                "summary": "Patch store whitelist",
+                "summary": "Generate store webhook secret",
                 "parameters": [
                     {
-                        "description": "Patch store whitelist",
-                        "name": "register",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/PatchWhitelistRequest"
-                        }
+                        "type": "string",
+                        "description": "Store ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "maximum": 720,
+                        "type": "integer",
+                        "description": "RotationWindowHours keeps replaced secret signing webhooks, 24 hours when omitted, 0 replaces it at once",
+                        "name": "rotation_window_hours",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-StoreWhitelistResponse"
+                            "$ref": "#/definitions/JSONResponse-StoreSecretResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     }
                 }
-            }
-        },
-        "/v1/dv-admin/store/{id}/whitelists/{ip}": {
+            },
             "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete store whitelist",
+                "description": "Remove store webhook secret",
                 "consumes": [
                     "application/json"
                 ],
@@ -4899,7 +5468,7 @@ const docTemplate = `{
                 "tags": [
                     "Store"
                 ],
-                "summary": "Delete store whitelist",
+                "summary": "Remove store webhook secret",
                 "parameters": [
                     {
                         "type": "string",
@@ -4907,30 +5476,23 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Whitelist IP",
-                        "name": "ip",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-any"
+                            "type": "string"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -4938,51 +5500,45 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/system/info": {
-            "get": {
-                "description": "Get system information about initialization, root user existence, registration status",
+        "/v1/dv-admin/store/{id}/secret/previous": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stop signing webhooks with replaced store secret before rotation window ends",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "System"
+                    "Store"
+                ],
+                "summary": "Revoke previous store webhook secret",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get system information",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-SystemInfoResponse"
+                            "$ref": "#/definitions/JSONResponse-StoreSecretResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
-                    }
-                }
-            }
-        },
-        "/v1/dv-admin/system/update/backend": {
-            "post": {
-                "description": "Update backend",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "System"
-                ],
-                "summary": "Update backend",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/JSONResponse-string"
-                        }
                     },
-                    "400": {
-                        "description": "Bad request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -4990,25 +5546,54 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/system/update/processing": {
-            "post": {
-                "description": "Update processing",
+        "/v1/dv-admin/store/{id}/transactions/": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Load store transactions",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "System"
+                    "Store"
+                ],
+                "summary": "Load store transactions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page number",
+                        "name": "page",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Update processing",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-string"
+                            "$ref": "#/definitions/JSONResponse-array_StoreTransactionResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad request",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -5016,14 +5601,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/system/versions": {
-            "get": {
+        "/v1/dv-admin/store/{id}/unarchive": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Application versions info with no cache",
+                "description": "Delete store",
                 "consumes": [
                     "application/json"
                 ],
@@ -5031,14 +5616,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "System"
+                    "Store"
+                ],
+                "summary": "Delete store",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Application versions info with no cache",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-VersionResponse"
+                            "$ref": "#/definitions/JSONResponse-any"
                         }
                     },
                     "401": {
@@ -5046,18 +5640,24 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
                     }
                 }
             }
         },
-        "/v1/dv-admin/transaction/": {
+        "/v1/dv-admin/store/{id}/webhook-client-certificate": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Load user transactions",
+                "description": "Load certificate presented to store webhook endpoints requiring mutual TLS",
                 "consumes": [
                     "application/json"
                 ],
@@ -5065,135 +5665,202 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Transaction"
+                    "StoreWebhook"
                 ],
-                "summary": "Load user transactions",
+                "summary": "Load store webhook client certificate",
                 "parameters": [
                     {
-                        "enum": [
-                            "arbitrum",
-                            "bitcoin",
-                            "bitcoincash",
-                            "bsc",
-                            "dogecoin",
-                            "ethereum",
-                            "litecoin",
-                            "polygon",
-                            "tron"
-                        ],
                         "type": "string",
-                        "x-enum-varnames": [
-                            "BlockchainArbitrum",
-                            "BlockchainBitcoin",
-                            "BlockchainBitcoinCash",
-                            "BlockchainBinanceSmartChain",
-                            "BlockchainDogecoin",
-                            "BlockchainEthereum",
-                            "BlockchainLitecoin",
-                            "BlockchainPolygon",
-                            "BlockchainTron"
-                        ],
-                        "name": "blockchain",
-                        "in": "query"
+                        "description": "Store ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-WebhookClientCertificateResponse"
+                        }
                     },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "name": "currencies",
-                        "in": "query"
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
                     {
-                        "type": "string",
-                        "format": "date-time",
-                        "name": "date_from",
-                        "in": "query"
-                    },
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Set PEM encoded certificate and private key presented to store webhook endpoints requiring mutual TLS",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "StoreWebhook"
+                ],
+                "summary": "Set store webhook client certificate",
+                "parameters": [
                     {
                         "type": "string",
-                        "format": "date-time",
-                        "name": "date_to",
-                        "in": "query"
+                        "description": "Store ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "string",
-                        "name": "from_address",
-                        "in": "query"
+                        "description": "Client certificate",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/SetWebhookClientCertificateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-WebhookClientCertificateResponse"
+                        }
                     },
-                    {
-                        "type": "boolean",
-                        "name": "is_system",
-                        "in": "query"
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
                     },
-                    {
-                        "type": "number",
-                        "name": "min_amount_usd",
-                        "in": "query"
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
                     {
-                        "type": "integer",
-                        "name": "page",
-                        "in": "query"
-                    },
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete certificate presented to store webhook endpoints",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "StoreWebhook"
+                ],
+                "summary": "Delete store webhook client certificate",
+                "parameters": [
                     {
-                        "type": "integer",
-                        "name": "page_size",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Store ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-any"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/dv-admin/store/{id}/webhook-dead-letters": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Load store webhooks that exhausted retry policy",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "StoreWebhook"
+                ],
+                "summary": "Load store webhook dead letters",
+                "parameters": [
                     {
-                        "enum": [
-                            "created_at_index",
-                            "amount_usd",
-                            "tx_hash",
-                            "user_email"
-                        ],
                         "type": "string",
-                        "name": "sort_by",
-                        "in": "query"
+                        "description": "Store ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "enum": [
-                            "asc",
-                            "desc"
-                        ],
+                        "maxLength": 50,
                         "type": "string",
-                        "name": "sort_direction",
+                        "name": "event",
                         "in": "query"
                     },
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "name": "store_uuids",
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page",
                         "in": "query"
                     },
                     {
-                        "type": "string",
-                        "name": "to_address",
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page_size",
                         "in": "query"
                     },
                     {
-                        "enum": [
-                            "transfer",
-                            "deposit",
-                            "withdrawal_from_processing"
-                        ],
-                        "type": "string",
-                        "x-enum-varnames": [
-                            "TransactionsTypeTransfer",
-                            "TransactionsTypeDeposit",
-                            "TransactionsTypeWithdrawalFromProcessing"
-                        ],
-                        "name": "type",
+                        "type": "boolean",
+                        "name": "requeued",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "name": "wallet_address",
+                        "name": "transaction_id",
                         "in": "query"
                     }
                 ],
@@ -5201,7 +5868,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-ResponseWithFullPagination-github_com_dv-net_dv-merchant_internal_storage_repos_repo_transactions_FindRow"
+                            "$ref": "#/definitions/JSONResponse-ResponseWithFullPagination-WebhookDeadLetterResponse"
                         }
                     },
                     "401": {
@@ -5219,14 +5886,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/transaction/export": {
-            "get": {
+        "/v1/dv-admin/store/{id}/webhook-dead-letters/requeue": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Download user transactions",
+                "description": "Put selected or all pending store webhook dead letters back to the send queue",
                 "consumes": [
                     "application/json"
                 ],
@@ -5234,139 +5901,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Transaction"
+                    "StoreWebhook"
                 ],
-                "summary": "Download user transactions",
+                "summary": "Requeue store webhook dead letters",
                 "parameters": [
                     {
-                        "enum": [
-                            "arbitrum",
-                            "bitcoin",
-                            "bitcoincash",
-                            "bsc",
-                            "dogecoin",
-                            "ethereum",
-                            "litecoin",
-                            "polygon",
-                            "tron"
-                        ],
                         "type": "string",
-                        "x-enum-varnames": [
-                            "BlockchainArbitrum",
-                            "BlockchainBitcoin",
-                            "BlockchainBitcoinCash",
-                            "BlockchainBinanceSmartChain",
-                            "BlockchainDogecoin",
-                            "BlockchainEthereum",
-                            "BlockchainLitecoin",
-                            "BlockchainPolygon",
-                            "BlockchainTron"
-                        ],
-                        "name": "blockchain",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "name": "currencies",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "format": "date-time",
-                        "name": "date_from",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "format": "date-time",
-                        "name": "date_to",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "from_address",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "name": "is_system",
-                        "in": "query"
-                    },
-                    {
-                        "type": "number",
-                        "name": "min_amount_usd",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "name": "page_size",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "created_at_index",
-                            "amount_usd",
-                            "tx_hash",
-                            "user_email"
-                        ],
-                        "type": "string",
-                        "name": "sort_by",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "asc",
-                            "desc"
-                        ],
-                        "type": "string",
-                        "name": "sort_direction",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "name": "store_uuids",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "to_address",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "transfer",
-                            "deposit",
-                            "withdrawal_from_processing"
-                        ],
-                        "type": "string",
-                        "x-enum-varnames": [
-                            "TransactionsTypeTransfer",
-                            "TransactionsTypeDeposit",
-                            "TransactionsTypeWithdrawalFromProcessing"
-                        ],
-                        "name": "type",
-                        "in": "query"
+                        "description": "Store ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "string",
-                        "name": "wallet_address",
-                        "in": "query"
+                        "description": "Dead letters to requeue",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/RequeueWebhookDeadLettersRequest"
+                        }
                     }
                 ],
                 "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-RequeueWebhookDeadLettersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
@@ -5382,14 +5950,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/transaction/stats": {
+        "/v1/dv-admin/store/{id}/webhook-dead-letters/{deadLetterId}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Load user transactions statistic by date",
+                "description": "Load store webhook dead letter with payload and signature",
                 "consumes": [
                     "application/json"
                 ],
@@ -5397,99 +5965,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Transaction"
+                    "StoreWebhook"
                 ],
-                "summary": "Load user transactions statistic by date",
+                "summary": "Load store webhook dead letter",
                 "parameters": [
                     {
-                        "enum": [
-                            "arbitrum",
-                            "bitcoin",
-                            "bitcoincash",
-                            "bsc",
-                            "dogecoin",
-                            "ethereum",
-                            "litecoin",
-                            "polygon",
-                            "tron"
-                        ],
-                        "type": "string",
-                        "x-enum-varnames": [
-                            "BlockchainArbitrum",
-                            "BlockchainBitcoin",
-                            "BlockchainBitcoinCash",
-                            "BlockchainBinanceSmartChain",
-                            "BlockchainDogecoin",
-                            "BlockchainEthereum",
-                            "BlockchainLitecoin",
-                            "BlockchainPolygon",
-                            "BlockchainTron"
-                        ],
-                        "name": "blockchain",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "name": "currencies",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "format": "date-time",
-                        "name": "date_from",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "format": "date-time",
-                        "name": "date_to",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "name": "is_system",
-                        "in": "query"
-                    },
-                    {
-                        "type": "number",
-                        "name": "min_amount_usd",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "day",
-                            "hour"
-                        ],
                         "type": "string",
-                        "name": "resolution",
-                        "in": "query",
+                        "description": "Store ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     },
                     {
-                        "enum": [
-                            "transfer",
-                            "deposit",
-                            "withdrawal_from_processing"
-                        ],
                         "type": "string",
-                        "x-enum-varnames": [
-                            "TransactionsTypeTransfer",
-                            "TransactionsTypeDeposit",
-                            "TransactionsTypeWithdrawalFromProcessing"
-                        ],
-                        "name": "type",
-                        "in": "query"
+                        "description": "Dead letter ID",
+                        "name": "deadLetterId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-github_com_dv-net_dv-merchant_internal_storage_repos_repo_transactions_StatisticsRow"
+                            "$ref": "#/definitions/JSONResponse-WebhookDeadLetterWithPayloadResponse"
                         }
                     },
                     "401": {
@@ -5507,14 +6006,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/transaction/{hash}": {
+        "/v1/dv-admin/store/{id}/webhook-retry-policy": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Search transactions by hash **Deprecated**: Use ` + "`" + `/v1/dv-admin/search/:searchParam instead",
+                "description": "Load policy used to retry failed store webhooks before they are moved to dead letters",
                 "consumes": [
                     "application/json"
                 ],
@@ -5522,14 +6021,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Transaction"
+                    "StoreWebhook"
+                ],
+                "summary": "Load store webhook retry policy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Search transactions by hash",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-TransactionInfoResponse"
+                            "$ref": "#/definitions/JSONResponse-WebhookRetryPolicyResponse"
                         }
                     },
                     "401": {
@@ -5545,16 +6053,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/dv-admin/transaction/{id}/send-webhooks": {
-            "post": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Send webhook manually. Accepts confirmed/unconfirmed tx",
+                "description": "Update policy used to retry failed store webhooks before they are moved to dead letters",
                 "consumes": [
                     "application/json"
                 ],
@@ -5564,21 +6070,30 @@ const docTemplate = `{
                 "tags": [
                     "StoreWebhook"
                 ],
-                "summary": "Send webhook",
+                "summary": "Update store webhook retry policy",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Transaction id",
-                        "name": "ID",
+                        "description": "Store ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Retry policy",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateWebhookRetryPolicyRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-string"
+                            "$ref": "#/definitions/JSONResponse-WebhookRetryPolicyResponse"
                         }
                     },
                     "400": {
@@ -5592,18 +6107,24 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
-                    }
-                }
-            }
-        },
-        "/v1/dv-admin/transfer/": {
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/dv-admin/store/{id}/webhooks/": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This endpoint for filter transfer by status",
+                "description": "Load store webhooks",
                 "consumes": [
                     "application/json"
                 ],
@@ -5611,31 +6132,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Transfers"
+                    "Store"
                 ],
-                "summary": "Get transfer by status",
+                "summary": "Load store webhooks",
                 "parameters": [
                     {
-                        "description": "Transfer Request",
-                        "name": "register",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/WithdrawalWalletsTransferRequest"
-                        }
+                        "type": "string",
+                        "description": "Store ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-ResponseWithFullPagination-GetTransferResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad request",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
+                            "$ref": "#/definitions/JSONResponse-array_StoreWebhookResponse"
                         }
                     },
                     "401": {
@@ -5644,12 +6157,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -5658,13 +6165,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This endpoint for removal action of transfers by ids",
+                "description": "Create store webhooks",
                 "consumes": [
                     "application/json"
                 ],
@@ -5672,17 +6179,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Transfers"
+                    "Store"
                 ],
-                "summary": "Delete transfers by ids",
+                "summary": "Create store webhooks",
                 "parameters": [
                     {
-                        "description": "Delete Transfer Request",
+                        "type": "string",
+                        "description": "Store ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create store webhook",
                         "name": "register",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/DeleteTransferRequest"
+                            "$ref": "#/definitions/CreateStoreWebhookRequest"
                         }
                     }
                 ],
@@ -5690,17 +6204,17 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-string"
+                            "$ref": "#/definitions/JSONResponse-StoreWebhookResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -5708,14 +6222,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/transfer/history": {
+        "/v1/dv-admin/store/{id}/webhooks/{webhookId}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This endpoint for get transfer history",
+                "description": "Load store webhook",
                 "consumes": [
                     "application/json"
                 ],
@@ -5723,31 +6237,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Transfers"
+                    "Store"
                 ],
-                "summary": "Get transfer history",
+                "summary": "Load store webhook",
                 "parameters": [
                     {
-                        "description": "Transfer History Request",
-                        "name": "register",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/TransferHistoryRequest"
-                        }
+                        "type": "string",
+                        "description": "Store ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "webhookId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-ResponseWithFullPagination-GetTransferResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad request",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
+                            "$ref": "#/definitions/JSONResponse-StoreWebhookResponse"
                         }
                     },
                     "401": {
@@ -5756,12 +6269,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -5769,16 +6276,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/dv-admin/transfer/mode": {
-            "post": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This endpoint for on/off transfer",
+                "description": "Update store webhooks",
                 "consumes": [
                     "application/json"
                 ],
@@ -5786,17 +6291,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Transfers"
+                    "Store"
                 ],
-                "summary": "Disable or enable transfers",
+                "summary": "Update store webhooks",
                 "parameters": [
                     {
-                        "description": "Transfer Request",
+                        "type": "string",
+                        "description": "Store ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "webhookId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create store webhook",
                         "name": "register",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/TransfersToggleRequest"
+                            "$ref": "#/definitions/UpdateStoreWebhookRequest"
                         }
                     }
                 ],
@@ -5804,13 +6323,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-string"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad request",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
+                            "$ref": "#/definitions/JSONResponse-StoreWebhookResponse"
                         }
                     },
                     "401": {
@@ -5819,12 +6332,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -5832,16 +6339,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/dv-admin/transfer/prefetch": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This endpoint for get prefetch transfer",
+                "description": "Delete store webhooks",
                 "consumes": [
                     "application/json"
                 ],
@@ -5849,20 +6354,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Transfers"
+                    "Store"
+                ],
+                "summary": "Delete store webhooks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "webhookId",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get prefetch Transfers",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-array_GetPrefetchDataResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad request",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
+                            "$ref": "#/definitions/JSONResponse-any"
                         }
                     },
                     "401": {
@@ -5871,12 +6386,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -5886,14 +6395,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/transfer/tron/transfer-type": {
-            "post": {
+        "/v1/dv-admin/store/{id}/whitelists/": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This endpoint for update transfer type by resources or burntrx",
+                "description": "Load store whitelist",
                 "consumes": [
                     "application/json"
                 ],
@@ -5901,29 +6410,27 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Transfers"
+                    "Store"
                 ],
-                "summary": "Update tron transfer type",
+                "summary": "Load store whitelist",
                 "parameters": [
                     {
-                        "description": "Tron Transfers Type Request",
-                        "name": "register",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/TronTransfersTypeRequest"
-                        }
+                        "type": "string",
+                        "description": "Store ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-SettingResponse"
+                            "$ref": "#/definitions/JSONResponse-StoreWhitelistResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -5933,30 +6440,16 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
-                    },
-                    "422": {
-                        "description": "Unprocessable Entity",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
                     }
                 }
-            }
-        },
-        "/v1/dv-admin/tron/resource-expenses/": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieves aggregated statistics for Tron wallet balances, transfer expenses, and deposit counts for a user over a specified date range.",
+                "description": "Update store whitelist",
                 "consumes": [
                     "application/json"
                 ],
@@ -5964,77 +6457,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Statistics"
+                    "Store"
                 ],
-                "summary": "Get Tron resource and deposit statistics",
+                "summary": "Update store whitelist",
                 "parameters": [
                     {
                         "type": "string",
-                        "example": "\"2025-06-17\"",
-                        "description": "Start date (YYYY-MM-DD)",
-                        "name": "date_from",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "example": "\"2025-06-24\"",
-                        "description": "End date (YYYY-MM-DD)",
-                        "name": "date_to",
-                        "in": "query"
+                        "description": "Store ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "string",
-                        "example": "\"hour\"",
-                        "description": "day/hour",
-                        "name": "resolution",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Successful response with statistics",
-                        "schema": {
-                            "$ref": "#/definitions/JSONResponse-map_string_CombinedStats"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid request parameters or processing error",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized: Invalid or missing Bearer token",
+                        "description": "Update store whitelist",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/APIErrors"
+                            "$ref": "#/definitions/UpdateWhitelistRequest"
                         }
                     }
-                }
-            }
-        },
-        "/v1/dv-admin/user": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Auth a user",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "User"
                 ],
-                "summary": "Auth user",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-GetUserInfoResponse"
+                            "$ref": "#/definitions/JSONResponse-any"
                         }
                     },
                     "400": {
@@ -6048,28 +6496,16 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
-                    },
-                    "422": {
-                        "description": "Unprocessable Entity",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    },
-                    "503": {
-                        "description": "Service Unavailable",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
                     }
                 }
             },
-            "put": {
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update user",
+                "description": "Patch store whitelist",
                 "consumes": [
                     "application/json"
                 ],
@@ -6077,17 +6513,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Store"
                 ],
-                "summary": "Update user",
+                "summary": "Patch store whitelist",
                 "parameters": [
                     {
-                        "description": "Update user info",
+                        "description": "Patch store whitelist",
                         "name": "register",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/UpdateUserRequest"
+                            "$ref": "#/definitions/PatchWhitelistRequest"
                         }
                     }
                 ],
@@ -6095,7 +6531,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-GetUserInfoResponse"
+                            "$ref": "#/definitions/JSONResponse-StoreWhitelistResponse"
                         }
                     },
                     "400": {
@@ -6109,24 +6545,18 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
-                    },
-                    "422": {
-                        "description": "Unprocessable Entity",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
                     }
                 }
             }
         },
-        "/v1/dv-admin/user-setting/": {
-            "post": {
+        "/v1/dv-admin/store/{id}/whitelists/{ip}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create or update root settings",
+                "description": "Delete store whitelist",
                 "consumes": [
                     "application/json"
                 ],
@@ -6134,31 +6564,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Setting"
+                    "Store"
                 ],
-                "summary": "Create or update root settings",
+                "summary": "Delete store whitelist",
                 "parameters": [
                     {
-                        "description": "Create or update user setting",
-                        "name": "register",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/CreateSettingRequest"
-                        }
+                        "type": "string",
+                        "description": "Store ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Whitelist IP",
+                        "name": "ip",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-string"
+                            "$ref": "#/definitions/JSONResponse-any"
                         }
                     },
-                    "202": {
-                        "description": "Accepted",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-string"
+                            "$ref": "#/definitions/APIErrors"
                         }
                     },
                     "401": {
@@ -6166,15 +6601,29 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
-                    },
-                    "404": {
-                        "description": "Not Found",
+                    }
+                }
+            }
+        },
+        "/v1/dv-admin/system/info": {
+            "get": {
+                "description": "Get system information about initialization, root user existence, registration status",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "System"
+                ],
+                "summary": "Get system information",
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/APIErrors"
+                            "$ref": "#/definitions/JSONResponse-SystemInfoResponse"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "400": {
+                        "description": "Bad request",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -6182,33 +6631,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/user-setting/list": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "List available user settings",
-                "consumes": [
-                    "application/json"
-                ],
+        "/v1/dv-admin/system/update/backend": {
+            "post": {
+                "description": "Update backend",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Setting"
+                    "System"
                 ],
-                "summary": "List available user settings",
+                "summary": "Update backend",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-array_github_com_dv-net_dv-merchant_internal_service_setting_Dto"
+                            "$ref": "#/definitions/JSONResponse-string"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad request",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -6216,30 +6657,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/user-setting/{setting_name}": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Get setting value",
+        "/v1/dv-admin/system/update/processing": {
+            "post": {
+                "description": "Update processing",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Setting"
+                    "System"
                 ],
-                "summary": "Get setting value",
+                "summary": "Update processing",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-SettingResponse"
+                            "$ref": "#/definitions/JSONResponse-string"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad request",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -6247,14 +6683,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/user/change-email": {
-            "post": {
+        "/v1/dv-admin/system/versions": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Change email",
+                "description": "Application versions info with no cache",
                 "consumes": [
                     "application/json"
                 ],
@@ -6262,31 +6698,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
-                ],
-                "summary": "Change email",
-                "parameters": [
-                    {
-                        "description": "Confirm email",
-                        "name": "register",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/ChangeEmailRequest"
-                        }
-                    }
+                    "System"
                 ],
+                "summary": "Application versions info with no cache",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-string"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
+                            "$ref": "#/definitions/JSONResponse-VersionResponse"
                         }
                     },
                     "401": {
@@ -6294,24 +6713,18 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
-                    },
-                    "422": {
-                        "description": "Unprocessable Entity",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
                     }
                 }
             }
         },
-        "/v1/dv-admin/user/change-password": {
-            "post": {
+        "/v1/dv-admin/transaction/": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update user password",
+                "description": "Load user transactions",
                 "consumes": [
                     "application/json"
                 ],
@@ -6319,129 +6732,143 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Transaction"
                 ],
-                "summary": "Update user password",
+                "summary": "Load user transactions",
                 "parameters": [
                     {
-                        "description": "Update user password",
-                        "name": "register",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/ChangePasswordInternalRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/JSONResponse-string"
-                        }
+                        "enum": [
+                            "arbitrum",
+                            "bitcoin",
+                            "bitcoincash",
+                            "bsc",
+                            "dogecoin",
+                            "ethereum",
+                            "litecoin",
+                            "polygon",
+                            "tron"
+                        ],
+                        "type": "string",
+                        "x-enum-varnames": [
+                            "BlockchainArbitrum",
+                            "BlockchainBitcoin",
+                            "BlockchainBitcoinCash",
+                            "BlockchainBinanceSmartChain",
+                            "BlockchainDogecoin",
+                            "BlockchainEthereum",
+                            "BlockchainLitecoin",
+                            "BlockchainPolygon",
+                            "BlockchainTron"
+                        ],
+                        "name": "blockchain",
+                        "in": "query"
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "currencies",
+                        "in": "query"
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "name": "date_from",
+                        "in": "query"
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    }
-                }
-            }
-        },
-        "/v1/dv-admin/user/confirm-email": {
-            "post": {
-                "security": [
                     {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Auth a user",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "User"
-                ],
-                "summary": "Auth user",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/JSONResponse-string"
-                        }
+                        "type": "string",
+                        "format": "date-time",
+                        "name": "date_to",
+                        "in": "query"
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
+                    {
+                        "type": "string",
+                        "name": "from_address",
+                        "in": "query"
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
+                    {
+                        "type": "boolean",
+                        "name": "is_system",
+                        "in": "query"
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
+                    {
+                        "type": "number",
+                        "name": "min_amount_usd",
+                        "in": "query"
                     },
-                    "503": {
-                        "description": "Service Unavailable",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    }
-                }
-            }
-        },
-        "/v1/dv-admin/user/init-email-change": {
-            "post": {
-                "security": [
                     {
-                        "BearerAuth": []
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "created_at_index",
+                            "amount_usd",
+                            "tx_hash",
+                            "user_email"
+                        ],
+                        "type": "string",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "name": "sort_direction",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "store_uuids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "to_address",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "transfer",
+                            "deposit",
+                            "withdrawal_from_processing"
+                        ],
+                        "type": "string",
+                        "x-enum-varnames": [
+                            "TransactionsTypeTransfer",
+                            "TransactionsTypeDeposit",
+                            "TransactionsTypeWithdrawalFromProcessing"
+                        ],
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "wallet_address",
+                        "in": "query"
                     }
                 ],
-                "description": "Init email change",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "User"
-                ],
-                "summary": "Init email change",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-string"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
+                            "$ref": "#/definitions/JSONResponse-ResponseWithFullPagination-github_com_dv-net_dv-merchant_internal_storage_repos_repo_transactions_FindRow"
                         }
                     },
                     "401": {
@@ -6450,8 +6877,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -6459,14 +6886,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/user/init-email-confirmation": {
-            "post": {
+        "/v1/dv-admin/transaction/export": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Init email confirnation",
+                "description": "Download user transactions",
                 "consumes": [
                     "application/json"
                 ],
@@ -6474,159 +6901,147 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Transaction"
                 ],
-                "summary": "Init email confirnation",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/JSONResponse-string"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    },
-                    "422": {
-                        "description": "Unprocessable Entity",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    }
-                }
-            }
-        },
-        "/v1/dv-admin/user/tg-link": {
-            "post": {
-                "security": [
+                "summary": "Download user transactions",
+                "parameters": [
                     {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Generate telegram link",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "User"
-                ],
-                "summary": "Generate telegram link",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/JSONResponse-TgLinkResponse"
-                        }
+                        "enum": [
+                            "arbitrum",
+                            "bitcoin",
+                            "bitcoincash",
+                            "bsc",
+                            "dogecoin",
+                            "ethereum",
+                            "litecoin",
+                            "polygon",
+                            "tron"
+                        ],
+                        "type": "string",
+                        "x-enum-varnames": [
+                            "BlockchainArbitrum",
+                            "BlockchainBitcoin",
+                            "BlockchainBitcoinCash",
+                            "BlockchainBinanceSmartChain",
+                            "BlockchainDogecoin",
+                            "BlockchainEthereum",
+                            "BlockchainLitecoin",
+                            "BlockchainPolygon",
+                            "BlockchainTron"
+                        ],
+                        "name": "blockchain",
+                        "in": "query"
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "currencies",
+                        "in": "query"
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "name": "date_from",
+                        "in": "query"
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    }
-                }
-            }
-        },
-        "/v1/dv-admin/user/tg-unlink/confirm": {
-            "post": {
-                "security": [
                     {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Confirm telegram unlink",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "User"
-                ],
-                "summary": "Confirm telegram unlink",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/JSONResponse-string"
-                        }
+                        "type": "string",
+                        "format": "date-time",
+                        "name": "date_to",
+                        "in": "query"
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
+                    {
+                        "type": "string",
+                        "name": "from_address",
+                        "in": "query"
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
+                    {
+                        "type": "boolean",
+                        "name": "is_system",
+                        "in": "query"
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    }
-                }
-            }
-        },
-        "/v1/dv-admin/user/tg-unlink/init": {
-            "post": {
-                "security": [
                     {
-                        "BearerAuth": []
+                        "type": "number",
+                        "name": "min_amount_usd",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "created_at_index",
+                            "amount_usd",
+                            "tx_hash",
+                            "user_email"
+                        ],
+                        "type": "string",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "name": "sort_direction",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "store_uuids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "to_address",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "transfer",
+                            "deposit",
+                            "withdrawal_from_processing"
+                        ],
+                        "type": "string",
+                        "x-enum-varnames": [
+                            "TransactionsTypeTransfer",
+                            "TransactionsTypeDeposit",
+                            "TransactionsTypeWithdrawalFromProcessing"
+                        ],
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "wallet_address",
+                        "in": "query"
                     }
                 ],
-                "description": "Init telegram unlink",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "User"
-                ],
-                "summary": "Init telegram unlink",
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/JSONResponse-string"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -6634,14 +7049,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/wallet": {
-            "post": {
+        "/v1/dv-admin/transaction/stats": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This endpoint returns wallet's with balance",
+                "description": "Load user transactions statistic by date",
                 "consumes": [
                     "application/json"
                 ],
@@ -6649,76 +7064,99 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Wallet"
+                    "Transaction"
                 ],
-                "summary": "Get wallet's with balance",
+                "summary": "Load user transactions statistic by date",
                 "parameters": [
                     {
-                        "description": "GetWalletByStoreRequest",
-                        "name": "json",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/GetWalletByStoreRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Successful operation",
-                        "schema": {
-                            "$ref": "#/definitions/JSONResponse-ResponseWithFullPagination-GetWalletBalanceResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    }
-                }
-            }
-        },
-        "/v1/dv-admin/wallet/addresses": {
-            "post": {
-                "security": [
+                        "enum": [
+                            "arbitrum",
+                            "bitcoin",
+                            "bitcoincash",
+                            "bsc",
+                            "dogecoin",
+                            "ethereum",
+                            "litecoin",
+                            "polygon",
+                            "tron"
+                        ],
+                        "type": "string",
+                        "x-enum-varnames": [
+                            "BlockchainArbitrum",
+                            "BlockchainBitcoin",
+                            "BlockchainBitcoinCash",
+                            "BlockchainBinanceSmartChain",
+                            "BlockchainDogecoin",
+                            "BlockchainEthereum",
+                            "BlockchainLitecoin",
+                            "BlockchainPolygon",
+                            "BlockchainTron"
+                        ],
+                        "name": "blockchain",
+                        "in": "query"
+                    },
                     {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Creates/returns wallet with addressed pre-generated.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Wallet"
-                ],
-                "summary": "Create/return wallet with addresses",
-                "parameters": [
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "currencies",
+                        "in": "query"
+                    },
                     {
-                        "description": "CreateRequest",
-                        "name": "json",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/CreateWalletRequest"
-                        }
+                        "type": "string",
+                        "format": "date-time",
+                        "name": "date_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "date-time",
+                        "name": "date_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "name": "is_system",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "name": "min_amount_usd",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "day",
+                            "hour"
+                        ],
+                        "type": "string",
+                        "name": "resolution",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "transfer",
+                            "deposit",
+                            "withdrawal_from_processing"
+                        ],
+                        "type": "string",
+                        "x-enum-varnames": [
+                            "TransactionsTypeTransfer",
+                            "TransactionsTypeDeposit",
+                            "TransactionsTypeWithdrawalFromProcessing"
+                        ],
+                        "name": "type",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-WalletShort"
+                            "$ref": "#/definitions/JSONResponse-github_com_dv-net_dv-merchant_internal_storage_repos_repo_transactions_StatisticsRow"
                         }
                     },
                     "401": {
@@ -6727,8 +7165,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -6736,34 +7174,29 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/wallet/addresses/converter": {
-            "post": {
+        "/v1/dv-admin/transaction/{hash}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This endpoint convert legacy address to new format",
-                "tags": [
-                    "Wallet"
+                "description": "Search transactions by hash **Deprecated**: Use ` + "`" + `/v1/dv-admin/search/:searchParam instead",
+                "consumes": [
+                    "application/json"
                 ],
-                "summary": "Convert wallet's address",
-                "parameters": [
-                    {
-                        "description": "AddressConverterRequest",
-                        "name": "json",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_dv-net_dv-merchant_internal_delivery_http_request_wallet_request.AddressConverterRequest"
-                        }
-                    }
+                "produces": [
+                    "application/json"
                 ],
+                "tags": [
+                    "Transaction"
+                ],
+                "summary": "Search transactions by hash",
                 "responses": {
                     "200": {
-                        "description": "Successful operation",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-ConvertedAddressResponse"
+                            "$ref": "#/definitions/JSONResponse-TransactionInfoResponse"
                         }
                     },
                     "401": {
@@ -6772,8 +7205,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -6781,14 +7214,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/wallet/addresses/dirty": {
+        "/v1/dv-admin/transaction/{id}/send-webhooks": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Marks the given wallet address as dirty so it will not be used for new payments",
+                "description": "Send webhook manually. Accepts confirmed/unconfirmed tx",
                 "consumes": [
                     "application/json"
                 ],
@@ -6796,18 +7229,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Wallet"
+                    "StoreWebhook"
                 ],
-                "summary": "Mark wallet address as dirty",
+                "summary": "Send webhook",
                 "parameters": [
                     {
-                        "description": "MarkIsDirtyRequest",
-                        "name": "json",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/MarkIsDirtyRequest"
-                        }
+                        "type": "string",
+                        "description": "Transaction id",
+                        "name": "ID",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -6832,14 +7263,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/wallet/addresses/keys": {
+        "/v1/dv-admin/transfer/": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This endpoint returns private keys for each wallet's asset.",
+                "description": "This endpoint for filter transfer by status",
                 "consumes": [
                     "application/json"
                 ],
@@ -6847,25 +7278,45 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Wallet"
+                    "Transfers"
                 ],
-                "summary": "Get wallet's private/public keys.",
+                "summary": "Get transfer by status",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "TOTP auth code",
-                        "name": "totp",
-                        "in": "query",
-                        "required": true
+                        "description": "Transfer Request",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/WithdrawalWalletsTransferRequest"
+                        }
                     }
                 ],
                 "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-ResponseWithFullPagination-GetTransferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -6873,16 +7324,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/dv-admin/wallet/addresses/seeds": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This endpoint returns wallet's seed phrases",
+                "description": "This endpoint for removal action of transfers by ids",
                 "consumes": [
                     "application/json"
                 ],
@@ -6890,33 +7339,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Wallet"
+                    "Transfers"
                 ],
-                "summary": "Get wallet's seed phrase",
+                "summary": "Delete transfers by ids",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "TOTP auth code",
-                        "name": "totp",
-                        "in": "query",
-                        "required": true
+                        "description": "Delete Transfer Request",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/DeleteTransferRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successful operation",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-WalletSeedResponse"
+                            "$ref": "#/definitions/JSONResponse-string"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad request",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -6924,63 +7375,46 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/wallet/balances/cold": {
+        "/v1/dv-admin/transfer/history": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get cold wallet's addresses total balance",
+                "description": "This endpoint for get transfer history",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Wallet"
+                    "Transfers"
                 ],
-                "summary": "Get cold wallet's addresses total balance",
-                "responses": {
-                    "200": {
-                        "description": "Successful operation",
-                        "schema": {
-                            "$ref": "#/definitions/JSONResponse-WalletAddressTotalUSDResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
+                "summary": "Get transfer history",
+                "parameters": [
+                    {
+                        "description": "Transfer History Request",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/APIErrors"
+                            "$ref": "#/definitions/TransferHistoryRequest"
                         }
                     }
-                }
-            }
-        },
-        "/v1/dv-admin/wallet/balances/exchange-settings": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Get withdrawal wallet's addresses total balance",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Wallet"
                 ],
-                "summary": "Get withdrawal wallet's addresses total balance",
                 "responses": {
                     "200": {
-                        "description": "Successful operation",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-WalletAddressTotalUSDResponse"
+                            "$ref": "#/definitions/JSONResponse-ResponseWithFullPagination-GetTransferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
                         }
                     },
                     "401": {
@@ -6989,6 +7423,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -6998,26 +7438,46 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/wallet/balances/hot": {
-            "get": {
+        "/v1/dv-admin/transfer/mode": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get hot wallet's addresses total balance",
+                "description": "This endpoint for on/off transfer",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Wallet"
+                    "Transfers"
+                ],
+                "summary": "Disable or enable transfers",
+                "parameters": [
+                    {
+                        "description": "Transfer Request",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/TransfersToggleRequest"
+                        }
+                    }
                 ],
-                "summary": "Get hot wallet's addresses total balance",
                 "responses": {
                     "200": {
-                        "description": "Successful operation",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-WalletAddressTotalUSDResponse"
+                            "$ref": "#/definitions/JSONResponse-string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
                         }
                     },
                     "401": {
@@ -7026,6 +7486,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -7035,26 +7501,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/wallet/info/{searchParam}": {
+        "/v1/dv-admin/transfer/prefetch": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This endpoint returns wallet's data group by blockchains  **Deprecated**: Use ` + "`" + `/v1/dv-admin/search/:searchParam instead",
+                "description": "This endpoint for get prefetch transfer",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Wallet"
+                    "Transfers"
                 ],
-                "summary": "Find wallet's and get info grouped by blockchain",
+                "summary": "Get prefetch Transfers",
                 "responses": {
                     "200": {
-                        "description": "Successful operation",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-WalletWithBlockchains"
+                            "$ref": "#/definitions/JSONResponse-array_GetPrefetchDataResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
                         }
                     },
                     "401": {
@@ -7063,6 +7538,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -7072,66 +7553,47 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/wallet/keys/hot": {
+        "/v1/dv-admin/transfer/tron/transfer-type": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get hot wallet's keys",
+                "description": "This endpoint for update transfer type by resources or burntrx",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Wallet"
+                    "Transfers"
                 ],
-                "summary": "Get hot wallet's keys",
+                "summary": "Update tron transfer type",
                 "parameters": [
                     {
-                        "description": "GetHotWalletKeysRequest",
-                        "name": "json",
+                        "description": "Tron Transfers Type Request",
+                        "name": "register",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/GetHotWalletKeysRequest"
+                            "$ref": "#/definitions/TronTransfersTypeRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "401": {
-                        "description": "Unauthorized",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/APIErrors"
+                            "$ref": "#/definitions/JSONResponse-SettingResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad request",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
-                    }
-                }
-            }
-        },
-        "/v1/dv-admin/wallet/restore/{walletId}": {
-            "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "This endpoint returns wallet's data group by blockchains",
-                "tags": [
-                    "Wallet"
-                ],
-                "summary": "Restore missed wallet transaction",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/JSONResponse-string"
-                        }
                     },
                     "401": {
                         "description": "Unauthorized",
@@ -7139,6 +7601,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -7148,43 +7616,62 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/wallet/summary": {
+        "/v1/dv-admin/tron/resource-expenses/": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This endpoint returns wallet's summary stats grouped by currencies",
+                "description": "Retrieves aggregated statistics for Tron wallet balances, transfer expenses, and deposit counts for a user over a specified date range.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Wallet"
+                    "Statistics"
                 ],
-                "summary": "Get wallet's summary by currencies",
+                "summary": "Get Tron resource and deposit statistics",
                 "parameters": [
                     {
-                        "type": "number",
-                        "name": "min_balance",
+                        "type": "string",
+                        "example": "\"2025-06-17\"",
+                        "description": "Start date (YYYY-MM-DD)",
+                        "name": "date_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"2025-06-24\"",
+                        "description": "End date (YYYY-MM-DD)",
+                        "name": "date_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"hour\"",
+                        "description": "day/hour",
+                        "name": "resolution",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successful operation",
+                        "description": "Successful response with statistics",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-array_SummaryDTO"
+                            "$ref": "#/definitions/JSONResponse-map_string_CombinedStats"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Invalid request parameters or processing error",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "401": {
+                        "description": "Unauthorized: Invalid or missing Bearer token",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -7192,26 +7679,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/wallet/{searchParam}": {
+        "/v1/dv-admin/user": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This endpoint returns wallet's data with transactions",
+                "description": "Auth a user",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Wallet"
+                    "User"
                 ],
-                "summary": "Find wallet's by address, id or  summary by currencies",
+                "summary": "Auth user",
                 "responses": {
                     "200": {
-                        "description": "Successful operation",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-array_WalletWithTransactionsInfo"
+                            "$ref": "#/definitions/JSONResponse-GetUserInfoResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
                         }
                     },
                     "401": {
@@ -7220,23 +7716,27 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     }
                 }
-            }
-        },
-        "/v1/dv-admin/webhook/history": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This endpoint returns webhook history",
+                "description": "Update user",
                 "consumes": [
                     "application/json"
                 ],
@@ -7244,35 +7744,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "WebhookHistory"
+                    "User"
                 ],
-                "summary": "Get webhooks history by stores",
+                "summary": "Update user",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "name": "page_size",
-                        "in": "query"
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "name": "store_uuids",
-                        "in": "query"
+                        "description": "Update user info",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateUserRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successful operation",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-WhHistoryResponse"
+                            "$ref": "#/definitions/JSONResponse-GetUserInfoResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
                         }
                     },
                     "401": {
@@ -7281,8 +7777,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -7290,14 +7786,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/webhook/send-test": {
+        "/v1/dv-admin/user-setting/": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This endpoint emulates store webhook",
+                "description": "Create or update root settings",
                 "consumes": [
                     "application/json"
                 ],
@@ -7305,25 +7801,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "StoreWebhook"
+                    "Setting"
                 ],
-                "summary": "Emulate webhook to store",
+                "summary": "Create or update root settings",
                 "parameters": [
                     {
-                        "description": "TestWebhookRequest",
+                        "description": "Create or update user setting",
                         "name": "register",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/TestWebhookRequest"
+                            "$ref": "#/definitions/CreateSettingRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successful operation",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-SendTestResult"
+                            "$ref": "#/definitions/JSONResponse-string"
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-string"
                         }
                     },
                     "401": {
@@ -7332,8 +7834,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -7341,14 +7849,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/withdrawal-wallet/{walletID}/addresses": {
-            "patch": {
+        "/v1/dv-admin/user-setting/list": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This endpoint for update full addresses list",
+                "description": "List available user settings",
                 "consumes": [
                     "application/json"
                 ],
@@ -7356,38 +7864,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "WithdrawalWallet"
-                ],
-                "summary": "Update withdrawal wallet addresses",
-                "parameters": [
-                    {
-                        "description": "Update address",
-                        "name": "register",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/UpdateAddressesListRequest"
-                        }
-                    },
-                    {
-                        "type": "string",
-                        "description": "walletID",
-                        "name": "walletID",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Setting"
                 ],
+                "summary": "List available user settings",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-string"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad request",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
+                            "$ref": "#/definitions/JSONResponse-array_github_com_dv-net_dv-merchant_internal_service_setting_Dto"
                         }
                     },
                     "401": {
@@ -7395,15 +7879,34 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
-                    },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    }
+                }
+            }
+        },
+        "/v1/dv-admin/user-setting/{setting_name}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get setting value",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Setting"
+                ],
+                "summary": "Get setting value",
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/APIErrors"
+                            "$ref": "#/definitions/JSONResponse-SettingResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -7411,14 +7914,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/withdrawal/address-book": {
-            "get": {
+        "/v1/dv-admin/user/change-email": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all address book entries for the current user",
+                "description": "Change email",
                 "consumes": [
                     "application/json"
                 ],
@@ -7426,14 +7929,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Address Book"
+                    "User"
+                ],
+                "summary": "Change email",
+                "parameters": [
+                    {
+                        "description": "Confirm email",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ChangeEmailRequest"
+                        }
+                    }
                 ],
-                "summary": "Get user address book",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-AddressBookListResponse"
+                            "$ref": "#/definitions/JSONResponse-string"
                         }
                     },
                     "400": {
@@ -7448,21 +7962,23 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/v1/dv-admin/user/change-password": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new address book entry for the current user. Requires 2FA verification.",
+                "description": "Update user password",
                 "consumes": [
                     "application/json"
                 ],
@@ -7470,25 +7986,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Address Book"
+                    "User"
                 ],
-                "summary": "Create address book entry",
+                "summary": "Update user password",
                 "parameters": [
                     {
-                        "description": "Address book entry data with TOTP",
-                        "name": "request",
+                        "description": "Update user password",
+                        "name": "register",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_dv-net_dv-merchant_internal_delivery_http_request_withdrawal_requests.CreateAddressBookRequest"
+                            "$ref": "#/definitions/ChangePasswordInternalRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-AddressBookEntryResponse"
+                            "$ref": "#/definitions/JSONResponse-string"
                         }
                     },
                     "400": {
@@ -7508,22 +8024,18 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/dv-admin/user/confirm-email": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete address book entries based on type (individual, universal, or EVM). Requires 2FA verification.",
+                "description": "Auth a user",
                 "consumes": [
                     "application/json"
                 ],
@@ -7531,20 +8043,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Address Book"
-                ],
-                "summary": "Delete address book entries",
-                "parameters": [
-                    {
-                        "description": "Deletion request with type flags",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_dv-net_dv-merchant_internal_delivery_http_request_withdrawal_requests.DeleteAddressBookRequest"
-                        }
-                    }
+                    "User"
                 ],
+                "summary": "Auth user",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -7564,14 +8065,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "503": {
+                        "description": "Service Unavailable",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -7579,14 +8080,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/withdrawal/address-book/withdrawal-rule": {
+        "/v1/dv-admin/user/init-email-change": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Add withdrawal rules based on type (individual, universal, or EVM). Requires 2FA verification.",
+                "description": "Init email change",
                 "consumes": [
                     "application/json"
                 ],
@@ -7594,20 +8095,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Address Book"
-                ],
-                "summary": "Add withdrawal rules",
-                "parameters": [
-                    {
-                        "description": "Withdrawal rule request with type flags",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_dv-net_dv-merchant_internal_delivery_http_request_withdrawal_requests.AddWithdrawalRuleRequest"
-                        }
-                    }
+                    "User"
                 ],
+                "summary": "Init email change",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -7627,8 +8117,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -7636,14 +8126,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/withdrawal/address-book/{id}": {
-            "put": {
+        "/v1/dv-admin/user/init-email-confirmation": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update an existing address book entry. Requires 2FA verification.",
+                "description": "Init email confirnation",
                 "consumes": [
                     "application/json"
                 ],
@@ -7651,32 +8141,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Address Book"
-                ],
-                "summary": "Update address book entry",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Address book entry ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Update data with TOTP",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_dv-net_dv-merchant_internal_delivery_http_request_withdrawal_requests.UpdateAddressBookRequest"
-                        }
-                    }
+                    "User"
                 ],
+                "summary": "Init email confirnation",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-AddressBookEntryResponse"
+                            "$ref": "#/definitions/JSONResponse-string"
                         }
                     },
                     "400": {
@@ -7691,14 +8163,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -7706,29 +8172,32 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/withdrawal/rules": {
-            "get": {
+        "/v1/dv-admin/user/tg-link": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This endpoint returns withdrawal wallet's",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Generate telegram link",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "WithdrawalWallet"
+                    "User"
                 ],
-                "summary": "Get withdrawal wallets",
+                "summary": "Generate telegram link",
                 "responses": {
                     "200": {
-                        "description": "Successful operation",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-array_WithdrawalWalletWithAddress"
+                            "$ref": "#/definitions/JSONResponse-TgLinkResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
                         }
                     },
                     "401": {
@@ -7737,8 +8206,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -7746,50 +8215,36 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/withdrawal/withdraw-manual": {
+        "/v1/dv-admin/user/tg-unlink/confirm": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This endpoint for import manual withdraw",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Confirm telegram unlink",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Withdrawal"
-                ],
-                "summary": "Create manual withdraw by specific wallet",
-                "parameters": [
-                    {
-                        "description": "Manual withdraw",
-                        "name": "register",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/ManualWithdrawRequest"
-                        }
-                    }
+                    "User"
                 ],
+                "summary": "Confirm telegram unlink",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-array_string"
+                            "$ref": "#/definitions/JSONResponse-string"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -7799,60 +8254,40 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
                     }
                 }
             }
         },
-        "/v1/dv-admin/withdrawal/withdraw-multiple-manual": {
+        "/v1/dv-admin/user/tg-unlink/init": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This endpoint for import manual withdraw",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Init telegram unlink",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Withdrawal"
-                ],
-                "summary": "Create manual withdraw by specific wallet",
-                "parameters": [
-                    {
-                        "description": "Manual withdraw",
-                        "name": "register",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/ManualMultipleWithdrawRequest"
-                        }
-                    }
+                    "User"
                 ],
+                "summary": "Init telegram unlink",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-array_string"
+                            "$ref": "#/definitions/JSONResponse-string"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -7862,24 +8297,18 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
                     }
                 }
             }
         },
-        "/v1/dv-admin/withdrawal/withdraw-multiple-to-processing": {
+        "/v1/dv-admin/wallet": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This endpoint for import manual withdraw",
+                "description": "This endpoint returns wallet's with balance",
                 "consumes": [
                     "application/json"
                 ],
@@ -7887,41 +8316,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Withdrawal"
+                    "Wallet"
                 ],
-                "summary": "Create manual withdraw by specific wallet",
+                "summary": "Get wallet's with balance",
                 "parameters": [
                     {
-                        "description": "Withdraw to processing",
-                        "name": "register",
+                        "description": "GetWalletByStoreRequest",
+                        "name": "json",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/MultipleWithdrawalToProcessingRequest"
+                            "$ref": "#/definitions/GetWalletByStoreRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/JSONResponse-array_string"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad request",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    },
-                    "403": {
-                        "description": "Forbidden",
+                        "description": "Successful operation",
                         "schema": {
-                            "$ref": "#/definitions/APIErrors"
+                            "$ref": "#/definitions/JSONResponse-ResponseWithFullPagination-GetWalletBalanceResponse"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -7935,14 +8352,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/withdrawal/withdraw-to-processing": {
+        "/v1/dv-admin/wallet/addresses": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This endpoint for import manual withdraw",
+                "description": "Creates/returns wallet with addressed pre-generated.",
                 "consumes": [
                     "application/json"
                 ],
@@ -7950,17 +8367,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Withdrawal"
+                    "Wallet"
                 ],
-                "summary": "Create manual withdraw by specific wallet",
+                "summary": "Create/return wallet with addresses",
                 "parameters": [
                     {
-                        "description": "Withdraw to processing",
-                        "name": "register",
+                        "description": "CreateRequest",
+                        "name": "json",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/WithdrawalToProcessingRequest"
+                            "$ref": "#/definitions/CreateWalletRequest"
                         }
                     }
                 ],
@@ -7968,29 +8385,23 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-array_string"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad request",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
+                            "$ref": "#/definitions/JSONResponse-WalletShort"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "503": {
+                        "description": "Service Unavailable",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -7998,40 +8409,34 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/withdrawal/withdrawal-from-processing": {
+        "/v1/dv-admin/wallet/addresses/converter": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Initialize withdrawal from processing",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "This endpoint convert legacy address to new format",
                 "tags": [
-                    "Withdrawal"
+                    "Wallet"
                 ],
-                "summary": "Initialize withdrawal from processing",
+                "summary": "Convert wallet's address",
                 "parameters": [
                     {
-                        "description": "Init withdrawal",
-                        "name": "register",
+                        "description": "AddressConverterRequest",
+                        "name": "json",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/CreateProcessingWithdrawalInternalRequest"
+                            "$ref": "#/definitions/github_com_dv-net_dv-merchant_internal_delivery_http_request_wallet_request.AddressConverterRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Successful operation",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-ProcessingWithdrawalResponse"
+                            "$ref": "#/definitions/JSONResponse-ConvertedAddressResponse"
                         }
                     },
                     "401": {
@@ -8040,30 +8445,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    },
-                    "422": {
-                        "description": "Unprocessable Entity",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    },
-                    "423": {
-                        "description": "Locked",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -8073,14 +8454,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/dv-admin/withdrawal/{currencyID}/rules": {
-            "get": {
+        "/v1/dv-admin/wallet/addresses/dirty": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This endpoint returns withdrawal wallet",
+                "description": "Marks the given wallet address as dirty so it will not be used for new payments",
                 "consumes": [
                     "application/json"
                 ],
@@ -8088,46 +8469,50 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "WithdrawalWallet"
+                    "Wallet"
                 ],
-                "summary": "Get withdrawal wallet",
+                "summary": "Mark wallet address as dirty",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Currency ID",
-                        "name": "currencyID",
-                        "in": "path",
-                        "required": true
+                        "description": "MarkIsDirtyRequest",
+                        "name": "json",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/MarkIsDirtyRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successful operation",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-WithdrawalRulesByCurrencyResponse"
+                            "$ref": "#/definitions/JSONResponse-string"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/v1/dv-admin/wallet/addresses/keys": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This endpoint for update withdrawal wallet",
+                "description": "This endpoint returns private keys for each wallet's asset.",
                 "consumes": [
                     "application/json"
                 ],
@@ -8135,46 +8520,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "WithdrawalWallet"
+                    "Wallet"
                 ],
-                "summary": "Update withdrawal wallet",
+                "summary": "Get wallet's private/public keys.",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "walletID",
-                        "name": "walletID",
-                        "in": "path",
+                        "description": "TOTP auth code",
+                        "name": "totp",
+                        "in": "query",
                         "required": true
-                    },
-                    {
-                        "description": "Update withdrawal wallet",
-                        "name": "register",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/UpdateWithdrawalWalletsRequest"
-                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/JSONResponse-string"
-                        }
-                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "422": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -8184,36 +8548,48 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/exchange/:exchange_slug/deposit-update": {
+        "/v1/dv-admin/wallet/addresses/seeds": {
             "get": {
                 "security": [
                     {
-                        "Bearer": []
+                        "BearerAuth": []
                     }
                 ],
-                "description": "Update deposit addresses",
+                "description": "This endpoint returns wallet's seed phrases",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Exchange"
+                    "Wallet"
+                ],
+                "summary": "Get wallet's seed phrase",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "TOTP auth code",
+                        "name": "totp",
+                        "in": "query",
+                        "required": true
+                    }
                 ],
-                "summary": "Update deposit addresses",
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Successful operation",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-array_DepositUpdateResponse"
+                            "$ref": "#/definitions/JSONResponse-WalletSeedResponse"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "503": {
-                        "description": "Service Unavailable",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -8221,96 +8597,73 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/exchange/:exchange_slug/withdrawal-setting": {
+        "/v1/dv-admin/wallet/balances/cold": {
             "get": {
                 "security": [
                     {
-                        "Bearer": []
+                        "BearerAuth": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get cold wallet's addresses total balance",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Exchange"
-                ],
-                "summary": "Get all withdrawal settings",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Exchange slug",
-                        "name": "exchange_slug",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Wallet"
                 ],
+                "summary": "Get cold wallet's addresses total balance",
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Successful operation",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-array_ExchangeWithdrawalSettingResponse"
+                            "$ref": "#/definitions/JSONResponse-WalletAddressTotalUSDResponse"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "503": {
-                        "description": "Service Unavailable",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/v1/dv-admin/wallet/balances/exchange-settings": {
+            "get": {
                 "security": [
                     {
-                        "Bearer": []
+                        "BearerAuth": []
                     }
                 ],
-                "description": "Creates withdrawal setting with minimum value in USD to withdrawal from exchange",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get withdrawal wallet's addresses total balance",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Exchange"
-                ],
-                "summary": "Create withdrawal setting",
-                "parameters": [
-                    {
-                        "description": "Create withdrawal",
-                        "name": "withdrawal",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/ExchangeCreateWithdrawalRequest"
-                        }
-                    }
+                    "Wallet"
                 ],
+                "summary": "Get withdrawal wallet's addresses total balance",
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Successful operation",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-string"
+                            "$ref": "#/definitions/JSONResponse-WalletAddressTotalUSDResponse"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "503": {
-                        "description": "Service Unavailable",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -8318,36 +8671,36 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/exchange/deposit-addresses": {
+        "/v1/dv-admin/wallet/balances/hot": {
             "get": {
                 "security": [
                     {
-                        "Bearer": []
+                        "BearerAuth": []
                     }
                 ],
-                "description": "Get exchange deposit address",
+                "description": "Get hot wallet's addresses total balance",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Exchange"
+                    "Wallet"
                 ],
-                "summary": "Get exchange deposit address",
+                "summary": "Get hot wallet's addresses total balance",
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Successful operation",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-array_GetDepositAddressesResponse"
+                            "$ref": "#/definitions/JSONResponse-WalletAddressTotalUSDResponse"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "503": {
-                        "description": "Service Unavailable",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -8355,36 +8708,36 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/exchange/exchange-history": {
+        "/v1/dv-admin/wallet/info/{searchParam}": {
             "get": {
                 "security": [
                     {
-                        "Bearer": []
+                        "BearerAuth": []
                     }
                 ],
-                "description": "Get user exchange orders history",
+                "description": "This endpoint returns wallet's data group by blockchains  **Deprecated**: Use ` + "`" + `/v1/dv-admin/search/:searchParam instead",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Exchange"
+                    "Wallet"
                 ],
-                "summary": "Get user exchange orders history",
+                "summary": "Find wallet's and get info grouped by blockchain",
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Successful operation",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-ResponseWithFullPagination-ExchangeOrderHistoryResponse"
+                            "$ref": "#/definitions/JSONResponse-WalletWithBlockchains"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "503": {
-                        "description": "Service Unavailable",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -8392,70 +8745,41 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/exchange/exchange-history/export": {
-            "get": {
+        "/v1/dv-admin/wallet/keys/hot": {
+            "post": {
                 "security": [
                     {
-                        "Bearer": []
+                        "BearerAuth": []
                     }
                 ],
-                "description": "Download user exchange orders history",
+                "description": "Get hot wallet's keys",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Exchange"
+                    "Wallet"
                 ],
-                "summary": "Download user exchange orders history",
+                "summary": "Get hot wallet's keys",
                 "parameters": [
                     {
-                        "type": "string",
-                        "name": "date_from",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "date_to",
-                        "in": "query"
+                        "description": "GetHotWalletKeysRequest",
+                        "name": "json",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/GetHotWalletKeysRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
                     },
-                    {
-                        "enum": [
-                            "csv",
-                            "xlsx"
-                        ],
-                        "type": "string",
-                        "name": "format",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "minimum": 1,
-                        "type": "integer",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "maximum": 100,
-                        "minimum": 1,
-                        "type": "integer",
-                        "name": "page_size",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "slug",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "422": {
-                        "description": "Unprocessable Entity",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    },
-                    "503": {
-                        "description": "Service Unavailable",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -8463,47 +8787,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/exchange/withdrawal": {
-            "get": {
+        "/v1/dv-admin/wallet/restore/{walletId}": {
+            "post": {
                 "security": [
                     {
-                        "Bearer": []
+                        "BearerAuth": []
                     }
                 ],
-                "description": "Get withdrawals",
-                "produces": [
-                    "application/json"
-                ],
+                "description": "This endpoint returns wallet's data group by blockchains",
                 "tags": [
-                    "Exchange"
-                ],
-                "summary": "Get withdrawals",
-                "parameters": [
-                    {
-                        "description": "Get withdrawal request",
-                        "name": "search",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/ExchangeGetWithdrawalsRequest"
-                        }
-                    }
+                    "Wallet"
                 ],
+                "summary": "Restore missed wallet transaction",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-ResponseWithFullPagination-ExchangeWithdrawalHistoryResponse"
+                            "$ref": "#/definitions/JSONResponse-string"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "503": {
-                        "description": "Service Unavailable",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -8511,41 +8821,43 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/exchange/withdrawal/export": {
+        "/v1/dv-admin/wallet/summary": {
             "get": {
                 "security": [
                     {
-                        "Bearer": []
+                        "BearerAuth": []
                     }
                 ],
-                "description": "Download withdrawals",
+                "description": "This endpoint returns wallet's summary stats grouped by currencies",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Exchange"
+                    "Wallet"
                 ],
-                "summary": "Download withdrawals",
+                "summary": "Get wallet's summary by currencies",
                 "parameters": [
                     {
-                        "description": "Get withdrawal exported request",
-                        "name": "search",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/ExchangeGetWithdrawalsExportedRequest"
-                        }
+                        "type": "number",
+                        "name": "min_balance",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "200": {
+                        "description": "Successful operation",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-array_SummaryDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "503": {
-                        "description": "Service Unavailable",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -8553,31 +8865,36 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/exchange/{exchange_slug}/chains": {
+        "/v1/dv-admin/wallet/{searchParam}": {
             "get": {
-                "description": "Get exchange chains",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This endpoint returns wallet's data with transactions",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Exchange"
+                    "Wallet"
                 ],
-                "summary": "Get exchange chains",
+                "summary": "Find wallet's by address, id or  summary by currencies",
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Successful operation",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-array_ExchangeChain"
+                            "$ref": "#/definitions/JSONResponse-array_WalletWithTransactionsInfo"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "503": {
-                        "description": "Service Unavailable",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -8585,36 +8902,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/exchange/{exchange_slug}/pairs": {
+        "/v1/dv-admin/webhook/events": {
             "get": {
                 "security": [
                     {
-                        "Bearer": []
+                        "BearerAuth": []
                     }
                 ],
-                "description": "Get exchange pairs",
+                "description": "This endpoint returns events store webhooks may subscribe to and supported payload schema versions",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Exchange"
+                    "StoreWebhook"
                 ],
-                "summary": "Get exchange pairs",
+                "summary": "Get webhook event catalogue",
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/JSONResponse-array_github_com_dv-net_dv-merchant_internal_models_ExchangeSymbolDTO"
-                        }
-                    },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                        "description": "Successful operation",
                         "schema": {
-                            "$ref": "#/definitions/APIErrors"
+                            "$ref": "#/definitions/JSONResponse-WebhookEventCatalogueResponse"
                         }
                     },
-                    "503": {
-                        "description": "Service Unavailable",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -8622,54 +8936,60 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/exchange/{exchange_slug}/toggle-swaps": {
-            "post": {
+        "/v1/dv-admin/webhook/history": {
+            "get": {
                 "security": [
                     {
-                        "Bearer": []
+                        "BearerAuth": []
                     }
                 ],
-                "description": "Toggle exchange swaps",
+                "description": "This endpoint returns webhook history",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Exchange"
+                    "WebhookHistory"
                 ],
-                "summary": "Toggle exchange swaps",
+                "summary": "Get webhooks history by stores",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Exchange slug",
-                        "name": "exchange_slug",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
                     },
                     {
-                        "description": "Toggle swaps request",
-                        "name": "state",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/ExchangeToggleSwapsRequest"
-                        }
+                        "type": "integer",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "store_uuids",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Successful operation",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-string"
+                            "$ref": "#/definitions/JSONResponse-WhHistoryResponse"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "503": {
-                        "description": "Service Unavailable",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -8677,54 +8997,50 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/exchange/{exchange_slug}/toggle-withdrawals": {
+        "/v1/dv-admin/webhook/send-test": {
             "post": {
                 "security": [
                     {
-                        "Bearer": []
+                        "BearerAuth": []
                     }
                 ],
-                "description": "Toggle exchange withdrawals",
+                "description": "This endpoint emulates store webhook",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Exchange"
+                    "StoreWebhook"
                 ],
-                "summary": "Toggle exchange withdrawals",
+                "summary": "Emulate webhook to store",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Exchange slug",
-                        "name": "exchange_slug",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Toggle withdrawals request",
-                        "name": "state",
+                        "description": "TestWebhookRequest",
+                        "name": "register",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/ExchangeToggleWithdrawalsRequest"
+                            "$ref": "#/definitions/TestWebhookRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Successful operation",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-string"
+                            "$ref": "#/definitions/JSONResponse-SendTestResult"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "503": {
-                        "description": "Service Unavailable",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -8732,36 +9048,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/exchange/{exchange_slug}/withdrawal-rules": {
+        "/v1/dv-admin/webhook/source-ips": {
             "get": {
                 "security": [
                     {
-                        "Bearer": []
+                        "BearerAuth": []
                     }
                 ],
-                "description": "Get exchange withdrawal rules for all enabled currencies",
+                "description": "This endpoint returns IP addresses store webhooks are sent from, so they may be allowlisted",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Exchange"
+                    "StoreWebhook"
                 ],
-                "summary": "Get exchange withdrawal rules",
+                "summary": "Get webhook source IPs",
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/JSONResponse-array_array_github_com_dv-net_dv-merchant_internal_delivery_http_responses_exchange_response_ExchangeWithdrawalRulesResponse"
-                        }
-                    },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                        "description": "Successful operation",
                         "schema": {
-                            "$ref": "#/definitions/APIErrors"
+                            "$ref": "#/definitions/JSONResponse-WebhookSourceIPsResponse"
                         }
                     },
-                    "503": {
-                        "description": "Service Unavailable",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -8769,13 +9082,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/exchange/{exchange_slug}/withdrawal-setting/{id}": {
-            "get": {
+        "/v1/dv-admin/withdrawal-wallet/{walletID}/addresses": {
+            "patch": {
                 "security": [
                     {
-                        "Bearer": []
+                        "BearerAuth": []
                     }
                 ],
+                "description": "This endpoint for update full addresses list",
                 "consumes": [
                     "application/json"
                 ],
@@ -8783,21 +9097,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Exchange"
+                    "WithdrawalWallet"
                 ],
-                "summary": "Get all withdrawal setting by id",
+                "summary": "Update withdrawal wallet addresses",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Exchange slug",
-                        "name": "exchange_slug",
-                        "in": "path",
-                        "required": true
+                        "description": "Update address",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateAddressesListRequest"
+                        }
                     },
                     {
                         "type": "string",
-                        "description": "Setting UUID",
-                        "name": "id",
+                        "description": "walletID",
+                        "name": "walletID",
                         "in": "path",
                         "required": true
                     }
@@ -8806,7 +9122,19 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-ExchangeWithdrawalSettingResponse"
+                            "$ref": "#/definitions/JSONResponse-string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
                         }
                     },
                     "422": {
@@ -8815,20 +9143,23 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "503": {
-                        "description": "Service Unavailable",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/dv-admin/withdrawal/address-book": {
+            "get": {
                 "security": [
                     {
-                        "Bearer": []
+                        "BearerAuth": []
                     }
                 ],
+                "description": "Get all address book entries for the current user",
                 "consumes": [
                     "application/json"
                 ],
@@ -8836,52 +9167,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Exchange"
-                ],
-                "summary": "Delete withdrawal setting by id",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Exchange slug",
-                        "name": "exchange_slug",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Setting UUID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Address Book"
                 ],
+                "summary": "Get user address book",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-string"
+                            "$ref": "#/definitions/JSONResponse-AddressBookListResponse"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "503": {
-                        "description": "Service Unavailable",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     }
                 }
             },
-            "patch": {
+            "post": {
                 "security": [
                     {
-                        "Bearer": []
+                        "BearerAuth": []
                     }
                 ],
+                "description": "Create a new address book entry for the current user. Requires 2FA verification.",
                 "consumes": [
                     "application/json"
                 ],
@@ -8889,39 +9211,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Exchange"
+                    "Address Book"
                 ],
-                "summary": "Update withdrawal setting by id",
+                "summary": "Create address book entry",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Exchange slug",
-                        "name": "exchange_slug",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Setting UUID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Update exchange settings",
-                        "name": "update_keys",
+                        "description": "Address book entry data with TOTP",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/UpdateWithdrawalSetting"
+                            "$ref": "#/definitions/github_com_dv-net_dv-merchant_internal_delivery_http_request_withdrawal_requests.CreateAddressBookRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-ExchangeWithdrawalSettingResponse"
+                            "$ref": "#/definitions/JSONResponse-AddressBookEntryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
                         }
                     },
                     "422": {
@@ -8930,56 +9250,69 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "503": {
-                        "description": "Service Unavailable",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     }
                 }
-            }
-        },
-        "/v1/exchange/{exchange_slug}/withdrawal/{id}": {
-            "get": {
-                "description": "Get withdrawal by ID",
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete address book entries based on type (individual, universal, or EVM). Requires 2FA verification.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Exchange"
+                    "Address Book"
                 ],
-                "summary": "Get withdrawal by ID",
+                "summary": "Delete address book entries",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Withdrawal UUID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Exchange slug",
-                        "name": "exchange_slug",
-                        "in": "path",
-                        "required": true
+                        "description": "Deletion request with type flags",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_dv-net_dv-merchant_internal_delivery_http_request_withdrawal_requests.DeleteAddressBookRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-ExchangeWithdrawalHistoryResponse"
+                            "$ref": "#/definitions/JSONResponse-string"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "503": {
-                        "description": "Service Unavailable",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -8987,14 +9320,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/external/exchange-balances": {
-            "get": {
+        "/v1/dv-admin/withdrawal/address-book/withdrawal-rule": {
+            "post": {
                 "security": [
                     {
-                        "XApiKey": []
+                        "BearerAuth": []
                     }
                 ],
-                "description": "Get external exchange balances",
+                "description": "Add withdrawal rules based on type (individual, universal, or EVM). Requires 2FA verification.",
                 "consumes": [
                     "application/json"
                 ],
@@ -9002,22 +9335,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Exchange"
+                    "Address Book"
                 ],
-                "summary": "Get external exchange balances",
+                "summary": "Add withdrawal rules",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Store API key",
-                        "name": "api_key",
-                        "in": "query"
+                        "description": "Withdrawal rule request with type flags",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_dv-net_dv-merchant_internal_delivery_http_request_withdrawal_requests.AddWithdrawalRuleRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-ExternalExchangeBalanceResponse"
+                            "$ref": "#/definitions/JSONResponse-string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
                         }
                     },
                     "401": {
@@ -9026,8 +9368,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -9035,14 +9377,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/external/processing-wallet-balances": {
-            "get": {
+        "/v1/dv-admin/withdrawal/address-book/{id}": {
+            "put": {
                 "security": [
                     {
-                        "XApiKey": []
+                        "BearerAuth": []
                     }
                 ],
-                "description": "Get external processing wallet balances",
+                "description": "Update an existing address book entry. Requires 2FA verification.",
                 "consumes": [
                     "application/json"
                 ],
@@ -9050,53 +9392,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Withdrawal"
+                    "Address Book"
                 ],
-                "summary": "Get external processing wallet balances",
+                "summary": "Update address book entry",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Store API key",
-                        "name": "api_key",
-                        "in": "query"
-                    },
-                    {
-                        "uniqueItems": true,
-                        "type": "array",
-                        "items": {
-                            "enum": [
-                                "arbitrum",
-                                "bitcoin",
-                                "bitcoincash",
-                                "bsc",
-                                "dogecoin",
-                                "ethereum",
-                                "litecoin",
-                                "polygon",
-                                "tron"
-                            ],
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "name": "blockchains",
-                        "in": "query"
+                        "description": "Address book entry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "uniqueItems": true,
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "name": "currencies",
-                        "in": "query"
+                        "description": "Update data with TOTP",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_dv-net_dv-merchant_internal_delivery_http_request_withdrawal_requests.UpdateAddressBookRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-ExternalProcessingWalletBalanceResponse"
+                            "$ref": "#/definitions/JSONResponse-AddressBookEntryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
                         }
                     },
                     "401": {
@@ -9105,6 +9432,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -9114,34 +9447,29 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/external/store/currencies": {
+        "/v1/dv-admin/withdrawal/approval-rules": {
             "get": {
                 "security": [
                     {
-                        "XApiKey": []
+                        "BearerAuth": []
                     }
                 ],
-                "description": "Get store currencies list",
+                "description": "Load rules holding withdrawals until approved, available for root",
                 "consumes": [
                     "application/json"
                 ],
-                "tags": [
-                    "Store"
+                "produces": [
+                    "application/json"
                 ],
-                "summary": "Get store currencies list",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Store API key",
-                        "name": "api_key",
-                        "in": "query"
-                    }
+                "tags": [
+                    "Withdrawal approval"
                 ],
+                "summary": "Load withdrawal approval rules",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-array_GetCurrencyResponse"
+                            "$ref": "#/definitions/JSONResponse-array_WithdrawalApprovalRuleResponse"
                         }
                     },
                     "401": {
@@ -9150,43 +9478,53 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     }
                 }
-            }
-        },
-        "/v1/external/store/currencies-extended": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
-                        "XApiKey": []
+                        "BearerAuth": []
                     }
                 ],
-                "description": "Get extended list of store currencies grouped by tokens and blockchains",
+                "description": "Require approvals for withdrawals matching currency, destination and amount in USD, empty conditions match any withdrawal",
                 "consumes": [
                     "application/json"
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Store"
+                    "Withdrawal approval"
                 ],
-                "summary": "Get extended list of store currencies",
+                "summary": "Create withdrawal approval rule",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Store API key",
-                        "name": "api_key",
-                        "in": "query"
+                        "description": "Approval rule",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateWithdrawalApprovalRuleRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-CurrenciesExtendedResponse"
+                            "$ref": "#/definitions/JSONResponse-WithdrawalApprovalRuleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
                         }
                     },
                     "401": {
@@ -9195,8 +9533,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -9204,41 +9542,38 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/external/store/currencies/{id}/rate": {
-            "get": {
+        "/v1/dv-admin/withdrawal/approval-rules/{ruleId}": {
+            "delete": {
                 "security": [
                     {
-                        "XApiKey": []
+                        "BearerAuth": []
                     }
                 ],
-                "description": "Get store currency rate",
+                "description": "Delete withdrawal approval rule, already requested approvals are kept",
                 "consumes": [
                     "application/json"
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Store"
+                    "Withdrawal approval"
                 ],
-                "summary": "Get store currency rate",
+                "summary": "Delete withdrawal approval rule",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Currency ID",
-                        "name": "id",
+                        "description": "Rule ID",
+                        "name": "ruleId",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Store API key",
-                        "name": "api_key",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-CurrencyRate"
+                            "$ref": "#/definitions/JSONResponse-string"
                         }
                     },
                     "401": {
@@ -9247,8 +9582,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -9256,14 +9597,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/external/transactions/unconfirmed/transfer": {
+        "/v1/dv-admin/withdrawal/approvals": {
             "get": {
                 "security": [
                     {
-                        "XApiKey": []
+                        "BearerAuth": []
                     }
                 ],
-                "description": "Get unconfirmed transfer transactions",
+                "description": "Load withdrawals held for approval, available for root and finance manager",
                 "consumes": [
                     "application/json"
                 ],
@@ -9271,14 +9612,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Transactions"
+                    "Withdrawal approval"
                 ],
-                "summary": "Get unconfirmed transfer transactions",
+                "summary": "Load withdrawal approvals",
                 "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "enum": [
+                                "pending",
+                                "approved",
+                                "executed",
+                                "failed",
+                                "rejected",
+                                "expired"
+                            ],
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "statuses",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "Store API key",
-                        "name": "api_key",
+                        "name": "user_id",
                         "in": "query"
                     }
                 ],
@@ -9286,7 +9656,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-UnconfirmedTransactionResponse"
+                            "$ref": "#/definitions/JSONResponse-ResponseWithFullPagination-WithdrawalApprovalResponse"
                         }
                     },
                     "401": {
@@ -9295,8 +9665,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -9304,14 +9674,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/external/wallet": {
+        "/v1/dv-admin/withdrawal/approvals/{approvalId}": {
             "get": {
                 "security": [
                     {
-                        "XApiKey": []
+                        "BearerAuth": []
                     }
                 ],
-                "description": "Create wallet with address",
+                "description": "Load withdrawal approval with decisions of approvers, available for root and finance manager",
                 "consumes": [
                     "application/json"
                 ],
@@ -9319,48 +9689,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Store"
+                    "Withdrawal approval"
                 ],
-                "summary": "Create wallet with address",
+                "summary": "Load withdrawal approval",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Store API key",
-                        "name": "api_key",
-                        "in": "query"
-                    },
-                    {
-                        "type": "number",
-                        "name": "amount",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "currency",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "format": "email",
-                        "name": "email",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "format": "ipv4",
-                        "name": "ip",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "locale",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "format": "uuid",
-                        "name": "store_external_id",
-                        "in": "query",
+                        "description": "Approval ID",
+                        "name": "approvalId",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -9368,7 +9705,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-CreateWalletExternalResponse"
+                            "$ref": "#/definitions/JSONResponse-WithdrawalApprovalWithDecisionsResponse"
                         }
                     },
                     "401": {
@@ -9377,21 +9714,29 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/v1/dv-admin/withdrawal/approvals/{approvalId}/approve": {
             "post": {
                 "security": [
                     {
-                        "XApiKey": []
+                        "BearerAuth": []
                     }
                 ],
-                "description": "Create wallet with address",
+                "description": "Sign withdrawal with two-factor code, the last required signature executes it",
                 "consumes": [
                     "application/json"
                 ],
@@ -9399,23 +9744,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Wallet"
+                    "Withdrawal approval"
                 ],
-                "summary": "Create wallet with address",
+                "summary": "Approve withdrawal",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Store API key",
-                        "name": "api_key",
-                        "in": "query"
+                        "description": "Approval ID",
+                        "name": "approvalId",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "description": "Create wallet",
+                        "description": "Decision",
                         "name": "register",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/CreateWalletExternalRequest"
+                            "$ref": "#/definitions/WithdrawalApprovalDecisionRequest"
                         }
                     }
                 ],
@@ -9423,7 +9769,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-CreateWalletExternalResponse"
+                            "$ref": "#/definitions/JSONResponse-WithdrawalApprovalResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
                         }
                     },
                     "401": {
@@ -9432,8 +9784,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -9441,14 +9805,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/external/wallet/addresses/dirty": {
+        "/v1/dv-admin/withdrawal/approvals/{approvalId}/reject": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Marks the given wallet address as dirty so it will not be used for new payments",
+                "description": "Reject withdrawal with two-factor code, a single rejection cancels it",
                 "consumes": [
                     "application/json"
                 ],
@@ -9456,17 +9820,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Wallet"
+                    "Withdrawal approval"
                 ],
-                "summary": "Mark wallet address as dirty",
+                "summary": "Reject withdrawal",
                 "parameters": [
                     {
-                        "description": "MarkIsDirtyRequest",
-                        "name": "json",
+                        "type": "string",
+                        "description": "Approval ID",
+                        "name": "approvalId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Decision",
+                        "name": "register",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/MarkIsDirtyRequest"
+                            "$ref": "#/definitions/WithdrawalApprovalDecisionRequest"
                         }
                     }
                 ],
@@ -9474,7 +9845,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-string"
+                            "$ref": "#/definitions/JSONResponse-WithdrawalApprovalResponse"
                         }
                     },
                     "400": {
@@ -9488,43 +9859,51 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
                     }
                 }
             }
         },
-        "/v1/external/wallet/balance/hot": {
+        "/v1/dv-admin/withdrawal/limits": {
             "get": {
                 "security": [
                     {
-                        "XApiKey": []
+                        "BearerAuth": []
                     }
                 ],
-                "description": "Get external hot wallet balances",
+                "description": "Load rolling window limits of amount in USD and count applied to manual, API and scheduled withdrawals, sweeps to the processing wallet are not limited",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Store"
-                ],
-                "summary": "Get external hot wallet balances",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Store API key",
-                        "name": "api_key",
-                        "in": "query"
-                    },
-                    {
-                        "type": "number",
-                        "name": "min_balance",
-                        "in": "query"
-                    }
+                    "Withdrawal limit"
                 ],
+                "summary": "Load withdrawal limits",
                 "responses": {
                     "200": {
-                        "description": "Successful operation",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-array_SummaryDTO"
+                            "$ref": "#/definitions/JSONResponse-array_WithdrawalLimitResponse"
                         }
                     },
                     "401": {
@@ -9532,24 +9911,16 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
                     }
                 }
-            }
-        },
-        "/v1/external/withdrawal-from-processing": {
+            },
             "post": {
                 "security": [
                     {
-                        "XApiKey": []
+                        "BearerAuth": []
                     }
                 ],
-                "description": "Initialize withdrawal from processing",
+                "description": "Limit amount in USD and count of withdrawals within rolling window per user, store, currency or destination, empty scope value limits each of them separately",
                 "consumes": [
                     "application/json"
                 ],
@@ -9557,24 +9928,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Withdrawal"
+                    "Withdrawal limit"
                 ],
-                "summary": "Initialize withdrawal from processing",
+                "summary": "Create withdrawal limit",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Store API key",
-                        "name": "api_key",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "description": "Init withdrawal",
+                        "description": "Withdrawal limit",
                         "name": "register",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/CreateProcessingWithdrawRequest"
+                            "$ref": "#/definitions/CreateWithdrawalLimitRequest"
                         }
                     }
                 ],
@@ -9582,23 +9946,23 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-ProcessingWithdrawalResponse"
+                            "$ref": "#/definitions/JSONResponse-WithdrawalLimitResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -9608,58 +9972,51 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
-                    },
-                    "423": {
-                        "description": "Locked",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
                     }
                 }
             }
         },
-        "/v1/external/withdrawal-from-processing/{id}": {
-            "get": {
+        "/v1/dv-admin/withdrawal/limits/{limitId}": {
+            "delete": {
                 "security": [
                     {
-                        "XApiKey": []
+                        "BearerAuth": []
                     }
                 ],
-                "description": "Get withdrawal from processing",
+                "description": "Delete withdrawal limit, confirmed with two-factor code",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Withdrawal"
+                    "Withdrawal limit"
                 ],
-                "summary": "Get withdrawal from processing",
+                "summary": "Delete withdrawal limit",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Store API key",
-                        "name": "api_key",
-                        "in": "query",
+                        "description": "Limit ID",
+                        "name": "limitId",
+                        "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Withdrawal ID or Request ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Confirmation",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/DeleteWithdrawalLimitRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-WithdrawalFromProcessingDto"
+                            "$ref": "#/definitions/JSONResponse-string"
                         }
                     },
                     "400": {
@@ -9679,21 +10036,37 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
-                    },
-                    "406": {
-                        "description": "Not Acceptable",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    },
-                    "409": {
-                        "description": "Conflict",
+                    }
+                }
+            }
+        },
+        "/v1/dv-admin/withdrawal/rules": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This endpoint returns withdrawal wallet's",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "WithdrawalWallet"
+                ],
+                "summary": "Get withdrawal wallets",
+                "responses": {
+                    "200": {
+                        "description": "Successful operation",
                         "schema": {
-                            "$ref": "#/definitions/APIErrors"
+                            "$ref": "#/definitions/JSONResponse-array_WithdrawalWalletWithAddress"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -9705,32 +10078,31 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/dv-admin/withdrawal/schedules/{currencyId}": {
+            "get": {
                 "security": [
                     {
-                        "XApiKey": []
+                        "BearerAuth": []
                     }
                 ],
-                "description": "Delete withdrawal from processing",
+                "description": "Load cron and threshold schedule of automatic withdrawals from hot wallets of the currency",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Withdrawal"
+                    "Withdrawal schedule"
                 ],
-                "summary": "Delete withdrawal from processing",
+                "summary": "Load withdrawal schedule",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Store API key",
-                        "name": "api_key",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Withdrawal ID",
-                        "name": "id",
+                        "description": "Currency ID",
+                        "name": "currencyId",
                         "in": "path",
                         "required": true
                     }
@@ -9739,11 +10111,11 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-string"
+                            "$ref": "#/definitions/JSONResponse-WithdrawalScheduleResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -9753,30 +10125,16 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
-                    },
-                    "422": {
-                        "description": "Unprocessable Entity",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
                     }
                 }
-            }
-        },
-        "/v1/public/currencies": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all Currencies",
+                "description": "Sweep hot wallets of the currency by cron expression in the time zone, when the balance exceeds the threshold in USD or while the gas price is below the threshold, keeping the float in USD on hot wallets. Triggers are combined, confirmed with two-factor code",
                 "consumes": [
                     "application/json"
                 ],
@@ -9784,57 +10142,44 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Currency"
+                    "Withdrawal schedule"
                 ],
-                "summary": "Get all Currencies",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/JSONResponse-array_GetCurrencyResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    }
-                }
-            }
-        },
-        "/v1/public/currencies/rate/{source}": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Get Currency rate",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Currency"
-                ],
-                "summary": "Get Currency rate",
+                "summary": "Set withdrawal schedule",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Currency Source example binance",
-                        "name": "source",
+                        "description": "Currency ID",
+                        "name": "currencyId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Withdrawal schedule",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/SetWithdrawalScheduleRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-array_ExchangeRate"
+                            "$ref": "#/definitions/JSONResponse-WithdrawalScheduleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
                         }
                     },
                     "404": {
@@ -9842,18 +10187,22 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
                     }
                 }
-            }
-        },
-        "/v1/public/currencies/{id}": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get Currency by ID",
+                "description": "Delete withdrawal schedule, hot wallets are swept on every iteration again, confirmed with two-factor code",
                 "consumes": [
                     "application/json"
                 ],
@@ -9861,33 +10210,48 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Currency"
+                    "Withdrawal schedule"
                 ],
-                "summary": "Get Currency by ID",
+                "summary": "Delete withdrawal schedule",
                 "parameters": [
                     {
-                        "type": "integer",
+                        "type": "string",
                         "description": "Currency ID",
-                        "name": "id",
+                        "name": "currencyId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Confirmation",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/DeleteWithdrawalScheduleRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-GetCurrencyResponse"
+                            "$ref": "#/definitions/JSONResponse-string"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "503": {
-                        "description": "Service Unavailable",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -9895,9 +10259,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/public/mnemonic/generate": {
-            "get": {
-                "description": "Generate random mnemonic",
+        "/v1/dv-admin/withdrawal/withdraw-manual": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This endpoint for import manual withdraw",
                 "consumes": [
                     "application/json"
                 ],
@@ -9905,32 +10274,74 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Mnemonic",
-                    "Public"
+                    "Withdrawal"
                 ],
-                "summary": "Generate random mnemonic",
+                "summary": "Create manual withdraw by specific wallet",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Length of mnemonic, 12 or 24 words",
-                        "name": "length",
-                        "in": "query",
-                        "required": true
+                        "description": "Manual withdraw",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ManualWithdrawRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-github_com_dv-net_dv-merchant_internal_delivery_http_responses_mnemonic_response_MnemonicResponse"
+                            "$ref": "#/definitions/JSONResponse-array_string"
+                        }
+                    },
+                    "202": {
+                        "description": "Held for approval",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-WithdrawalApprovalResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "429": {
+                        "description": "Withdrawal limit exceeded",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
                         }
                     }
                 }
             }
         },
-        "/v1/public/store/topup/{store_id}/{external_id}": {
-            "get": {
-                "description": "Get wallet full data",
+        "/v1/dv-admin/withdrawal/withdraw-multiple-manual": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This endpoint for import manual withdraw",
                 "consumes": [
                     "application/json"
                 ],
@@ -9938,58 +10349,53 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Wallet",
-                    "Public"
+                    "Withdrawal"
                 ],
-                "summary": "Get top up data by store",
+                "summary": "Create manual withdraw by specific wallet",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Slug of the shop",
-                        "name": "slug",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "External client ID",
-                        "name": "external_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "format": "email",
-                        "name": "email",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "format": "ipv4",
-                        "name": "ip",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "locale",
-                        "in": "query"
+                        "description": "Manual withdraw",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ManualMultipleWithdrawRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-PublicGetWalletResponse"
+                            "$ref": "#/definitions/JSONResponse-array_string"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "202": {
+                        "description": "Held for approval",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-WithdrawalApprovalResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "410": {
-                        "description": "Gone",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "429": {
+                        "description": "Withdrawal limit exceeded",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -10003,9 +10409,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/public/user/accept-invite": {
+        "/v1/dv-admin/withdrawal/withdraw-multiple-to-processing": {
             "post": {
-                "description": "Accept admin invite link",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This endpoint for import manual withdraw",
                 "consumes": [
                     "application/json"
                 ],
@@ -10013,18 +10424,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User",
-                    "Public"
+                    "Withdrawal"
                 ],
-                "summary": "Accept admin invite link",
+                "summary": "Create manual withdraw by specific wallet",
                 "parameters": [
                     {
-                        "description": "Invite request",
-                        "name": "inviteRequest",
+                        "description": "Withdraw to processing",
+                        "name": "register",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/PublicAcceptInviteRequest"
+                            "$ref": "#/definitions/MultipleWithdrawalToProcessingRequest"
                         }
                     }
                 ],
@@ -10032,50 +10442,44 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-string"
+                            "$ref": "#/definitions/JSONResponse-array_string"
                         }
-                    }
-                }
-            }
-        },
-        "/v1/public/user/forgot-password": {
-            "post": {
-                "description": "Request password reset link by email",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "User",
-                    "Public"
-                ],
-                "summary": "Request password reset link by email",
-                "parameters": [
-                    {
-                        "description": "User email",
-                        "name": "email",
-                        "in": "body",
-                        "required": true,
+                    },
+                    "400": {
+                        "description": "Bad request",
                         "schema": {
-                            "$ref": "#/definitions/PublicUserForgotPasswordRequest"
+                            "$ref": "#/definitions/APIErrors"
                         }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-string"
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
                         }
                     }
                 }
             }
         },
-        "/v1/public/user/reset-password": {
+        "/v1/dv-admin/withdrawal/withdraw-to-processing": {
             "post": {
-                "description": "Reset user password",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This endpoint for import manual withdraw",
                 "consumes": [
                     "application/json"
                 ],
@@ -10083,18 +10487,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User",
-                    "Public"
+                    "Withdrawal"
                 ],
-                "summary": "Reset user password",
+                "summary": "Create manual withdraw by specific wallet",
                 "parameters": [
                     {
-                        "description": "Public reset password request",
-                        "name": "resetPassword",
+                        "description": "Withdraw to processing",
+                        "name": "register",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/PublicResetPasswordRequest"
+                            "$ref": "#/definitions/WithdrawalToProcessingRequest"
                         }
                     }
                 ],
@@ -10102,15 +10505,44 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-string"
+                            "$ref": "#/definitions/JSONResponse-array_string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
                         }
                     }
                 }
             }
         },
-        "/v1/public/wallet/{id}": {
-            "get": {
-                "description": "Get wallet full data",
+        "/v1/dv-admin/withdrawal/withdrawal-from-processing": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Initialize withdrawal from processing",
                 "consumes": [
                     "application/json"
                 ],
@@ -10118,30 +10550,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Wallet",
-                    "Public"
+                    "Withdrawal"
                 ],
-                "summary": "Get wallet full data",
+                "summary": "Initialize withdrawal from processing",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Wallet ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Locale",
-                        "name": "locale",
-                        "in": "query"
+                        "description": "Init withdrawal",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateProcessingWithdrawalInternalRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-PublicGetWalletResponse"
+                            "$ref": "#/definitions/JSONResponse-ProcessingWithdrawalResponse"
+                        }
+                    },
+                    "202": {
+                        "description": "Held for approval",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-WithdrawalApprovalResponse"
                         }
                     },
                     "401": {
@@ -10150,8 +10583,38 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "410": {
-                        "description": "Gone",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "423": {
+                        "description": "Locked",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -10165,9 +10628,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/public/wallet/{id}/confirm": {
+        "/v1/dv-admin/withdrawal/{currencyID}/rules": {
             "get": {
-                "description": "Notify wallet email",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This endpoint returns withdrawal wallet",
                 "consumes": [
                     "application/json"
                 ],
@@ -10175,30 +10643,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Wallet",
-                    "Public"
+                    "WithdrawalWallet"
                 ],
-                "summary": "Notify wallet email",
+                "summary": "Get withdrawal wallet",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Wallet ID",
-                        "name": "id",
+                        "description": "Currency ID",
+                        "name": "currencyID",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Currency ID",
-                        "name": "currency_id",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Successful operation",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-string"
+                            "$ref": "#/definitions/JSONResponse-WithdrawalRulesByCurrencyResponse"
                         }
                     },
                     "401": {
@@ -10207,12 +10668,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -10220,11 +10675,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/public/wallet/{id}/refresh-address": {
-            "post": {
-                "description": "Marks the current address as dirty so a new one will be generated",
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This endpoint for update withdrawal wallet",
                 "consumes": [
                     "application/json"
                 ],
@@ -10232,25 +10690,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Wallet",
-                    "Public"
+                    "WithdrawalWallet"
                 ],
-                "summary": "Refresh wallet address",
+                "summary": "Update withdrawal wallet",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Wallet ID",
-                        "name": "id",
+                        "description": "walletID",
+                        "name": "walletID",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "RefreshWalletAddressRequest",
-                        "name": "request",
+                        "description": "Update withdrawal wallet",
+                        "name": "register",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/RefreshWalletAddressRequest"
+                            "$ref": "#/definitions/UpdateWithdrawalWalletsRequest"
                         }
                     }
                 ],
@@ -10261,20 +10718,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/JSONResponse-string"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "422": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -10282,2915 +10739,6701 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/public/wallet/{id}/tx-find": {
+        "/v1/exchange/:exchange_slug/deposit-update": {
             "get": {
-                "description": "Get recent wallet transactions",
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Update deposit addresses",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Wallet",
-                    "Public"
-                ],
-                "summary": "Get recent wallet transactions",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Wallet ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Exchange"
                 ],
+                "summary": "Update deposit addresses",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-ShortTransactionInfoListResponse"
+                            "$ref": "#/definitions/JSONResponse-array_DepositUpdateResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "503": {
+                        "description": "Service Unavailable",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "AMLKey": {
-            "type": "object",
-            "properties": {
-                "name": {
-                    "type": "string"
-                },
-                "value": {
-                    "type": "string"
+        },
+        "/v1/exchange/:exchange_slug/withdrawal-setting": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exchange"
+                ],
+                "summary": "Get all withdrawal settings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exchange slug",
+                        "name": "exchange_slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-array_ExchangeWithdrawalSettingResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Creates withdrawal setting with minimum value in USD to withdrawal from exchange",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exchange"
+                ],
+                "summary": "Create withdrawal setting",
+                "parameters": [
+                    {
+                        "description": "Create withdrawal",
+                        "name": "withdrawal",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ExchangeCreateWithdrawalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-string"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/exchange/deposit-addresses": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get exchange deposit address",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exchange"
+                ],
+                "summary": "Get exchange deposit address",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-array_GetDepositAddressesResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/exchange/exchange-history": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get user exchange orders history",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exchange"
+                ],
+                "summary": "Get user exchange orders history",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-ResponseWithFullPagination-ExchangeOrderHistoryResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/exchange/exchange-history/export": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Download user exchange orders history",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exchange"
+                ],
+                "summary": "Download user exchange orders history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "date_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "date_to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "csv",
+                            "xlsx"
+                        ],
+                        "type": "string",
+                        "name": "format",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "slug",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/exchange/withdrawal": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get withdrawals",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exchange"
+                ],
+                "summary": "Get withdrawals",
+                "parameters": [
+                    {
+                        "description": "Get withdrawal request",
+                        "name": "search",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ExchangeGetWithdrawalsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-ResponseWithFullPagination-ExchangeWithdrawalHistoryResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/exchange/withdrawal/export": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Download withdrawals",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exchange"
+                ],
+                "summary": "Download withdrawals",
+                "parameters": [
+                    {
+                        "description": "Get withdrawal exported request",
+                        "name": "search",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ExchangeGetWithdrawalsExportedRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/exchange/{exchange_slug}/chains": {
+            "get": {
+                "description": "Get exchange chains",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exchange"
+                ],
+                "summary": "Get exchange chains",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-array_ExchangeChain"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/exchange/{exchange_slug}/pairs": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get exchange pairs",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exchange"
+                ],
+                "summary": "Get exchange pairs",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-array_github_com_dv-net_dv-merchant_internal_models_ExchangeSymbolDTO"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/exchange/{exchange_slug}/toggle-swaps": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Toggle exchange swaps",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exchange"
+                ],
+                "summary": "Toggle exchange swaps",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exchange slug",
+                        "name": "exchange_slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Toggle swaps request",
+                        "name": "state",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ExchangeToggleSwapsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-string"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/exchange/{exchange_slug}/toggle-withdrawals": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Toggle exchange withdrawals",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exchange"
+                ],
+                "summary": "Toggle exchange withdrawals",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exchange slug",
+                        "name": "exchange_slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Toggle withdrawals request",
+                        "name": "state",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ExchangeToggleWithdrawalsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-string"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/exchange/{exchange_slug}/withdrawal-rules": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get exchange withdrawal rules for all enabled currencies",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exchange"
+                ],
+                "summary": "Get exchange withdrawal rules",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-array_array_github_com_dv-net_dv-merchant_internal_delivery_http_responses_exchange_response_ExchangeWithdrawalRulesResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/exchange/{exchange_slug}/withdrawal-setting/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exchange"
+                ],
+                "summary": "Get all withdrawal setting by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exchange slug",
+                        "name": "exchange_slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Setting UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-ExchangeWithdrawalSettingResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exchange"
+                ],
+                "summary": "Delete withdrawal setting by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exchange slug",
+                        "name": "exchange_slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Setting UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-string"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exchange"
+                ],
+                "summary": "Update withdrawal setting by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Exchange slug",
+                        "name": "exchange_slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Setting UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update exchange settings",
+                        "name": "update_keys",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateWithdrawalSetting"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-ExchangeWithdrawalSettingResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/exchange/{exchange_slug}/withdrawal/{id}": {
+            "get": {
+                "description": "Get withdrawal by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exchange"
+                ],
+                "summary": "Get withdrawal by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Withdrawal UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Exchange slug",
+                        "name": "exchange_slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-ExchangeWithdrawalHistoryResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/external/exchange-balances": {
+            "get": {
+                "security": [
+                    {
+                        "XApiKey": []
+                    }
+                ],
+                "description": "Get external exchange balances",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Exchange"
+                ],
+                "summary": "Get external exchange balances",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store API key",
+                        "name": "api_key",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-ExternalExchangeBalanceResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/external/invoice": {
+            "post": {
+                "security": [
+                    {
+                        "XApiKey": []
+                    }
+                ],
+                "description": "Create invoice with fixed amount and expiry",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Invoice"
+                ],
+                "summary": "Create invoice",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store API key",
+                        "name": "api_key",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Create invoice",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateInvoiceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-InvoiceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/external/invoice/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "XApiKey": []
+                    }
+                ],
+                "description": "Get invoice with status history",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Invoice"
+                ],
+                "summary": "Get invoice",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store API key",
+                        "name": "api_key",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Invoice ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-InvoiceWithHistoryResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/external/invoice/{id}/cancel": {
+            "post": {
+                "security": [
+                    {
+                        "XApiKey": []
+                    }
+                ],
+                "description": "Cancel pending or partially paid invoice",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Invoice"
+                ],
+                "summary": "Cancel invoice",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store API key",
+                        "name": "api_key",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Invoice ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-InvoiceResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/external/processing-wallet-balances": {
+            "get": {
+                "security": [
+                    {
+                        "XApiKey": []
+                    }
+                ],
+                "description": "Get external processing wallet balances",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Withdrawal"
+                ],
+                "summary": "Get external processing wallet balances",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store API key",
+                        "name": "api_key",
+                        "in": "query"
+                    },
+                    {
+                        "uniqueItems": true,
+                        "type": "array",
+                        "items": {
+                            "enum": [
+                                "arbitrum",
+                                "bitcoin",
+                                "bitcoincash",
+                                "bsc",
+                                "dogecoin",
+                                "ethereum",
+                                "litecoin",
+                                "polygon",
+                                "tron"
+                            ],
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "blockchains",
+                        "in": "query"
+                    },
+                    {
+                        "uniqueItems": true,
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "currencies",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-ExternalProcessingWalletBalanceResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/external/refund": {
+            "post": {
+                "security": [
+                    {
+                        "XApiKey": []
+                    }
+                ],
+                "description": "Request refund of a deposit to payer supplied or source address, it is executed after approval",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Refund"
+                ],
+                "summary": "Create refund",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store API key",
+                        "name": "api_key",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Create refund",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateRefundRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-RefundResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/external/refund/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "XApiKey": []
+                    }
+                ],
+                "description": "Get refund with status history",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Refund"
+                ],
+                "summary": "Get refund",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store API key",
+                        "name": "api_key",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Refund ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-RefundWithHistoryResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/external/refund/{id}/address": {
+            "put": {
+                "security": [
+                    {
+                        "XApiKey": []
+                    }
+                ],
+                "description": "Change destination address of refund pending approval",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Refund"
+                ],
+                "summary": "Update refund address",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store API key",
+                        "name": "api_key",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Refund ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Refund address",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateRefundAddressRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-RefundResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/external/refund/{id}/cancel": {
+            "post": {
+                "security": [
+                    {
+                        "XApiKey": []
+                    }
+                ],
+                "description": "Cancel refund pending approval",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Refund"
+                ],
+                "summary": "Cancel refund",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store API key",
+                        "name": "api_key",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Refund ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-RefundResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/external/store/currencies": {
+            "get": {
+                "security": [
+                    {
+                        "XApiKey": []
+                    }
+                ],
+                "description": "Get store currencies list",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Store"
+                ],
+                "summary": "Get store currencies list",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store API key",
+                        "name": "api_key",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-array_GetCurrencyResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/external/store/currencies-extended": {
+            "get": {
+                "security": [
+                    {
+                        "XApiKey": []
+                    }
+                ],
+                "description": "Get extended list of store currencies grouped by tokens and blockchains",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Store"
+                ],
+                "summary": "Get extended list of store currencies",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store API key",
+                        "name": "api_key",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-CurrenciesExtendedResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/external/store/currencies/{id}/rate": {
+            "get": {
+                "security": [
+                    {
+                        "XApiKey": []
+                    }
+                ],
+                "description": "Get store currency rate",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Store"
+                ],
+                "summary": "Get store currency rate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Currency ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Store API key",
+                        "name": "api_key",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-CurrencyRate"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/external/transactions/unconfirmed/transfer": {
+            "get": {
+                "security": [
+                    {
+                        "XApiKey": []
+                    }
+                ],
+                "description": "Get unconfirmed transfer transactions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transactions"
+                ],
+                "summary": "Get unconfirmed transfer transactions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store API key",
+                        "name": "api_key",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-UnconfirmedTransactionResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/external/wallet": {
+            "get": {
+                "security": [
+                    {
+                        "XApiKey": []
+                    }
+                ],
+                "description": "Create wallet with address",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Store"
+                ],
+                "summary": "Create wallet with address",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store API key",
+                        "name": "api_key",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "name": "amount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "email",
+                        "name": "email",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "ipv4",
+                        "name": "ip",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "locale",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "name": "store_external_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-CreateWalletExternalResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "XApiKey": []
+                    }
+                ],
+                "description": "Create wallet with address",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wallet"
+                ],
+                "summary": "Create wallet with address",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store API key",
+                        "name": "api_key",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Create wallet",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateWalletExternalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-CreateWalletExternalResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/external/wallet/addresses/dirty": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Marks the given wallet address as dirty so it will not be used for new payments",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wallet"
+                ],
+                "summary": "Mark wallet address as dirty",
+                "parameters": [
+                    {
+                        "description": "MarkIsDirtyRequest",
+                        "name": "json",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/MarkIsDirtyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/external/wallet/balance/hot": {
+            "get": {
+                "security": [
+                    {
+                        "XApiKey": []
+                    }
+                ],
+                "description": "Get external hot wallet balances",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Store"
+                ],
+                "summary": "Get external hot wallet balances",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store API key",
+                        "name": "api_key",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "name": "min_balance",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful operation",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-array_SummaryDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/external/webhook/dead-letters": {
+            "get": {
+                "security": [
+                    {
+                        "XApiKey": []
+                    }
+                ],
+                "description": "List store webhooks that exhausted retry policy",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "List webhook dead letters",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store API key",
+                        "name": "api_key",
+                        "in": "query"
+                    },
+                    {
+                        "maxLength": 50,
+                        "type": "string",
+                        "name": "event",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "name": "requeued",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "transaction_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-ResponseWithFullPagination-WebhookDeadLetterResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/external/webhook/dead-letters/requeue": {
+            "post": {
+                "security": [
+                    {
+                        "XApiKey": []
+                    }
+                ],
+                "description": "Put selected or all pending webhook dead letters back to the send queue",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Requeue webhook dead letters",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store API key",
+                        "name": "api_key",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Dead letters to requeue",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/RequeueWebhookDeadLettersRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-RequeueWebhookDeadLettersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/external/webhook/dead-letters/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "XApiKey": []
+                    }
+                ],
+                "description": "Get webhook dead letter with payload and signature",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Get webhook dead letter",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store API key",
+                        "name": "api_key",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Dead letter ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-WebhookDeadLetterWithPayloadResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/external/withdrawal-from-processing": {
+            "post": {
+                "security": [
+                    {
+                        "XApiKey": []
+                    }
+                ],
+                "description": "Initialize withdrawal from processing",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Withdrawal"
+                ],
+                "summary": "Initialize withdrawal from processing",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store API key",
+                        "name": "api_key",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "Init withdrawal",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateProcessingWithdrawRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-ProcessingWithdrawalResponse"
+                        }
+                    },
+                    "202": {
+                        "description": "Held for approval",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-WithdrawalApprovalResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "423": {
+                        "description": "Locked",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/external/withdrawal-from-processing/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "XApiKey": []
+                    }
+                ],
+                "description": "Get withdrawal from processing",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Withdrawal"
+                ],
+                "summary": "Get withdrawal from processing",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store API key",
+                        "name": "api_key",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Withdrawal ID or Request ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-WithdrawalFromProcessingDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "XApiKey": []
+                    }
+                ],
+                "description": "Delete withdrawal from processing",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Withdrawal"
+                ],
+                "summary": "Delete withdrawal from processing",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store API key",
+                        "name": "api_key",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Withdrawal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/public/address-book/revoke/{token}": {
+            "get": {
+                "description": "Shows the address book entries added by a single request, they are removed after confirmation",
+                "produces": [
+                    "text/html"
+                ],
+                "tags": [
+                    "Address Book",
+                    "Public"
+                ],
+                "summary": "Revoke address book entry confirmation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Revoke token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Removes the address book entries added by a single request, the link is sent to the owner email and can be used once",
+                "produces": [
+                    "text/html"
+                ],
+                "tags": [
+                    "Address Book",
+                    "Public"
+                ],
+                "summary": "Revoke address book entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Revoke token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/public/currencies": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all Currencies",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Currency"
+                ],
+                "summary": "Get all Currencies",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-array_GetCurrencyResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/public/currencies/rate/{source}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get Currency rate",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Currency"
+                ],
+                "summary": "Get Currency rate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Currency Source example binance",
+                        "name": "source",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-array_ExchangeRate"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/public/currencies/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get Currency by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Currency"
+                ],
+                "summary": "Get Currency by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Currency ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-GetCurrencyResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/public/mnemonic/generate": {
+            "get": {
+                "description": "Generate random mnemonic",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Mnemonic",
+                    "Public"
+                ],
+                "summary": "Generate random mnemonic",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Length of mnemonic, 12 or 24 words",
+                        "name": "length",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-github_com_dv-net_dv-merchant_internal_delivery_http_responses_mnemonic_response_MnemonicResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/public/store/topup/{store_id}/{external_id}": {
+            "get": {
+                "description": "Get wallet full data",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wallet",
+                    "Public"
+                ],
+                "summary": "Get top up data by store",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Slug of the shop",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "External client ID",
+                        "name": "external_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "email",
+                        "name": "email",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "ipv4",
+                        "name": "ip",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "locale",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-PublicGetWalletResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "410": {
+                        "description": "Gone",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/public/user/accept-invite": {
+            "post": {
+                "description": "Accept admin invite link",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User",
+                    "Public"
+                ],
+                "summary": "Accept admin invite link",
+                "parameters": [
+                    {
+                        "description": "Invite request",
+                        "name": "inviteRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/PublicAcceptInviteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/public/user/forgot-password": {
+            "post": {
+                "description": "Request password reset link by email",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User",
+                    "Public"
+                ],
+                "summary": "Request password reset link by email",
+                "parameters": [
+                    {
+                        "description": "User email",
+                        "name": "email",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/PublicUserForgotPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/public/user/reset-password": {
+            "post": {
+                "description": "Reset user password",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User",
+                    "Public"
+                ],
+                "summary": "Reset user password",
+                "parameters": [
+                    {
+                        "description": "Public reset password request",
+                        "name": "resetPassword",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/PublicResetPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-string"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/public/wallet/{id}": {
+            "get": {
+                "description": "Get wallet full data",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wallet",
+                    "Public"
+                ],
+                "summary": "Get wallet full data",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Locale",
+                        "name": "locale",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-PublicGetWalletResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "410": {
+                        "description": "Gone",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/public/wallet/{id}/confirm": {
+            "get": {
+                "description": "Notify wallet email",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wallet",
+                    "Public"
+                ],
+                "summary": "Notify wallet email",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Currency ID",
+                        "name": "currency_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/public/wallet/{id}/quote": {
+            "post": {
+                "description": "Lock current currency rate for the wallet payer, deposits received before expiry are valued at it up to the quoted amount",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wallet",
+                    "Public"
+                ],
+                "summary": "Create rate quote",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "CreateRateQuoteRequest",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateRateQuoteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-PublicRateQuote"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "410": {
+                        "description": "Gone",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/public/wallet/{id}/quote/{quote_id}": {
+            "get": {
+                "description": "Get rate quote of the wallet",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wallet",
+                    "Public"
+                ],
+                "summary": "Get rate quote",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Rate quote ID",
+                        "name": "quote_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-PublicRateQuote"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "410": {
+                        "description": "Gone",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/public/wallet/{id}/refresh-address": {
+            "post": {
+                "description": "Marks the current address as dirty so a new one will be generated",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wallet",
+                    "Public"
+                ],
+                "summary": "Refresh wallet address",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "RefreshWalletAddressRequest",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/RefreshWalletAddressRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/public/wallet/{id}/tx-find": {
+            "get": {
+                "description": "Get recent wallet transactions",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wallet",
+                    "Public"
+                ],
+                "summary": "Get recent wallet transactions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Wallet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-ShortTransactionInfoListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "AMLKey": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "APIError": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "APIErrors": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/APIError"
+                    }
+                }
+            }
+        },
+        "AddUserRoleRequest": {
+            "type": "object",
+            "required": [
+                "user_id",
+                "user_role"
+            ],
+            "properties": {
+                "user_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "user_role": {
+                    "enum": [
+                        "root",
+                        "user"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/UserRole"
+                        }
+                    ]
+                }
+            }
+        },
+        "AddUserRoleResponse": {
+            "type": "object",
+            "required": [
+                "user_id",
+                "user_roles"
+            ],
+            "properties": {
+                "user_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "user_roles": {
+                    "type": "array",
+                    "items": {
+                        "enum": [
+                            "root",
+                            "user"
+                        ],
+                        "$ref": "#/definitions/UserRole"
+                    }
+                }
+            }
+        },
+        "AddressBookEntryResponse": {
+            "type": "object",
+            "properties": {
+                "active_at": {
+                    "type": "string"
+                },
+                "address": {
+                    "type": "string"
+                },
+                "blockchain": {
+                    "$ref": "#/definitions/Blockchain"
+                },
+                "currency_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "submitted_at": {
+                    "type": "string"
+                },
+                "tag": {
+                    "type": "string"
+                },
+                "withdrawal_rule_exists": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "AddressBookEntryResponseShort": {
+            "type": "object",
+            "properties": {
+                "active_at": {
+                    "type": "string"
+                },
+                "currency_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "withdrawal_rule_exists": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "AddressBookListResponse": {
+            "type": "object",
+            "properties": {
+                "addresses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/AddressBookEntryResponse"
+                    }
+                },
+                "evm_groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/EVMAddressGroupResponse"
+                    }
+                },
+                "universal_groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/UniversalAddressGroupResponse"
+                    }
+                }
+            }
+        },
+        "AmlHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "external_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "request_history": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/CheckHistory"
+                    }
+                },
+                "risk_level": {
+                    "type": "string"
+                },
+                "score": {
+                    "type": "number"
+                },
+                "service_id": {
+                    "type": "string"
+                },
+                "service_slug": {
+                    "$ref": "#/definitions/github_com_dv-net_dv-merchant_internal_models.AMLSlug"
+                },
+                "status": {
+                    "$ref": "#/definitions/github_com_dv-net_dv-merchant_internal_models.AMLCheckStatus"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "AmlSettingsResponse": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "provider_slug": {
+                    "$ref": "#/definitions/github_com_dv-net_dv-merchant_internal_models.AMLSlug"
+                }
+            }
+        },
+        "Asset": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string"
+                },
+                "amount_usd": {
+                    "type": "string"
+                },
+                "currency_id": {
+                    "type": "string"
+                },
+                "identity": {
+                    "type": "string"
+                }
+            }
+        },
+        "AssetWallet": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "amount_usd": {
+                    "type": "number"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "total_deposit": {
+                    "type": "number"
+                },
+                "tx_count": {
+                    "type": "number"
+                }
+            }
+        },
+        "AuthLinkResponse": {
+            "type": "object",
+            "properties": {
+                "link": {
+                    "type": "string"
+                }
+            }
+        },
+        "AuthRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "maxLength": 32,
+                    "minLength": 8
+                },
+                "remember_me": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "AuthResponse": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "Balance": {
+            "type": "object",
+            "properties": {
+                "native_token": {
+                    "type": "string"
+                },
+                "native_token_usd": {
+                    "type": "string"
+                }
+            }
+        },
+        "BanUserResponse": {
+            "type": "object",
+            "properties": {
+                "banned": {
+                    "type": "boolean"
+                },
+                "user_id": {
+                    "type": "string",
+                    "format": "uuid"
+                }
+            }
+        },
+        "Blockchain": {
+            "type": "string",
+            "enum": [
+                "arbitrum",
+                "bitcoin",
+                "bitcoincash",
+                "bsc",
+                "dogecoin",
+                "ethereum",
+                "litecoin",
+                "polygon",
+                "tron"
+            ],
+            "x-enum-varnames": [
+                "BlockchainArbitrum",
+                "BlockchainBitcoin",
+                "BlockchainBitcoinCash",
+                "BlockchainBinanceSmartChain",
+                "BlockchainDogecoin",
+                "BlockchainEthereum",
+                "BlockchainLitecoin",
+                "BlockchainPolygon",
+                "BlockchainTron"
+            ]
+        },
+        "BlockchainAdditionalData": {
+            "type": "object",
+            "properties": {
+                "evm_data": {
+                    "$ref": "#/definitions/github_com_dv-net_dv-merchant_internal_service_wallet.EVMData"
+                },
+                "tron_data": {
+                    "$ref": "#/definitions/TronData"
+                }
+            }
+        },
+        "BlockchainGroup": {
+            "type": "object",
+            "properties": {
+                "assets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/AssetWallet"
+                    }
+                },
+                "blockchain": {
+                    "$ref": "#/definitions/Blockchain"
+                }
+            }
+        },
+        "CallbackURLResponse": {
+            "type": "object",
+            "properties": {
+                "callback_url": {
+                    "type": "string"
+                }
+            }
+        },
+        "ChangeEmailRequest": {
+            "type": "object",
+            "required": [
+                "new_email"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "new_email": {
+                    "type": "string"
+                },
+                "new_email_confirmation": {
+                    "type": "string"
+                }
+            }
+        },
+        "ChangePasswordInternalRequest": {
+            "type": "object",
+            "required": [
+                "password_new",
+                "password_old"
+            ],
+            "properties": {
+                "password_new": {
+                    "type": "string",
+                    "format": "password",
+                    "maxLength": 32,
+                    "minLength": 8
+                },
+                "password_old": {
+                    "type": "string",
+                    "format": "password",
+                    "maxLength": 32,
+                    "minLength": 8
+                }
+            }
+        },
+        "ChangeTwoFactorAuthStatusRequest": {
+            "type": "object",
+            "required": [
+                "otp"
+            ],
+            "properties": {
+                "otp": {
+                    "type": "string"
+                }
+            }
+        },
+        "CheckHistory": {
+            "type": "object",
+            "properties": {
+                "aml_check_id": {
+                    "type": "string"
+                },
+                "attempt_number": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "error_msg": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "request_payload": {
+                    "type": "string"
+                },
+                "service_response": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "ClarificationStoreRequest": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "CombinedStats": {
+            "type": "object",
+            "properties": {
+                "available_bandwidth": {
+                    "type": "number"
+                },
+                "available_energy": {
+                    "type": "number"
+                },
+                "delegated_bandwidth": {
+                    "type": "number"
+                },
+                "delegated_energy": {
+                    "type": "number"
+                },
+                "staked_bandwidth": {
+                    "type": "number"
+                },
+                "staked_energy": {
+                    "type": "number"
+                },
+                "total_bandwidth_used": {
+                    "type": "number"
+                },
+                "total_energy_used": {
+                    "type": "number"
+                },
+                "total_trx_fee": {
+                    "type": "number"
+                },
+                "transfer_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "ConfirmTwoFactorAuthRequest": {
+            "type": "object",
+            "required": [
+                "otp"
+            ],
+            "properties": {
+                "otp": {
+                    "type": "string"
+                }
+            }
+        },
+        "ConvertedAddressResponse": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "legacy": {
+                    "type": "string"
+                }
+            }
+        },
+        "CreateInvoiceRequest": {
+            "type": "object",
+            "required": [
+                "currency_id",
+                "store_external_id"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "amount_usd": {
+                    "type": "number"
+                },
+                "currency_id": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "email": {
+                    "type": "string",
+                    "format": "email"
+                },
+                "ip": {
+                    "type": "string",
+                    "format": "ipv4"
+                },
+                "lifetime_minutes": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "locale": {
+                    "type": "string"
+                },
+                "order_id": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "store_external_id": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "CreateProcessingWithdrawRequest": {
+            "type": "object",
+            "required": [
+                "address_to",
+                "amount",
+                "currency_id",
+                "request_id"
+            ],
+            "properties": {
+                "address_to": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 16
+                },
+                "amount": {
+                    "type": "number"
+                },
+                "currency_id": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                }
+            }
+        },
+        "CreateProcessingWithdrawalInternalRequest": {
+            "type": "object",
+            "required": [
+                "address_to",
+                "amount",
+                "currency_id",
+                "request_id",
+                "totp"
+            ],
+            "properties": {
+                "address_to": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 16
+                },
+                "amount": {
+                    "type": "number"
+                },
+                "currency_id": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "totp": {
+                    "type": "string",
+                    "maxLength": 6,
+                    "minLength": 6
+                }
+            }
+        },
+        "CreateRateQuoteRequest": {
+            "type": "object",
+            "required": [
+                "amount_usd",
+                "currency_id"
+            ],
+            "properties": {
+                "amount_usd": {
+                    "type": "number"
+                },
+                "currency_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "CreateRefundRequest": {
+            "type": "object",
+            "required": [
+                "transaction_id"
+            ],
+            "properties": {
+                "address_to": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "amount": {
+                    "type": "number"
+                },
+                "transaction_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "CreateSettingRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 2
+                },
+                "otp": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 0
+                }
+            }
+        },
+        "CreateStoreRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 32,
+                    "minLength": 2
+                },
+                "site": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 5
+                }
+            }
+        },
+        "CreateStoreWebhookRequest": {
+            "type": "object",
+            "required": [
+                "events",
+                "url"
+            ],
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/WebhookEvent"
+                    }
+                },
+                "schema_version": {
+                    "description": "SchemaVersion 1 sends legacy flat payloads, 2 wraps them into a versioned envelope",
+                    "type": "integer",
+                    "enum": [
+                        1,
+                        2
+                    ]
+                },
+                "success_json_path": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1,
+                    "example": "success"
+                },
+                "success_json_value": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "true"
+                },
+                "success_mode": {
+                    "enum": [
+                        "any_2xx",
+                        "json_body",
+                        "status_codes"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/WebhookSuccessMode"
+                        }
+                    ]
+                },
+                "success_status_codes": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "timeout_seconds": {
+                    "description": "TimeoutSeconds zero means configured default timeout",
+                    "type": "integer",
+                    "maximum": 120,
+                    "minimum": 0
+                },
+                "url": {
+                    "type": "string",
+                    "format": "url"
+                }
+            }
+        },
+        "CreateWalletExternalRequest": {
+            "type": "object",
+            "required": [
+                "store_external_id"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string",
+                    "format": "email"
+                },
+                "ip": {
+                    "type": "string",
+                    "format": "ipv4"
+                },
+                "locale": {
+                    "type": "string"
+                },
+                "store_external_id": {
+                    "type": "string",
+                    "format": "uuid"
+                }
+            }
+        },
+        "CreateWalletExternalResponse": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/WalletAddressResponse"
+                    }
+                },
+                "amount_usd": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "pay_url": {
+                    "type": "string"
+                },
+                "rates": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "store_external_id": {
+                    "type": "string"
+                },
+                "store_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "format": "date-time"
                 }
             }
         },
-        "APIError": {
+        "CreateWalletRequest": {
             "type": "object",
+            "required": [
+                "store_external_id",
+                "store_id"
+            ],
             "properties": {
-                "field": {
+                "amount": {
+                    "type": "number"
+                },
+                "currency": {
                     "type": "string"
                 },
-                "message": {
+                "email": {
+                    "type": "string",
+                    "format": "email"
+                },
+                "locale": {
                     "type": "string"
+                },
+                "store_external_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "store_id": {
+                    "type": "string",
+                    "format": "uuid"
                 }
             }
         },
-        "APIErrors": {
+        "CreateWithdrawalApprovalRuleRequest": {
             "type": "object",
+            "required": [
+                "required_approvals"
+            ],
             "properties": {
-                "code": {
-                    "type": "integer"
+                "address_to": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 16
                 },
-                "errors": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/APIError"
-                    }
+                "currency_id": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "min_amount_usd": {
+                    "type": "number"
+                },
+                "required_approvals": {
+                    "type": "integer",
+                    "maximum": 10,
+                    "minimum": 1
                 }
             }
         },
-        "AddUserRoleRequest": {
+        "CreateWithdrawalLimitRequest": {
             "type": "object",
             "required": [
-                "user_id",
-                "user_role"
+                "scope",
+                "window_seconds"
             ],
             "properties": {
-                "user_id": {
-                    "type": "string",
-                    "format": "uuid"
+                "max_amount_usd": {
+                    "type": "number"
                 },
-                "user_role": {
+                "max_count": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "scope": {
                     "enum": [
-                        "root",
-                        "user"
+                        "user",
+                        "store",
+                        "currency",
+                        "destination"
                     ],
                     "allOf": [
                         {
-                            "$ref": "#/definitions/UserRole"
+                            "$ref": "#/definitions/WithdrawalLimitScope"
                         }
                     ]
+                },
+                "scope_value": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "window_seconds": {
+                    "type": "integer",
+                    "maximum": 2592000,
+                    "minimum": 1
                 }
             }
         },
-        "AddUserRoleResponse": {
+        "CurrenciesExtendedResponse": {
             "type": "object",
-            "required": [
-                "user_id",
-                "user_roles"
-            ],
             "properties": {
-                "user_id": {
-                    "type": "string",
-                    "format": "uuid"
+                "blockchains": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/BlockchainGroup"
+                    }
                 },
-                "user_roles": {
+                "currencies": {
                     "type": "array",
                     "items": {
-                        "enum": [
-                            "root",
-                            "user"
-                        ],
-                        "$ref": "#/definitions/UserRole"
+                        "$ref": "#/definitions/CurrencyExtendedItem"
+                    }
+                },
+                "tokens": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/TokenGroup"
                     }
                 }
             }
         },
-        "AddressBookEntryResponse": {
+        "CurrencyDTO": {
             "type": "object",
             "properties": {
-                "address": {
+                "blockchain": {
+                    "$ref": "#/definitions/Blockchain"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "contract_address": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_native": {
+                    "type": "boolean"
+                },
+                "name": {
                     "type": "string"
                 },
+                "sort_order": {
+                    "type": "integer"
+                }
+            }
+        },
+        "CurrencyExtendedItem": {
+            "type": "object",
+            "properties": {
                 "blockchain": {
-                    "$ref": "#/definitions/Blockchain"
+                    "type": "string"
                 },
-                "currency_id": {
+                "blockchain_icon": {
+                    "$ref": "#/definitions/CurrencyIcon"
+                },
+                "code": {
                     "type": "string"
                 },
+                "contract_address": {
+                    "type": "string"
+                },
+                "explorer_link": {
+                    "type": "string"
+                },
+                "has_balance": {
+                    "type": "boolean"
+                },
+                "icon": {
+                    "$ref": "#/definitions/CurrencyIcon"
+                },
                 "id": {
                     "type": "string"
                 },
+                "min_confirmation": {
+                    "type": "integer"
+                },
                 "name": {
                     "type": "string"
                 },
-                "submitted_at": {
+                "token_icon": {
+                    "$ref": "#/definitions/CurrencyIcon"
+                }
+            }
+        },
+        "CurrencyIcon": {
+            "type": "object",
+            "properties": {
+                "icon_128": {
                     "type": "string"
                 },
-                "tag": {
+                "icon_512": {
                     "type": "string"
                 },
-                "withdrawal_rule_exists": {
+                "icon_svg": {
+                    "type": "string"
+                }
+            }
+        },
+        "CurrencyRate": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "rate": {
+                    "type": "string"
+                },
+                "rate_source": {
+                    "type": "string"
+                }
+            }
+        },
+        "CurrencyShort": {
+            "type": "object",
+            "properties": {
+                "blockchain": {
+                    "$ref": "#/definitions/Blockchain"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_bitcoin_like": {
+                    "type": "boolean"
+                },
+                "is_evm_like": {
+                    "type": "boolean"
+                },
+                "is_stable_coin": {
                     "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "precision": {
+                    "type": "integer"
                 }
             }
         },
-        "AddressBookEntryResponseShort": {
+        "DeleteTransferRequest": {
             "type": "object",
+            "required": [
+                "id"
+            ],
             "properties": {
-                "currency_id": {
+                "id": {
+                    "type": "array",
+                    "items": {
+                        "type": "string",
+                        "format": "uuid"
+                    }
+                }
+            }
+        },
+        "DeleteWithdrawalLimitRequest": {
+            "type": "object",
+            "required": [
+                "totp"
+            ],
+            "properties": {
+                "totp": {
+                    "type": "string"
+                }
+            }
+        },
+        "DeleteWithdrawalScheduleRequest": {
+            "type": "object",
+            "required": [
+                "totp"
+            ],
+            "properties": {
+                "totp": {
+                    "type": "string"
+                }
+            }
+        },
+        "DeliveryChannel": {
+            "type": "string",
+            "enum": [
+                "email",
+                "telegram"
+            ],
+            "x-enum-varnames": [
+                "EmailDeliveryChannel",
+                "TelegramDeliveryChannel"
+            ]
+        },
+        "DepositUpdateResponse": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "address_type": {
+                    "$ref": "#/definitions/github_com_dv-net_dv-merchant_internal_models.AddressType"
+                },
+                "chain": {
                     "type": "string"
                 },
-                "id": {
+                "currency": {
                     "type": "string"
                 },
-                "withdrawal_rule_exists": {
-                    "type": "boolean"
+                "min_deposit_amount": {
+                    "type": "string"
                 }
             }
         },
-        "AddressBookListResponse": {
+        "EVMAddressGroupResponse": {
             "type": "object",
             "properties": {
-                "addresses": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/AddressBookEntryResponse"
-                    }
+                "address": {
+                    "type": "string"
                 },
-                "evm_groups": {
+                "blockchains": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/EVMAddressGroupResponse"
+                        "$ref": "#/definitions/Blockchain"
                     }
                 },
-                "universal_groups": {
+                "currencies": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/UniversalAddressGroupResponse"
+                        "$ref": "#/definitions/AddressBookEntryResponseShort"
                     }
-                }
-            }
-        },
-        "AmlHistoryResponse": {
-            "type": "object",
-            "properties": {
-                "created_at": {
-                    "type": "string"
-                },
-                "external_id": {
-                    "type": "string"
                 },
-                "id": {
-                    "type": "string"
+                "currency_count": {
+                    "type": "integer"
                 },
-                "request_history": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/CheckHistory"
-                    }
+                "is_evm": {
+                    "type": "boolean"
                 },
-                "risk_level": {
+                "name": {
                     "type": "string"
                 },
-                "score": {
-                    "type": "number"
-                },
-                "service_id": {
+                "submitted_at": {
                     "type": "string"
                 },
-                "service_slug": {
-                    "$ref": "#/definitions/github_com_dv-net_dv-merchant_internal_models.AMLSlug"
-                },
-                "status": {
-                    "$ref": "#/definitions/github_com_dv-net_dv-merchant_internal_models.AMLCheckStatus"
-                },
-                "updated_at": {
+                "tag": {
                     "type": "string"
+                }
+            }
+        },
+        "ExchangeAsset": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
                 },
-                "user_id": {
+                "amount_usd": {
+                    "type": "number"
+                },
+                "currency": {
                     "type": "string"
                 }
             }
         },
-        "AmlSettingsResponse": {
+        "ExchangeBalance": {
             "type": "object",
             "properties": {
-                "enabled": {
-                    "type": "boolean"
+                "balances": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ExchangeAsset"
+                    }
                 },
-                "provider_slug": {
-                    "$ref": "#/definitions/github_com_dv-net_dv-merchant_internal_models.AMLSlug"
+                "total_usd": {
+                    "type": "number"
                 }
             }
         },
-        "Asset": {
+        "ExchangeChain": {
             "type": "object",
             "properties": {
-                "amount": {
+                "chain": {
                     "type": "string"
                 },
-                "amount_usd": {
+                "chain_label": {
                     "type": "string"
                 },
                 "currency_id": {
                     "type": "string"
                 },
-                "identity": {
+                "ticker": {
+                    "type": "string"
+                },
+                "ticker_label": {
                     "type": "string"
                 }
             }
         },
-        "AssetWallet": {
+        "ExchangeCreateWithdrawalRequest": {
             "type": "object",
+            "required": [
+                "address",
+                "chain",
+                "currency_id",
+                "min_amount"
+            ],
             "properties": {
-                "amount": {
-                    "type": "number"
-                },
-                "amount_usd": {
-                    "type": "number"
+                "address": {
+                    "type": "string"
                 },
-                "currency": {
+                "chain": {
                     "type": "string"
                 },
-                "total_deposit": {
-                    "type": "number"
+                "currency_id": {
+                    "type": "string"
                 },
-                "tx_count": {
-                    "type": "number"
-                }
-            }
-        },
-        "AuthLinkResponse": {
-            "type": "object",
-            "properties": {
-                "link": {
+                "min_amount": {
                     "type": "string"
                 }
             }
         },
-        "AuthRequest": {
+        "ExchangeGetWithdrawalsExportedRequest": {
             "type": "object",
             "required": [
-                "email",
-                "password"
+                "format"
             ],
             "properties": {
-                "email": {
+                "currency_id": {
                     "type": "string"
                 },
-                "password": {
-                    "type": "string",
-                    "maxLength": 32,
-                    "minLength": 8
-                },
-                "remember_me": {
-                    "type": "boolean"
-                }
-            }
-        },
-        "AuthResponse": {
-            "type": "object",
-            "properties": {
-                "token": {
-                    "type": "string"
-                }
-            }
-        },
-        "Balance": {
-            "type": "object",
-            "properties": {
-                "native_token": {
+                "date_from": {
                     "type": "string"
                 },
-                "native_token_usd": {
+                "date_to": {
                     "type": "string"
-                }
-            }
-        },
-        "BanUserResponse": {
-            "type": "object",
-            "properties": {
-                "banned": {
-                    "type": "boolean"
                 },
-                "user_id": {
+                "format": {
                     "type": "string",
-                    "format": "uuid"
-                }
-            }
-        },
-        "Blockchain": {
-            "type": "string",
-            "enum": [
-                "arbitrum",
-                "bitcoin",
-                "bitcoincash",
-                "bsc",
-                "dogecoin",
-                "ethereum",
-                "litecoin",
-                "polygon",
-                "tron"
-            ],
-            "x-enum-varnames": [
-                "BlockchainArbitrum",
-                "BlockchainBitcoin",
-                "BlockchainBitcoinCash",
-                "BlockchainBinanceSmartChain",
-                "BlockchainDogecoin",
-                "BlockchainEthereum",
-                "BlockchainLitecoin",
-                "BlockchainPolygon",
-                "BlockchainTron"
-            ]
-        },
-        "BlockchainAdditionalData": {
-            "type": "object",
-            "properties": {
-                "evm_data": {
-                    "$ref": "#/definitions/github_com_dv-net_dv-merchant_internal_service_wallet.EVMData"
-                },
-                "tron_data": {
-                    "$ref": "#/definitions/TronData"
-                }
-            }
-        },
-        "BlockchainGroup": {
-            "type": "object",
-            "properties": {
-                "assets": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/AssetWallet"
-                    }
+                    "enum": [
+                        "csv",
+                        "xlsx"
+                    ]
                 },
-                "blockchain": {
-                    "$ref": "#/definitions/Blockchain"
-                }
-            }
-        },
-        "CallbackURLResponse": {
-            "type": "object",
-            "properties": {
-                "callback_url": {
+                "slug": {
                     "type": "string"
                 }
             }
         },
-        "ChangeEmailRequest": {
+        "ExchangeGetWithdrawalsRequest": {
             "type": "object",
-            "required": [
-                "new_email"
-            ],
             "properties": {
-                "code": {
+                "currency_id": {
                     "type": "string"
                 },
-                "new_email": {
+                "date_from": {
                     "type": "string"
                 },
-                "new_email_confirmation": {
+                "date_to": {
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "page_size": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1
+                },
+                "slug": {
                     "type": "string"
                 }
             }
         },
-        "ChangePasswordInternalRequest": {
+        "ExchangeKeyData": {
             "type": "object",
-            "required": [
-                "password_new",
-                "password_old"
-            ],
             "properties": {
-                "password_new": {
-                    "type": "string",
-                    "format": "password",
-                    "maxLength": 32,
-                    "minLength": 8
+                "name": {
+                    "type": "string"
                 },
-                "password_old": {
-                    "type": "string",
-                    "format": "password",
-                    "maxLength": 32,
-                    "minLength": 8
+                "value": {
+                    "type": "string"
                 }
             }
         },
-        "ChangeTwoFactorAuthStatusRequest": {
+        "ExchangeKeyPermissionsResponse": {
             "type": "object",
-            "required": [
-                "otp"
-            ],
             "properties": {
-                "otp": {
+                "can_trade": {
+                    "type": "boolean"
+                },
+                "can_transfer": {
+                    "type": "boolean"
+                },
+                "can_withdraw": {
+                    "type": "boolean"
+                },
+                "exchange": {
+                    "type": "string"
+                },
+                "expires_at": {
                     "type": "string"
+                },
+                "ip_restricted": {
+                    "type": "boolean"
+                },
+                "transfer_required": {
+                    "type": "boolean"
+                },
+                "warnings": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "CheckHistory": {
+        "ExchangeOrderHistoryResponse": {
             "type": "object",
             "properties": {
-                "aml_check_id": {
+                "amount": {
                     "type": "string"
                 },
-                "attempt_number": {
-                    "type": "integer"
+                "amount_usd": {
+                    "type": "string"
+                },
+                "client_order_id": {
+                    "type": "string"
                 },
                 "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "exchange_id": {
                     "type": "string"
                 },
-                "error_msg": {
+                "exchange_order_id": {
+                    "type": "string"
+                },
+                "exchange_slug": {
+                    "type": "string"
+                },
+                "fail_reason": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "request_payload": {
+                "order_type": {
                     "type": "string"
                 },
-                "service_response": {
+                "parent_id": {
                     "type": "string"
                 },
-                "updated_at": {
+                "price": {
+                    "type": "string"
+                },
+                "route": {
+                    "type": "object"
+                },
+                "side": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "symbol": {
+                    "type": "string"
+                },
+                "time_in_force": {
+                    "type": "string"
+                },
+                "user_id": {
                     "type": "string"
                 }
             }
         },
-        "ClarificationStoreRequest": {
+        "ExchangePair": {
             "type": "object",
             "required": [
-                "reason"
+                "base_symbol",
+                "quote_symbol",
+                "symbol",
+                "type"
             ],
             "properties": {
-                "reason": {
+                "base_symbol": {
                     "type": "string"
-                }
-            }
-        },
-        "CombinedStats": {
-            "type": "object",
-            "properties": {
-                "available_bandwidth": {
-                    "type": "number"
                 },
-                "available_energy": {
-                    "type": "number"
-                },
-                "delegated_bandwidth": {
-                    "type": "number"
+                "order_type": {
+                    "type": "string",
+                    "enum": [
+                        "market",
+                        "limit",
+                        "twap"
+                    ]
                 },
-                "delegated_energy": {
-                    "type": "number"
+                "price_offset": {
+                    "type": "string"
                 },
-                "staked_bandwidth": {
-                    "type": "number"
+                "quote_symbol": {
+                    "type": "string"
                 },
-                "staked_energy": {
-                    "type": "number"
+                "symbol": {
+                    "type": "string"
                 },
-                "total_bandwidth_used": {
-                    "type": "number"
+                "time_in_force": {
+                    "type": "string",
+                    "enum": [
+                        "gtc",
+                        "ioc",
+                        "fok"
+                    ]
                 },
-                "total_energy_used": {
-                    "type": "number"
+                "twap_slices": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 2
                 },
-                "total_trx_fee": {
-                    "type": "number"
+                "twap_window_seconds": {
+                    "type": "integer",
+                    "maximum": 86400,
+                    "minimum": 60
                 },
-                "transfer_count": {
-                    "type": "integer"
+                "type": {
+                    "type": "string"
                 }
             }
         },
-        "ConfirmTwoFactorAuthRequest": {
+        "ExchangeRate": {
             "type": "object",
-            "required": [
-                "otp"
-            ],
             "properties": {
-                "otp": {
+                "from": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "value": {
+                    "type": "string"
+                },
+                "value_scale": {
                     "type": "string"
                 }
             }
         },
-        "ConvertedAddressResponse": {
+        "ExchangeSlug": {
+            "type": "string",
+            "enum": [
+                "htx",
+                "okx",
+                "binance",
+                "bitget",
+                "kucoin",
+                "bybit",
+                "gate",
+                "mexc",
+                "kraken",
+                "coinbase"
+            ],
+            "x-enum-varnames": [
+                "ExchangeSlugHtx",
+                "ExchangeSlugOkx",
+                "ExchangeSlugBinance",
+                "ExchangeSlugBitget",
+                "ExchangeSlugKucoin",
+                "ExchangeSlugBybit",
+                "ExchangeSlugGateio",
+                "ExchangeSlugMexc",
+                "ExchangeSlugKraken",
+                "ExchangeSlugCoinbase"
+            ]
+        },
+        "ExchangeTestConnectionRequest": {
             "type": "object",
+            "required": [
+                "credentials",
+                "slug"
+            ],
             "properties": {
-                "address": {
-                    "type": "string"
+                "credentials": {
+                    "type": "object",
+                    "required": [
+                        "key",
+                        "secret"
+                    ],
+                    "properties": {
+                        "key": {
+                            "type": "string"
+                        },
+                        "passphrase": {
+                            "type": "string"
+                        },
+                        "secret": {
+                            "type": "string"
+                        }
+                    }
                 },
-                "legacy": {
+                "slug": {
                     "type": "string"
                 }
             }
         },
-        "CreateProcessingWithdrawRequest": {
+        "ExchangeToggleSwapsRequest": {
             "type": "object",
             "required": [
-                "address_to",
-                "amount",
-                "currency_id",
-                "request_id"
+                "new_state"
             ],
             "properties": {
-                "address_to": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 16
-                },
-                "amount": {
-                    "type": "number"
-                },
-                "currency_id": {
-                    "type": "string"
-                },
-                "request_id": {
+                "new_state": {
                     "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1
+                    "enum": [
+                        "enabled",
+                        "disabled"
+                    ]
                 }
             }
         },
-        "CreateProcessingWithdrawalInternalRequest": {
+        "ExchangeToggleWithdrawalsRequest": {
             "type": "object",
             "required": [
-                "address_to",
-                "amount",
-                "currency_id",
-                "request_id",
-                "totp"
+                "new_state"
             ],
             "properties": {
-                "address_to": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 16
-                },
-                "amount": {
-                    "type": "number"
-                },
-                "currency_id": {
-                    "type": "string"
-                },
-                "request_id": {
-                    "type": "string"
-                },
-                "totp": {
+                "new_state": {
                     "type": "string",
-                    "maxLength": 6,
-                    "minLength": 6
+                    "enum": [
+                        "enabled",
+                        "disabled"
+                    ]
                 }
             }
         },
-        "CreateSettingRequest": {
+        "ExchangeUpdateKeyData": {
             "type": "object",
             "required": [
-                "name"
+                "name",
+                "value"
             ],
             "properties": {
                 "name": {
                     "type": "string",
                     "maxLength": 255,
-                    "minLength": 2
-                },
-                "otp": {
-                    "type": "string"
+                    "minLength": 1
                 },
                 "value": {
                     "type": "string",
                     "maxLength": 255,
-                    "minLength": 0
+                    "minLength": 1
                 }
             }
         },
-        "CreateStoreRequest": {
+        "ExchangeUpdateKeysRequest": {
             "type": "object",
             "required": [
-                "name"
+                "keys"
             ],
             "properties": {
-                "description": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1
-                },
-                "name": {
-                    "type": "string",
-                    "maxLength": 32,
-                    "minLength": 2
-                },
-                "site": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 5
+                "keys": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ExchangeUpdateKeyData"
+                    }
                 }
             }
         },
-        "CreateStoreWebhookRequest": {
+        "ExchangeUpdatePairsRequest": {
             "type": "object",
             "required": [
-                "events",
-                "url"
+                "pairs"
             ],
             "properties": {
-                "enabled": {
-                    "type": "boolean"
-                },
-                "events": {
+                "pairs": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/WebhookEvent"
+                        "$ref": "#/definitions/ExchangePair"
                     }
-                },
-                "url": {
-                    "type": "string",
-                    "format": "url"
                 }
             }
         },
-        "CreateWalletExternalRequest": {
+        "ExchangeUserPairResponse": {
             "type": "object",
-            "required": [
-                "store_external_id"
-            ],
             "properties": {
-                "amount": {
-                    "type": "number"
-                },
-                "currency": {
+                "display_name": {
                     "type": "string"
                 },
-                "email": {
-                    "type": "string",
-                    "format": "email"
+                "order_type": {
+                    "type": "string"
                 },
-                "ip": {
-                    "type": "string",
-                    "format": "ipv4"
+                "price_offset": {
+                    "type": "string"
                 },
-                "locale": {
+                "time_in_force": {
                     "type": "string"
                 },
-                "store_external_id": {
-                    "type": "string",
-                    "format": "uuid"
+                "twap_slices": {
+                    "type": "integer"
+                },
+                "twap_window_seconds": {
+                    "type": "integer"
                 }
             }
         },
-        "CreateWalletExternalResponse": {
+        "ExchangeWithKeysResponse": {
             "type": "object",
             "properties": {
-                "address": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/WalletAddressResponse"
-                    }
-                },
-                "amount_usd": {
-                    "type": "string"
-                },
-                "created_at": {
-                    "type": "string",
-                    "format": "date-time"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "pay_url": {
+                "current_exchange": {
                     "type": "string"
                 },
-                "rates": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
+                "exchanges": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_dv-net_dv-merchant_internal_delivery_http_responses_exchange_response.ExchangeData"
                     }
                 },
-                "store_external_id": {
+                "swap_state": {
                     "type": "string"
                 },
-                "store_id": {
+                "withdrawal_state": {
                     "type": "string"
-                },
-                "updated_at": {
-                    "type": "string",
-                    "format": "date-time"
                 }
             }
         },
-        "CreateWalletRequest": {
+        "ExchangeWithdrawalHistoryResponse": {
             "type": "object",
-            "required": [
-                "store_external_id",
-                "store_id"
-            ],
             "properties": {
-                "amount": {
-                    "type": "number"
-                },
-                "currency": {
+                "address": {
                     "type": "string"
                 },
-                "email": {
-                    "type": "string",
-                    "format": "email"
+                "amount_native": {
+                    "type": "string"
                 },
-                "locale": {
+                "amount_usd": {
                     "type": "string"
                 },
-                "store_external_id": {
-                    "type": "string",
-                    "format": "uuid"
+                "chain": {
+                    "type": "string"
                 },
-                "store_id": {
+                "created_at": {
                     "type": "string",
-                    "format": "uuid"
-                }
-            }
-        },
-        "CurrenciesExtendedResponse": {
-            "type": "object",
-            "properties": {
-                "blockchains": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/BlockchainGroup"
-                    }
+                    "format": "date-time"
                 },
-                "currencies": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/CurrencyExtendedItem"
-                    }
+                "currency_id": {
+                    "type": "string"
                 },
-                "tokens": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/TokenGroup"
-                    }
-                }
-            }
-        },
-        "CurrencyDTO": {
-            "type": "object",
-            "properties": {
-                "blockchain": {
-                    "$ref": "#/definitions/Blockchain"
+                "exchange_id": {
+                    "type": "string"
                 },
-                "code": {
+                "exchange_slug": {
                     "type": "string"
                 },
-                "contract_address": {
+                "fail_reason": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "is_native": {
-                    "type": "boolean"
-                },
-                "name": {
+                "status": {
                     "type": "string"
                 },
-                "sort_order": {
-                    "type": "integer"
+                "tx_id": {
+                    "type": "string"
                 }
             }
         },
-        "CurrencyExtendedItem": {
+        "ExchangeWithdrawalSettingResponse": {
             "type": "object",
             "properties": {
-                "blockchain": {
+                "address": {
                     "type": "string"
                 },
-                "blockchain_icon": {
-                    "$ref": "#/definitions/CurrencyIcon"
-                },
-                "code": {
+                "chain": {
                     "type": "string"
                 },
-                "contract_address": {
+                "created_at": {
                     "type": "string"
                 },
-                "explorer_link": {
+                "currency_id": {
                     "type": "string"
                 },
-                "has_balance": {
+                "enabled": {
                     "type": "boolean"
                 },
-                "icon": {
-                    "$ref": "#/definitions/CurrencyIcon"
-                },
                 "id": {
                     "type": "string"
                 },
-                "min_confirmation": {
-                    "type": "integer"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "token_icon": {
-                    "$ref": "#/definitions/CurrencyIcon"
+                "min_amount": {
+                    "type": "number"
                 }
             }
         },
-        "CurrencyIcon": {
+        "ExternalExchangeBalanceResponse": {
             "type": "object",
             "properties": {
-                "icon_128": {
-                    "type": "string"
-                },
-                "icon_512": {
-                    "type": "string"
+                "balances": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ExchangeAsset"
+                    }
                 },
-                "icon_svg": {
-                    "type": "string"
+                "total_usd": {
+                    "type": "number"
                 }
             }
         },
-        "CurrencyRate": {
+        "FullPagingData": {
             "type": "object",
             "properties": {
-                "code": {
-                    "type": "string"
+                "last_page": {
+                    "type": "integer"
                 },
-                "rate": {
-                    "type": "string"
+                "page": {
+                    "type": "integer"
                 },
-                "rate_source": {
-                    "type": "string"
+                "page_size": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
                 }
             }
         },
-        "CurrencyShort": {
+        "GetCurrencyResponse": {
             "type": "object",
             "properties": {
                 "blockchain": {
-                    "$ref": "#/definitions/Blockchain"
+                    "type": "string"
+                },
+                "blockchain_icon": {
+                    "$ref": "#/definitions/CurrencyIcon"
                 },
                 "code": {
                     "type": "string"
                 },
-                "id": {
+                "contract_address": {
                     "type": "string"
                 },
-                "is_bitcoin_like": {
-                    "type": "boolean"
+                "explorer_link": {
+                    "type": "string"
                 },
-                "is_evm_like": {
+                "has_balance": {
                     "type": "boolean"
                 },
-                "is_stable_coin": {
+                "icon": {
+                    "$ref": "#/definitions/CurrencyIcon"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_fiat": {
                     "type": "boolean"
                 },
+                "min_confirmation": {
+                    "type": "integer"
+                },
                 "name": {
                     "type": "string"
                 },
                 "precision": {
                     "type": "integer"
+                },
+                "status": {
+                    "type": "boolean"
+                },
+                "withdrawal_min_balance": {
+                    "type": "number"
                 }
             }
         },
-        "DeleteTransferRequest": {
+        "GetDepositAddressesResponse": {
             "type": "object",
-            "required": [
-                "id"
-            ],
             "properties": {
-                "id": {
+                "addresses": {
                     "type": "array",
                     "items": {
-                        "type": "string",
-                        "format": "uuid"
+                        "$ref": "#/definitions/DepositUpdateResponse"
                     }
-                }
-            }
-        },
-        "DeliveryChannel": {
-            "type": "string",
-            "enum": [
-                "email",
-                "telegram"
-            ],
-            "x-enum-varnames": [
-                "EmailDeliveryChannel",
-                "TelegramDeliveryChannel"
-            ]
-        },
-        "DepositUpdateResponse": {
-            "type": "object",
-            "properties": {
-                "address": {
-                    "type": "string"
-                },
-                "address_type": {
-                    "$ref": "#/definitions/github_com_dv-net_dv-merchant_internal_models.AddressType"
-                },
-                "chain": {
-                    "type": "string"
                 },
-                "currency": {
+                "name": {
                     "type": "string"
                 },
-                "min_deposit_amount": {
+                "slug": {
                     "type": "string"
                 }
             }
         },
-        "EVMAddressGroupResponse": {
+        "GetDictionariesResponse": {
             "type": "object",
             "properties": {
-                "address": {
-                    "type": "string"
-                },
-                "blockchains": {
+                "available_aml_providers": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/Blockchain"
+                        "$ref": "#/definitions/github_com_dv-net_dv-merchant_internal_models.AMLProvider"
                     }
                 },
-                "currencies": {
+                "available_currencies": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/AddressBookEntryResponseShort"
+                        "$ref": "#/definitions/CurrencyShort"
                     }
                 },
-                "currency_count": {
-                    "type": "integer"
+                "available_rate_sources": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "is_evm": {
-                    "type": "boolean"
+                "backend_address": {
+                    "type": "string",
+                    "format": "ipv4"
                 },
-                "name": {
+                "backend_version_hash": {
                     "type": "string"
                 },
-                "submitted_at": {
+                "backend_version_tag": {
                     "type": "string"
                 },
-                "tag": {
-                    "type": "string"
-                }
-            }
-        },
-        "ExchangeAsset": {
-            "type": "object",
-            "properties": {
-                "amount": {
-                    "type": "number"
+                "general_settings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/SettingResponse"
+                    }
                 },
-                "amount_usd": {
-                    "type": "number"
+                "processing_version_hash": {
+                    "type": "string"
                 },
-                "currency": {
+                "processing_version_tag": {
                     "type": "string"
                 }
             }
         },
-        "ExchangeBalance": {
+        "GetHotWalletKeysRequest": {
             "type": "object",
+            "required": [
+                "file_type",
+                "totp"
+            ],
             "properties": {
-                "balances": {
+                "exclude_wallet_address_ids": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/ExchangeAsset"
+                        "type": "string"
                     }
                 },
-                "total_usd": {
-                    "type": "number"
-                }
-            }
-        },
-        "ExchangeChain": {
-            "type": "object",
-            "properties": {
-                "chain": {
-                    "type": "string"
-                },
-                "chain_label": {
-                    "type": "string"
-                },
-                "currency_id": {
-                    "type": "string"
+                "file_type": {
+                    "type": "string",
+                    "enum": [
+                        "csv",
+                        "json",
+                        "txt"
+                    ]
                 },
-                "ticker": {
+                "totp": {
                     "type": "string"
                 },
-                "ticker_label": {
-                    "type": "string"
+                "wallet_address_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "ExchangeCreateWithdrawalRequest": {
+        "GetLastLogsResponse": {
             "type": "object",
-            "required": [
-                "address",
-                "chain",
-                "currency_id",
-                "min_amount"
-            ],
             "properties": {
-                "address": {
-                    "type": "string"
-                },
-                "chain": {
-                    "type": "string"
-                },
-                "currency_id": {
-                    "type": "string"
-                },
-                "min_amount": {
-                    "type": "string"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_dv-net_dv-merchant_internal_dto.LogDTO"
+                    }
                 }
             }
         },
-        "ExchangeGetWithdrawalsExportedRequest": {
+        "GetMonitorTypesResponse": {
             "type": "object",
-            "required": [
-                "format"
-            ],
             "properties": {
-                "currency_id": {
-                    "type": "string"
-                },
-                "date_from": {
-                    "type": "string"
-                },
-                "date_to": {
-                    "type": "string"
-                },
-                "format": {
-                    "type": "string",
-                    "enum": [
-                        "csv",
-                        "xlsx"
-                    ]
-                },
-                "slug": {
-                    "type": "string"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_dv-net_dv-merchant_internal_delivery_http_responses_log_response.LogData"
+                    }
                 }
             }
         },
-        "ExchangeGetWithdrawalsRequest": {
+        "GetPrefetchDataResponse": {
             "type": "object",
             "properties": {
-                "currency_id": {
-                    "type": "string"
-                },
-                "date_from": {
-                    "type": "string"
+                "amount": {
+                    "type": "number"
                 },
-                "date_to": {
-                    "type": "string"
+                "amount_usd": {
+                    "type": "number"
                 },
-                "page": {
-                    "type": "integer",
-                    "minimum": 1
+                "currency": {
+                    "$ref": "#/definitions/CurrencyShort"
                 },
-                "page_size": {
-                    "type": "integer",
-                    "maximum": 100,
-                    "minimum": 1
+                "from_addresses": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "slug": {
-                    "type": "string"
-                }
-            }
-        },
-        "ExchangeKeyData": {
-            "type": "object",
-            "properties": {
-                "name": {
-                    "type": "string"
+                "to_addresses": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "value": {
-                    "type": "string"
+                "type": {
+                    "$ref": "#/definitions/TransferKind"
                 }
             }
         },
-        "ExchangeOrderHistoryResponse": {
+        "GetTransferResponse": {
             "type": "object",
             "properties": {
                 "amount": {
-                    "type": "string"
+                    "type": "number"
                 },
                 "amount_usd": {
-                    "type": "string"
-                },
-                "client_order_id": {
-                    "type": "string"
+                    "type": "number"
                 },
                 "created_at": {
                     "type": "string",
                     "format": "date-time"
                 },
-                "exchange_id": {
+                "currency_id": {
                     "type": "string"
                 },
-                "exchange_order_id": {
-                    "type": "string"
+                "from_addresses": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "exchange_slug": {
-                    "type": "string"
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
                 },
-                "fail_reason": {
-                    "type": "string"
+                "kind": {
+                    "$ref": "#/definitions/TransferKind"
                 },
-                "id": {
+                "message": {
                     "type": "string"
                 },
-                "side": {
-                    "type": "string"
+                "number": {
+                    "type": "integer"
+                },
+                "stage": {
+                    "$ref": "#/definitions/TransferStage"
                 },
                 "status": {
+                    "$ref": "#/definitions/TransferStatus"
+                },
+                "step": {
                     "type": "string"
                 },
-                "symbol": {
+                "to_addresses": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "tx_hash": {
                     "type": "string"
                 },
+                "updated_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
                 "user_id": {
-                    "type": "string"
+                    "type": "string",
+                    "format": "uuid"
                 }
             }
         },
-        "ExchangePair": {
+        "GetTwoFactorAuthDataResponse": {
             "type": "object",
-            "required": [
-                "base_symbol",
-                "quote_symbol",
-                "symbol",
-                "type"
-            ],
             "properties": {
-                "base_symbol": {
-                    "type": "string"
-                },
-                "quote_symbol": {
-                    "type": "string"
-                },
-                "symbol": {
-                    "type": "string"
+                "is_confirmed": {
+                    "type": "boolean"
                 },
-                "type": {
+                "secret": {
                     "type": "string"
                 }
             }
         },
-        "ExchangeRate": {
+        "GetUserInfoResponse": {
             "type": "object",
+            "required": [
+                "email",
+                "location"
+            ],
             "properties": {
-                "from": {
-                    "type": "string"
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
                 },
-                "source": {
+                "email": {
+                    "type": "string",
+                    "format": "email"
+                },
+                "email_verified_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "language": {
                     "type": "string"
                 },
-                "to": {
+                "location": {
                     "type": "string"
                 },
-                "updated_at": {
+                "processing_owner_id": {
                     "type": "string",
-                    "format": "date-time"
+                    "format": "uuid"
                 },
-                "value": {
+                "quick_start_guide_status": {
                     "type": "string"
                 },
-                "value_scale": {
+                "rate_scale": {
+                    "type": "number"
+                },
+                "rate_source": {
                     "type": "string"
-                }
-            }
-        },
-        "ExchangeSlug": {
-            "type": "string",
-            "enum": [
-                "htx",
-                "okx",
-                "binance",
-                "bitget",
-                "kucoin",
-                "bybit",
-                "gate"
-            ],
-            "x-enum-varnames": [
-                "ExchangeSlugHtx",
-                "ExchangeSlugOkx",
-                "ExchangeSlugBinance",
-                "ExchangeSlugBitget",
-                "ExchangeSlugKucoin",
-                "ExchangeSlugBybit",
-                "ExchangeSlugGateio"
-            ]
-        },
-        "ExchangeTestConnectionRequest": {
-            "type": "object",
-            "required": [
-                "credentials",
-                "slug"
-            ],
-            "properties": {
-                "credentials": {
-                    "type": "object",
-                    "required": [
-                        "key",
-                        "secret"
-                    ],
-                    "properties": {
-                        "key": {
-                            "type": "string"
-                        },
-                        "passphrase": {
-                            "type": "string"
-                        },
-                        "secret": {
-                            "type": "string"
-                        }
+                },
+                "roles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/UserRole"
                     }
                 },
-                "slug": {
-                    "type": "string"
-                }
-            }
-        },
-        "ExchangeToggleSwapsRequest": {
-            "type": "object",
-            "required": [
-                "new_state"
-            ],
-            "properties": {
-                "new_state": {
+                "two_fa_reset_expires_at": {
                     "type": "string",
-                    "enum": [
-                        "enabled",
-                        "disabled"
-                    ]
-                }
-            }
-        },
-        "ExchangeToggleWithdrawalsRequest": {
-            "type": "object",
-            "required": [
-                "new_state"
-            ],
-            "properties": {
-                "new_state": {
+                    "format": "date-time"
+                },
+                "updated_at": {
                     "type": "string",
-                    "enum": [
-                        "enabled",
-                        "disabled"
-                    ]
+                    "format": "date-time"
                 }
             }
         },
-        "ExchangeUpdateKeyData": {
+        "GetUsersResponse": {
             "type": "object",
-            "required": [
-                "name",
-                "value"
-            ],
             "properties": {
-                "name": {
+                "banned": {
+                    "type": "boolean"
+                },
+                "created_at": {
                     "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1
+                    "format": "date-time"
                 },
-                "value": {
+                "email": {
                     "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1
-                }
-            }
-        },
-        "ExchangeUpdateKeysRequest": {
-            "type": "object",
-            "required": [
-                "keys"
-            ],
-            "properties": {
-                "keys": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/ExchangeUpdateKeyData"
-                    }
-                }
-            }
-        },
-        "ExchangeUpdatePairsRequest": {
-            "type": "object",
-            "required": [
-                "pairs"
-            ],
-            "properties": {
-                "pairs": {
+                    "format": "email"
+                },
+                "roles": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/ExchangePair"
-                    }
-                }
-            }
-        },
-        "ExchangeUserPairResponse": {
-            "type": "object",
-            "properties": {
-                "display_name": {
-                    "type": "string"
+                        "type": "string",
+                        "enum": [
+                            "root",
+                            "user"
+                        ]
+                    }
+                },
+                "user_id": {
+                    "type": "string",
+                    "format": "uuid"
                 }
             }
         },
-        "ExchangeWithKeysResponse": {
+        "GetWalletBalanceResponse": {
             "type": "object",
             "properties": {
-                "current_exchange": {
+                "address": {
                     "type": "string"
                 },
-                "exchanges": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_dv-net_dv-merchant_internal_delivery_http_responses_exchange_response.ExchangeData"
-                    }
+                "amount": {
+                    "type": "number"
                 },
-                "swap_state": {
+                "amount_usd": {
+                    "type": "number"
+                },
+                "blockchain": {
+                    "$ref": "#/definitions/Blockchain"
+                },
+                "currency_id": {
                     "type": "string"
                 },
-                "withdrawal_state": {
+                "dirty": {
+                    "type": "boolean"
+                },
+                "id": {
                     "type": "string"
                 }
             }
         },
-        "ExchangeWithdrawalHistoryResponse": {
+        "GetWalletByStoreRequest": {
             "type": "object",
+            "required": [
+                "store_ids"
+            ],
             "properties": {
                 "address": {
                     "type": "string"
                 },
-                "amount_native": {
-                    "type": "string"
+                "amount": {
+                    "type": "number"
                 },
-                "amount_usd": {
-                    "type": "string"
+                "balance_fiat_from": {
+                    "type": "number"
                 },
-                "chain": {
-                    "type": "string"
+                "balance_fiat_to": {
+                    "type": "number"
                 },
-                "created_at": {
+                "blockchain": {
                     "type": "string",
-                    "format": "date-time"
+                    "enum": [
+                        "bitcoin",
+                        "tron",
+                        "ethereum",
+                        "litecoin"
+                    ]
                 },
                 "currency_id": {
                     "type": "string"
                 },
-                "exchange_id": {
-                    "type": "string"
+                "is_dirty": {
+                    "type": "boolean"
                 },
-                "exchange_slug": {
-                    "type": "string"
+                "is_sort_by_amount": {
+                    "type": "boolean"
                 },
-                "fail_reason": {
-                    "type": "string"
+                "is_sort_by_balance": {
+                    "type": "boolean"
                 },
-                "id": {
-                    "type": "string"
+                "page": {
+                    "type": "integer",
+                    "minimum": 1
                 },
-                "status": {
-                    "type": "string"
+                "page_size": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1
                 },
-                "tx_id": {
-                    "type": "string"
+                "store_ids": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "wallet_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "ExchangeWithdrawalSettingResponse": {
+        "InitProcessingResponse": {
             "type": "object",
             "properties": {
-                "address": {
-                    "type": "string"
-                },
-                "chain": {
-                    "type": "string"
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "currency_id": {
-                    "type": "string"
+                "base_url": {
+                    "type": "string",
+                    "format": "uri"
                 },
-                "enabled": {
-                    "type": "boolean"
+                "processing_client_id": {
+                    "type": "string",
+                    "format": "uuid"
                 },
-                "id": {
+                "processing_client_key": {
                     "type": "string"
-                },
-                "min_amount": {
-                    "type": "number"
                 }
             }
         },
-        "ExternalExchangeBalanceResponse": {
+        "InitializeProcessingRequest": {
             "type": "object",
+            "required": [
+                "callback_domain",
+                "host",
+                "merchant_domain",
+                "merchant_pay_form_domain"
+            ],
             "properties": {
-                "balances": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/ExchangeAsset"
-                    }
+                "callback_domain": {
+                    "type": "string"
                 },
-                "total_usd": {
-                    "type": "number"
+                "host": {
+                    "description": "Host (http://domain.example:574 or https://127.0.0.1:999)",
+                    "type": "string"
+                },
+                "merchant_domain": {
+                    "type": "string"
+                },
+                "merchant_pay_form_domain": {
+                    "type": "string"
                 }
             }
         },
-        "FullPagingData": {
+        "InviteUserWithRoleRequest": {
             "type": "object",
+            "required": [
+                "email",
+                "mnemonic",
+                "role",
+                "store_ids"
+            ],
             "properties": {
-                "last_page": {
-                    "type": "integer"
+                "email": {
+                    "type": "string",
+                    "format": "email"
                 },
-                "page": {
-                    "type": "integer"
+                "mnemonic": {
+                    "type": "string"
                 },
-                "page_size": {
-                    "type": "integer"
+                "role": {
+                    "$ref": "#/definitions/UserRole"
                 },
-                "total": {
-                    "type": "integer"
+                "store_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "GetCurrencyResponse": {
+        "InvoiceResponse": {
             "type": "object",
             "properties": {
-                "blockchain": {
+                "address": {
                     "type": "string"
                 },
-                "blockchain_icon": {
-                    "$ref": "#/definitions/CurrencyIcon"
+                "amount": {
+                    "type": "number"
                 },
-                "code": {
-                    "type": "string"
+                "amount_usd": {
+                    "type": "number"
                 },
-                "contract_address": {
-                    "type": "string"
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
                 },
-                "explorer_link": {
+                "credit_amount_usd": {
+                    "type": "number"
+                },
+                "currency_id": {
                     "type": "string"
                 },
-                "has_balance": {
-                    "type": "boolean"
+                "description": {
+                    "type": "string"
                 },
-                "icon": {
-                    "$ref": "#/definitions/CurrencyIcon"
+                "expires_at": {
+                    "type": "string",
+                    "format": "date-time"
                 },
                 "id": {
-                    "type": "string"
+                    "type": "string",
+                    "format": "uuid"
                 },
-                "is_fiat": {
-                    "type": "boolean"
+                "leftover_action": {
+                    "$ref": "#/definitions/LeftoverAction"
                 },
-                "min_confirmation": {
-                    "type": "integer"
+                "leftover_amount_usd": {
+                    "type": "number"
                 },
-                "name": {
+                "order_id": {
                     "type": "string"
                 },
-                "precision": {
-                    "type": "integer"
+                "paid_amount": {
+                    "type": "number"
+                },
+                "paid_amount_usd": {
+                    "type": "number"
+                },
+                "paid_at": {
+                    "type": "string",
+                    "format": "date-time"
                 },
                 "status": {
-                    "type": "boolean"
+                    "$ref": "#/definitions/InvoiceStatus"
                 },
-                "withdrawal_min_balance": {
-                    "type": "number"
+                "store_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "wallet_id": {
+                    "type": "string",
+                    "format": "uuid"
                 }
             }
         },
-        "GetDepositAddressesResponse": {
+        "InvoiceStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "partially_paid",
+                "paid",
+                "overpaid",
+                "expired",
+                "cancelled"
+            ],
+            "x-enum-varnames": [
+                "InvoiceStatusPending",
+                "InvoiceStatusPartiallyPaid",
+                "InvoiceStatusPaid",
+                "InvoiceStatusOverpaid",
+                "InvoiceStatusExpired",
+                "InvoiceStatusCancelled"
+            ]
+        },
+        "InvoiceStatusHistoryResponse": {
             "type": "object",
             "properties": {
-                "addresses": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/DepositUpdateResponse"
-                    }
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
                 },
-                "name": {
-                    "type": "string"
+                "status_from": {
+                    "$ref": "#/definitions/InvoiceStatus"
                 },
-                "slug": {
-                    "type": "string"
+                "status_to": {
+                    "$ref": "#/definitions/InvoiceStatus"
+                },
+                "transaction_id": {
+                    "type": "string",
+                    "format": "uuid"
                 }
             }
         },
-        "GetDictionariesResponse": {
+        "InvoiceWithHistoryResponse": {
             "type": "object",
             "properties": {
-                "available_aml_providers": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_dv-net_dv-merchant_internal_models.AMLProvider"
-                    }
+                "address": {
+                    "type": "string"
                 },
-                "available_currencies": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/CurrencyShort"
-                    }
+                "amount": {
+                    "type": "number"
                 },
-                "available_rate_sources": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "amount_usd": {
+                    "type": "number"
                 },
-                "backend_address": {
+                "created_at": {
                     "type": "string",
-                    "format": "ipv4"
+                    "format": "date-time"
                 },
-                "backend_version_hash": {
+                "credit_amount_usd": {
+                    "type": "number"
+                },
+                "currency_id": {
                     "type": "string"
                 },
-                "backend_version_tag": {
+                "description": {
                     "type": "string"
                 },
-                "general_settings": {
+                "expires_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "history": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/SettingResponse"
+                        "$ref": "#/definitions/InvoiceStatusHistoryResponse"
                     }
                 },
-                "processing_version_hash": {
-                    "type": "string"
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
                 },
-                "processing_version_tag": {
+                "leftover_action": {
+                    "$ref": "#/definitions/LeftoverAction"
+                },
+                "leftover_amount_usd": {
+                    "type": "number"
+                },
+                "order_id": {
                     "type": "string"
+                },
+                "paid_amount": {
+                    "type": "number"
+                },
+                "paid_amount_usd": {
+                    "type": "number"
+                },
+                "paid_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "status": {
+                    "$ref": "#/definitions/InvoiceStatus"
+                },
+                "store_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "wallet_id": {
+                    "type": "string",
+                    "format": "uuid"
                 }
             }
         },
-        "GetHotWalletKeysRequest": {
+        "JSONResponse-AddUserRoleResponse": {
             "type": "object",
-            "required": [
-                "file_type",
-                "totp"
-            ],
             "properties": {
-                "exclude_wallet_address_ids": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "code": {
+                    "type": "integer"
                 },
-                "file_type": {
-                    "type": "string",
-                    "enum": [
-                        "csv",
-                        "json",
-                        "txt"
-                    ]
+                "data": {
+                    "$ref": "#/definitions/AddUserRoleResponse"
                 },
-                "totp": {
+                "message": {
                     "type": "string"
-                },
-                "wallet_address_ids": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
                 }
             }
         },
-        "GetLastLogsResponse": {
+        "JSONResponse-AddressBookEntryResponse": {
             "type": "object",
             "properties": {
-                "items": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_dv-net_dv-merchant_internal_dto.LogDTO"
-                    }
+                "code": {
+                    "type": "integer"
+                },
+                "data": {
+                    "$ref": "#/definitions/AddressBookEntryResponse"
+                },
+                "message": {
+                    "type": "string"
                 }
             }
         },
-        "GetMonitorTypesResponse": {
+        "JSONResponse-AddressBookListResponse": {
             "type": "object",
             "properties": {
-                "items": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_dv-net_dv-merchant_internal_delivery_http_responses_log_response.LogData"
-                    }
+                "code": {
+                    "type": "integer"
+                },
+                "data": {
+                    "$ref": "#/definitions/AddressBookListResponse"
+                },
+                "message": {
+                    "type": "string"
                 }
             }
         },
-        "GetPrefetchDataResponse": {
+        "JSONResponse-AmlSettingsResponse": {
             "type": "object",
             "properties": {
-                "amount": {
-                    "type": "number"
-                },
-                "amount_usd": {
-                    "type": "number"
-                },
-                "currency": {
-                    "$ref": "#/definitions/CurrencyShort"
-                },
-                "from_addresses": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "code": {
+                    "type": "integer"
                 },
-                "to_addresses": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "data": {
+                    "$ref": "#/definitions/AmlSettingsResponse"
                 },
-                "type": {
-                    "$ref": "#/definitions/TransferKind"
+                "message": {
+                    "type": "string"
                 }
             }
         },
-        "GetTransferResponse": {
+        "JSONResponse-AuthLinkResponse": {
             "type": "object",
             "properties": {
-                "amount": {
-                    "type": "number"
-                },
-                "amount_usd": {
-                    "type": "number"
+                "code": {
+                    "type": "integer"
                 },
-                "created_at": {
-                    "type": "string",
-                    "format": "date-time"
+                "data": {
+                    "$ref": "#/definitions/AuthLinkResponse"
                 },
-                "currency_id": {
+                "message": {
                     "type": "string"
+                }
+            }
+        },
+        "JSONResponse-AuthResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
                 },
-                "from_addresses": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "data": {
+                    "$ref": "#/definitions/AuthResponse"
                 },
-                "id": {
-                    "type": "string",
-                    "format": "uuid"
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "JSONResponse-BanUserResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
                 },
-                "kind": {
-                    "$ref": "#/definitions/TransferKind"
+                "data": {
+                    "$ref": "#/definitions/BanUserResponse"
                 },
                 "message": {
                     "type": "string"
-                },
-                "number": {
+                }
+            }
+        },
+        "JSONResponse-CallbackURLResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
                     "type": "integer"
                 },
-                "stage": {
-                    "$ref": "#/definitions/TransferStage"
-                },
-                "status": {
-                    "$ref": "#/definitions/TransferStatus"
+                "data": {
+                    "$ref": "#/definitions/CallbackURLResponse"
                 },
-                "step": {
+                "message": {
                     "type": "string"
+                }
+            }
+        },
+        "JSONResponse-ConvertedAddressResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
                 },
-                "to_addresses": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "data": {
+                    "$ref": "#/definitions/ConvertedAddressResponse"
                 },
-                "tx_hash": {
+                "message": {
                     "type": "string"
+                }
+            }
+        },
+        "JSONResponse-CreateWalletExternalResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
                 },
-                "updated_at": {
-                    "type": "string",
-                    "format": "date-time"
-                },
-                "user_id": {
-                    "type": "string",
-                    "format": "uuid"
+                "data": {
+                    "$ref": "#/definitions/CreateWalletExternalResponse"
+                },
+                "message": {
+                    "type": "string"
                 }
             }
         },
-        "GetTwoFactorAuthDataResponse": {
+        "JSONResponse-CurrenciesExtendedResponse": {
             "type": "object",
             "properties": {
-                "is_confirmed": {
-                    "type": "boolean"
+                "code": {
+                    "type": "integer"
                 },
-                "secret": {
+                "data": {
+                    "$ref": "#/definitions/CurrenciesExtendedResponse"
+                },
+                "message": {
                     "type": "string"
                 }
             }
         },
-        "GetUserInfoResponse": {
+        "JSONResponse-CurrencyRate": {
             "type": "object",
-            "required": [
-                "email",
-                "location"
-            ],
             "properties": {
-                "created_at": {
-                    "type": "string",
-                    "format": "date-time"
-                },
-                "email": {
-                    "type": "string",
-                    "format": "email"
-                },
-                "email_verified_at": {
-                    "type": "string",
-                    "format": "date-time"
-                },
-                "id": {
-                    "type": "string",
-                    "format": "uuid"
+                "code": {
+                    "type": "integer"
                 },
-                "language": {
-                    "type": "string"
+                "data": {
+                    "$ref": "#/definitions/CurrencyRate"
                 },
-                "location": {
+                "message": {
                     "type": "string"
+                }
+            }
+        },
+        "JSONResponse-ExchangeBalance": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
                 },
-                "processing_owner_id": {
-                    "type": "string",
-                    "format": "uuid"
+                "data": {
+                    "$ref": "#/definitions/ExchangeBalance"
                 },
-                "quick_start_guide_status": {
+                "message": {
                     "type": "string"
+                }
+            }
+        },
+        "JSONResponse-ExchangeKeyPermissionsResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
                 },
-                "rate_scale": {
-                    "type": "number"
+                "data": {
+                    "$ref": "#/definitions/ExchangeKeyPermissionsResponse"
                 },
-                "rate_source": {
+                "message": {
                     "type": "string"
-                },
-                "roles": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/UserRole"
-                    }
-                },
-                "two_fa_reset_expires_at": {
-                    "type": "string",
-                    "format": "date-time"
-                },
-                "updated_at": {
-                    "type": "string",
-                    "format": "date-time"
                 }
             }
         },
-        "GetUsersResponse": {
+        "JSONResponse-ExchangeRate": {
             "type": "object",
             "properties": {
-                "banned": {
-                    "type": "boolean"
-                },
-                "created_at": {
-                    "type": "string",
-                    "format": "date-time"
-                },
-                "email": {
-                    "type": "string",
-                    "format": "email"
+                "code": {
+                    "type": "integer"
                 },
-                "roles": {
-                    "type": "array",
-                    "items": {
-                        "type": "string",
-                        "enum": [
-                            "root",
-                            "user"
-                        ]
-                    }
+                "data": {
+                    "$ref": "#/definitions/ExchangeRate"
                 },
-                "user_id": {
-                    "type": "string",
-                    "format": "uuid"
+                "message": {
+                    "type": "string"
                 }
             }
         },
-        "GetWalletBalanceResponse": {
+        "JSONResponse-ExchangeUserPairResponse": {
             "type": "object",
             "properties": {
-                "address": {
-                    "type": "string"
-                },
-                "amount": {
-                    "type": "number"
-                },
-                "amount_usd": {
-                    "type": "number"
+                "code": {
+                    "type": "integer"
                 },
-                "blockchain": {
-                    "$ref": "#/definitions/Blockchain"
+                "data": {
+                    "$ref": "#/definitions/ExchangeUserPairResponse"
                 },
-                "currency_id": {
+                "message": {
                     "type": "string"
+                }
+            }
+        },
+        "JSONResponse-ExchangeWithKeysResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
                 },
-                "dirty": {
-                    "type": "boolean"
+                "data": {
+                    "$ref": "#/definitions/ExchangeWithKeysResponse"
                 },
-                "id": {
+                "message": {
                     "type": "string"
                 }
             }
         },
-        "GetWalletByStoreRequest": {
+        "JSONResponse-ExchangeWithdrawalHistoryResponse": {
             "type": "object",
-            "required": [
-                "store_ids"
-            ],
             "properties": {
-                "address": {
-                    "type": "string"
-                },
-                "amount": {
-                    "type": "number"
-                },
-                "balance_fiat_from": {
-                    "type": "number"
-                },
-                "balance_fiat_to": {
-                    "type": "number"
+                "code": {
+                    "type": "integer"
                 },
-                "blockchain": {
-                    "type": "string",
-                    "enum": [
-                        "bitcoin",
-                        "tron",
-                        "ethereum",
-                        "litecoin"
-                    ]
+                "data": {
+                    "$ref": "#/definitions/ExchangeWithdrawalHistoryResponse"
                 },
-                "currency_id": {
+                "message": {
                     "type": "string"
+                }
+            }
+        },
+        "JSONResponse-ExchangeWithdrawalSettingResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
                 },
-                "is_dirty": {
-                    "type": "boolean"
-                },
-                "is_sort_by_amount": {
-                    "type": "boolean"
-                },
-                "is_sort_by_balance": {
-                    "type": "boolean"
-                },
-                "page": {
-                    "type": "integer",
-                    "minimum": 1
-                },
-                "page_size": {
-                    "type": "integer",
-                    "maximum": 100,
-                    "minimum": 1
-                },
-                "store_ids": {
-                    "type": "array",
-                    "minItems": 1,
-                    "items": {
-                        "type": "string"
-                    }
+                "data": {
+                    "$ref": "#/definitions/ExchangeWithdrawalSettingResponse"
                 },
-                "wallet_ids": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "message": {
+                    "type": "string"
                 }
             }
         },
-        "InitProcessingResponse": {
+        "JSONResponse-ExternalExchangeBalanceResponse": {
             "type": "object",
             "properties": {
-                "base_url": {
-                    "type": "string",
-                    "format": "uri"
+                "code": {
+                    "type": "integer"
                 },
-                "processing_client_id": {
-                    "type": "string",
-                    "format": "uuid"
+                "data": {
+                    "$ref": "#/definitions/ExternalExchangeBalanceResponse"
                 },
-                "processing_client_key": {
+                "message": {
                     "type": "string"
                 }
             }
         },
-        "InitializeProcessingRequest": {
+        "JSONResponse-ExternalProcessingWalletBalanceResponse": {
             "type": "object",
-            "required": [
-                "callback_domain",
-                "host",
-                "merchant_domain",
-                "merchant_pay_form_domain"
-            ],
             "properties": {
-                "callback_domain": {
-                    "type": "string"
+                "code": {
+                    "type": "integer"
                 },
-                "host": {
-                    "description": "Host (http://domain.example:574 or https://127.0.0.1:999)",
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ProcessingWalletWithAssets"
+                    }
+                },
+                "message": {
                     "type": "string"
+                }
+            }
+        },
+        "JSONResponse-GetCurrencyResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
                 },
-                "merchant_domain": {
-                    "type": "string"
+                "data": {
+                    "$ref": "#/definitions/GetCurrencyResponse"
                 },
-                "merchant_pay_form_domain": {
+                "message": {
                     "type": "string"
                 }
             }
         },
-        "InviteUserWithRoleRequest": {
+        "JSONResponse-GetDictionariesResponse": {
             "type": "object",
-            "required": [
-                "email",
-                "mnemonic",
-                "role",
-                "store_ids"
-            ],
             "properties": {
-                "email": {
-                    "type": "string",
-                    "format": "email"
-                },
-                "mnemonic": {
-                    "type": "string"
+                "code": {
+                    "type": "integer"
                 },
-                "role": {
-                    "$ref": "#/definitions/UserRole"
+                "data": {
+                    "$ref": "#/definitions/GetDictionariesResponse"
                 },
-                "store_ids": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "message": {
+                    "type": "string"
                 }
             }
         },
-        "JSONResponse-AddUserRoleResponse": {
+        "JSONResponse-GetLastLogsResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/AddUserRoleResponse"
+                    "$ref": "#/definitions/GetLastLogsResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-AddressBookEntryResponse": {
+        "JSONResponse-GetMonitorTypesResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/AddressBookEntryResponse"
+                    "$ref": "#/definitions/GetMonitorTypesResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-AddressBookListResponse": {
+        "JSONResponse-GetTwoFactorAuthDataResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/AddressBookListResponse"
+                    "$ref": "#/definitions/GetTwoFactorAuthDataResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-AmlSettingsResponse": {
+        "JSONResponse-GetUserInfoResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/AmlSettingsResponse"
+                    "$ref": "#/definitions/GetUserInfoResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-AuthLinkResponse": {
+        "JSONResponse-InitProcessingResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/AuthLinkResponse"
+                    "$ref": "#/definitions/InitProcessingResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-AuthResponse": {
+        "JSONResponse-InvoiceResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/AuthResponse"
+                    "$ref": "#/definitions/InvoiceResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-BanUserResponse": {
+        "JSONResponse-InvoiceWithHistoryResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/BanUserResponse"
+                    "$ref": "#/definitions/InvoiceWithHistoryResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-CallbackURLResponse": {
+        "JSONResponse-NotificationTypeListResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/CallbackURLResponse"
+                    "$ref": "#/definitions/NotificationTypeListResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-ConvertedAddressResponse": {
+        "JSONResponse-OwnerData": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/ConvertedAddressResponse"
+                    "$ref": "#/definitions/OwnerData"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-CreateWalletExternalResponse": {
+        "JSONResponse-OwnerProcessingResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/CreateWalletExternalResponse"
+                    "$ref": "#/definitions/OwnerProcessingResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-CurrenciesExtendedResponse": {
+        "JSONResponse-ProcessingListResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/CurrenciesExtendedResponse"
+                    "$ref": "#/definitions/ProcessingListResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-CurrencyRate": {
+        "JSONResponse-ProcessingWithdrawalResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/CurrencyRate"
+                    "$ref": "#/definitions/ProcessingWithdrawalResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-ExchangeBalance": {
+        "JSONResponse-PublicGetWalletResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/ExchangeBalance"
+                    "$ref": "#/definitions/PublicGetWalletResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-ExchangeUserPairResponse": {
+        "JSONResponse-PublicRateQuote": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/ExchangeUserPairResponse"
+                    "$ref": "#/definitions/PublicRateQuote"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-ExchangeWithKeysResponse": {
+        "JSONResponse-ReceiptResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/ExchangeWithKeysResponse"
+                    "$ref": "#/definitions/ReceiptResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-ExchangeWithdrawalHistoryResponse": {
+        "JSONResponse-RefundResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/ExchangeWithdrawalHistoryResponse"
+                    "$ref": "#/definitions/RefundResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-ExchangeWithdrawalSettingResponse": {
+        "JSONResponse-RefundWithHistoryResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/ExchangeWithdrawalSettingResponse"
+                    "$ref": "#/definitions/RefundWithHistoryResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-ExternalExchangeBalanceResponse": {
+        "JSONResponse-RegisterRootResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/ExternalExchangeBalanceResponse"
+                    "$ref": "#/definitions/RegisterRootResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-ExternalProcessingWalletBalanceResponse": {
+        "JSONResponse-RegisterUserResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/ProcessingWalletWithAssets"
-                    }
+                    "$ref": "#/definitions/RegisterUserResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-GetCurrencyResponse": {
+        "JSONResponse-RequeueWebhookDeadLettersResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/GetCurrencyResponse"
+                    "$ref": "#/definitions/RequeueWebhookDeadLettersResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-GetDictionariesResponse": {
+        "JSONResponse-ResponseWithFullPagination-AmlHistoryResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/GetDictionariesResponse"
+                    "$ref": "#/definitions/ResponseWithFullPagination-AmlHistoryResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-GetLastLogsResponse": {
+        "JSONResponse-ResponseWithFullPagination-ExchangeOrderHistoryResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/GetLastLogsResponse"
+                    "$ref": "#/definitions/ResponseWithFullPagination-ExchangeOrderHistoryResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-GetMonitorTypesResponse": {
+        "JSONResponse-ResponseWithFullPagination-ExchangeWithdrawalHistoryResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/GetMonitorTypesResponse"
+                    "$ref": "#/definitions/ResponseWithFullPagination-ExchangeWithdrawalHistoryResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-GetTwoFactorAuthDataResponse": {
+        "JSONResponse-ResponseWithFullPagination-GetTransferResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/GetTwoFactorAuthDataResponse"
+                    "$ref": "#/definitions/ResponseWithFullPagination-GetTransferResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-GetUserInfoResponse": {
+        "JSONResponse-ResponseWithFullPagination-GetUsersResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/GetUserInfoResponse"
+                    "$ref": "#/definitions/ResponseWithFullPagination-GetUsersResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-InitProcessingResponse": {
+        "JSONResponse-ResponseWithFullPagination-GetWalletBalanceResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/InitProcessingResponse"
+                    "$ref": "#/definitions/ResponseWithFullPagination-GetWalletBalanceResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-NotificationTypeListResponse": {
+        "JSONResponse-ResponseWithFullPagination-InvoiceResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/NotificationTypeListResponse"
+                    "$ref": "#/definitions/ResponseWithFullPagination-InvoiceResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-OwnerData": {
+        "JSONResponse-ResponseWithFullPagination-NotificationHistoryResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/OwnerData"
+                    "$ref": "#/definitions/ResponseWithFullPagination-NotificationHistoryResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-OwnerProcessingResponse": {
+        "JSONResponse-ResponseWithFullPagination-RefundResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/OwnerProcessingResponse"
+                    "$ref": "#/definitions/ResponseWithFullPagination-RefundResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-ProcessingListResponse": {
+        "JSONResponse-ResponseWithFullPagination-StoreResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/ProcessingListResponse"
+                    "$ref": "#/definitions/ResponseWithFullPagination-StoreResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-ProcessingWithdrawalResponse": {
+        "JSONResponse-ResponseWithFullPagination-WebhookDeadLetterResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/ProcessingWithdrawalResponse"
+                    "$ref": "#/definitions/ResponseWithFullPagination-WebhookDeadLetterResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-PublicGetWalletResponse": {
+        "JSONResponse-ResponseWithFullPagination-WithdrawalApprovalResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/PublicGetWalletResponse"
+                    "$ref": "#/definitions/ResponseWithFullPagination-WithdrawalApprovalResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-ReceiptResponse": {
+        "JSONResponse-ResponseWithFullPagination-github_com_dv-net_dv-merchant_internal_storage_repos_repo_transactions_FindRow": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/ReceiptResponse"
+                    "$ref": "#/definitions/ResponseWithFullPagination-github_com_dv-net_dv-merchant_internal_storage_repos_repo_transactions_FindRow"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-RegisterRootResponse": {
+        "JSONResponse-SearchByCriteriaResponse-any": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/RegisterRootResponse"
+                    "$ref": "#/definitions/SearchByCriteriaResponse-any"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-RegisterUserResponse": {
+        "JSONResponse-SendTestResult": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/RegisterUserResponse"
+                    "$ref": "#/definitions/SendTestResult"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-ResponseWithFullPagination-AmlHistoryResponse": {
+        "JSONResponse-SettingResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/ResponseWithFullPagination-AmlHistoryResponse"
+                    "$ref": "#/definitions/SettingResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-ResponseWithFullPagination-ExchangeOrderHistoryResponse": {
+        "JSONResponse-ShortTransactionInfoListResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/ResponseWithFullPagination-ExchangeOrderHistoryResponse"
+                    "$ref": "#/definitions/ShortTransactionInfoListResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-ResponseWithFullPagination-ExchangeWithdrawalHistoryResponse": {
+        "JSONResponse-StoreAPIKeyResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/ResponseWithFullPagination-ExchangeWithdrawalHistoryResponse"
+                    "$ref": "#/definitions/StoreAPIKeyResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-ResponseWithFullPagination-GetTransferResponse": {
+        "JSONResponse-StorePaymentPolicyResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/ResponseWithFullPagination-GetTransferResponse"
+                    "$ref": "#/definitions/StorePaymentPolicyResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-ResponseWithFullPagination-GetUsersResponse": {
+        "JSONResponse-StoreResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/ResponseWithFullPagination-GetUsersResponse"
+                    "$ref": "#/definitions/StoreResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-ResponseWithFullPagination-GetWalletBalanceResponse": {
+        "JSONResponse-StoreSecretResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/ResponseWithFullPagination-GetWalletBalanceResponse"
+                    "$ref": "#/definitions/StoreSecretResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-ResponseWithFullPagination-NotificationHistoryResponse": {
+        "JSONResponse-StoreWebhookResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/ResponseWithFullPagination-NotificationHistoryResponse"
+                    "$ref": "#/definitions/StoreWebhookResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-ResponseWithFullPagination-StoreResponse": {
+        "JSONResponse-StoreWhitelistResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/ResponseWithFullPagination-StoreResponse"
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-ResponseWithFullPagination-github_com_dv-net_dv-merchant_internal_storage_repos_repo_transactions_FindRow": {
+        "JSONResponse-SystemInfoResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/ResponseWithFullPagination-github_com_dv-net_dv-merchant_internal_storage_repos_repo_transactions_FindRow"
+                    "$ref": "#/definitions/SystemInfoResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-SearchByCriteriaResponse-any": {
+        "JSONResponse-TgLinkResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/SearchByCriteriaResponse-any"
+                    "$ref": "#/definitions/TgLinkResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-SendTestResult": {
+        "JSONResponse-TransactionInfoResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/SendTestResult"
+                    "$ref": "#/definitions/TransactionInfoResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-SettingResponse": {
+        "JSONResponse-UnbanUserResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/SettingResponse"
+                    "$ref": "#/definitions/UnbanUserResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-ShortTransactionInfoListResponse": {
+        "JSONResponse-UnconfirmedTransactionResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/ShortTransactionInfoListResponse"
+                    "$ref": "#/definitions/UnconfirmedTransactionResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-StoreAPIKeyResponse": {
+        "JSONResponse-VersionResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/StoreAPIKeyResponse"
+                    "$ref": "#/definitions/VersionResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-StoreResponse": {
+        "JSONResponse-WalletAddressTotalUSDResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/StoreResponse"
+                    "$ref": "#/definitions/WalletAddressTotalUSDResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-StoreSecretResponse": {
+        "JSONResponse-WalletSeedResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/StoreSecretResponse"
+                    "$ref": "#/definitions/WalletSeedResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-StoreWebhookResponse": {
+        "JSONResponse-WalletShort": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/StoreWebhookResponse"
+                    "$ref": "#/definitions/WalletShort"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-StoreWhitelistResponse": {
+        "JSONResponse-WalletWithBlockchains": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                    "$ref": "#/definitions/WalletWithBlockchains"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-SystemInfoResponse": {
+        "JSONResponse-WebhookClientCertificateResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/SystemInfoResponse"
+                    "$ref": "#/definitions/WebhookClientCertificateResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-TgLinkResponse": {
+        "JSONResponse-WebhookDeadLetterWithPayloadResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/TgLinkResponse"
+                    "$ref": "#/definitions/WebhookDeadLetterWithPayloadResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-TransactionInfoResponse": {
+        "JSONResponse-WebhookEventCatalogueResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/TransactionInfoResponse"
+                    "$ref": "#/definitions/WebhookEventCatalogueResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-UnbanUserResponse": {
+        "JSONResponse-WebhookRetryPolicyResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/UnbanUserResponse"
+                    "$ref": "#/definitions/WebhookRetryPolicyResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-UnconfirmedTransactionResponse": {
+        "JSONResponse-WebhookSourceIPsResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/UnconfirmedTransactionResponse"
+                    "$ref": "#/definitions/WebhookSourceIPsResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-VersionResponse": {
+        "JSONResponse-WhHistoryResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/VersionResponse"
+                    "$ref": "#/definitions/WhHistoryResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-WalletAddressTotalUSDResponse": {
+        "JSONResponse-WithdrawalApprovalResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/WalletAddressTotalUSDResponse"
+                    "$ref": "#/definitions/WithdrawalApprovalResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-WalletSeedResponse": {
+        "JSONResponse-WithdrawalApprovalRuleResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/WalletSeedResponse"
+                    "$ref": "#/definitions/WithdrawalApprovalRuleResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-WalletShort": {
+        "JSONResponse-WithdrawalApprovalWithDecisionsResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/WalletShort"
+                    "$ref": "#/definitions/WithdrawalApprovalWithDecisionsResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-WalletWithBlockchains": {
+        "JSONResponse-WithdrawalFromProcessingDto": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/WalletWithBlockchains"
+                    "$ref": "#/definitions/WithdrawalFromProcessingDto"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-WhHistoryResponse": {
+        "JSONResponse-WithdrawalLimitResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/WhHistoryResponse"
+                    "$ref": "#/definitions/WithdrawalLimitResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-WithdrawalFromProcessingDto": {
+        "JSONResponse-WithdrawalRulesByCurrencyResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/WithdrawalFromProcessingDto"
+                    "$ref": "#/definitions/WithdrawalRulesByCurrencyResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-WithdrawalRulesByCurrencyResponse": {
+        "JSONResponse-WithdrawalScheduleResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/WithdrawalRulesByCurrencyResponse"
+                    "$ref": "#/definitions/WithdrawalScheduleResponse"
                 },
                 "message": {
                     "type": "string"
@@ -13583,6 +17826,40 @@ const docTemplate = `{
                 }
             }
         },
+        "JSONResponse-array_WithdrawalApprovalRuleResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/WithdrawalApprovalRuleResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "JSONResponse-array_WithdrawalLimitResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/WithdrawalLimitResponse"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
         "JSONResponse-array_WithdrawalWalletWithAddress": {
             "type": "object",
             "properties": {
@@ -13727,6 +18004,19 @@ const docTemplate = `{
                 }
             }
         },
+        "LeftoverAction": {
+            "type": "string",
+            "enum": [
+                "refund_request",
+                "credit_next_invoice",
+                "ignore"
+            ],
+            "x-enum-varnames": [
+                "LeftoverActionRefundRequest",
+                "LeftoverActionCreditNextInvoice",
+                "LeftoverActionIgnore"
+            ]
+        },
         "LowBalanceWithdrawalRuleResponse": {
             "type": "object",
             "properties": {
@@ -13735,6 +18025,12 @@ const docTemplate = `{
                 },
                 "mode": {
                     "$ref": "#/definitions/github_com_dv-net_dv-merchant_internal_models.MultiWithdrawalMode"
+                },
+                "split_legs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/MultiWithdrawalSplitLegResponse"
+                    }
                 }
             }
         },
@@ -13817,13 +18113,63 @@ const docTemplate = `{
                         "random",
                         "disabled",
                         "processing",
-                        "manual"
+                        "manual",
+                        "split"
                     ],
                     "allOf": [
                         {
                             "$ref": "#/definitions/github_com_dv-net_dv-merchant_internal_models.MultiWithdrawalMode"
                         }
                     ]
+                },
+                "split_legs": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "$ref": "#/definitions/MultiWithdrawalSplitLegRequest"
+                    }
+                }
+            }
+        },
+        "MultiWithdrawalSplitLegRequest": {
+            "type": "object",
+            "required": [
+                "address",
+                "percent"
+            ],
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "min_amount_usd": {
+                    "description": "MinAmountUSD skips the leg while its share is lower, the share goes to the remainder",
+                    "type": "number"
+                },
+                "percent": {
+                    "type": "number"
+                },
+                "receives_remainder": {
+                    "description": "ReceivesRemainder marks the leg receiving rounding dust and shares of skipped legs",
+                    "type": "boolean"
+                }
+            }
+        },
+        "MultiWithdrawalSplitLegResponse": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "min_amount_usd": {
+                    "type": "number"
+                },
+                "percent": {
+                    "type": "number"
+                },
+                "receives_remainder": {
+                    "type": "boolean"
                 }
             }
         },
@@ -13899,7 +18245,11 @@ const docTemplate = `{
                 "user_remind_verification",
                 "user_update_setting_verification",
                 "user_test_email",
-                "user_crypto_receipt"
+                "user_crypto_receipt",
+                "exrate_source_stale",
+                "withdrawal_limit_exceeded",
+                "address_book_entry_added",
+                "withdrawal_allowlist_disabling"
             ],
             "x-enum-varnames": [
                 "NotificationTypeUserVerification",
@@ -13916,7 +18266,11 @@ const docTemplate = `{
                 "NotificationTypeUserRemindVerification",
                 "NotificationTypeUserUpdateSetting",
                 "NotificationTypeUserTestEmail",
-                "NotificationTypeUserCryptoReceipt"
+                "NotificationTypeUserCryptoReceipt",
+                "NotificationTypeExrateSourceStale",
+                "NotificationTypeWithdrawalLimitExceeded",
+                "NotificationTypeAddressBookEntryAdded",
+                "NotificationTypeWithdrawalAllowlistDisabling"
             ]
         },
         "NotificationTypeListResponse": {
@@ -14176,6 +18530,43 @@ const docTemplate = `{
                 }
             }
         },
+        "PublicRateQuote": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "amount_usd": {
+                    "type": "number"
+                },
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "currency_id": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "invoice_id": {
+                    "type": "string"
+                },
+                "rate": {
+                    "type": "number"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
         "PublicResetPasswordRequest": {
             "type": "object",
             "required": [
@@ -14227,40 +18618,218 @@ const docTemplate = `{
                 "site_url": {
                     "type": "string"
                 },
-                "status": {
-                    "type": "boolean"
+                "status": {
+                    "type": "boolean"
+                },
+                "success_url": {
+                    "type": "string"
+                }
+            }
+        },
+        "PublicUserForgotPasswordRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "format": "email"
+                }
+            }
+        },
+        "PublicWalletAddress": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "currency": {
+                    "$ref": "#/definitions/PublicCurrency"
+                }
+            }
+        },
+        "ReceiptResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "currency_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "status": {
+                    "$ref": "#/definitions/ReceiptStatus"
+                },
+                "store_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "wallet_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "ReceiptStatus": {
+            "type": "string",
+            "enum": [
+                "paid",
+                "canceled"
+            ],
+            "x-enum-varnames": [
+                "ReceiptStatusPaid",
+                "ReceiptStatusCanceled"
+            ]
+        },
+        "RefreshWalletAddressRequest": {
+            "type": "object",
+            "required": [
+                "address"
+            ],
+            "properties": {
+                "address": {
+                    "type": "string"
+                }
+            }
+        },
+        "RefundReason": {
+            "type": "string",
+            "enum": [
+                "aml_blocked",
+                "overpayment",
+                "manual"
+            ],
+            "x-enum-varnames": [
+                "RefundReasonAMLBlocked",
+                "RefundReasonOverpayment",
+                "RefundReasonManual"
+            ]
+        },
+        "RefundResponse": {
+            "type": "object",
+            "properties": {
+                "address_to": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "number"
+                },
+                "amount_usd": {
+                    "type": "number"
+                },
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "currency_id": {
+                    "type": "string"
+                },
+                "decided_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "failure_reason": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "invoice_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "reason": {
+                    "$ref": "#/definitions/RefundReason"
+                },
+                "refund_tx_hash": {
+                    "type": "string"
+                },
+                "rejection_reason": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/RefundStatus"
+                },
+                "store_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "transaction_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "format": "date-time"
                 },
-                "success_url": {
-                    "type": "string"
+                "withdrawal_id": {
+                    "type": "string",
+                    "format": "uuid"
                 }
             }
         },
-        "PublicUserForgotPasswordRequest": {
+        "RefundStatus": {
+            "type": "string",
+            "enum": [
+                "pending_approval",
+                "approved",
+                "processing",
+                "completed",
+                "rejected",
+                "failed",
+                "cancelled"
+            ],
+            "x-enum-varnames": [
+                "RefundStatusPendingApproval",
+                "RefundStatusApproved",
+                "RefundStatusProcessing",
+                "RefundStatusCompleted",
+                "RefundStatusRejected",
+                "RefundStatusFailed",
+                "RefundStatusCancelled"
+            ]
+        },
+        "RefundStatusHistoryResponse": {
             "type": "object",
             "properties": {
-                "email": {
+                "created_at": {
                     "type": "string",
-                    "format": "email"
+                    "format": "date-time"
+                },
+                "status_from": {
+                    "$ref": "#/definitions/RefundStatus"
+                },
+                "status_to": {
+                    "$ref": "#/definitions/RefundStatus"
+                },
+                "user_id": {
+                    "type": "string",
+                    "format": "uuid"
                 }
             }
         },
-        "PublicWalletAddress": {
+        "RefundWithHistoryResponse": {
             "type": "object",
             "properties": {
-                "address": {
+                "address_to": {
                     "type": "string"
                 },
-                "currency": {
-                    "$ref": "#/definitions/PublicCurrency"
-                }
-            }
-        },
-        "ReceiptResponse": {
-            "type": "object",
-            "properties": {
                 "amount": {
                     "type": "number"
                 },
+                "amount_usd": {
+                    "type": "number"
+                },
                 "created_at": {
                     "type": "string",
                     "format": "date-time"
@@ -14268,45 +18837,54 @@ const docTemplate = `{
                 "currency_id": {
                     "type": "string"
                 },
+                "decided_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "failure_reason": {
+                    "type": "string"
+                },
+                "history": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/RefundStatusHistoryResponse"
+                    }
+                },
                 "id": {
                     "type": "string",
                     "format": "uuid"
                 },
+                "invoice_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "reason": {
+                    "$ref": "#/definitions/RefundReason"
+                },
+                "refund_tx_hash": {
+                    "type": "string"
+                },
+                "rejection_reason": {
+                    "type": "string"
+                },
                 "status": {
-                    "$ref": "#/definitions/ReceiptStatus"
+                    "$ref": "#/definitions/RefundStatus"
                 },
                 "store_id": {
                     "type": "string",
                     "format": "uuid"
                 },
+                "transaction_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
                 "updated_at": {
                     "type": "string",
                     "format": "date-time"
                 },
-                "wallet_id": {
-                    "type": "string"
-                }
-            }
-        },
-        "ReceiptStatus": {
-            "type": "string",
-            "enum": [
-                "paid",
-                "canceled"
-            ],
-            "x-enum-varnames": [
-                "ReceiptStatusPaid",
-                "ReceiptStatusCanceled"
-            ]
-        },
-        "RefreshWalletAddressRequest": {
-            "type": "object",
-            "required": [
-                "address"
-            ],
-            "properties": {
-                "address": {
-                    "type": "string"
+                "withdrawal_id": {
+                    "type": "string",
+                    "format": "uuid"
                 }
             }
         },
@@ -14370,6 +18948,15 @@ const docTemplate = `{
                 }
             }
         },
+        "RejectRefundRequest": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 1000
+                }
+            }
+        },
         "RejectStoreRequest": {
             "type": "object",
             "required": [
@@ -14381,6 +18968,30 @@ const docTemplate = `{
                 }
             }
         },
+        "RequeueWebhookDeadLettersRequest": {
+            "type": "object",
+            "properties": {
+                "ids": {
+                    "description": "IDs of dead letters to requeue, all pending ones are requeued when empty",
+                    "type": "array",
+                    "maxItems": 1000,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "RequeueWebhookDeadLettersResponse": {
+            "type": "object",
+            "properties": {
+                "already_queued": {
+                    "type": "integer"
+                },
+                "requeued": {
+                    "type": "integer"
+                }
+            }
+        },
         "ResponseWithFullPagination-AmlHistoryResponse": {
             "type": "object",
             "properties": {
@@ -14465,6 +19076,20 @@ const docTemplate = `{
                 }
             }
         },
+        "ResponseWithFullPagination-InvoiceResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/InvoiceResponse"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/FullPagingData"
+                }
+            }
+        },
         "ResponseWithFullPagination-NotificationHistoryResponse": {
             "type": "object",
             "properties": {
@@ -14479,6 +19104,20 @@ const docTemplate = `{
                 }
             }
         },
+        "ResponseWithFullPagination-RefundResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/RefundResponse"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/FullPagingData"
+                }
+            }
+        },
         "ResponseWithFullPagination-StoreResponse": {
             "type": "object",
             "properties": {
@@ -14493,6 +19132,34 @@ const docTemplate = `{
                 }
             }
         },
+        "ResponseWithFullPagination-WebhookDeadLetterResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/WebhookDeadLetterResponse"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/FullPagingData"
+                }
+            }
+        },
+        "ResponseWithFullPagination-WithdrawalApprovalResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/WithdrawalApprovalResponse"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/FullPagingData"
+                }
+            }
+        },
         "ResponseWithFullPagination-github_com_dv-net_dv-merchant_internal_storage_repos_repo_transactions_FindRow": {
             "type": "object",
             "properties": {
@@ -14609,6 +19276,55 @@ const docTemplate = `{
                 }
             }
         },
+        "SetWebhookClientCertificateRequest": {
+            "type": "object",
+            "required": [
+                "certificate",
+                "private_key"
+            ],
+            "properties": {
+                "certificate": {
+                    "description": "Certificate PEM encoded client certificate, intermediate certificates may follow it",
+                    "type": "string",
+                    "maxLength": 65536
+                },
+                "private_key": {
+                    "description": "PrivateKey PEM encoded private key of the certificate",
+                    "type": "string",
+                    "maxLength": 65536
+                }
+            }
+        },
+        "SetWithdrawalScheduleRequest": {
+            "type": "object",
+            "required": [
+                "totp"
+            ],
+            "properties": {
+                "cron_expression": {
+                    "description": "CronExpression is a five-field cron expression or @hourly, @daily, @weekly, @monthly macro",
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "keep_float_usd": {
+                    "type": "number"
+                },
+                "max_gas_price_gwei": {
+                    "type": "number"
+                },
+                "min_balance_usd": {
+                    "type": "number"
+                },
+                "timezone": {
+                    "description": "Timezone is an IANA time zone of the cron expression, user location by default",
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "totp": {
+                    "type": "string"
+                }
+            }
+        },
         "SettingResponse": {
             "type": "object",
             "properties": {
@@ -14764,6 +19480,49 @@ const docTemplate = `{
                 }
             }
         },
+        "StorePaymentPolicyResponse": {
+            "type": "object",
+            "properties": {
+                "leftover_action": {
+                    "enum": [
+                        "refund_request",
+                        "credit_next_invoice",
+                        "ignore"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/LeftoverAction"
+                        }
+                    ]
+                },
+                "rounding": {
+                    "enum": [
+                        "up",
+                        "down",
+                        "half"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ToleranceRounding"
+                        }
+                    ]
+                },
+                "threshold": {
+                    "type": "number"
+                },
+                "threshold_type": {
+                    "enum": [
+                        "absolute",
+                        "percent"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ToleranceType"
+                        }
+                    ]
+                }
+            }
+        },
         "StoreResponse": {
             "type": "object",
             "properties": {
@@ -14836,6 +19595,16 @@ const docTemplate = `{
         "StoreSecretResponse": {
             "type": "object",
             "properties": {
+                "key_id": {
+                    "type": "string"
+                },
+                "previous_expires_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "previous_key_id": {
+                    "type": "string"
+                },
                 "secret": {
                     "type": "string"
                 }
@@ -14915,7 +19684,16 @@ const docTemplate = `{
                         "type": "string",
                         "enum": [
                             "PaymentReceived",
-                            "PaymentNotConfirmed"
+                            "PaymentNotConfirmed",
+                            "WithdrawalFromProcessingReceived",
+                            "PaymentAMLBlocked",
+                            "InvoiceStatusChanged",
+                            "RefundStatusChanged",
+                            "TransferStatusChanged",
+                            "ExchangeOrderFilled",
+                            "ExchangeWithdrawalStatusChanged",
+                            "WalletAddressCreated",
+                            "AMLCheckCompleted"
                         ]
                     }
                 },
@@ -14923,6 +19701,36 @@ const docTemplate = `{
                     "type": "string",
                     "format": "uuid"
                 },
+                "schema_version": {
+                    "type": "integer",
+                    "enum": [
+                        1,
+                        2
+                    ]
+                },
+                "success_json_path": {
+                    "type": "string"
+                },
+                "success_json_value": {
+                    "type": "string"
+                },
+                "success_mode": {
+                    "type": "string",
+                    "enum": [
+                        "any_2xx",
+                        "json_body",
+                        "status_codes"
+                    ]
+                },
+                "success_status_codes": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "timeout_seconds": {
+                    "type": "integer"
+                },
                 "url": {
                     "description": "StoreID   string    ` + "`" + `json:\"store_id\" format:\"uuid\"` + "`" + `",
                     "type": "string",
@@ -15035,6 +19843,30 @@ const docTemplate = `{
                 }
             }
         },
+        "ToleranceRounding": {
+            "type": "string",
+            "enum": [
+                "up",
+                "down",
+                "half"
+            ],
+            "x-enum-varnames": [
+                "ToleranceRoundingUp",
+                "ToleranceRoundingDown",
+                "ToleranceRoundingHalf"
+            ]
+        },
+        "ToleranceType": {
+            "type": "string",
+            "enum": [
+                "absolute",
+                "percent"
+            ],
+            "x-enum-varnames": [
+                "ToleranceTypeAbsolute",
+                "ToleranceTypePercent"
+            ]
+        },
         "TransactionInfoResponse": {
             "type": "object",
             "properties": {
@@ -15419,6 +20251,18 @@ const docTemplate = `{
                 }
             }
         },
+        "UpdateRefundAddressRequest": {
+            "type": "object",
+            "required": [
+                "address_to"
+            ],
+            "properties": {
+                "address_to": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
         "UpdateStoreCurrencyRequest": {
             "type": "object",
             "properties": {
@@ -15430,6 +20274,54 @@ const docTemplate = `{
                 }
             }
         },
+        "UpdateStorePaymentPolicyRequest": {
+            "type": "object",
+            "required": [
+                "leftover_action",
+                "rounding",
+                "threshold_type"
+            ],
+            "properties": {
+                "leftover_action": {
+                    "enum": [
+                        "refund_request",
+                        "credit_next_invoice",
+                        "ignore"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/LeftoverAction"
+                        }
+                    ]
+                },
+                "rounding": {
+                    "enum": [
+                        "up",
+                        "down",
+                        "half"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ToleranceRounding"
+                        }
+                    ]
+                },
+                "threshold": {
+                    "type": "number"
+                },
+                "threshold_type": {
+                    "enum": [
+                        "absolute",
+                        "percent"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ToleranceType"
+                        }
+                    ]
+                }
+            }
+        },
         "UpdateStoreRequest": {
             "type": "object",
             "required": [
@@ -15489,6 +20381,50 @@ const docTemplate = `{
                         "$ref": "#/definitions/WebhookEvent"
                     }
                 },
+                "schema_version": {
+                    "description": "SchemaVersion 1 sends legacy flat payloads, 2 wraps them into a versioned envelope",
+                    "type": "integer",
+                    "enum": [
+                        1,
+                        2
+                    ]
+                },
+                "success_json_path": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1,
+                    "example": "success"
+                },
+                "success_json_value": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "true"
+                },
+                "success_mode": {
+                    "enum": [
+                        "any_2xx",
+                        "json_body",
+                        "status_codes"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/WebhookSuccessMode"
+                        }
+                    ]
+                },
+                "success_status_codes": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "timeout_seconds": {
+                    "description": "TimeoutSeconds zero means configured default timeout",
+                    "type": "integer",
+                    "maximum": 120,
+                    "minimum": 0
+                },
                 "url": {
                     "type": "string"
                 }
@@ -15533,6 +20469,7 @@ const docTemplate = `{
                         "bitget",
                         "bybit",
                         "gate",
+                        "aggregate",
                         "dv-min",
                         "dv-max",
                         "dv-avg"
@@ -15540,6 +20477,47 @@ const docTemplate = `{
                 }
             }
         },
+        "UpdateWebhookRetryPolicyRequest": {
+            "type": "object",
+            "required": [
+                "max_attempts",
+                "policy_type"
+            ],
+            "properties": {
+                "delay_seconds": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "max_attempts": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1
+                },
+                "max_delay_seconds": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "policy_type": {
+                    "enum": [
+                        "fixed",
+                        "exponential",
+                        "custom"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/WebhookRetryPolicyType"
+                        }
+                    ]
+                },
+                "schedule_seconds": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
         "UpdateWhitelistRequest": {
             "type": "object",
             "required": [
@@ -15960,32 +20938,267 @@ const docTemplate = `{
                 }
             }
         },
+        "WebhookClientCertificateResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "fingerprint": {
+                    "type": "string"
+                },
+                "subject": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "format": "date-time"
+                }
+            }
+        },
+        "WebhookDeadLetterResponse": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "event": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "last_response": {
+                    "type": "string"
+                },
+                "last_response_status_code": {
+                    "type": "integer"
+                },
+                "requeued_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "store_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "transaction_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "webhook_id": {
+                    "type": "string",
+                    "format": "uuid"
+                }
+            }
+        },
+        "WebhookDeadLetterWithPayloadResponse": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "event": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "last_response": {
+                    "type": "string"
+                },
+                "last_response_status_code": {
+                    "type": "integer"
+                },
+                "payload": {
+                    "type": "string"
+                },
+                "requeued_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "signature": {
+                    "type": "string"
+                },
+                "store_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "transaction_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "webhook_id": {
+                    "type": "string",
+                    "format": "uuid"
+                }
+            }
+        },
         "WebhookEvent": {
             "type": "string",
             "enum": [
                 "PaymentReceived",
                 "PaymentNotConfirmed",
                 "WithdrawalFromProcessingReceived",
-                "PaymentAMLBlocked"
+                "PaymentAMLBlocked",
+                "InvoiceStatusChanged",
+                "RefundStatusChanged",
+                "TransferStatusChanged",
+                "ExchangeOrderFilled",
+                "ExchangeWithdrawalStatusChanged",
+                "WalletAddressCreated",
+                "AMLCheckCompleted"
             ],
             "x-enum-varnames": [
                 "WebhookEventPaymentReceived",
                 "WebhookEventPaymentNotConfirmed",
                 "WebhookEventWithdrawalFromProcessingReceived",
-                "WebhookEventPaymentAMLBlocked"
+                "WebhookEventPaymentAMLBlocked",
+                "WebhookEventInvoiceStatusChanged",
+                "WebhookEventRefundStatusChanged",
+                "WebhookEventTransferStatusChanged",
+                "WebhookEventExchangeOrderFilled",
+                "WebhookEventExchangeWithdrawalStatusChanged",
+                "WebhookEventWalletAddressCreated",
+                "WebhookEventAMLCheckCompleted"
             ]
         },
-        "WebhookKind": {
+        "WebhookEventCatalogueResponse": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/WebhookEventDescription"
+                    }
+                },
+                "schema_versions": {
+                    "description": "SchemaVersions supported by store webhooks, 1 is legacy flat payload, 2 is versioned envelope",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "WebhookEventDescription": {
+            "type": "object",
+            "properties": {
+                "event": {
+                    "type": "string"
+                },
+                "scope": {
+                    "description": "Scope is store for events of a single store, account events are delivered to every store of the owner",
+                    "type": "string",
+                    "enum": [
+                        "store",
+                        "account"
+                    ]
+                }
+            }
+        },
+        "WebhookKind": {
+            "type": "string",
+            "enum": [
+                "transfer",
+                "deposit",
+                "transfer_status"
+            ],
+            "x-enum-varnames": [
+                "WebhookKindTransfer",
+                "WebhookKindDeposit",
+                "WebhookKindTransferStatus"
+            ]
+        },
+        "WebhookRetryPolicyResponse": {
+            "type": "object",
+            "properties": {
+                "delay_seconds": {
+                    "type": "integer"
+                },
+                "max_attempts": {
+                    "type": "integer"
+                },
+                "max_delay_seconds": {
+                    "type": "integer"
+                },
+                "policy_type": {
+                    "enum": [
+                        "fixed",
+                        "exponential",
+                        "custom"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/WebhookRetryPolicyType"
+                        }
+                    ]
+                },
+                "schedule_seconds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "WebhookRetryPolicyType": {
+            "type": "string",
+            "enum": [
+                "fixed",
+                "exponential",
+                "custom"
+            ],
+            "x-enum-varnames": [
+                "WebhookRetryPolicyFixed",
+                "WebhookRetryPolicyExponential",
+                "WebhookRetryPolicyCustom"
+            ]
+        },
+        "WebhookSourceIPsResponse": {
+            "type": "object",
+            "properties": {
+                "ips": {
+                    "description": "IPs webhook deliveries are sent from, empty when not pinned",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "WebhookSuccessMode": {
             "type": "string",
             "enum": [
-                "transfer",
-                "deposit",
-                "transfer_status"
+                "any_2xx",
+                "json_body",
+                "status_codes"
             ],
             "x-enum-varnames": [
-                "WebhookKindTransfer",
-                "WebhookKindDeposit",
-                "WebhookKindTransferStatus"
+                "WebhookSuccessAny2xx",
+                "WebhookSuccessJSONBody",
+                "WebhookSuccessStatusCodes"
             ]
         },
         "WhHistory": {
@@ -16067,6 +21280,240 @@ const docTemplate = `{
                 }
             }
         },
+        "WithdrawalApprovalDecisionRequest": {
+            "type": "object",
+            "required": [
+                "totp"
+            ],
+            "properties": {
+                "comment": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "totp": {
+                    "type": "string"
+                }
+            }
+        },
+        "WithdrawalApprovalDecisionResponse": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "decision": {
+                    "$ref": "#/definitions/WithdrawalApprovalDecisionType"
+                },
+                "user_id": {
+                    "type": "string",
+                    "format": "uuid"
+                }
+            }
+        },
+        "WithdrawalApprovalDecisionType": {
+            "type": "string",
+            "enum": [
+                "approve",
+                "reject"
+            ],
+            "x-enum-varnames": [
+                "WithdrawalApprovalDecisionApprove",
+                "WithdrawalApprovalDecisionReject"
+            ]
+        },
+        "WithdrawalApprovalKind": {
+            "type": "string",
+            "enum": [
+                "from_address",
+                "from_addresses",
+                "from_processing"
+            ],
+            "x-enum-varnames": [
+                "WithdrawalApprovalKindFromAddress",
+                "WithdrawalApprovalKindFromAddresses",
+                "WithdrawalApprovalKindFromProcessing"
+            ]
+        },
+        "WithdrawalApprovalResponse": {
+            "type": "object",
+            "properties": {
+                "address_to": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "number"
+                },
+                "amount_usd": {
+                    "type": "number"
+                },
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "currency_id": {
+                    "type": "string"
+                },
+                "executed_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "failure_reason": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "initiated_by": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "kind": {
+                    "$ref": "#/definitions/WithdrawalApprovalKind"
+                },
+                "required_approvals": {
+                    "type": "integer"
+                },
+                "rule_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "status": {
+                    "$ref": "#/definitions/WithdrawalApprovalStatus"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "user_id": {
+                    "type": "string",
+                    "format": "uuid"
+                }
+            }
+        },
+        "WithdrawalApprovalRuleResponse": {
+            "type": "object",
+            "properties": {
+                "address_to": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "created_by": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "currency_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "min_amount_usd": {
+                    "type": "number"
+                },
+                "required_approvals": {
+                    "type": "integer"
+                }
+            }
+        },
+        "WithdrawalApprovalStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "approved",
+                "executed",
+                "failed",
+                "rejected",
+                "expired"
+            ],
+            "x-enum-varnames": [
+                "WithdrawalApprovalStatusPending",
+                "WithdrawalApprovalStatusApproved",
+                "WithdrawalApprovalStatusExecuted",
+                "WithdrawalApprovalStatusFailed",
+                "WithdrawalApprovalStatusRejected",
+                "WithdrawalApprovalStatusExpired"
+            ]
+        },
+        "WithdrawalApprovalWithDecisionsResponse": {
+            "type": "object",
+            "properties": {
+                "address_to": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "number"
+                },
+                "amount_usd": {
+                    "type": "number"
+                },
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "currency_id": {
+                    "type": "string"
+                },
+                "decisions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/WithdrawalApprovalDecisionResponse"
+                    }
+                },
+                "executed_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "failure_reason": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "initiated_by": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "kind": {
+                    "$ref": "#/definitions/WithdrawalApprovalKind"
+                },
+                "required_approvals": {
+                    "type": "integer"
+                },
+                "rule_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "status": {
+                    "$ref": "#/definitions/WithdrawalApprovalStatus"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "user_id": {
+                    "type": "string",
+                    "format": "uuid"
+                }
+            }
+        },
         "WithdrawalFromProcessingDto": {
             "type": "object",
             "properties": {
@@ -16118,6 +21565,49 @@ const docTemplate = `{
                 "WithdrawalIntervalEveryWeek"
             ]
         },
+        "WithdrawalLimitResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "max_amount_usd": {
+                    "type": "number"
+                },
+                "max_count": {
+                    "type": "integer"
+                },
+                "scope": {
+                    "$ref": "#/definitions/WithdrawalLimitScope"
+                },
+                "scope_value": {
+                    "type": "string"
+                },
+                "window_seconds": {
+                    "type": "integer"
+                }
+            }
+        },
+        "WithdrawalLimitScope": {
+            "type": "string",
+            "enum": [
+                "user",
+                "store",
+                "currency",
+                "destination"
+            ],
+            "x-enum-varnames": [
+                "WithdrawalLimitScopeUser",
+                "WithdrawalLimitScopeStore",
+                "WithdrawalLimitScopeCurrency",
+                "WithdrawalLimitScopeDestination"
+            ]
+        },
         "WithdrawalRulesByCurrencyResponse": {
             "type": "object",
             "properties": {
@@ -16153,6 +21643,42 @@ const docTemplate = `{
                 }
             }
         },
+        "WithdrawalScheduleResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "cron_expression": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "keep_float_usd": {
+                    "type": "number"
+                },
+                "last_run_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "max_gas_price_gwei": {
+                    "type": "number"
+                },
+                "min_balance_usd": {
+                    "type": "number"
+                },
+                "run_started_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "timezone": {
+                    "type": "string"
+                }
+            }
+        },
         "WithdrawalStatus": {
             "type": "string",
             "enum": [
@@ -16295,7 +21821,7 @@ const docTemplate = `{
                 "rate_source": {
                     "type": "string",
                     "enum": [
-                        "okx htx binance bitget bybit gate dv-min dv-max dv-avg"
+                        "okx htx binance bitget bybit gate aggregate dv-min dv-max dv-avg"
                     ]
                 }
             }
@@ -16654,13 +22180,15 @@ const docTemplate = `{
                 "random",
                 "disabled",
                 "processing",
-                "manual"
+                "manual",
+                "split"
             ],
             "x-enum-varnames": [
                 "MultiWithdrawalModeRandom",
                 "MultiWithdrawalModeDisabled",
                 "MultiWithdrawalModeProcessing",
-                "MultiWithdrawalModeManual"
+                "MultiWithdrawalModeManual",
+                "MultiWithdrawalModeSplit"
             ]
         },
         "github_com_dv-net_dv-merchant_internal_models.RateSource": {
@@ -16672,7 +22200,11 @@ const docTemplate = `{
                 "bitget",
                 "kucoin",
                 "bybit",
-                "gate"
+                "gate",
+                "mexc",
+                "kraken",
+                "coinbase",
+                "aggregate"
             ],
             "x-enum-varnames": [
                 "RateSourceOKX",
@@ -16681,7 +22213,11 @@ const docTemplate = `{
                 "RateSourceBitGet",
                 "RateSourceKucoin",
                 "RateSourceBybit",
-                "RateSourceGateio"
+                "RateSourceGateio",
+                "RateSourceMexc",
+                "RateSourceKraken",
+                "RateSourceCoinbase",
+                "RateSourceAggregate"
             ]
         },
         "github_com_dv-net_dv-merchant_internal_models.WalletType": {
@@ -66,14 +66,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/external/processing-wallet-balances": {
-            "get": {
+        "/v1/external/invoice": {
+            "post": {
                 "security": [
                     {
                         "XApiKey": []
                     }
                 ],
-                "description": "Get external processing wallet balances",
+                "description": "Create invoice with fixed amount and expiry",
                 "consumes": [
                     "application/json"
                 ],
@@ -81,9 +81,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Withdrawal"
+                    "Invoice"
                 ],
-                "summary": "Get external processing wallet balances",
+                "summary": "Create invoice",
                 "parameters": [
                     {
                         "type": "string",
@@ -92,42 +92,26 @@ const docTemplate = `{
                         "in": "query"
                     },
                     {
-                        "uniqueItems": true,
-                        "type": "array",
-                        "items": {
-                            "enum": [
-                                "arbitrum",
-                                "bitcoin",
-                                "bitcoincash",
-                                "bsc",
-                                "dogecoin",
-                                "ethereum",
-                                "litecoin",
-                                "polygon",
-                                "tron"
-                            ],
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "name": "blockchains",
-                        "in": "query"
-                    },
-                    {
-                        "uniqueItems": true,
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "name": "currencies",
-                        "in": "query"
+                        "description": "Create invoice",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateInvoiceRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-ExternalProcessingWalletBalanceResponse"
+                            "$ref": "#/definitions/JSONResponse-InvoiceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
                         }
                     },
                     "401": {
@@ -136,8 +120,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -145,34 +129,44 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/external/store/currencies": {
+        "/v1/external/invoice/{id}": {
             "get": {
                 "security": [
                     {
                         "XApiKey": []
                     }
                 ],
-                "description": "Get store currencies list",
+                "description": "Get invoice with status history",
                 "consumes": [
                     "application/json"
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Store"
+                    "Invoice"
                 ],
-                "summary": "Get store currencies list",
+                "summary": "Get invoice",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "Store API key",
                         "name": "api_key",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Invoice ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-array_GetCurrencyResponse"
+                            "$ref": "#/definitions/JSONResponse-InvoiceWithHistoryResponse"
                         }
                     },
                     "401": {
@@ -181,8 +175,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -190,34 +184,44 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/external/store/currencies-extended": {
-            "get": {
+        "/v1/external/invoice/{id}/cancel": {
+            "post": {
                 "security": [
                     {
                         "XApiKey": []
                     }
                 ],
-                "description": "Get extended list of store currencies grouped by tokens and blockchains",
+                "description": "Cancel pending or partially paid invoice",
                 "consumes": [
                     "application/json"
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Store"
+                    "Invoice"
                 ],
-                "summary": "Get extended list of store currencies",
+                "summary": "Cancel invoice",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "Store API key",
                         "name": "api_key",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Invoice ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-CurrenciesExtendedResponse"
+                            "$ref": "#/definitions/JSONResponse-InvoiceResponse"
                         }
                     },
                     "401": {
@@ -226,8 +230,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -235,41 +245,68 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/external/store/currencies/{id}/rate": {
+        "/v1/external/processing-wallet-balances": {
             "get": {
                 "security": [
                     {
                         "XApiKey": []
                     }
                 ],
-                "description": "Get store currency rate",
+                "description": "Get external processing wallet balances",
                 "consumes": [
                     "application/json"
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Store"
+                    "Withdrawal"
                 ],
-                "summary": "Get store currency rate",
+                "summary": "Get external processing wallet balances",
                 "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Currency ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "type": "string",
                         "description": "Store API key",
                         "name": "api_key",
                         "in": "query"
+                    },
+                    {
+                        "uniqueItems": true,
+                        "type": "array",
+                        "items": {
+                            "enum": [
+                                "arbitrum",
+                                "bitcoin",
+                                "bitcoincash",
+                                "bsc",
+                                "dogecoin",
+                                "ethereum",
+                                "litecoin",
+                                "polygon",
+                                "tron"
+                            ],
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "blockchains",
+                        "in": "query"
+                    },
+                    {
+                        "uniqueItems": true,
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "currencies",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-CurrencyRate"
+                            "$ref": "#/definitions/JSONResponse-ExternalProcessingWalletBalanceResponse"
                         }
                     },
                     "401": {
@@ -287,14 +324,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/external/transactions/unconfirmed/transfer": {
-            "get": {
+        "/v1/external/refund": {
+            "post": {
                 "security": [
                     {
                         "XApiKey": []
                     }
                 ],
-                "description": "Get unconfirmed transfer transactions",
+                "description": "Request refund of a deposit to payer supplied or source address, it is executed after approval",
                 "consumes": [
                     "application/json"
                 ],
@@ -302,22 +339,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Transactions"
+                    "Refund"
                 ],
-                "summary": "Get unconfirmed transfer transactions",
+                "summary": "Create refund",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "Store API key",
                         "name": "api_key",
                         "in": "query"
+                    },
+                    {
+                        "description": "Create refund",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateRefundRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-UnconfirmedTransactionResponse"
+                            "$ref": "#/definitions/JSONResponse-RefundResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
                         }
                     },
                     "401": {
@@ -326,8 +378,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -335,14 +393,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/external/wallet": {
+        "/v1/external/refund/{id}": {
             "get": {
                 "security": [
                     {
                         "XApiKey": []
                     }
                 ],
-                "description": "Create wallet with address",
+                "description": "Get refund with status history",
                 "consumes": [
                     "application/json"
                 ],
@@ -350,9 +408,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Store"
+                    "Refund"
                 ],
-                "summary": "Create wallet with address",
+                "summary": "Get refund",
                 "parameters": [
                     {
                         "type": "string",
@@ -360,38 +418,11 @@ const docTemplate = `{
                         "name": "api_key",
                         "in": "query"
                     },
-                    {
-                        "type": "number",
-                        "name": "amount",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "currency",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "format": "email",
-                        "name": "email",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "format": "ipv4",
-                        "name": "ip",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "locale",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "format": "uuid",
-                        "name": "store_external_id",
-                        "in": "query",
+                        "description": "Refund ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -399,7 +430,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-CreateWalletExternalResponse"
+                            "$ref": "#/definitions/JSONResponse-RefundWithHistoryResponse"
                         }
                     },
                     "401": {
@@ -408,21 +439,23 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/v1/external/refund/{id}/address": {
+            "put": {
                 "security": [
                     {
                         "XApiKey": []
                     }
                 ],
-                "description": "Create wallet with address",
+                "description": "Change destination address of refund pending approval",
                 "consumes": [
                     "application/json"
                 ],
@@ -430,9 +463,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Wallet"
+                    "Refund"
                 ],
-                "summary": "Create wallet with address",
+                "summary": "Update refund address",
                 "parameters": [
                     {
                         "type": "string",
@@ -441,12 +474,19 @@ const docTemplate = `{
                         "in": "query"
                     },
                     {
-                        "description": "Create wallet",
+                        "type": "string",
+                        "description": "Refund ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Refund address",
                         "name": "register",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/CreateWalletExternalRequest"
+                            "$ref": "#/definitions/UpdateRefundAddressRequest"
                         }
                     }
                 ],
@@ -454,7 +494,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-CreateWalletExternalResponse"
+                            "$ref": "#/definitions/JSONResponse-RefundResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
                         }
                     },
                     "401": {
@@ -463,8 +509,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -472,14 +524,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/external/wallet/addresses/dirty": {
+        "/v1/external/refund/{id}/cancel": {
             "post": {
                 "security": [
                     {
-                        "BearerAuth": []
+                        "XApiKey": []
                     }
                 ],
-                "description": "Marks the given wallet address as dirty so it will not be used for new payments",
+                "description": "Cancel refund pending approval",
                 "consumes": [
                     "application/json"
                 ],
@@ -487,35 +539,45 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Wallet"
+                    "Refund"
                 ],
-                "summary": "Mark wallet address as dirty",
+                "summary": "Cancel refund",
                 "parameters": [
                     {
-                        "description": "MarkIsDirtyRequest",
-                        "name": "json",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/MarkIsDirtyRequest"
-                        }
+                        "type": "string",
+                        "description": "Store API key",
+                        "name": "api_key",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Refund ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-string"
+                            "$ref": "#/definitions/JSONResponse-RefundResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -523,39 +585,34 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/external/wallet/balance/hot": {
+        "/v1/external/store/currencies": {
             "get": {
                 "security": [
                     {
                         "XApiKey": []
                     }
                 ],
-                "description": "Get external hot wallet balances",
-                "produces": [
+                "description": "Get store currencies list",
+                "consumes": [
                     "application/json"
                 ],
                 "tags": [
                     "Store"
                 ],
-                "summary": "Get external hot wallet balances",
+                "summary": "Get store currencies list",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "Store API key",
                         "name": "api_key",
                         "in": "query"
-                    },
-                    {
-                        "type": "number",
-                        "name": "min_balance",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successful operation",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-array_SummaryDTO"
+                            "$ref": "#/definitions/JSONResponse-array_GetCurrencyResponse"
                         }
                     },
                     "401": {
@@ -573,47 +630,34 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/external/withdrawal-from-processing": {
-            "post": {
+        "/v1/external/store/currencies-extended": {
+            "get": {
                 "security": [
                     {
                         "XApiKey": []
                     }
                 ],
-                "description": "Initialize withdrawal from processing",
+                "description": "Get extended list of store currencies grouped by tokens and blockchains",
                 "consumes": [
                     "application/json"
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Withdrawal"
+                    "Store"
                 ],
-                "summary": "Initialize withdrawal from processing",
+                "summary": "Get extended list of store currencies",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "Store API key",
                         "name": "api_key",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "description": "Init withdrawal",
-                        "name": "register",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/CreateProcessingWithdrawRequest"
-                        }
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-ProcessingWithdrawalResponse"
+                            "$ref": "#/definitions/JSONResponse-CurrenciesExtendedResponse"
                         }
                     },
                     "401": {
@@ -622,30 +666,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    },
-                    "422": {
-                        "description": "Unprocessable Entity",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    },
-                    "423": {
-                        "description": "Locked",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -655,48 +675,41 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/external/withdrawal-from-processing/{id}": {
+        "/v1/external/store/currencies/{id}/rate": {
             "get": {
                 "security": [
                     {
                         "XApiKey": []
                     }
                 ],
-                "description": "Get withdrawal from processing",
-                "produces": [
+                "description": "Get store currency rate",
+                "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "Withdrawal"
+                    "Store"
                 ],
-                "summary": "Get withdrawal from processing",
+                "summary": "Get store currency rate",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Store API key",
-                        "name": "api_key",
-                        "in": "query",
+                        "description": "Currency ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Withdrawal ID or Request ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Store API key",
+                        "name": "api_key",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-WithdrawalFromProcessingDto"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
+                            "$ref": "#/definitions/JSONResponse-CurrencyRate"
                         }
                     },
                     "401": {
@@ -705,26 +718,50 @@ const docTemplate = `{
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
-                        }
-                    },
-                    "406": {
-                        "description": "Not Acceptable",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
-                    },
-                    "409": {
-                        "description": "Conflict",
+                    }
+                }
+            }
+        },
+        "/v1/external/transactions/unconfirmed/transfer": {
+            "get": {
+                "security": [
+                    {
+                        "XApiKey": []
+                    }
+                ],
+                "description": "Get unconfirmed transfer transactions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Transactions"
+                ],
+                "summary": "Get unconfirmed transfer transactions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store API key",
+                        "name": "api_key",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/APIErrors"
+                            "$ref": "#/definitions/JSONResponse-UnconfirmedTransactionResponse"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
@@ -736,21 +773,26 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/external/wallet": {
+            "get": {
                 "security": [
                     {
                         "XApiKey": []
                     }
                 ],
-                "description": "Delete withdrawal from processing",
+                "description": "Create wallet with address",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Withdrawal"
+                    "Store"
                 ],
-                "summary": "Delete withdrawal from processing",
+                "summary": "Create wallet with address",
                 "parameters": [
                     {
                         "type": "string",
@@ -758,11 +800,38 @@ const docTemplate = `{
                         "name": "api_key",
                         "in": "query"
                     },
+                    {
+                        "type": "number",
+                        "name": "amount",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "Withdrawal ID",
-                        "name": "id",
-                        "in": "path",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "email",
+                        "name": "email",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "ipv4",
+                        "name": "ip",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "locale",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "name": "store_external_id",
+                        "in": "query",
                         "required": true
                     }
                 ],
@@ -770,560 +839,1524 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/JSONResponse-string"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/APIErrors"
+                            "$ref": "#/definitions/JSONResponse-CreateWalletExternalResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "503": {
+                        "description": "Service Unavailable",
                         "schema": {
                             "$ref": "#/definitions/APIErrors"
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "APIError": {
-            "type": "object",
-            "properties": {
-                "field": {
+            },
+            "post": {
+                "security": [
+                    {
+                        "XApiKey": []
+                    }
+                ],
+                "description": "Create wallet with address",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wallet"
+                ],
+                "summary": "Create wallet with address",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store API key",
+                        "name": "api_key",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Create wallet",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateWalletExternalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-CreateWalletExternalResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/external/wallet/addresses/dirty": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Marks the given wallet address as dirty so it will not be used for new payments",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Wallet"
+                ],
+                "summary": "Mark wallet address as dirty",
+                "parameters": [
+                    {
+                        "description": "MarkIsDirtyRequest",
+                        "name": "json",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/MarkIsDirtyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/external/wallet/balance/hot": {
+            "get": {
+                "security": [
+                    {
+                        "XApiKey": []
+                    }
+                ],
+                "description": "Get external hot wallet balances",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Store"
+                ],
+                "summary": "Get external hot wallet balances",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store API key",
+                        "name": "api_key",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "name": "min_balance",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful operation",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-array_SummaryDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/external/webhook/dead-letters": {
+            "get": {
+                "security": [
+                    {
+                        "XApiKey": []
+                    }
+                ],
+                "description": "List store webhooks that exhausted retry policy",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "List webhook dead letters",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store API key",
+                        "name": "api_key",
+                        "in": "query"
+                    },
+                    {
+                        "maxLength": 50,
+                        "type": "string",
+                        "name": "event",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "name": "requeued",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "transaction_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-ResponseWithFullPagination-WebhookDeadLetterResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/external/webhook/dead-letters/requeue": {
+            "post": {
+                "security": [
+                    {
+                        "XApiKey": []
+                    }
+                ],
+                "description": "Put selected or all pending webhook dead letters back to the send queue",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Requeue webhook dead letters",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store API key",
+                        "name": "api_key",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Dead letters to requeue",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/RequeueWebhookDeadLettersRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-RequeueWebhookDeadLettersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/external/webhook/dead-letters/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "XApiKey": []
+                    }
+                ],
+                "description": "Get webhook dead letter with payload and signature",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhook"
+                ],
+                "summary": "Get webhook dead letter",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store API key",
+                        "name": "api_key",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Dead letter ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-WebhookDeadLetterWithPayloadResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/external/withdrawal-from-processing": {
+            "post": {
+                "security": [
+                    {
+                        "XApiKey": []
+                    }
+                ],
+                "description": "Initialize withdrawal from processing",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Withdrawal"
+                ],
+                "summary": "Initialize withdrawal from processing",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store API key",
+                        "name": "api_key",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "Init withdrawal",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateProcessingWithdrawRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-ProcessingWithdrawalResponse"
+                        }
+                    },
+                    "202": {
+                        "description": "Held for approval",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-WithdrawalApprovalResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "423": {
+                        "description": "Locked",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/external/withdrawal-from-processing/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "XApiKey": []
+                    }
+                ],
+                "description": "Get withdrawal from processing",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Withdrawal"
+                ],
+                "summary": "Get withdrawal from processing",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store API key",
+                        "name": "api_key",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Withdrawal ID or Request ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-WithdrawalFromProcessingDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "XApiKey": []
+                    }
+                ],
+                "description": "Delete withdrawal from processing",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Withdrawal"
+                ],
+                "summary": "Delete withdrawal from processing",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Store API key",
+                        "name": "api_key",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Withdrawal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/JSONResponse-string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/APIErrors"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "APIError": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "APIErrors": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/APIError"
+                    }
+                }
+            }
+        },
+        "Asset": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string"
+                },
+                "amount_usd": {
+                    "type": "string"
+                },
+                "currency_id": {
+                    "type": "string"
+                },
+                "identity": {
+                    "type": "string"
+                }
+            }
+        },
+        "Balance": {
+            "type": "object",
+            "properties": {
+                "native_token": {
+                    "type": "string"
+                },
+                "native_token_usd": {
+                    "type": "string"
+                }
+            }
+        },
+        "Blockchain": {
+            "type": "string",
+            "enum": [
+                "arbitrum",
+                "bitcoin",
+                "bitcoincash",
+                "bsc",
+                "dogecoin",
+                "ethereum",
+                "litecoin",
+                "polygon",
+                "tron"
+            ],
+            "x-enum-varnames": [
+                "BlockchainArbitrum",
+                "BlockchainBitcoin",
+                "BlockchainBitcoinCash",
+                "BlockchainBinanceSmartChain",
+                "BlockchainDogecoin",
+                "BlockchainEthereum",
+                "BlockchainLitecoin",
+                "BlockchainPolygon",
+                "BlockchainTron"
+            ]
+        },
+        "BlockchainAdditionalData": {
+            "type": "object",
+            "properties": {
+                "evm_data": {
+                    "$ref": "#/definitions/wallet.EVMData"
+                },
+                "tron_data": {
+                    "$ref": "#/definitions/TronData"
+                }
+            }
+        },
+        "BlockchainGroup": {
+            "type": "object",
+            "properties": {
+                "currencies": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "icon": {
+                    "$ref": "#/definitions/CurrencyIcon"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "tokens": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "CreateInvoiceRequest": {
+            "type": "object",
+            "required": [
+                "currency_id",
+                "store_external_id"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "amount_usd": {
+                    "type": "number"
+                },
+                "currency_id": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "email": {
+                    "type": "string",
+                    "format": "email"
+                },
+                "ip": {
+                    "type": "string",
+                    "format": "ipv4"
+                },
+                "lifetime_minutes": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "locale": {
+                    "type": "string"
+                },
+                "order_id": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "store_external_id": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "CreateProcessingWithdrawRequest": {
+            "type": "object",
+            "required": [
+                "address_to",
+                "amount",
+                "currency_id",
+                "request_id"
+            ],
+            "properties": {
+                "address_to": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 16
+                },
+                "amount": {
+                    "type": "number"
+                },
+                "currency_id": {
                     "type": "string"
                 },
-                "message": {
+                "request_id": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                }
+            }
+        },
+        "CreateRefundRequest": {
+            "type": "object",
+            "required": [
+                "transaction_id"
+            ],
+            "properties": {
+                "address_to": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "amount": {
+                    "type": "number"
+                },
+                "transaction_id": {
                     "type": "string"
                 }
             }
         },
-        "APIErrors": {
+        "CreateWalletExternalRequest": {
             "type": "object",
+            "required": [
+                "store_external_id"
+            ],
             "properties": {
-                "code": {
-                    "type": "integer"
+                "amount": {
+                    "type": "number"
                 },
-                "errors": {
+                "currency": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string",
+                    "format": "email"
+                },
+                "ip": {
+                    "type": "string",
+                    "format": "ipv4"
+                },
+                "locale": {
+                    "type": "string"
+                },
+                "store_external_id": {
+                    "type": "string",
+                    "format": "uuid"
+                }
+            }
+        },
+        "CreateWalletExternalResponse": {
+            "type": "object",
+            "properties": {
+                "address": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/APIError"
+                        "$ref": "#/definitions/WalletAddressResponse"
+                    }
+                },
+                "amount_usd": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "pay_url": {
+                    "type": "string"
+                },
+                "rates": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
                     }
+                },
+                "store_external_id": {
+                    "type": "string"
+                },
+                "store_id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "format": "date-time"
                 }
             }
         },
-        "Asset": {
+        "CurrenciesExtendedResponse": {
             "type": "object",
             "properties": {
-                "amount": {
+                "blockchains": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/BlockchainGroup"
+                    }
+                },
+                "currencies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/CurrencyExtendedItem"
+                    }
+                },
+                "tokens": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/TokenGroup"
+                    }
+                }
+            }
+        },
+        "CurrencyDTO": {
+            "type": "object",
+            "properties": {
+                "blockchain": {
+                    "$ref": "#/definitions/Blockchain"
+                },
+                "code": {
                     "type": "string"
                 },
-                "amount_usd": {
+                "contract_address": {
                     "type": "string"
                 },
-                "currency_id": {
+                "id": {
                     "type": "string"
                 },
-                "identity": {
+                "is_native": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "sort_order": {
+                    "type": "integer"
+                }
+            }
+        },
+        "CurrencyExtendedItem": {
+            "type": "object",
+            "properties": {
+                "blockchain": {
+                    "type": "string"
+                },
+                "blockchain_icon": {
+                    "$ref": "#/definitions/CurrencyIcon"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "contract_address": {
+                    "type": "string"
+                },
+                "explorer_link": {
+                    "type": "string"
+                },
+                "has_balance": {
+                    "type": "boolean"
+                },
+                "icon": {
+                    "$ref": "#/definitions/CurrencyIcon"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "min_confirmation": {
+                    "type": "integer"
+                },
+                "name": {
                     "type": "string"
+                },
+                "token_icon": {
+                    "$ref": "#/definitions/CurrencyIcon"
                 }
             }
         },
-        "Balance": {
+        "CurrencyIcon": {
             "type": "object",
             "properties": {
-                "native_token": {
+                "icon_128": {
                     "type": "string"
                 },
-                "native_token_usd": {
+                "icon_512": {
+                    "type": "string"
+                },
+                "icon_svg": {
                     "type": "string"
                 }
             }
         },
-        "Blockchain": {
-            "type": "string",
-            "enum": [
-                "arbitrum",
-                "bitcoin",
-                "bitcoincash",
-                "bsc",
-                "dogecoin",
-                "ethereum",
-                "litecoin",
-                "polygon",
-                "tron"
-            ],
-            "x-enum-varnames": [
-                "BlockchainArbitrum",
-                "BlockchainBitcoin",
-                "BlockchainBitcoinCash",
-                "BlockchainBinanceSmartChain",
-                "BlockchainDogecoin",
-                "BlockchainEthereum",
-                "BlockchainLitecoin",
-                "BlockchainPolygon",
-                "BlockchainTron"
-            ]
+        "CurrencyRate": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "rate": {
+                    "type": "string"
+                },
+                "rate_source": {
+                    "type": "string"
+                }
+            }
         },
-        "BlockchainAdditionalData": {
+        "CurrencyShort": {
             "type": "object",
             "properties": {
-                "evm_data": {
-                    "$ref": "#/definitions/wallet.EVMData"
+                "blockchain": {
+                    "$ref": "#/definitions/Blockchain"
                 },
-                "tron_data": {
-                    "$ref": "#/definitions/TronData"
+                "code": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_bitcoin_like": {
+                    "type": "boolean"
+                },
+                "is_evm_like": {
+                    "type": "boolean"
+                },
+                "is_stable_coin": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "precision": {
+                    "type": "integer"
                 }
             }
         },
-        "BlockchainGroup": {
+        "ExchangeAsset": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "amount_usd": {
+                    "type": "number"
+                },
+                "currency": {
+                    "type": "string"
+                }
+            }
+        },
+        "ExternalExchangeBalanceResponse": {
             "type": "object",
             "properties": {
-                "currencies": {
+                "balances": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/ExchangeAsset"
                     }
                 },
-                "icon": {
-                    "$ref": "#/definitions/CurrencyIcon"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "tokens": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "total_usd": {
+                    "type": "number"
                 }
             }
         },
-        "CreateProcessingWithdrawRequest": {
+        "FullPagingData": {
             "type": "object",
-            "required": [
-                "address_to",
-                "amount",
-                "currency_id",
-                "request_id"
-            ],
             "properties": {
-                "address_to": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 16
+                "last_page": {
+                    "type": "integer"
                 },
-                "amount": {
-                    "type": "number"
+                "page": {
+                    "type": "integer"
                 },
-                "currency_id": {
-                    "type": "string"
+                "page_size": {
+                    "type": "integer"
                 },
-                "request_id": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1
+                "total": {
+                    "type": "integer"
                 }
             }
         },
-        "CreateWalletExternalRequest": {
+        "GetCurrencyResponse": {
             "type": "object",
-            "required": [
-                "store_external_id"
-            ],
             "properties": {
-                "amount": {
-                    "type": "number"
+                "blockchain": {
+                    "type": "string"
                 },
-                "currency": {
+                "blockchain_icon": {
+                    "$ref": "#/definitions/CurrencyIcon"
+                },
+                "code": {
                     "type": "string"
                 },
-                "email": {
-                    "type": "string",
-                    "format": "email"
+                "contract_address": {
+                    "type": "string"
                 },
-                "ip": {
-                    "type": "string",
-                    "format": "ipv4"
+                "explorer_link": {
+                    "type": "string"
                 },
-                "locale": {
+                "has_balance": {
+                    "type": "boolean"
+                },
+                "icon": {
+                    "$ref": "#/definitions/CurrencyIcon"
+                },
+                "id": {
                     "type": "string"
                 },
-                "store_external_id": {
-                    "type": "string",
-                    "format": "uuid"
+                "is_fiat": {
+                    "type": "boolean"
+                },
+                "min_confirmation": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "precision": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "boolean"
+                },
+                "withdrawal_min_balance": {
+                    "type": "number"
                 }
             }
         },
-        "CreateWalletExternalResponse": {
+        "InvoiceResponse": {
             "type": "object",
             "properties": {
                 "address": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/WalletAddressResponse"
-                    }
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "number"
                 },
                 "amount_usd": {
-                    "type": "string"
+                    "type": "number"
                 },
                 "created_at": {
                     "type": "string",
                     "format": "date-time"
                 },
-                "id": {
+                "credit_amount_usd": {
+                    "type": "number"
+                },
+                "currency_id": {
                     "type": "string"
                 },
-                "pay_url": {
+                "description": {
                     "type": "string"
                 },
-                "rates": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    }
+                "expires_at": {
+                    "type": "string",
+                    "format": "date-time"
                 },
-                "store_external_id": {
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "leftover_action": {
+                    "$ref": "#/definitions/LeftoverAction"
+                },
+                "leftover_amount_usd": {
+                    "type": "number"
+                },
+                "order_id": {
                     "type": "string"
                 },
+                "paid_amount": {
+                    "type": "number"
+                },
+                "paid_amount_usd": {
+                    "type": "number"
+                },
+                "paid_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "status": {
+                    "$ref": "#/definitions/InvoiceStatus"
+                },
                 "store_id": {
-                    "type": "string"
+                    "type": "string",
+                    "format": "uuid"
                 },
                 "updated_at": {
                     "type": "string",
                     "format": "date-time"
+                },
+                "wallet_id": {
+                    "type": "string",
+                    "format": "uuid"
                 }
             }
         },
-        "CurrenciesExtendedResponse": {
+        "InvoiceStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "partially_paid",
+                "paid",
+                "overpaid",
+                "expired",
+                "cancelled"
+            ],
+            "x-enum-varnames": [
+                "InvoiceStatusPending",
+                "InvoiceStatusPartiallyPaid",
+                "InvoiceStatusPaid",
+                "InvoiceStatusOverpaid",
+                "InvoiceStatusExpired",
+                "InvoiceStatusCancelled"
+            ]
+        },
+        "InvoiceStatusHistoryResponse": {
             "type": "object",
             "properties": {
-                "blockchains": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/BlockchainGroup"
-                    }
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
                 },
-                "currencies": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/CurrencyExtendedItem"
-                    }
+                "status_from": {
+                    "$ref": "#/definitions/InvoiceStatus"
                 },
-                "tokens": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/TokenGroup"
-                    }
+                "status_to": {
+                    "$ref": "#/definitions/InvoiceStatus"
+                },
+                "transaction_id": {
+                    "type": "string",
+                    "format": "uuid"
                 }
             }
         },
-        "CurrencyDTO": {
+        "InvoiceWithHistoryResponse": {
             "type": "object",
             "properties": {
-                "blockchain": {
-                    "$ref": "#/definitions/Blockchain"
-                },
-                "code": {
+                "address": {
                     "type": "string"
                 },
-                "contract_address": {
-                    "type": "string"
+                "amount": {
+                    "type": "number"
                 },
-                "id": {
-                    "type": "string"
+                "amount_usd": {
+                    "type": "number"
                 },
-                "is_native": {
-                    "type": "boolean"
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
                 },
-                "name": {
+                "credit_amount_usd": {
+                    "type": "number"
+                },
+                "currency_id": {
                     "type": "string"
                 },
-                "sort_order": {
-                    "type": "integer"
-                }
-            }
-        },
-        "CurrencyExtendedItem": {
-            "type": "object",
-            "properties": {
-                "blockchain": {
+                "description": {
                     "type": "string"
                 },
-                "blockchain_icon": {
-                    "$ref": "#/definitions/CurrencyIcon"
+                "expires_at": {
+                    "type": "string",
+                    "format": "date-time"
                 },
-                "code": {
-                    "type": "string"
+                "history": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/InvoiceStatusHistoryResponse"
+                    }
                 },
-                "contract_address": {
-                    "type": "string"
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
                 },
-                "explorer_link": {
+                "leftover_action": {
+                    "$ref": "#/definitions/LeftoverAction"
+                },
+                "leftover_amount_usd": {
+                    "type": "number"
+                },
+                "order_id": {
                     "type": "string"
                 },
-                "has_balance": {
-                    "type": "boolean"
+                "paid_amount": {
+                    "type": "number"
                 },
-                "icon": {
-                    "$ref": "#/definitions/CurrencyIcon"
+                "paid_amount_usd": {
+                    "type": "number"
                 },
-                "id": {
-                    "type": "string"
+                "paid_at": {
+                    "type": "string",
+                    "format": "date-time"
                 },
-                "min_confirmation": {
-                    "type": "integer"
+                "status": {
+                    "$ref": "#/definitions/InvoiceStatus"
                 },
-                "name": {
-                    "type": "string"
+                "store_id": {
+                    "type": "string",
+                    "format": "uuid"
                 },
-                "token_icon": {
-                    "$ref": "#/definitions/CurrencyIcon"
+                "updated_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "wallet_id": {
+                    "type": "string",
+                    "format": "uuid"
                 }
             }
         },
-        "CurrencyIcon": {
+        "JSONResponse-CreateWalletExternalResponse": {
             "type": "object",
             "properties": {
-                "icon_128": {
-                    "type": "string"
+                "code": {
+                    "type": "integer"
                 },
-                "icon_512": {
-                    "type": "string"
+                "data": {
+                    "$ref": "#/definitions/CreateWalletExternalResponse"
                 },
-                "icon_svg": {
+                "message": {
                     "type": "string"
                 }
             }
         },
-        "CurrencyRate": {
+        "JSONResponse-CurrenciesExtendedResponse": {
             "type": "object",
             "properties": {
                 "code": {
-                    "type": "string"
+                    "type": "integer"
                 },
-                "rate": {
-                    "type": "string"
+                "data": {
+                    "$ref": "#/definitions/CurrenciesExtendedResponse"
                 },
-                "rate_source": {
+                "message": {
                     "type": "string"
                 }
             }
         },
-        "CurrencyShort": {
+        "JSONResponse-CurrencyRate": {
             "type": "object",
             "properties": {
-                "blockchain": {
-                    "$ref": "#/definitions/Blockchain"
-                },
                 "code": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "is_bitcoin_like": {
-                    "type": "boolean"
-                },
-                "is_evm_like": {
-                    "type": "boolean"
+                    "type": "integer"
                 },
-                "is_stable_coin": {
-                    "type": "boolean"
+                "data": {
+                    "$ref": "#/definitions/CurrencyRate"
                 },
-                "name": {
+                "message": {
                     "type": "string"
-                },
-                "precision": {
-                    "type": "integer"
                 }
             }
         },
-        "ExchangeAsset": {
+        "JSONResponse-ExternalExchangeBalanceResponse": {
             "type": "object",
             "properties": {
-                "amount": {
-                    "type": "number"
+                "code": {
+                    "type": "integer"
                 },
-                "amount_usd": {
-                    "type": "number"
+                "data": {
+                    "$ref": "#/definitions/ExternalExchangeBalanceResponse"
                 },
-                "currency": {
+                "message": {
                     "type": "string"
                 }
             }
         },
-        "ExternalExchangeBalanceResponse": {
+        "JSONResponse-ExternalProcessingWalletBalanceResponse": {
             "type": "object",
             "properties": {
-                "balances": {
+                "code": {
+                    "type": "integer"
+                },
+                "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/ExchangeAsset"
+                        "$ref": "#/definitions/ProcessingWalletWithAssets"
                     }
                 },
-                "total_usd": {
-                    "type": "number"
+                "message": {
+                    "type": "string"
                 }
             }
         },
-        "GetCurrencyResponse": {
+        "JSONResponse-InvoiceResponse": {
             "type": "object",
             "properties": {
-                "blockchain": {
-                    "type": "string"
-                },
-                "blockchain_icon": {
-                    "$ref": "#/definitions/CurrencyIcon"
-                },
                 "code": {
-                    "type": "string"
-                },
-                "contract_address": {
-                    "type": "string"
-                },
-                "explorer_link": {
-                    "type": "string"
-                },
-                "has_balance": {
-                    "type": "boolean"
+                    "type": "integer"
                 },
-                "icon": {
-                    "$ref": "#/definitions/CurrencyIcon"
+                "data": {
+                    "$ref": "#/definitions/InvoiceResponse"
                 },
-                "id": {
+                "message": {
                     "type": "string"
-                },
-                "is_fiat": {
-                    "type": "boolean"
-                },
-                "min_confirmation": {
+                }
+            }
+        },
+        "JSONResponse-InvoiceWithHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
                     "type": "integer"
                 },
-                "name": {
-                    "type": "string"
+                "data": {
+                    "$ref": "#/definitions/InvoiceWithHistoryResponse"
                 },
-                "precision": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "JSONResponse-ProcessingWithdrawalResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
                     "type": "integer"
                 },
-                "status": {
-                    "type": "boolean"
+                "data": {
+                    "$ref": "#/definitions/ProcessingWithdrawalResponse"
                 },
-                "withdrawal_min_balance": {
-                    "type": "number"
+                "message": {
+                    "type": "string"
                 }
             }
         },
-        "JSONResponse-CreateWalletExternalResponse": {
+        "JSONResponse-RefundResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/CreateWalletExternalResponse"
+                    "$ref": "#/definitions/RefundResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-CurrenciesExtendedResponse": {
+        "JSONResponse-RefundWithHistoryResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/CurrenciesExtendedResponse"
+                    "$ref": "#/definitions/RefundWithHistoryResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-CurrencyRate": {
+        "JSONResponse-RequeueWebhookDeadLettersResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/CurrencyRate"
+                    "$ref": "#/definitions/RequeueWebhookDeadLettersResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-ExternalExchangeBalanceResponse": {
+        "JSONResponse-ResponseWithFullPagination-WebhookDeadLetterResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/ExternalExchangeBalanceResponse"
+                    "$ref": "#/definitions/ResponseWithFullPagination-WebhookDeadLetterResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-ExternalProcessingWalletBalanceResponse": {
+        "JSONResponse-UnconfirmedTransactionResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/ProcessingWalletWithAssets"
-                    }
+                    "$ref": "#/definitions/UnconfirmedTransactionResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-ProcessingWithdrawalResponse": {
+        "JSONResponse-WebhookDeadLetterWithPayloadResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/ProcessingWithdrawalResponse"
+                    "$ref": "#/definitions/WebhookDeadLetterWithPayloadResponse"
                 },
                 "message": {
                     "type": "string"
                 }
             }
         },
-        "JSONResponse-UnconfirmedTransactionResponse": {
+        "JSONResponse-WithdrawalApprovalResponse": {
             "type": "object",
             "properties": {
                 "code": {
                     "type": "integer"
                 },
                 "data": {
-                    "$ref": "#/definitions/UnconfirmedTransactionResponse"
+                    "$ref": "#/definitions/WithdrawalApprovalResponse"
                 },
                 "message": {
                     "type": "string"
@@ -1392,6 +2425,19 @@ const docTemplate = `{
                 }
             }
         },
+        "LeftoverAction": {
+            "type": "string",
+            "enum": [
+                "refund_request",
+                "credit_next_invoice",
+                "ignore"
+            ],
+            "x-enum-varnames": [
+                "LeftoverActionRefundRequest",
+                "LeftoverActionCreditNextInvoice",
+                "LeftoverActionIgnore"
+            ]
+        },
         "MarkIsDirtyRequest": {
             "type": "object",
             "required": [
@@ -1403,46 +2449,193 @@ const docTemplate = `{
                 }
             }
         },
-        "ProcessingWalletWithAssets": {
+        "ProcessingWalletWithAssets": {
+            "type": "object",
+            "properties": {
+                "additional_data": {
+                    "$ref": "#/definitions/BlockchainAdditionalData"
+                },
+                "address": {
+                    "type": "string"
+                },
+                "assets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/Asset"
+                    }
+                },
+                "balance": {
+                    "$ref": "#/definitions/Balance"
+                },
+                "blockchain": {
+                    "$ref": "#/definitions/Blockchain"
+                },
+                "currency": {
+                    "$ref": "#/definitions/CurrencyShort"
+                }
+            }
+        },
+        "ProcessingWithdrawalResponse": {
+            "type": "object",
+            "properties": {
+                "address_from": {
+                    "type": "string"
+                },
+                "address_to": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "string"
+                },
+                "amount_usd": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "currency_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "store_id": {
+                    "type": "string"
+                },
+                "transfer_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "RefundReason": {
+            "type": "string",
+            "enum": [
+                "aml_blocked",
+                "overpayment",
+                "manual"
+            ],
+            "x-enum-varnames": [
+                "RefundReasonAMLBlocked",
+                "RefundReasonOverpayment",
+                "RefundReasonManual"
+            ]
+        },
+        "RefundResponse": {
+            "type": "object",
+            "properties": {
+                "address_to": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "number"
+                },
+                "amount_usd": {
+                    "type": "number"
+                },
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "currency_id": {
+                    "type": "string"
+                },
+                "decided_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "failure_reason": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "invoice_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "reason": {
+                    "$ref": "#/definitions/RefundReason"
+                },
+                "refund_tx_hash": {
+                    "type": "string"
+                },
+                "rejection_reason": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/RefundStatus"
+                },
+                "store_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "transaction_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "withdrawal_id": {
+                    "type": "string",
+                    "format": "uuid"
+                }
+            }
+        },
+        "RefundStatus": {
+            "type": "string",
+            "enum": [
+                "pending_approval",
+                "approved",
+                "processing",
+                "completed",
+                "rejected",
+                "failed",
+                "cancelled"
+            ],
+            "x-enum-varnames": [
+                "RefundStatusPendingApproval",
+                "RefundStatusApproved",
+                "RefundStatusProcessing",
+                "RefundStatusCompleted",
+                "RefundStatusRejected",
+                "RefundStatusFailed",
+                "RefundStatusCancelled"
+            ]
+        },
+        "RefundStatusHistoryResponse": {
             "type": "object",
             "properties": {
-                "additional_data": {
-                    "$ref": "#/definitions/BlockchainAdditionalData"
-                },
-                "address": {
-                    "type": "string"
-                },
-                "assets": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/Asset"
-                    }
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
                 },
-                "balance": {
-                    "$ref": "#/definitions/Balance"
+                "status_from": {
+                    "$ref": "#/definitions/RefundStatus"
                 },
-                "blockchain": {
-                    "$ref": "#/definitions/Blockchain"
+                "status_to": {
+                    "$ref": "#/definitions/RefundStatus"
                 },
-                "currency": {
-                    "$ref": "#/definitions/CurrencyShort"
+                "user_id": {
+                    "type": "string",
+                    "format": "uuid"
                 }
             }
         },
-        "ProcessingWithdrawalResponse": {
+        "RefundWithHistoryResponse": {
             "type": "object",
             "properties": {
-                "address_from": {
-                    "type": "string"
-                },
                 "address_to": {
                     "type": "string"
                 },
                 "amount": {
-                    "type": "string"
+                    "type": "number"
                 },
                 "amount_usd": {
-                    "type": "string"
+                    "type": "number"
                 },
                 "created_at": {
                     "type": "string",
@@ -1451,14 +2644,92 @@ const docTemplate = `{
                 "currency_id": {
                     "type": "string"
                 },
-                "id": {
+                "decided_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "failure_reason": {
                     "type": "string"
                 },
-                "store_id": {
+                "history": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/RefundStatusHistoryResponse"
+                    }
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "invoice_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "reason": {
+                    "$ref": "#/definitions/RefundReason"
+                },
+                "refund_tx_hash": {
                     "type": "string"
                 },
-                "transfer_id": {
+                "rejection_reason": {
                     "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/RefundStatus"
+                },
+                "store_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "transaction_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "withdrawal_id": {
+                    "type": "string",
+                    "format": "uuid"
+                }
+            }
+        },
+        "RequeueWebhookDeadLettersRequest": {
+            "type": "object",
+            "properties": {
+                "ids": {
+                    "description": "IDs of dead letters to requeue, all pending ones are requeued when empty",
+                    "type": "array",
+                    "maxItems": 1000,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "RequeueWebhookDeadLettersResponse": {
+            "type": "object",
+            "properties": {
+                "already_queued": {
+                    "type": "integer"
+                },
+                "requeued": {
+                    "type": "integer"
+                }
+            }
+        },
+        "ResponseWithFullPagination-WebhookDeadLetterResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/WebhookDeadLetterResponse"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/FullPagingData"
                 }
             }
         },
@@ -1669,6 +2940,18 @@ const docTemplate = `{
                 }
             }
         },
+        "UpdateRefundAddressRequest": {
+            "type": "object",
+            "required": [
+                "address_to"
+            ],
+            "properties": {
+                "address_to": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
         "WalletAddressResponse": {
             "type": "object",
             "properties": {
@@ -1707,6 +2990,193 @@ const docTemplate = `{
                 }
             }
         },
+        "WebhookDeadLetterResponse": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "event": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "last_response": {
+                    "type": "string"
+                },
+                "last_response_status_code": {
+                    "type": "integer"
+                },
+                "requeued_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "store_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "transaction_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "webhook_id": {
+                    "type": "string",
+                    "format": "uuid"
+                }
+            }
+        },
+        "WebhookDeadLetterWithPayloadResponse": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "event": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "last_response": {
+                    "type": "string"
+                },
+                "last_response_status_code": {
+                    "type": "integer"
+                },
+                "payload": {
+                    "type": "string"
+                },
+                "requeued_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "signature": {
+                    "type": "string"
+                },
+                "store_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "transaction_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "webhook_id": {
+                    "type": "string",
+                    "format": "uuid"
+                }
+            }
+        },
+        "WithdrawalApprovalKind": {
+            "type": "string",
+            "enum": [
+                "from_address",
+                "from_addresses",
+                "from_processing"
+            ],
+            "x-enum-varnames": [
+                "WithdrawalApprovalKindFromAddress",
+                "WithdrawalApprovalKindFromAddresses",
+                "WithdrawalApprovalKindFromProcessing"
+            ]
+        },
+        "WithdrawalApprovalResponse": {
+            "type": "object",
+            "properties": {
+                "address_to": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "number"
+                },
+                "amount_usd": {
+                    "type": "number"
+                },
+                "created_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "currency_id": {
+                    "type": "string"
+                },
+                "executed_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "expires_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "failure_reason": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "initiated_by": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "kind": {
+                    "$ref": "#/definitions/WithdrawalApprovalKind"
+                },
+                "required_approvals": {
+                    "type": "integer"
+                },
+                "rule_id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "status": {
+                    "$ref": "#/definitions/WithdrawalApprovalStatus"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "user_id": {
+                    "type": "string",
+                    "format": "uuid"
+                }
+            }
+        },
+        "WithdrawalApprovalStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "approved",
+                "executed",
+                "failed",
+                "rejected",
+                "expired"
+            ],
+            "x-enum-varnames": [
+                "WithdrawalApprovalStatusPending",
+                "WithdrawalApprovalStatusApproved",
+                "WithdrawalApprovalStatusExecuted",
+                "WithdrawalApprovalStatusFailed",
+                "WithdrawalApprovalStatusRejected",
+                "WithdrawalApprovalStatusExpired"
+            ]
+        },
         "WithdrawalFromProcessingDto": {
             "type": "object",
             "properties": {
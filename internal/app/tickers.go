@@ -28,6 +28,10 @@ func initTickers(ctx context.Context, services *service.Services, conf *config.C
 		go services.UnconfirmedCollapser.Run(ctx, conf.Transactions.UnconfirmedCollapseInterval)
 	}
 
+	if services.OutboxService != nil {
+		go services.OutboxService.Run(ctx)
+	}
+
 	if services.NotificationService != nil {
 		go services.NotificationService.Run(ctx)
 	}
@@ -62,6 +66,14 @@ func initTickers(ctx context.Context, services *service.Services, conf *config.C
 	if services.AMLStatusChecker != nil {
 		go services.AMLStatusChecker.Run(ctx)
 	}
+
+	if services.InvoiceService != nil {
+		go services.InvoiceService.Run(ctx)
+	}
+
+	if services.RefundService != nil {
+		go services.RefundService.Run(ctx)
+	}
 }
 
 func processingPingMonitor(ctx context.Context, services *service.Services, l logger.Logger) {
@@ -2,10 +2,13 @@ package handlers
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	// Blank import for swaggen
 	_ "github.com/dv-net/dv-merchant/internal/delivery/http/responses/currency_response"
-	_ "github.com/dv-net/dv-merchant/internal/service/exrate"
+
+	"github.com/dv-net/dv-merchant/internal/service/exrate"
 
 	"github.com/dv-net/dv-merchant/internal/delivery/http/request/currency_request"
 	"github.com/dv-net/dv-merchant/internal/models"
@@ -84,6 +87,47 @@ func (h *Handler) getAllCurrencyRates(c fiber.Ctx) error {
 	return c.JSON(response.OkByData(rates))
 }
 
+// getCurrencyRateAt returns rate snapshotted at the requested time
+//
+//	@Summary		Get historical Currency rate
+//	@Description	Get rate of the latest snapshot taken at or before the requested time
+//	@Tags			Currency
+//	@Accept			json
+//	@Produce		json
+//	@Param			source	path		string								true	"Currency Source example binance"
+//	@Param			string	query		currency_request.GetRateAtRequest	true	"GetRateAtRequest"
+//	@Success		200		{object}	response.Result[exrate.ExRate]
+//	@Failure		400		{object}	apierror.Errors
+//	@Failure		404		{object}	apierror.Errors
+//	@Router			/v1/dv-admin/currencies/rate/{source}/history [get]
+//	@Security		BearerAuth
+func (h *Handler) getCurrencyRateAt(c fiber.Ctx) error {
+	user, err := loadAuthUser(c)
+	if err != nil {
+		return err
+	}
+
+	req := &currency_request.GetRateAtRequest{}
+	if err = c.Bind().Query(req); err != nil {
+		return err
+	}
+
+	at, err := time.Parse(time.RFC3339, req.At)
+	if err != nil {
+		return apierror.New().AddError(fmt.Errorf("invalid time: %w", err)).SetHttpCode(fiber.StatusBadRequest)
+	}
+
+	rate, err := h.services.ExRateService.GetRateAt(c.Context(), c.Params("source"), req.From, req.To, at, user.RateScale)
+	if err != nil {
+		if rateNotFound := new(exrate.ExchangeRateNotFoundError); errors.As(err, &rateNotFound) {
+			return apierror.New().AddError(err).SetHttpCode(fiber.StatusNotFound)
+		}
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusBadRequest)
+	}
+
+	return c.JSON(response.OkByData(rate))
+}
+
 // updateRate is a function to Update rate scale
 //
 //	@Summary		Get Currency rate
@@ -126,5 +170,6 @@ func (h *Handler) initCurrencyRoutes(v1 fiber.Router) {
 	currencies.Get("/rate/", h.getAllCurrencyRates)
 	currencies.Put("/rate/", h.updateRate)
 	currencies.Get("/rate/:source", h.getAllCurrencyRates)
+	currencies.Get("/rate/:source/history", h.getCurrencyRateAt)
 	currencies.Get("/:id", h.getCurrencyByID)
 }
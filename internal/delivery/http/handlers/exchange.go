@@ -162,6 +162,35 @@ func (h *Handler) testConnection(c fiber.Ctx) error {
 	return c.JSON(response.OkByMessage("ok"))
 }
 
+// @Summary		Get exchange key permissions
+// @Description	Rights of the stored exchange key with warnings for rights missing or unused by enabled swaps and withdrawals
+// @Tags			Exchange
+// @Produce		json
+// @Param			exchange_slug	path		string	true	"Exchange slug"
+// @Success		200				{object}	response.Result[exchange_response.ExchangeKeyPermissionsResponse]
+// @Failure		400				{object}	apierror.Errors
+// @Failure		404				{object}	apierror.Errors
+// @Router			/v1/dv-admin/exchange/{exchange_slug}/key-permissions [get]
+// @Security		Bearer
+func (h *Handler) getKeyPermissions(c fiber.Ctx) error {
+	usr, err := loadAuthUser(c)
+	if err != nil {
+		return err
+	}
+
+	slug := models.ExchangeSlug(c.Params("exchange_slug"))
+	if !slug.Valid() {
+		return apierror.New().AddError(errors.New("exchange not found")).SetHttpCode(fiber.StatusNotFound)
+	}
+
+	res, err := h.services.ExchangeService.GetKeyPermissions(c.Context(), *usr, slug)
+	if err != nil {
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusBadRequest)
+	}
+
+	return c.JSON(response.OkByData(converters.FromExchangeKeyPermissionsToResponse(res)))
+}
+
 // @Summary		Test exchange API connection
 // @Description	Test exchange API connection with provided credentials
 // @Tags			Exchange
@@ -840,6 +869,7 @@ func (h *Handler) initExchangeRoutes(router fiber.Router) {
 	g.Post("/:exchange_slug/keys", h.updateKeys)
 	g.Delete("/:exchange_slug/keys", h.deleteKeys)
 	g.Get("/:exchange_slug/test", h.testConnection)
+	g.Get("/:exchange_slug/key-permissions", h.getKeyPermissions)
 	g.Post("/test", h.testConnectionExternal)
 	g.Get("/:exchange_slug/balance", h.getBalance)
 	g.Post("/:exchange_slug/set", h.setExchange)
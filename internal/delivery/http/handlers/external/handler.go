@@ -37,6 +37,9 @@ func (h *Handler) Init(api *fiber.App) {
 	h.initExchangeBalances(secured)
 	h.initProcessingWalletBalances(secured)
 	h.initTransactionsRouter(secured)
+	h.initInvoiceRoutes(secured)
+	h.initRefundRoutes(secured)
+	h.initWebhookDeadLetterRoutes(secured)
 }
 
 func loadAuthStore(c fiber.Ctx) (*models.Store, error) {
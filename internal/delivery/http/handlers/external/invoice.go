@@ -0,0 +1,141 @@
+package external
+
+import (
+	"errors"
+
+	"github.com/dv-net/dv-merchant/internal/delivery/http/request/invoice_request"
+	"github.com/dv-net/dv-merchant/internal/service/invoice"
+	"github.com/dv-net/dv-merchant/internal/tools"
+	"github.com/dv-net/dv-merchant/internal/tools/apierror"
+	"github.com/dv-net/dv-merchant/internal/tools/converters"
+	"github.com/dv-net/dv-merchant/internal/tools/response"
+
+	_ "github.com/dv-net/dv-merchant/internal/delivery/http/responses/invoice_response" // swaggo
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// createInvoice is a function to create invoice
+//
+//	@Summary		Create invoice
+//	@Description	Create invoice with fixed amount and expiry
+//	@Tags			Invoice
+//	@Accept			json
+//	@Produce		json
+//	@Param			api_key		query		string							false	"Store API key"
+//	@Param			register	body		invoice_request.CreateRequest	true	"Create invoice"
+//	@Success		200			{object}	response.Result[invoice_response.InvoiceResponse]
+//	@Failure		400			{object}	apierror.Errors
+//	@Failure		401			{object}	apierror.Errors
+//	@Failure		422			{object}	apierror.Errors
+//	@Router			/v1/external/invoice [post]
+//	@Security		XApiKey
+func (h *Handler) createInvoice(c fiber.Ctx) error {
+	store, err := loadAuthStore(c)
+	if err != nil {
+		return err
+	}
+
+	request := &invoice_request.CreateRequest{}
+	if err := c.Bind().Body(request); err != nil {
+		return err
+	}
+
+	inv, err := h.services.InvoiceService.Create(c.Context(), store, converters.FromInvoiceCreateRequestToDTO(request))
+	if err != nil {
+		return handleInvoiceError(err)
+	}
+
+	return c.JSON(response.OkByData(converters.FromInvoiceModelToResponse(inv)))
+}
+
+// getInvoice is a function to get invoice with status history
+//
+//	@Summary		Get invoice
+//	@Description	Get invoice with status history
+//	@Tags			Invoice
+//	@Accept			json
+//	@Produce		json
+//	@Param			api_key	query		string	false	"Store API key"
+//	@Param			id		path		string	true	"Invoice ID"
+//	@Success		200		{object}	response.Result[invoice_response.InvoiceWithHistoryResponse]
+//	@Failure		401		{object}	apierror.Errors
+//	@Failure		404		{object}	apierror.Errors
+//	@Router			/v1/external/invoice/{id} [get]
+//	@Security		XApiKey
+func (h *Handler) getInvoice(c fiber.Ctx) error {
+	store, err := loadAuthStore(c)
+	if err != nil {
+		return err
+	}
+
+	invoiceID, err := tools.ValidateUUID(c.Params("id"))
+	if err != nil {
+		return err
+	}
+
+	res, err := h.services.InvoiceService.GetByStore(c.Context(), store, invoiceID)
+	if err != nil {
+		return handleInvoiceError(err)
+	}
+
+	return c.JSON(response.OkByData(converters.FromInvoiceWithHistoryToResponse(res)))
+}
+
+// cancelInvoice is a function to cancel open invoice
+//
+//	@Summary		Cancel invoice
+//	@Description	Cancel pending or partially paid invoice
+//	@Tags			Invoice
+//	@Accept			json
+//	@Produce		json
+//	@Param			api_key	query		string	false	"Store API key"
+//	@Param			id		path		string	true	"Invoice ID"
+//	@Success		200		{object}	response.Result[invoice_response.InvoiceResponse]
+//	@Failure		401		{object}	apierror.Errors
+//	@Failure		404		{object}	apierror.Errors
+//	@Failure		422		{object}	apierror.Errors
+//	@Router			/v1/external/invoice/{id}/cancel [post]
+//	@Security		XApiKey
+func (h *Handler) cancelInvoice(c fiber.Ctx) error {
+	store, err := loadAuthStore(c)
+	if err != nil {
+		return err
+	}
+
+	invoiceID, err := tools.ValidateUUID(c.Params("id"))
+	if err != nil {
+		return err
+	}
+
+	inv, err := h.services.InvoiceService.Cancel(c.Context(), store, invoiceID)
+	if err != nil {
+		return handleInvoiceError(err)
+	}
+
+	return c.JSON(response.OkByData(converters.FromInvoiceModelToResponse(inv)))
+}
+
+func handleInvoiceError(err error) error {
+	switch {
+	case errors.Is(err, invoice.ErrInvoiceNotFound):
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusNotFound)
+	case errors.Is(err, invoice.ErrInvoiceNotOpen),
+		errors.Is(err, invoice.ErrAddressBusy),
+		errors.Is(err, invoice.ErrDuplicateOrderID):
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusUnprocessableEntity)
+	case errors.Is(err, invoice.ErrInvalidAmount),
+		errors.Is(err, invoice.ErrInvalidLifetime),
+		errors.Is(err, invoice.ErrCurrencyNotAvailable):
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusBadRequest)
+	}
+
+	return apierror.New().AddError(errors.New("failed to process invoice")).SetHttpCode(fiber.StatusBadRequest)
+}
+
+func (h *Handler) initInvoiceRoutes(v1 fiber.Router) {
+	w := v1.Group("/invoice")
+	w.Post("/", h.createInvoice)
+	w.Get("/:id", h.getInvoice)
+	w.Post("/:id/cancel", h.cancelInvoice)
+}
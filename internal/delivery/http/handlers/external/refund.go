@@ -0,0 +1,191 @@
+package external
+
+import (
+	"errors"
+
+	"github.com/dv-net/dv-merchant/internal/delivery/http/request/refund_request"
+	"github.com/dv-net/dv-merchant/internal/service/refund"
+	"github.com/dv-net/dv-merchant/internal/tools"
+	"github.com/dv-net/dv-merchant/internal/tools/apierror"
+	"github.com/dv-net/dv-merchant/internal/tools/converters"
+	"github.com/dv-net/dv-merchant/internal/tools/response"
+
+	_ "github.com/dv-net/dv-merchant/internal/delivery/http/responses/refund_response" // swaggo
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+// createRefund is a function to request refund of deposit
+//
+//	@Summary		Create refund
+//	@Description	Request refund of a deposit to payer supplied or source address, it is executed after approval
+//	@Tags			Refund
+//	@Accept			json
+//	@Produce		json
+//	@Param			api_key		query		string							false	"Store API key"
+//	@Param			register	body		refund_request.CreateRequest	true	"Create refund"
+//	@Success		200			{object}	response.Result[refund_response.RefundResponse]
+//	@Failure		400			{object}	apierror.Errors
+//	@Failure		401			{object}	apierror.Errors
+//	@Failure		404			{object}	apierror.Errors
+//	@Failure		422			{object}	apierror.Errors
+//	@Router			/v1/external/refund [post]
+//	@Security		XApiKey
+func (h *Handler) createRefund(c fiber.Ctx) error {
+	store, err := loadAuthStore(c)
+	if err != nil {
+		return err
+	}
+
+	request := &refund_request.CreateRequest{}
+	if err := c.Bind().Body(request); err != nil {
+		return err
+	}
+
+	txID, err := tools.ValidateUUID(request.TransactionID)
+	if err != nil {
+		return err
+	}
+
+	res, err := h.services.RefundService.Create(c.Context(), store, converters.FromRefundCreateRequestToDTO(request, txID, uuid.NullUUID{}))
+	if err != nil {
+		return handleRefundError(err)
+	}
+
+	return c.JSON(response.OkByData(converters.FromRefundModelToResponse(res)))
+}
+
+// getRefund is a function to get refund with status history
+//
+//	@Summary		Get refund
+//	@Description	Get refund with status history
+//	@Tags			Refund
+//	@Accept			json
+//	@Produce		json
+//	@Param			api_key	query		string	false	"Store API key"
+//	@Param			id		path		string	true	"Refund ID"
+//	@Success		200		{object}	response.Result[refund_response.RefundWithHistoryResponse]
+//	@Failure		401		{object}	apierror.Errors
+//	@Failure		404		{object}	apierror.Errors
+//	@Router			/v1/external/refund/{id} [get]
+//	@Security		XApiKey
+func (h *Handler) getRefund(c fiber.Ctx) error {
+	store, err := loadAuthStore(c)
+	if err != nil {
+		return err
+	}
+
+	refundID, err := tools.ValidateUUID(c.Params("id"))
+	if err != nil {
+		return err
+	}
+
+	res, err := h.services.RefundService.GetByStore(c.Context(), store, refundID)
+	if err != nil {
+		return handleRefundError(err)
+	}
+
+	return c.JSON(response.OkByData(converters.FromRefundWithHistoryToResponse(res)))
+}
+
+// updateRefundAddress is a function to set payer supplied refund address
+//
+//	@Summary		Update refund address
+//	@Description	Change destination address of refund pending approval
+//	@Tags			Refund
+//	@Accept			json
+//	@Produce		json
+//	@Param			api_key		query		string								false	"Store API key"
+//	@Param			id			path		string								true	"Refund ID"
+//	@Param			register	body		refund_request.UpdateAddressRequest	true	"Refund address"
+//	@Success		200			{object}	response.Result[refund_response.RefundResponse]
+//	@Failure		400			{object}	apierror.Errors
+//	@Failure		401			{object}	apierror.Errors
+//	@Failure		404			{object}	apierror.Errors
+//	@Failure		422			{object}	apierror.Errors
+//	@Router			/v1/external/refund/{id}/address [put]
+//	@Security		XApiKey
+func (h *Handler) updateRefundAddress(c fiber.Ctx) error {
+	store, err := loadAuthStore(c)
+	if err != nil {
+		return err
+	}
+
+	refundID, err := tools.ValidateUUID(c.Params("id"))
+	if err != nil {
+		return err
+	}
+
+	request := &refund_request.UpdateAddressRequest{}
+	if err := c.Bind().Body(request); err != nil {
+		return err
+	}
+
+	res, err := h.services.RefundService.UpdateAddress(c.Context(), store, refundID, request.AddressTo)
+	if err != nil {
+		return handleRefundError(err)
+	}
+
+	return c.JSON(response.OkByData(converters.FromRefundModelToResponse(res)))
+}
+
+// cancelRefund is a function to cancel pending refund
+//
+//	@Summary		Cancel refund
+//	@Description	Cancel refund pending approval
+//	@Tags			Refund
+//	@Accept			json
+//	@Produce		json
+//	@Param			api_key	query		string	false	"Store API key"
+//	@Param			id		path		string	true	"Refund ID"
+//	@Success		200		{object}	response.Result[refund_response.RefundResponse]
+//	@Failure		401		{object}	apierror.Errors
+//	@Failure		404		{object}	apierror.Errors
+//	@Failure		422		{object}	apierror.Errors
+//	@Router			/v1/external/refund/{id}/cancel [post]
+//	@Security		XApiKey
+func (h *Handler) cancelRefund(c fiber.Ctx) error {
+	store, err := loadAuthStore(c)
+	if err != nil {
+		return err
+	}
+
+	refundID, err := tools.ValidateUUID(c.Params("id"))
+	if err != nil {
+		return err
+	}
+
+	res, err := h.services.RefundService.Cancel(c.Context(), store, refundID, uuid.NullUUID{})
+	if err != nil {
+		return handleRefundError(err)
+	}
+
+	return c.JSON(response.OkByData(converters.FromRefundModelToResponse(res)))
+}
+
+func handleRefundError(err error) error {
+	switch {
+	case errors.Is(err, refund.ErrRefundNotFound),
+		errors.Is(err, refund.ErrTransactionNotFound):
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusNotFound)
+	case errors.Is(err, refund.ErrRefundNotPending),
+		errors.Is(err, refund.ErrRefundInProgress),
+		errors.Is(err, refund.ErrTransactionNotRefundable),
+		errors.Is(err, refund.ErrRefundAmountExceeded):
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusUnprocessableEntity)
+	case errors.Is(err, refund.ErrRefundAddressRequired),
+		errors.Is(err, refund.ErrInvalidRefundAddress):
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusBadRequest)
+	}
+
+	return apierror.New().AddError(errors.New("failed to process refund")).SetHttpCode(fiber.StatusBadRequest)
+}
+
+func (h *Handler) initRefundRoutes(v1 fiber.Router) {
+	w := v1.Group("/refund")
+	w.Post("/", h.createRefund)
+	w.Get("/:id", h.getRefund)
+	w.Put("/:id/address", h.updateRefundAddress)
+	w.Post("/:id/cancel", h.cancelRefund)
+}
@@ -1,10 +1,13 @@
 package external
 
 import (
+	"errors"
+
 	"github.com/dv-net/dv-merchant/internal/models"
 	"github.com/dv-net/dv-merchant/internal/util"
 
 	_ "github.com/dv-net/dv-merchant/internal/delivery/http/responses/wallet_response" // swaggo
+	"github.com/dv-net/dv-merchant/internal/service/exrate"
 	"github.com/dv-net/dv-merchant/internal/service/wallet"
 
 	"github.com/dv-net/dv-merchant/internal/delivery/http/request/wallet_request"
@@ -27,6 +30,7 @@ import (
 //	@Success		200			{object}	response.Result[wallet_response.CreateWalletExternalResponse]
 //	@Failure		401			{object}	apierror.Errors
 //	@Failure		500			{object}	apierror.Errors
+//	@Failure		503			{object}	apierror.Errors
 //	@Router			/v1/external/wallet [post]
 //	@Security		XApiKey
 func (h *Handler) createWalletWithAddressByBody(c fiber.Ctx) error {
@@ -56,6 +60,10 @@ func (h *Handler) createWalletWithAddressByBody(c fiber.Ctx) error {
 		amountUSD.String(),
 	)
 	if err != nil {
+		var staleErr *exrate.StaleExchangeRateError
+		if errors.As(err, &staleErr) {
+			return apierror.New().AddError(err).SetHttpCode(fiber.StatusServiceUnavailable)
+		}
 		return apierror.New().AddError(err).SetHttpCode(fiber.StatusBadRequest)
 	}
 
@@ -79,6 +87,7 @@ func (h *Handler) createWalletWithAddressByBody(c fiber.Ctx) error {
 //	@Success		200		{object}	response.Result[wallet_response.CreateWalletExternalResponse]
 //	@Failure		401		{object}	apierror.Errors
 //	@Failure		500		{object}	apierror.Errors
+//	@Failure		503		{object}	apierror.Errors
 //	@Router			/v1/external/wallet [get]
 //	@Security		XApiKey
 func (h *Handler) createWalletWithAddressByQuery(c fiber.Ctx) error {
@@ -108,6 +117,10 @@ func (h *Handler) createWalletWithAddressByQuery(c fiber.Ctx) error {
 		amountUSD.String(),
 	)
 	if err != nil {
+		var staleErr *exrate.StaleExchangeRateError
+		if errors.As(err, &staleErr) {
+			return apierror.New().AddError(err).SetHttpCode(fiber.StatusServiceUnavailable)
+		}
 		return apierror.New().AddError(err).SetHttpCode(fiber.StatusBadRequest)
 	}
 
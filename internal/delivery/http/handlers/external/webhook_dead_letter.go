@@ -0,0 +1,153 @@
+package external
+
+import (
+	"errors"
+
+	"github.com/dv-net/dv-merchant/internal/delivery/http/request/store_webhook_request"
+	"github.com/dv-net/dv-merchant/internal/service/webhook"
+	"github.com/dv-net/dv-merchant/internal/tools"
+	"github.com/dv-net/dv-merchant/internal/tools/apierror"
+	"github.com/dv-net/dv-merchant/internal/tools/converters"
+	"github.com/dv-net/dv-merchant/internal/tools/response"
+
+	_ "github.com/dv-net/dv-merchant/internal/delivery/http/responses/webhook_response" // swaggo
+	_ "github.com/dv-net/dv-merchant/internal/storage/storecmn"                         // swaggo
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+// listWebhookDeadLetters is a function to list webhooks that exhausted store retry policy
+//
+//	@Summary		List webhook dead letters
+//	@Description	List store webhooks that exhausted retry policy
+//	@Tags			Webhook
+//	@Accept			json
+//	@Produce		json
+//	@Param			api_key	query		string											false	"Store API key"
+//	@Param			string	query		store_webhook_request.ListDeadLettersRequest	true	"Dead letters filter"
+//	@Success		200		{object}	response.Result[storecmn.FindResponseWithFullPagination[webhook_response.DeadLetterResponse]]
+//	@Failure		400		{object}	apierror.Errors
+//	@Failure		401		{object}	apierror.Errors
+//	@Router			/v1/external/webhook/dead-letters [get]
+//	@Security		XApiKey
+func (h *Handler) listWebhookDeadLetters(c fiber.Ctx) error {
+	store, err := loadAuthStore(c)
+	if err != nil {
+		return err
+	}
+
+	request := &store_webhook_request.ListDeadLettersRequest{}
+	if err := c.Bind().Query(request); err != nil {
+		return err
+	}
+
+	var txID *uuid.UUID
+	if request.TransactionID != nil {
+		id, err := tools.ValidateUUID(*request.TransactionID)
+		if err != nil {
+			return err
+		}
+		txID = &id
+	}
+
+	res, err := h.services.WebHookService.ListDeadLetters(c.Context(), store.ID, converters.FromDeadLettersListRequestToDTO(request, txID))
+	if err != nil {
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusBadRequest)
+	}
+
+	return c.JSON(response.OkByData(converters.FromDeadLettersListToResponse(res)))
+}
+
+// getWebhookDeadLetter is a function to inspect webhook dead letter
+//
+//	@Summary		Get webhook dead letter
+//	@Description	Get webhook dead letter with payload and signature
+//	@Tags			Webhook
+//	@Accept			json
+//	@Produce		json
+//	@Param			api_key	query		string	false	"Store API key"
+//	@Param			id		path		string	true	"Dead letter ID"
+//	@Success		200		{object}	response.Result[webhook_response.DeadLetterWithPayloadResponse]
+//	@Failure		401		{object}	apierror.Errors
+//	@Failure		404		{object}	apierror.Errors
+//	@Router			/v1/external/webhook/dead-letters/{id} [get]
+//	@Security		XApiKey
+func (h *Handler) getWebhookDeadLetter(c fiber.Ctx) error {
+	store, err := loadAuthStore(c)
+	if err != nil {
+		return err
+	}
+
+	deadLetterID, err := tools.ValidateUUID(c.Params("id"))
+	if err != nil {
+		return err
+	}
+
+	res, err := h.services.WebHookService.GetDeadLetter(c.Context(), store.ID, deadLetterID)
+	if err != nil {
+		return handleWebhookDeadLetterError(err)
+	}
+
+	return c.JSON(response.OkByData(converters.FromDeadLetterModelToPayloadResponse(res)))
+}
+
+// requeueWebhookDeadLetters is a function to put webhook dead letters back to the send queue
+//
+//	@Summary		Requeue webhook dead letters
+//	@Description	Put selected or all pending webhook dead letters back to the send queue
+//	@Tags			Webhook
+//	@Accept			json
+//	@Produce		json
+//	@Param			api_key		query		string											false	"Store API key"
+//	@Param			register	body		store_webhook_request.RequeueDeadLettersRequest	true	"Dead letters to requeue"
+//	@Success		200			{object}	response.Result[webhook_response.RequeueDeadLettersResponse]
+//	@Failure		400			{object}	apierror.Errors
+//	@Failure		401			{object}	apierror.Errors
+//	@Router			/v1/external/webhook/dead-letters/requeue [post]
+//	@Security		XApiKey
+func (h *Handler) requeueWebhookDeadLetters(c fiber.Ctx) error {
+	store, err := loadAuthStore(c)
+	if err != nil {
+		return err
+	}
+
+	request := &store_webhook_request.RequeueDeadLettersRequest{}
+	if err := c.Bind().Body(request); err != nil {
+		return err
+	}
+
+	ids := make([]uuid.UUID, 0, len(request.IDs))
+	for _, value := range request.IDs {
+		id, err := tools.ValidateUUID(value)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, id)
+	}
+
+	res, err := h.services.WebHookService.RequeueDeadLetters(c.Context(), store.ID, ids)
+	if err != nil {
+		return handleWebhookDeadLetterError(err)
+	}
+
+	return c.JSON(response.OkByData(converters.FromRequeueResultToResponse(res)))
+}
+
+func handleWebhookDeadLetterError(err error) error {
+	switch {
+	case errors.Is(err, webhook.ErrDeadLetterNotFound):
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusNotFound)
+	case errors.Is(err, webhook.ErrTooManyDeadLetterIDs):
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusBadRequest)
+	}
+
+	return apierror.New().AddError(errors.New("failed to process webhook dead letters")).SetHttpCode(fiber.StatusBadRequest)
+}
+
+func (h *Handler) initWebhookDeadLetterRoutes(v1 fiber.Router) {
+	w := v1.Group("/webhook/dead-letters")
+	w.Get("/", h.listWebhookDeadLetters)
+	w.Post("/requeue", h.requeueWebhookDeadLetters)
+	w.Get("/:id", h.getWebhookDeadLetter)
+}
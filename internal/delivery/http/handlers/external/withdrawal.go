@@ -27,11 +27,14 @@ import (
 //	@Param			api_key		query		string												true	"Store API key"
 //	@Param			register	body		withdrawal_requests.CreateProcessingWithdrawRequest	true	"Init withdrawal"
 //	@Success		200			{object}	response.Result[withdrawal_response.ProcessingWithdrawalResponse]
+//	@Success		202			{object}	response.Result[withdrawal_response.WithdrawalApprovalResponse]	"Held for approval"
 //	@Failure		401			{object}	apierror.Errors
+//	@Failure		403			{object}	apierror.Errors
 //	@Failure		423			{object}	apierror.Errors
 //	@Failure		404			{object}	apierror.Errors
 //	@Failure		409			{object}	apierror.Errors
 //	@Failure		422			{object}	apierror.Errors
+//	@Failure		429			{object}	apierror.Errors
 //	@Failure		500			{object}	apierror.Errors
 //	@Router			/v1/external/withdrawal-from-processing [post]
 //	@Security		XApiKey
@@ -60,6 +63,11 @@ func (h *Handler) createWithdrawalFromProcessingWallet(c fiber.Ctx) error {
 
 	res, err := h.services.WithdrawService.CreateWithdrawalFromProcessing(c.Context(), dto)
 	if err != nil {
+		var approvalErr *withdraw.ApprovalRequiredError
+		if errors.As(err, &approvalErr) {
+			return c.Status(fiber.StatusAccepted).JSON(response.OkByData(converters.FromWithdrawalApprovalToResponse(approvalErr.Approval)))
+		}
+
 		return prepareWithdrawalHTTPError(err)
 	}
 
@@ -151,7 +159,9 @@ func prepareWithdrawalHTTPError(err error) error {
 		errCode = fiber.StatusUnprocessableEntity
 	case errors.Is(err, withdraw.ErrWithdrawFromProcessingToHotNotAllowed):
 		errCode = fiber.StatusNotAcceptable
-	case errors.Is(err, withdraw.ErrStoreIsNotOwnedByUser):
+	case errors.Is(err, withdraw.ErrStoreIsNotOwnedByUser),
+		errors.Is(err, withdraw.ErrDestinationNotAllowlisted),
+		errors.Is(err, withdraw.ErrDestinationCoolingDown):
 		errCode = fiber.StatusForbidden
 	}
 
@@ -160,6 +170,11 @@ func prepareWithdrawalHTTPError(err error) error {
 		errCode = fiber.StatusConflict
 	}
 
+	var limitErr *withdraw.WithdrawalLimitExceededError
+	if errors.As(err, &limitErr) {
+		errCode = fiber.StatusTooManyRequests
+	}
+
 	return apierror.New().AddError(err).SetHttpCode(errCode)
 }
 
@@ -61,6 +61,10 @@ func (h *Handler) Init(api *fiber.App) {
 
 	h.initStoreRoutes(securedV1Admin)
 
+	h.initInvoiceRoutes(securedV1Admin)
+
+	h.initRefundRoutes(securedV1Admin)
+
 	h.initTransactionRoutes(securedV1Admin)
 
 	h.initWalletRoutes(securedV1Admin)
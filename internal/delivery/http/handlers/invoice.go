@@ -0,0 +1,177 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/dv-net/dv-merchant/internal/delivery/http/request/invoice_request"
+	"github.com/dv-net/dv-merchant/internal/service/invoice"
+	"github.com/dv-net/dv-merchant/internal/tools"
+	"github.com/dv-net/dv-merchant/internal/tools/apierror"
+	"github.com/dv-net/dv-merchant/internal/tools/converters"
+	"github.com/dv-net/dv-merchant/internal/tools/response"
+
+	_ "github.com/dv-net/dv-merchant/internal/delivery/http/responses/invoice_response" // swaggo
+	_ "github.com/dv-net/dv-merchant/internal/storage/storecmn"                         // swaggo
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// loadStoreInvoices is a function to load store invoices
+//
+//	@Summary		Load store invoices
+//	@Description	Load store invoices
+//	@Tags			Invoice
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string						true	"Store ID"
+//	@Param			string	query		invoice_request.ListRequest	true	"Invoices filter"
+//	@Success		200		{object}	response.Result[storecmn.FindResponseWithFullPagination[invoice_response.InvoiceResponse]]
+//	@Failure		401		{object}	apierror.Errors
+//	@Failure		404		{object}	apierror.Errors
+//	@Router			/v1/dv-admin/store/{id}/invoices [get]
+//	@Security		BearerAuth
+func (h *Handler) loadStoreInvoices(c fiber.Ctx) error {
+	targetStore, err := h.validateAndLoadStore(c)
+	if err != nil {
+		return err
+	}
+
+	request := &invoice_request.ListRequest{}
+	if err := c.Bind().Query(request); err != nil {
+		return err
+	}
+
+	res, err := h.services.InvoiceService.List(c.Context(), targetStore, converters.FromInvoiceListRequestToDTO(request))
+	if err != nil {
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusBadRequest)
+	}
+
+	return c.JSON(response.OkByData(converters.FromInvoiceListToResponse(res)))
+}
+
+// loadStoreInvoice is a function to load store invoice with status history
+//
+//	@Summary		Load store invoice
+//	@Description	Load store invoice with status history
+//	@Tags			Invoice
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string	true	"Store ID"
+//	@Param			invoiceId	path		string	true	"Invoice ID"
+//	@Success		200			{object}	response.Result[invoice_response.InvoiceWithHistoryResponse]
+//	@Failure		401			{object}	apierror.Errors
+//	@Failure		404			{object}	apierror.Errors
+//	@Router			/v1/dv-admin/store/{id}/invoices/{invoiceId} [get]
+//	@Security		BearerAuth
+func (h *Handler) loadStoreInvoice(c fiber.Ctx) error {
+	targetStore, err := h.validateAndLoadStore(c)
+	if err != nil {
+		return err
+	}
+
+	invoiceID, err := tools.ValidateUUID(c.Params("invoiceId"))
+	if err != nil {
+		return err
+	}
+
+	res, err := h.services.InvoiceService.GetByStore(c.Context(), targetStore, invoiceID)
+	if err != nil {
+		return h.handleInvoiceError(err)
+	}
+
+	return c.JSON(response.OkByData(converters.FromInvoiceWithHistoryToResponse(res)))
+}
+
+// createStoreInvoice is a function to create store invoice
+//
+//	@Summary		Create store invoice
+//	@Description	Create invoice with fixed amount and expiry
+//	@Tags			Invoice
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string							true	"Store ID"
+//	@Param			register	body		invoice_request.CreateRequest	true	"Create invoice"
+//	@Success		200			{object}	response.Result[invoice_response.InvoiceResponse]
+//	@Failure		400			{object}	apierror.Errors
+//	@Failure		401			{object}	apierror.Errors
+//	@Failure		422			{object}	apierror.Errors
+//	@Router			/v1/dv-admin/store/{id}/invoices [post]
+//	@Security		BearerAuth
+func (h *Handler) createStoreInvoice(c fiber.Ctx) error {
+	targetStore, err := h.validateAndLoadStore(c)
+	if err != nil {
+		return err
+	}
+
+	request := &invoice_request.CreateRequest{}
+	if err := c.Bind().Body(request); err != nil {
+		return err
+	}
+
+	inv, err := h.services.InvoiceService.Create(c.Context(), targetStore, converters.FromInvoiceCreateRequestToDTO(request))
+	if err != nil {
+		return h.handleInvoiceError(err)
+	}
+
+	return c.JSON(response.OkByData(converters.FromInvoiceModelToResponse(inv)))
+}
+
+// cancelStoreInvoice is a function to cancel open store invoice
+//
+//	@Summary		Cancel store invoice
+//	@Description	Cancel pending or partially paid invoice
+//	@Tags			Invoice
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string	true	"Store ID"
+//	@Param			invoiceId	path		string	true	"Invoice ID"
+//	@Success		200			{object}	response.Result[invoice_response.InvoiceResponse]
+//	@Failure		401			{object}	apierror.Errors
+//	@Failure		404			{object}	apierror.Errors
+//	@Failure		422			{object}	apierror.Errors
+//	@Router			/v1/dv-admin/store/{id}/invoices/{invoiceId}/cancel [post]
+//	@Security		BearerAuth
+func (h *Handler) cancelStoreInvoice(c fiber.Ctx) error {
+	targetStore, err := h.validateAndLoadStore(c)
+	if err != nil {
+		return err
+	}
+
+	invoiceID, err := tools.ValidateUUID(c.Params("invoiceId"))
+	if err != nil {
+		return err
+	}
+
+	inv, err := h.services.InvoiceService.Cancel(c.Context(), targetStore, invoiceID)
+	if err != nil {
+		return h.handleInvoiceError(err)
+	}
+
+	return c.JSON(response.OkByData(converters.FromInvoiceModelToResponse(inv)))
+}
+
+func (h *Handler) handleInvoiceError(err error) error {
+	switch {
+	case errors.Is(err, invoice.ErrInvoiceNotFound):
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusNotFound)
+	case errors.Is(err, invoice.ErrInvoiceNotOpen),
+		errors.Is(err, invoice.ErrAddressBusy),
+		errors.Is(err, invoice.ErrDuplicateOrderID):
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusUnprocessableEntity)
+	case errors.Is(err, invoice.ErrInvalidAmount),
+		errors.Is(err, invoice.ErrInvalidLifetime),
+		errors.Is(err, invoice.ErrCurrencyNotAvailable):
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusBadRequest)
+	}
+
+	h.logger.Errorw("invoice request failed", "error", err)
+	return apierror.New().AddError(errors.New("failed to process invoice")).SetHttpCode(fiber.StatusBadRequest)
+}
+
+func (h *Handler) initInvoiceRoutes(v1 fiber.Router) {
+	invoices := v1.Group("/store/:id/invoices")
+	invoices.Get("/", h.loadStoreInvoices)
+	invoices.Post("/", h.createStoreInvoice)
+	invoices.Get("/:invoiceId", h.loadStoreInvoice)
+	invoices.Post("/:invoiceId/cancel", h.cancelStoreInvoice)
+}
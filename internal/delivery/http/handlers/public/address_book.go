@@ -0,0 +1,128 @@
+package public
+
+import (
+	"errors"
+	"html/template"
+	"strings"
+
+	"github.com/dv-net/dv-merchant/internal/delivery/middleware"
+	"github.com/dv-net/dv-merchant/internal/service/address_book"
+	"github.com/dv-net/dv-merchant/internal/tools"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// revokePage is opened from the notification email, entries are removed only by the confirmation form
+var revokePage = template.Must(template.New("revoke").Parse(`<!DOCTYPE html>
+<html>
+<head>
+	<meta charset="utf-8">
+	<meta name="viewport" content="width=device-width, initial-scale=1">
+	<title>Revoke address book entry</title>
+</head>
+<body>
+	{{- if .Error }}
+	<p>{{ .Error }}</p>
+	{{- else if .Revoked }}
+	<p>Address book entry revoked.</p>
+	{{- else }}
+	<p>Remove address <b>{{ .Address }}</b> ({{ .Currencies }}) from the address book and its withdrawal rules?</p>
+	<form method="post">
+		<button type="submit">Revoke</button>
+	</form>
+	{{- end }}
+</body>
+</html>
+`))
+
+type revokePageData struct {
+	Address    string
+	Currencies string
+	Revoked    bool
+	Error      string
+}
+
+// revokeAddressBookEntryPage asks to confirm the revoke link from the notification email
+//
+//	@Summary		Revoke address book entry confirmation
+//	@Description	Shows the address book entries added by a single request, they are removed after confirmation
+//	@Tags			Address Book,Public
+//	@Produce		html
+//	@Param			token	path		string	true	"Revoke token"
+//	@Success		200		{string}	string
+//	@Failure		400		{string}	string
+//	@Failure		404		{string}	string
+//	@Router			/v1/public/address-book/revoke/{token} [get]
+func (h *Handler) revokeAddressBookEntryPage(c fiber.Ctx) error {
+	token, err := tools.ValidateUUID(c.Params("token"))
+	if err != nil {
+		return renderRevokePage(c, fiber.StatusBadRequest, revokePageData{Error: "Invalid revoke link."})
+	}
+
+	entries, err := h.services.AddressBookService.GetRevokeEntries(c.Context(), token)
+	if err != nil {
+		if errors.Is(err, address_book.ErrRevokeTokenNotFound) {
+			return renderRevokePage(c, fiber.StatusNotFound, revokePageData{Error: "Revoke link has expired or was already used."})
+		}
+		return renderRevokePage(c, fiber.StatusBadRequest, revokePageData{Error: "Failed to load address book entry."})
+	}
+
+	currencies := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		currencies = append(currencies, entry.CurrencyID)
+	}
+
+	return renderRevokePage(c, fiber.StatusOK, revokePageData{
+		Address:    entries[0].Address,
+		Currencies: strings.Join(currencies, ", "),
+	})
+}
+
+// revokeAddressBookEntry removes address book entries by the confirmed revoke link from the notification email
+//
+//	@Summary		Revoke address book entry
+//	@Description	Removes the address book entries added by a single request, the link is sent to the owner email and can be used once
+//	@Tags			Address Book,Public
+//	@Produce		html
+//	@Param			token	path		string	true	"Revoke token"
+//	@Success		200		{string}	string
+//	@Failure		400		{string}	string
+//	@Failure		404		{string}	string
+//	@Router			/v1/public/address-book/revoke/{token} [post]
+func (h *Handler) revokeAddressBookEntry(c fiber.Ctx) error {
+	token, err := tools.ValidateUUID(c.Params("token"))
+	if err != nil {
+		return renderRevokePage(c, fiber.StatusBadRequest, revokePageData{Error: "Invalid revoke link."})
+	}
+
+	if err = h.services.AddressBookService.RevokeAddress(c.Context(), token); err != nil {
+		if errors.Is(err, address_book.ErrRevokeTokenNotFound) {
+			return renderRevokePage(c, fiber.StatusNotFound, revokePageData{Error: "Revoke link has expired or was already used."})
+		}
+		return renderRevokePage(c, fiber.StatusBadRequest, revokePageData{Error: "Failed to revoke address book entry."})
+	}
+
+	return renderRevokePage(c, fiber.StatusOK, revokePageData{Revoked: true})
+}
+
+func renderRevokePage(c fiber.Ctx, status int, data revokePageData) error {
+	var page strings.Builder
+	if err := revokePage.Execute(&page, data); err != nil {
+		return err
+	}
+
+	c.Set(fiber.HeaderContentType, fiber.MIMETextHTMLCharsetUTF8)
+	return c.Status(status).SendString(page.String())
+}
+
+func (h *Handler) initAddressBookRoutes(v1 fiber.Router) {
+	addressBook := v1.Group("/address-book")
+	addressBook.Get("/revoke/:token",
+		middleware.LimiterMiddleware(10, 60, middleware.WithSlidingWindow),
+		h.revokeAddressBookEntryPage,
+	)
+	addressBook.Post("/revoke/:token",
+		middleware.LimiterMiddleware(10, 60, middleware.WithSlidingWindow),
+		h.revokeAddressBookEntry,
+	)
+}
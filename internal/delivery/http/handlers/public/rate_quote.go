@@ -0,0 +1,123 @@
+package public
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dv-net/dv-merchant/internal/delivery/http/request/public_request"
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/exrate"
+	"github.com/dv-net/dv-merchant/internal/service/rate_quote"
+	"github.com/dv-net/dv-merchant/internal/service/store"
+	"github.com/dv-net/dv-merchant/internal/tools/apierror"
+	"github.com/dv-net/dv-merchant/internal/tools/converters"
+	"github.com/dv-net/dv-merchant/internal/tools/response"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+// createRateQuote is a function to lock current rate for the wallet payer
+//
+//	@Summary		Create rate quote
+//	@Description	Lock current currency rate for the wallet payer, deposits received before expiry are valued at it up to the quoted amount
+//	@Tags			Wallet,Public
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string									true	"Wallet ID"
+//	@Param			request	body		public_request.CreateRateQuoteRequest	true	"CreateRateQuoteRequest"
+//	@Success		200		{object}	response.Result[public_request.RateQuoteDto]
+//	@Failure		400		{object}	apierror.Errors
+//	@Failure		404		{object}	apierror.Errors
+//	@Failure		410		{object}	apierror.Errors
+//	@Failure		503		{object}	apierror.Errors
+//	@Router			/v1/public/wallet/{id}/quote [post]
+func (h *Handler) createRateQuote(c fiber.Ctx) error {
+	walletID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return apierror.New().AddError(fmt.Errorf("bad wallet id")).SetHttpCode(fiber.StatusBadRequest)
+	}
+
+	req := &public_request.CreateRateQuoteRequest{}
+	if err := c.Bind().Body(req); err != nil {
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusBadRequest)
+	}
+
+	walletStore, err := h.loadWalletStore(c, walletID)
+	if err != nil {
+		return err
+	}
+
+	quote, err := h.services.RateQuoteService.Create(c.Context(), walletStore, walletID, converters.FromCreateRateQuoteRequestToDTO(req))
+	if err != nil {
+		return handleRateQuoteError(err)
+	}
+
+	return c.JSON(response.OkByData(converters.FromRateQuoteModelToResponse(quote)))
+}
+
+// getRateQuote is a function to get wallet rate quote
+//
+//	@Summary		Get rate quote
+//	@Description	Get rate quote of the wallet
+//	@Tags			Wallet,Public
+//	@Produce		json
+//	@Param			id			path		string	true	"Wallet ID"
+//	@Param			quote_id	path		string	true	"Rate quote ID"
+//	@Success		200			{object}	response.Result[public_request.RateQuoteDto]
+//	@Failure		400			{object}	apierror.Errors
+//	@Failure		404			{object}	apierror.Errors
+//	@Failure		410			{object}	apierror.Errors
+//	@Router			/v1/public/wallet/{id}/quote/{quote_id} [get]
+func (h *Handler) getRateQuote(c fiber.Ctx) error {
+	walletID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return apierror.New().AddError(fmt.Errorf("bad wallet id")).SetHttpCode(fiber.StatusBadRequest)
+	}
+
+	quoteID, err := uuid.Parse(c.Params("quote_id"))
+	if err != nil {
+		return apierror.New().AddError(fmt.Errorf("bad quote id")).SetHttpCode(fiber.StatusBadRequest)
+	}
+
+	if _, err = h.loadWalletStore(c, walletID); err != nil {
+		return err
+	}
+
+	quote, err := h.services.RateQuoteService.GetByWallet(c.Context(), walletID, quoteID)
+	if err != nil {
+		return handleRateQuoteError(err)
+	}
+
+	return c.JSON(response.OkByData(converters.FromRateQuoteModelToResponse(quote)))
+}
+
+func (h *Handler) loadWalletStore(c fiber.Ctx, walletID uuid.UUID) (*models.Store, error) {
+	walletStore, err := h.services.StoreService.GetStoreByWalletID(c.Context(), walletID)
+	if err != nil {
+		if errors.Is(err, store.ErrStoreNotFound) {
+			return nil, apierror.New().AddError(errors.New("store not found")).SetHttpCode(fiber.StatusNotFound)
+		}
+		if errors.Is(err, store.ErrStoreDisabled) {
+			return nil, apierror.New().AddError(errors.New("store is disabled")).SetHttpCode(fiber.StatusGone)
+		}
+		return nil, apierror.New().AddError(fmt.Errorf("something went wrong")).SetHttpCode(fiber.StatusBadRequest)
+	}
+
+	return walletStore, nil
+}
+
+func handleRateQuoteError(err error) error {
+	var staleErr *exrate.StaleExchangeRateError
+	switch {
+	case errors.Is(err, rate_quote.ErrQuoteNotFound):
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusNotFound)
+	case errors.Is(err, rate_quote.ErrInvalidAmount),
+		errors.Is(err, rate_quote.ErrCurrencyNotAvailable):
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusBadRequest)
+	case errors.As(err, &staleErr):
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusServiceUnavailable)
+	}
+
+	return apierror.New().AddError(errors.New("failed to process rate quote")).SetHttpCode(fiber.StatusBadRequest)
+}
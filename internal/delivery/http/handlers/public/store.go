@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/dv-net/dv-merchant/internal/delivery/http/request/public_request"
+	"github.com/dv-net/dv-merchant/internal/service/exrate"
 	"github.com/dv-net/dv-merchant/internal/service/store"
 	"github.com/dv-net/dv-merchant/internal/service/wallet"
 	"github.com/dv-net/dv-merchant/internal/tools"
@@ -30,6 +31,7 @@ import (
 //	@Failure		401			{object}	apierror.Errors
 //	@Failure		410			{object}	apierror.Errors
 //	@Failure		500			{object}	apierror.Errors
+//	@Failure		503			{object}	apierror.Errors
 //	@Router			/v1/public/store/topup/{store_id}/{external_id} [get]
 func (h *Handler) getTopUpDataByStore(c fiber.Ctx) error {
 	request := &public_request.TopUpFormByStoreRequest{}
@@ -63,11 +65,14 @@ func (h *Handler) getTopUpDataByStore(c fiber.Ctx) error {
 		Locale:          request.Locale,
 	})
 	if err != nil {
+		var staleErr *exrate.StaleExchangeRateError
 		code := fiber.StatusBadRequest
 		if errors.Is(err, store.ErrStoreNotFound) {
 			code = fiber.StatusNotFound
 		} else if errors.Is(err, store.ErrStoreRequestLimitExceeded) {
 			code = fiber.StatusForbidden
+		} else if errors.As(err, &staleErr) {
+			code = fiber.StatusServiceUnavailable
 		}
 
 		return apierror.New().AddError(err).SetHttpCode(code)
@@ -10,6 +10,7 @@ import (
 	_ "github.com/dv-net/dv-merchant/internal/delivery/http/responses/transaction_response"
 	"github.com/dv-net/dv-merchant/internal/delivery/middleware"
 
+	"github.com/dv-net/dv-merchant/internal/service/exrate"
 	"github.com/dv-net/dv-merchant/internal/service/wallet"
 
 	"github.com/dv-net/dv-merchant/internal/delivery/http/request/public_request"
@@ -37,6 +38,7 @@ import (
 //	@Failure		401		{object}	apierror.Errors
 //	@Failure		410		{object}	apierror.Errors
 //	@Failure		500		{object}	apierror.Errors
+//	@Failure		503		{object}	apierror.Errors
 //	@Router			/v1/public/wallet/{id} [get]
 func (h *Handler) getWalletData(c fiber.Ctx) error {
 	walletID, err := uuid.Parse(c.Params("id"))
@@ -73,6 +75,10 @@ func (h *Handler) getWalletData(c fiber.Ctx) error {
 	// get wallet data
 	data, err := h.services.WalletService.GetFullDataByID(c.Context(), walletID)
 	if err != nil {
+		var staleErr *exrate.StaleExchangeRateError
+		if errors.As(err, &staleErr) {
+			return apierror.New().AddError(err).SetHttpCode(fiber.StatusServiceUnavailable)
+		}
 		return apierror.New().AddError(err).SetHttpCode(fiber.StatusBadRequest)
 	}
 
@@ -274,4 +280,8 @@ func (h *Handler) initWalletRoutes(v1 fiber.Router) {
 		middleware.LimiterMiddleware(3, 60, middleware.WithSlidingWindow),
 		middleware.FakeDelayMiddleware(2*time.Second),
 		h.refreshWalletAddress)
+	w.Post("/:id/quote",
+		middleware.LimiterMiddleware(10, 60, middleware.WithSlidingWindow),
+		h.createRateQuote)
+	w.Get("/:id/quote/:quote_id", h.getRateQuote)
 }
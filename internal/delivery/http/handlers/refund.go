@@ -0,0 +1,410 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/dv-net/dv-merchant/internal/delivery/http/request/refund_request"
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/refund"
+	"github.com/dv-net/dv-merchant/internal/tools"
+	"github.com/dv-net/dv-merchant/internal/tools/apierror"
+	"github.com/dv-net/dv-merchant/internal/tools/converters"
+	"github.com/dv-net/dv-merchant/internal/tools/response"
+
+	_ "github.com/dv-net/dv-merchant/internal/delivery/http/responses/refund_response" // swaggo
+	_ "github.com/dv-net/dv-merchant/internal/storage/storecmn"                        // swaggo
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+// loadStoreRefunds is a function to load store refunds
+//
+//	@Summary		Load store refunds
+//	@Description	Load store refunds
+//	@Tags			Refund
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string						true	"Store ID"
+//	@Param			string	query		refund_request.ListRequest	true	"Refunds filter"
+//	@Success		200		{object}	response.Result[storecmn.FindResponseWithFullPagination[refund_response.RefundResponse]]
+//	@Failure		401		{object}	apierror.Errors
+//	@Failure		404		{object}	apierror.Errors
+//	@Router			/v1/dv-admin/store/{id}/refunds [get]
+//	@Security		BearerAuth
+func (h *Handler) loadStoreRefunds(c fiber.Ctx) error {
+	targetStore, err := h.validateAndLoadStore(c)
+	if err != nil {
+		return err
+	}
+
+	request := &refund_request.ListRequest{}
+	if err := c.Bind().Query(request); err != nil {
+		return err
+	}
+
+	txID, err := parseOptionalUUID(request.TransactionID)
+	if err != nil {
+		return err
+	}
+
+	res, err := h.services.RefundService.List(c.Context(), converters.FromRefundListRequestToDTO(request, &targetStore.ID, txID))
+	if err != nil {
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusBadRequest)
+	}
+
+	return c.JSON(response.OkByData(converters.FromRefundListToResponse(res)))
+}
+
+// loadStoreRefund is a function to load store refund with status history
+//
+//	@Summary		Load store refund
+//	@Description	Load store refund with status history
+//	@Tags			Refund
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string	true	"Store ID"
+//	@Param			refundId	path		string	true	"Refund ID"
+//	@Success		200			{object}	response.Result[refund_response.RefundWithHistoryResponse]
+//	@Failure		401			{object}	apierror.Errors
+//	@Failure		404			{object}	apierror.Errors
+//	@Router			/v1/dv-admin/store/{id}/refunds/{refundId} [get]
+//	@Security		BearerAuth
+func (h *Handler) loadStoreRefund(c fiber.Ctx) error {
+	targetStore, err := h.validateAndLoadStore(c)
+	if err != nil {
+		return err
+	}
+
+	refundID, err := tools.ValidateUUID(c.Params("refundId"))
+	if err != nil {
+		return err
+	}
+
+	res, err := h.services.RefundService.GetByStore(c.Context(), targetStore, refundID)
+	if err != nil {
+		return h.handleRefundError(err)
+	}
+
+	return c.JSON(response.OkByData(converters.FromRefundWithHistoryToResponse(res)))
+}
+
+// createStoreRefund is a function to request refund of store deposit
+//
+//	@Summary		Create store refund
+//	@Description	Request refund of a deposit, it is executed after approval by root or finance manager
+//	@Tags			Refund
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string						true	"Store ID"
+//	@Param			register	body		refund_request.CreateRequest	true	"Create refund"
+//	@Success		200			{object}	response.Result[refund_response.RefundResponse]
+//	@Failure		400			{object}	apierror.Errors
+//	@Failure		401			{object}	apierror.Errors
+//	@Failure		404			{object}	apierror.Errors
+//	@Failure		422			{object}	apierror.Errors
+//	@Router			/v1/dv-admin/store/{id}/refunds [post]
+//	@Security		BearerAuth
+func (h *Handler) createStoreRefund(c fiber.Ctx) error {
+	usr, err := loadAuthUser(c)
+	if err != nil {
+		return err
+	}
+
+	targetStore, err := h.validateAndLoadStore(c)
+	if err != nil {
+		return err
+	}
+
+	request := &refund_request.CreateRequest{}
+	if err := c.Bind().Body(request); err != nil {
+		return err
+	}
+
+	txID, err := tools.ValidateUUID(request.TransactionID)
+	if err != nil {
+		return err
+	}
+
+	res, err := h.services.RefundService.Create(
+		c.Context(),
+		targetStore,
+		converters.FromRefundCreateRequestToDTO(request, txID, uuid.NullUUID{UUID: usr.ID, Valid: true}),
+	)
+	if err != nil {
+		return h.handleRefundError(err)
+	}
+
+	return c.JSON(response.OkByData(converters.FromRefundModelToResponse(res)))
+}
+
+// updateStoreRefundAddress is a function to change destination of pending store refund
+//
+//	@Summary		Update store refund address
+//	@Description	Change destination address of refund pending approval
+//	@Tags			Refund
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string								true	"Store ID"
+//	@Param			refundId	path		string								true	"Refund ID"
+//	@Param			register	body		refund_request.UpdateAddressRequest	true	"Refund address"
+//	@Success		200			{object}	response.Result[refund_response.RefundResponse]
+//	@Failure		400			{object}	apierror.Errors
+//	@Failure		401			{object}	apierror.Errors
+//	@Failure		404			{object}	apierror.Errors
+//	@Failure		422			{object}	apierror.Errors
+//	@Router			/v1/dv-admin/store/{id}/refunds/{refundId}/address [put]
+//	@Security		BearerAuth
+func (h *Handler) updateStoreRefundAddress(c fiber.Ctx) error {
+	targetStore, err := h.validateAndLoadStore(c)
+	if err != nil {
+		return err
+	}
+
+	refundID, err := tools.ValidateUUID(c.Params("refundId"))
+	if err != nil {
+		return err
+	}
+
+	request := &refund_request.UpdateAddressRequest{}
+	if err := c.Bind().Body(request); err != nil {
+		return err
+	}
+
+	res, err := h.services.RefundService.UpdateAddress(c.Context(), targetStore, refundID, request.AddressTo)
+	if err != nil {
+		return h.handleRefundError(err)
+	}
+
+	return c.JSON(response.OkByData(converters.FromRefundModelToResponse(res)))
+}
+
+// cancelStoreRefund is a function to cancel pending store refund
+//
+//	@Summary		Cancel store refund
+//	@Description	Cancel refund pending approval
+//	@Tags			Refund
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string	true	"Store ID"
+//	@Param			refundId	path		string	true	"Refund ID"
+//	@Success		200			{object}	response.Result[refund_response.RefundResponse]
+//	@Failure		401			{object}	apierror.Errors
+//	@Failure		404			{object}	apierror.Errors
+//	@Failure		422			{object}	apierror.Errors
+//	@Router			/v1/dv-admin/store/{id}/refunds/{refundId}/cancel [post]
+//	@Security		BearerAuth
+func (h *Handler) cancelStoreRefund(c fiber.Ctx) error {
+	usr, err := loadAuthUser(c)
+	if err != nil {
+		return err
+	}
+
+	targetStore, err := h.validateAndLoadStore(c)
+	if err != nil {
+		return err
+	}
+
+	refundID, err := tools.ValidateUUID(c.Params("refundId"))
+	if err != nil {
+		return err
+	}
+
+	res, err := h.services.RefundService.Cancel(c.Context(), targetStore, refundID, uuid.NullUUID{UUID: usr.ID, Valid: true})
+	if err != nil {
+		return h.handleRefundError(err)
+	}
+
+	return c.JSON(response.OkByData(converters.FromRefundModelToResponse(res)))
+}
+
+// loadRefunds is a function to load refunds of all stores for approval
+//
+//	@Summary		Load refunds
+//	@Description	Load refunds of all stores, available for root and finance manager
+//	@Tags			Refund
+//	@Accept			json
+//	@Produce		json
+//	@Param			string	query		refund_request.ListAllRequest	true	"Refunds filter"
+//	@Success		200		{object}	response.Result[storecmn.FindResponseWithFullPagination[refund_response.RefundResponse]]
+//	@Failure		401		{object}	apierror.Errors
+//	@Failure		403		{object}	apierror.Errors
+//	@Router			/v1/dv-admin/refunds [get]
+//	@Security		BearerAuth
+func (h *Handler) loadRefunds(c fiber.Ctx) error {
+	request := &refund_request.ListAllRequest{}
+	if err := c.Bind().Query(request); err != nil {
+		return err
+	}
+
+	storeID, err := parseOptionalUUID(request.StoreID)
+	if err != nil {
+		return err
+	}
+
+	txID, err := parseOptionalUUID(request.TransactionID)
+	if err != nil {
+		return err
+	}
+
+	res, err := h.services.RefundService.List(c.Context(), converters.FromRefundListRequestToDTO(&request.ListRequest, storeID, txID))
+	if err != nil {
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusBadRequest)
+	}
+
+	return c.JSON(response.OkByData(converters.FromRefundListToResponse(res)))
+}
+
+// loadRefund is a function to load any refund with status history
+//
+//	@Summary		Load refund
+//	@Description	Load refund with status history, available for root and finance manager
+//	@Tags			Refund
+//	@Accept			json
+//	@Produce		json
+//	@Param			refundId	path		string	true	"Refund ID"
+//	@Success		200			{object}	response.Result[refund_response.RefundWithHistoryResponse]
+//	@Failure		401			{object}	apierror.Errors
+//	@Failure		403			{object}	apierror.Errors
+//	@Failure		404			{object}	apierror.Errors
+//	@Router			/v1/dv-admin/refunds/{refundId} [get]
+//	@Security		BearerAuth
+func (h *Handler) loadRefund(c fiber.Ctx) error {
+	refundID, err := tools.ValidateUUID(c.Params("refundId"))
+	if err != nil {
+		return err
+	}
+
+	res, err := h.services.RefundService.GetByID(c.Context(), refundID)
+	if err != nil {
+		return h.handleRefundError(err)
+	}
+
+	return c.JSON(response.OkByData(converters.FromRefundWithHistoryToResponse(res)))
+}
+
+// approveRefund is a function to approve pending refund and send it to processing
+//
+//	@Summary		Approve refund
+//	@Description	Approve refund and withdraw it from processing wallet of store owner
+//	@Tags			Refund
+//	@Accept			json
+//	@Produce		json
+//	@Param			refundId	path		string	true	"Refund ID"
+//	@Success		200			{object}	response.Result[refund_response.RefundResponse]
+//	@Failure		401			{object}	apierror.Errors
+//	@Failure		403			{object}	apierror.Errors
+//	@Failure		404			{object}	apierror.Errors
+//	@Failure		422			{object}	apierror.Errors
+//	@Router			/v1/dv-admin/refunds/{refundId}/approve [post]
+//	@Security		BearerAuth
+func (h *Handler) approveRefund(c fiber.Ctx) error {
+	usr, err := loadAuthUser(c)
+	if err != nil {
+		return err
+	}
+
+	refundID, err := tools.ValidateUUID(c.Params("refundId"))
+	if err != nil {
+		return err
+	}
+
+	res, err := h.services.RefundService.Approve(c.Context(), usr, refundID)
+	if err != nil {
+		return h.handleRefundError(err)
+	}
+
+	return c.JSON(response.OkByData(converters.FromRefundModelToResponse(res)))
+}
+
+// rejectRefund is a function to reject pending refund
+//
+//	@Summary		Reject refund
+//	@Description	Reject refund pending approval
+//	@Tags			Refund
+//	@Accept			json
+//	@Produce		json
+//	@Param			refundId	path		string						true	"Refund ID"
+//	@Param			register	body		refund_request.RejectRequest	true	"Rejection"
+//	@Success		200			{object}	response.Result[refund_response.RefundResponse]
+//	@Failure		401			{object}	apierror.Errors
+//	@Failure		403			{object}	apierror.Errors
+//	@Failure		404			{object}	apierror.Errors
+//	@Failure		422			{object}	apierror.Errors
+//	@Router			/v1/dv-admin/refunds/{refundId}/reject [post]
+//	@Security		BearerAuth
+func (h *Handler) rejectRefund(c fiber.Ctx) error {
+	usr, err := loadAuthUser(c)
+	if err != nil {
+		return err
+	}
+
+	refundID, err := tools.ValidateUUID(c.Params("refundId"))
+	if err != nil {
+		return err
+	}
+
+	request := &refund_request.RejectRequest{}
+	if err := c.Bind().Body(request); err != nil {
+		return err
+	}
+
+	res, err := h.services.RefundService.Reject(c.Context(), usr, refundID, request.Reason)
+	if err != nil {
+		return h.handleRefundError(err)
+	}
+
+	return c.JSON(response.OkByData(converters.FromRefundModelToResponse(res)))
+}
+
+func (h *Handler) handleRefundError(err error) error {
+	switch {
+	case errors.Is(err, refund.ErrRefundNotFound),
+		errors.Is(err, refund.ErrTransactionNotFound):
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusNotFound)
+	case errors.Is(err, refund.ErrRefundNotPending),
+		errors.Is(err, refund.ErrRefundInProgress),
+		errors.Is(err, refund.ErrTransactionNotRefundable),
+		errors.Is(err, refund.ErrRefundAmountExceeded):
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusUnprocessableEntity)
+	case errors.Is(err, refund.ErrRefundAddressRequired),
+		errors.Is(err, refund.ErrInvalidRefundAddress):
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusBadRequest)
+	}
+
+	h.logger.Errorw("refund request failed", "error", err)
+	return apierror.New().AddError(errors.New("failed to process refund")).SetHttpCode(fiber.StatusBadRequest)
+}
+
+func parseOptionalUUID(value *string) (*uuid.UUID, error) {
+	if value == nil {
+		return nil, nil //nolint:nilnil
+	}
+
+	id, err := tools.ValidateUUID(*value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (h *Handler) initRefundRoutes(v1 fiber.Router) {
+	storeRefunds := v1.Group("/store/:id/refunds")
+	storeRefunds.Get("/", h.loadStoreRefunds)
+	storeRefunds.Post("/", h.createStoreRefund)
+	storeRefunds.Get("/:refundId", h.loadStoreRefund)
+	storeRefunds.Put("/:refundId/address", h.updateStoreRefundAddress)
+	storeRefunds.Post("/:refundId/cancel", h.cancelStoreRefund)
+
+	refunds := v1.Group("/refunds", h.services.PermissionService.FiberMiddleware(
+		[]models.UserRole{
+			models.UserRoleRoot,
+			models.UserRoleFinanceManager,
+		}...,
+	))
+	refunds.Get("/", h.loadRefunds)
+	refunds.Get("/:refundId", h.loadRefund)
+	refunds.Post("/:refundId/approve", h.approveRefund)
+	refunds.Post("/:refundId/reject", h.rejectRefund)
+}
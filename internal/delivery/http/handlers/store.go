@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/dv-net/dv-merchant/internal/constants"
 	"github.com/dv-net/dv-merchant/internal/delivery/http/request/store_api_key_request"
@@ -914,7 +915,7 @@ func (h *Handler) getStoreSecret(c fiber.Ctx) error {
 		return err
 	}
 
-	secret, err := h.services.StoreSecretService.GetSecretByStore(c.Context(), st.ID)
+	secret, err := h.services.StoreSecretService.GetStoreSecret(c.Context(), st.ID)
 	if errors.Is(err, store.ErrStoreSecretNotFound) {
 		return apierror.New().AddError(err).SetHttpCode(fiber.StatusNotFound)
 	}
@@ -922,20 +923,21 @@ func (h *Handler) getStoreSecret(c fiber.Ctx) error {
 		return apierror.New().AddError(err).SetHttpCode(fiber.StatusBadRequest)
 	}
 
-	return c.JSON(response.OkByData(store_response.StoreSecretResponse{Secret: secret}))
+	return c.JSON(response.OkByData(converters.FromStoreSecretModelToResponse(secret)))
 }
 
 // generateStoreSecret is a function to generate store secret for webhook sign
 //
 //	@Summary		Generate store webhook secret
-//	@Description	Generate store webhook secret
+//	@Description	Generate store webhook secret, the replaced secret keeps signing webhooks during rotation window
 //	@Tags			Store
 //	@Accept			json
 //	@Produce		json
-//	@Param			id	path		string	true	"Store ID"
-//	@Success		200	{object}	response.Result[store_response.StoreSecretResponse]
-//	@Failure		401	{object}	apierror.Errors
-//	@Failure		500	{object}	apierror.Errors
+//	@Param			id		path		string								true	"Store ID"
+//	@Param			string	query		store_request.GenerateSecretRequest	true	"Rotation window"
+//	@Success		200		{object}	response.Result[store_response.StoreSecretResponse]
+//	@Failure		401		{object}	apierror.Errors
+//	@Failure		500		{object}	apierror.Errors
 //	@Router			/v1/dv-admin/store/{id}/secret [post]
 //	@Security		BearerAuth
 func (h *Handler) generateStoreSecret(c fiber.Ctx) error {
@@ -944,7 +946,17 @@ func (h *Handler) generateStoreSecret(c fiber.Ctx) error {
 		return err
 	}
 
-	secret, err := h.services.StoreSecretService.GenerateNewSecret(c.Context(), st.ID)
+	request := &store_request.GenerateSecretRequest{}
+	if err := c.Bind().Query(request); err != nil {
+		return err
+	}
+
+	rotationWindow := store.DefaultSecretRotationWindow
+	if request.RotationWindowHours != nil {
+		rotationWindow = time.Duration(*request.RotationWindowHours) * time.Hour
+	}
+
+	secret, err := h.services.StoreSecretService.GenerateNewSecret(c.Context(), st.ID, rotationWindow)
 	if err != nil {
 		return apierror.New().AddError(err).SetHttpCode(fiber.StatusBadRequest)
 	}
@@ -963,7 +975,36 @@ func (h *Handler) generateStoreSecret(c fiber.Ctx) error {
 		},
 	)
 
-	return c.JSON(response.OkByData(store_response.StoreSecretResponse{Secret: secret}))
+	return c.JSON(response.OkByData(converters.FromStoreSecretModelToResponse(secret)))
+}
+
+// revokePreviousStoreSecret is a function to stop signing webhooks with replaced store secret
+//
+//	@Summary		Revoke previous store webhook secret
+//	@Description	Stop signing webhooks with replaced store secret before rotation window ends
+//	@Tags			Store
+//	@Produce		json
+//	@Param			id	path		string	true	"Store ID"
+//	@Success		200	{object}	response.Result[store_response.StoreSecretResponse]
+//	@Failure		401	{object}	apierror.Errors
+//	@Failure		404	{object}	apierror.Errors
+//	@Router			/v1/dv-admin/store/{id}/secret/previous [delete]
+//	@Security		BearerAuth
+func (h *Handler) revokePreviousStoreSecret(c fiber.Ctx) error {
+	st, err := h.validateAndLoadStore(c)
+	if err != nil {
+		return err
+	}
+
+	secret, err := h.services.StoreSecretService.RevokePreviousSecret(c.Context(), st.ID)
+	if errors.Is(err, store.ErrStoreSecretNotFound) {
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusNotFound)
+	}
+	if err != nil {
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusBadRequest)
+	}
+
+	return c.JSON(response.OkByData(converters.FromStoreSecretModelToResponse(secret)))
 }
 
 // resendVerifyStore is a function to resend store verification request
@@ -1033,6 +1074,7 @@ func (h *Handler) initStoreRoutes(v1 fiber.Router) {
 	storeHandlers.Post("/:id/secret", h.generateStoreSecret)
 	storeHandlers.Get("/:id/secret", h.getStoreSecret)
 	storeHandlers.Delete("/:id/secret", h.deleteStoreSecret)
+	storeHandlers.Delete("/:id/secret/previous", h.revokePreviousStoreSecret)
 	storeHandlers.Get("/:id/webhooks", h.loadStoreWebhooks)
 	storeHandlers.Get("/:id/webhooks/:webhookId", h.loadStoreWebhook)
 	storeHandlers.Post("/:id/webhooks", h.createStoreWebhooks)
@@ -1041,6 +1083,16 @@ func (h *Handler) initStoreRoutes(v1 fiber.Router) {
 	storeHandlers.Get("/:id/transactions", h.loadStoreTransaction)
 	storeHandlers.Get("/:id/currencies", h.loadStoreCurrencies)
 	storeHandlers.Put("/:id/currencies", h.updateStoreCurrency)
+	storeHandlers.Get("/:id/payment-policy", h.loadStorePaymentPolicy)
+	storeHandlers.Put("/:id/payment-policy", h.updateStorePaymentPolicy)
+	storeHandlers.Get("/:id/webhook-retry-policy", h.loadStoreWebhookRetryPolicy)
+	storeHandlers.Put("/:id/webhook-retry-policy", h.updateStoreWebhookRetryPolicy)
+	storeHandlers.Get("/:id/webhook-dead-letters", h.loadStoreWebhookDeadLetters)
+	storeHandlers.Post("/:id/webhook-dead-letters/requeue", h.requeueStoreWebhookDeadLetters)
+	storeHandlers.Get("/:id/webhook-dead-letters/:deadLetterId", h.loadStoreWebhookDeadLetter)
+	storeHandlers.Get("/:id/webhook-client-certificate", h.loadStoreWebhookClientCertificate)
+	storeHandlers.Put("/:id/webhook-client-certificate", h.setStoreWebhookClientCertificate)
+	storeHandlers.Delete("/:id/webhook-client-certificate", h.deleteStoreWebhookClientCertificate)
 	storeHandlers.Get("/:id/whitelists", h.loadStoreWhitelist)
 	storeHandlers.Put("/:id/whitelists", h.updateStoreWhitelist)
 	storeHandlers.Patch("/:id/whitelists", h.patchStoreWhitelist)
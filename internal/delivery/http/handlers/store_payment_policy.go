@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/dv-net/dv-merchant/internal/delivery/http/request/store_request"
+	"github.com/dv-net/dv-merchant/internal/service/payment_policy"
+	"github.com/dv-net/dv-merchant/internal/tools/apierror"
+	"github.com/dv-net/dv-merchant/internal/tools/converters"
+	"github.com/dv-net/dv-merchant/internal/tools/response"
+
+	_ "github.com/dv-net/dv-merchant/internal/delivery/http/responses/store_response" // swaggo
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// loadStorePaymentPolicy is a function to load store under/overpayment tolerance policy
+//
+//	@Summary		Load store payment policy
+//	@Description	Load store under/overpayment tolerance policy
+//	@Tags			Store
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string	true	"Store ID"
+//	@Success		200	{object}	response.Result[store_response.StorePaymentPolicyResponse]
+//	@Failure		401	{object}	apierror.Errors
+//	@Failure		404	{object}	apierror.Errors
+//	@Router			/v1/dv-admin/store/{id}/payment-policy [get]
+//	@Security		BearerAuth
+func (h *Handler) loadStorePaymentPolicy(c fiber.Ctx) error {
+	targetStore, err := h.validateAndLoadStore(c)
+	if err != nil {
+		return err
+	}
+
+	policy, err := h.services.PaymentPolicyService.GetByStore(c.Context(), targetStore.ID)
+	if err != nil {
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusBadRequest)
+	}
+
+	return c.JSON(response.OkByData(converters.FromPaymentPolicyModelToResponse(policy)))
+}
+
+// updateStorePaymentPolicy is a function to update store under/overpayment tolerance policy
+//
+//	@Summary		Update store payment policy
+//	@Description	Update store under/overpayment tolerance policy
+//	@Tags			Store
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string										true	"Store ID"
+//	@Param			register	body		store_request.UpdatePaymentPolicyRequest	true	"Payment policy"
+//	@Success		200			{object}	response.Result[store_response.StorePaymentPolicyResponse]
+//	@Failure		400			{object}	apierror.Errors
+//	@Failure		401			{object}	apierror.Errors
+//	@Failure		404			{object}	apierror.Errors
+//	@Router			/v1/dv-admin/store/{id}/payment-policy [put]
+//	@Security		BearerAuth
+func (h *Handler) updateStorePaymentPolicy(c fiber.Ctx) error {
+	targetStore, err := h.validateAndLoadStore(c)
+	if err != nil {
+		return err
+	}
+
+	request := &store_request.UpdatePaymentPolicyRequest{}
+	if err := c.Bind().Body(request); err != nil {
+		return err
+	}
+
+	policy, err := h.services.PaymentPolicyService.Update(c.Context(), targetStore.ID, converters.FromPaymentPolicyRequestToDTO(request))
+	if err != nil {
+		if errors.Is(err, payment_policy.ErrInvalidThreshold) || errors.Is(err, payment_policy.ErrInvalidPercent) {
+			return apierror.New().AddError(err).SetHttpCode(fiber.StatusBadRequest)
+		}
+		return h.handleError(err, "store payment policy")
+	}
+
+	return c.JSON(response.OkByData(converters.FromPaymentPolicyModelToResponse(*policy)))
+}
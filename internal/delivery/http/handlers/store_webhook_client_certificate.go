@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/dv-net/dv-merchant/internal/delivery/http/request/store_webhook_request"
+	"github.com/dv-net/dv-merchant/internal/service/webhook"
+	"github.com/dv-net/dv-merchant/internal/tools/apierror"
+	"github.com/dv-net/dv-merchant/internal/tools/converters"
+	"github.com/dv-net/dv-merchant/internal/tools/response"
+
+	_ "github.com/dv-net/dv-merchant/internal/delivery/http/responses/webhook_response" // swaggo
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// loadStoreWebhookClientCertificate is a function to load store webhook client certificate
+//
+//	@Summary		Load store webhook client certificate
+//	@Description	Load certificate presented to store webhook endpoints requiring mutual TLS
+//	@Tags			StoreWebhook
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string	true	"Store ID"
+//	@Success		200	{object}	response.Result[webhook_response.ClientCertificateResponse]
+//	@Failure		401	{object}	apierror.Errors
+//	@Failure		404	{object}	apierror.Errors
+//	@Router			/v1/dv-admin/store/{id}/webhook-client-certificate [get]
+//	@Security		BearerAuth
+func (h *Handler) loadStoreWebhookClientCertificate(c fiber.Ctx) error {
+	targetStore, err := h.validateAndLoadStore(c)
+	if err != nil {
+		return err
+	}
+
+	cert, err := h.services.WebHookService.GetClientCertificate(c.Context(), targetStore.ID)
+	if err != nil {
+		return h.handleWebhookClientCertificateError(err)
+	}
+
+	return c.JSON(response.OkByData(converters.FromClientCertificateModelToResponse(cert)))
+}
+
+// setStoreWebhookClientCertificate is a function to set store webhook client certificate
+//
+//	@Summary		Set store webhook client certificate
+//	@Description	Set PEM encoded certificate and private key presented to store webhook endpoints requiring mutual TLS
+//	@Tags			StoreWebhook
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string												true	"Store ID"
+//	@Param			register	body		store_webhook_request.SetClientCertificateRequest	true	"Client certificate"
+//	@Success		200			{object}	response.Result[webhook_response.ClientCertificateResponse]
+//	@Failure		400			{object}	apierror.Errors
+//	@Failure		401			{object}	apierror.Errors
+//	@Failure		404			{object}	apierror.Errors
+//	@Router			/v1/dv-admin/store/{id}/webhook-client-certificate [put]
+//	@Security		BearerAuth
+func (h *Handler) setStoreWebhookClientCertificate(c fiber.Ctx) error {
+	targetStore, err := h.validateAndLoadStore(c)
+	if err != nil {
+		return err
+	}
+
+	request := &store_webhook_request.SetClientCertificateRequest{}
+	if err := c.Bind().Body(request); err != nil {
+		return err
+	}
+
+	cert, err := h.services.WebHookService.SetClientCertificate(c.Context(), targetStore.ID, request.Certificate, request.PrivateKey)
+	if err != nil {
+		return h.handleWebhookClientCertificateError(err)
+	}
+
+	return c.JSON(response.OkByData(converters.FromClientCertificateModelToResponse(cert)))
+}
+
+// deleteStoreWebhookClientCertificate is a function to delete store webhook client certificate
+//
+//	@Summary		Delete store webhook client certificate
+//	@Description	Delete certificate presented to store webhook endpoints
+//	@Tags			StoreWebhook
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string	true	"Store ID"
+//	@Success		200	{object}	response.Result[any]
+//	@Failure		401	{object}	apierror.Errors
+//	@Failure		404	{object}	apierror.Errors
+//	@Router			/v1/dv-admin/store/{id}/webhook-client-certificate [delete]
+//	@Security		BearerAuth
+func (h *Handler) deleteStoreWebhookClientCertificate(c fiber.Ctx) error {
+	targetStore, err := h.validateAndLoadStore(c)
+	if err != nil {
+		return err
+	}
+
+	if err = h.services.WebHookService.DeleteClientCertificate(c.Context(), targetStore.ID); err != nil {
+		return h.handleWebhookClientCertificateError(err)
+	}
+
+	return c.JSON(response.OkByMessage("Webhook client certificate successfully deleted"))
+}
+
+func (h *Handler) handleWebhookClientCertificateError(err error) error {
+	switch {
+	case errors.Is(err, webhook.ErrClientCertificateNotFound):
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusNotFound)
+	case errors.Is(err, webhook.ErrInvalidClientCertificate),
+		errors.Is(err, webhook.ErrClientCertificateExpired):
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusBadRequest)
+	}
+
+	h.logger.Errorw("webhook client certificate request failed", "error", err)
+	return apierror.New().AddError(errors.New("failed to process webhook client certificate")).SetHttpCode(fiber.StatusBadRequest)
+}
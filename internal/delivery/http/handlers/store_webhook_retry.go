@@ -0,0 +1,218 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/dv-net/dv-merchant/internal/delivery/http/request/store_webhook_request"
+	"github.com/dv-net/dv-merchant/internal/service/webhook"
+	"github.com/dv-net/dv-merchant/internal/tools"
+	"github.com/dv-net/dv-merchant/internal/tools/apierror"
+	"github.com/dv-net/dv-merchant/internal/tools/converters"
+	"github.com/dv-net/dv-merchant/internal/tools/response"
+
+	_ "github.com/dv-net/dv-merchant/internal/delivery/http/responses/webhook_response" // swaggo
+	_ "github.com/dv-net/dv-merchant/internal/storage/storecmn"                         // swaggo
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+// loadStoreWebhookRetryPolicy is a function to load store webhook retry policy
+//
+//	@Summary		Load store webhook retry policy
+//	@Description	Load policy used to retry failed store webhooks before they are moved to dead letters
+//	@Tags			StoreWebhook
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	path		string	true	"Store ID"
+//	@Success		200	{object}	response.Result[webhook_response.RetryPolicyResponse]
+//	@Failure		401	{object}	apierror.Errors
+//	@Failure		404	{object}	apierror.Errors
+//	@Router			/v1/dv-admin/store/{id}/webhook-retry-policy [get]
+//	@Security		BearerAuth
+func (h *Handler) loadStoreWebhookRetryPolicy(c fiber.Ctx) error {
+	targetStore, err := h.validateAndLoadStore(c)
+	if err != nil {
+		return err
+	}
+
+	policy, err := h.services.WebHookService.GetRetryPolicy(c.Context(), targetStore.ID)
+	if err != nil {
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusBadRequest)
+	}
+
+	return c.JSON(response.OkByData(converters.FromRetryPolicyModelToResponse(policy)))
+}
+
+// updateStoreWebhookRetryPolicy is a function to update store webhook retry policy
+//
+//	@Summary		Update store webhook retry policy
+//	@Description	Update policy used to retry failed store webhooks before they are moved to dead letters
+//	@Tags			StoreWebhook
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string											true	"Store ID"
+//	@Param			register	body		store_webhook_request.UpdateRetryPolicyRequest	true	"Retry policy"
+//	@Success		200			{object}	response.Result[webhook_response.RetryPolicyResponse]
+//	@Failure		400			{object}	apierror.Errors
+//	@Failure		401			{object}	apierror.Errors
+//	@Failure		404			{object}	apierror.Errors
+//	@Router			/v1/dv-admin/store/{id}/webhook-retry-policy [put]
+//	@Security		BearerAuth
+func (h *Handler) updateStoreWebhookRetryPolicy(c fiber.Ctx) error {
+	targetStore, err := h.validateAndLoadStore(c)
+	if err != nil {
+		return err
+	}
+
+	request := &store_webhook_request.UpdateRetryPolicyRequest{}
+	if err := c.Bind().Body(request); err != nil {
+		return err
+	}
+
+	policy, err := h.services.WebHookService.UpdateRetryPolicy(c.Context(), targetStore.ID, converters.FromRetryPolicyRequestToDTO(request))
+	if err != nil {
+		return h.handleWebhookRetryError(err)
+	}
+
+	return c.JSON(response.OkByData(converters.FromRetryPolicyModelToResponse(*policy)))
+}
+
+// loadStoreWebhookDeadLetters is a function to load store webhooks that exhausted retry policy
+//
+//	@Summary		Load store webhook dead letters
+//	@Description	Load store webhooks that exhausted retry policy
+//	@Tags			StoreWebhook
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string											true	"Store ID"
+//	@Param			string	query		store_webhook_request.ListDeadLettersRequest	true	"Dead letters filter"
+//	@Success		200		{object}	response.Result[storecmn.FindResponseWithFullPagination[webhook_response.DeadLetterResponse]]
+//	@Failure		401		{object}	apierror.Errors
+//	@Failure		404		{object}	apierror.Errors
+//	@Router			/v1/dv-admin/store/{id}/webhook-dead-letters [get]
+//	@Security		BearerAuth
+func (h *Handler) loadStoreWebhookDeadLetters(c fiber.Ctx) error {
+	targetStore, err := h.validateAndLoadStore(c)
+	if err != nil {
+		return err
+	}
+
+	request := &store_webhook_request.ListDeadLettersRequest{}
+	if err := c.Bind().Query(request); err != nil {
+		return err
+	}
+
+	txID, err := parseOptionalUUID(request.TransactionID)
+	if err != nil {
+		return err
+	}
+
+	res, err := h.services.WebHookService.ListDeadLetters(c.Context(), targetStore.ID, converters.FromDeadLettersListRequestToDTO(request, txID))
+	if err != nil {
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusBadRequest)
+	}
+
+	return c.JSON(response.OkByData(converters.FromDeadLettersListToResponse(res)))
+}
+
+// loadStoreWebhookDeadLetter is a function to inspect store webhook dead letter
+//
+//	@Summary		Load store webhook dead letter
+//	@Description	Load store webhook dead letter with payload and signature
+//	@Tags			StoreWebhook
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path		string	true	"Store ID"
+//	@Param			deadLetterId	path		string	true	"Dead letter ID"
+//	@Success		200				{object}	response.Result[webhook_response.DeadLetterWithPayloadResponse]
+//	@Failure		401				{object}	apierror.Errors
+//	@Failure		404				{object}	apierror.Errors
+//	@Router			/v1/dv-admin/store/{id}/webhook-dead-letters/{deadLetterId} [get]
+//	@Security		BearerAuth
+func (h *Handler) loadStoreWebhookDeadLetter(c fiber.Ctx) error {
+	targetStore, err := h.validateAndLoadStore(c)
+	if err != nil {
+		return err
+	}
+
+	deadLetterID, err := tools.ValidateUUID(c.Params("deadLetterId"))
+	if err != nil {
+		return err
+	}
+
+	res, err := h.services.WebHookService.GetDeadLetter(c.Context(), targetStore.ID, deadLetterID)
+	if err != nil {
+		return h.handleWebhookRetryError(err)
+	}
+
+	return c.JSON(response.OkByData(converters.FromDeadLetterModelToPayloadResponse(res)))
+}
+
+// requeueStoreWebhookDeadLetters is a function to put store webhook dead letters back to the send queue
+//
+//	@Summary		Requeue store webhook dead letters
+//	@Description	Put selected or all pending store webhook dead letters back to the send queue
+//	@Tags			StoreWebhook
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string											true	"Store ID"
+//	@Param			register	body		store_webhook_request.RequeueDeadLettersRequest	true	"Dead letters to requeue"
+//	@Success		200			{object}	response.Result[webhook_response.RequeueDeadLettersResponse]
+//	@Failure		400			{object}	apierror.Errors
+//	@Failure		401			{object}	apierror.Errors
+//	@Failure		404			{object}	apierror.Errors
+//	@Router			/v1/dv-admin/store/{id}/webhook-dead-letters/requeue [post]
+//	@Security		BearerAuth
+func (h *Handler) requeueStoreWebhookDeadLetters(c fiber.Ctx) error {
+	targetStore, err := h.validateAndLoadStore(c)
+	if err != nil {
+		return err
+	}
+
+	request := &store_webhook_request.RequeueDeadLettersRequest{}
+	if err := c.Bind().Body(request); err != nil {
+		return err
+	}
+
+	ids, err := parseUUIDs(request.IDs)
+	if err != nil {
+		return err
+	}
+
+	res, err := h.services.WebHookService.RequeueDeadLetters(c.Context(), targetStore.ID, ids)
+	if err != nil {
+		return h.handleWebhookRetryError(err)
+	}
+
+	return c.JSON(response.OkByData(converters.FromRequeueResultToResponse(res)))
+}
+
+func (h *Handler) handleWebhookRetryError(err error) error {
+	switch {
+	case errors.Is(err, webhook.ErrDeadLetterNotFound):
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusNotFound)
+	case errors.Is(err, webhook.ErrInvalidRetryPolicy),
+		errors.Is(err, webhook.ErrInvalidMaxAttempts),
+		errors.Is(err, webhook.ErrInvalidRetryDelay),
+		errors.Is(err, webhook.ErrInvalidRetrySchedule),
+		errors.Is(err, webhook.ErrTooManyDeadLetterIDs):
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusBadRequest)
+	}
+
+	h.logger.Errorw("webhook retry request failed", "error", err)
+	return apierror.New().AddError(errors.New("failed to process webhook request")).SetHttpCode(fiber.StatusBadRequest)
+}
+
+func parseUUIDs(values []string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(values))
+	for _, value := range values {
+		id, err := tools.ValidateUUID(value)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/dv-net/dv-merchant/internal/delivery/http/request/wallet_request"
 	"github.com/dv-net/dv-merchant/internal/delivery/http/responses/wallet_response"
 	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/exrate"
 	"github.com/dv-net/dv-merchant/internal/service/wallet"
 	"github.com/dv-net/dv-merchant/internal/storage/storecmn"
 	"github.com/dv-net/dv-merchant/internal/tools"
@@ -53,6 +54,7 @@ type AddressFull struct {
 //	@Success		200		{object}	response.Result[handlers.WalletShort]
 //	@Failure		401		{object}	apierror.Errors
 //	@Failure		500		{object}	apierror.Errors
+//	@Failure		503		{object}	apierror.Errors
 //	@Router			/v1/dv-admin/wallet/addresses [post]
 //	@Security		BearerAuth
 func (h *Handler) createWalletWithAddresses(c fiber.Ctx) error {
@@ -93,6 +95,10 @@ func (h *Handler) createWalletWithAddresses(c fiber.Ctx) error {
 
 	walletWithAddress, err := h.services.WalletService.StoreWalletWithAddress(c.Context(), dto, amount)
 	if err != nil {
+		var staleErr *exrate.StaleExchangeRateError
+		if errors.As(err, &staleErr) {
+			return apierror.New().AddError(err).SetHttpCode(fiber.StatusServiceUnavailable)
+		}
 		return apierror.New().AddError(err).SetHttpCode(fiber.StatusBadRequest)
 	}
 
@@ -2,10 +2,8 @@ package handlers
 
 import (
 	"github.com/dv-net/dv-merchant/internal/delivery/http/request/webhook"
+	"github.com/dv-net/dv-merchant/internal/delivery/http/responses/webhook_response"
 	"github.com/dv-net/dv-merchant/internal/models"
-
-	// Blank import for swaggen
-	_ "github.com/dv-net/dv-merchant/internal/delivery/http/responses/webhook_response"
 	"github.com/dv-net/dv-merchant/internal/tools/apierror"
 	"github.com/dv-net/dv-merchant/internal/tools/converters"
 	"github.com/dv-net/dv-merchant/internal/tools/response"
@@ -82,8 +80,42 @@ func (h *Handler) sendTestWh(c fiber.Ctx) error {
 	return c.JSON(response.OkByData(converters.FromSendResultDtoToResponse(res)))
 }
 
+// sourceIPs get webhook source ips
+//
+//	@Summary		Get webhook source IPs
+//	@Description	This endpoint returns IP addresses store webhooks are sent from, so they may be allowlisted
+//	@Tags			StoreWebhook
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	response.Result[webhook_response.SourceIPsResponse]	"Successful operation"
+//	@Failure		401	{object}	apierror.Errors										"Unauthorized"
+//	@Router			/v1/dv-admin/webhook/source-ips [get]
+//	@Security		BearerAuth
+func (h *Handler) sourceIPs(c fiber.Ctx) error {
+	return c.JSON(response.OkByData(webhook_response.SourceIPsResponse{
+		IPs: h.services.WebHookService.SourceIPs(),
+	}))
+}
+
+// whEvents get webhook event catalogue
+//
+//	@Summary		Get webhook event catalogue
+//	@Description	This endpoint returns events store webhooks may subscribe to and supported payload schema versions
+//	@Tags			StoreWebhook
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	response.Result[webhook_response.EventCatalogueResponse]	"Successful operation"
+//	@Failure		401	{object}	apierror.Errors												"Unauthorized"
+//	@Router			/v1/dv-admin/webhook/events [get]
+//	@Security		BearerAuth
+func (h *Handler) whEvents(c fiber.Ctx) error {
+	return c.JSON(response.OkByData(converters.FromWebhookEventsToCatalogueResponse(models.WebhookEvents)))
+}
+
 func (h *Handler) initWhRoutes(router fiber.Router) {
 	wh := router.Group("/webhook")
 	wh.Get("/history", h.history)
 	wh.Post("/send-test", h.sendTestWh)
+	wh.Get("/source-ips", h.sourceIPs)
+	wh.Get("/events", h.whEvents)
 }
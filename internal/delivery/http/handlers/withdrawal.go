@@ -124,6 +124,14 @@ func (h *Handler) updateWithdrawalRule(c fiber.Ctx) error {
 			Mode:          req.LowBalanceRules.Mode,
 			ManualAddress: req.LowBalanceRules.ManualAddress,
 		}
+		for _, leg := range req.LowBalanceRules.SplitLegs {
+			multiRulesDTO.SplitLegs = append(multiRulesDTO.SplitLegs, withdrawal_wallet.SplitLegDTO{
+				Address:           leg.Address,
+				Percent:           leg.Percent,
+				MinAmountUSD:      leg.MinAmountUSD,
+				ReceivesRemainder: leg.ReceivesRemainder,
+			})
+		}
 	}
 
 	err = h.services.WithdrawalWalletService.UpdateWithdrawalRules(c.Context(), withdrawal_wallet.UpdateRulesDTO{
@@ -151,10 +159,12 @@ func (h *Handler) updateWithdrawalRule(c fiber.Ctx) error {
 //	@Produce		json
 //	@Param			register	body		withdrawal_requests.ManualWithdrawRequest	true	"Manual withdraw"
 //	@Success		200			{object}	response.Result[[]string]
-//	@Failure		400			{object}	apierror.Errors	"Bad request"
-//	@Failure		403			{object}	apierror.Errors	"Forbidden"
-//	@Failure		422			{object}	apierror.Errors	"Unprocessable Entity"
-//	@Failure		500			{object}	apierror.Errors	"Internal Server Error"
+//	@Success		202			{object}	response.Result[withdrawal_response.WithdrawalApprovalResponse]	"Held for approval"
+//	@Failure		400			{object}	apierror.Errors													"Bad request"
+//	@Failure		403			{object}	apierror.Errors													"Forbidden"
+//	@Failure		422			{object}	apierror.Errors													"Unprocessable Entity"
+//	@Failure		429			{object}	apierror.Errors													"Withdrawal limit exceeded"
+//	@Failure		500			{object}	apierror.Errors													"Internal Server Error"
 //	@Router			/v1/dv-admin/withdrawal/withdraw-manual [Post]
 //	@Security		BearerAuth
 func (h *Handler) manualWithdraw(c fiber.Ctx) error {
@@ -169,10 +179,21 @@ func (h *Handler) manualWithdraw(c fiber.Ctx) error {
 	}
 
 	if err = h.services.WithdrawService.WithdrawFromAddress(c.Context(), user, dto.WalletAddressID, dto.CurrencyID); err != nil {
+		var approvalErr *withdraw.ApprovalRequiredError
+		if errors.As(err, &approvalErr) {
+			return c.Status(fiber.StatusAccepted).JSON(response.OkByData(converters.FromWithdrawalApprovalToResponse(approvalErr.Approval)))
+		}
+
 		apiErr := apierror.New().AddError(err)
-		if errors.Is(err, withdraw.ErrWalletIsNotOwnedByUser) {
+		if errors.Is(err, withdraw.ErrWalletIsNotOwnedByUser) ||
+			errors.Is(err, withdraw.ErrDestinationNotAllowlisted) ||
+			errors.Is(err, withdraw.ErrDestinationCoolingDown) {
 			return apiErr.SetHttpCode(fiber.StatusForbidden)
 		}
+		var limitErr *withdraw.WithdrawalLimitExceededError
+		if errors.As(err, &limitErr) {
+			return apiErr.SetHttpCode(fiber.StatusTooManyRequests)
+		}
 
 		return apiErr.SetHttpCode(fiber.StatusBadRequest)
 	}
@@ -189,10 +210,12 @@ func (h *Handler) manualWithdraw(c fiber.Ctx) error {
 //	@Produce		json
 //	@Param			register	body		withdrawal_requests.ManualMultipleWithdrawRequest	true	"Manual withdraw"
 //	@Success		200			{object}	response.Result[[]string]
-//	@Failure		400			{object}	apierror.Errors	"Bad request"
-//	@Failure		403			{object}	apierror.Errors	"Forbidden"
-//	@Failure		422			{object}	apierror.Errors	"Unprocessable Entity"
-//	@Failure		500			{object}	apierror.Errors	"Internal Server Error"
+//	@Success		202			{object}	response.Result[withdrawal_response.WithdrawalApprovalResponse]	"Held for approval"
+//	@Failure		400			{object}	apierror.Errors													"Bad request"
+//	@Failure		403			{object}	apierror.Errors													"Forbidden"
+//	@Failure		422			{object}	apierror.Errors													"Unprocessable Entity"
+//	@Failure		429			{object}	apierror.Errors													"Withdrawal limit exceeded"
+//	@Failure		500			{object}	apierror.Errors													"Internal Server Error"
 //	@Router			/v1/dv-admin/withdrawal/withdraw-multiple-manual [Post]
 //	@Security		BearerAuth
 func (h *Handler) manualWithdrawMultiple(c fiber.Ctx) error {
@@ -212,10 +235,21 @@ func (h *Handler) manualWithdrawMultiple(c fiber.Ctx) error {
 		ExcludedWalletAddressesIDs: dto.ExcludedWalletAddressesIDs,
 		CurrencyID:                 dto.CurrencyID,
 	}); err != nil {
+		var approvalErr *withdraw.ApprovalRequiredError
+		if errors.As(err, &approvalErr) {
+			return c.Status(fiber.StatusAccepted).JSON(response.OkByData(converters.FromWithdrawalApprovalToResponse(approvalErr.Approval)))
+		}
+
 		apiErr := apierror.New().AddError(err)
-		if errors.Is(err, withdraw.ErrWalletIsNotOwnedByUser) {
+		if errors.Is(err, withdraw.ErrWalletIsNotOwnedByUser) ||
+			errors.Is(err, withdraw.ErrDestinationNotAllowlisted) ||
+			errors.Is(err, withdraw.ErrDestinationCoolingDown) {
 			return apiErr.SetHttpCode(fiber.StatusForbidden)
 		}
+		var limitErr *withdraw.WithdrawalLimitExceededError
+		if errors.As(err, &limitErr) {
+			return apiErr.SetHttpCode(fiber.StatusTooManyRequests)
+		}
 
 		return apiErr
 	}
@@ -305,11 +339,14 @@ func (h *Handler) withdrawToProcessingMultiple(c fiber.Ctx) error {
 //	@Produce		json
 //	@Param			register	body		withdrawal_requests.CreateProcessingWithdrawInternalRequest	true	"Init withdrawal"
 //	@Success		200			{object}	response.Result[withdrawal_response.ProcessingWithdrawalResponse]
+//	@Success		202			{object}	response.Result[withdrawal_response.WithdrawalApprovalResponse]	"Held for approval"
 //	@Failure		401			{object}	apierror.Errors
+//	@Failure		403			{object}	apierror.Errors
 //	@Failure		423			{object}	apierror.Errors
 //	@Failure		404			{object}	apierror.Errors
 //	@Failure		409			{object}	apierror.Errors
 //	@Failure		422			{object}	apierror.Errors
+//	@Failure		429			{object}	apierror.Errors
 //	@Failure		500			{object}	apierror.Errors
 //	@Router			/v1/dv-admin/withdrawal/withdrawal-from-processing [post]
 //	@Security		BearerAuth
@@ -333,15 +370,21 @@ func (h *Handler) createWithdrawalFromProcessingWallet(c fiber.Ctx) error {
 	}
 
 	dto := withdraw.CreateWithdrawalFromProcessingDTO{
-		CurrencyID: req.CurrencyID,
-		Amount:     req.Amount,
-		AddressTo:  req.AddressTo,
-		UserID:     user.ID,
-		RequestID:  req.RequestID,
+		CurrencyID:  req.CurrencyID,
+		Amount:      req.Amount,
+		AddressTo:   req.AddressTo,
+		UserID:      user.ID,
+		RequestID:   req.RequestID,
+		InitiatedBy: uuid.NullUUID{UUID: user.ID, Valid: true},
 	}
 
 	res, err := h.services.WithdrawService.CreateWithdrawalFromProcessing(c.Context(), dto)
 	if err != nil {
+		var approvalErr *withdraw.ApprovalRequiredError
+		if errors.As(err, &approvalErr) {
+			return c.Status(fiber.StatusAccepted).JSON(response.OkByData(converters.FromWithdrawalApprovalToResponse(approvalErr.Approval)))
+		}
+
 		return prepareWithdrawalHTTPError(err)
 	}
 
@@ -367,6 +410,10 @@ func (h *Handler) initWithdrawalRoutes(v1 fiber.Router) {
 
 	// Add withdrawal rule routes
 	withdrawal.Post("/address-book/withdrawal-rule", h.addWithdrawalRule)
+
+	h.initWithdrawalApprovalRoutes(withdrawal)
+	h.initWithdrawalLimitRoutes(withdrawal)
+	h.initWithdrawalScheduleRoutes(withdrawal)
 }
 
 func prepareWithdrawalHTTPError(err error) error {
@@ -380,7 +427,9 @@ func prepareWithdrawalHTTPError(err error) error {
 		errCode = fiber.StatusUnprocessableEntity
 	case errors.Is(err, withdraw.ErrWithdrawFromProcessingToHotNotAllowed):
 		errCode = fiber.StatusNotAcceptable
-	case errors.Is(err, withdraw.ErrStoreIsNotOwnedByUser):
+	case errors.Is(err, withdraw.ErrStoreIsNotOwnedByUser),
+		errors.Is(err, withdraw.ErrDestinationNotAllowlisted),
+		errors.Is(err, withdraw.ErrDestinationCoolingDown):
 		errCode = fiber.StatusForbidden
 	}
 
@@ -389,6 +438,11 @@ func prepareWithdrawalHTTPError(err error) error {
 		errCode = fiber.StatusConflict
 	}
 
+	var limitErr *withdraw.WithdrawalLimitExceededError
+	if errors.As(err, &limitErr) {
+		errCode = fiber.StatusTooManyRequests
+	}
+
 	return apierror.New().AddError(err).SetHttpCode(errCode)
 }
 
@@ -0,0 +1,268 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dv-net/dv-merchant/internal/delivery/http/request/withdrawal_requests"
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/withdraw"
+	"github.com/dv-net/dv-merchant/internal/tools"
+	"github.com/dv-net/dv-merchant/internal/tools/apierror"
+	"github.com/dv-net/dv-merchant/internal/tools/converters"
+	"github.com/dv-net/dv-merchant/internal/tools/response"
+
+	_ "github.com/dv-net/dv-merchant/internal/delivery/http/responses/withdrawal_response" // swaggo
+	_ "github.com/dv-net/dv-merchant/internal/storage/storecmn"                            // swaggo
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+// loadWithdrawalApprovalRules is a function to load withdrawal approval rules
+//
+//	@Summary		Load withdrawal approval rules
+//	@Description	Load rules holding withdrawals until approved, available for root
+//	@Tags			Withdrawal approval
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	response.Result[[]withdrawal_response.WithdrawalApprovalRuleResponse]
+//	@Failure		401	{object}	apierror.Errors
+//	@Failure		403	{object}	apierror.Errors
+//	@Router			/v1/dv-admin/withdrawal/approval-rules [get]
+//	@Security		BearerAuth
+func (h *Handler) loadWithdrawalApprovalRules(c fiber.Ctx) error {
+	rules, err := h.services.WithdrawService.GetApprovalRules(c.Context())
+	if err != nil {
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusBadRequest)
+	}
+
+	return c.JSON(response.OkByData(converters.FromWithdrawalApprovalRulesToResponse(rules)))
+}
+
+// createWithdrawalApprovalRule is a function to create withdrawal approval rule
+//
+//	@Summary		Create withdrawal approval rule
+//	@Description	Require approvals for withdrawals matching currency, destination and amount in USD, empty conditions match any withdrawal
+//	@Tags			Withdrawal approval
+//	@Accept			json
+//	@Produce		json
+//	@Param			register	body		withdrawal_requests.CreateApprovalRuleRequest	true	"Approval rule"
+//	@Success		200			{object}	response.Result[withdrawal_response.WithdrawalApprovalRuleResponse]
+//	@Failure		400			{object}	apierror.Errors
+//	@Failure		401			{object}	apierror.Errors
+//	@Failure		403			{object}	apierror.Errors
+//	@Router			/v1/dv-admin/withdrawal/approval-rules [post]
+//	@Security		BearerAuth
+func (h *Handler) createWithdrawalApprovalRule(c fiber.Ctx) error {
+	usr, err := loadAuthUser(c)
+	if err != nil {
+		return err
+	}
+
+	request := &withdrawal_requests.CreateApprovalRuleRequest{}
+	if err := c.Bind().Body(request); err != nil {
+		return err
+	}
+
+	rule, err := h.services.WithdrawService.CreateApprovalRule(c.Context(), usr, converters.FromCreateApprovalRuleRequestToDTO(request))
+	if err != nil {
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusBadRequest)
+	}
+
+	return c.JSON(response.OkByData(converters.FromWithdrawalApprovalRuleToResponse(rule)))
+}
+
+// deleteWithdrawalApprovalRule is a function to delete withdrawal approval rule
+//
+//	@Summary		Delete withdrawal approval rule
+//	@Description	Delete withdrawal approval rule, already requested approvals are kept
+//	@Tags			Withdrawal approval
+//	@Accept			json
+//	@Produce		json
+//	@Param			ruleId	path		string	true	"Rule ID"
+//	@Success		200		{object}	response.Result[string]
+//	@Failure		401		{object}	apierror.Errors
+//	@Failure		403		{object}	apierror.Errors
+//	@Failure		404		{object}	apierror.Errors
+//	@Router			/v1/dv-admin/withdrawal/approval-rules/{ruleId} [delete]
+//	@Security		BearerAuth
+func (h *Handler) deleteWithdrawalApprovalRule(c fiber.Ctx) error {
+	ruleID, err := tools.ValidateUUID(c.Params("ruleId"))
+	if err != nil {
+		return err
+	}
+
+	if err = h.services.WithdrawService.DeleteApprovalRule(c.Context(), ruleID); err != nil {
+		return h.handleWithdrawalApprovalError(err)
+	}
+
+	return c.JSON(response.OkByMessage("Withdrawal approval rule deleted successfully"))
+}
+
+// loadWithdrawalApprovals is a function to load withdrawals held for approval
+//
+//	@Summary		Load withdrawal approvals
+//	@Description	Load withdrawals held for approval, available for root and finance manager
+//	@Tags			Withdrawal approval
+//	@Accept			json
+//	@Produce		json
+//	@Param			string	query		withdrawal_requests.ListApprovalsRequest	true	"Approvals filter"
+//	@Success		200		{object}	response.Result[storecmn.FindResponseWithFullPagination[withdrawal_response.WithdrawalApprovalResponse]]
+//	@Failure		401		{object}	apierror.Errors
+//	@Failure		403		{object}	apierror.Errors
+//	@Router			/v1/dv-admin/withdrawal/approvals [get]
+//	@Security		BearerAuth
+func (h *Handler) loadWithdrawalApprovals(c fiber.Ctx) error {
+	request := &withdrawal_requests.ListApprovalsRequest{}
+	if err := c.Bind().Query(request); err != nil {
+		return err
+	}
+
+	userID, err := parseOptionalUUID(request.UserID)
+	if err != nil {
+		return err
+	}
+
+	res, err := h.services.WithdrawService.GetApprovals(c.Context(), converters.FromListApprovalsRequestToDTO(request, userID))
+	if err != nil {
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusBadRequest)
+	}
+
+	return c.JSON(response.OkByData(converters.FromWithdrawalApprovalListToResponse(res)))
+}
+
+// loadWithdrawalApproval is a function to load withdrawal approval with decisions
+//
+//	@Summary		Load withdrawal approval
+//	@Description	Load withdrawal approval with decisions of approvers, available for root and finance manager
+//	@Tags			Withdrawal approval
+//	@Accept			json
+//	@Produce		json
+//	@Param			approvalId	path		string	true	"Approval ID"
+//	@Success		200			{object}	response.Result[withdrawal_response.WithdrawalApprovalWithDecisionsResponse]
+//	@Failure		401			{object}	apierror.Errors
+//	@Failure		403			{object}	apierror.Errors
+//	@Failure		404			{object}	apierror.Errors
+//	@Router			/v1/dv-admin/withdrawal/approvals/{approvalId} [get]
+//	@Security		BearerAuth
+func (h *Handler) loadWithdrawalApproval(c fiber.Ctx) error {
+	approvalID, err := tools.ValidateUUID(c.Params("approvalId"))
+	if err != nil {
+		return err
+	}
+
+	res, err := h.services.WithdrawService.GetApproval(c.Context(), approvalID)
+	if err != nil {
+		return h.handleWithdrawalApprovalError(err)
+	}
+
+	return c.JSON(response.OkByData(converters.FromWithdrawalApprovalWithDecisionsToResponse(res)))
+}
+
+// approveWithdrawal is a function to sign withdrawal held for approval
+//
+//	@Summary		Approve withdrawal
+//	@Description	Sign withdrawal with two-factor code, the last required signature executes it
+//	@Tags			Withdrawal approval
+//	@Accept			json
+//	@Produce		json
+//	@Param			approvalId	path		string										true	"Approval ID"
+//	@Param			register	body		withdrawal_requests.ApprovalDecisionRequest	true	"Decision"
+//	@Success		200			{object}	response.Result[withdrawal_response.WithdrawalApprovalResponse]
+//	@Failure		400			{object}	apierror.Errors
+//	@Failure		401			{object}	apierror.Errors
+//	@Failure		403			{object}	apierror.Errors
+//	@Failure		404			{object}	apierror.Errors
+//	@Failure		422			{object}	apierror.Errors
+//	@Router			/v1/dv-admin/withdrawal/approvals/{approvalId}/approve [post]
+//	@Security		BearerAuth
+func (h *Handler) approveWithdrawal(c fiber.Ctx) error {
+	return h.decideWithdrawal(c, h.services.WithdrawService.ApproveWithdrawal)
+}
+
+// rejectWithdrawal is a function to reject withdrawal held for approval
+//
+//	@Summary		Reject withdrawal
+//	@Description	Reject withdrawal with two-factor code, a single rejection cancels it
+//	@Tags			Withdrawal approval
+//	@Accept			json
+//	@Produce		json
+//	@Param			approvalId	path		string										true	"Approval ID"
+//	@Param			register	body		withdrawal_requests.ApprovalDecisionRequest	true	"Decision"
+//	@Success		200			{object}	response.Result[withdrawal_response.WithdrawalApprovalResponse]
+//	@Failure		400			{object}	apierror.Errors
+//	@Failure		401			{object}	apierror.Errors
+//	@Failure		403			{object}	apierror.Errors
+//	@Failure		404			{object}	apierror.Errors
+//	@Failure		422			{object}	apierror.Errors
+//	@Router			/v1/dv-admin/withdrawal/approvals/{approvalId}/reject [post]
+//	@Security		BearerAuth
+func (h *Handler) rejectWithdrawal(c fiber.Ctx) error {
+	return h.decideWithdrawal(c, h.services.WithdrawService.RejectWithdrawal)
+}
+
+type withdrawalDecisionFunc func(ctx context.Context, approver *models.User, id uuid.UUID, comment *string) (*models.WithdrawalApproval, error)
+
+func (h *Handler) decideWithdrawal(c fiber.Ctx, decide withdrawalDecisionFunc) error {
+	usr, err := loadAuthUser(c)
+	if err != nil {
+		return err
+	}
+
+	approvalID, err := tools.ValidateUUID(c.Params("approvalId"))
+	if err != nil {
+		return err
+	}
+
+	request := &withdrawal_requests.ApprovalDecisionRequest{}
+	if err := c.Bind().Body(request); err != nil {
+		return err
+	}
+
+	if err := h.services.ProcessingOwnerService.ValidateTwoFactorToken(c.Context(), usr.ProcessingOwnerID.UUID, request.TOTP); err != nil {
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusBadRequest)
+	}
+
+	res, err := decide(c.Context(), usr, approvalID, request.Comment)
+	if err != nil {
+		return h.handleWithdrawalApprovalError(err)
+	}
+
+	return c.JSON(response.OkByData(converters.FromWithdrawalApprovalToResponse(res)))
+}
+
+func (h *Handler) handleWithdrawalApprovalError(err error) error {
+	switch {
+	case errors.Is(err, withdraw.ErrApprovalNotFound),
+		errors.Is(err, withdraw.ErrApprovalRuleNotFound):
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusNotFound)
+	case errors.Is(err, withdraw.ErrApproverIsInitiator):
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusForbidden)
+	case errors.Is(err, withdraw.ErrApprovalNotPending),
+		errors.Is(err, withdraw.ErrApprovalExpired),
+		errors.Is(err, withdraw.ErrApprovalAlreadyDecided):
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusUnprocessableEntity)
+	}
+
+	h.logger.Errorw("withdrawal approval request failed", "error", err)
+	return apierror.New().AddError(errors.New("failed to process withdrawal approval")).SetHttpCode(fiber.StatusBadRequest)
+}
+
+func (h *Handler) initWithdrawalApprovalRoutes(withdrawal fiber.Router) {
+	rules := withdrawal.Group("/approval-rules", h.services.PermissionService.FiberMiddleware(models.UserRoleRoot))
+	rules.Get("/", h.loadWithdrawalApprovalRules)
+	rules.Post("/", h.createWithdrawalApprovalRule)
+	rules.Delete("/:ruleId", h.deleteWithdrawalApprovalRule)
+
+	approvals := withdrawal.Group("/approvals", h.services.PermissionService.FiberMiddleware(
+		[]models.UserRole{
+			models.UserRoleRoot,
+			models.UserRoleFinanceManager,
+		}...,
+	))
+	approvals.Get("/", h.loadWithdrawalApprovals)
+	approvals.Get("/:approvalId", h.loadWithdrawalApproval)
+	approvals.Post("/:approvalId/approve", h.approveWithdrawal)
+	approvals.Post("/:approvalId/reject", h.rejectWithdrawal)
+}
@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/dv-net/dv-merchant/internal/delivery/http/request/withdrawal_requests"
+	"github.com/dv-net/dv-merchant/internal/service/withdraw"
+	"github.com/dv-net/dv-merchant/internal/tools"
+	"github.com/dv-net/dv-merchant/internal/tools/apierror"
+	"github.com/dv-net/dv-merchant/internal/tools/converters"
+	"github.com/dv-net/dv-merchant/internal/tools/response"
+
+	_ "github.com/dv-net/dv-merchant/internal/delivery/http/responses/withdrawal_response" // swaggo
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// loadWithdrawalLimits is a function to load withdrawal limits of the user
+//
+//	@Summary		Load withdrawal limits
+//	@Description	Load rolling window limits of amount in USD and count applied to manual, API and scheduled withdrawals, sweeps to the processing wallet are not limited
+//	@Tags			Withdrawal limit
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	response.Result[[]withdrawal_response.WithdrawalLimitResponse]
+//	@Failure		401	{object}	apierror.Errors
+//	@Router			/v1/dv-admin/withdrawal/limits [get]
+//	@Security		BearerAuth
+func (h *Handler) loadWithdrawalLimits(c fiber.Ctx) error {
+	usr, err := loadAuthUser(c)
+	if err != nil {
+		return err
+	}
+
+	limits, err := h.services.WithdrawService.GetWithdrawalLimits(c.Context(), usr)
+	if err != nil {
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusBadRequest)
+	}
+
+	return c.JSON(response.OkByData(converters.FromWithdrawalLimitsToResponse(limits)))
+}
+
+// createWithdrawalLimit is a function to create withdrawal limit
+//
+//	@Summary		Create withdrawal limit
+//	@Description	Limit amount in USD and count of withdrawals within rolling window per user, store, currency or destination, empty scope value limits each of them separately
+//	@Tags			Withdrawal limit
+//	@Accept			json
+//	@Produce		json
+//	@Param			register	body		withdrawal_requests.CreateWithdrawalLimitRequest	true	"Withdrawal limit"
+//	@Success		200			{object}	response.Result[withdrawal_response.WithdrawalLimitResponse]
+//	@Failure		400			{object}	apierror.Errors
+//	@Failure		401			{object}	apierror.Errors
+//	@Failure		403			{object}	apierror.Errors
+//	@Failure		422			{object}	apierror.Errors
+//	@Router			/v1/dv-admin/withdrawal/limits [post]
+//	@Security		BearerAuth
+func (h *Handler) createWithdrawalLimit(c fiber.Ctx) error {
+	usr, err := loadAuthUser(c)
+	if err != nil {
+		return err
+	}
+
+	request := &withdrawal_requests.CreateWithdrawalLimitRequest{}
+	if err := c.Bind().Body(request); err != nil {
+		return err
+	}
+
+	limit, err := h.services.WithdrawService.CreateWithdrawalLimit(c.Context(), usr, converters.FromCreateWithdrawalLimitRequestToDTO(request))
+	if err != nil {
+		return h.handleWithdrawalLimitError(err)
+	}
+
+	return c.JSON(response.OkByData(converters.FromWithdrawalLimitToResponse(limit)))
+}
+
+// deleteWithdrawalLimit is a function to delete withdrawal limit
+//
+//	@Summary		Delete withdrawal limit
+//	@Description	Delete withdrawal limit, confirmed with two-factor code
+//	@Tags			Withdrawal limit
+//	@Accept			json
+//	@Produce		json
+//	@Param			limitId		path		string												true	"Limit ID"
+//	@Param			register	body		withdrawal_requests.DeleteWithdrawalLimitRequest	true	"Confirmation"
+//	@Success		200			{object}	response.Result[string]
+//	@Failure		400			{object}	apierror.Errors
+//	@Failure		401			{object}	apierror.Errors
+//	@Failure		404			{object}	apierror.Errors
+//	@Router			/v1/dv-admin/withdrawal/limits/{limitId} [delete]
+//	@Security		BearerAuth
+func (h *Handler) deleteWithdrawalLimit(c fiber.Ctx) error {
+	usr, err := loadAuthUser(c)
+	if err != nil {
+		return err
+	}
+
+	limitID, err := tools.ValidateUUID(c.Params("limitId"))
+	if err != nil {
+		return err
+	}
+
+	request := &withdrawal_requests.DeleteWithdrawalLimitRequest{}
+	if err := c.Bind().Body(request); err != nil {
+		return err
+	}
+
+	if err := h.services.ProcessingOwnerService.ValidateTwoFactorToken(c.Context(), usr.ProcessingOwnerID.UUID, request.TOTP); err != nil {
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusBadRequest)
+	}
+
+	if err = h.services.WithdrawService.DeleteWithdrawalLimit(c.Context(), usr, limitID); err != nil {
+		return h.handleWithdrawalLimitError(err)
+	}
+
+	return c.JSON(response.OkByMessage("Withdrawal limit deleted successfully"))
+}
+
+func (h *Handler) handleWithdrawalLimitError(err error) error {
+	switch {
+	case errors.Is(err, withdraw.ErrWithdrawalLimitNotFound):
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusNotFound)
+	case errors.Is(err, withdraw.ErrStoreIsNotOwnedByUser):
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusForbidden)
+	case errors.Is(err, withdraw.ErrInvalidWithdrawalLimitScope),
+		errors.Is(err, withdraw.ErrInvalidWithdrawalLimitWindow),
+		errors.Is(err, withdraw.ErrWithdrawalLimitThresholdRequired):
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusUnprocessableEntity)
+	}
+
+	h.logger.Errorw("withdrawal limit request failed", "error", err)
+	return apierror.New().AddError(errors.New("failed to process withdrawal limit")).SetHttpCode(fiber.StatusBadRequest)
+}
+
+func (h *Handler) initWithdrawalLimitRoutes(withdrawal fiber.Router) {
+	withdrawal.Get("/limits", h.loadWithdrawalLimits)
+	withdrawal.Post("/limits", h.createWithdrawalLimit)
+	withdrawal.Delete("/limits/:limitId", h.deleteWithdrawalLimit)
+}
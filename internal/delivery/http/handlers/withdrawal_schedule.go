@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/dv-net/dv-merchant/internal/delivery/http/request/withdrawal_requests"
+	"github.com/dv-net/dv-merchant/internal/service/withdraw"
+	"github.com/dv-net/dv-merchant/internal/tools/apierror"
+	"github.com/dv-net/dv-merchant/internal/tools/converters"
+	"github.com/dv-net/dv-merchant/internal/tools/response"
+
+	_ "github.com/dv-net/dv-merchant/internal/delivery/http/responses/withdrawal_response" // swaggo
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/jackc/pgx/v5"
+)
+
+// loadWithdrawalSchedule is a function to load withdrawal schedule of the currency
+//
+//	@Summary		Load withdrawal schedule
+//	@Description	Load cron and threshold schedule of automatic withdrawals from hot wallets of the currency
+//	@Tags			Withdrawal schedule
+//	@Accept			json
+//	@Produce		json
+//	@Param			currencyId	path		string	true	"Currency ID"
+//	@Success		200			{object}	response.Result[withdrawal_response.WithdrawalScheduleResponse]
+//	@Failure		401			{object}	apierror.Errors
+//	@Failure		404			{object}	apierror.Errors
+//	@Router			/v1/dv-admin/withdrawal/schedules/{currencyId} [get]
+//	@Security		BearerAuth
+func (h *Handler) loadWithdrawalSchedule(c fiber.Ctx) error {
+	usr, err := loadAuthUser(c)
+	if err != nil {
+		return err
+	}
+
+	schedule, err := h.services.WithdrawService.GetWithdrawalSchedule(c.Context(), usr, c.Params("currencyId"))
+	if err != nil {
+		return h.handleWithdrawalScheduleError(err)
+	}
+
+	return c.JSON(response.OkByData(converters.FromWithdrawalScheduleToResponse(schedule)))
+}
+
+// setWithdrawalSchedule is a function to create or replace withdrawal schedule of the currency
+//
+//	@Summary		Set withdrawal schedule
+//	@Description	Sweep hot wallets of the currency by cron expression in the time zone, when the balance exceeds the threshold in USD or while the gas price is below the threshold, keeping the float in USD on hot wallets. Triggers are combined, confirmed with two-factor code
+//	@Tags			Withdrawal schedule
+//	@Accept			json
+//	@Produce		json
+//	@Param			currencyId	path		string												true	"Currency ID"
+//	@Param			register	body		withdrawal_requests.SetWithdrawalScheduleRequest	true	"Withdrawal schedule"
+//	@Success		200			{object}	response.Result[withdrawal_response.WithdrawalScheduleResponse]
+//	@Failure		400			{object}	apierror.Errors
+//	@Failure		401			{object}	apierror.Errors
+//	@Failure		404			{object}	apierror.Errors
+//	@Failure		422			{object}	apierror.Errors
+//	@Router			/v1/dv-admin/withdrawal/schedules/{currencyId} [put]
+//	@Security		BearerAuth
+func (h *Handler) setWithdrawalSchedule(c fiber.Ctx) error {
+	usr, err := loadAuthUser(c)
+	if err != nil {
+		return err
+	}
+
+	request := &withdrawal_requests.SetWithdrawalScheduleRequest{}
+	if err := c.Bind().Body(request); err != nil {
+		return err
+	}
+
+	if err := h.services.ProcessingOwnerService.ValidateTwoFactorToken(c.Context(), usr.ProcessingOwnerID.UUID, request.TOTP); err != nil {
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusBadRequest)
+	}
+
+	schedule, err := h.services.WithdrawService.SetWithdrawalSchedule(
+		c.Context(),
+		usr,
+		c.Params("currencyId"),
+		converters.FromSetWithdrawalScheduleRequestToDTO(request),
+	)
+	if err != nil {
+		return h.handleWithdrawalScheduleError(err)
+	}
+
+	return c.JSON(response.OkByData(converters.FromWithdrawalScheduleToResponse(schedule)))
+}
+
+// deleteWithdrawalSchedule is a function to delete withdrawal schedule of the currency
+//
+//	@Summary		Delete withdrawal schedule
+//	@Description	Delete withdrawal schedule, hot wallets are swept on every iteration again, confirmed with two-factor code
+//	@Tags			Withdrawal schedule
+//	@Accept			json
+//	@Produce		json
+//	@Param			currencyId	path		string												true	"Currency ID"
+//	@Param			register	body		withdrawal_requests.DeleteWithdrawalScheduleRequest	true	"Confirmation"
+//	@Success		200			{object}	response.Result[string]
+//	@Failure		400			{object}	apierror.Errors
+//	@Failure		401			{object}	apierror.Errors
+//	@Failure		404			{object}	apierror.Errors
+//	@Router			/v1/dv-admin/withdrawal/schedules/{currencyId} [delete]
+//	@Security		BearerAuth
+func (h *Handler) deleteWithdrawalSchedule(c fiber.Ctx) error {
+	usr, err := loadAuthUser(c)
+	if err != nil {
+		return err
+	}
+
+	request := &withdrawal_requests.DeleteWithdrawalScheduleRequest{}
+	if err := c.Bind().Body(request); err != nil {
+		return err
+	}
+
+	if err := h.services.ProcessingOwnerService.ValidateTwoFactorToken(c.Context(), usr.ProcessingOwnerID.UUID, request.TOTP); err != nil {
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusBadRequest)
+	}
+
+	if err = h.services.WithdrawService.DeleteWithdrawalSchedule(c.Context(), usr, c.Params("currencyId")); err != nil {
+		return h.handleWithdrawalScheduleError(err)
+	}
+
+	return c.JSON(response.OkByMessage("Withdrawal schedule deleted successfully"))
+}
+
+func (h *Handler) handleWithdrawalScheduleError(err error) error {
+	switch {
+	case errors.Is(err, withdraw.ErrWithdrawalScheduleNotFound),
+		errors.Is(err, pgx.ErrNoRows):
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusNotFound)
+	case errors.Is(err, withdraw.ErrWithdrawalScheduleTriggerRequired),
+		errors.Is(err, withdraw.ErrInvalidWithdrawalScheduleThreshold),
+		errors.Is(err, withdraw.ErrInvalidWithdrawalScheduleCron),
+		errors.Is(err, withdraw.ErrInvalidWithdrawalScheduleTimezone),
+		errors.Is(err, withdraw.ErrGasPriceTriggerNotSupported):
+		return apierror.New().AddError(err).SetHttpCode(fiber.StatusUnprocessableEntity)
+	}
+
+	h.logger.Errorw("withdrawal schedule request failed", "error", err)
+	return apierror.New().AddError(errors.New("failed to process withdrawal schedule")).SetHttpCode(fiber.StatusBadRequest)
+}
+
+func (h *Handler) initWithdrawalScheduleRoutes(withdrawal fiber.Router) {
+	withdrawal.Get("/schedules/:currencyId", h.loadWithdrawalSchedule)
+	withdrawal.Put("/schedules/:currencyId", h.setWithdrawalSchedule)
+	withdrawal.Delete("/schedules/:currencyId", h.deleteWithdrawalSchedule)
+}
@@ -0,0 +1,7 @@
+package currency_request
+
+type GetRateAtRequest struct {
+	From string `json:"from" query:"from" validate:"required" example:"BTC"`
+	To   string `json:"to" query:"to" validate:"required" example:"USDT"`
+	At   string `json:"at" query:"at" validate:"required" format:"date-time" example:"2026-01-01T00:00:00Z"`
+} //	@name	GetRateAtRequest
@@ -1,14 +1,21 @@
 package exchange_request
 
+import "github.com/shopspring/decimal"
+
 type UpdateExchangePairsRequest struct {
-	Pairs []ExchangePair `json:"pairs" validate:"required"`
+	Pairs []ExchangePair `json:"pairs" validate:"required,dive"`
 } //	@name	ExchangeUpdatePairsRequest
 
 type ExchangePair struct {
-	BaseSymbol  string `json:"base_symbol" validate:"required"`
-	QuoteSymbol string `json:"quote_symbol" validate:"required"`
-	Symbol      string `json:"symbol" validate:"required"`
-	Type        string `json:"type" validate:"required"`
+	BaseSymbol        string          `json:"base_symbol" validate:"required"`
+	QuoteSymbol       string          `json:"quote_symbol" validate:"required"`
+	Symbol            string          `json:"symbol" validate:"required"`
+	Type              string          `json:"type" validate:"required"`
+	OrderType         string          `json:"order_type" validate:"omitempty,oneof=market limit twap" enums:"market,limit,twap"`
+	PriceOffset       decimal.Decimal `json:"price_offset" validate:"decimal_gte=-5,decimal_lte=5" swaggertype:"string"`
+	TimeInForce       string          `json:"time_in_force" validate:"omitempty,oneof=gtc ioc fok" enums:"gtc,ioc,fok"`
+	TwapSlices        int32           `json:"twap_slices" validate:"required_if=OrderType twap,omitempty,gte=2,lte=100"`
+	TwapWindowSeconds int32           `json:"twap_window_seconds" validate:"required_if=OrderType twap,omitempty,gte=60,lte=86400"`
 } //	@name	ExchangePair
 
 type GetDepositAddressRequest struct {
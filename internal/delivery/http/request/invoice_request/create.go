@@ -0,0 +1,18 @@
+package invoice_request
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+type CreateRequest struct {
+	StoreExternalID string              `json:"store_external_id" validate:"required,max=255"`
+	CurrencyID      string              `json:"currency_id" validate:"required"`
+	Amount          decimal.NullDecimal `json:"amount" validate:"omitempty"`
+	AmountUSD       decimal.NullDecimal `json:"amount_usd" validate:"omitempty"`
+	OrderID         *string             `json:"order_id,omitempty" validate:"omitempty,max=255"`
+	Description     *string             `json:"description,omitempty" validate:"omitempty,max=1000"`
+	LifetimeMinutes *uint32             `json:"lifetime_minutes,omitempty" validate:"omitempty,min=1"`
+	IP              *string             `json:"ip,omitempty" validate:"omitempty,ip" format:"ipv4"`
+	Email           *string             `json:"email,omitempty" validate:"omitempty,email" format:"email"`
+	Locale          *string             `json:"locale,omitempty" validate:"omitempty"`
+} //	@name	CreateInvoiceRequest
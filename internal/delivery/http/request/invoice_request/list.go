@@ -0,0 +1,10 @@
+package invoice_request
+
+import "github.com/dv-net/dv-merchant/internal/models"
+
+type ListRequest struct {
+	Statuses []models.InvoiceStatus `json:"statuses" query:"statuses" validate:"omitempty,dive,oneof=pending partially_paid paid overpaid expired cancelled"`
+	OrderID  *string                `json:"order_id,omitempty" query:"order_id"`
+	Page     *uint32                `json:"page" query:"page" validate:"omitempty,numeric,gte=1"`
+	PageSize *uint32                `json:"page_size" query:"page_size" validate:"omitempty,min=1,max=100"`
+} //	@name	ListInvoicesRequest
@@ -1,5 +1,30 @@
 package public_request
 
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
 type RefreshWalletAddressRequest struct {
 	Address string `json:"address" validate:"required"`
 } //	@name	RefreshWalletAddressRequest
+
+type CreateRateQuoteRequest struct {
+	CurrencyID string          `json:"currency_id" validate:"required"`
+	AmountUSD  decimal.Decimal `json:"amount_usd" validate:"required"`
+} //	@name	CreateRateQuoteRequest
+
+type RateQuoteDto struct {
+	ID         uuid.UUID        `json:"id"`
+	WalletID   uuid.UUID        `json:"wallet_id"`
+	InvoiceID  *uuid.UUID       `json:"invoice_id,omitempty"`
+	CurrencyID string           `json:"currency_id"`
+	Source     string           `json:"source"`
+	Rate       decimal.Decimal  `json:"rate"`
+	Amount     *decimal.Decimal `json:"amount,omitempty"`
+	AmountUSD  *decimal.Decimal `json:"amount_usd,omitempty"`
+	ExpiresAt  time.Time        `json:"expires_at" format:"date-time"`
+	CreatedAt  time.Time        `json:"created_at" format:"date-time"`
+} //	@name	PublicRateQuote
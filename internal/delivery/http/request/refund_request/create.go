@@ -0,0 +1,19 @@
+package refund_request
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+type CreateRequest struct {
+	TransactionID string              `json:"transaction_id" validate:"required,uuid"`
+	Amount        decimal.NullDecimal `json:"amount" validate:"omitempty"`
+	AddressTo     *string             `json:"address_to,omitempty" validate:"omitempty,max=255"`
+} //	@name	CreateRefundRequest
+
+type UpdateAddressRequest struct {
+	AddressTo string `json:"address_to" validate:"required,max=255"`
+} //	@name	UpdateRefundAddressRequest
+
+type RejectRequest struct {
+	Reason *string `json:"reason,omitempty" validate:"omitempty,max=1000"`
+} //	@name	RejectRefundRequest
@@ -0,0 +1,16 @@
+package refund_request
+
+import "github.com/dv-net/dv-merchant/internal/models"
+
+type ListRequest struct {
+	Statuses      []models.RefundStatus `json:"statuses" query:"statuses" validate:"omitempty,dive,oneof=pending_approval approved processing completed rejected failed cancelled"`
+	Reasons       []models.RefundReason `json:"reasons" query:"reasons" validate:"omitempty,dive,oneof=aml_blocked overpayment manual"`
+	TransactionID *string               `json:"transaction_id,omitempty" query:"transaction_id" validate:"omitempty,uuid"`
+	Page          *uint32               `json:"page" query:"page" validate:"omitempty,numeric,gte=1"`
+	PageSize      *uint32               `json:"page_size" query:"page_size" validate:"omitempty,min=1,max=100"`
+} //	@name	ListRefundsRequest
+
+type ListAllRequest struct {
+	ListRequest
+	StoreID *string `json:"store_id,omitempty" query:"store_id" validate:"omitempty,uuid"`
+} //	@name	ListAllRefundsRequest
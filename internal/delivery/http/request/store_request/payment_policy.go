@@ -0,0 +1,14 @@
+package store_request
+
+import (
+	"github.com/dv-net/dv-merchant/internal/models"
+
+	"github.com/shopspring/decimal"
+)
+
+type UpdatePaymentPolicyRequest struct {
+	ThresholdType  models.ToleranceType     `json:"threshold_type" validate:"required,oneof=absolute percent" enums:"absolute,percent"`
+	Threshold      decimal.Decimal          `json:"threshold"`
+	Rounding       models.ToleranceRounding `json:"rounding" validate:"required,oneof=up down half" enums:"up,down,half"`
+	LeftoverAction models.LeftoverAction    `json:"leftover_action" validate:"required,oneof=refund_request credit_next_invoice ignore" enums:"refund_request,credit_next_invoice,ignore"`
+} //	@name	UpdateStorePaymentPolicyRequest
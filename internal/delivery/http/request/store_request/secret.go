@@ -0,0 +1,6 @@
+package store_request
+
+type GenerateSecretRequest struct {
+	// RotationWindowHours keeps replaced secret signing webhooks, 24 hours when omitted, 0 replaces it at once
+	RotationWindowHours *uint32 `json:"rotation_window_hours" query:"rotation_window_hours" validate:"omitempty,max=720"`
+} //	@name	GenerateStoreSecretRequest
@@ -0,0 +1,8 @@
+package store_webhook_request
+
+type SetClientCertificateRequest struct {
+	// Certificate PEM encoded client certificate, intermediate certificates may follow it
+	Certificate string `json:"certificate" validate:"required,max=65536"`
+	// PrivateKey PEM encoded private key of the certificate
+	PrivateKey string `json:"private_key" validate:"required,max=65536"`
+} //	@name	SetWebhookClientCertificateRequest
@@ -7,5 +7,7 @@ import (
 type CreateRequest struct {
 	URL     string                 `db:"url" json:"url" validate:"required,http_url" format:"url"`
 	Enabled bool                   `db:"enabled" json:"enabled"`
-	Events  []*models.WebhookEvent `db:"events" json:"events,omitempty" validate:"required,dive,oneof=PaymentReceived PaymentNotConfirmed WithdrawalFromProcessingReceived"`
+	Events  []*models.WebhookEvent `db:"events" json:"events,omitempty" validate:"required,dive,oneof=PaymentReceived PaymentNotConfirmed WithdrawalFromProcessingReceived PaymentAMLBlocked InvoiceStatusChanged RefundStatusChanged TransferStatusChanged ExchangeOrderFilled ExchangeWithdrawalStatusChanged WalletAddressCreated AMLCheckCompleted"`
+
+	DeliverySettings
 } //	@name	CreateStoreWebhookRequest
@@ -0,0 +1,14 @@
+package store_webhook_request
+
+type ListDeadLettersRequest struct {
+	TransactionID *string `json:"transaction_id,omitempty" query:"transaction_id" validate:"omitempty,uuid"`
+	Event         *string `json:"event,omitempty" query:"event" validate:"omitempty,max=50"`
+	Requeued      *bool   `json:"requeued,omitempty" query:"requeued"`
+	Page          *uint32 `json:"page" query:"page" validate:"omitempty,numeric,gte=1"`
+	PageSize      *uint32 `json:"page_size" query:"page_size" validate:"omitempty,min=1,max=100"`
+} //	@name	ListWebhookDeadLettersRequest
+
+type RequeueDeadLettersRequest struct {
+	// IDs of dead letters to requeue, all pending ones are requeued when empty
+	IDs []string `json:"ids" validate:"omitempty,max=1000,dive,uuid"`
+} //	@name	RequeueWebhookDeadLettersRequest
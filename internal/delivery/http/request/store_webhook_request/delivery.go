@@ -0,0 +1,15 @@
+package store_webhook_request
+
+import "github.com/dv-net/dv-merchant/internal/models"
+
+// DeliverySettings fields left empty keep current values, defaults are used on create
+type DeliverySettings struct {
+	SuccessMode        *models.WebhookSuccessMode `json:"success_mode,omitempty" validate:"omitempty,oneof=any_2xx json_body status_codes" enums:"any_2xx,json_body,status_codes"`
+	SuccessStatusCodes []int32                    `json:"success_status_codes,omitempty" validate:"omitempty,max=20,dive,min=100,max=599"`
+	SuccessJSONPath    *string                    `json:"success_json_path,omitempty" validate:"omitempty,min=1,max=255" example:"success"`
+	SuccessJSONValue   *string                    `json:"success_json_value,omitempty" validate:"omitempty,max=255" example:"true"`
+	// TimeoutSeconds zero means configured default timeout
+	TimeoutSeconds *int32 `json:"timeout_seconds,omitempty" validate:"omitempty,min=0,max=120"`
+	// SchemaVersion 1 sends legacy flat payloads, 2 wraps them into a versioned envelope
+	SchemaVersion *int32 `json:"schema_version,omitempty" validate:"omitempty,oneof=1 2" enums:"1,2"`
+} //	@name	StoreWebhookDeliverySettings
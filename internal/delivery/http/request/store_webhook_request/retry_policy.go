@@ -0,0 +1,11 @@
+package store_webhook_request
+
+import "github.com/dv-net/dv-merchant/internal/models"
+
+type UpdateRetryPolicyRequest struct {
+	PolicyType      models.WebhookRetryPolicyType `json:"policy_type" validate:"required,oneof=fixed exponential custom" enums:"fixed,exponential,custom"`
+	MaxAttempts     int32                         `json:"max_attempts" validate:"required,min=1,max=100"`
+	DelaySeconds    int32                         `json:"delay_seconds" validate:"omitempty,min=1"`
+	MaxDelaySeconds int32                         `json:"max_delay_seconds" validate:"omitempty,min=1"`
+	ScheduleSeconds []int32                       `json:"schedule_seconds" validate:"omitempty,max=100,dive,min=1"`
+} //	@name	UpdateWebhookRetryPolicyRequest
@@ -7,5 +7,7 @@ import (
 type UpdateRequest struct {
 	URL     string                 `db:"url" json:"url" validate:"required,http_url"`
 	Enabled bool                   `db:"enabled" json:"enabled"  validate:"boolean"`
-	Events  []*models.WebhookEvent `db:"events" json:"events" validate:"required,dive"`
+	Events  []*models.WebhookEvent `db:"events" json:"events" validate:"required,dive,oneof=PaymentReceived PaymentNotConfirmed WithdrawalFromProcessingReceived PaymentAMLBlocked InvoiceStatusChanged RefundStatusChanged TransferStatusChanged ExchangeOrderFilled ExchangeWithdrawalStatusChanged WalletAddressCreated AMLCheckCompleted"`
+
+	DeliverySettings
 } //	@name	UpdateStoreWebhookRequest
@@ -3,7 +3,7 @@ package user_request
 type UpdateRequest struct {
 	Location   string `db:"location" json:"location" validate:"required,timezone"`
 	Language   string `db:"language" json:"language" validate:"required,min=2,max=2"`
-	RateSource string `db:"rate_source" json:"rate_source" validate:"required,oneof=okx htx binance bitget bybit gate dv-min dv-max dv-avg"`
+	RateSource string `db:"rate_source" json:"rate_source" validate:"required,oneof=okx htx binance bitget bybit gate aggregate dv-min dv-max dv-avg"`
 } //	@name	UpdateUserRequest
 
 type ChangePasswordInternalRequest struct {
@@ -0,0 +1,26 @@
+package withdrawal_requests
+
+import (
+	"github.com/dv-net/dv-merchant/internal/models"
+
+	"github.com/shopspring/decimal"
+)
+
+type CreateApprovalRuleRequest struct {
+	CurrencyID        *string             `json:"currency_id,omitempty" validate:"omitempty,max=255"`
+	AddressTo         *string             `json:"address_to,omitempty" validate:"omitempty,min=16,max=255"`
+	MinAmountUSD      decimal.NullDecimal `json:"min_amount_usd" validate:"omitempty"`
+	RequiredApprovals int32               `json:"required_approvals" validate:"required,min=1,max=10"`
+} //	@name	CreateWithdrawalApprovalRuleRequest
+
+type ListApprovalsRequest struct {
+	Statuses []models.WithdrawalApprovalStatus `json:"statuses" query:"statuses" validate:"omitempty,dive,oneof=pending approved executed failed rejected expired"`
+	UserID   *string                           `json:"user_id,omitempty" query:"user_id" validate:"omitempty,uuid"`
+	Page     *uint32                           `json:"page" query:"page" validate:"omitempty,numeric,gte=1"`
+	PageSize *uint32                           `json:"page_size" query:"page_size" validate:"omitempty,min=1,max=100"`
+} //	@name	ListWithdrawalApprovalsRequest
+
+type ApprovalDecisionRequest struct {
+	TOTP    string  `json:"totp" validate:"required,len=6"`
+	Comment *string `json:"comment,omitempty" validate:"omitempty,max=1000"`
+} //	@name	WithdrawalApprovalDecisionRequest
@@ -0,0 +1,19 @@
+package withdrawal_requests
+
+import (
+	"github.com/dv-net/dv-merchant/internal/models"
+
+	"github.com/shopspring/decimal"
+)
+
+type CreateWithdrawalLimitRequest struct {
+	Scope         models.WithdrawalLimitScope `json:"scope" validate:"required,oneof=user store currency destination"`
+	ScopeValue    *string                     `json:"scope_value,omitempty" validate:"omitempty,max=255"`
+	WindowSeconds int32                       `json:"window_seconds" validate:"required,min=1,max=2592000"`
+	MaxAmountUSD  decimal.NullDecimal         `json:"max_amount_usd" validate:"omitempty"`
+	MaxCount      *int32                      `json:"max_count,omitempty" validate:"omitempty,min=1"`
+} //	@name	CreateWithdrawalLimitRequest
+
+type DeleteWithdrawalLimitRequest struct {
+	TOTP string `json:"totp" validate:"required,len=6"`
+} //	@name	DeleteWithdrawalLimitRequest
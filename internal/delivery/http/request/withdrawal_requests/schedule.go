@@ -0,0 +1,20 @@
+package withdrawal_requests
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+type SetWithdrawalScheduleRequest struct {
+	// CronExpression is a five-field cron expression or @hourly, @daily, @weekly, @monthly macro
+	CronExpression *string `json:"cron_expression,omitempty" validate:"omitempty,max=255"`
+	// Timezone is an IANA time zone of the cron expression, user location by default
+	Timezone        *string             `json:"timezone,omitempty" validate:"omitempty,max=64"`
+	MinBalanceUSD   decimal.NullDecimal `json:"min_balance_usd" validate:"omitempty"`
+	MaxGasPriceGwei decimal.NullDecimal `json:"max_gas_price_gwei" validate:"omitempty"`
+	KeepFloatUSD    decimal.NullDecimal `json:"keep_float_usd" validate:"omitempty"`
+	TOTP            string              `json:"totp" validate:"required,len=6"`
+} //	@name	SetWithdrawalScheduleRequest
+
+type DeleteWithdrawalScheduleRequest struct {
+	TOTP string `json:"totp" validate:"required,len=6"`
+} //	@name	DeleteWithdrawalScheduleRequest
@@ -2,6 +2,7 @@ package withdrawal_wallets_request
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/dv-net/dv-merchant/internal/models"
 
@@ -13,15 +14,35 @@ import (
 )
 
 type MultiWithdrawalRules struct {
-	Mode          models.MultiWithdrawalMode `json:"mode" validate:"required,oneof=random disabled processing manual"`
+	Mode          models.MultiWithdrawalMode `json:"mode" validate:"required,oneof=random disabled processing manual split"`
 	ManualAddress *string                    `json:"manual_address" validate:"omitempty,min=1,max=255"`
+	SplitLegs     []SplitLeg                 `json:"split_legs" validate:"omitempty,max=10,dive"`
 } //	@name	MultiWithdrawalRules
 
+type SplitLeg struct {
+	Address string          `json:"address" validate:"required,min=1,max=255"`
+	Percent decimal.Decimal `json:"percent" validate:"required"`
+	// MinAmountUSD skips the leg while its share is lower, the share goes to the remainder
+	MinAmountUSD decimal.NullDecimal `json:"min_amount_usd" validate:"omitempty"`
+	// ReceivesRemainder marks the leg receiving rounding dust and shares of skipped legs
+	ReceivesRemainder bool `json:"receives_remainder"`
+} //	@name	MultiWithdrawalSplitLegRequest
+
 func (r *MultiWithdrawalRules) ValidateByBlockchain(b models.Blockchain) error {
 	if r.Mode == models.MultiWithdrawalModeManual && r.ManualAddress == nil {
 		return errors.New("missing required field: manual_address")
 	}
 
+	if r.Mode == models.MultiWithdrawalModeSplit && len(r.SplitLegs) == 0 {
+		return errors.New("missing required field: split_legs")
+	}
+
+	for _, leg := range r.SplitLegs {
+		if !avalidator.ValidateAddressByBlockchain(leg.Address, b.String()) {
+			return fmt.Errorf("invalid split leg address %s", leg.Address)
+		}
+	}
+
 	if r.ManualAddress != nil && !avalidator.ValidateAddressByBlockchain(
 		*r.ManualAddress,
 		b.String(),
@@ -1,6 +1,7 @@
 package exchange_response
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/dv-net/dv-merchant/internal/models"
@@ -43,8 +44,24 @@ type ExchangeTestConnectionResponse struct {
 	ErrorMessage string `json:"error_message"`
 } //	@name	ExchangeTestConnectionResponse
 
+type ExchangeKeyPermissionsResponse struct {
+	Exchange         string     `json:"exchange"`
+	CanTrade         *bool      `json:"can_trade"`
+	CanWithdraw      *bool      `json:"can_withdraw"`
+	CanTransfer      *bool      `json:"can_transfer"`
+	IPRestricted     *bool      `json:"ip_restricted"`
+	ExpiresAt        *time.Time `json:"expires_at"`
+	TransferRequired bool       `json:"transfer_required"`
+	Warnings         []string   `json:"warnings"`
+} //	@name	ExchangeKeyPermissionsResponse
+
 type ExchangeUserPairResponse struct {
-	DisplayName string `json:"display_name"`
+	DisplayName       string          `json:"display_name"`
+	OrderType         string          `json:"order_type"`
+	PriceOffset       decimal.Decimal `json:"price_offset" swaggertype:"string"`
+	TimeInForce       string          `json:"time_in_force"`
+	TwapSlices        int32           `json:"twap_slices"`
+	TwapWindowSeconds int32           `json:"twap_window_seconds"`
 } //	@name	ExchangeUserPairResponse
 
 type ExternalExchangeBalanceResponse ExchangeBalanceResponse //	@name	ExternalExchangeBalanceResponse
@@ -69,19 +86,24 @@ type ExchangeWithdrawalHistoryResponse struct {
 } //	@name	ExchangeWithdrawalHistoryResponse
 
 type ExchangeOrderHistoryResponse struct {
-	ID              string    `json:"id"`
-	UserID          string    `json:"user_id"`
-	ExchangeID      string    `json:"exchange_id"`
-	ExchangeSlug    string    `json:"exchange_slug"`
-	ExchangeOrderID string    `json:"exchange_order_id"`
-	ClientOrderID   string    `json:"client_order_id"`
-	Symbol          string    `json:"symbol"`
-	Side            string    `json:"side"`
-	Amount          string    `json:"amount"`
-	AmountUsd       string    `json:"amount_usd"`
-	Status          string    `json:"status"`
-	FailReason      string    `json:"fail_reason"`
-	CreatedAt       time.Time `json:"created_at" format:"date-time"`
+	ID              string          `json:"id"`
+	UserID          string          `json:"user_id"`
+	ExchangeID      string          `json:"exchange_id"`
+	ExchangeSlug    string          `json:"exchange_slug"`
+	ExchangeOrderID string          `json:"exchange_order_id"`
+	ClientOrderID   string          `json:"client_order_id"`
+	Symbol          string          `json:"symbol"`
+	Side            string          `json:"side"`
+	Amount          string          `json:"amount"`
+	AmountUsd       string          `json:"amount_usd"`
+	Status          string          `json:"status"`
+	FailReason      string          `json:"fail_reason"`
+	Route           json.RawMessage `json:"route,omitempty" swaggertype:"object"`
+	OrderType       string          `json:"order_type"`
+	Price           string          `json:"price,omitempty"`
+	TimeInForce     string          `json:"time_in_force,omitempty"`
+	ParentID        string          `json:"parent_id,omitempty"`
+	CreatedAt       time.Time       `json:"created_at" format:"date-time"`
 } //	@name	ExchangeOrderHistoryResponse
 
 type ExchangeWithdrawalRulesResponse struct {
@@ -0,0 +1,43 @@
+package invoice_response
+
+import (
+	"time"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+
+	"github.com/shopspring/decimal"
+)
+
+type InvoiceResponse struct {
+	ID                string                 `json:"id" format:"uuid"`
+	StoreID           string                 `json:"store_id" format:"uuid"`
+	WalletID          string                 `json:"wallet_id" format:"uuid"`
+	CurrencyID        string                 `json:"currency_id"`
+	Address           string                 `json:"address"`
+	OrderID           *string                `json:"order_id"`
+	Description       *string                `json:"description"`
+	Status            models.InvoiceStatus   `json:"status"`
+	Amount            decimal.Decimal        `json:"amount"`
+	AmountUSD         decimal.Decimal        `json:"amount_usd"`
+	PaidAmount        decimal.Decimal        `json:"paid_amount"`
+	PaidAmountUSD     decimal.Decimal        `json:"paid_amount_usd"`
+	CreditAmountUSD   decimal.Decimal        `json:"credit_amount_usd"`
+	LeftoverAmountUSD decimal.Decimal        `json:"leftover_amount_usd"`
+	LeftoverAction    *models.LeftoverAction `json:"leftover_action"`
+	ExpiresAt         time.Time              `json:"expires_at" format:"date-time"`
+	PaidAt            *time.Time             `json:"paid_at" format:"date-time"`
+	CreatedAt         time.Time              `json:"created_at" format:"date-time"`
+	UpdatedAt         *time.Time             `json:"updated_at" format:"date-time"`
+} //	@name	InvoiceResponse
+
+type InvoiceStatusHistoryResponse struct {
+	StatusFrom    models.InvoiceStatus `json:"status_from"`
+	StatusTo      models.InvoiceStatus `json:"status_to"`
+	TransactionID *string              `json:"transaction_id" format:"uuid"`
+	CreatedAt     time.Time            `json:"created_at" format:"date-time"`
+} //	@name	InvoiceStatusHistoryResponse
+
+type InvoiceWithHistoryResponse struct {
+	InvoiceResponse
+	History []*InvoiceStatusHistoryResponse `json:"history"`
+} //	@name	InvoiceWithHistoryResponse
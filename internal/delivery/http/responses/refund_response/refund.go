@@ -0,0 +1,41 @@
+package refund_response
+
+import (
+	"time"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+
+	"github.com/shopspring/decimal"
+)
+
+type RefundResponse struct {
+	ID              string              `json:"id" format:"uuid"`
+	StoreID         string              `json:"store_id" format:"uuid"`
+	TransactionID   string              `json:"transaction_id" format:"uuid"`
+	InvoiceID       *string             `json:"invoice_id" format:"uuid"`
+	CurrencyID      string              `json:"currency_id"`
+	Reason          models.RefundReason `json:"reason"`
+	Status          models.RefundStatus `json:"status"`
+	Amount          decimal.Decimal     `json:"amount"`
+	AmountUSD       decimal.Decimal     `json:"amount_usd"`
+	AddressTo       string              `json:"address_to"`
+	WithdrawalID    *string             `json:"withdrawal_id" format:"uuid"`
+	RefundTxHash    *string             `json:"refund_tx_hash"`
+	RejectionReason *string             `json:"rejection_reason"`
+	FailureReason   *string             `json:"failure_reason"`
+	DecidedAt       *time.Time          `json:"decided_at" format:"date-time"`
+	CreatedAt       time.Time           `json:"created_at" format:"date-time"`
+	UpdatedAt       *time.Time          `json:"updated_at" format:"date-time"`
+} //	@name	RefundResponse
+
+type RefundStatusHistoryResponse struct {
+	StatusFrom *models.RefundStatus `json:"status_from"`
+	StatusTo   models.RefundStatus  `json:"status_to"`
+	UserID     *string              `json:"user_id" format:"uuid"`
+	CreatedAt  time.Time            `json:"created_at" format:"date-time"`
+} //	@name	RefundStatusHistoryResponse
+
+type RefundWithHistoryResponse struct {
+	RefundResponse
+	History []*RefundStatusHistoryResponse `json:"history"`
+} //	@name	RefundWithHistoryResponse
@@ -102,15 +102,24 @@ type StoreAPIKeyResponse struct {
 } //	@name	StoreAPIKeyResponse
 
 type StoreSecretResponse struct {
-	Secret string `json:"secret"`
+	Secret            string     `json:"secret"`
+	KeyID             string     `json:"key_id"`
+	PreviousKeyID     *string    `json:"previous_key_id"`
+	PreviousExpiresAt *time.Time `json:"previous_expires_at" format:"date-time"`
 } //	@name	StoreSecretResponse
 
 type StoreWebhookResponse struct {
 	ID string `json:"id" format:"uuid"`
 	// StoreID   string    `json:"store_id" format:"uuid"`
-	URL     string   `json:"url" format:"uri"`
-	Enabled bool     `json:"enabled"`
-	Events  []string `json:"events" enums:"PaymentReceived,PaymentNotConfirmed"`
+	URL                string   `json:"url" format:"uri"`
+	Enabled            bool     `json:"enabled"`
+	Events             []string `json:"events" enums:"PaymentReceived,PaymentNotConfirmed,WithdrawalFromProcessingReceived,PaymentAMLBlocked,InvoiceStatusChanged,RefundStatusChanged,TransferStatusChanged,ExchangeOrderFilled,ExchangeWithdrawalStatusChanged,WalletAddressCreated,AMLCheckCompleted"`
+	SuccessMode        string   `json:"success_mode" enums:"any_2xx,json_body,status_codes"`
+	SuccessStatusCodes []int32  `json:"success_status_codes"`
+	SuccessJSONPath    string   `json:"success_json_path"`
+	SuccessJSONValue   string   `json:"success_json_value"`
+	TimeoutSeconds     int32    `json:"timeout_seconds"`
+	SchemaVersion      int32    `json:"schema_version" enums:"1,2"`
 	// CreatedAt time.Time `json:"created_at" format:"date-time"`
 } //	@name	StoreWebhookResponse
 
@@ -187,3 +196,10 @@ func NewStoreWhitelistResponses(whitelists ...*models.StoreWhitelist) StoreWhite
 	}
 	return res
 }
+
+type StorePaymentPolicyResponse struct {
+	ThresholdType  models.ToleranceType     `json:"threshold_type" enums:"absolute,percent"`
+	Threshold      decimal.Decimal          `json:"threshold"`
+	Rounding       models.ToleranceRounding `json:"rounding" enums:"up,down,half"`
+	LeftoverAction models.LeftoverAction    `json:"leftover_action" enums:"refund_request,credit_next_invoice,ignore"`
+} //	@name	StorePaymentPolicyResponse
@@ -0,0 +1,30 @@
+package webhook_response
+
+import (
+	"time"
+)
+
+type DeadLetterResponse struct {
+	ID                     string     `json:"id" format:"uuid"`
+	WebhookID              string     `json:"webhook_id" format:"uuid"`
+	StoreID                string     `json:"store_id" format:"uuid"`
+	TransactionID          string     `json:"transaction_id" format:"uuid"`
+	Event                  string     `json:"event"`
+	URL                    string     `json:"url"`
+	Attempts               int32      `json:"attempts"`
+	LastResponse           *string    `json:"last_response"`
+	LastResponseStatusCode int32      `json:"last_response_status_code"`
+	RequeuedAt             *time.Time `json:"requeued_at" format:"date-time"`
+	CreatedAt              time.Time  `json:"created_at" format:"date-time"`
+} //	@name	WebhookDeadLetterResponse
+
+type DeadLetterWithPayloadResponse struct {
+	DeadLetterResponse
+	Payload   string `json:"payload"`
+	Signature string `json:"signature"`
+} //	@name	WebhookDeadLetterWithPayloadResponse
+
+type RequeueDeadLettersResponse struct {
+	Requeued      int `json:"requeued"`
+	AlreadyQueued int `json:"already_queued"`
+} //	@name	RequeueWebhookDeadLettersResponse
@@ -0,0 +1,13 @@
+package webhook_response
+
+type EventCatalogueResponse struct {
+	Events []EventDescription `json:"events"`
+	// SchemaVersions supported by store webhooks, 1 is legacy flat payload, 2 is versioned envelope
+	SchemaVersions []int32 `json:"schema_versions"`
+} //	@name	WebhookEventCatalogueResponse
+
+type EventDescription struct {
+	Event string `json:"event"`
+	// Scope is store for events of a single store, account events are delivered to every store of the owner
+	Scope string `json:"scope" enums:"store,account"`
+} //	@name	WebhookEventDescription
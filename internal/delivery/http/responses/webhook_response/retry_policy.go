@@ -0,0 +1,11 @@
+package webhook_response
+
+import "github.com/dv-net/dv-merchant/internal/models"
+
+type RetryPolicyResponse struct {
+	PolicyType      models.WebhookRetryPolicyType `json:"policy_type" enums:"fixed,exponential,custom"`
+	MaxAttempts     int32                         `json:"max_attempts"`
+	DelaySeconds    int32                         `json:"delay_seconds"`
+	MaxDelaySeconds int32                         `json:"max_delay_seconds"`
+	ScheduleSeconds []int32                       `json:"schedule_seconds"`
+} //	@name	WebhookRetryPolicyResponse
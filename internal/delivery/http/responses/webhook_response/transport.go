@@ -0,0 +1,16 @@
+package webhook_response
+
+import "time"
+
+type ClientCertificateResponse struct {
+	Fingerprint string     `json:"fingerprint"`
+	Subject     string     `json:"subject"`
+	ExpiresAt   time.Time  `json:"expires_at" format:"date-time"`
+	CreatedAt   time.Time  `json:"created_at" format:"date-time"`
+	UpdatedAt   *time.Time `json:"updated_at" format:"date-time"`
+} //	@name	WebhookClientCertificateResponse
+
+type SourceIPsResponse struct {
+	// IPs webhook deliveries are sent from, empty when not pinned
+	IPs []string `json:"ips"`
+} //	@name	WebhookSourceIPsResponse
@@ -14,12 +14,14 @@ type AddressBookEntryResponse struct {
 	Tag                  *string           `json:"tag"`
 	Blockchain           models.Blockchain `json:"blockchain,omitempty"`
 	SubmittedAt          string            `json:"submitted_at"`
+	ActiveAt             string            `json:"active_at"`
 	WithdrawalRuleExists bool              `json:"withdrawal_rule_exists"`
 } //	@name	AddressBookEntryResponse
 
 type AddressBookEntryResponseShort struct {
 	ID                   uuid.UUID `json:"id"`
 	CurrencyID           string    `json:"currency_id"`
+	ActiveAt             string    `json:"active_at"`
 	WithdrawalRuleExists bool      `json:"withdrawal_rule_exists"`
 } //	@name	AddressBookEntryResponseShort
 
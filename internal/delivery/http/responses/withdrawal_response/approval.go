@@ -0,0 +1,50 @@
+package withdrawal_response
+
+import (
+	"time"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+
+	"github.com/shopspring/decimal"
+)
+
+type WithdrawalApprovalRuleResponse struct {
+	ID                string           `json:"id" format:"uuid"`
+	CurrencyID        *string          `json:"currency_id"`
+	AddressTo         *string          `json:"address_to"`
+	MinAmountUSD      *decimal.Decimal `json:"min_amount_usd"`
+	RequiredApprovals int32            `json:"required_approvals"`
+	CreatedBy         *string          `json:"created_by" format:"uuid"`
+	CreatedAt         time.Time        `json:"created_at" format:"date-time"`
+} //	@name	WithdrawalApprovalRuleResponse
+
+type WithdrawalApprovalResponse struct {
+	ID                string                          `json:"id" format:"uuid"`
+	UserID            string                          `json:"user_id" format:"uuid"`
+	Kind              models.WithdrawalApprovalKind   `json:"kind"`
+	Status            models.WithdrawalApprovalStatus `json:"status"`
+	CurrencyID        string                          `json:"currency_id"`
+	AddressTo         string                          `json:"address_to"`
+	Amount            decimal.Decimal                 `json:"amount"`
+	AmountUSD         decimal.Decimal                 `json:"amount_usd"`
+	RequiredApprovals int32                           `json:"required_approvals"`
+	RuleID            *string                         `json:"rule_id" format:"uuid"`
+	InitiatedBy       *string                         `json:"initiated_by" format:"uuid"`
+	ExpiresAt         time.Time                       `json:"expires_at" format:"date-time"`
+	ExecutedAt        *time.Time                      `json:"executed_at" format:"date-time"`
+	FailureReason     *string                         `json:"failure_reason"`
+	CreatedAt         time.Time                       `json:"created_at" format:"date-time"`
+	UpdatedAt         *time.Time                      `json:"updated_at" format:"date-time"`
+} //	@name	WithdrawalApprovalResponse
+
+type WithdrawalApprovalDecisionResponse struct {
+	UserID    string                                `json:"user_id" format:"uuid"`
+	Decision  models.WithdrawalApprovalDecisionType `json:"decision"`
+	Comment   *string                               `json:"comment"`
+	CreatedAt time.Time                             `json:"created_at" format:"date-time"`
+} //	@name	WithdrawalApprovalDecisionResponse
+
+type WithdrawalApprovalWithDecisionsResponse struct {
+	WithdrawalApprovalResponse
+	Decisions []*WithdrawalApprovalDecisionResponse `json:"decisions"`
+} //	@name	WithdrawalApprovalWithDecisionsResponse
@@ -0,0 +1,19 @@
+package withdrawal_response
+
+import (
+	"time"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+
+	"github.com/shopspring/decimal"
+)
+
+type WithdrawalLimitResponse struct {
+	ID            string                      `json:"id" format:"uuid"`
+	Scope         models.WithdrawalLimitScope `json:"scope"`
+	ScopeValue    *string                     `json:"scope_value"`
+	WindowSeconds int32                       `json:"window_seconds"`
+	MaxAmountUSD  *decimal.Decimal            `json:"max_amount_usd"`
+	MaxCount      *int32                      `json:"max_count"`
+	CreatedAt     time.Time                   `json:"created_at" format:"date-time"`
+} //	@name	WithdrawalLimitResponse
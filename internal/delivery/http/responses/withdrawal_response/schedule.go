@@ -0,0 +1,19 @@
+package withdrawal_response
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type WithdrawalScheduleResponse struct {
+	ID              string           `json:"id" format:"uuid"`
+	CronExpression  *string          `json:"cron_expression"`
+	Timezone        string           `json:"timezone"`
+	MinBalanceUSD   *decimal.Decimal `json:"min_balance_usd"`
+	MaxGasPriceGwei *decimal.Decimal `json:"max_gas_price_gwei"`
+	KeepFloatUSD    *decimal.Decimal `json:"keep_float_usd"`
+	RunStartedAt    *time.Time       `json:"run_started_at" format:"date-time"`
+	LastRunAt       *time.Time       `json:"last_run_at" format:"date-time"`
+	CreatedAt       time.Time        `json:"created_at" format:"date-time"`
+} //	@name	WithdrawalScheduleResponse
@@ -46,6 +46,14 @@ type WithdrawalRulesByCurrencyResponse struct {
 type LowBalanceWithdrawalRuleResponse struct {
 	Mode          models.MultiWithdrawalMode `json:"mode"`
 	ManualAddress *string                    `json:"manual_address"`
+	SplitLegs     []SplitLegResponse         `json:"split_legs,omitempty"`
 } //	@name	LowBalanceWithdrawalRuleResponse
 
+type SplitLegResponse struct {
+	Address           string           `json:"address"`
+	Percent           decimal.Decimal  `json:"percent"`
+	MinAmountUSD      *decimal.Decimal `json:"min_amount_usd"`
+	ReceivesRemainder bool             `json:"receives_remainder"`
+} //	@name	MultiWithdrawalSplitLegResponse
+
 type WithdrawalRuleResponse struct{} //	@name	WithdrawalRule
@@ -12,6 +12,8 @@ const (
 	ProcessingRequestDurationMetricName = "processing_request_duration"
 	ProcessingHTTPStatusCodeMetricName  = "processing_response_code_http_total"
 	ProcessingRPCStatusCodeMetricName   = "processing_response_code_rpc_total"
+	ExrateSourceAgeMetricName           = "exrate_source_age_seconds"
+	ExrateSourceStaleMetricName         = "exrate_source_stale"
 )
 
 type ProcessingStatusCodeLabel string
@@ -25,10 +27,16 @@ const (
 	ProcessingRequestDurationLabelMethod  = "method"
 )
 
+const (
+	ExrateSourceLabel = "source"
+)
+
 type PrometheusMetrics struct {
 	processingHTTPResponseStatusCode *prometheus.CounterVec
 	processingRPCResponseStatusCode  *prometheus.CounterVec
 	processingRequestDurationSeconds *prometheus.HistogramVec
+	exrateSourceAgeSeconds           *prometheus.GaugeVec
+	exrateSourceStale                *prometheus.GaugeVec
 }
 
 func New() (*PrometheusMetrics, error) {
@@ -64,10 +72,30 @@ func New() (*PrometheusMetrics, error) {
 		return nil, err
 	}
 
+	exrateSourceAgeSeconds := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      ExrateSourceAgeMetricName,
+		Help:      "seconds since exchange rate source was last updated",
+	}, []string{ExrateSourceLabel})
+	if err := prometheus.DefaultRegisterer.Register(exrateSourceAgeSeconds); err != nil {
+		return nil, err
+	}
+
+	exrateSourceStale := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      ExrateSourceStaleMetricName,
+		Help:      "1 when exchange rate source is older than its max age",
+	}, []string{ExrateSourceLabel})
+	if err := prometheus.DefaultRegisterer.Register(exrateSourceStale); err != nil {
+		return nil, err
+	}
+
 	return &PrometheusMetrics{
 		processingRPCResponseStatusCode:  processingRPCResponseStatusCode,
 		processingHTTPResponseStatusCode: processingHTTPResponseStatusCode,
 		processingRequestDurationSeconds: processingRequestDurationSeconds,
+		exrateSourceAgeSeconds:           exrateSourceAgeSeconds,
+		exrateSourceStale:                exrateSourceStale,
 	}, nil
 }
 
@@ -87,6 +115,18 @@ func (m *PrometheusMetrics) ProcessingRequestDuration(service, methodName string
 	m.processingRequestDurationSeconds.WithLabelValues(service, methodName).Observe(duration)
 }
 
+func (m *PrometheusMetrics) ExrateSourceAge(source string, seconds float64) {
+	m.exrateSourceAgeSeconds.WithLabelValues(source).Set(seconds)
+}
+
+func (m *PrometheusMetrics) ExrateSourceStale(source string, stale bool) {
+	value := 0.0
+	if stale {
+		value = 1
+	}
+	m.exrateSourceStale.WithLabelValues(source).Set(value)
+}
+
 func (m *PrometheusMetrics) Namespace() string {
 	return metricsNamespace
 }
@@ -0,0 +1,23 @@
+package models
+
+// EventOutboxStatus is delivery state of outbox event
+type EventOutboxStatus string //	@name	EventOutboxStatus
+
+const (
+	EventOutboxStatusPending   EventOutboxStatus = "pending"
+	EventOutboxStatusProcessed EventOutboxStatus = "processed"
+	// EventOutboxStatusFailed is set once delivery attempts are exhausted, such events are only published again by replay
+	EventOutboxStatusFailed EventOutboxStatus = "failed"
+)
+
+func (s EventOutboxStatus) String() string {
+	return string(s)
+}
+
+func (s EventOutboxStatus) Valid() bool {
+	switch s {
+	case EventOutboxStatusPending, EventOutboxStatusProcessed, EventOutboxStatusFailed:
+		return true
+	}
+	return false
+}
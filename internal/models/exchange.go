@@ -1,6 +1,8 @@
 package models
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/shopspring/decimal"
@@ -57,6 +59,12 @@ type OrderRulesDTO struct {
 	BuyMarketMaxOrderValue   string `json:"buy_market_max_order_value,omitempty"`
 }
 
+type TickerPriceDTO struct {
+	Symbol string          `json:"symbol"`
+	Bid    decimal.Decimal `json:"bid"`
+	Ask    decimal.Decimal `json:"ask"`
+}
+
 type OrderDetailsDTO struct {
 	State      ExchangeOrderStatus `json:"state,omitempty"`
 	Amount     decimal.Decimal     `json:"amount"`
@@ -88,6 +96,32 @@ type DepositAddressDTO struct {
 	PaymentTag       string      `json:"payment_tag,omitempty"`
 }
 
+// KeyPermissionsDTO describes rights of the api key as reported by exchange, nil fields are not exposed by it
+type KeyPermissionsDTO struct {
+	CanTrade     *bool      `json:"can_trade"`
+	CanWithdraw  *bool      `json:"can_withdraw"`
+	CanTransfer  *bool      `json:"can_transfer"`
+	IPRestricted *bool      `json:"ip_restricted"`
+	ExpiresAt    *time.Time `json:"expires_at,omitempty"`
+	// TransferRequired is set by drivers moving funds between exchange accounts for orders and withdrawals
+	TransferRequired bool `json:"transfer_required"`
+}
+
+type KeyPermissionWarning string
+
+func (o KeyPermissionWarning) String() string { return string(o) }
+
+const (
+	KeyPermissionWarningTradeMissing      KeyPermissionWarning = "trade_missing"
+	KeyPermissionWarningWithdrawMissing   KeyPermissionWarning = "withdraw_missing"
+	KeyPermissionWarningTransferMissing   KeyPermissionWarning = "transfer_missing"
+	KeyPermissionWarningTradeExcessive    KeyPermissionWarning = "trade_excessive"
+	KeyPermissionWarningWithdrawExcessive KeyPermissionWarning = "withdraw_excessive"
+	KeyPermissionWarningTransferExcessive KeyPermissionWarning = "transfer_excessive"
+	KeyPermissionWarningNoIPRestriction   KeyPermissionWarning = "no_ip_restriction"
+	KeyPermissionWarningExpiresSoon       KeyPermissionWarning = "expires_soon"
+)
+
 type WithdrawalAddressDTO struct {
 	Address  string `json:"address"`
 	Currency string `json:"currency"`
@@ -107,8 +141,19 @@ type OrderType string
 func (o OrderType) String() string { return string(o) }
 
 const (
-	OrderTypeMarket OrderType = ""
-	OrderTypeLimit  OrderType = ""
+	OrderTypeMarket OrderType = "market"
+	OrderTypeLimit  OrderType = "limit"
+	OrderTypeTWAP   OrderType = "twap"
+)
+
+type TimeInForce string
+
+func (o TimeInForce) String() string { return string(o) }
+
+const (
+	TimeInForceGTC TimeInForce = "gtc"
+	TimeInForceIOC TimeInForce = "ioc"
+	TimeInForceFOK TimeInForce = "fok"
 )
 
 type ExchangeGroup struct {
@@ -169,6 +214,21 @@ type GetOrderByIDParams struct {
 	InternalOrder   *ExchangeOrder
 }
 
+// CreateLimitOrderParams describes limit order, amount is always in base currency
+type CreateLimitOrderParams struct {
+	Symbol      string
+	Side        OrderSide
+	Amount      decimal.Decimal
+	Price       decimal.Decimal
+	TimeInForce TimeInForce
+}
+
+type CancelOrderParams struct {
+	Symbol          string
+	ExternalOrderID string
+	ClientOrderID   string
+}
+
 type ExchangeOrderDTO struct {
 	ClientOrderID   string          `json:"client_order_id"`
 	ExchangeOrderID string          `json:"exchange_order_id"`
@@ -203,6 +263,8 @@ const (
 	ExchangeOrderStatusInProgress ExchangeOrderStatus = "in_progress"
 	ExchangeOrderStatusCompleted  ExchangeOrderStatus = "completed"
 	ExchangeOrderStatusFailed     ExchangeOrderStatus = "failed"
+	// ExchangeOrderStatusCancelled is reported by exchange drivers only, order is stored as completed or failed
+	ExchangeOrderStatusCancelled ExchangeOrderStatus = "cancelled"
 )
 
 type ExchangeWithdrawalState string
@@ -3,14 +3,16 @@ package models
 type ExchangeSlug string //	@name	ExchangeSlug
 
 const (
-	ExchangeSlugHtx     ExchangeSlug = "htx"
-	ExchangeSlugOkx     ExchangeSlug = "okx"
-	ExchangeSlugBinance ExchangeSlug = "binance"
-	ExchangeSlugBitget  ExchangeSlug = "bitget"
-	ExchangeSlugKucoin  ExchangeSlug = "kucoin"
-	ExchangeSlugBybit   ExchangeSlug = "bybit"
-	ExchangeSlugGateio  ExchangeSlug = "gate"
-	ExchangeSlugMexc    ExchangeSlug = "mexc"
+	ExchangeSlugHtx      ExchangeSlug = "htx"
+	ExchangeSlugOkx      ExchangeSlug = "okx"
+	ExchangeSlugBinance  ExchangeSlug = "binance"
+	ExchangeSlugBitget   ExchangeSlug = "bitget"
+	ExchangeSlugKucoin   ExchangeSlug = "kucoin"
+	ExchangeSlugBybit    ExchangeSlug = "bybit"
+	ExchangeSlugGateio   ExchangeSlug = "gate"
+	ExchangeSlugMexc     ExchangeSlug = "mexc"
+	ExchangeSlugKraken   ExchangeSlug = "kraken"
+	ExchangeSlugCoinbase ExchangeSlug = "coinbase"
 )
 
 func (o ExchangeSlug) Valid() bool {
@@ -31,6 +33,10 @@ func (o ExchangeSlug) Valid() bool {
 		return true
 	case ExchangeSlugMexc:
 		return true
+	case ExchangeSlugKraken:
+		return true
+	case ExchangeSlugCoinbase:
+		return true
 	default:
 		return false
 	}
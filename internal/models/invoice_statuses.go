@@ -0,0 +1,34 @@
+package models
+
+type InvoiceStatus string //	@name	InvoiceStatus
+
+const (
+	InvoiceStatusPending       InvoiceStatus = "pending"
+	InvoiceStatusPartiallyPaid InvoiceStatus = "partially_paid"
+	InvoiceStatusPaid          InvoiceStatus = "paid"
+	InvoiceStatusOverpaid      InvoiceStatus = "overpaid"
+	InvoiceStatusExpired       InvoiceStatus = "expired"
+	InvoiceStatusCancelled     InvoiceStatus = "cancelled"
+)
+
+func (s InvoiceStatus) String() string {
+	return string(s)
+}
+
+// IsOpen reports whether the invoice still accepts payments
+func (s InvoiceStatus) IsOpen() bool {
+	return s == InvoiceStatusPending || s == InvoiceStatusPartiallyPaid
+}
+
+func (s InvoiceStatus) Valid() bool {
+	switch s {
+	case InvoiceStatusPending,
+		InvoiceStatusPartiallyPaid,
+		InvoiceStatusPaid,
+		InvoiceStatusOverpaid,
+		InvoiceStatusExpired,
+		InvoiceStatusCancelled:
+		return true
+	}
+	return false
+}
@@ -2,11 +2,12 @@
 package models
 
 import (
+	"time"
+
 	"github.com/dv-net/dv-merchant/internal/constants"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/shopspring/decimal"
-	"time"
 )
 
 type AmlCheck struct {
@@ -20,7 +21,7 @@ type AmlCheck struct {
 	CreatedAt     pgtype.Timestamp `db:"created_at" json:"created_at"`
 	UpdatedAt     pgtype.Timestamp `db:"updated_at" json:"updated_at"`
 	TransactionID uuid.NullUUID    `db:"transaction_id" json:"transaction_id"`
-} // @name AmlCheck
+} //	@name	AmlCheck
 
 type AmlCheckHistory struct {
 	ID              uuid.UUID        `db:"id" json:"id"`
@@ -31,7 +32,7 @@ type AmlCheckHistory struct {
 	AttemptNumber   int32            `db:"attempt_number" json:"attempt_number"`
 	CreatedAt       pgtype.Timestamp `db:"created_at" json:"created_at"`
 	UpdatedAt       pgtype.Timestamp `db:"updated_at" json:"updated_at"`
-} // @name AmlCheckHistory
+} //	@name	AmlCheckHistory
 
 type AmlCheckQueue struct {
 	ID             uuid.UUID        `db:"id" json:"id"`
@@ -41,14 +42,14 @@ type AmlCheckQueue struct {
 	CreatedAt      pgtype.Timestamp `db:"created_at" json:"created_at"`
 	UpdatedAt      pgtype.Timestamp `db:"updated_at" json:"updated_at"`
 	RequestPayload []byte           `db:"request_payload" json:"request_payload"`
-} // @name AmlCheckQueue
+} //	@name	AmlCheckQueue
 
 type AmlService struct {
 	ID        uuid.UUID        `db:"id" json:"id"`
 	Slug      AMLSlug          `db:"slug" json:"slug"`
 	CreatedAt pgtype.Timestamp `db:"created_at" json:"created_at"`
 	UpdatedAt pgtype.Timestamp `db:"updated_at" json:"updated_at"`
-} // @name AmlService
+} //	@name	AmlService
 
 type AmlServiceKey struct {
 	ID          uuid.UUID        `db:"id" json:"id"`
@@ -57,14 +58,14 @@ type AmlServiceKey struct {
 	Description string           `db:"description" json:"description"`
 	CreatedAt   pgtype.Timestamp `db:"created_at" json:"created_at"`
 	UpdatedAt   pgtype.Timestamp `db:"updated_at" json:"updated_at"`
-} // @name AmlServiceKey
+} //	@name	AmlServiceKey
 
 type AmlSupportedAsset struct {
 	ServiceSlug    AMLSlug `db:"service_slug" json:"service_slug"`
 	CurrencyID     string  `db:"currency_id" json:"currency_id"`
 	AssetIdentity  string  `db:"asset_identity" json:"asset_identity"`
 	BlockchainName string  `db:"blockchain_name" json:"blockchain_name"`
-} // @name AmlSupportedAsset
+} //	@name	AmlSupportedAsset
 
 type AmlUserKey struct {
 	ID        uuid.UUID        `db:"id" json:"id"`
@@ -73,7 +74,7 @@ type AmlUserKey struct {
 	Value     string           `db:"value" json:"value"`
 	CreatedAt pgtype.Timestamp `db:"created_at" json:"created_at"`
 	UpdatedAt interface{}      `db:"updated_at" json:"updated_at"`
-} // @name AmlUserKey
+} //	@name	AmlUserKey
 
 type Currency struct {
 	ID                   string           `db:"id" json:"id"`
@@ -95,7 +96,21 @@ type Currency struct {
 	TokenLabel           pgtype.Text      `db:"token_label" json:"token_label"`
 	IsNative             bool             `db:"is_native" json:"is_native"`
 	IsNewStoreDefault    bool             `db:"is_new_store_default" json:"is_new_store_default"`
-} // @name Currency
+} //	@name	Currency
+
+type EventOutbox struct {
+	ID             uuid.UUID         `db:"id" json:"id"`
+	EventType      string            `db:"event_type" json:"event_type"`
+	IdempotencyKey string            `db:"idempotency_key" json:"idempotency_key"`
+	Payload        []byte            `db:"payload" json:"payload"`
+	Status         EventOutboxStatus `db:"status" json:"status"`
+	Attempts       int32             `db:"attempts" json:"attempts"`
+	LastError      pgtype.Text       `db:"last_error" json:"last_error"`
+	AvailableAt    pgtype.Timestamp  `db:"available_at" json:"available_at"`
+	LockedUntil    pgtype.Timestamp  `db:"locked_until" json:"locked_until"`
+	CreatedAt      pgtype.Timestamp  `db:"created_at" json:"created_at"`
+	ProcessedAt    pgtype.Timestamp  `db:"processed_at" json:"processed_at"`
+} //	@name	EventOutbox
 
 type Exchange struct {
 	ID        uuid.UUID        `db:"id" json:"id"`
@@ -105,7 +120,7 @@ type Exchange struct {
 	Url       string           `db:"url" json:"url"`
 	CreatedAt pgtype.Timestamp `db:"created_at" json:"created_at"`
 	UpdatedAt pgtype.Timestamp `db:"updated_at" json:"updated_at"`
-} // @name Exchange
+} //	@name	Exchange
 
 type ExchangeAddress struct {
 	ID          uuid.UUID        `db:"id" json:"id"`
@@ -117,7 +132,7 @@ type ExchangeAddress struct {
 	CreateType  string           `db:"create_type" json:"create_type"`
 	CreatedAt   pgtype.Timestamp `db:"created_at" json:"created_at"`
 	ExchangeID  uuid.UUID        `db:"exchange_id" json:"exchange_id"`
-} // @name ExchangeAddress
+} //	@name	ExchangeAddress
 
 type ExchangeChain struct {
 	ID         uuid.UUID    `db:"id" json:"id"`
@@ -125,7 +140,7 @@ type ExchangeChain struct {
 	CurrencyID string       `db:"currency_id" json:"currency_id"`
 	Ticker     string       `db:"ticker" json:"ticker"`
 	Chain      string       `db:"chain" json:"chain"`
-} // @name ExchangeChain
+} //	@name	ExchangeChain
 
 type ExchangeKey struct {
 	ID         uuid.UUID        `db:"id" json:"id"`
@@ -134,7 +149,7 @@ type ExchangeKey struct {
 	Title      string           `db:"title" json:"title"`
 	CreatedAt  pgtype.Timestamp `db:"created_at" json:"created_at"`
 	UpdatedAt  pgtype.Timestamp `db:"updated_at" json:"updated_at"`
-} // @name ExchangeKey
+} //	@name	ExchangeKey
 
 type ExchangeOrder struct {
 	ID                     uuid.UUID           `db:"id" json:"id"`
@@ -152,7 +167,30 @@ type ExchangeOrder struct {
 	UserID                 uuid.UUID           `db:"user_id" json:"user_id"`
 	AmountUsd              decimal.NullDecimal `db:"amount_usd" json:"amount_usd"`
 	ExchangeConnectionHash pgtype.Text         `db:"exchange_connection_hash" json:"exchange_connection_hash"`
-} // @name ExchangeOrder
+	Route                  []byte              `db:"route" json:"route"`
+	OrderType              OrderType           `db:"order_type" json:"order_type"`
+	Price                  decimal.NullDecimal `db:"price" json:"price"`
+	TimeInForce            pgtype.Text         `db:"time_in_force" json:"time_in_force"`
+	ParentID               uuid.NullUUID       `db:"parent_id" json:"parent_id"`
+	ScheduledAt            pgtype.Timestamp    `db:"scheduled_at" json:"scheduled_at"`
+	ExpiresAt              pgtype.Timestamp    `db:"expires_at" json:"expires_at"`
+} //	@name	ExchangeOrder
+
+type ExchangeRateHistory struct {
+	Source       string           `db:"source" json:"source"`
+	FromCurrency string           `db:"from_currency" json:"from_currency"`
+	ToCurrency   string           `db:"to_currency" json:"to_currency"`
+	Value        decimal.Decimal  `db:"value" json:"value"`
+	SnapshotAt   pgtype.Timestamp `db:"snapshot_at" json:"snapshot_at"`
+} //	@name	ExchangeRateHistory
+
+type ExchangeRoutedReserve struct {
+	UserID     uuid.UUID        `db:"user_id" json:"user_id"`
+	ExchangeID uuid.UUID        `db:"exchange_id" json:"exchange_id"`
+	Currency   string           `db:"currency" json:"currency"`
+	Amount     decimal.Decimal  `db:"amount" json:"amount"`
+	UpdatedAt  pgtype.Timestamp `db:"updated_at" json:"updated_at"`
+} //	@name	ExchangeRoutedReserve
 
 type ExchangeUserKey struct {
 	ID            uuid.UUID        `db:"id" json:"id"`
@@ -161,7 +199,7 @@ type ExchangeUserKey struct {
 	Value         string           `db:"value" json:"value"`
 	CreatedAt     pgtype.Timestamp `db:"created_at" json:"created_at"`
 	UpdatedAt     pgtype.Timestamp `db:"updated_at" json:"updated_at"`
-} // @name ExchangeUserKey
+} //	@name	ExchangeUserKey
 
 type ExchangeWithdrawalHistory struct {
 	ID                     uuid.UUID               `db:"id" json:"id"`
@@ -179,7 +217,7 @@ type ExchangeWithdrawalHistory struct {
 	UpdatedAt              pgtype.Timestamp        `db:"updated_at" json:"updated_at"`
 	FailReason             pgtype.Text             `db:"fail_reason" json:"fail_reason"`
 	ExchangeConnectionHash pgtype.Text             `db:"exchange_connection_hash" json:"exchange_connection_hash"`
-} // @name ExchangeWithdrawalHistory
+} //	@name	ExchangeWithdrawalHistory
 
 type ExchangeWithdrawalSetting struct {
 	ID         uuid.UUID        `db:"id" json:"id"`
@@ -192,7 +230,48 @@ type ExchangeWithdrawalSetting struct {
 	CreatedAt  pgtype.Timestamp `db:"created_at" json:"created_at"`
 	UpdatedAt  pgtype.Timestamp `db:"updated_at" json:"updated_at"`
 	IsEnabled  bool             `db:"is_enabled" json:"is_enabled"`
-} // @name ExchangeWithdrawalSetting
+} //	@name	ExchangeWithdrawalSetting
+
+type Invoice struct {
+	ID                  uuid.UUID        `db:"id" json:"id"`
+	StoreID             uuid.UUID        `db:"store_id" json:"store_id"`
+	WalletID            uuid.UUID        `db:"wallet_id" json:"wallet_id"`
+	CurrencyID          string           `db:"currency_id" json:"currency_id"`
+	Address             string           `db:"address" json:"address"`
+	OrderID             pgtype.Text      `db:"order_id" json:"order_id"`
+	Description         pgtype.Text      `db:"description" json:"description"`
+	Status              InvoiceStatus    `db:"status" json:"status"`
+	Amount              decimal.Decimal  `db:"amount" json:"amount"`
+	AmountUsd           decimal.Decimal  `db:"amount_usd" json:"amount_usd"`
+	PaidAmount          decimal.Decimal  `db:"paid_amount" json:"paid_amount"`
+	PaidAmountUsd       decimal.Decimal  `db:"paid_amount_usd" json:"paid_amount_usd"`
+	ExpiresAt           pgtype.Timestamp `db:"expires_at" json:"expires_at"`
+	PaidAt              pgtype.Timestamp `db:"paid_at" json:"paid_at"`
+	CreatedAt           pgtype.Timestamp `db:"created_at" json:"created_at"`
+	UpdatedAt           pgtype.Timestamp `db:"updated_at" json:"updated_at"`
+	LeftoverAmountUsd   decimal.Decimal  `db:"leftover_amount_usd" json:"leftover_amount_usd"`
+	LeftoverAction      *LeftoverAction  `db:"leftover_action" json:"leftover_action"`
+	CreditAmountUsd     decimal.Decimal  `db:"credit_amount_usd" json:"credit_amount_usd"`
+	CreditedToInvoiceID uuid.NullUUID    `db:"credited_to_invoice_id" json:"credited_to_invoice_id"`
+} //	@name	Invoice
+
+type InvoicePayment struct {
+	ID            uuid.UUID        `db:"id" json:"id"`
+	InvoiceID     uuid.UUID        `db:"invoice_id" json:"invoice_id"`
+	TransactionID uuid.UUID        `db:"transaction_id" json:"transaction_id"`
+	Amount        decimal.Decimal  `db:"amount" json:"amount"`
+	AmountUsd     decimal.Decimal  `db:"amount_usd" json:"amount_usd"`
+	CreatedAt     pgtype.Timestamp `db:"created_at" json:"created_at"`
+} //	@name	InvoicePayment
+
+type InvoiceStatusHistory struct {
+	ID            uuid.UUID        `db:"id" json:"id"`
+	InvoiceID     uuid.UUID        `db:"invoice_id" json:"invoice_id"`
+	StatusFrom    InvoiceStatus    `db:"status_from" json:"status_from"`
+	StatusTo      InvoiceStatus    `db:"status_to" json:"status_to"`
+	TransactionID uuid.NullUUID    `db:"transaction_id" json:"transaction_id"`
+	CreatedAt     pgtype.Timestamp `db:"created_at" json:"created_at"`
+} //	@name	InvoiceStatusHistory
 
 type Log struct {
 	ID          uuid.UUID        `db:"id" json:"id"`
@@ -202,7 +281,7 @@ type Log struct {
 	Status      string           `db:"status" json:"status"`
 	Message     string           `db:"message" json:"message"`
 	CreatedAt   pgtype.Timestamp `db:"created_at" json:"created_at"`
-} // @name Log
+} //	@name	Log
 
 type LogType struct {
 	ID                    int32            `db:"id" json:"id"`
@@ -214,7 +293,7 @@ type LogType struct {
 	NotifyParams          []byte           `db:"notify_params" json:"notify_params"`
 	CreatedAt             pgtype.Timestamp `db:"created_at" json:"created_at"`
 	UpdatedAt             pgtype.Timestamp `db:"updated_at" json:"updated_at"`
-} // @name LogType
+} //	@name	LogType
 
 type MultiWithdrawalRule struct {
 	ID                 uuid.UUID           `db:"id" json:"id"`
@@ -223,13 +302,36 @@ type MultiWithdrawalRule struct {
 	ManualAddress      pgtype.Text         `db:"manual_address" json:"manual_address"`
 	CreatedAt          pgtype.Timestamp    `db:"created_at" json:"created_at"`
 	UpdatedAt          pgtype.Timestamp    `db:"updated_at" json:"updated_at"`
-} // @name MultiWithdrawalRule
+} //	@name	MultiWithdrawalRule
+
+type MultiWithdrawalSplitLeg struct {
+	ID                    uuid.UUID           `db:"id" json:"id"`
+	MultiWithdrawalRuleID uuid.UUID           `db:"multi_withdrawal_rule_id" json:"multi_withdrawal_rule_id"`
+	Address               string              `db:"address" json:"address"`
+	Percent               decimal.Decimal     `db:"percent" json:"percent"`
+	MinAmountUsd          decimal.NullDecimal `db:"min_amount_usd" json:"min_amount_usd"`
+	ReceivesRemainder     bool                `db:"receives_remainder" json:"receives_remainder"`
+	CreatedAt             pgtype.Timestamp    `db:"created_at" json:"created_at"`
+} //	@name	MultiWithdrawalSplitLeg
+
+type MultiWithdrawalSplitTransfer struct {
+	ID                    uuid.UUID        `db:"id" json:"id"`
+	BatchID               uuid.UUID        `db:"batch_id" json:"batch_id"`
+	MultiWithdrawalRuleID uuid.UUID        `db:"multi_withdrawal_rule_id" json:"multi_withdrawal_rule_id"`
+	Address               string           `db:"address" json:"address"`
+	Amount                decimal.Decimal  `db:"amount" json:"amount"`
+	AmountUsd             decimal.Decimal  `db:"amount_usd" json:"amount_usd"`
+	ReceivesRemainder     bool             `db:"receives_remainder" json:"receives_remainder"`
+	FromAddresses         []string         `db:"from_addresses" json:"from_addresses"`
+	TransferID            uuid.NullUUID    `db:"transfer_id" json:"transfer_id"`
+	CreatedAt             pgtype.Timestamp `db:"created_at" json:"created_at"`
+} //	@name	MultiWithdrawalSplitTransfer
 
 type Notification struct {
 	ID       uuid.UUID             `db:"id" json:"id"`
 	Category *NotificationCategory `db:"category" json:"category"`
 	Type     NotificationType      `db:"type" json:"type"`
-} // @name Notification
+} //	@name	Notification
 
 type NotificationSendHistory struct {
 	ID                      uuid.UUID        `db:"id" json:"id"`
@@ -244,7 +346,7 @@ type NotificationSendHistory struct {
 	NotificationSendQueueID uuid.UUID        `db:"notification_send_queue_id" json:"notification_send_queue_id"`
 	StoreID                 uuid.NullUUID    `db:"store_id" json:"store_id"`
 	UserID                  uuid.NullUUID    `db:"user_id" json:"user_id"`
-} // @name NotificationSendHistory
+} //	@name	NotificationSendHistory
 
 type NotificationSendQueue struct {
 	ID          uuid.UUID         `db:"id" json:"id"`
@@ -256,7 +358,7 @@ type NotificationSendQueue struct {
 	CreatedAt   pgtype.Timestamp  `db:"created_at" json:"created_at"`
 	UpdatedAt   pgtype.Timestamp  `db:"updated_at" json:"updated_at"`
 	Args        *NotificationArgs `db:"args" json:"args"`
-} // @name NotificationSendQueue
+} //	@name	NotificationSendQueue
 
 type PersonalAccessToken struct {
 	ID            uuid.UUID        `db:"id" json:"id"`
@@ -268,7 +370,22 @@ type PersonalAccessToken struct {
 	ExpiresAt     *time.Time       `db:"expires_at" json:"expires_at"`
 	CreatedAt     pgtype.Timestamp `db:"created_at" json:"created_at"`
 	UpdatedAt     pgtype.Timestamp `db:"updated_at" json:"updated_at"`
-} // @name PersonalAccessToken
+} //	@name	PersonalAccessToken
+
+type RateQuote struct {
+	ID         uuid.UUID           `db:"id" json:"id"`
+	StoreID    uuid.UUID           `db:"store_id" json:"store_id"`
+	WalletID   uuid.UUID           `db:"wallet_id" json:"wallet_id"`
+	InvoiceID  uuid.NullUUID       `db:"invoice_id" json:"invoice_id"`
+	CurrencyID string              `db:"currency_id" json:"currency_id"`
+	Source     string              `db:"source" json:"source"`
+	Rate       decimal.Decimal     `db:"rate" json:"rate"`
+	Amount     decimal.NullDecimal `db:"amount" json:"amount"`
+	AmountUsd  decimal.NullDecimal `db:"amount_usd" json:"amount_usd"`
+	UsedAmount decimal.Decimal     `db:"used_amount" json:"used_amount"`
+	ExpiresAt  pgtype.Timestamp    `db:"expires_at" json:"expires_at"`
+	CreatedAt  pgtype.Timestamp    `db:"created_at" json:"created_at"`
+} //	@name	RateQuote
 
 type Receipt struct {
 	ID         uuid.UUID        `db:"id" json:"id"`
@@ -279,7 +396,38 @@ type Receipt struct {
 	WalletID   uuid.NullUUID    `db:"wallet_id" json:"wallet_id"`
 	CreatedAt  pgtype.Timestamp `db:"created_at" json:"created_at"`
 	UpdatedAt  pgtype.Timestamp `db:"updated_at" json:"updated_at"`
-} // @name Receipt
+} //	@name	Receipt
+
+type Refund struct {
+	ID              uuid.UUID        `db:"id" json:"id"`
+	StoreID         uuid.UUID        `db:"store_id" json:"store_id"`
+	TransactionID   uuid.UUID        `db:"transaction_id" json:"transaction_id"`
+	InvoiceID       uuid.NullUUID    `db:"invoice_id" json:"invoice_id"`
+	CurrencyID      string           `db:"currency_id" json:"currency_id"`
+	Reason          RefundReason     `db:"reason" json:"reason"`
+	Status          RefundStatus     `db:"status" json:"status"`
+	Amount          decimal.Decimal  `db:"amount" json:"amount"`
+	AmountUsd       decimal.Decimal  `db:"amount_usd" json:"amount_usd"`
+	AddressTo       string           `db:"address_to" json:"address_to"`
+	WithdrawalID    uuid.NullUUID    `db:"withdrawal_id" json:"withdrawal_id"`
+	RefundTxHash    pgtype.Text      `db:"refund_tx_hash" json:"refund_tx_hash"`
+	RequestedBy     uuid.NullUUID    `db:"requested_by" json:"requested_by"`
+	DecidedBy       uuid.NullUUID    `db:"decided_by" json:"decided_by"`
+	DecidedAt       pgtype.Timestamp `db:"decided_at" json:"decided_at"`
+	RejectionReason pgtype.Text      `db:"rejection_reason" json:"rejection_reason"`
+	FailureReason   pgtype.Text      `db:"failure_reason" json:"failure_reason"`
+	CreatedAt       pgtype.Timestamp `db:"created_at" json:"created_at"`
+	UpdatedAt       pgtype.Timestamp `db:"updated_at" json:"updated_at"`
+} //	@name	Refund
+
+type RefundStatusHistory struct {
+	ID         uuid.UUID        `db:"id" json:"id"`
+	RefundID   uuid.UUID        `db:"refund_id" json:"refund_id"`
+	StatusFrom *RefundStatus    `db:"status_from" json:"status_from"`
+	StatusTo   RefundStatus     `db:"status_to" json:"status_to"`
+	UserID     uuid.NullUUID    `db:"user_id" json:"user_id"`
+	CreatedAt  pgtype.Timestamp `db:"created_at" json:"created_at"`
+} //	@name	RefundStatusHistory
 
 type Setting struct {
 	ID        uuid.UUID        `db:"id" json:"id"`
@@ -290,7 +438,7 @@ type Setting struct {
 	CreatedAt pgtype.Timestamp `db:"created_at" json:"created_at"`
 	UpdatedAt pgtype.Timestamp `db:"updated_at" json:"updated_at"`
 	IsMutable bool             `db:"is_mutable" json:"is_mutable"`
-} // @name Setting
+} //	@name	Setting
 
 type Store struct {
 	ID                       uuid.UUID                         `db:"id" json:"id"`
@@ -314,7 +462,7 @@ type Store struct {
 	RejectionReason          pgtype.Text                       `db:"rejection_reason" json:"rejection_reason"`
 	Description              *string                           `db:"description" json:"description"`
 	VerificationComment      pgtype.Text                       `db:"verification_comment" json:"verification_comment"`
-} // @name Store
+} //	@name	Store
 
 type StoreApiKey struct {
 	ID        uuid.UUID        `db:"id" json:"id"`
@@ -323,35 +471,78 @@ type StoreApiKey struct {
 	Enabled   bool             `db:"enabled" json:"enabled"`
 	CreatedAt pgtype.Timestamp `db:"created_at" json:"created_at"`
 	UpdatedAt pgtype.Timestamp `db:"updated_at" json:"updated_at"`
-} // @name StoreApiKey
+} //	@name	StoreApiKey
 
 type StoreCurrency struct {
 	CurrencyID string    `db:"currency_id" json:"currency_id"`
 	StoreID    uuid.UUID `db:"store_id" json:"store_id"`
-} // @name StoreCurrency
+} //	@name	StoreCurrency
+
+type StorePaymentPolicy struct {
+	ID             uuid.UUID         `db:"id" json:"id"`
+	StoreID        uuid.UUID         `db:"store_id" json:"store_id"`
+	ThresholdType  ToleranceType     `db:"threshold_type" json:"threshold_type"`
+	Threshold      decimal.Decimal   `db:"threshold" json:"threshold"`
+	Rounding       ToleranceRounding `db:"rounding" json:"rounding"`
+	LeftoverAction LeftoverAction    `db:"leftover_action" json:"leftover_action"`
+	CreatedAt      pgtype.Timestamp  `db:"created_at" json:"created_at"`
+	UpdatedAt      pgtype.Timestamp  `db:"updated_at" json:"updated_at"`
+} //	@name	StorePaymentPolicy
+
+type StoreWebhookClientCertificate struct {
+	ID          uuid.UUID        `db:"id" json:"id"`
+	StoreID     uuid.UUID        `db:"store_id" json:"store_id"`
+	Certificate string           `db:"certificate" json:"certificate"`
+	PrivateKey  string           `db:"private_key" json:"private_key"`
+	Fingerprint string           `db:"fingerprint" json:"fingerprint"`
+	Subject     string           `db:"subject" json:"subject"`
+	ExpiresAt   pgtype.Timestamp `db:"expires_at" json:"expires_at"`
+	CreatedAt   pgtype.Timestamp `db:"created_at" json:"created_at"`
+	UpdatedAt   pgtype.Timestamp `db:"updated_at" json:"updated_at"`
+} //	@name	StoreWebhookClientCertificate
+
+type StoreWebhookRetryPolicy struct {
+	ID              uuid.UUID              `db:"id" json:"id"`
+	StoreID         uuid.UUID              `db:"store_id" json:"store_id"`
+	PolicyType      WebhookRetryPolicyType `db:"policy_type" json:"policy_type"`
+	MaxAttempts     int32                  `db:"max_attempts" json:"max_attempts"`
+	DelaySeconds    int32                  `db:"delay_seconds" json:"delay_seconds"`
+	MaxDelaySeconds int32                  `db:"max_delay_seconds" json:"max_delay_seconds"`
+	ScheduleSeconds []int32                `db:"schedule_seconds" json:"schedule_seconds"`
+	CreatedAt       pgtype.Timestamp       `db:"created_at" json:"created_at"`
+	UpdatedAt       pgtype.Timestamp       `db:"updated_at" json:"updated_at"`
+} //	@name	StoreWebhookRetryPolicy
 
 type StoreSecret struct {
-	ID        uuid.UUID        `db:"id" json:"id"`
-	StoreID   uuid.UUID        `db:"store_id" json:"store_id"`
-	Secret    string           `db:"secret" json:"secret"`
-	CreatedAt pgtype.Timestamp `db:"created_at" json:"created_at"`
-	UpdatedAt pgtype.Timestamp `db:"updated_at" json:"updated_at"`
-} // @name StoreSecret
+	ID                uuid.UUID        `db:"id" json:"id"`
+	StoreID           uuid.UUID        `db:"store_id" json:"store_id"`
+	Secret            string           `db:"secret" json:"secret"`
+	CreatedAt         pgtype.Timestamp `db:"created_at" json:"created_at"`
+	UpdatedAt         pgtype.Timestamp `db:"updated_at" json:"updated_at"`
+	PreviousSecret    pgtype.Text      `db:"previous_secret" json:"previous_secret"`
+	PreviousExpiresAt pgtype.Timestamp `db:"previous_expires_at" json:"previous_expires_at"`
+} //	@name	StoreSecret
 
 type StoreWebhook struct {
-	ID        uuid.UUID        `db:"id" json:"id"`
-	StoreID   uuid.UUID        `db:"store_id" json:"store_id"`
-	Url       string           `db:"url" json:"url"`
-	Enabled   bool             `db:"enabled" json:"enabled"`
-	Events    []*WebhookEvent  `db:"events" json:"events"`
-	CreatedAt pgtype.Timestamp `db:"created_at" json:"created_at"`
-	UpdatedAt pgtype.Timestamp `db:"updated_at" json:"updated_at"`
-} // @name StoreWebhook
+	ID                 uuid.UUID          `db:"id" json:"id"`
+	StoreID            uuid.UUID          `db:"store_id" json:"store_id"`
+	Url                string             `db:"url" json:"url"`
+	Enabled            bool               `db:"enabled" json:"enabled"`
+	Events             []*WebhookEvent    `db:"events" json:"events"`
+	CreatedAt          pgtype.Timestamp   `db:"created_at" json:"created_at"`
+	UpdatedAt          pgtype.Timestamp   `db:"updated_at" json:"updated_at"`
+	SuccessMode        WebhookSuccessMode `db:"success_mode" json:"success_mode"`
+	SuccessStatusCodes []int32            `db:"success_status_codes" json:"success_status_codes"`
+	SuccessJsonPath    string             `db:"success_json_path" json:"success_json_path"`
+	SuccessJsonValue   string             `db:"success_json_value" json:"success_json_value"`
+	TimeoutSeconds     int32              `db:"timeout_seconds" json:"timeout_seconds"`
+	SchemaVersion      int32              `db:"schema_version" json:"schema_version"`
+} //	@name	StoreWebhook
 
 type StoreWhitelist struct {
 	Ip      string    `db:"ip" json:"ip"`
 	StoreID uuid.UUID `db:"store_id" json:"store_id"`
-} // @name StoreWhitelist
+} //	@name	StoreWhitelist
 
 type Transaction struct {
 	ID                 uuid.UUID           `db:"id" json:"id"`
@@ -375,7 +566,7 @@ type Transaction struct {
 	UpdatedAt          pgtype.Timestamp    `db:"updated_at" json:"updated_at"`
 	CreatedAtIndex     pgtype.Int8         `db:"created_at_index" json:"created_at_index"`
 	IsSystem           bool                `db:"is_system" json:"is_system"`
-} // @name Transaction
+} //	@name	Transaction
 
 type Transfer struct {
 	ID            uuid.UUID        `db:"id" json:"id"`
@@ -395,7 +586,7 @@ type Transfer struct {
 	ToAddresses   []string         `db:"to_addresses" json:"to_addresses"`
 	Step          *string          `db:"step" json:"step"`
 	TxHash        *string          `db:"tx_hash" json:"tx_hash"`
-} // @name Transfer
+} //	@name	Transfer
 
 type TransferTransaction struct {
 	ID                uuid.UUID                  `db:"id" json:"id"`
@@ -410,7 +601,7 @@ type TransferTransaction struct {
 	Step              string                     `db:"step" json:"step"`
 	CreatedAt         pgtype.Timestamptz         `db:"created_at" json:"created_at"`
 	UpdatedAt         pgtype.Timestamptz         `db:"updated_at" json:"updated_at"`
-} // @name TransferTransaction
+} //	@name	TransferTransaction
 
 type TronWalletBalanceStatistic struct {
 	ID                 uuid.UUID          `db:"id" json:"id"`
@@ -423,7 +614,7 @@ type TronWalletBalanceStatistic struct {
 	AvailableBandwidth decimal.Decimal    `db:"available_bandwidth" json:"available_bandwidth"`
 	AvailableEnergy    decimal.Decimal    `db:"available_energy" json:"available_energy"`
 	CreatedAt          pgtype.Timestamptz `db:"created_at" json:"created_at"`
-} // @name TronWalletBalanceStatistic
+} //	@name	TronWalletBalanceStatistic
 
 type UnconfirmedTransaction struct {
 	ID               uuid.UUID           `db:"id" json:"id"`
@@ -442,7 +633,7 @@ type UnconfirmedTransaction struct {
 	CreatedAt        pgtype.Timestamp    `db:"created_at" json:"created_at"`
 	UpdatedAt        pgtype.Timestamp    `db:"updated_at" json:"updated_at"`
 	Blockchain       Blockchain          `db:"blockchain" json:"blockchain"`
-} // @name UnconfirmedTransaction
+} //	@name	UnconfirmedTransaction
 
 type UpdateBalanceQueue struct {
 	ID                       uuid.UUID        `db:"id" json:"id"`
@@ -451,7 +642,7 @@ type UpdateBalanceQueue struct {
 	NativeTokenBalanceUpdate bool             `db:"native_token_balance_update" json:"native_token_balance_update"`
 	CreatedAt                pgtype.Timestamp `db:"created_at" json:"created_at"`
 	UpdatedAt                pgtype.Timestamp `db:"updated_at" json:"updated_at"`
-} // @name UpdateBalanceQueue
+} //	@name	UpdateBalanceQueue
 
 type User struct {
 	ID                  uuid.UUID        `db:"id" json:"id"`
@@ -471,22 +662,25 @@ type User struct {
 	RateScale           decimal.Decimal  `db:"rate_scale" json:"rate_scale"`
 	DvnetToken          pgtype.Text      `db:"dvnet_token" json:"dvnet_token"`
 	TwoFaResetExpiresAt pgtype.Timestamp `db:"two_fa_reset_expires_at" json:"two_fa_reset_expires_at"`
-} // @name User
+} //	@name	User
 
 type UserAddressBook struct {
-	ID          uuid.UUID          `db:"id" json:"id"`
-	UserID      uuid.UUID          `db:"user_id" json:"user_id"`
-	Address     string             `db:"address" json:"address"`
-	CurrencyID  string             `db:"currency_id" json:"currency_id"`
-	Name        pgtype.Text        `db:"name" json:"name"`
-	Tag         pgtype.Text        `db:"tag" json:"tag"`
-	Blockchain  *Blockchain        `db:"blockchain" json:"blockchain"`
-	Type        AddressBookType    `db:"type" json:"type"`
-	SubmittedAt pgtype.Timestamptz `db:"submitted_at" json:"submitted_at"`
-	CreatedAt   pgtype.Timestamptz `db:"created_at" json:"created_at"`
-	UpdatedAt   pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
-	DeletedAt   pgtype.Timestamptz `db:"deleted_at" json:"deleted_at"`
-} // @name UserAddressBook
+	ID                   uuid.UUID          `db:"id" json:"id"`
+	UserID               uuid.UUID          `db:"user_id" json:"user_id"`
+	Address              string             `db:"address" json:"address"`
+	CurrencyID           string             `db:"currency_id" json:"currency_id"`
+	Name                 pgtype.Text        `db:"name" json:"name"`
+	Tag                  pgtype.Text        `db:"tag" json:"tag"`
+	Blockchain           *Blockchain        `db:"blockchain" json:"blockchain"`
+	Type                 AddressBookType    `db:"type" json:"type"`
+	SubmittedAt          pgtype.Timestamptz `db:"submitted_at" json:"submitted_at"`
+	CreatedAt            pgtype.Timestamptz `db:"created_at" json:"created_at"`
+	UpdatedAt            pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
+	DeletedAt            pgtype.Timestamptz `db:"deleted_at" json:"deleted_at"`
+	ActiveAt             pgtype.Timestamp   `db:"active_at" json:"active_at"`
+	RevokeToken          uuid.NullUUID      `db:"revoke_token" json:"revoke_token"`
+	RevokeTokenExpiresAt pgtype.Timestamp   `db:"revoke_token_expires_at" json:"revoke_token_expires_at"`
+} //	@name	UserAddressBook
 
 type UserAmlRiskRule struct {
 	ID           uuid.UUID          `db:"id" json:"id"`
@@ -498,7 +692,7 @@ type UserAmlRiskRule struct {
 	Action       string             `db:"action" json:"action"`
 	CreatedAt    pgtype.Timestamptz `db:"created_at" json:"created_at"`
 	UpdatedAt    pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
-} // @name UserAmlRiskRule
+} //	@name	UserAmlRiskRule
 
 type UserAmlSetting struct {
 	ID           uuid.UUID          `db:"id" json:"id"`
@@ -507,7 +701,7 @@ type UserAmlSetting struct {
 	CreatedAt    pgtype.Timestamptz `db:"created_at" json:"created_at"`
 	UpdatedAt    pgtype.Timestamptz `db:"updated_at" json:"updated_at"`
 	UserID       uuid.UUID          `db:"user_id" json:"user_id"`
-} // @name UserAmlSetting
+} //	@name	UserAmlSetting
 
 type UserExchange struct {
 	ID              uuid.UUID               `db:"id" json:"id"`
@@ -515,17 +709,22 @@ type UserExchange struct {
 	UserID          uuid.UUID               `db:"user_id" json:"user_id"`
 	WithdrawalState ExchangeWithdrawalState `db:"withdrawal_state" json:"withdrawal_state"`
 	SwapState       ExchangeSwapState       `db:"swap_state" json:"swap_state"`
-} // @name UserExchange
+} //	@name	UserExchange
 
 type UserExchangePair struct {
-	ID           uuid.UUID `db:"id" json:"id"`
-	ExchangeID   uuid.UUID `db:"exchange_id" json:"exchange_id"`
-	UserID       uuid.UUID `db:"user_id" json:"user_id"`
-	CurrencyFrom string    `db:"currency_from" json:"currency_from"`
-	CurrencyTo   string    `db:"currency_to" json:"currency_to"`
-	Symbol       string    `db:"symbol" json:"symbol"`
-	Type         OrderSide `db:"type" json:"type"`
-} // @name UserExchangePair
+	ID                uuid.UUID       `db:"id" json:"id"`
+	ExchangeID        uuid.UUID       `db:"exchange_id" json:"exchange_id"`
+	UserID            uuid.UUID       `db:"user_id" json:"user_id"`
+	CurrencyFrom      string          `db:"currency_from" json:"currency_from"`
+	CurrencyTo        string          `db:"currency_to" json:"currency_to"`
+	Symbol            string          `db:"symbol" json:"symbol"`
+	Type              OrderSide       `db:"type" json:"type"`
+	OrderType         OrderType       `db:"order_type" json:"order_type"`
+	PriceOffset       decimal.Decimal `db:"price_offset" json:"price_offset"`
+	TimeInForce       TimeInForce     `db:"time_in_force" json:"time_in_force"`
+	TwapSlices        int32           `db:"twap_slices" json:"twap_slices"`
+	TwapWindowSeconds int32           `db:"twap_window_seconds" json:"twap_window_seconds"`
+} //	@name	UserExchangePair
 
 type UserNotification struct {
 	ID             uuid.UUID        `db:"id" json:"id"`
@@ -535,14 +734,14 @@ type UserNotification struct {
 	TgEnabled      bool             `db:"tg_enabled" json:"tg_enabled"`
 	CreatedAt      pgtype.Timestamp `db:"created_at" json:"created_at"`
 	UpdatedAt      pgtype.Timestamp `db:"updated_at" json:"updated_at"`
-} // @name UserNotification
+} //	@name	UserNotification
 
 type UserStore struct {
 	ID        uuid.UUID        `db:"id" json:"id"`
 	UserID    uuid.UUID        `db:"user_id" json:"user_id"`
 	StoreID   uuid.UUID        `db:"store_id" json:"store_id"`
 	CreatedAt pgtype.Timestamp `db:"created_at" json:"created_at"`
-} // @name UserStore
+} //	@name	UserStore
 
 type Wallet struct {
 	ID              uuid.UUID        `db:"id" json:"id"`
@@ -555,7 +754,7 @@ type Wallet struct {
 	IpAddress       pgtype.Text      `db:"ip_address" json:"ip_address"`
 	UntrustedEmail  pgtype.Text      `db:"untrusted_email" json:"untrusted_email"`
 	Locale          string           `db:"locale" json:"locale"`
-} // @name Wallet
+} //	@name	Wallet
 
 type WalletAddress struct {
 	ID         uuid.UUID        `db:"id" json:"id"`
@@ -569,7 +768,7 @@ type WalletAddress struct {
 	UpdatedAt  pgtype.Timestamp `db:"updated_at" json:"updated_at"`
 	DeletedAt  pgtype.Timestamp `db:"deleted_at" json:"deleted_at"`
 	Dirty      bool             `db:"dirty" json:"dirty"`
-} // @name WalletAddress
+} //	@name	WalletAddress
 
 type WalletAddressesActivityLog struct {
 	ID                uuid.UUID        `db:"id" json:"id"`
@@ -578,7 +777,24 @@ type WalletAddressesActivityLog struct {
 	TextVariables     []byte           `db:"text_variables" json:"text_variables"`
 	CreatedAt         pgtype.Timestamp `db:"created_at" json:"created_at"`
 	UpdatedAt         pgtype.Timestamp `db:"updated_at" json:"updated_at"`
-} // @name WalletAddressesActivityLog
+} //	@name	WalletAddressesActivityLog
+
+type WebhookDeadLetter struct {
+	ID                     uuid.UUID        `db:"id" json:"id"`
+	SendQueueJobID         uuid.UUID        `db:"send_queue_job_id" json:"send_queue_job_id"`
+	WebhookID              uuid.UUID        `db:"webhook_id" json:"webhook_id"`
+	StoreID                uuid.UUID        `db:"store_id" json:"store_id"`
+	TransactionID          uuid.UUID        `db:"transaction_id" json:"transaction_id"`
+	Event                  string           `db:"event" json:"event"`
+	Url                    string           `db:"url" json:"url"`
+	Payload                []byte           `db:"payload" json:"payload"`
+	Signature              string           `db:"signature" json:"signature"`
+	Attempts               int32            `db:"attempts" json:"attempts"`
+	LastResponse           *string          `db:"last_response" json:"last_response"`
+	LastResponseStatusCode int32            `db:"last_response_status_code" json:"last_response_status_code"`
+	RequeuedAt             pgtype.Timestamp `db:"requeued_at" json:"requeued_at"`
+	CreatedAt              pgtype.Timestamp `db:"created_at" json:"created_at"`
+} //	@name	WebhookDeadLetter
 
 type WebhookSendHistory struct {
 	ID                 uuid.UUID        `db:"id" json:"id"`
@@ -594,19 +810,62 @@ type WebhookSendHistory struct {
 	UpdatedAt          pgtype.Timestamp `db:"updated_at" json:"updated_at"`
 	IsManual           pgtype.Bool      `db:"is_manual" json:"is_manual"`
 	StoreID            uuid.NullUUID    `db:"store_id" json:"store_id"`
-} // @name WebhookSendHistory
+} //	@name	WebhookSendHistory
 
 type WebhookSendQueue struct {
 	ID            uuid.UUID        `db:"id" json:"id"`
 	WebhookID     uuid.UUID        `db:"webhook_id" json:"webhook_id"`
-	SecondsDelay  int16            `db:"seconds_delay" json:"seconds_delay"`
+	SecondsDelay  int32            `db:"seconds_delay" json:"seconds_delay"`
 	TransactionID uuid.UUID        `db:"transaction_id" json:"transaction_id"`
 	Payload       []byte           `db:"payload" json:"payload"`
 	Signature     string           `db:"signature" json:"signature"`
 	Event         string           `db:"event" json:"event"`
 	LastSentAt    pgtype.Timestamp `db:"last_sent_at" json:"last_sent_at"`
 	CreatedAt     pgtype.Timestamp `db:"created_at" json:"created_at"`
-} // @name WebhookSendQueue
+	LockedUntil   pgtype.Timestamp `db:"locked_until" json:"locked_until"`
+} //	@name	WebhookSendQueue
+
+type WithdrawalApproval struct {
+	ID                uuid.UUID                `db:"id" json:"id"`
+	UserID            uuid.UUID                `db:"user_id" json:"user_id"`
+	Kind              WithdrawalApprovalKind   `db:"kind" json:"kind"`
+	Status            WithdrawalApprovalStatus `db:"status" json:"status"`
+	CurrencyID        string                   `db:"currency_id" json:"currency_id"`
+	AddressTo         string                   `db:"address_to" json:"address_to"`
+	Amount            decimal.Decimal          `db:"amount" json:"amount"`
+	AmountUsd         decimal.Decimal          `db:"amount_usd" json:"amount_usd"`
+	Payload           []byte                   `db:"payload" json:"payload"`
+	RequiredApprovals int32                    `db:"required_approvals" json:"required_approvals"`
+	RuleID            uuid.NullUUID            `db:"rule_id" json:"rule_id"`
+	InitiatedBy       uuid.NullUUID            `db:"initiated_by" json:"initiated_by"`
+	StoreID           uuid.NullUUID            `db:"store_id" json:"store_id"`
+	RequestID         pgtype.Text              `db:"request_id" json:"request_id"`
+	ExpiresAt         pgtype.Timestamp         `db:"expires_at" json:"expires_at"`
+	ExecutedAt        pgtype.Timestamp         `db:"executed_at" json:"executed_at"`
+	FailureReason     pgtype.Text              `db:"failure_reason" json:"failure_reason"`
+	CreatedAt         pgtype.Timestamp         `db:"created_at" json:"created_at"`
+	UpdatedAt         pgtype.Timestamp         `db:"updated_at" json:"updated_at"`
+} //	@name	WithdrawalApproval
+
+type WithdrawalApprovalDecision struct {
+	ID         uuid.UUID                      `db:"id" json:"id"`
+	ApprovalID uuid.UUID                      `db:"approval_id" json:"approval_id"`
+	UserID     uuid.UUID                      `db:"user_id" json:"user_id"`
+	Decision   WithdrawalApprovalDecisionType `db:"decision" json:"decision"`
+	Comment    pgtype.Text                    `db:"comment" json:"comment"`
+	CreatedAt  pgtype.Timestamp               `db:"created_at" json:"created_at"`
+} //	@name	WithdrawalApprovalDecision
+
+type WithdrawalApprovalRule struct {
+	ID                uuid.UUID           `db:"id" json:"id"`
+	CurrencyID        pgtype.Text         `db:"currency_id" json:"currency_id"`
+	AddressTo         pgtype.Text         `db:"address_to" json:"address_to"`
+	MinAmountUsd      decimal.NullDecimal `db:"min_amount_usd" json:"min_amount_usd"`
+	RequiredApprovals int32               `db:"required_approvals" json:"required_approvals"`
+	CreatedBy         uuid.NullUUID       `db:"created_by" json:"created_by"`
+	CreatedAt         pgtype.Timestamp    `db:"created_at" json:"created_at"`
+	UpdatedAt         pgtype.Timestamp    `db:"updated_at" json:"updated_at"`
+} //	@name	WithdrawalApprovalRule
 
 type WithdrawalFromProcessingWallet struct {
 	ID                       uuid.UUID        `db:"id" json:"id"`
@@ -621,7 +880,29 @@ type WithdrawalFromProcessingWallet struct {
 	UpdatedAt                pgtype.Timestamp `db:"updated_at" json:"updated_at"`
 	RequestID                *string          `db:"request_id" json:"request_id"`
 	BlockedByProcessingError bool             `db:"blocked_by_processing_error" json:"blocked_by_processing_error"`
-} // @name WithdrawalFromProcessingWallet
+} //	@name	WithdrawalFromProcessingWallet
+
+type WithdrawalLimit struct {
+	ID            uuid.UUID            `db:"id" json:"id"`
+	UserID        uuid.UUID            `db:"user_id" json:"user_id"`
+	Scope         WithdrawalLimitScope `db:"scope" json:"scope"`
+	ScopeValue    pgtype.Text          `db:"scope_value" json:"scope_value"`
+	WindowSeconds int32                `db:"window_seconds" json:"window_seconds"`
+	MaxAmountUsd  decimal.NullDecimal  `db:"max_amount_usd" json:"max_amount_usd"`
+	MaxCount      pgtype.Int4          `db:"max_count" json:"max_count"`
+	CreatedAt     pgtype.Timestamp     `db:"created_at" json:"created_at"`
+	UpdatedAt     pgtype.Timestamp     `db:"updated_at" json:"updated_at"`
+} //	@name	WithdrawalLimit
+
+type WithdrawalLimitUsage struct {
+	ID         uuid.UUID        `db:"id" json:"id"`
+	UserID     uuid.UUID        `db:"user_id" json:"user_id"`
+	StoreID    uuid.NullUUID    `db:"store_id" json:"store_id"`
+	CurrencyID string           `db:"currency_id" json:"currency_id"`
+	AddressTo  string           `db:"address_to" json:"address_to"`
+	AmountUsd  decimal.Decimal  `db:"amount_usd" json:"amount_usd"`
+	CreatedAt  pgtype.Timestamp `db:"created_at" json:"created_at"`
+} //	@name	WithdrawalLimitUsage
 
 type WithdrawalWallet struct {
 	ID                      uuid.UUID           `db:"id" json:"id"`
@@ -635,7 +916,7 @@ type WithdrawalWallet struct {
 	UpdatedAt               pgtype.Timestamp    `db:"updated_at" json:"updated_at"`
 	WithdrawalEnabled       string              `db:"withdrawal_enabled" json:"withdrawal_enabled"`
 	WithdrawalMinBalanceUsd decimal.NullDecimal `db:"withdrawal_min_balance_usd" json:"withdrawal_min_balance_usd"`
-} // @name WithdrawalWallet
+} //	@name	WithdrawalWallet
 
 type WithdrawalWalletAddress struct {
 	ID                 uuid.UUID        `db:"id" json:"id"`
@@ -645,4 +926,18 @@ type WithdrawalWalletAddress struct {
 	CreatedAt          pgtype.Timestamp `db:"created_at" json:"created_at"`
 	UpdatedAt          pgtype.Timestamp `db:"updated_at" json:"updated_at"`
 	DeletedAt          pgtype.Timestamp `db:"deleted_at" json:"deleted_at"`
-} // @name WithdrawalWalletAddress
+} //	@name	WithdrawalWalletAddress
+
+type WithdrawalWalletSchedule struct {
+	ID                 uuid.UUID           `db:"id" json:"id"`
+	WithdrawalWalletID uuid.UUID           `db:"withdrawal_wallet_id" json:"withdrawal_wallet_id"`
+	CronExpression     pgtype.Text         `db:"cron_expression" json:"cron_expression"`
+	Timezone           string              `db:"timezone" json:"timezone"`
+	MinBalanceUsd      decimal.NullDecimal `db:"min_balance_usd" json:"min_balance_usd"`
+	MaxGasPriceGwei    decimal.NullDecimal `db:"max_gas_price_gwei" json:"max_gas_price_gwei"`
+	KeepFloatUsd       decimal.NullDecimal `db:"keep_float_usd" json:"keep_float_usd"`
+	RunStartedAt       pgtype.Timestamp    `db:"run_started_at" json:"run_started_at"`
+	LastRunAt          pgtype.Timestamp    `db:"last_run_at" json:"last_run_at"`
+	CreatedAt          pgtype.Timestamp    `db:"created_at" json:"created_at"`
+	UpdatedAt          pgtype.Timestamp    `db:"updated_at" json:"updated_at"`
+} //	@name	WithdrawalWalletSchedule
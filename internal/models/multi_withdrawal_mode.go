@@ -7,6 +7,8 @@ const (
 	MultiWithdrawalModeDisabled   MultiWithdrawalMode = "disabled"
 	MultiWithdrawalModeProcessing MultiWithdrawalMode = "processing"
 	MultiWithdrawalModeManual     MultiWithdrawalMode = "manual"
+	// MultiWithdrawalModeSplit sends weighted shares of the sweep to several withdrawal addresses
+	MultiWithdrawalModeSplit MultiWithdrawalMode = "split"
 )
 
 func (w MultiWithdrawalMode) String() string {
@@ -17,6 +19,8 @@ func (w MultiWithdrawalMode) IsValidByCurrency(curr Currency) bool {
 	switch w {
 	case MultiWithdrawalModeDisabled:
 		return true
+	case MultiWithdrawalModeSplit:
+		return curr.Blockchain != nil
 	default:
 		return curr.Blockchain.IsBitcoinLike()
 	}
@@ -41,6 +41,14 @@ func (o NotificationType) Label() string {
 		return "User email change"
 	case NotificationTypeUserCryptoReceipt:
 		return "User crypto receipt"
+	case NotificationTypeExrateSourceStale:
+		return "Exchange rate source stale"
+	case NotificationTypeWithdrawalLimitExceeded:
+		return "Withdrawal limit exceeded"
+	case NotificationTypeAddressBookEntryAdded:
+		return "Address book entry added"
+	case NotificationTypeWithdrawalAllowlistDisabling:
+		return "Withdrawal allowlist disabling"
 	default:
 		return "Unknown Notification Type"
 	}
@@ -62,6 +70,10 @@ const (
 	NotificationTypeUserUpdateSetting              NotificationType = "user_update_setting_verification"
 	NotificationTypeUserTestEmail                  NotificationType = "user_test_email"
 	NotificationTypeUserCryptoReceipt              NotificationType = "user_crypto_receipt"
+	NotificationTypeExrateSourceStale              NotificationType = "exrate_source_stale"
+	NotificationTypeWithdrawalLimitExceeded        NotificationType = "withdrawal_limit_exceeded"
+	NotificationTypeAddressBookEntryAdded          NotificationType = "address_book_entry_added"
+	NotificationTypeWithdrawalAllowlistDisabling   NotificationType = "withdrawal_allowlist_disabling"
 )
 
 var validNotificationTypes = map[NotificationType]struct{}{
@@ -80,4 +92,8 @@ var validNotificationTypes = map[NotificationType]struct{}{
 	NotificationTypeUserUpdateSetting:              {},
 	NotificationTypeUserTestEmail:                  {},
 	NotificationTypeUserCryptoReceipt:              {},
+	NotificationTypeExrateSourceStale:              {},
+	NotificationTypeWithdrawalLimitExceeded:        {},
+	NotificationTypeAddressBookEntryAdded:          {},
+	NotificationTypeWithdrawalAllowlistDisabling:   {},
 }
@@ -0,0 +1,65 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type ToleranceType string //	@name	ToleranceType
+
+const (
+	ToleranceTypeAbsolute ToleranceType = "absolute"
+	ToleranceTypePercent  ToleranceType = "percent"
+)
+
+func (t ToleranceType) String() string {
+	return string(t)
+}
+
+type ToleranceRounding string //	@name	ToleranceRounding
+
+const (
+	ToleranceRoundingUp   ToleranceRounding = "up"
+	ToleranceRoundingDown ToleranceRounding = "down"
+	ToleranceRoundingHalf ToleranceRounding = "half"
+)
+
+func (r ToleranceRounding) String() string {
+	return string(r)
+}
+
+type LeftoverAction string //	@name	LeftoverAction
+
+const (
+	LeftoverActionRefundRequest     LeftoverAction = "refund_request"
+	LeftoverActionCreditNextInvoice LeftoverAction = "credit_next_invoice"
+	LeftoverActionIgnore            LeftoverAction = "ignore"
+)
+
+func (a LeftoverAction) String() string {
+	return string(a)
+}
+
+type PaymentOutcomeResult string //	@name	PaymentOutcomeResult
+
+const (
+	PaymentOutcomePaid      PaymentOutcomeResult = "paid"
+	PaymentOutcomeUnderpaid PaymentOutcomeResult = "underpaid"
+	PaymentOutcomeOverpaid  PaymentOutcomeResult = "overpaid"
+)
+
+func (r PaymentOutcomeResult) String() string {
+	return string(r)
+}
+
+// PaymentOutcome is the result of matching received amount against expected one under store tolerance policy
+type PaymentOutcome struct {
+	Result            PaymentOutcomeResult `json:"result"`
+	ExpectedAmountUSD decimal.Decimal      `json:"expected_amount_usd"`
+	ReceivedAmountUSD decimal.Decimal      `json:"received_amount_usd"`
+	DifferenceUSD     decimal.Decimal      `json:"difference_usd"`
+	WithinTolerance   bool                 `json:"within_tolerance"`
+	LeftoverUSD       decimal.Decimal      `json:"leftover_usd"`
+	LeftoverAction    *LeftoverAction      `json:"leftover_action,omitempty"`
+	InvoiceID         *uuid.UUID           `json:"invoice_id,omitempty"`
+} //	@name	PaymentOutcome
@@ -3,14 +3,19 @@ package models
 type RateSource string
 
 const (
-	RateSourceOKX     RateSource = "okx"
-	RateSourceHTX     RateSource = "htx"
-	RateSourceBinance RateSource = "binance"
-	RateSourceBitGet  RateSource = "bitget"
-	RateSourceKucoin  RateSource = "kucoin"
-	RateSourceBybit   RateSource = "bybit"
-	RateSourceGateio  RateSource = "gate"
-	RateSourceMexc    RateSource = "mexc"
+	RateSourceOKX      RateSource = "okx"
+	RateSourceHTX      RateSource = "htx"
+	RateSourceBinance  RateSource = "binance"
+	RateSourceBitGet   RateSource = "bitget"
+	RateSourceKucoin   RateSource = "kucoin"
+	RateSourceBybit    RateSource = "bybit"
+	RateSourceGateio   RateSource = "gate"
+	RateSourceMexc     RateSource = "mexc"
+	RateSourceKraken   RateSource = "kraken"
+	RateSourceCoinbase RateSource = "coinbase"
+
+	// RateSourceAggregate combines rates of all healthy sources
+	RateSourceAggregate RateSource = "aggregate"
 )
 
 func (rs RateSource) String() string {
@@ -35,6 +40,12 @@ func (rs RateSource) Valid() bool {
 		return true
 	case RateSourceMexc:
 		return true
+	case RateSourceKraken:
+		return true
+	case RateSourceCoinbase:
+		return true
+	case RateSourceAggregate:
+		return true
 	}
 
 	return false
@@ -0,0 +1,48 @@
+package models
+
+type RefundStatus string //	@name	RefundStatus
+
+const (
+	RefundStatusPendingApproval RefundStatus = "pending_approval"
+	RefundStatusApproved        RefundStatus = "approved"
+	RefundStatusProcessing      RefundStatus = "processing"
+	RefundStatusCompleted       RefundStatus = "completed"
+	RefundStatusRejected        RefundStatus = "rejected"
+	RefundStatusFailed          RefundStatus = "failed"
+	RefundStatusCancelled       RefundStatus = "cancelled"
+)
+
+func (s RefundStatus) String() string {
+	return string(s)
+}
+
+// IsActive reports whether the refund is still in flight and blocks new refunds of the same transaction
+func (s RefundStatus) IsActive() bool {
+	return s == RefundStatusPendingApproval || s == RefundStatusApproved || s == RefundStatusProcessing
+}
+
+func (s RefundStatus) Valid() bool {
+	switch s {
+	case RefundStatusPendingApproval,
+		RefundStatusApproved,
+		RefundStatusProcessing,
+		RefundStatusCompleted,
+		RefundStatusRejected,
+		RefundStatusFailed,
+		RefundStatusCancelled:
+		return true
+	}
+	return false
+}
+
+type RefundReason string //	@name	RefundReason
+
+const (
+	RefundReasonAMLBlocked  RefundReason = "aml_blocked"
+	RefundReasonOverpayment RefundReason = "overpayment"
+	RefundReasonManual      RefundReason = "manual"
+)
+
+func (r RefundReason) String() string {
+	return string(r)
+}
@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// ActivePreviousSecret returns replaced secret while its rotation window lasts
+func (s StoreSecret) ActivePreviousSecret() (string, bool) {
+	if !s.PreviousSecret.Valid || s.PreviousSecret.String == "" || !s.PreviousExpiresAt.Valid {
+		return "", false
+	}
+	if !time.Now().Before(s.PreviousExpiresAt.Time) {
+		return "", false
+	}
+
+	return s.PreviousSecret.String, true
+}
@@ -7,8 +7,52 @@ const (
 	WebhookEventPaymentNotConfirmed              WebhookEvent = "PaymentNotConfirmed"
 	WebhookEventWithdrawalFromProcessingReceived WebhookEvent = "WithdrawalFromProcessingReceived"
 	WebhookEventPaymentAMLBlocked                WebhookEvent = "PaymentAMLBlocked"
+	WebhookEventInvoiceStatusChanged             WebhookEvent = "InvoiceStatusChanged"
+	WebhookEventRefundStatusChanged              WebhookEvent = "RefundStatusChanged"
+	WebhookEventTransferStatusChanged            WebhookEvent = "TransferStatusChanged"
+	WebhookEventExchangeOrderFilled              WebhookEvent = "ExchangeOrderFilled"
+	WebhookEventExchangeWithdrawalStatusChanged  WebhookEvent = "ExchangeWithdrawalStatusChanged"
+	WebhookEventWalletAddressCreated             WebhookEvent = "WalletAddressCreated"
+	WebhookEventAMLCheckCompleted                WebhookEvent = "AMLCheckCompleted"
 )
 
+// WebhookEvents is the catalogue of events a store webhook can subscribe to
+var WebhookEvents = []WebhookEvent{
+	WebhookEventPaymentReceived,
+	WebhookEventPaymentNotConfirmed,
+	WebhookEventWithdrawalFromProcessingReceived,
+	WebhookEventPaymentAMLBlocked,
+	WebhookEventInvoiceStatusChanged,
+	WebhookEventRefundStatusChanged,
+	WebhookEventTransferStatusChanged,
+	WebhookEventExchangeOrderFilled,
+	WebhookEventExchangeWithdrawalStatusChanged,
+	WebhookEventWalletAddressCreated,
+	WebhookEventAMLCheckCompleted,
+}
+
 func (s WebhookEvent) String() string {
 	return string(s)
 }
+
+func (s WebhookEvent) Valid() bool {
+	for _, e := range WebhookEvents {
+		if s == e {
+			return true
+		}
+	}
+	return false
+}
+
+// IsStoreScoped reports whether the event belongs to a single store; other events are
+// account wide and delivered to every store of the owner subscribed to them
+func (s WebhookEvent) IsStoreScoped() bool {
+	switch s {
+	case WebhookEventTransferStatusChanged,
+		WebhookEventExchangeOrderFilled,
+		WebhookEventExchangeWithdrawalStatusChanged:
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,21 @@
+package models
+
+type WebhookRetryPolicyType string //	@name	WebhookRetryPolicyType
+
+const (
+	WebhookRetryPolicyFixed       WebhookRetryPolicyType = "fixed"
+	WebhookRetryPolicyExponential WebhookRetryPolicyType = "exponential"
+	WebhookRetryPolicyCustom      WebhookRetryPolicyType = "custom"
+)
+
+func (t WebhookRetryPolicyType) String() string {
+	return string(t)
+}
+
+func (t WebhookRetryPolicyType) Valid() bool {
+	switch t {
+	case WebhookRetryPolicyFixed, WebhookRetryPolicyExponential, WebhookRetryPolicyCustom:
+		return true
+	}
+	return false
+}
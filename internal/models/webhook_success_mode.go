@@ -0,0 +1,25 @@
+package models
+
+// WebhookSuccessMode defines which store webhook responses count as delivered
+type WebhookSuccessMode string //	@name	WebhookSuccessMode
+
+const (
+	// WebhookSuccessAny2xx accepts any 2xx status
+	WebhookSuccessAny2xx WebhookSuccessMode = "any_2xx"
+	// WebhookSuccessJSONBody accepts 2xx status with JSON body field equal to the expected value
+	WebhookSuccessJSONBody WebhookSuccessMode = "json_body"
+	// WebhookSuccessStatusCodes accepts only listed statuses
+	WebhookSuccessStatusCodes WebhookSuccessMode = "status_codes"
+)
+
+func (m WebhookSuccessMode) String() string {
+	return string(m)
+}
+
+func (m WebhookSuccessMode) Valid() bool {
+	switch m {
+	case WebhookSuccessAny2xx, WebhookSuccessJSONBody, WebhookSuccessStatusCodes:
+		return true
+	}
+	return false
+}
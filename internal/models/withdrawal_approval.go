@@ -0,0 +1,53 @@
+package models
+
+type WithdrawalApprovalStatus string //	@name	WithdrawalApprovalStatus
+
+const (
+	WithdrawalApprovalStatusPending  WithdrawalApprovalStatus = "pending"
+	WithdrawalApprovalStatusApproved WithdrawalApprovalStatus = "approved"
+	WithdrawalApprovalStatusExecuted WithdrawalApprovalStatus = "executed"
+	WithdrawalApprovalStatusFailed   WithdrawalApprovalStatus = "failed"
+	WithdrawalApprovalStatusRejected WithdrawalApprovalStatus = "rejected"
+	WithdrawalApprovalStatusExpired  WithdrawalApprovalStatus = "expired"
+)
+
+func (s WithdrawalApprovalStatus) String() string {
+	return string(s)
+}
+
+func (s WithdrawalApprovalStatus) Valid() bool {
+	switch s {
+	case WithdrawalApprovalStatusPending,
+		WithdrawalApprovalStatusApproved,
+		WithdrawalApprovalStatusExecuted,
+		WithdrawalApprovalStatusFailed,
+		WithdrawalApprovalStatusRejected,
+		WithdrawalApprovalStatusExpired:
+		return true
+	}
+	return false
+}
+
+// WithdrawalApprovalKind is the withdrawal operation held until approved
+type WithdrawalApprovalKind string //	@name	WithdrawalApprovalKind
+
+const (
+	WithdrawalApprovalKindFromAddress    WithdrawalApprovalKind = "from_address"
+	WithdrawalApprovalKindFromAddresses  WithdrawalApprovalKind = "from_addresses"
+	WithdrawalApprovalKindFromProcessing WithdrawalApprovalKind = "from_processing"
+)
+
+func (k WithdrawalApprovalKind) String() string {
+	return string(k)
+}
+
+type WithdrawalApprovalDecisionType string //	@name	WithdrawalApprovalDecisionType
+
+const (
+	WithdrawalApprovalDecisionApprove WithdrawalApprovalDecisionType = "approve"
+	WithdrawalApprovalDecisionReject  WithdrawalApprovalDecisionType = "reject"
+)
+
+func (d WithdrawalApprovalDecisionType) String() string {
+	return string(d)
+}
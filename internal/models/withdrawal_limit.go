@@ -0,0 +1,26 @@
+package models
+
+// WithdrawalLimitScope defines what withdrawals are summed up within the limit window
+type WithdrawalLimitScope string //	@name	WithdrawalLimitScope
+
+const (
+	WithdrawalLimitScopeUser        WithdrawalLimitScope = "user"
+	WithdrawalLimitScopeStore       WithdrawalLimitScope = "store"
+	WithdrawalLimitScopeCurrency    WithdrawalLimitScope = "currency"
+	WithdrawalLimitScopeDestination WithdrawalLimitScope = "destination"
+)
+
+func (s WithdrawalLimitScope) String() string {
+	return string(s)
+}
+
+func (s WithdrawalLimitScope) Valid() bool {
+	switch s {
+	case WithdrawalLimitScopeUser,
+		WithdrawalLimitScopeStore,
+		WithdrawalLimitScopeCurrency,
+		WithdrawalLimitScopeDestination:
+		return true
+	}
+	return false
+}
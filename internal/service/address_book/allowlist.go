@@ -0,0 +1,116 @@
+package address_book
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/notify"
+	"github.com/dv-net/dv-merchant/internal/service/setting"
+	"github.com/dv-net/dv-merchant/internal/storage/repos"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var ErrRevokeTokenNotFound = errors.New("address book entries for revoke token not found or the link has expired")
+
+// coolingSeconds is the delay before a new entry may be used as withdrawal destination
+func (s *Service) coolingSeconds() int32 {
+	return int32(s.allowlistCfg.CoolingPeriod.Seconds())
+}
+
+// revokeTTLSeconds is how long the revoke link from the notification stays valid
+func (s *Service) revokeTTLSeconds() int32 {
+	return int32(s.allowlistCfg.RevokeLinkTTL.Seconds())
+}
+
+// GetRevokeEntries returns address book entries the revoke token was issued for, it is shown before the entries are revoked
+func (s *Service) GetRevokeEntries(ctx context.Context, token uuid.UUID) ([]*models.UserAddressBook, error) {
+	entries, err := s.storage.UserAddressBook().GetByRevokeToken(ctx, uuid.NullUUID{UUID: token, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get address entries: %w", err)
+	}
+	if len(entries) == 0 {
+		return nil, ErrRevokeTokenNotFound
+	}
+
+	return entries, nil
+}
+
+// RevokeAddress removes every address book entry created by the request the revoke token was issued for,
+// withdrawal rules of the entries are removed as well. The token is used up by the revoke.
+func (s *Service) RevokeAddress(ctx context.Context, token uuid.UUID) error {
+	var entries []*models.UserAddressBook
+	err := repos.BeginTxFunc(ctx, s.storage.PSQLConn(), pgx.TxOptions{}, func(tx pgx.Tx) error {
+		var err error
+		entries, err = s.storage.UserAddressBook(repos.WithTx(tx)).RevokeByToken(ctx, uuid.NullUUID{UUID: token, Valid: true})
+		if err != nil {
+			return fmt.Errorf("failed to revoke address entries: %w", err)
+		}
+		if len(entries) == 0 {
+			return ErrRevokeTokenNotFound
+		}
+
+		usr, err := s.storage.Users(repos.WithTx(tx)).GetByID(ctx, entries[0].UserID)
+		if err != nil {
+			return fmt.Errorf("failed to get user: %w", err)
+		}
+
+		for _, entry := range entries {
+			if err := s.cleanupWithdrawalRule(ctx, usr, entry, tx); err != nil {
+				return fmt.Errorf("failed to cleanup withdrawal rule for entry %s: %w", entry.ID, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.logger.Infow("Revoked address book entries",
+		"user_id", entries[0].UserID,
+		"address", entries[0].Address,
+		"entries_count", len(entries))
+
+	return nil
+}
+
+func (s *Service) notifyAddressAdded(ctx context.Context, dto CreateAddressDTO, entry *models.UserAddressBook) {
+	usr, err := s.storage.Users().GetByID(ctx, dto.UserID)
+	if err != nil {
+		s.logger.Errorw("failed to get user for address book notification", "user_id", dto.UserID, "error", err)
+		return
+	}
+
+	entries, err := s.storage.UserAddressBook().GetByRevokeToken(ctx, uuid.NullUUID{UUID: dto.revokeToken, Valid: true})
+	if err != nil {
+		s.logger.Errorw("failed to get created address book entries", "user_id", dto.UserID, "error", err)
+		return
+	}
+
+	payload := &notify.AddressBookEntryAddedData{
+		Language:   usr.Language,
+		Address:    entry.Address,
+		Currencies: make([]string, 0, len(entries)),
+		ActiveAt:   entry.ActiveAt.Time.Format("2006-01-02T15:04:05Z"),
+	}
+	if entry.Name.Valid {
+		payload.Name = entry.Name.String
+	}
+	for _, e := range entries {
+		payload.Currencies = append(payload.Currencies, e.CurrencyID)
+	}
+
+	// without merchant domain the entry can still be removed from the address book
+	domain, err := s.settingService.GetRootSetting(ctx, setting.MerchantDomain)
+	if err == nil && domain != nil {
+		payload.RevokeLink = fmt.Sprintf("%s/api/v1/public/address-book/revoke/%s", domain.Value, dto.revokeToken)
+	}
+
+	s.notificationSvc.SendUser(ctx, models.NotificationTypeAddressBookEntryAdded, usr, payload, &models.NotificationArgs{
+		UserID: &usr.ID,
+	})
+}
@@ -249,9 +249,12 @@ func (s *Service) createEVMAddress(ctx context.Context, params CreateAddressDTO)
 
 func (s *Service) restoreAddressEntry(ctx context.Context, params CreateAddressDTO, opts ...repos.Option) (*models.UserAddressBook, error) {
 	restoreParams := repo_user_address_book.RestoreFromTrashParams{
-		UserID:     params.UserID,
-		Address:    params.Address,
-		CurrencyID: params.CurrencyID,
+		UserID:           params.UserID,
+		Address:          params.Address,
+		CurrencyID:       params.CurrencyID,
+		CoolingSeconds:   s.coolingSeconds(),
+		RevokeToken:      uuid.NullUUID{UUID: params.revokeToken, Valid: params.revokeToken != uuid.Nil},
+		RevokeTtlSeconds: s.revokeTTLSeconds(),
 	}
 
 	restoreParams.Type = models.AddressBookTypeSimple
@@ -286,10 +289,13 @@ func (s *Service) restoreAddressEntry(ctx context.Context, params CreateAddressD
 
 func (s *Service) createNewAddressEntry(ctx context.Context, params CreateAddressDTO, blockchain *models.Blockchain, opts ...repos.Option) (*models.UserAddressBook, error) {
 	createParams := repo_user_address_book.CreateParams{
-		UserID:     params.UserID,
-		Address:    params.Address,
-		CurrencyID: params.CurrencyID,
-		Blockchain: blockchain,
+		UserID:           params.UserID,
+		Address:          params.Address,
+		CurrencyID:       params.CurrencyID,
+		Blockchain:       blockchain,
+		CoolingSeconds:   s.coolingSeconds(),
+		RevokeToken:      uuid.NullUUID{UUID: params.revokeToken, Valid: params.revokeToken != uuid.Nil},
+		RevokeTtlSeconds: s.revokeTTLSeconds(),
 	}
 
 	createParams.Type = models.AddressBookTypeSimple
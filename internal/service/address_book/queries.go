@@ -53,6 +53,9 @@ func (s *Service) GetUserAddresses(ctx context.Context, usr *models.User) (*with
 		if entry.SubmittedAt.Valid {
 			resp.SubmittedAt = entry.SubmittedAt.Time.Format("2006-01-02T15:04:05Z")
 		}
+		if entry.ActiveAt.Valid {
+			resp.ActiveAt = entry.ActiveAt.Time.Format("2006-01-02T15:04:05Z")
+		}
 
 		// Check withdrawal rule status
 		if withdrawalRuleExists, err := s.CheckWithdrawalRuleExists(ctx, entry, usr); err == nil {
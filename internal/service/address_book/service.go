@@ -5,10 +5,13 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/dv-net/dv-merchant/internal/config"
 	"github.com/dv-net/dv-merchant/internal/delivery/http/responses/withdrawal_response"
 	"github.com/dv-net/dv-merchant/internal/models"
 	"github.com/dv-net/dv-merchant/internal/service/currency"
+	"github.com/dv-net/dv-merchant/internal/service/notify"
 	"github.com/dv-net/dv-merchant/internal/service/processing"
+	"github.com/dv-net/dv-merchant/internal/service/setting"
 	"github.com/dv-net/dv-merchant/internal/service/withdrawal_wallet"
 	"github.com/dv-net/dv-merchant/internal/storage"
 	"github.com/dv-net/dv-merchant/internal/storage/repos"
@@ -35,6 +38,8 @@ type IAddressBookService interface {
 	GetUniversalAddressGroup(ctx context.Context, userID uuid.UUID, address string, blockchain models.Blockchain) ([]*models.UserAddressBook, error)
 	AddWithdrawalRule(ctx context.Context, dto AddWithdrawalRuleDTO) error
 	CheckWithdrawalRuleExists(ctx context.Context, entry *models.UserAddressBook, usr *models.User) (bool, error)
+	GetRevokeEntries(ctx context.Context, token uuid.UUID) ([]*models.UserAddressBook, error)
+	RevokeAddress(ctx context.Context, token uuid.UUID) error
 }
 
 type CreateAddressDTO struct {
@@ -48,6 +53,9 @@ type CreateAddressDTO struct {
 	Blockchain           *models.Blockchain
 	CreateWithdrawalRule bool
 	TOTP                 string
+
+	// revokeToken is shared by all entries created by the same request
+	revokeToken uuid.UUID
 }
 
 type UpdateAddressDTO struct {
@@ -61,6 +69,9 @@ type Service struct {
 	currencyService         currency.ICurrency
 	withdrawalWalletService withdrawal_wallet.IWithdrawalWalletService
 	processingWalletService processing.IProcessingWallet
+	settingService          setting.ISettingService
+	notificationSvc         notify.INotificationService
+	allowlistCfg            config.WithdrawalAllowlist
 }
 
 func New(
@@ -69,6 +80,9 @@ func New(
 	currencyService currency.ICurrency,
 	withdrawalWalletService withdrawal_wallet.IWithdrawalWalletService,
 	processingWalletService processing.IProcessingWallet,
+	settingService setting.ISettingService,
+	notificationSvc notify.INotificationService,
+	allowlistCfg config.WithdrawalAllowlist,
 ) *Service {
 	return &Service{
 		storage:                 storage,
@@ -76,6 +90,9 @@ func New(
 		currencyService:         currencyService,
 		withdrawalWalletService: withdrawalWalletService,
 		processingWalletService: processingWalletService,
+		settingService:          settingService,
+		notificationSvc:         notificationSvc,
+		allowlistCfg:            allowlistCfg,
 	}
 }
 
@@ -84,13 +101,28 @@ func (s *Service) CreateAddress(ctx context.Context, dto CreateAddressDTO) (*mod
 	if err := s.ValidateCreateAddressDTO(ctx, dto); err != nil {
 		return nil, fmt.Errorf("address validation failed: %w", err)
 	}
-	if dto.EVM {
-		return s.createEVMAddress(ctx, dto)
+
+	dto.revokeToken = uuid.New()
+
+	var (
+		entry *models.UserAddressBook
+		err   error
+	)
+	switch {
+	case dto.EVM:
+		entry, err = s.createEVMAddress(ctx, dto)
+	case dto.Universal:
+		entry, err = s.createUniversalAddress(ctx, dto)
+	default:
+		entry, err = s.createSingleAddress(ctx, dto)
 	}
-	if dto.Universal {
-		return s.createUniversalAddress(ctx, dto)
+	if err != nil {
+		return nil, err
 	}
-	return s.createSingleAddress(ctx, dto)
+
+	s.notifyAddressAdded(ctx, dto, entry)
+
+	return entry, nil
 }
 
 // CheckWithdrawalRuleExists checks if a withdrawal rule exists and is active for a given address book entry
@@ -135,6 +167,9 @@ func (s *Service) toUniversalAddressGroupResponse(ctx context.Context, entries [
 			CurrencyID:           entry.CurrencyID,
 			WithdrawalRuleExists: withdrawalRuleExists,
 		}
+		if entry.ActiveAt.Valid {
+			currencies[i].ActiveAt = entry.ActiveAt.Time.Format("2006-01-02T15:04:05Z")
+		}
 	}
 
 	var submittedAt string
@@ -181,6 +216,9 @@ func (s *Service) toEVMAddressGroupResponse(ctx context.Context, entries []*mode
 			CurrencyID:           entry.CurrencyID,
 			WithdrawalRuleExists: withdrawalRuleExists,
 		}
+		if entry.ActiveAt.Valid {
+			currencies[i].ActiveAt = entry.ActiveAt.Time.Format("2006-01-02T15:04:05Z")
+		}
 	}
 
 	// Collect unique blockchains
@@ -0,0 +1,57 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/dv-net/dv-merchant/internal/event"
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/exrate"
+	"github.com/dv-net/dv-merchant/internal/service/notify"
+)
+
+// handleExrateSourceStale alerts root users that wallets quoted with the source are refused
+func (o *Service) handleExrateSourceStale(ev event.IEvent) error {
+	staleEv, ok := ev.(exrate.SourceStaleEvent)
+	if !ok {
+		return fmt.Errorf("invalid event type %s", ev.Type())
+	}
+
+	ctx := context.Background()
+
+	rootIDs, err := o.permissionService.RoleUsers(models.UserRoleRoot)
+	if err != nil {
+		return fmt.Errorf("fetch root users: %w", err)
+	}
+
+	payload := &notify.ExrateSourceStaleData{
+		Source: staleEv.Source,
+		MaxAge: staleEv.MaxAge.String(),
+	}
+	if !staleEv.UpdatedAt.IsZero() {
+		payload.UpdatedAt = staleEv.UpdatedAt.UTC().Format(time.RFC3339)
+	}
+
+	for _, rawID := range rootIDs {
+		id, err := uuid.Parse(rawID)
+		if err != nil {
+			continue
+		}
+
+		usr, err := o.storage.Users().GetByID(ctx, id)
+		if err != nil {
+			o.logger.Errorw("fetch root user for exrate alert", "error", err, "user_id", id)
+			continue
+		}
+
+		payload.Language = usr.Language
+		o.notificationSvc.SendUser(ctx, models.NotificationTypeExrateSourceStale, usr, payload, &models.NotificationArgs{
+			UserID: &usr.ID,
+		})
+	}
+
+	return nil
+}
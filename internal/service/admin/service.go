@@ -8,6 +8,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/dv-net/dv-merchant/internal/event"
+	"github.com/dv-net/dv-merchant/internal/service/exrate"
 	"github.com/dv-net/dv-merchant/internal/service/notify"
 	"github.com/dv-net/dv-merchant/internal/service/user"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_user_stores"
@@ -48,6 +50,7 @@ type Service struct {
 	permissionService permission.IPermission
 	userService       user.IUser
 	notificationSvc   notify.INotificationService
+	eventListener     event.IListener
 }
 
 var _ IAdmin = (*Service)(nil)
@@ -59,15 +62,21 @@ func New(
 	permissionService permission.IPermission,
 	userService user.IUser,
 	notificationSvc notify.INotificationService,
+	eventListener event.IListener,
 ) *Service {
-	return &Service{
+	srv := &Service{
 		cfg:               cfg,
 		storage:           storage,
 		logger:            logger,
 		permissionService: permissionService,
 		userService:       userService,
 		notificationSvc:   notificationSvc,
+		eventListener:     eventListener,
 	}
+
+	srv.eventListener.Register(exrate.SourceStaleEventType, srv.handleExrateSourceStale)
+
+	return srv
 }
 
 func (o *Service) GetAllUsersFiltered(ctx context.Context, req admin_request.GetUsersRequest) (*storecmn.FindResponseWithFullPagination[*admin_response.GetUsersResponse], error) {
@@ -3,6 +3,9 @@ package aml
 import (
 	"github.com/dv-net/dv-merchant/internal/event"
 	"github.com/dv-net/dv-merchant/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 )
 
 const CheckCompletedEventType = "aml_check_completed"
@@ -18,3 +21,21 @@ func (e CheckCompletedEvent) Type() event.Type {
 func (e CheckCompletedEvent) String() string {
 	return "aml_check_completed: " + e.Check.ID.String()
 }
+
+const DepositBlockedEventType = "aml_deposit_blocked"
+
+// DepositBlockedEvent is fired when a confirmed deposit is held back by store AML rules
+type DepositBlockedEvent struct {
+	TransactionID uuid.UUID
+	StoreID       uuid.UUID
+	Check         models.AmlCheck
+	DBTx          pgx.Tx
+}
+
+func (e DepositBlockedEvent) Type() event.Type {
+	return DepositBlockedEventType
+}
+
+func (e DepositBlockedEvent) String() string {
+	return "aml_deposit_blocked: " + e.TransactionID.String()
+}
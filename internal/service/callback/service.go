@@ -10,9 +10,13 @@ import (
 	"github.com/dv-net/dv-merchant/internal/event"
 	"github.com/dv-net/dv-merchant/internal/models"
 	"github.com/dv-net/dv-merchant/internal/service/currconv"
+	"github.com/dv-net/dv-merchant/internal/service/exrate"
+	"github.com/dv-net/dv-merchant/internal/service/outbox"
+	"github.com/dv-net/dv-merchant/internal/service/rate_quote"
 	"github.com/dv-net/dv-merchant/internal/service/receipts"
 	"github.com/dv-net/dv-merchant/internal/service/store"
 	"github.com/dv-net/dv-merchant/internal/service/transactions"
+	"github.com/dv-net/dv-merchant/internal/service/withdraw"
 	"github.com/dv-net/dv-merchant/internal/storage"
 	"github.com/dv-net/dv-merchant/internal/storage/repos"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_receipts"
@@ -44,6 +48,8 @@ type Service struct {
 	storeService                   store.IStore
 	currConvService                currconv.ICurrencyConvertor
 	receiptsService                receipts.IReceiptService
+	outbox                         outbox.IOutbox
+	rateQuotes                     rate_quote.IRateQuoteService
 }
 
 func New(
@@ -55,6 +61,8 @@ func New(
 	storeService store.IStore,
 	currConvService currconv.ICurrencyConvertor,
 	receiptsService receipts.IReceiptService,
+	outboxService outbox.IOutbox,
+	rateQuotes rate_quote.IRateQuoteService,
 ) ICallback {
 	return &Service{
 		log:                            logger,
@@ -65,6 +73,8 @@ func New(
 		storeService:                   storeService,
 		currConvService:                currConvService,
 		receiptsService:                receiptsService,
+		outbox:                         outboxService,
+		rateQuotes:                     rateQuotes,
 	}
 }
 
@@ -119,7 +129,13 @@ func (s *Service) HandleDepositCallback(ctx context.Context, dto DepositWebhookD
 			return fmt.Errorf("convert usd: %w", err)
 		}
 
-		if s.checkUnconfirmed(dto) {
+		// unconfirmed deposit is received again once confirmed, the quote is used up only by the confirmed one
+		unconfirmed := s.checkUnconfirmed(dto)
+		if usdAmount, err = s.lockedUSDAmount(ctx, dto, wallet, amount, usdAmount, !unconfirmed, repos.WithTx(tx)); err != nil {
+			return err
+		}
+
+		if unconfirmed {
 			return s.handleUnconfirmedDeposit(ctx, dto, storeData, wallet, amount, usdAmount, isDirty, tx)
 		}
 
@@ -127,6 +143,54 @@ func (s *Service) HandleDepositCallback(ctx context.Context, dto DepositWebhookD
 	})
 }
 
+// lockedUSDAmount values deposit at the rate quoted to the payer when it arrived before the quote expired,
+// only the quoted amount left by previous deposits is locked, use takes the locked part from it
+func (s *Service) lockedUSDAmount(
+	ctx context.Context,
+	dto DepositWebhookDto,
+	wallet *models.Wallet,
+	amount, usdAmount decimal.Decimal,
+	use bool,
+	opts ...repos.Option,
+) (decimal.Decimal, error) {
+	quote, err := s.rateQuotes.GetLocked(ctx, wallet.ID, dto.Currency.ID, dto.NetworkCreatedAt, opts...)
+	if err != nil {
+		if errors.Is(err, rate_quote.ErrQuoteNotFound) {
+			return usdAmount, nil
+		}
+		return decimal.Zero, fmt.Errorf("fetch locked rate: %w", err)
+	}
+
+	value, locked := lockedValue(amount, usdAmount, quote)
+	if !locked.IsPositive() {
+		return usdAmount, nil
+	}
+
+	if use {
+		if err = s.rateQuotes.Use(ctx, quote, locked, opts...); err != nil {
+			return decimal.Zero, err
+		}
+	}
+
+	s.log.Debugw("deposit valued at locked rate", "hash", dto.Hash, "quote_id", quote.ID, "rate", quote.Rate, "locked_amount", locked)
+
+	return value, nil
+}
+
+// lockedValue applies quoted rate up to the quoted amount left, excess is valued at the live rate of usdAmount.
+// The part of amount valued at the quoted rate is returned as well.
+func lockedValue(amount, usdAmount decimal.Decimal, quote *models.RateQuote) (decimal.Decimal, decimal.Decimal) {
+	if !quote.Amount.Valid || !amount.IsPositive() {
+		return usdAmount, decimal.Zero
+	}
+
+	left := decimal.Max(quote.Amount.Decimal.Sub(quote.UsedAmount), decimal.Zero)
+	locked := decimal.Min(amount, left)
+	excess := amount.Sub(locked)
+
+	return locked.Mul(quote.Rate).Add(usdAmount.Mul(excess).Div(amount)), locked
+}
+
 func (s *Service) handleUnconfirmedDeposit(
 	ctx context.Context,
 	dto DepositWebhookDto,
@@ -145,16 +209,15 @@ func (s *Service) handleUnconfirmedDeposit(
 		return nil
 	}
 
-	if err = s.eventListener.Fire(transactions.DepositUnconfirmedEvent{
+	if err = s.outbox.Enqueue(ctx, tx, transactions.DepositUnconfirmedEvent{
 		Tx:              *uTransaction,
 		Store:           *storeData,
 		Currency:        *dto.Currency,
 		StoreExternalID: wallet.StoreExternalID,
 		WebhookEvent:    models.WebhookEventPaymentNotConfirmed,
-		DBTx:            tx,
 	}); err != nil {
-		s.log.Errorw("eventListener fire error", "error", err)
-		return fmt.Errorf("eventListener fire error: %w", err)
+		s.log.Errorw("outbox enqueue error", "error", err)
+		return fmt.Errorf("outbox enqueue error: %w", err)
 	}
 
 	return nil
@@ -228,31 +291,29 @@ func (s *Service) handleConfirmedDeposit(
 		return nil
 	}
 
-	if err = s.eventListener.Fire(transactions.DepositReceivedEvent{
+	if err = s.outbox.Enqueue(ctx, tx, transactions.DepositReceivedEvent{
 		Tx:              *transaction,
 		Store:           *storeData,
 		Currency:        *dto.Currency,
 		StoreExternalID: wallet.StoreExternalID,
 		WebhookEvent:    models.WebhookEventPaymentReceived,
-		DBTx:            tx,
 	}); err != nil {
-		s.log.Errorw("eventListener fire error", "error", err)
-		return fmt.Errorf("eventListener fire error: %w", err)
+		s.log.Errorw("outbox enqueue error", "error", err)
+		return fmt.Errorf("outbox enqueue error: %w", err)
 	}
 
-	if err = s.eventListener.Fire(transactions.DepositReceiptSentEvent{
+	if err = s.outbox.Enqueue(ctx, tx, transactions.DepositReceiptSentEvent{
 		Tx:              *transaction,
 		Store:           *storeData,
 		Currency:        *dto.Currency,
 		StoreExternalID: wallet.StoreExternalID,
-		DBTx:            tx,
 		WalletEmail:     resolveUserEmail(wallet),
 		WalletLocale:    wallet.Locale,
 		ExchangeRate:    exchangeRate,
 		UsdFee:          usdFee,
 	}); err != nil {
-		s.log.Errorw("eventListener fire error", "error", err)
-		return fmt.Errorf("eventListener fire error: %w", err)
+		s.log.Errorw("outbox enqueue error", "error", err)
+		return fmt.Errorf("outbox enqueue error: %w", err)
 	}
 
 	return nil
@@ -270,14 +331,25 @@ func (s *Service) convertFeeAndExchangeRate(
 		return decimal.Zero, exchangeRate, nil
 	}
 
-	usdFee, err = s.currConvService.Convert(ctx, currconv.ConvertDTO{
+	convertDTO := currconv.ConvertDTO{
 		Source:     store.RateSource.String(),
 		From:       dto.Currency.Code,
 		To:         models.CurrencyCodeUSDT,
 		Amount:     fee.String(),
 		StableCoin: dto.Currency.IsStablecoin,
 		Scale:      &store.RateScale,
-	})
+	}
+	if !dto.NetworkCreatedAt.IsZero() {
+		convertDTO.At = &dto.NetworkCreatedAt
+	}
+
+	usdFee, err = s.currConvService.Convert(ctx, convertDTO)
+	if rateNotFound := new(exrate.ExchangeRateNotFoundError); convertDTO.At != nil && errors.As(err, &rateNotFound) {
+		// no snapshot around network time yet, fee is priced with the current rate
+		s.log.Warnw("historical rate not found, using current rate", "error", err, "hash", dto.Hash)
+		convertDTO.At = nil
+		usdFee, err = s.currConvService.Convert(ctx, convertDTO)
+	}
 	if err != nil {
 		return decimal.Zero, decimal.Zero, fmt.Errorf("convert usd fee: %w", err)
 	}
@@ -472,13 +544,12 @@ func (s *Service) handleConfirmedProcessingTransfer(ctx context.Context, dto Tra
 		return fmt.Errorf("transaction creation: %w", err)
 	}
 
-	return s.eventListener.Fire(transactions.WithdrawalFromProcessingReceivedEvent{
+	return s.outbox.Enqueue(ctx, tx, transactions.WithdrawalFromProcessingReceivedEvent{
 		WithdrawalID: withdrawalData.WithdrawalFromProcessingWallet.ID.String(),
 		Tx:           *createdTx,
 		Store:        *storeData,
 		Currency:     *dto.Currency,
 		WebhookEvent: models.WebhookEventWithdrawalFromProcessingReceived,
-		DBTx:         tx,
 	})
 }
 
@@ -611,7 +682,12 @@ func (s *Service) prepareCreateTxParamsByHotWallet(
 
 func (s *Service) HandleUpdateTransferStatusCallback(ctx context.Context, dto processing_request.TransferStatusWebhook) error {
 	return repos.BeginTxFunc(ctx, s.storage.PSQLConn(), pgx.TxOptions{}, func(tx pgx.Tx) error {
-		if err := s.storage.Transfers(repos.WithTx(tx)).UpdateTransferStatus(ctx, repo_transfers.UpdateTransferStatusParams{
+		current, err := s.storage.Transfers(repos.WithTx(tx)).GetById(ctx, *dto.RequestID)
+		if err != nil {
+			return fmt.Errorf("fetch transfer: %w", err)
+		}
+
+		if err = s.storage.Transfers(repos.WithTx(tx)).UpdateTransferStatus(ctx, repo_transfers.UpdateTransferStatusParams{
 			Status:  dto.Status,
 			Stage:   models.ResolveTransferStageByStatus(dto.Status),
 			Step:    util.Pointer(dto.Step),
@@ -621,6 +697,12 @@ func (s *Service) HandleUpdateTransferStatusCallback(ctx context.Context, dto pr
 			return fmt.Errorf("update status callback: %w", err)
 		}
 
+		if current.Status != dto.Status {
+			if err = s.fireTransferStatusChanged(ctx, current.Status, *dto.RequestID, tx); err != nil {
+				return err
+			}
+		}
+
 		if len(dto.SystemTransactions) < 1 {
 			return nil
 		}
@@ -671,6 +753,23 @@ func (s *Service) HandleUpdateTransferStatusCallback(ctx context.Context, dto pr
 	})
 }
 
+func (s *Service) fireTransferStatusChanged(ctx context.Context, from models.TransferStatus, transferID uuid.UUID, tx pgx.Tx) error {
+	transfer, err := s.storage.Transfers(repos.WithTx(tx)).GetById(ctx, transferID)
+	if err != nil {
+		return fmt.Errorf("fetch updated transfer: %w", err)
+	}
+
+	if err = s.eventListener.Fire(withdraw.TransferStatusChangedEvent{
+		Transfer:       *transfer,
+		PreviousStatus: from,
+		DBTx:           tx,
+	}); err != nil {
+		return fmt.Errorf("fire transfer status changed: %w", err)
+	}
+
+	return nil
+}
+
 func (s *Service) createUnconfirmedTransaction(
 	ctx context.Context,
 	dto DepositWebhookDto,
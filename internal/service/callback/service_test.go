@@ -0,0 +1,46 @@
+package callback
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+)
+
+func TestLockedValue(t *testing.T) {
+	quote := &models.RateQuote{
+		Rate:   decimal.RequireFromString("100"),
+		Amount: decimal.NullDecimal{Decimal: decimal.RequireFromString("2"), Valid: true},
+	}
+
+	partlyUsed := *quote
+	partlyUsed.UsedAmount = decimal.RequireFromString("1.5")
+	usedUp := *quote
+	usedUp.UsedAmount = decimal.RequireFromString("2")
+
+	tests := []struct {
+		name      string
+		quote     *models.RateQuote
+		amount    string
+		usdAmount string
+		expected  string
+		locked    string
+	}{
+		{name: "below quoted amount", quote: quote, amount: "1.5", usdAmount: "135", expected: "150", locked: "1.5"},
+		{name: "exactly quoted amount", quote: quote, amount: "2", usdAmount: "180", expected: "200", locked: "2"},
+		{name: "above quoted amount", quote: quote, amount: "5", usdAmount: "450", expected: "470", locked: "2"},
+		{name: "partly used quote", quote: &partlyUsed, amount: "1.5", usdAmount: "135", expected: "140", locked: "0.5"},
+		{name: "used up quote", quote: &usedUp, amount: "1", usdAmount: "90", expected: "90", locked: "0"},
+		{name: "quote without amount", quote: &models.RateQuote{Rate: quote.Rate}, amount: "5", usdAmount: "450", expected: "450", locked: "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, locked := lockedValue(decimal.RequireFromString(tt.amount), decimal.RequireFromString(tt.usdAmount), tt.quote)
+			require.Equal(t, tt.expected, got.String())
+			require.Equal(t, tt.locked, locked.String())
+		})
+	}
+}
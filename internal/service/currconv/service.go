@@ -2,9 +2,12 @@ package currconv
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/dv-net/dv-merchant/internal/models"
 	"github.com/dv-net/dv-merchant/internal/service/exrate"
 
 	"github.com/shopspring/decimal"
@@ -29,6 +32,8 @@ type ConvertDTO struct {
 	Amount     string
 	StableCoin bool
 	Scale      *decimal.Decimal
+	// At converts with rate snapshotted at given time instead of the current one
+	At *time.Time
 }
 
 var _ ICurrencyConvertor = (*service)(nil)
@@ -42,11 +47,52 @@ func (srv *service) Convert(ctx context.Context, dto ConvertDTO) (v decimal.Deci
 		return v, err
 	}
 
+	if from == to {
+		return amountDec, nil
+	}
+
+	// fiat currencies other than USD are converted through USD with fiat reference rates
+	toPerUSD, toFiat, err := srv.fiatPerUSD(ctx, to, dto.At)
+	if err != nil {
+		return v, err
+	}
+	fromPerUSD, fromFiat, err := srv.fiatPerUSD(ctx, from, dto.At)
+	if err != nil {
+		return v, err
+	}
+
+	if !toFiat && !fromFiat {
+		return srv.convert(ctx, dto, from, to, amountDec)
+	}
+
+	amountUSD := amountDec
+	if fromFiat {
+		amountUSD = amountDec.Div(fromPerUSD)
+	} else if amountUSD, err = srv.convert(ctx, dto, from, models.CurrencyCodeUSD, amountDec); err != nil {
+		return v, err
+	}
+
+	if toFiat {
+		return amountUSD.Mul(toPerUSD), nil
+	}
+
+	return srv.convert(ctx, dto, models.CurrencyCodeUSD, to, amountUSD)
+}
+
+// convert converts amount with rate of the source
+func (srv *service) convert(ctx context.Context, dto ConvertDTO, from, to string, amountDec decimal.Decimal) (v decimal.Decimal, err error) {
 	if from == to || dto.StableCoin {
 		return amountDec, nil
 	}
 	var rate string
-	if rate, err = srv.exrateSrc.GetCurrencyRate(ctx, dto.Source, from, to); err != nil {
+	if dto.At != nil {
+		var historical *exrate.ExRate
+		if historical, err = srv.exrateSrc.GetRateAt(ctx, dto.Source, from, to, *dto.At); err != nil {
+			err = fmt.Errorf("get currency rate at %s: %w", dto.At.Format(time.RFC3339), err)
+			return v, err
+		}
+		rate = historical.Value
+	} else if rate, err = srv.exrateSrc.GetCurrencyRate(ctx, dto.Source, from, to); err != nil {
 		err = fmt.Errorf("get currency rate: %w", err)
 		return v, err
 	}
@@ -64,3 +110,29 @@ func (srv *service) Convert(ctx context.Context, dto ConvertDTO) (v decimal.Deci
 	result := amountDec.Mul(rateDec)
 	return result, nil
 }
+
+// fiatPerUSD returns units of fiat currency per one USD, ok is false for USD pegged and crypto currencies
+func (srv *service) fiatPerUSD(ctx context.Context, code string, at *time.Time) (decimal.Decimal, bool, error) {
+	if _, ok := models.CurrencyCodeStableSet()[code]; ok {
+		return decimal.Zero, false, nil
+	}
+
+	rate, err := srv.exrateSrc.GetFiatRate(ctx, models.CurrencyCodeUSD, code, at)
+	if err != nil {
+		var notFound *exrate.ExchangeRateNotFoundError
+		if errors.As(err, &notFound) {
+			return decimal.Zero, false, nil
+		}
+		return decimal.Zero, false, fmt.Errorf("get fiat rate: %w", err)
+	}
+
+	rateDec, err := decimal.NewFromString(rate)
+	if err != nil {
+		return decimal.Zero, false, fmt.Errorf("parse fiat rate to decimal: %w", err)
+	}
+	if !rateDec.IsPositive() {
+		return decimal.Zero, false, fmt.Errorf("invalid fiat rate %s for %s", rate, code)
+	}
+
+	return rateDec, true, nil
+}
@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -65,6 +66,27 @@ func (o *Service) TestConnection(ctx context.Context) error {
 	return nil
 }
 
+func (o *Service) GetKeyPermissions(ctx context.Context) (*models.KeyPermissionsDTO, error) {
+	res, err := o.exClient.Wallet().GetAPIRestrictions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	perms := &models.KeyPermissionsDTO{
+		CanTrade:         lo.ToPtr(res.Data.EnableSpotAndMarginTrading),
+		CanWithdraw:      lo.ToPtr(res.Data.EnableWithdrawals),
+		CanTransfer:      lo.ToPtr(res.Data.PermitsUniversalTransfer),
+		IPRestricted:     lo.ToPtr(res.Data.IPRestrict),
+		TransferRequired: true,
+	}
+	// trading right of keys without ip restriction is time limited
+	if res.Data.TradingAuthorityExpirationTime > 0 {
+		perms.ExpiresAt = lo.ToPtr(time.UnixMilli(res.Data.TradingAuthorityExpirationTime).UTC())
+	}
+
+	return perms, nil
+}
+
 type UniversalBalanceDTO struct {
 	Balance decimal.Decimal `json:"balance"`
 	Ccy     string          `json:"ccy"`
@@ -464,6 +486,137 @@ func (o *Service) CreateSpotOrder(ctx context.Context, from string, to string, s
 	}, nil
 }
 
+func (o *Service) CreateLimitOrder(ctx context.Context, args *models.CreateLimitOrderParams) (*models.ExchangeOrderDTO, error) {
+	orderID, err := uuid.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+	clientOrderID := strings.ReplaceAll(orderID.String(), "-", "")
+
+	symbolsData, err := o.exClient.MarketData().GetExchangeInfo(ctx, &binancerequests.GetExchangeInfoRequest{
+		Symbol: args.Symbol,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(symbolsData.Symbols) == 0 {
+		return nil, fmt.Errorf("symbol %s not found", args.Symbol)
+	}
+	symbolData := symbolsData.Symbols[0]
+	if symbolData.Status != binancemodels.SymbolStatusTrading {
+		return nil, fmt.Errorf("symbol %s cannot be traded with status %s", args.Symbol, symbolData.Status.String())
+	}
+
+	filters, err := utils.ExtractMarketFilters(symbolData.Filters)
+	if err != nil {
+		return nil, err
+	}
+
+	price := args.Price
+	if filters.PriceFilter != nil {
+		tickSize, err := decimal.NewFromString(filters.PriceFilter.TickSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse tick size: %w", err)
+		}
+		price = utils.FloorToStep(args.Price, tickSize)
+		if args.Side == models.OrderSideSell {
+			price = utils.CeilToStep(args.Price, tickSize)
+		}
+	}
+
+	qty := args.Amount
+	if filters.LotSizeFilter != nil {
+		qty = utils.FloorToStep(args.Amount, filters.LotSizeFilter.StepSize)
+		if qty.LessThan(filters.LotSizeFilter.MinQty) {
+			return nil, exchangeclient.ErrMinOrderValue
+		}
+	}
+	if filters.NotionalFilter != nil && qty.Mul(price).LessThan(filters.NotionalFilter.MinNotional) {
+		return nil, exchangeclient.ErrMinOrderValue
+	}
+
+	asset, spent := symbolData.BaseAsset, qty
+	if args.Side == models.OrderSideBuy {
+		asset, spent = symbolData.QuoteAsset, qty.Mul(price)
+	}
+	if err := o.topUpSpot(ctx, asset, spent); err != nil {
+		return nil, err
+	}
+
+	order, err := o.exClient.Spot().NewOrder(ctx, &binancerequests.NewOrderRequest{
+		Symbol:           args.Symbol,
+		Side:             strings.ToUpper(args.Side.String()),
+		Type:             binancemodels.OrderTypeLimit.String(),
+		TimeInForce:      strings.ToUpper(args.TimeInForce.String()),
+		Quantity:         qty.String(),
+		Price:            price.String(),
+		NewClientOrderId: clientOrderID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.ExchangeOrderDTO{
+		ExchangeOrderID: strconv.Itoa(order.OrderId),
+		ClientOrderID:   order.ClientOrderId,
+		Amount:          qty,
+	}, nil
+}
+
+// topUpSpot moves missing part of amount from funding to spot wallet
+func (o *Service) topUpSpot(ctx context.Context, asset string, amount decimal.Decimal) error {
+	spotBalances, err := o.exClient.Wallet().GetSpotAssets(ctx, &binancerequests.GetSpotAssetsRequest{
+		Asset: asset,
+	})
+	if err != nil {
+		return err
+	}
+
+	missing := amount.Sub(o.getSpotBalance(asset, spotBalances.Data))
+	if !missing.IsPositive() {
+		return nil
+	}
+
+	fundingBalances, err := o.exClient.Wallet().GetFundingAssets(ctx, &binancerequests.GetFundingAssetsRequest{
+		Asset: asset,
+	})
+	if err != nil {
+		return err
+	}
+
+	missing = decimal.Min(missing, o.getFundingBalance(asset, fundingBalances.Data))
+	if !missing.IsPositive() {
+		return exchangeclient.ErrInsufficientBalance
+	}
+
+	if _, err = o.exClient.Wallet().UniversalTransfer(ctx, &binancerequests.UniversalTransferRequest{
+		Type:   binancemodels.TransferTypeFundingToSpot,
+		Asset:  asset,
+		Amount: missing.String(),
+	}); err != nil {
+		return fmt.Errorf("failed to transfer funds: %w", err)
+	}
+
+	return nil
+}
+
+func (o *Service) CancelOrder(ctx context.Context, args *models.CancelOrderParams) error {
+	req := &binancerequests.CancelOrderRequest{
+		Symbol:            args.Symbol,
+		OrigClientOrderId: args.ClientOrderID,
+	}
+	if args.ExternalOrderID != "" {
+		orderID, err := strconv.ParseInt(args.ExternalOrderID, 10, 64)
+		if err != nil {
+			return err
+		}
+		req.OrderID = orderID
+	}
+
+	_, err := o.exClient.Spot().CancelOrder(ctx, req)
+	return err
+}
+
 func (o *Service) getSpotBalance(symbol string, spot []binancemodels.AssetBalance) decimal.Decimal {
 	spotAmount := decimal.Zero
 	spotBalance, exists := lo.Find(spot, func(item binancemodels.AssetBalance) bool {
@@ -703,7 +856,9 @@ func (o *Service) GetOrderDetails(ctx context.Context, args *models.GetOrderByID
 	switch res.Status {
 	case binancemodels.OrderStatusFilled.String():
 		order.State = models.ExchangeOrderStatusCompleted
-	case binancemodels.OrderStatusCanceled.String(), binancemodels.OrderStatusRejected.String(), binancemodels.OrderStatusExpired.String():
+	case binancemodels.OrderStatusCanceled.String(), binancemodels.OrderStatusExpired.String(), binancemodels.OrderStatusExpiredInMatch.String():
+		order.State = models.ExchangeOrderStatusCancelled
+	case binancemodels.OrderStatusRejected.String():
 		order.State = models.ExchangeOrderStatusFailed
 	default:
 		order.State = models.ExchangeOrderStatusInProgress
@@ -788,6 +943,24 @@ func (o *Service) GetOrderRules(ctx context.Context, tickers ...string) ([]*mode
 	return rules, nil
 }
 
+func (o *Service) GetTickerPrice(ctx context.Context, ticker string) (*models.TickerPriceDTO, error) {
+	res, err := o.exClient.MarketData().GetSymbolBookTicker(ctx, &binancerequests.GetSymbolBookTickerRequest{
+		Symbol: ticker,
+	})
+	if err != nil {
+		return nil, err
+	}
+	bid, err := decimal.NewFromString(res.BidPrice)
+	if err != nil {
+		return nil, fmt.Errorf("parse bid price: %w", err)
+	}
+	ask, err := decimal.NewFromString(res.AskPrice)
+	if err != nil {
+		return nil, fmt.Errorf("parse ask price: %w", err)
+	}
+	return &models.TickerPriceDTO{Symbol: ticker, Bid: bid, Ask: ask}, nil
+}
+
 func (o *Service) GetConnectionHash() string {
 	return o.connHash
 }
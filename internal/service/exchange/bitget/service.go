@@ -30,7 +30,7 @@ import (
 )
 
 var (
-	ErrInsufficientBalance         = errors.New("insufficient balance")
+	ErrInsufficientBalance         = exchangeclient.ErrInsufficientBalance
 	ErrUnprocessableCurrencyStatus = errors.New("unprocessable currency status")
 	ErrMaxOrderValueReached        = errors.New("max order value reached")
 )
@@ -83,6 +83,23 @@ func (o *Service) TestConnection(ctx context.Context) error {
 	return nil
 }
 
+// GetKeyPermissions accepts both plain authority names and scoped ones (stow for spot trade, wow for withdrawals)
+func (o *Service) GetKeyPermissions(ctx context.Context) (*models.KeyPermissionsDTO, error) {
+	res, err := o.exClient.Spot().Account().AccountInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if res.Data == nil {
+		return nil, fmt.Errorf("empty account info")
+	}
+
+	return &models.KeyPermissionsDTO{
+		CanTrade:     lo.ToPtr(lo.ContainsBy(res.Data.Authorities, func(item string) bool { return item == "trade" || item == "stow" })),
+		CanWithdraw:  lo.ToPtr(lo.ContainsBy(res.Data.Authorities, func(item string) bool { return item == "withdraw" || item == "wow" })),
+		IPRestricted: lo.ToPtr(strings.TrimSpace(res.Data.IPs) != ""),
+	}, nil
+}
+
 func (o *Service) GetAccountBalance(ctx context.Context) ([]*models.AccountBalanceDTO, error) {
 	enabledCurrencies, err := o.storage.ExchangeChains().GetEnabledCurrencies(ctx, models.ExchangeSlugBitget)
 	if err != nil {
@@ -344,7 +361,9 @@ func (o *Service) CreateSpotOrder(ctx context.Context, from string, to string, s
 		if err != nil {
 			return nil, err
 		}
-		baseBalance = res.Data[0].Available
+		if len(res.Data) > 0 {
+			baseBalance = res.Data[0].Available
+		}
 	}
 	{
 		res, err := o.exClient.Spot().Account().AccountAssets(ctx, &bitgetrequests.AccountAssetsRequest{
@@ -354,7 +373,9 @@ func (o *Service) CreateSpotOrder(ctx context.Context, from string, to string, s
 		if err != nil {
 			return nil, err
 		}
-		quoteBalance = res.Data[0].Available
+		if len(res.Data) > 0 {
+			quoteBalance = res.Data[0].Available
+		}
 	}
 
 	orderMinimumBase, err := decimal.NewFromString(rule.MinOrderAmount)
@@ -406,6 +427,83 @@ func (o *Service) CreateSpotOrder(ctx context.Context, from string, to string, s
 	return order, nil
 }
 
+func (o *Service) CreateLimitOrder(ctx context.Context, args *models.CreateLimitOrderParams) (*models.ExchangeOrderDTO, error) {
+	orderID, err := uuid.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := o.exClient.Spot().Market().SymbolInformation(ctx, &bitgetrequests.SymbolInformationRequest{
+		Symbol: args.Symbol,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Data) == 0 {
+		return nil, fmt.Errorf("symbol %s not found", args.Symbol)
+	}
+	symbol := res.Data[0]
+
+	if symbol.Status != bitgetmodels.SymbolStatusOnline {
+		return nil, fmt.Errorf("%w: symbol is not online", ErrUnprocessableCurrencyStatus)
+	}
+
+	price := args.Price.RoundFloor(int32(symbol.PricePrecision)) //nolint:gosec
+	if args.Side == models.OrderSideSell {
+		price = args.Price.RoundCeil(int32(symbol.PricePrecision)) //nolint:gosec
+	}
+	qty := args.Amount.RoundDown(int32(symbol.QuantityPrecision)) //nolint:gosec
+	if qty.LessThan(symbol.MinTradeAmount) {
+		return nil, exchangeclient.ErrMinOrderValue
+	}
+	if symbol.QuoteCoin == "USDT" && qty.Mul(price).LessThan(symbol.MinTradeUSDT) {
+		return nil, exchangeclient.ErrMinOrderValue
+	}
+
+	coin, spent := symbol.BaseCoin, qty
+	if args.Side == models.OrderSideBuy {
+		coin, spent = symbol.QuoteCoin, qty.Mul(price)
+	}
+	assets, err := o.exClient.Spot().Account().AccountAssets(ctx, &bitgetrequests.AccountAssetsRequest{
+		Coin:      coin,
+		AssetType: "all",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(assets.Data) == 0 || assets.Data[0].Available.LessThan(spent) {
+		return nil, exchangeclient.ErrInsufficientBalance
+	}
+
+	placedOrder, err := o.exClient.Spot().Trade().PlaceOrder(ctx, &bitgetrequests.PlaceOrderRequest{
+		Symbol:    symbol.Symbol,
+		Side:      bitgetmodels.OrderSide(args.Side.String()),
+		OrderType: bitgetmodels.OrderTypeLimit,
+		Force:     bitgetmodels.OrderForce(args.TimeInForce.String()),
+		Price:     price.String(),
+		Size:      qty.String(),
+		ClientOID: orderID.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to place order: %w", err)
+	}
+
+	return &models.ExchangeOrderDTO{
+		ExchangeOrderID: placedOrder.Data.OrderID,
+		ClientOrderID:   orderID.String(),
+		Amount:          qty,
+	}, nil
+}
+
+func (o *Service) CancelOrder(ctx context.Context, args *models.CancelOrderParams) error {
+	_, err := o.exClient.Spot().Trade().CancelOrder(ctx, &bitgetrequests.CancelOrderRequest{
+		Symbol:    args.Symbol,
+		OrderID:   args.ExternalOrderID,
+		ClientOID: args.ClientOrderID,
+	})
+	return err
+}
+
 func (o *Service) GetOrderRule(ctx context.Context, symbol string) (*models.OrderRulesDTO, error) {
 	symbolData, err := o.exClient.Spot().Market().SymbolInformation(ctx, &bitgetrequests.SymbolInformationRequest{
 		Symbol: symbol,
@@ -539,7 +637,7 @@ func (o *Service) getOrderDetails(ctx context.Context, symbol *string, order *mo
 	case bitgetmodels.OrderStatusLive:
 		order.State = models.ExchangeOrderStatusInProgress
 	case bitgetmodels.OrderStatusCanceled:
-		order.State = models.ExchangeOrderStatusFailed
+		order.State = models.ExchangeOrderStatusCancelled
 	case bitgetmodels.OrderStatusPartiallyFilled:
 		order.State = models.ExchangeOrderStatusInProgress
 	default:
@@ -646,6 +744,19 @@ func (o *Service) GetOrderRules(ctx context.Context, tickers ...string) ([]*mode
 	return rules, nil
 }
 
+func (o *Service) GetTickerPrice(ctx context.Context, ticker string) (*models.TickerPriceDTO, error) {
+	res, err := o.exClient.Spot().Market().TickerInformation(ctx, &bitgetrequests.TickerInformationRequest{
+		Symbol: ticker,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Data) == 0 {
+		return nil, fmt.Errorf("ticker %s not found", ticker)
+	}
+	return &models.TickerPriceDTO{Symbol: ticker, Bid: res.Data[0].BidPr, Ask: res.Data[0].AskPr}, nil
+}
+
 func (o *Service) GetConnectionHash() string {
 	return o.connHash
 }
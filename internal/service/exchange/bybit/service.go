@@ -31,7 +31,7 @@ import (
 )
 
 var (
-	ErrInsufficientBalance         = errors.New("insufficient balance")
+	ErrInsufficientBalance         = exchangeclient.ErrInsufficientBalance
 	ErrUnprocessableCurrencyStatus = errors.New("unprocessable currency status")
 	ErrMaxOrderValueReached        = errors.New("max order value reached")
 )
@@ -96,6 +96,29 @@ func (o *Service) TestConnection(ctx context.Context) error {
 	return nil
 }
 
+func (o *Service) GetKeyPermissions(ctx context.Context) (*models.KeyPermissionsDTO, error) {
+	res, err := o.exClient.Account().GetAPIKeyInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	info := res.Result
+	writable := info.ReadOnly == 0
+	perms := &models.KeyPermissionsDTO{
+		CanTrade:         lo.ToPtr(writable && lo.Contains(info.Permissions["Spot"], "SpotTrade")),
+		CanWithdraw:      lo.ToPtr(writable && lo.Contains(info.Permissions["Wallet"], "Withdraw")),
+		CanTransfer:      lo.ToPtr(writable && lo.Contains(info.Permissions["Wallet"], "AccountTransfer")),
+		IPRestricted:     lo.ToPtr(len(info.IPs) > 0 && !lo.Contains(info.IPs, "*")),
+		TransferRequired: true,
+	}
+	// keys without bound ip expire, expiredAt is empty for the rest
+	if expiresAt, err := time.Parse(time.RFC3339, info.ExpiredAt); err == nil && !expiresAt.IsZero() {
+		perms.ExpiresAt = &expiresAt
+	}
+
+	return perms, nil
+}
+
 func (o *Service) GetAccountBalance(ctx context.Context) ([]*models.AccountBalanceDTO, error) {
 	enabledCurrencies, err := o.storage.ExchangeChains().GetEnabledCurrencies(ctx, models.ExchangeSlugBybit)
 	if err != nil {
@@ -503,6 +526,28 @@ func (o *Service) GetOrderRules(ctx context.Context, ticker ...string) ([]*model
 	return rules, nil
 }
 
+func (o *Service) GetTickerPrice(ctx context.Context, ticker string) (*models.TickerPriceDTO, error) {
+	res, err := o.exClient.Market().GetTickers(ctx, &requests.GetTickersRequest{
+		Category: "spot",
+		Symbol:   ticker,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticker data: %w", err)
+	}
+	if len(res.Result.List) == 0 {
+		return nil, fmt.Errorf("ticker data for %s not found", ticker)
+	}
+	bid, err := decimal.NewFromString(res.Result.List[0].Bid1Price)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse bid price: %w", err)
+	}
+	ask, err := decimal.NewFromString(res.Result.List[0].Ask1Price)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ask price: %w", err)
+	}
+	return &models.TickerPriceDTO{Symbol: ticker, Bid: bid, Ask: ask}, nil
+}
+
 func (o *Service) GetOrderDetails(ctx context.Context, args *models.GetOrderByIDParams) (*models.OrderDetailsDTO, error) { //nolint:gocognit,gocyclo,funlen
 	order := &models.OrderDetailsDTO{
 		State:     models.ExchangeOrderStatusFailed,
@@ -540,19 +585,7 @@ func (o *Service) GetOrderDetails(ctx context.Context, args *models.GetOrderByID
 
 		orderData := res.Result.List[0]
 
-		// Map order status
-		switch orderData.OrderStatus {
-		case bybitmodels.OrderStatusFilled:
-			order.State = models.ExchangeOrderStatusCompleted
-		case bybitmodels.OrderStatusNew, bybitmodels.OrderStatusPartiallyFilled:
-			order.State = models.ExchangeOrderStatusInProgress
-		case bybitmodels.OrderStatusCancelled,
-			bybitmodels.OrderStatusRejected,
-			bybitmodels.OrderStatusDeactivated:
-			order.State = models.ExchangeOrderStatusFailed
-		default:
-			order.State = models.ExchangeOrderStatusInProgress
-		}
+		order.State = orderState(orderData.OrderStatus)
 
 		// Get symbol information
 		symbolInfo, err := o.exClient.Market().GetInstruments(ctx, &requests.GetInstrumentsRequest{
@@ -669,18 +702,7 @@ func (o *Service) GetOrderDetails(ctx context.Context, args *models.GetOrderByID
 
 		orderData := res.Result.List[0]
 
-		switch orderData.OrderStatus {
-		case bybitmodels.OrderStatusFilled:
-			order.State = models.ExchangeOrderStatusCompleted
-		case bybitmodels.OrderStatusNew, bybitmodels.OrderStatusPartiallyFilled:
-			order.State = models.ExchangeOrderStatusInProgress
-		case bybitmodels.OrderStatusCancelled,
-			bybitmodels.OrderStatusRejected,
-			bybitmodels.OrderStatusDeactivated:
-			order.State = models.ExchangeOrderStatusFailed
-		default:
-			order.State = models.ExchangeOrderStatusInProgress
-		}
+		order.State = orderState(orderData.OrderStatus)
 
 		// Parse executed quantity
 		executedQty, err := decimal.NewFromString(orderData.CumExecQty)
@@ -735,6 +757,19 @@ func (o *Service) GetOrderDetails(ctx context.Context, args *models.GetOrderByID
 	return order, nil
 }
 
+func orderState(status bybitmodels.OrderStatus) models.ExchangeOrderStatus {
+	switch status {
+	case bybitmodels.OrderStatusFilled:
+		return models.ExchangeOrderStatusCompleted
+	case bybitmodels.OrderStatusCancelled, bybitmodels.OrderStatusPartiallyFilledCanceled:
+		return models.ExchangeOrderStatusCancelled
+	case bybitmodels.OrderStatusRejected, bybitmodels.OrderStatusDeactivated:
+		return models.ExchangeOrderStatusFailed
+	default:
+		return models.ExchangeOrderStatusInProgress
+	}
+}
+
 func (o *Service) CreateSpotOrder(ctx context.Context, from string, to string, side string, ticker string, amount *decimal.Decimal, rule *models.OrderRulesDTO) (*models.ExchangeOrderDTO, error) { //nolint:all
 	clientOrderID, err := uuid.NewUUID()
 	if err != nil {
@@ -935,6 +970,140 @@ func (o *Service) CreateSpotOrder(ctx context.Context, from string, to string, s
 	}, nil
 }
 
+func (o *Service) CreateLimitOrder(ctx context.Context, args *models.CreateLimitOrderParams) (*models.ExchangeOrderDTO, error) {
+	clientOrderID, err := uuid.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := o.exClient.Market().GetInstruments(ctx, &requests.GetInstrumentsRequest{
+		Category: "spot",
+		Symbol:   args.Symbol,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get trading symbol %s: %w", args.Symbol, err)
+	}
+	if len(res.Result.List) == 0 {
+		return nil, fmt.Errorf("symbol %s not found", args.Symbol)
+	}
+
+	symbol := res.Result.List[0]
+	if symbol.Status != bybitmodels.InstrumentStatusStatusTrading {
+		return nil, fmt.Errorf("symbol %s is not available for trading: status=%s", args.Symbol, symbol.Status)
+	}
+
+	tickSize, err := decimal.NewFromString(symbol.PriceFilter.TickSize)
+	if err != nil {
+		return nil, fmt.Errorf("parse tick size %s: %w", symbol.PriceFilter.TickSize, err)
+	}
+	basePrecision, err := decimal.NewFromString(symbol.LotSizeFilter.BasePrecision)
+	if err != nil {
+		return nil, fmt.Errorf("parse base precision %s: %w", symbol.LotSizeFilter.BasePrecision, err)
+	}
+
+	price := utils.FloorToStep(args.Price, tickSize)
+	side := bybitmodels.SideBuy
+	if args.Side == models.OrderSideSell {
+		price, side = utils.CeilToStep(args.Price, tickSize), bybitmodels.SideSell
+	}
+	qty := utils.FloorToStep(args.Amount, basePrecision)
+	if qty.LessThan(symbol.LotSizeFilter.MinOrderQty) || qty.Mul(price).LessThan(symbol.LotSizeFilter.MinOrderAmt) {
+		return nil, exchangeclient.ErrMinOrderValue
+	}
+
+	coin, spent := symbol.BaseCoin, qty
+	if args.Side == models.OrderSideBuy {
+		coin, spent = symbol.QuoteCoin, qty.Mul(price)
+	}
+	if err := o.topUpUnified(ctx, coin, spent); err != nil {
+		return nil, err
+	}
+
+	placedOrder, err := o.exClient.Trade().PlaceOrder(ctx, &requests.PlaceOrderRequest{
+		Category:    "spot",
+		Symbol:      symbol.Symbol,
+		Side:        side.String(),
+		OrderType:   bybitmodels.OrderTypeLimit.String(),
+		Qty:         qty.String(),
+		Price:       price.String(),
+		TimeInForce: strings.ToUpper(args.TimeInForce.String()),
+		OrderLinkID: clientOrderID.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to place order: %w", err)
+	}
+
+	return &models.ExchangeOrderDTO{
+		ClientOrderID:   placedOrder.Result.OrderLinkID,
+		ExchangeOrderID: placedOrder.Result.OrderID,
+		Amount:          qty,
+	}, nil
+}
+
+// topUpUnified moves missing part of amount from funding to unified account
+func (o *Service) topUpUnified(ctx context.Context, coin string, amount decimal.Decimal) error {
+	unified, err := o.exClient.Account().GetTradingBalance(ctx, &requests.GetTradingBalanceRequest{
+		AccountType: bybitmodels.AccountTypeUnified.String(),
+		Coin:        coin,
+	})
+	if err != nil {
+		return fmt.Errorf("get unified currency balance %s: %w", coin, err)
+	}
+
+	unifiedBalance := decimal.Zero
+	if len(unified.Result.List) > 0 && len(unified.Result.List[0].Coin) > 0 && unified.Result.List[0].Coin[0].WalletBalance != "" {
+		unifiedBalance, _ = decimal.NewFromString(unified.Result.List[0].Coin[0].WalletBalance)
+	}
+
+	missing := amount.Sub(unifiedBalance)
+	if !missing.IsPositive() {
+		return nil
+	}
+
+	funding, err := o.exClient.Account().GetAllCoinsBalance(ctx, &requests.GetFundingBalanceRequest{
+		AccountType: bybitmodels.AccountTypeFund.String(),
+		Coin:        coin,
+	})
+	if err != nil {
+		return fmt.Errorf("get funding currency balance %s: %w", coin, err)
+	}
+
+	fundingBalance := decimal.Zero
+	if len(funding.Result.Balance) > 0 && funding.Result.Balance[0].WalletBalance != "" {
+		fundingBalance, _ = decimal.NewFromString(funding.Result.Balance[0].WalletBalance)
+	}
+	if fundingBalance.LessThan(missing) {
+		return exchangeclient.ErrInsufficientBalance
+	}
+
+	transferID, err := uuid.NewUUID()
+	if err != nil {
+		return fmt.Errorf("failed to generate transfer ID: %w", err)
+	}
+
+	if _, err = o.exClient.Account().CreateInternalTransfer(ctx, &requests.CreateInternalTransferRequest{
+		TransferID:      transferID.String(),
+		Coin:            coin,
+		Amount:          missing.String(),
+		FromAccountType: bybitmodels.AccountTypeFund,
+		ToAccountType:   bybitmodels.AccountTypeUnified,
+	}); err != nil {
+		return fmt.Errorf("failed to transfer funds from funding to unified: %w", err)
+	}
+
+	return nil
+}
+
+func (o *Service) CancelOrder(ctx context.Context, args *models.CancelOrderParams) error {
+	_, err := o.exClient.Trade().CancelOrder(ctx, &requests.CancelOrderRequest{
+		Category:    "spot",
+		Symbol:      args.Symbol,
+		OrderID:     args.ExternalOrderID,
+		OrderLinkID: args.ClientOrderID,
+	})
+	return err
+}
+
 func (o *Service) CreateWithdrawalOrder(ctx context.Context, args *models.CreateWithdrawalOrderParams) (*models.ExchangeWithdrawalDTO, error) {
 	precision := int32(args.WithdrawalPrecision)
 
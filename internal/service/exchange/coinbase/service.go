@@ -0,0 +1,683 @@
+package coinbase
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/ulule/limiter/v3"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/currconv"
+	"github.com/dv-net/dv-merchant/internal/storage"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_exchange_chains"
+	"github.com/dv-net/dv-merchant/internal/tools/hash"
+	exchangeclient "github.com/dv-net/dv-merchant/pkg/exchange_client"
+	coinbase "github.com/dv-net/dv-merchant/pkg/exchange_client/coinbase"
+	coinbaserequests "github.com/dv-net/dv-merchant/pkg/exchange_client/coinbase/requests"
+	"github.com/dv-net/dv-merchant/pkg/exchange_client/coinbase/responses"
+	"github.com/dv-net/dv-merchant/pkg/exchange_client/utils"
+	"github.com/dv-net/dv-merchant/pkg/iso"
+	"github.com/dv-net/dv-merchant/pkg/logger"
+)
+
+// maxWithdrawPrecision caps currency exponent, coinbase reports up to 18 digits for evm tokens
+const maxWithdrawPrecision = 8
+
+type Service struct {
+	exClient *coinbase.BaseClient
+	storage  storage.IStorage
+	convSvc  currconv.ICurrencyConvertor
+	l        logger.Logger
+	connHash string
+}
+
+func NewService(logger logger.Logger, apiKey, secretKey string, baseURL *url.URL, storage storage.IStorage, store limiter.Store, convSvc currconv.ICurrencyConvertor) (*Service, error) {
+	exClient, err := coinbase.NewBaseClient(&coinbase.ClientOptions{
+		APIKey:    apiKey,
+		SecretKey: secretKey,
+		BaseURL:   baseURL,
+	}, store, coinbase.WithLogger(logger))
+	if err != nil {
+		return nil, err
+	}
+
+	connHash, err := hash.SHA256ConnectionHash(models.ExchangeSlugCoinbase.String(), apiKey, secretKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create connection hash: %w", err)
+	}
+
+	return &Service{
+		exClient: exClient,
+		storage:  storage,
+		convSvc:  convSvc,
+		l:        logger,
+		connHash: connHash,
+	}, nil
+}
+
+// accountByCurrency finds the wallet of the currency, coinbase keeps one account per currency
+func (o *Service) accountByCurrency(ctx context.Context, currency string) (*responses.Account, error) {
+	accounts, err := o.exClient.Account().GetAccounts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get accounts: %w", err)
+	}
+
+	account, ok := lo.Find(accounts, func(item responses.Account) bool {
+		return item.Currency == currency
+	})
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", currency, coinbase.ErrAccountNotFound)
+	}
+
+	return &account, nil
+}
+
+func (o *Service) TestConnection(ctx context.Context) error {
+	if _, err := o.exClient.Account().GetKeyPermissions(ctx); err != nil {
+		return fmt.Errorf("get key permissions: %w", err)
+	}
+	return nil
+}
+
+// GetKeyPermissions maps can_transfer to withdrawals, the driver never moves funds between portfolios
+func (o *Service) GetKeyPermissions(ctx context.Context) (*models.KeyPermissionsDTO, error) {
+	res, err := o.exClient.Account().GetKeyPermissions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get key permissions: %w", err)
+	}
+
+	return &models.KeyPermissionsDTO{
+		CanTrade:    lo.ToPtr(res.CanTrade),
+		CanWithdraw: lo.ToPtr(res.CanTransfer),
+	}, nil
+}
+
+func (o *Service) GetConnectionHash() string {
+	return o.connHash
+}
+
+func (o *Service) GetAccountBalance(ctx context.Context) ([]*models.AccountBalanceDTO, error) {
+	accounts, err := o.exClient.Account().GetAccounts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get accounts: %w", err)
+	}
+
+	res := make([]*models.AccountBalanceDTO, 0, len(accounts))
+	for _, account := range accounts {
+		amount := account.AvailableBalance.Value
+		if !amount.IsPositive() {
+			continue
+		}
+
+		currencyID, err := o.storage.ExchangeChains().GetCurrencyIDByTicker(ctx, account.Currency)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				continue
+			}
+			return nil, fmt.Errorf("get internal currency id for %s: %w", account.Currency, err)
+		}
+
+		amountUSD, err := o.convSvc.Convert(ctx, currconv.ConvertDTO{
+			Source: models.ExchangeSlugCoinbase.String(),
+			From:   account.Currency,
+			To:     models.CurrencyCodeUSDT,
+			Amount: amount.String(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("convert %s balance to usdt: %w", account.Currency, err)
+		}
+
+		res = append(res, &models.AccountBalanceDTO{
+			Currency:  currencyID,
+			Amount:    amount,
+			AmountUSD: amountUSD.Round(4),
+			Type:      models.CurrencyTypeCrypto.String(),
+		})
+	}
+
+	return res, nil
+}
+
+func (o *Service) GetCurrencyBalance(ctx context.Context, currency string) (*decimal.Decimal, error) {
+	account, err := o.accountByCurrency(ctx, currency)
+	if err != nil {
+		if errors.Is(err, coinbase.ErrAccountNotFound) {
+			return &decimal.Zero, nil
+		}
+		return nil, err
+	}
+
+	return &account.AvailableBalance.Value, nil
+}
+
+// tradable reports whether market orders are accepted for the product
+func tradable(product *responses.Product) bool {
+	return product.Status == coinbase.ProductStatusOnline &&
+		!product.TradingDisabled &&
+		!product.IsDisabled &&
+		!product.CancelOnly &&
+		!product.ViewOnly
+}
+
+func (o *Service) GetExchangeSymbols(ctx context.Context) ([]*models.ExchangeSymbolDTO, error) {
+	products, err := o.exClient.Market().GetProducts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get products: %w", err)
+	}
+
+	symbols := make([]*models.ExchangeSymbolDTO, 0, len(products.Products)*2)
+	for _, product := range products.Products {
+		if !tradable(&product) {
+			continue
+		}
+		base, quote := product.BaseCurrencyID, product.QuoteCurrencyID
+		if iso.IsFiat(base) || iso.IsFiat(quote) {
+			continue
+		}
+
+		symbols = append(symbols, &models.ExchangeSymbolDTO{
+			Symbol:      product.ProductID,
+			DisplayName: base + "/" + quote,
+			BaseSymbol:  base,
+			QuoteSymbol: quote,
+			Type:        models.OrderSideSell.String(),
+		}, &models.ExchangeSymbolDTO{
+			Symbol:      product.ProductID,
+			DisplayName: quote + "/" + base,
+			BaseSymbol:  base,
+			QuoteSymbol: quote,
+			Type:        models.OrderSideBuy.String(),
+		})
+	}
+
+	return symbols, nil
+}
+
+func (o *Service) GetDepositAddresses(ctx context.Context, currency, chain string) ([]*models.DepositAddressDTO, error) {
+	account, err := o.accountByCurrency(ctx, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses, err := o.exClient.Wallet().ListAddresses(ctx, account.UUID)
+	if err != nil {
+		return nil, fmt.Errorf("get deposit addresses for %s: %w", currency, err)
+	}
+
+	items := lo.Filter(addresses.Data, func(item responses.Address, _ int) bool {
+		return item.Network == chain
+	})
+	if len(items) == 0 {
+		address, err := o.exClient.Wallet().CreateAddress(ctx, account.UUID, &coinbaserequests.CreateAddressRequest{
+			Network: chain,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("create deposit address for %s: %w", currency, err)
+		}
+		items = append(items, *address)
+	}
+
+	currencyID, err := o.storage.ExchangeChains().GetCurrencyIDByParams(ctx, repo_exchange_chains.GetCurrencyIDByParamsParams{
+		Ticker: currency,
+		Chain:  chain,
+		Slug:   models.ExchangeSlugCoinbase,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get internal currency id for %s: %w", chain, err)
+	}
+
+	items = lo.UniqBy(items, func(item responses.Address) string {
+		return cmp.Or(item.AddressInfo.Address, item.Address) + ":" + item.AddressInfo.DestinationTag
+	})
+
+	return lo.Map(items, func(item responses.Address, _ int) *models.DepositAddressDTO {
+		return &models.DepositAddressDTO{
+			Address:          cmp.Or(item.AddressInfo.Address, item.Address),
+			Currency:         currencyID,
+			Chain:            chain,
+			InternalCurrency: currency,
+			AddressType:      models.DepositAddress,
+			PaymentTag:       item.AddressInfo.DestinationTag,
+		}
+	}), nil
+}
+
+// CreateWithdrawalOrder sends funds from the currency account, the returned id combines
+// account and transaction ids since v2 api looks transactions up per account
+func (o *Service) CreateWithdrawalOrder(ctx context.Context, args *models.CreateWithdrawalOrderParams) (*models.ExchangeWithdrawalDTO, error) {
+	args.NativeAmount = args.NativeAmount.RoundDown(int32(args.WithdrawalPrecision)) //nolint:gosec
+
+	internalCurrency, err := o.storage.ExchangeChains().GetTickerByCurrencyID(ctx, repo_exchange_chains.GetTickerByCurrencyIDParams{
+		CurrencyID: args.Currency,
+		Slug:       models.ExchangeSlugCoinbase,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get exchange ticker for %s: %w", args.Currency, err)
+	}
+
+	if args.NativeAmount.LessThan(args.MinWithdrawal) {
+		return nil, exchangeclient.ErrMinWithdrawalBalance
+	}
+
+	account, err := o.accountByCurrency(ctx, internalCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	o.l.Infow(
+		"withdrawal request assembled",
+		"exchange", models.ExchangeSlugCoinbase.String(),
+		"recordID", args.RecordID.String(),
+		"amount", args.NativeAmount.String(),
+		"fee", args.Fee.String(),
+		"currency", internalCurrency,
+		"chain", args.Chain,
+		"address", args.Address,
+	)
+
+	tx, err := o.exClient.Wallet().SendMoney(ctx, account.UUID, &coinbaserequests.SendMoneyRequest{
+		Type:     coinbase.TransactionTypeSend,
+		To:       args.Address,
+		Amount:   args.NativeAmount.String(),
+		Currency: internalCurrency,
+		Network:  args.Chain,
+		Idem:     args.RecordID.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if tx.ID == "" {
+		return nil, fmt.Errorf("withdrawal for %s created without id", internalCurrency)
+	}
+
+	return &models.ExchangeWithdrawalDTO{ExternalOrderID: account.UUID + "/" + tx.ID}, nil
+}
+
+func (o *Service) bestPrices(ctx context.Context, ticker string) (*models.TickerPriceDTO, error) {
+	res, err := o.exClient.Market().GetProductBook(ctx, ticker)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.PriceBook.Bids) == 0 || len(res.PriceBook.Asks) == 0 {
+		return nil, fmt.Errorf("ticker %s not found", ticker)
+	}
+
+	return &models.TickerPriceDTO{
+		Symbol: ticker,
+		Bid:    res.PriceBook.Bids[0].Price,
+		Ask:    res.PriceBook.Asks[0].Price,
+	}, nil
+}
+
+func (o *Service) CreateSpotOrder(ctx context.Context, _ string, _ string, side string, ticker string, _ *decimal.Decimal, rule *models.OrderRulesDTO) (*models.ExchangeOrderDTO, error) {
+	req := &coinbaserequests.CreateOrderRequest{
+		ProductID: ticker,
+		Side:      strings.ToUpper(side),
+	}
+
+	product, err := o.exClient.Market().GetProduct(ctx, ticker)
+	if err != nil {
+		if errors.Is(err, exchangeclient.ErrRateLimited) {
+			return nil, exchangeclient.ErrSkipOrder
+		}
+		return nil, fmt.Errorf("get product %s: %w", ticker, err)
+	}
+	if !tradable(product) || product.LimitOnly || product.PostOnly {
+		return nil, exchangeclient.ErrSymbolTradingHalted
+	}
+
+	clientOrderID, err := uuid.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+	req.ClientOrderID = clientOrderID.String()
+
+	var amount decimal.Decimal
+	switch req.Side {
+	case coinbase.OrderSideSell:
+		balance, err := o.GetCurrencyBalance(ctx, rule.BaseCurrency)
+		if err != nil {
+			if errors.Is(err, exchangeclient.ErrRateLimited) {
+				return nil, exchangeclient.ErrSkipOrder
+			}
+			return nil, fmt.Errorf("get base currency balance %s: %w", rule.BaseCurrency, err)
+		}
+		if balance.LessThan(product.BaseMinSize) {
+			return nil, exchangeclient.ErrInsufficientBalance
+		}
+		amount = utils.FloorToStep(*balance, product.BaseIncrement)
+
+		prices, err := o.bestPrices(ctx, ticker)
+		if err != nil {
+			if errors.Is(err, exchangeclient.ErrRateLimited) {
+				return nil, exchangeclient.ErrSkipOrder
+			}
+			return nil, fmt.Errorf("get ticker price for %s: %w", ticker, err)
+		}
+		if !prices.Bid.IsPositive() {
+			return nil, exchangeclient.ErrSkipOrder
+		}
+		if amount.Mul(prices.Bid).LessThan(product.QuoteMinSize) {
+			return nil, exchangeclient.ErrInsufficientBalance
+		}
+
+		req.OrderConfiguration.MarketMarketIOC = &coinbaserequests.MarketIOC{BaseSize: amount.String()}
+	case coinbase.OrderSideBuy:
+		balance, err := o.GetCurrencyBalance(ctx, rule.QuoteCurrency)
+		if err != nil {
+			if errors.Is(err, exchangeclient.ErrRateLimited) {
+				return nil, exchangeclient.ErrSkipOrder
+			}
+			return nil, fmt.Errorf("get quote currency balance %s: %w", rule.QuoteCurrency, err)
+		}
+		if balance.LessThan(product.QuoteMinSize) {
+			return nil, exchangeclient.ErrInsufficientBalance
+		}
+		amount = utils.FloorToStep(*balance, product.QuoteIncrement)
+
+		req.OrderConfiguration.MarketMarketIOC = &coinbaserequests.MarketIOC{QuoteSize: amount.String()}
+	default:
+		return nil, fmt.Errorf("unsupported order side %s", side)
+	}
+
+	order, err := o.exClient.Order().CreateOrder(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("place spot order: %w", err)
+	}
+	if order.OrderID == "" {
+		return nil, fmt.Errorf("failed to create spot order for %s", ticker)
+	}
+
+	return &models.ExchangeOrderDTO{
+		ExchangeOrderID: order.OrderID,
+		ClientOrderID:   req.ClientOrderID,
+		Amount:          amount,
+	}, nil
+}
+
+func (o *Service) CreateLimitOrder(ctx context.Context, args *models.CreateLimitOrderParams) (*models.ExchangeOrderDTO, error) {
+	req := &coinbaserequests.CreateOrderRequest{
+		ProductID: args.Symbol,
+		Side:      strings.ToUpper(args.Side.String()),
+	}
+
+	product, err := o.exClient.Market().GetProduct(ctx, args.Symbol)
+	if err != nil {
+		if errors.Is(err, exchangeclient.ErrRateLimited) {
+			return nil, exchangeclient.ErrSkipOrder
+		}
+		return nil, fmt.Errorf("get product %s: %w", args.Symbol, err)
+	}
+	if !tradable(product) {
+		return nil, exchangeclient.ErrSymbolTradingHalted
+	}
+
+	price := utils.FloorToStep(args.Price, product.PriceIncrement)
+	if args.Side == models.OrderSideSell {
+		price = utils.CeilToStep(args.Price, product.PriceIncrement)
+	}
+	qty := utils.FloorToStep(args.Amount, product.BaseIncrement)
+	if !qty.IsPositive() || qty.LessThan(product.BaseMinSize) || qty.Mul(price).LessThan(product.QuoteMinSize) {
+		return nil, exchangeclient.ErrMinOrderValue
+	}
+
+	currency, spent := product.BaseCurrencyID, qty
+	if args.Side == models.OrderSideBuy {
+		currency, spent = product.QuoteCurrencyID, qty.Mul(price)
+	}
+	balance, err := o.GetCurrencyBalance(ctx, currency)
+	if err != nil {
+		if errors.Is(err, exchangeclient.ErrRateLimited) {
+			return nil, exchangeclient.ErrSkipOrder
+		}
+		return nil, fmt.Errorf("get currency balance %s: %w", currency, err)
+	}
+	if balance.LessThan(spent) {
+		return nil, exchangeclient.ErrInsufficientBalance
+	}
+
+	limit := &coinbaserequests.LimitOrder{
+		BaseSize:   qty.String(),
+		LimitPrice: price.String(),
+	}
+	switch args.TimeInForce {
+	case models.TimeInForceIOC:
+		req.OrderConfiguration.SorLimitIOC = limit
+	case models.TimeInForceFOK:
+		req.OrderConfiguration.LimitLimitFOK = limit
+	default:
+		req.OrderConfiguration.LimitLimitGTC = limit
+	}
+
+	clientOrderID, err := uuid.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+	req.ClientOrderID = clientOrderID.String()
+
+	order, err := o.exClient.Order().CreateOrder(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("place spot order: %w", err)
+	}
+	if order.OrderID == "" {
+		return nil, fmt.Errorf("failed to create spot order for %s", args.Symbol)
+	}
+
+	return &models.ExchangeOrderDTO{
+		ExchangeOrderID: order.OrderID,
+		ClientOrderID:   req.ClientOrderID,
+		Amount:          qty,
+	}, nil
+}
+
+// CancelOrder needs exchange order id, coinbase does not cancel by client order id
+func (o *Service) CancelOrder(ctx context.Context, args *models.CancelOrderParams) error {
+	if args.ExternalOrderID == "" {
+		return fmt.Errorf("external order id is required")
+	}
+
+	res, err := o.exClient.Order().CancelOrders(ctx, &coinbaserequests.CancelOrdersRequest{
+		OrderIDs: []string{args.ExternalOrderID},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, result := range res.Results {
+		if result.OrderID == args.ExternalOrderID && !result.Success {
+			return fmt.Errorf("cancel order %s: %s", args.ExternalOrderID, result.FailureReason)
+		}
+	}
+
+	return nil
+}
+
+func (o *Service) GetOrderRule(ctx context.Context, ticker string) (*models.OrderRulesDTO, error) {
+	product, err := o.exClient.Market().GetProduct(ctx, ticker)
+	if err != nil {
+		if errors.Is(err, exchangeclient.ErrRateLimited) {
+			return nil, exchangeclient.ErrSkipOrder
+		}
+		return nil, fmt.Errorf("get order rule for %s: %w", ticker, err)
+	}
+	if !tradable(product) {
+		return nil, exchangeclient.ErrSymbolTradingHalted
+	}
+
+	return &models.OrderRulesDTO{
+		Symbol:          product.ProductID,
+		State:           product.Status,
+		BaseCurrency:    product.BaseCurrencyID,
+		QuoteCurrency:   product.QuoteCurrencyID,
+		MinOrderAmount:  product.BaseMinSize.String(),
+		MaxOrderAmount:  product.BaseMaxSize.String(),
+		MinOrderValue:   product.QuoteMinSize.String(),
+		AmountPrecision: int(utils.ConvertPrecision(product.BaseIncrement.String()).IntPart()),
+		PricePrecision:  int(utils.ConvertPrecision(product.PriceIncrement.String()).IntPart()),
+		ValuePrecision:  int(utils.ConvertPrecision(product.QuoteIncrement.String()).IntPart()),
+	}, nil
+}
+
+func (o *Service) GetOrderRules(ctx context.Context, tickers ...string) ([]*models.OrderRulesDTO, error) {
+	rules := make([]*models.OrderRulesDTO, 0, len(tickers))
+	for _, ticker := range tickers {
+		rule, err := o.GetOrderRule(ctx, ticker)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+func (o *Service) GetTickerPrice(ctx context.Context, ticker string) (*models.TickerPriceDTO, error) {
+	return o.bestPrices(ctx, ticker)
+}
+
+func (o *Service) GetOrderDetails(ctx context.Context, args *models.GetOrderByIDParams) (*models.OrderDetailsDTO, error) {
+	order := &models.OrderDetailsDTO{
+		State:     models.ExchangeOrderStatusFailed,
+		Amount:    decimal.Zero,
+		AmountUSD: decimal.Zero,
+	}
+
+	if args.ExternalOrderID == nil || *args.ExternalOrderID == "" {
+		return order, fmt.Errorf("external order id is required")
+	}
+
+	res, err := o.exClient.Order().GetOrder(ctx, *args.ExternalOrderID)
+	if err != nil {
+		return nil, fmt.Errorf("get order: %w", err)
+	}
+
+	switch res.Status {
+	case responses.OrderStatusFilled:
+		order.State = models.ExchangeOrderStatusCompleted
+	case responses.OrderStatusPending, responses.OrderStatusQueued, responses.OrderStatusOpen, responses.OrderStatusCancelQueued:
+		order.State = models.ExchangeOrderStatusInProgress
+	case responses.OrderStatusCancelled, responses.OrderStatusExpired:
+		order.State = models.ExchangeOrderStatusCancelled
+	case responses.OrderStatusFailed:
+		order.State = models.ExchangeOrderStatusFailed
+	default:
+		order.State = models.ExchangeOrderStatusInProgress
+	}
+
+	order.Amount = res.FilledSize
+
+	_, quote, ok := strings.Cut(res.ProductID, "-")
+	if !ok {
+		return nil, fmt.Errorf("unexpected product id %s", res.ProductID)
+	}
+
+	if quote == models.CurrencyCodeUSDT || quote == models.CurrencyCodeUSDC {
+		order.AmountUSD = res.FilledValue
+		return order, nil
+	}
+
+	amountUSD, err := o.convSvc.Convert(ctx, currconv.ConvertDTO{
+		Source: models.ExchangeSlugCoinbase.String(),
+		From:   quote,
+		To:     models.CurrencyCodeUSDT,
+		Amount: res.FilledValue.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("convert %s to usdt: %w", quote, err)
+	}
+	order.AmountUSD = amountUSD.Round(4)
+
+	return order, nil
+}
+
+func (o *Service) GetWithdrawalRules(ctx context.Context, currencies ...string) ([]*models.WithdrawalRulesDTO, error) {
+	currEnabled, err := o.storage.ExchangeChains().GetEnabledCurrencies(ctx, models.ExchangeSlugCoinbase)
+	if err != nil {
+		return nil, fmt.Errorf("get enabled currencies: %w", err)
+	}
+
+	currEnabled = lo.Filter(currEnabled, func(item *repo_exchange_chains.GetEnabledCurrenciesRow, _ int) bool {
+		return lo.Contains(currencies, item.ID.String)
+	})
+
+	cryptoCurrencies, err := o.exClient.Market().GetCryptoCurrencies(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get crypto currencies: %w", err)
+	}
+
+	rules := make([]*models.WithdrawalRulesDTO, 0, len(currEnabled))
+	for _, currency := range currEnabled {
+		precision := maxWithdrawPrecision
+		if info, ok := lo.Find(cryptoCurrencies.Data, func(item responses.CryptoCurrency) bool {
+			return item.Code == currency.Ticker
+		}); ok {
+			precision = min(info.Exponent, maxWithdrawPrecision)
+		}
+
+		minDepositAmount, err := o.convSvc.Convert(ctx, currconv.ConvertDTO{
+			Source:     models.ExchangeSlugCoinbase.String(),
+			From:       models.CurrencyCodeUSDT,
+			To:         currency.Ticker,
+			Amount:     "1",
+			StableCoin: false,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("convert minimum deposit amount for %s: %w", currency.Ticker, err)
+		}
+
+		// coinbase does not publish withdrawal minimums and charges network fee on top of the amount
+		rules = append(rules, &models.WithdrawalRulesDTO{
+			Currency:          currency.Ticker,
+			Chain:             currency.Chain,
+			MinDepositAmount:  minDepositAmount.RoundUp(int32(precision)).String(), //nolint:gosec
+			MinWithdrawAmount: decimal.Zero.String(),
+			WithdrawPrecision: strconv.Itoa(precision),
+			WithdrawFeeType:   models.WithdrawalFeeTypeFixed,
+			Fee:               decimal.Zero.String(),
+		})
+	}
+
+	return rules, nil
+}
+
+func (o *Service) GetWithdrawalByID(ctx context.Context, args *models.GetWithdrawalByIDParams) (*models.WithdrawalStatusDTO, error) {
+	id := ""
+	switch {
+	case args.ExternalOrderID != nil && *args.ExternalOrderID != "":
+		id = *args.ExternalOrderID
+	case args.ClientOrderID != nil && *args.ClientOrderID != "":
+		id = *args.ClientOrderID
+	default:
+		return nil, fmt.Errorf("either ClientOrderID or ExternalOrderID must be provided")
+	}
+
+	accountID, transactionID, ok := strings.Cut(id, "/")
+	if !ok {
+		return nil, fmt.Errorf("unexpected withdrawal id %s", id)
+	}
+
+	tx, err := o.exClient.Wallet().GetTransaction(ctx, accountID, transactionID)
+	if err != nil {
+		return nil, fmt.Errorf("get withdrawal status: %w", err)
+	}
+
+	// sends are reported with negative amount
+	return &models.WithdrawalStatusDTO{
+		ID:           tx.ID,
+		TxHash:       tx.Network.Hash,
+		NativeAmount: tx.Amount.Amount.Abs(),
+		Status:       tx.Status,
+	}, nil
+}
@@ -0,0 +1,144 @@
+// Package conformance replays recorded exchange API traffic through an httptest server
+// to check IExchangeClient drivers: request signing, response parsing, decimal precision
+// and mapping of exchange errors into exchangeclient sentinels.
+//
+// Every driver keeps its cases in testdata/<slug>/*.json of this package,
+// supporting a new exchange means adding a Driver entry and recording its cases.
+package conformance
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"slices"
+	"testing"
+	"time"
+
+	mxlogger "github.com/dv-net/mx/logger"
+	"github.com/stretchr/testify/require"
+	"github.com/ulule/limiter/v3"
+	"github.com/ulule/limiter/v3/drivers/store/memory"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/currconv"
+	"github.com/dv-net/dv-merchant/internal/service/exchange_manager"
+	"github.com/dv-net/dv-merchant/internal/storage"
+	exchangeclient "github.com/dv-net/dv-merchant/pkg/exchange_client"
+	"github.com/dv-net/dv-merchant/pkg/logger"
+)
+
+// Credentials drivers are created with, verifiers sign with the same secret
+const (
+	APIKey     = "conformance-api-key"
+	SecretKey  = "conformance-secret-key"
+	Passphrase = "conformance-passphrase"
+)
+
+const caseTimeout = 10 * time.Second
+
+var sentinels = map[string]error{
+	"ErrInvalidIPAddress":                exchangeclient.ErrInvalidIPAddress,
+	"ErrInvalidAPICredentials":           exchangeclient.ErrInvalidAPICredentials,
+	"ErrIncorrectAPIPermissions":         exchangeclient.ErrIncorrectAPIPermissions,
+	"ErrSoftLockByUserSecurityAction":    exchangeclient.ErrSoftLockByUserSecurityAction,
+	"ErrWithdrawalBalanceLocked":         exchangeclient.ErrWithdrawalBalanceLocked,
+	"ErrMinWithdrawalBalance":            exchangeclient.ErrMinWithdrawalBalance,
+	"ErrInsufficientBalance":             exchangeclient.ErrInsufficientBalance,
+	"ErrSymbolTradingHalted":             exchangeclient.ErrSymbolTradingHalted,
+	"ErrWithdrawalAddressNotWhitelisted": exchangeclient.ErrWithdrawalAddressNotWhitelisted,
+	"ErrWithdrawalPending":               exchangeclient.ErrWithdrawalPending,
+	"ErrInvalidAddress":                  exchangeclient.ErrInvalidAddress,
+	"ErrRateLimited":                     exchangeclient.ErrRateLimited,
+	"ErrMinOrderValue":                   exchangeclient.ErrMinOrderValue,
+	"ErrSkipOrder":                       exchangeclient.ErrSkipOrder,
+}
+
+// uncovered lists methods without a single case, GetConnectionHash makes no requests
+func uncovered(cases []*Case) []string {
+	covered := make(map[string]bool, len(cases))
+	for _, c := range cases {
+		covered[c.Method] = true
+	}
+
+	var res []string
+	for name := range methods {
+		if !covered[name] && name != "GetConnectionHash" {
+			res = append(res, name)
+		}
+	}
+	slices.Sort(res)
+	return res
+}
+
+// Env is passed to driver constructor, BaseURL points to the replay server
+type Env struct {
+	Logger  logger.Logger
+	BaseURL *url.URL
+	Storage storage.IStorage
+	Store   limiter.Store
+	ConvSvc currconv.ICurrencyConvertor
+}
+
+type Driver struct {
+	Slug   models.ExchangeSlug
+	New    func(env Env) (exchange_manager.IExchangeClient, error)
+	Verify SignatureVerifier
+}
+
+// Run replays every recorded case of the driver
+func Run(t *testing.T, d Driver) {
+	t.Helper()
+
+	cases, err := LoadCases("testdata/" + d.Slug.String())
+	require.NoError(t, err)
+	require.Empty(t, uncovered(cases), "IExchangeClient methods without conformance cases")
+
+	l := logger.New("conformance", mxlogger.Config{Format: "json", Level: "fatal"})
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			srv := newReplayServer(c.Interactions, d.Verify)
+			defer srv.Close()
+
+			baseURL, err := url.Parse(srv.URL)
+			require.NoError(t, err)
+
+			client, err := d.New(Env{
+				Logger:  l,
+				BaseURL: baseURL,
+				Storage: chainStorage{chains: &chainsQuerier{chains: c.State.Chains}},
+				Store:   memory.NewStore(),
+				ConvSvc: &usdConvertor{rates: c.State.USDRates},
+			})
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithTimeout(context.Background(), caseTimeout)
+			defer cancel()
+
+			res, err := methods[c.Method](ctx, client, c.Args)
+			require.Empty(t, srv.problems(), "replay server, method error: %v", err)
+
+			switch {
+			case c.Error != "":
+				require.ErrorIs(t, err, sentinels[c.Error])
+				return
+			case c.ErrorContains != "":
+				require.ErrorContains(t, err, c.ErrorContains)
+				return
+			}
+			require.NoError(t, err)
+
+			if len(c.Expect) == 0 {
+				return
+			}
+
+			encoded, err := json.Marshal(res)
+			require.NoError(t, err)
+
+			var expected, actual any
+			require.NoError(t, json.Unmarshal(c.Expect, &expected))
+			require.NoError(t, json.Unmarshal(encoded, &actual))
+			require.NoError(t, subset(expected, actual), "result: %s", encoded)
+		})
+	}
+}
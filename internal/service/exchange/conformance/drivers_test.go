@@ -0,0 +1,118 @@
+package conformance
+
+import (
+	"testing"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/exchange/binance"
+	"github.com/dv-net/dv-merchant/internal/service/exchange/bitget"
+	"github.com/dv-net/dv-merchant/internal/service/exchange/bybit"
+	"github.com/dv-net/dv-merchant/internal/service/exchange/coinbase"
+	"github.com/dv-net/dv-merchant/internal/service/exchange/gateio"
+	"github.com/dv-net/dv-merchant/internal/service/exchange/htx"
+	"github.com/dv-net/dv-merchant/internal/service/exchange/kraken"
+	"github.com/dv-net/dv-merchant/internal/service/exchange/kucoin"
+	"github.com/dv-net/dv-merchant/internal/service/exchange/mexc"
+	"github.com/dv-net/dv-merchant/internal/service/exchange/okx"
+	"github.com/dv-net/dv-merchant/internal/service/exchange_manager"
+)
+
+func TestBinance(t *testing.T) {
+	Run(t, Driver{
+		Slug: models.ExchangeSlugBinance,
+		New: func(env Env) (exchange_manager.IExchangeClient, error) {
+			return binance.NewService(env.Logger, APIKey, SecretKey, false, env.BaseURL, env.Storage, env.ConvSvc)
+		},
+		Verify: verifyBinance,
+	})
+}
+
+func TestBitget(t *testing.T) {
+	Run(t, Driver{
+		Slug: models.ExchangeSlugBitget,
+		New: func(env Env) (exchange_manager.IExchangeClient, error) {
+			return bitget.NewService(env.Logger, APIKey, SecretKey, Passphrase, false, env.BaseURL, env.Storage, env.Store, env.ConvSvc)
+		},
+		Verify: verifyBitget,
+	})
+}
+
+func TestBybit(t *testing.T) {
+	Run(t, Driver{
+		Slug: models.ExchangeSlugBybit,
+		New: func(env Env) (exchange_manager.IExchangeClient, error) {
+			return bybit.NewService(env.Logger, APIKey, SecretKey, env.BaseURL, env.Storage, env.Store, env.ConvSvc)
+		},
+		Verify: verifyBybit,
+	})
+}
+
+func TestCoinbase(t *testing.T) {
+	Run(t, Driver{
+		Slug: models.ExchangeSlugCoinbase,
+		New: func(env Env) (exchange_manager.IExchangeClient, error) {
+			return coinbase.NewService(env.Logger, APIKey, coinbaseSecret, env.BaseURL, env.Storage, env.Store, env.ConvSvc)
+		},
+		Verify: verifyCoinbase,
+	})
+}
+
+func TestGateio(t *testing.T) {
+	Run(t, Driver{
+		Slug: models.ExchangeSlugGateio,
+		New: func(env Env) (exchange_manager.IExchangeClient, error) {
+			return gateio.NewService(env.Logger, APIKey, SecretKey, env.BaseURL, env.Storage, env.Store, env.ConvSvc)
+		},
+		Verify: verifyGate,
+	})
+}
+
+func TestHtx(t *testing.T) {
+	Run(t, Driver{
+		Slug: models.ExchangeSlugHtx,
+		New: func(env Env) (exchange_manager.IExchangeClient, error) {
+			return htx.NewService(env.Logger, APIKey, SecretKey, env.BaseURL, env.Storage, env.Store, env.ConvSvc)
+		},
+		Verify: verifyHtx,
+	})
+}
+
+func TestKraken(t *testing.T) {
+	Run(t, Driver{
+		Slug: models.ExchangeSlugKraken,
+		New: func(env Env) (exchange_manager.IExchangeClient, error) {
+			return kraken.NewService(env.Logger, APIKey, krakenSecret, env.BaseURL, env.Storage, env.Store, env.ConvSvc)
+		},
+		Verify: verifyKraken,
+	})
+}
+
+func TestKucoin(t *testing.T) {
+	Run(t, Driver{
+		Slug: models.ExchangeSlugKucoin,
+		New: func(env Env) (exchange_manager.IExchangeClient, error) {
+			return kucoin.NewService(env.Logger, APIKey, SecretKey, Passphrase, false, env.BaseURL, env.Storage, env.Store, env.ConvSvc)
+		},
+		Verify: verifyKucoin,
+	})
+}
+
+func TestMexc(t *testing.T) {
+	Run(t, Driver{
+		Slug: models.ExchangeSlugMexc,
+		New: func(env Env) (exchange_manager.IExchangeClient, error) {
+			return mexc.NewService(env.Logger, APIKey, SecretKey, env.BaseURL, env.Storage, env.Store, env.ConvSvc)
+		},
+		Verify: verifyMexc,
+	})
+}
+
+func TestOkx(t *testing.T) {
+	Run(t, Driver{
+		Slug: models.ExchangeSlugOkx,
+		New: func(env Env) (exchange_manager.IExchangeClient, error) {
+			return okx.NewService(env.Logger, APIKey, SecretKey, Passphrase, env.BaseURL, env.Storage, env.Store, env.ConvSvc)
+		},
+		Verify: verifyOkx,
+	})
+}
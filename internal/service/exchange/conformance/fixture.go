@@ -0,0 +1,104 @@
+package conformance
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Case is a recorded call of one IExchangeClient method with the exchange API traffic it produced
+type Case struct {
+	Name         string          `json:"name"`
+	Method       string          `json:"method"`
+	Args         json.RawMessage `json:"args"`
+	State        State           `json:"state"`
+	Interactions []*Interaction  `json:"interactions"`
+	// Expect is matched as a subset of JSON encoded method result,
+	// decimals are compared as strings to catch precision loss
+	Expect json.RawMessage `json:"expect"`
+	// Error is name of exchangeclient sentinel the method must return
+	Error string `json:"error"`
+	// ErrorContains is checked against error text when no sentinel applies
+	ErrorContains string `json:"error_contains"`
+}
+
+// State is local data the driver reads apart from exchange API
+type State struct {
+	Chains   []Chain           `json:"chains"`
+	USDRates map[string]string `json:"usd_rates"`
+}
+
+type Chain struct {
+	CurrencyID string `json:"currency_id"`
+	Code       string `json:"code"`
+	Ticker     string `json:"ticker"`
+	Chain      string `json:"chain"`
+}
+
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+type Request struct {
+	Method string            `json:"method"`
+	Path   string            `json:"path"`
+	Query  map[string]string `json:"query"`
+	// Body is matched as a subset of JSON request body
+	Body json.RawMessage `json:"body"`
+	// Form is matched against url encoded request body
+	Form map[string]string `json:"form"`
+	// Signed requests are checked by driver signature verifier
+	Signed bool `json:"signed"`
+}
+
+type Response struct {
+	Status int             `json:"status"`
+	Body   json.RawMessage `json:"body"`
+}
+
+// LoadCases reads every *.json case from dir, file name is used when case has no name
+func LoadCases(dir string) ([]*Case, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no conformance cases in %s", dir)
+	}
+
+	cases := make([]*Case, 0, len(files))
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		// unknown keys are rejected, a misspelled expectation must not pass silently
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+
+		c := &Case{}
+		if err := dec.Decode(c); err != nil {
+			return nil, fmt.Errorf("parse %s: %w", file, err)
+		}
+		if c.Name == "" {
+			c.Name = strings.TrimSuffix(filepath.Base(file), ".json")
+		}
+		if _, ok := methods[c.Method]; !ok {
+			return nil, fmt.Errorf("%s: unknown method %q", file, c.Method)
+		}
+		if c.Error != "" {
+			if _, ok := sentinels[c.Error]; !ok {
+				return nil, fmt.Errorf("%s: unknown sentinel error %q", file, c.Error)
+			}
+		}
+
+		cases = append(cases, c)
+	}
+
+	return cases, nil
+}
@@ -0,0 +1,52 @@
+package conformance
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// subset checks that every field of expected JSON value is present in actual one,
+// arrays must have the same length and are compared element by element,
+// decimals are encoded as strings so "0.10" does not match "0.1"
+func subset(expected, actual any) error {
+	return subsetAt("$", expected, actual)
+}
+
+func subsetAt(path string, expected, actual any) error {
+	switch exp := expected.(type) {
+	case map[string]any:
+		act, ok := actual.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s: expected object, got %v", path, actual)
+		}
+		for k, v := range exp {
+			av, ok := act[k]
+			if !ok {
+				return fmt.Errorf("%s.%s: missing", path, k)
+			}
+			if err := subsetAt(path+"."+k, v, av); err != nil {
+				return err
+			}
+		}
+		return nil
+	case []any:
+		act, ok := actual.([]any)
+		if !ok {
+			return fmt.Errorf("%s: expected array, got %v", path, actual)
+		}
+		if len(exp) != len(act) {
+			return fmt.Errorf("%s: expected %d items, got %d", path, len(exp), len(act))
+		}
+		for i := range exp {
+			if err := subsetAt(fmt.Sprintf("%s[%d]", path, i), exp[i], act[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		if !reflect.DeepEqual(expected, actual) {
+			return fmt.Errorf("%s: expected %v, got %v", path, expected, actual)
+		}
+		return nil
+	}
+}
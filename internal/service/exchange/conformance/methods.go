@@ -0,0 +1,144 @@
+package conformance
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/exchange_manager"
+)
+
+type invoker func(ctx context.Context, client exchange_manager.IExchangeClient, args json.RawMessage) (any, error)
+
+// methods covers every IExchangeClient method, args of a case are decoded into method parameters
+var methods = map[string]invoker{
+	"TestConnection": func(ctx context.Context, client exchange_manager.IExchangeClient, _ json.RawMessage) (any, error) {
+		return nil, client.TestConnection(ctx)
+	},
+	"GetKeyPermissions": func(ctx context.Context, client exchange_manager.IExchangeClient, _ json.RawMessage) (any, error) {
+		return client.GetKeyPermissions(ctx)
+	},
+	"GetAccountBalance": func(ctx context.Context, client exchange_manager.IExchangeClient, _ json.RawMessage) (any, error) {
+		return client.GetAccountBalance(ctx)
+	},
+	"GetCurrencyBalance": func(ctx context.Context, client exchange_manager.IExchangeClient, args json.RawMessage) (any, error) {
+		var params struct {
+			Currency string `json:"currency"`
+		}
+		if err := decode(args, &params); err != nil {
+			return nil, err
+		}
+		return client.GetCurrencyBalance(ctx, params.Currency)
+	},
+	"GetExchangeSymbols": func(ctx context.Context, client exchange_manager.IExchangeClient, _ json.RawMessage) (any, error) {
+		return client.GetExchangeSymbols(ctx)
+	},
+	"GetDepositAddresses": func(ctx context.Context, client exchange_manager.IExchangeClient, args json.RawMessage) (any, error) {
+		var params struct {
+			Currency string `json:"currency"`
+			Network  string `json:"network"`
+		}
+		if err := decode(args, &params); err != nil {
+			return nil, err
+		}
+		return client.GetDepositAddresses(ctx, params.Currency, params.Network)
+	},
+	"CreateWithdrawalOrder": func(ctx context.Context, client exchange_manager.IExchangeClient, args json.RawMessage) (any, error) {
+		params := &models.CreateWithdrawalOrderParams{}
+		if err := decode(args, params); err != nil {
+			return nil, err
+		}
+		return client.CreateWithdrawalOrder(ctx, params)
+	},
+	"CreateSpotOrder": func(ctx context.Context, client exchange_manager.IExchangeClient, args json.RawMessage) (any, error) {
+		var params struct {
+			From   string                `json:"from"`
+			To     string                `json:"to"`
+			Side   string                `json:"side"`
+			Ticker string                `json:"ticker"`
+			Amount decimal.Decimal       `json:"amount"`
+			Rule   *models.OrderRulesDTO `json:"rule"`
+		}
+		if err := decode(args, &params); err != nil {
+			return nil, err
+		}
+		return client.CreateSpotOrder(ctx, params.From, params.To, params.Side, params.Ticker, &params.Amount, params.Rule)
+	},
+	"CreateLimitOrder": func(ctx context.Context, client exchange_manager.IExchangeClient, args json.RawMessage) (any, error) {
+		params := &models.CreateLimitOrderParams{}
+		if err := decode(args, params); err != nil {
+			return nil, err
+		}
+		return client.CreateLimitOrder(ctx, params)
+	},
+	"CancelOrder": func(ctx context.Context, client exchange_manager.IExchangeClient, args json.RawMessage) (any, error) {
+		params := &models.CancelOrderParams{}
+		if err := decode(args, params); err != nil {
+			return nil, err
+		}
+		return nil, client.CancelOrder(ctx, params)
+	},
+	"GetOrderRule": func(ctx context.Context, client exchange_manager.IExchangeClient, args json.RawMessage) (any, error) {
+		var params struct {
+			Ticker string `json:"ticker"`
+		}
+		if err := decode(args, &params); err != nil {
+			return nil, err
+		}
+		return client.GetOrderRule(ctx, params.Ticker)
+	},
+	"GetOrderRules": func(ctx context.Context, client exchange_manager.IExchangeClient, args json.RawMessage) (any, error) {
+		var params struct {
+			Tickers []string `json:"tickers"`
+		}
+		if err := decode(args, &params); err != nil {
+			return nil, err
+		}
+		return client.GetOrderRules(ctx, params.Tickers...)
+	},
+	"GetTickerPrice": func(ctx context.Context, client exchange_manager.IExchangeClient, args json.RawMessage) (any, error) {
+		var params struct {
+			Ticker string `json:"ticker"`
+		}
+		if err := decode(args, &params); err != nil {
+			return nil, err
+		}
+		return client.GetTickerPrice(ctx, params.Ticker)
+	},
+	"GetOrderDetails": func(ctx context.Context, client exchange_manager.IExchangeClient, args json.RawMessage) (any, error) {
+		params := &models.GetOrderByIDParams{}
+		if err := decode(args, params); err != nil {
+			return nil, err
+		}
+		return client.GetOrderDetails(ctx, params)
+	},
+	"GetWithdrawalRules": func(ctx context.Context, client exchange_manager.IExchangeClient, args json.RawMessage) (any, error) {
+		var params struct {
+			Currencies []string `json:"currencies"`
+		}
+		if err := decode(args, &params); err != nil {
+			return nil, err
+		}
+		return client.GetWithdrawalRules(ctx, params.Currencies...)
+	},
+	"GetWithdrawalByID": func(ctx context.Context, client exchange_manager.IExchangeClient, args json.RawMessage) (any, error) {
+		params := &models.GetWithdrawalByIDParams{}
+		if err := decode(args, params); err != nil {
+			return nil, err
+		}
+		return client.GetWithdrawalByID(ctx, params)
+	},
+	"GetConnectionHash": func(_ context.Context, client exchange_manager.IExchangeClient, _ json.RawMessage) (any, error) {
+		return client.GetConnectionHash(), nil
+	},
+}
+
+// decode reads case args, params without json tags are matched by field name
+func decode(args json.RawMessage, dest any) error {
+	if len(args) == 0 {
+		return nil
+	}
+	return json.Unmarshal(args, dest)
+}
@@ -0,0 +1,138 @@
+package conformance
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// SignatureVerifier recomputes signature of a private request with the test secret
+type SignatureVerifier func(r *http.Request, body []byte) error
+
+// replayServer answers driver requests with recorded responses, every request must match
+// an unused interaction and every interaction must be used by the end of a case
+type replayServer struct {
+	*httptest.Server
+
+	mu           sync.Mutex
+	interactions []*Interaction
+	used         []bool
+	verify       SignatureVerifier
+	failures     []string
+}
+
+func newReplayServer(interactions []*Interaction, verify SignatureVerifier) *replayServer {
+	s := &replayServer{
+		interactions: interactions,
+		used:         make([]bool, len(interactions)),
+		verify:       verify,
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+func (s *replayServer) serve(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		s.fail("read request body: %s", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	s.mu.Lock()
+	idx := -1
+	for i, interaction := range s.interactions {
+		if !s.used[i] && interaction.Request.matches(r, body) {
+			idx = i
+			s.used[i] = true
+			break
+		}
+	}
+	s.mu.Unlock()
+
+	if idx < 0 {
+		s.fail("unexpected request %s %s?%s body=%s", r.Method, r.URL.Path, r.URL.RawQuery, body)
+		w.WriteHeader(http.StatusNotImplemented)
+		return
+	}
+
+	interaction := s.interactions[idx]
+	if interaction.Request.Signed {
+		if s.verify == nil {
+			s.fail("%s %s: signed request without signature verifier", r.Method, r.URL.Path)
+		} else if err := s.verify(r, body); err != nil {
+			s.fail("%s %s: signature: %s", r.Method, r.URL.Path, err)
+		}
+	}
+
+	status := interaction.Response.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(interaction.Response.Body)
+}
+
+func (s *replayServer) fail(format string, args ...any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = append(s.failures, fmt.Sprintf(format, args...))
+}
+
+// problems returns mismatched requests, bad signatures and unused interactions
+func (s *replayServer) problems() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res := append([]string{}, s.failures...)
+	for i, used := range s.used {
+		if !used {
+			req := s.interactions[i].Request
+			res = append(res, fmt.Sprintf("interaction %s %s was not requested", req.Method, req.Path))
+		}
+	}
+	return res
+}
+
+func (o Request) matches(r *http.Request, body []byte) bool {
+	if !strings.EqualFold(o.Method, r.Method) || o.Path != r.URL.Path {
+		return false
+	}
+
+	query := r.URL.Query()
+	for k, v := range o.Query {
+		if !query.Has(k) || query.Get(k) != v {
+			return false
+		}
+	}
+
+	if len(o.Form) > 0 {
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return false
+		}
+		for k, v := range o.Form {
+			if !form.Has(k) || form.Get(k) != v {
+				return false
+			}
+		}
+	}
+
+	if len(o.Body) == 0 {
+		return true
+	}
+
+	var expected, actual any
+	if json.Unmarshal(o.Body, &expected) != nil || json.Unmarshal(body, &actual) != nil {
+		return false
+	}
+	return subset(expected, actual) == nil
+}
@@ -0,0 +1,278 @@
+package conformance
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+func hmacSHA256(data string) []byte {
+	h := hmac.New(sha256.New, []byte(SecretKey))
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func checkValue(name, expected, got string) error {
+	if got != expected {
+		return fmt.Errorf("%s: expected %q, got %q", name, expected, got)
+	}
+	return nil
+}
+
+func checkHeader(r *http.Request, name, expected string) error {
+	return checkValue("header "+name, expected, r.Header.Get(name))
+}
+
+// verifyBinance checks hex HMAC-SHA256 of query string without the signature
+func verifyBinance(r *http.Request, _ []byte) error {
+	if err := checkHeader(r, "X-MBX-APIKEY", APIKey); err != nil {
+		return err
+	}
+
+	q := r.URL.Query()
+	signature := q.Get("signature")
+	q.Del("signature")
+	if q.Get("timestamp") == "" {
+		return fmt.Errorf("missing timestamp")
+	}
+
+	return checkValue("signature", hex.EncodeToString(hmacSHA256(q.Encode())), signature)
+}
+
+// verifyMexc checks hex HMAC-SHA256 of query string, signature is always the last parameter
+func verifyMexc(r *http.Request, _ []byte) error {
+	if err := checkHeader(r, "X-MEXC-APIKEY", APIKey); err != nil {
+		return err
+	}
+
+	message, signature, ok := strings.Cut(r.URL.RawQuery, "&signature=")
+	if !ok {
+		return fmt.Errorf("missing signature")
+	}
+
+	return checkValue("signature", hex.EncodeToString(hmacSHA256(message)), signature)
+}
+
+// verifyOkx checks base64 HMAC-SHA256 of timestamp, method, request path and body
+func verifyOkx(r *http.Request, body []byte) error {
+	if err := checkHeader(r, "OK-ACCESS-KEY", APIKey); err != nil {
+		return err
+	}
+	if err := checkHeader(r, "OK-ACCESS-PASSPHRASE", Passphrase); err != nil {
+		return err
+	}
+
+	payload := string(body)
+	if payload == "{}" {
+		payload = ""
+	}
+	prehash := r.Header.Get("OK-ACCESS-TIMESTAMP") + r.Method + r.URL.RequestURI() + payload
+
+	return checkHeader(r, "OK-ACCESS-SIGN", base64.StdEncoding.EncodeToString(hmacSHA256(prehash)))
+}
+
+// verifyKucoin checks base64 HMAC-SHA256 of timestamp, method, request path and body,
+// passphrase is signed with the secret as well
+func verifyKucoin(r *http.Request, body []byte) error {
+	if err := checkHeader(r, "KC-API-KEY", APIKey); err != nil {
+		return err
+	}
+	if err := checkHeader(r, "KC-API-PASSPHRASE", base64.StdEncoding.EncodeToString(hmacSHA256(Passphrase))); err != nil {
+		return err
+	}
+
+	prehash := r.Header.Get("KC-API-TIMESTAMP") + r.Method + r.URL.RequestURI() + string(body)
+
+	return checkHeader(r, "KC-API-SIGN", base64.StdEncoding.EncodeToString(hmacSHA256(prehash)))
+}
+
+// verifyBitget checks base64 HMAC-SHA256 of timestamp, method, path, sorted query and body
+func verifyBitget(r *http.Request, body []byte) error {
+	if err := checkHeader(r, "ACCESS-KEY", APIKey); err != nil {
+		return err
+	}
+	if err := checkHeader(r, "ACCESS-PASSPHRASE", Passphrase); err != nil {
+		return err
+	}
+
+	prehash := r.Header.Get("ACCESS-TIMESTAMP") + r.Method + r.URL.Path
+	if r.URL.RawQuery != "" {
+		query, err := unescapedQuery(r)
+		if err != nil {
+			return err
+		}
+		prehash += "?" + query
+	}
+	prehash += string(body)
+
+	return checkHeader(r, "ACCESS-SIGN", base64.StdEncoding.EncodeToString(hmacSHA256(prehash)))
+}
+
+// verifyBybit checks hex HMAC-SHA256 of timestamp, key, receive window and query or body
+func verifyBybit(r *http.Request, body []byte) error {
+	if err := checkHeader(r, "X-BAPI-API-KEY", APIKey); err != nil {
+		return err
+	}
+
+	payload := r.URL.RawQuery
+	if r.Method == http.MethodPost {
+		payload = string(body)
+	}
+	prehash := r.Header.Get("X-BAPI-TIMESTAMP") + APIKey + r.Header.Get("X-BAPI-RECV-WINDOW") + payload
+
+	return checkHeader(r, "X-BAPI-SIGN", hex.EncodeToString(hmacSHA256(prehash)))
+}
+
+// verifyGate checks hex HMAC-SHA512 of method, path, query, body hash and timestamp
+func verifyGate(r *http.Request, body []byte) error {
+	if err := checkHeader(r, "KEY", APIKey); err != nil {
+		return err
+	}
+
+	bodyHash := sha512.Sum512(body)
+	prehash := strings.Join([]string{
+		r.Method,
+		r.URL.Path,
+		r.URL.RawQuery,
+		hex.EncodeToString(bodyHash[:]),
+		r.Header.Get("Timestamp"),
+	}, "\n")
+
+	h := hmac.New(sha512.New, []byte(SecretKey))
+	h.Write([]byte(prehash))
+
+	return checkHeader(r, "SIGN", hex.EncodeToString(h.Sum(nil)))
+}
+
+// verifyHtx checks base64 HMAC-SHA256 of method, host, path and sorted query without the signature
+func verifyHtx(r *http.Request, _ []byte) error {
+	q := r.URL.Query()
+	if err := checkValue("AccessKeyId", APIKey, q.Get("AccessKeyId")); err != nil {
+		return err
+	}
+
+	signature := q.Get("Signature")
+	q.Del("Signature")
+	prehash := strings.Join([]string{r.Method, r.Host, r.URL.Path, q.Encode()}, "\n")
+
+	return checkValue("Signature", base64.StdEncoding.EncodeToString(hmacSHA256(prehash)), signature)
+}
+
+// krakenSecret is the test secret in the base64 form kraken issues secrets in
+var krakenSecret = base64.StdEncoding.EncodeToString([]byte(SecretKey))
+
+// verifyKraken checks base64 HMAC-SHA512 of path and SHA256 of nonce with the form body
+func verifyKraken(r *http.Request, body []byte) error {
+	if err := checkHeader(r, "API-Key", APIKey); err != nil {
+		return err
+	}
+
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return err
+	}
+	nonce := form.Get("nonce")
+	if nonce == "" {
+		return fmt.Errorf("missing nonce")
+	}
+
+	payload := sha256.Sum256([]byte(nonce + string(body)))
+	h := hmac.New(sha512.New, []byte(SecretKey))
+	h.Write([]byte(r.URL.Path))
+	h.Write(payload[:])
+
+	return checkHeader(r, "API-Sign", base64.StdEncoding.EncodeToString(h.Sum(nil)))
+}
+
+// coinbaseKey replaces the secret for coinbase, CDP keys are EC private keys
+var coinbaseKey, coinbaseSecret = func() (*ecdsa.PrivateKey, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		panic(err)
+	}
+	return key, string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}))
+}()
+
+// verifyCoinbase checks ES256 bearer token issued for the key and bound to method, host and path
+func verifyCoinbase(r *http.Request, _ []byte) error {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return fmt.Errorf("missing bearer token")
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return fmt.Errorf("malformed token")
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	var claims struct {
+		Sub string `json:"sub"`
+		URI string `json:"uri"`
+	}
+	for i, dest := range []any{&header, &claims} {
+		data, err := base64.RawURLEncoding.DecodeString(parts[i])
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(data, dest); err != nil {
+			return err
+		}
+	}
+	if err := checkValue("alg", "ES256", header.Alg); err != nil {
+		return err
+	}
+	if err := checkValue("kid", APIKey, header.Kid); err != nil {
+		return err
+	}
+	if err := checkValue("sub", APIKey, claims.Sub); err != nil {
+		return err
+	}
+	if err := checkValue("uri", r.Method+" "+r.Host+r.URL.Path, claims.URI); err != nil {
+		return err
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return err
+	}
+	if len(signature) != 64 {
+		return fmt.Errorf("signature: expected 64 bytes, got %d", len(signature))
+	}
+
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	rs, ss := new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:])
+	if !ecdsa.Verify(&coinbaseKey.PublicKey, digest[:], rs, ss) {
+		return fmt.Errorf("signature does not match")
+	}
+	return nil
+}
+
+// unescapedQuery joins sorted query parameters the way bitget signer does
+func unescapedQuery(r *http.Request) (string, error) {
+	query, err := url.QueryUnescape(r.URL.Query().Encode())
+	if err != nil {
+		return "", err
+	}
+	return query, nil
+}
@@ -0,0 +1,124 @@
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/currconv"
+	"github.com/dv-net/dv-merchant/internal/storage"
+	"github.com/dv-net/dv-merchant/internal/storage/repos"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_exchange_chains"
+)
+
+// chainStorage exposes only exchange chains, drivers must not touch other repositories
+type chainStorage struct {
+	storage.IStorage
+	chains *chainsQuerier
+}
+
+func (o chainStorage) ExchangeChains(...repos.Option) repo_exchange_chains.Querier {
+	return o.chains
+}
+
+// chainsQuerier answers exchange chain lookups from case state
+type chainsQuerier struct {
+	chains []Chain
+}
+
+var _ repo_exchange_chains.Querier = (*chainsQuerier)(nil)
+
+func (o *chainsQuerier) find(match func(Chain) bool) (Chain, error) {
+	for _, c := range o.chains {
+		if match(c) {
+			return c, nil
+		}
+	}
+	return Chain{}, pgx.ErrNoRows
+}
+
+func (o *chainsQuerier) GetAll(context.Context) ([]*repo_exchange_chains.GetAllRow, error) {
+	return nil, fmt.Errorf("exchange chains GetAll is not supported by conformance state")
+}
+
+func (o *chainsQuerier) GetByID(context.Context, uuid.UUID) (*models.ExchangeChain, error) {
+	return nil, fmt.Errorf("exchange chains GetByID is not supported by conformance state")
+}
+
+func (o *chainsQuerier) GetCurrencyIDByParams(_ context.Context, arg repo_exchange_chains.GetCurrencyIDByParamsParams) (string, error) {
+	c, err := o.find(func(c Chain) bool {
+		return strings.EqualFold(c.Ticker, arg.Ticker) && strings.EqualFold(c.Chain, arg.Chain)
+	})
+	return c.CurrencyID, err
+}
+
+func (o *chainsQuerier) GetCurrencyIDBySlugAndChain(_ context.Context, arg repo_exchange_chains.GetCurrencyIDBySlugAndChainParams) (string, error) {
+	c, err := o.find(func(c Chain) bool { return strings.EqualFold(c.Chain, arg.Chain) })
+	return c.CurrencyID, err
+}
+
+func (o *chainsQuerier) GetCurrencyIDByTicker(_ context.Context, ticker string) (string, error) {
+	c, err := o.find(func(c Chain) bool { return strings.EqualFold(c.Ticker, ticker) })
+	return c.CurrencyID, err
+}
+
+func (o *chainsQuerier) GetEnabledCurrencies(context.Context, models.ExchangeSlug) ([]*repo_exchange_chains.GetEnabledCurrenciesRow, error) {
+	rows := make([]*repo_exchange_chains.GetEnabledCurrenciesRow, 0, len(o.chains))
+	for _, c := range o.chains {
+		rows = append(rows, &repo_exchange_chains.GetEnabledCurrenciesRow{
+			ID:     pgtype.Text{String: c.CurrencyID, Valid: true},
+			Code:   pgtype.Text{String: c.Code, Valid: true},
+			Name:   pgtype.Text{String: c.Code, Valid: true},
+			Ticker: c.Ticker,
+			Chain:  c.Chain,
+		})
+	}
+	return rows, nil
+}
+
+func (o *chainsQuerier) GetTickerByCurrencyID(_ context.Context, arg repo_exchange_chains.GetTickerByCurrencyIDParams) (string, error) {
+	c, err := o.find(func(c Chain) bool { return c.CurrencyID == arg.CurrencyID })
+	return c.Ticker, err
+}
+
+// usdConvertor converts amounts by USD rates of case state
+type usdConvertor struct {
+	rates map[string]string
+}
+
+var _ currconv.ICurrencyConvertor = (*usdConvertor)(nil)
+
+func (o *usdConvertor) Convert(_ context.Context, dto currconv.ConvertDTO) (decimal.Decimal, error) {
+	amount, err := decimal.NewFromString(dto.Amount)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	rate := func(currency string) (decimal.Decimal, error) {
+		if strings.EqualFold(currency, models.CurrencyCodeUSD) || strings.EqualFold(currency, models.CurrencyCodeUSDT) {
+			return decimal.NewFromInt(1), nil
+		}
+		v, ok := o.rates[strings.ToUpper(currency)]
+		if !ok {
+			return decimal.Zero, fmt.Errorf("no usd rate for %s", currency)
+		}
+		return decimal.NewFromString(v)
+	}
+
+	from, err := rate(dto.From)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	to, err := rate(dto.To)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return amount.Mul(from).Div(to), nil
+}
@@ -0,0 +1,27 @@
+package exchange
+
+import (
+	"fmt"
+
+	"github.com/dv-net/dv-merchant/internal/event"
+	"github.com/dv-net/dv-merchant/internal/models"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const OrderFilledEventType = "exchange_order_filled"
+
+// OrderFilledEvent is fired once an exchange reports the order as completed
+type OrderFilledEvent struct {
+	Order models.ExchangeOrder
+	Slug  models.ExchangeSlug
+	DBTx  pgx.Tx
+}
+
+func (e OrderFilledEvent) Type() event.Type {
+	return OrderFilledEventType
+}
+
+func (e OrderFilledEvent) String() string {
+	return fmt.Sprintf("ExchangeOrderFilled: order=%s, exchange=%s", e.Order.ID, e.Slug)
+}
@@ -27,7 +27,7 @@ import (
 )
 
 var (
-	ErrInsufficientBalance         = errors.New("insufficient balance")
+	ErrInsufficientBalance         = exchangeclient.ErrInsufficientBalance
 	ErrUnprocessableCurrencyStatus = errors.New("unprocessable currency status")
 	ErrMaxOrderValueReached        = errors.New("max order value reached")
 )
@@ -74,6 +74,18 @@ func (o *Service) TestConnection(ctx context.Context) error {
 	return nil
 }
 
+// GetKeyPermissions reports ip binding only, gate does not expose rights of the key
+func (o *Service) GetKeyPermissions(ctx context.Context) (*models.KeyPermissionsDTO, error) {
+	res, err := o.exClient.Account().GetAccountDetail(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.KeyPermissionsDTO{
+		IPRestricted: lo.ToPtr(len(res.Data.IPWhitelist) > 0),
+	}, nil
+}
+
 func (o *Service) GetAccountBalance(ctx context.Context) ([]*models.AccountBalanceDTO, error) {
 	balances, err := o.exClient.Spot().GetSpotAccountBalances(ctx, &gateio.GetSpotAccountBalancesRequest{})
 	if err != nil {
@@ -81,12 +93,12 @@ func (o *Service) GetAccountBalance(ctx context.Context) ([]*models.AccountBalan
 	}
 	accountBalances := make([]*models.AccountBalanceDTO, 0, len(balances.Data))
 	for _, balance := range balances.Data {
-		currencyID, err := o.storage.ExchangeChains().GetCurrencyIDByParams(ctx, repo_exchange_chains.GetCurrencyIDByParamsParams{
-			Ticker: balance.Currency,
-			Slug:   models.ExchangeSlugGateio,
-		})
-		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
-			continue
+		currencyID, err := o.storage.ExchangeChains().GetCurrencyIDByTicker(ctx, balance.Currency)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				continue
+			}
+			return nil, fmt.Errorf("get internal currency id for %s: %w", balance.Currency, err)
 		}
 		amountUSD, err := o.convSvc.Convert(ctx, currconv.ConvertDTO{
 			Source: models.ExchangeSlugGateio.String(),
@@ -116,6 +128,10 @@ func (o *Service) GetCurrencyBalance(ctx context.Context, currency string) (*dec
 	if err != nil {
 		return nil, fmt.Errorf("get account balance: %w", err)
 	}
+	// currency never held on spot account is not listed
+	if len(balance.Data) == 0 {
+		return &decimal.Zero, nil
+	}
 	return &balance.Data[0].Available, nil
 }
 
@@ -251,6 +267,19 @@ func (o *Service) GetOrderRules(ctx context.Context, tickers ...string) ([]*mode
 	return rules, nil
 }
 
+func (o *Service) GetTickerPrice(ctx context.Context, ticker string) (*models.TickerPriceDTO, error) {
+	res, err := o.exClient.Spot().GetTickersInfo(ctx, &gateio.GetTickersInfoRequest{
+		CurrencyPair: ticker,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get ticker info for %s: %w", ticker, err)
+	}
+	if len(res.Data) == 0 {
+		return nil, fmt.Errorf("ticker %s not found", ticker)
+	}
+	return &models.TickerPriceDTO{Symbol: ticker, Bid: res.Data[0].HighestBid, Ask: res.Data[0].LowestAsk}, nil
+}
+
 func (o *Service) GetWithdrawalRules(ctx context.Context, currencies ...string) ([]*models.WithdrawalRulesDTO, error) {
 	currEnabled, err := o.storage.ExchangeChains().GetEnabledCurrencies(ctx, models.ExchangeSlugGateio)
 	if err != nil {
@@ -402,10 +431,9 @@ func (o *Service) CreateSpotOrder(ctx context.Context, _ string, _ string, side
 		if err != nil {
 			return nil, fmt.Errorf("get base currency balance %s: %w", tradingSymbol.Data.Base, err)
 		}
-		if len(res.Data) == 0 {
-			return nil, fmt.Errorf("base currency %s not found", tradingSymbol.Data.Base)
+		if len(res.Data) > 0 {
+			baseBalance = baseBalance.Add(res.Data[0].Available)
 		}
-		baseBalance = baseBalance.Add(res.Data[0].Available)
 	}
 
 	quoteBalance := decimal.Zero
@@ -416,10 +444,9 @@ func (o *Service) CreateSpotOrder(ctx context.Context, _ string, _ string, side
 		if err != nil {
 			return nil, fmt.Errorf("get quote currency balance %s: %w", tradingSymbol.Data.Quote, err)
 		}
-		if len(res.Data) == 0 {
-			return nil, fmt.Errorf("quote currency %s not found", tradingSymbol.Data.Quote)
+		if len(res.Data) > 0 {
+			quoteBalance = quoteBalance.Add(res.Data[0].Available)
 		}
-		quoteBalance = quoteBalance.Add(res.Data[0].Available)
 	}
 
 	orderMinimumBase, err := decimal.NewFromString(rule.MinOrderAmount)
@@ -470,6 +497,75 @@ func (o *Service) CreateSpotOrder(ctx context.Context, _ string, _ string, side
 	}, nil
 }
 
+func (o *Service) CreateLimitOrder(ctx context.Context, args *models.CreateLimitOrderParams) (*models.ExchangeOrderDTO, error) {
+	tradingSymbol, err := o.exClient.Spot().GetSpotSupportedCurrencyPair(ctx, args.Symbol)
+	if err != nil {
+		return nil, fmt.Errorf("get trading symbol %s: %w", args.Symbol, err)
+	}
+	if tradingSymbol.Data.TradeStatus != gateio.PairTradeStatusTradable.String() {
+		return nil, fmt.Errorf("trading is disabled for symbol %s", args.Symbol)
+	}
+
+	minBase, err := decimal.NewFromString(tradingSymbol.Data.MinBaseAmount)
+	if err != nil {
+		return nil, fmt.Errorf("parse min base amount %s: %w", tradingSymbol.Data.MinBaseAmount, err)
+	}
+	minQuote, err := decimal.NewFromString(tradingSymbol.Data.MinQuoteAmount)
+	if err != nil {
+		return nil, fmt.Errorf("parse min quote amount %s: %w", tradingSymbol.Data.MinQuoteAmount, err)
+	}
+
+	price := args.Price.RoundFloor(int32(tradingSymbol.Data.Precision))
+	if args.Side == models.OrderSideSell {
+		price = args.Price.RoundCeil(int32(tradingSymbol.Data.Precision))
+	}
+	qty := args.Amount.RoundDown(int32(tradingSymbol.Data.AmountPrecision))
+	if qty.LessThan(minBase) || qty.Mul(price).LessThan(minQuote) {
+		return nil, exchangeclient.ErrMinOrderValue
+	}
+
+	currency, spent := tradingSymbol.Data.Base, qty
+	if args.Side == models.OrderSideBuy {
+		currency, spent = tradingSymbol.Data.Quote, qty.Mul(price)
+	}
+	balances, err := o.exClient.Spot().GetSpotAccountBalances(ctx, &gateio.GetSpotAccountBalancesRequest{
+		Currency: currency,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get currency balance %s: %w", currency, err)
+	}
+	if len(balances.Data) == 0 || balances.Data[0].Available.LessThan(spent) {
+		return nil, exchangeclient.ErrInsufficientBalance
+	}
+
+	placedOrder, err := o.exClient.Spot().CreateSpotOrder(ctx, &gateio.CreateSpotOrderRequest{
+		CurrencyPair: args.Symbol,
+		Type:         gateio.OrderTypeLimit.String(),
+		Side:         args.Side.String(),
+		Amount:       qty.String(),
+		Price:        price.String(),
+		TimeInForce:  args.TimeInForce.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create spot order: %w", err)
+	}
+	if placedOrder.Data == nil || placedOrder.Data.ID == "" {
+		return nil, fmt.Errorf("failed to create spot order for %s", args.Symbol)
+	}
+
+	return &models.ExchangeOrderDTO{
+		ExchangeOrderID: placedOrder.Data.ID,
+		Amount:          qty,
+	}, nil
+}
+
+func (o *Service) CancelOrder(ctx context.Context, args *models.CancelOrderParams) error {
+	_, err := o.exClient.Spot().CancelSpotOrder(ctx, args.ExternalOrderID, &gateio.CancelSpotOrderRequest{
+		CurrencyPair: args.Symbol,
+	})
+	return err
+}
+
 func (o *Service) CreateWithdrawalOrder(ctx context.Context, args *models.CreateWithdrawalOrderParams) (*models.ExchangeWithdrawalDTO, error) {
 	args.NativeAmount = args.NativeAmount.RoundDown(int32(args.WithdrawalPrecision))
 
@@ -602,6 +698,10 @@ func (o *Service) GetOrderDetails(ctx context.Context, args *models.GetOrderByID
 		if res.Data.FinishAs.IsFailed() {
 			order.State = models.ExchangeOrderStatusFailed
 		}
+		// limit order may be cancelled after partial fill, filled part is kept
+		if res.Data.Type == gateio.OrderTypeLimit && res.Data.Status == gateio.OrderStatusCanceled {
+			order.State = models.ExchangeOrderStatusCancelled
+		}
 
 		pair, err := o.exClient.Spot().GetSpotSupportedCurrencyPair(ctx, res.Data.CurrencyPair)
 		if err != nil {
@@ -621,7 +721,16 @@ func (o *Service) GetOrderDetails(ctx context.Context, args *models.GetOrderByID
 		}
 		order.Amount = orderAmount
 
-		switch res.Data.Side {
+		// limit order amount is in base currency on both sides, so it is valued like market sell
+		side := res.Data.Side
+		if res.Data.Type == gateio.OrderTypeLimit {
+			if orderAmount, err = decimal.NewFromString(res.Data.FilledAmount); err != nil {
+				return nil, fmt.Errorf("parse filled amount %s: %w", res.Data.FilledAmount, err)
+			}
+			side = gateio.OrderSideSell
+		}
+
+		switch side {
 		case gateio.OrderSideBuy:
 			// For market BUY: orderAmount is in QUOTE currency
 			// Calculate amount of BASE received = orderAmount / price
@@ -8,6 +8,7 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dv-net/dv-merchant/internal/models"
 	"github.com/dv-net/dv-merchant/internal/service/currconv"
@@ -28,7 +29,7 @@ import (
 )
 
 var (
-	ErrInsufficientBalance           = errors.New("insufficient balance")
+	ErrInsufficientBalance           = exchangeclient.ErrInsufficientBalance
 	ErrUnprocessableCurrencyState    = errors.New("unprocessable currency state")
 	ErrTradingDisabled               = errors.New("trading is disabled")
 	ErrMaxOrderValueReached          = errors.New("max order value reached")
@@ -80,7 +81,7 @@ func (o *Service) getOrderDetailsExternal(ctx context.Context, order *models.Ord
 		return nil, err
 	}
 	if res.Order != nil {
-		amt, err := decimal.NewFromString(res.Order.Amount)
+		amt, err := orderAmount(res.Order)
 		if err != nil {
 			o.l.Errorw("failed to parse order amount", "error", err, "exchange_slug", models.ExchangeSlugHtx, "external_order_id", externalOrderID, "amount", res.Order.Amount, "connection_hash", o.connHash)
 			return nil, err
@@ -94,12 +95,7 @@ func (o *Service) getOrderDetailsExternal(ctx context.Context, order *models.Ord
 		}
 		order.AmountUSD = amtUSD
 
-		switch res.Order.State {
-		case htxmodels.OrderStateFilled:
-			order.State = models.ExchangeOrderStatusCompleted
-		default:
-			order.State = models.ExchangeOrderStatusInProgress
-		}
+		order.State = orderState(res.Order.State)
 
 		o.l.Infow("order details retrieved successfully", "exchange_slug", models.ExchangeSlugHtx, "external_order_id", externalOrderID, "state", res.Order.State.String(), "amount", amt.String(), "amount_usd", amtUSD.String(), "connection_hash", o.connHash)
 	} else {
@@ -108,6 +104,27 @@ func (o *Service) getOrderDetailsExternal(ctx context.Context, order *models.Ord
 	return order, nil
 }
 
+// orderAmount returns filled base amount of limit order, market order keeps the placed amount
+func orderAmount(order *htxmodels.Order) (decimal.Decimal, error) {
+	switch order.Type {
+	case htxmodels.OrderTypeBuyMarket, htxmodels.OrderTypeSellMarket:
+		return decimal.NewFromString(order.Amount)
+	default:
+		return decimal.NewFromString(order.FieldAmount)
+	}
+}
+
+func orderState(state htxmodels.OrderState) models.ExchangeOrderStatus {
+	switch state {
+	case htxmodels.OrderStateFilled:
+		return models.ExchangeOrderStatusCompleted
+	case htxmodels.OrderStateCanceled, htxmodels.OrderStatePartialCanceled:
+		return models.ExchangeOrderStatusCancelled
+	default:
+		return models.ExchangeOrderStatusInProgress
+	}
+}
+
 func (o *Service) getNotionalUSD(ctx context.Context, instID string, side htxmodels.OrderType, amount decimal.Decimal) (decimal.Decimal, error) {
 	res, err := o.exClient.Common().GetAllSupportedSymbols(ctx)
 	if err != nil {
@@ -155,18 +172,13 @@ func (o *Service) getOrderDetailsInternal(ctx context.Context, order *models.Ord
 		return nil, err
 	}
 	if res.Order != nil {
-		amt, err := decimal.NewFromString(res.Order.Amount)
+		amt, err := orderAmount(res.Order)
 		if err != nil {
 			o.l.Errorw("failed to parse order amount", "error", err, "exchange_slug", models.ExchangeSlugHtx, "client_order_id", clientOrderID, "amount", res.Order.Amount, "connection_hash", o.connHash)
 			return nil, err
 		}
 		order.Amount = amt
-		switch res.Order.State {
-		case htxmodels.OrderStateFilled:
-			order.State = models.ExchangeOrderStatusCompleted
-		default:
-			order.State = models.ExchangeOrderStatusInProgress
-		}
+		order.State = orderState(res.Order.State)
 
 		o.l.Infow("internal order details retrieved successfully", "exchange_slug", models.ExchangeSlugHtx, "client_order_id", clientOrderID, "state", res.Order.State.String(), "amount", amt.String(), "connection_hash", o.connHash)
 	} else {
@@ -391,6 +403,98 @@ func (o *Service) CreateSpotOrder(ctx context.Context, _ string, _ string, side
 	return order, nil
 }
 
+func (o *Service) CreateLimitOrder(ctx context.Context, args *models.CreateLimitOrderParams) (*models.ExchangeOrderDTO, error) {
+	spotAccount, err := o.getSpotAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := o.exClient.Common().GetAllMarketSymbols(ctx, &htxrequests.GetMarketSymbolsRequest{
+		Symbols: args.Symbol,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.MarketSymbols) == 0 {
+		return nil, fmt.Errorf("symbol %s not found", args.Symbol)
+	}
+	symbol := res.MarketSymbols[0]
+
+	if symbol.State != htxmodels.SymbolStatusOnline {
+		return nil, fmt.Errorf("%w: symbol is not online", ErrUnprocessableCurrencyState)
+	}
+	if symbol.APITrading != "enabled" {
+		return nil, fmt.Errorf("%w: symbol is not enabled for trading", ErrTradingDisabled)
+	}
+
+	price := args.Price.RoundFloor(int32(symbol.PricePrecision))
+	if args.Side == models.OrderSideSell {
+		price = args.Price.RoundCeil(int32(symbol.PricePrecision))
+	}
+	qty := args.Amount.RoundDown(int32(symbol.AmountPrecision))
+	if qty.LessThan(decimal.NewFromFloat(symbol.MinOrderAmount)) || qty.Mul(price).LessThan(decimal.NewFromFloat(symbol.MinOrderValue)) {
+		return nil, exchangeclient.ErrMinOrderValue
+	}
+
+	balance, err := o.exClient.Account().GetAccountBalance(ctx, spotAccount)
+	if err != nil {
+		return nil, err
+	}
+	balances := lo.Filter(balance.Balances.List, func(item htxmodels.ListItem, _ int) bool {
+		return item.Type == htxmodels.BalanceTypeTrade
+	})
+
+	currency, spent := symbol.BaseCurrency, qty
+	if args.Side == models.OrderSideBuy {
+		currency, spent = symbol.QuoteCurrency, qty.Mul(price)
+	}
+	if o.getMaxAmount(currency, balances).LessThan(spent) {
+		return nil, exchangeclient.ErrInsufficientBalance
+	}
+
+	orderType := args.Side.String() + "-limit"
+	switch args.TimeInForce {
+	case models.TimeInForceIOC:
+		orderType = args.Side.String() + "-ioc"
+	case models.TimeInForceFOK:
+		orderType = args.Side.String() + "-limit-fok"
+	}
+
+	orderID, err := uuid.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	placeOrderResponse, err := o.exClient.Order().PlaceOrder(ctx, &htxrequests.PlaceOrderRequest{
+		AccountID:     strconv.FormatInt(spotAccount.ID, 10),
+		Symbol:        symbol.Symbol,
+		Type:          orderType,
+		Amount:        qty.String(),
+		Price:         price.String(),
+		ClientOrderID: orderID.String(),
+		Source:        "spot-api",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to place order: %w", err)
+	}
+
+	return &models.ExchangeOrderDTO{
+		ExchangeOrderID: placeOrderResponse.OrderID,
+		ClientOrderID:   orderID.String(),
+		Amount:          qty,
+	}, nil
+}
+
+func (o *Service) CancelOrder(ctx context.Context, args *models.CancelOrderParams) error {
+	orderID, err := strconv.ParseInt(args.ExternalOrderID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("order id cant be casted to int64 %w", err)
+	}
+
+	_, err = o.exClient.Order().CancelOrder(ctx, orderID)
+	return err
+}
+
 func (o *Service) getMaxAmount(baseSymbol string, accountBalance []htxmodels.ListItem) decimal.Decimal {
 	for _, b := range accountBalance {
 		if strings.EqualFold(b.Currency, baseSymbol) {
@@ -681,6 +785,39 @@ func (o *Service) TestConnection(ctx context.Context) error {
 	return nil
 }
 
+func (o *Service) GetKeyPermissions(ctx context.Context) (*models.KeyPermissionsDTO, error) {
+	uid, err := o.exClient.User().GetUserUID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	info, err := o.exClient.User().GetAPIKeyInformation(ctx, &htxrequests.GetAPIKeyInformationRequest{
+		UID:       strconv.Itoa(int(uid.Data)),
+		AccessKey: o.exClient.AccessKey(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	apiInfo, exists := lo.Find(info.Data, func(entry *htxmodels.APIKeyInformation) bool {
+		return entry.AccessKey == o.exClient.AccessKey()
+	})
+	if !exists {
+		return nil, fmt.Errorf("api key info not found")
+	}
+
+	perms := strings.Split(apiInfo.Permission, ",")
+	res := &models.KeyPermissionsDTO{
+		CanTrade:     lo.ToPtr(lo.Contains(perms, "trade")),
+		CanWithdraw:  lo.ToPtr(lo.Contains(perms, "withdraw")),
+		IPRestricted: lo.ToPtr(apiInfo.IPAddresses != ""),
+	}
+	// validDays holds remaining days, -1 for keys bound to ip
+	if apiInfo.ValidDays >= 0 {
+		res.ExpiresAt = lo.ToPtr(time.Now().UTC().AddDate(0, 0, apiInfo.ValidDays))
+	}
+
+	return res, nil
+}
+
 func (o *Service) GetExchangeSymbols(ctx context.Context) ([]*models.ExchangeSymbolDTO, error) {
 	res, err := o.exClient.Common().GetAllMarketSymbols(ctx, &htxrequests.GetMarketSymbolsRequest{})
 	if err != nil {
@@ -741,6 +878,20 @@ func (o *Service) GetOrderRules(ctx context.Context, tickers ...string) ([]*mode
 	return rules, nil
 }
 
+func (o *Service) GetTickerPrice(ctx context.Context, ticker string) (*models.TickerPriceDTO, error) {
+	tickers, err := o.exClient.Market().GetMarketTickers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t, exists := lo.Find(tickers.Tickers, func(t *htxmodels.MarketTicker) bool {
+		return t.Symbol == strings.ToLower(ticker)
+	})
+	if !exists {
+		return nil, fmt.Errorf("ticker %s not found", ticker)
+	}
+	return &models.TickerPriceDTO{Symbol: ticker, Bid: decimal.NewFromFloat(t.Bid), Ask: decimal.NewFromFloat(t.Ask)}, nil
+}
+
 func (o *Service) GetConnectionHash() string {
 	return o.connHash
 }
@@ -0,0 +1,773 @@
+package kraken
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+	"github.com/ulule/limiter/v3"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/currconv"
+	"github.com/dv-net/dv-merchant/internal/storage"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_exchange_chains"
+	"github.com/dv-net/dv-merchant/internal/tools/hash"
+	exchangeclient "github.com/dv-net/dv-merchant/pkg/exchange_client"
+	kraken "github.com/dv-net/dv-merchant/pkg/exchange_client/kraken"
+	krakenrequests "github.com/dv-net/dv-merchant/pkg/exchange_client/kraken/requests"
+	"github.com/dv-net/dv-merchant/pkg/exchange_client/kraken/responses"
+	"github.com/dv-net/dv-merchant/pkg/iso"
+	"github.com/dv-net/dv-merchant/pkg/logger"
+)
+
+const assetCacheTTL = time.Minute
+
+// maxWithdrawPrecision caps asset decimals, kraken keeps up to 10 of them internally
+const maxWithdrawPrecision = 8
+
+type Service struct {
+	exClient *kraken.BaseClient
+	storage  storage.IStorage
+	convSvc  currconv.ICurrencyConvertor
+	l        logger.Logger
+	connHash string
+	cacheMu  sync.Mutex
+	assets   responses.GetAssetsResponse
+	assetsAt time.Time
+}
+
+func NewService(logger logger.Logger, apiKey, secretKey string, baseURL *url.URL, storage storage.IStorage, store limiter.Store, convSvc currconv.ICurrencyConvertor) (*Service, error) {
+	exClient, err := kraken.NewBaseClient(&kraken.ClientOptions{
+		APIKey:    apiKey,
+		SecretKey: secretKey,
+		BaseURL:   baseURL,
+	}, store, kraken.WithLogger(logger))
+	if err != nil {
+		return nil, err
+	}
+
+	connHash, err := hash.SHA256ConnectionHash(models.ExchangeSlugKraken.String(), apiKey, secretKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create connection hash: %w", err)
+	}
+
+	return &Service{
+		exClient: exClient,
+		storage:  storage,
+		convSvc:  convSvc,
+		l:        logger,
+		connHash: connHash,
+	}, nil
+}
+
+func (o *Service) assetsInfo(ctx context.Context) (responses.GetAssetsResponse, error) {
+	o.cacheMu.Lock()
+	defer o.cacheMu.Unlock()
+
+	if o.assets != nil && time.Since(o.assetsAt) < assetCacheTTL {
+		return o.assets, nil
+	}
+
+	assets, err := o.exClient.Market().GetAssets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get assets: %w", err)
+	}
+	o.assets, o.assetsAt = assets, time.Now()
+
+	return assets, nil
+}
+
+// spotBalances returns available spot funds keyed by common ticker, earn and staking
+// balances (XBT.F, DOT.S and alike) are skipped
+func (o *Service) spotBalances(ctx context.Context) (map[string]decimal.Decimal, error) {
+	balances, err := o.exClient.Account().GetBalanceEx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get balance: %w", err)
+	}
+
+	assets, err := o.assetsInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]decimal.Decimal, len(balances))
+	for assetID, balance := range balances {
+		if strings.Contains(assetID, ".") {
+			continue
+		}
+
+		altname := assetID
+		if asset, ok := assets[assetID]; ok {
+			altname = asset.Altname
+		}
+		res[kraken.NormalizeAsset(altname)] = balance.Balance.Sub(balance.HoldTrade)
+	}
+
+	return res, nil
+}
+
+func (o *Service) TestConnection(ctx context.Context) error {
+	if _, err := o.exClient.Account().GetBalanceEx(ctx); err != nil {
+		return fmt.Errorf("get balance: %w", err)
+	}
+	return nil
+}
+
+// GetKeyPermissions returns no rights, kraken does not expose them and missing ones surface
+// as EGeneral:Permission denied on the call needing it
+func (o *Service) GetKeyPermissions(_ context.Context) (*models.KeyPermissionsDTO, error) {
+	return &models.KeyPermissionsDTO{}, nil
+}
+
+func (o *Service) GetConnectionHash() string {
+	return o.connHash
+}
+
+func (o *Service) GetAccountBalance(ctx context.Context) ([]*models.AccountBalanceDTO, error) {
+	balances, err := o.spotBalances(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*models.AccountBalanceDTO, 0, len(balances))
+	for asset, amount := range balances {
+		if !amount.IsPositive() {
+			continue
+		}
+
+		currencyID, err := o.storage.ExchangeChains().GetCurrencyIDByTicker(ctx, asset)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				continue
+			}
+			return nil, fmt.Errorf("get internal currency id for %s: %w", asset, err)
+		}
+
+		amountUSD, err := o.convSvc.Convert(ctx, currconv.ConvertDTO{
+			Source: models.ExchangeSlugKraken.String(),
+			From:   asset,
+			To:     models.CurrencyCodeUSDT,
+			Amount: amount.String(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("convert %s balance to usdt: %w", asset, err)
+		}
+
+		res = append(res, &models.AccountBalanceDTO{
+			Currency:  currencyID,
+			Amount:    amount,
+			AmountUSD: amountUSD.Round(4),
+			Type:      models.CurrencyTypeCrypto.String(),
+		})
+	}
+
+	return res, nil
+}
+
+func (o *Service) GetCurrencyBalance(ctx context.Context, currency string) (*decimal.Decimal, error) {
+	balances, err := o.spotBalances(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if balance, ok := balances[currency]; ok {
+		return &balance, nil
+	}
+
+	return &decimal.Zero, nil
+}
+
+func (o *Service) GetExchangeSymbols(ctx context.Context) ([]*models.ExchangeSymbolDTO, error) {
+	pairs, err := o.exClient.Market().GetAssetPairs(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("get asset pairs: %w", err)
+	}
+
+	symbols := make([]*models.ExchangeSymbolDTO, 0, len(pairs)*2)
+	for _, pair := range pairs {
+		if pair.Status != kraken.PairStatusOnline {
+			continue
+		}
+		base, quote, ok := pairAssets(pair)
+		if !ok || iso.IsFiat(base) || iso.IsFiat(quote) {
+			continue
+		}
+
+		symbols = append(symbols, &models.ExchangeSymbolDTO{
+			Symbol:      pair.Altname,
+			DisplayName: base + "/" + quote,
+			BaseSymbol:  base,
+			QuoteSymbol: quote,
+			Type:        models.OrderSideSell.String(),
+		}, &models.ExchangeSymbolDTO{
+			Symbol:      pair.Altname,
+			DisplayName: quote + "/" + base,
+			BaseSymbol:  base,
+			QuoteSymbol: quote,
+			Type:        models.OrderSideBuy.String(),
+		})
+	}
+
+	return symbols, nil
+}
+
+// pairAssets takes common tickers from websocket name of the pair, e.g. XBT/USDT
+func pairAssets(pair responses.AssetPair) (string, string, bool) {
+	base, quote, ok := strings.Cut(pair.Wsname, "/")
+	if !ok {
+		return "", "", false
+	}
+	return kraken.NormalizeAsset(base), kraken.NormalizeAsset(quote), true
+}
+
+func (o *Service) assetPair(ctx context.Context, ticker string) (*responses.AssetPair, error) {
+	pairs, err := o.exClient.Market().GetAssetPairs(ctx, ticker)
+	if err != nil {
+		return nil, err
+	}
+
+	// kraken keys the answer by canonical pair name, altname is what we keep as symbol
+	for _, pair := range pairs {
+		if pair.Altname == ticker {
+			return &pair, nil
+		}
+	}
+	for _, pair := range pairs {
+		return &pair, nil
+	}
+
+	return nil, fmt.Errorf("symbol %s not found", ticker)
+}
+
+func (o *Service) GetDepositAddresses(ctx context.Context, currency, chain string) ([]*models.DepositAddressDTO, error) {
+	asset := kraken.ExchangeAsset(currency)
+
+	addresses, err := o.exClient.Funding().GetDepositAddresses(ctx, &krakenrequests.GetDepositAddressesRequest{
+		Asset:  asset,
+		Method: chain,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get deposit addresses for %s: %w", currency, err)
+	}
+
+	if len(addresses) == 0 {
+		addresses, err = o.exClient.Funding().GetDepositAddresses(ctx, &krakenrequests.GetDepositAddressesRequest{
+			Asset:  asset,
+			Method: chain,
+			New:    true,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("create deposit address for %s: %w", currency, err)
+		}
+		if len(addresses) == 0 {
+			return nil, nil
+		}
+	}
+
+	currencyID, err := o.storage.ExchangeChains().GetCurrencyIDByParams(ctx, repo_exchange_chains.GetCurrencyIDByParamsParams{
+		Ticker: currency,
+		Chain:  chain,
+		Slug:   models.ExchangeSlugKraken,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get internal currency id for %s: %w", chain, err)
+	}
+
+	addresses = lo.UniqBy(addresses, func(item responses.DepositAddress) string {
+		return item.Address + ":" + cmp.Or(item.Tag, item.Memo)
+	})
+
+	return lo.Map(addresses, func(item responses.DepositAddress, _ int) *models.DepositAddressDTO {
+		return &models.DepositAddressDTO{
+			Address:          item.Address,
+			Currency:         currencyID,
+			Chain:            chain,
+			InternalCurrency: currency,
+			AddressType:      models.DepositAddress,
+			PaymentTag:       cmp.Or(item.Tag, item.Memo),
+		}
+	}), nil
+}
+
+// withdrawMethod looks the chain up by method name, network name is accepted as well
+func (o *Service) withdrawMethod(ctx context.Context, asset, chain string) (*responses.WithdrawMethod, error) {
+	methods, err := o.exClient.Funding().GetWithdrawMethods(ctx, &krakenrequests.GetWithdrawMethodsRequest{Asset: asset})
+	if err != nil {
+		return nil, fmt.Errorf("get withdraw methods for %s: %w", asset, err)
+	}
+
+	for _, method := range methods {
+		if method.Method == chain || method.Network == chain {
+			return &method, nil
+		}
+	}
+
+	return nil, fmt.Errorf("network %s is not supported for %s on kraken", chain, asset)
+}
+
+func (o *Service) CreateWithdrawalOrder(ctx context.Context, args *models.CreateWithdrawalOrderParams) (*models.ExchangeWithdrawalDTO, error) {
+	args.NativeAmount = args.NativeAmount.RoundDown(int32(args.WithdrawalPrecision)) //nolint:gosec
+
+	internalCurrency, err := o.storage.ExchangeChains().GetTickerByCurrencyID(ctx, repo_exchange_chains.GetTickerByCurrencyIDParams{
+		CurrencyID: args.Currency,
+		Slug:       models.ExchangeSlugKraken,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get exchange ticker for %s: %w", args.Currency, err)
+	}
+
+	if args.NativeAmount.LessThan(args.MinWithdrawal) {
+		return nil, exchangeclient.ErrMinWithdrawalBalance
+	}
+
+	asset := kraken.ExchangeAsset(internalCurrency)
+	method, err := o.withdrawMethod(ctx, asset, args.Chain)
+	if err != nil {
+		return nil, err
+	}
+
+	// kraken withdraws only to addresses saved and verified in the account address book
+	addresses, err := o.exClient.Funding().GetWithdrawAddresses(ctx, &krakenrequests.GetWithdrawAddressesRequest{
+		Asset:    asset,
+		Method:   method.Method,
+		Verified: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get withdraw addresses for %s: %w", internalCurrency, err)
+	}
+	destination, ok := lo.Find(addresses, func(item responses.WithdrawAddress) bool {
+		return item.Address == args.Address
+	})
+	if !ok {
+		return nil, exchangeclient.ErrWithdrawalAddressNotWhitelisted
+	}
+
+	o.l.Infow(
+		"withdrawal request assembled",
+		"exchange", models.ExchangeSlugKraken.String(),
+		"recordID", args.RecordID.String(),
+		"amount", args.NativeAmount.String(),
+		"fee", args.Fee.String(),
+		"currency", internalCurrency,
+		"chain", args.Chain,
+		"key", destination.Key,
+		"address", args.Address,
+	)
+
+	order, err := o.exClient.Funding().Withdraw(ctx, &krakenrequests.WithdrawRequest{
+		Asset:   asset,
+		Key:     destination.Key,
+		Address: args.Address,
+		Amount:  args.NativeAmount.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if order.RefID == "" {
+		return nil, fmt.Errorf("withdrawal for %s created without id", internalCurrency)
+	}
+
+	return &models.ExchangeWithdrawalDTO{ExternalOrderID: order.RefID}, nil
+}
+
+func (o *Service) bestPrices(ctx context.Context, ticker string) (*models.TickerPriceDTO, error) {
+	res, err := o.exClient.Market().GetTicker(ctx, ticker)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range res {
+		if len(item.Ask) == 0 || len(item.Bid) == 0 {
+			break
+		}
+		return &models.TickerPriceDTO{Symbol: ticker, Bid: item.Bid[0], Ask: item.Ask[0]}, nil
+	}
+
+	return nil, fmt.Errorf("ticker %s not found", ticker)
+}
+
+func (o *Service) CreateSpotOrder(ctx context.Context, _ string, _ string, side string, ticker string, _ *decimal.Decimal, rule *models.OrderRulesDTO) (*models.ExchangeOrderDTO, error) {
+	req := &krakenrequests.AddOrderRequest{
+		Pair:      ticker,
+		Type:      strings.ToLower(side),
+		OrderType: kraken.OrderTypeMarket,
+	}
+
+	pair, err := o.assetPair(ctx, ticker)
+	if err != nil {
+		if errors.Is(err, exchangeclient.ErrRateLimited) {
+			return nil, exchangeclient.ErrSkipOrder
+		}
+		return nil, fmt.Errorf("get asset pair %s: %w", ticker, err)
+	}
+	if pair.Status != kraken.PairStatusOnline {
+		return nil, exchangeclient.ErrSymbolTradingHalted
+	}
+
+	clientOrderID, err := uuid.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+	req.ClOrdID = clientOrderID.String()
+
+	var amount decimal.Decimal
+	switch req.Type {
+	case kraken.OrderSideSell:
+		balance, err := o.GetCurrencyBalance(ctx, rule.BaseCurrency)
+		if err != nil {
+			if errors.Is(err, exchangeclient.ErrRateLimited) {
+				return nil, exchangeclient.ErrSkipOrder
+			}
+			return nil, fmt.Errorf("get base currency balance %s: %w", rule.BaseCurrency, err)
+		}
+		if balance.LessThan(pair.OrderMin) {
+			return nil, exchangeclient.ErrInsufficientBalance
+		}
+		amount = balance.RoundDown(int32(pair.LotDecimals)) //nolint:gosec
+
+		prices, err := o.bestPrices(ctx, ticker)
+		if err != nil {
+			if errors.Is(err, exchangeclient.ErrRateLimited) {
+				return nil, exchangeclient.ErrSkipOrder
+			}
+			return nil, fmt.Errorf("get ticker price for %s: %w", ticker, err)
+		}
+		if !prices.Bid.IsPositive() {
+			return nil, exchangeclient.ErrSkipOrder
+		}
+		if amount.Mul(prices.Bid).LessThan(pair.CostMin) {
+			return nil, exchangeclient.ErrInsufficientBalance
+		}
+
+		req.Volume = amount.String()
+	case kraken.OrderSideBuy:
+		balance, err := o.GetCurrencyBalance(ctx, rule.QuoteCurrency)
+		if err != nil {
+			if errors.Is(err, exchangeclient.ErrRateLimited) {
+				return nil, exchangeclient.ErrSkipOrder
+			}
+			return nil, fmt.Errorf("get quote currency balance %s: %w", rule.QuoteCurrency, err)
+		}
+		if balance.LessThan(pair.CostMin) {
+			return nil, exchangeclient.ErrInsufficientBalance
+		}
+		amount = balance.RoundDown(int32(pair.CostDecimals)) //nolint:gosec
+		req.Volume = amount.String()
+		req.OFlags = kraken.OrderFlagVolumeInQuote
+	default:
+		return nil, fmt.Errorf("unsupported order side %s", side)
+	}
+
+	order, err := o.exClient.Trade().AddOrder(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("place spot order: %w", err)
+	}
+	if len(order.TxID) == 0 {
+		return nil, fmt.Errorf("failed to create spot order for %s", ticker)
+	}
+
+	return &models.ExchangeOrderDTO{
+		ExchangeOrderID: order.TxID[0],
+		ClientOrderID:   req.ClOrdID,
+		Amount:          amount,
+	}, nil
+}
+
+func (o *Service) CreateLimitOrder(ctx context.Context, args *models.CreateLimitOrderParams) (*models.ExchangeOrderDTO, error) {
+	req := &krakenrequests.AddOrderRequest{
+		Pair:        args.Symbol,
+		Type:        strings.ToLower(args.Side.String()),
+		OrderType:   kraken.OrderTypeLimit,
+		TimeInForce: kraken.TimeInForceGTC,
+	}
+	switch args.TimeInForce {
+	case models.TimeInForceIOC:
+		req.TimeInForce = kraken.TimeInForceIOC
+	case models.TimeInForceFOK:
+		return nil, fmt.Errorf("kraken does not support %s spot orders", args.TimeInForce.String())
+	}
+
+	pair, err := o.assetPair(ctx, args.Symbol)
+	if err != nil {
+		if errors.Is(err, exchangeclient.ErrRateLimited) {
+			return nil, exchangeclient.ErrSkipOrder
+		}
+		return nil, fmt.Errorf("get asset pair %s: %w", args.Symbol, err)
+	}
+	if pair.Status != kraken.PairStatusOnline && pair.Status != kraken.PairStatusLimitOnly {
+		return nil, exchangeclient.ErrSymbolTradingHalted
+	}
+
+	price := args.Price.RoundFloor(int32(pair.PairDecimals)) //nolint:gosec
+	if args.Side == models.OrderSideSell {
+		price = args.Price.RoundCeil(int32(pair.PairDecimals)) //nolint:gosec
+	}
+	qty := args.Amount.RoundDown(int32(pair.LotDecimals)) //nolint:gosec
+	if !qty.IsPositive() || qty.LessThan(pair.OrderMin) || qty.Mul(price).LessThan(pair.CostMin) {
+		return nil, exchangeclient.ErrMinOrderValue
+	}
+
+	base, quote, ok := pairAssets(*pair)
+	if !ok {
+		return nil, fmt.Errorf("unexpected pair name %s", pair.Wsname)
+	}
+	currency, spent := base, qty
+	if args.Side == models.OrderSideBuy {
+		currency, spent = quote, qty.Mul(price)
+	}
+	balance, err := o.GetCurrencyBalance(ctx, currency)
+	if err != nil {
+		if errors.Is(err, exchangeclient.ErrRateLimited) {
+			return nil, exchangeclient.ErrSkipOrder
+		}
+		return nil, fmt.Errorf("get currency balance %s: %w", currency, err)
+	}
+	if balance.LessThan(spent) {
+		return nil, exchangeclient.ErrInsufficientBalance
+	}
+
+	clientOrderID, err := uuid.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+	req.ClOrdID = clientOrderID.String()
+	req.Volume = qty.String()
+	req.Price = price.String()
+
+	order, err := o.exClient.Trade().AddOrder(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("place spot order: %w", err)
+	}
+	if len(order.TxID) == 0 {
+		return nil, fmt.Errorf("failed to create spot order for %s", args.Symbol)
+	}
+
+	return &models.ExchangeOrderDTO{
+		ExchangeOrderID: order.TxID[0],
+		ClientOrderID:   req.ClOrdID,
+		Amount:          qty,
+	}, nil
+}
+
+func (o *Service) CancelOrder(ctx context.Context, args *models.CancelOrderParams) error {
+	req := &krakenrequests.CancelOrderRequest{}
+	switch {
+	case args.ExternalOrderID != "":
+		req.TxID = args.ExternalOrderID
+	case args.ClientOrderID != "":
+		req.ClOrdID = args.ClientOrderID
+	default:
+		return fmt.Errorf("either external or client order id must be provided")
+	}
+
+	_, err := o.exClient.Trade().CancelOrder(ctx, req)
+	return err
+}
+
+func (o *Service) GetOrderRule(ctx context.Context, ticker string) (*models.OrderRulesDTO, error) {
+	pair, err := o.assetPair(ctx, ticker)
+	if err != nil {
+		if errors.Is(err, exchangeclient.ErrRateLimited) {
+			return nil, exchangeclient.ErrSkipOrder
+		}
+		return nil, fmt.Errorf("get order rule for %s: %w", ticker, err)
+	}
+	if pair.Status != kraken.PairStatusOnline {
+		return nil, exchangeclient.ErrSymbolTradingHalted
+	}
+
+	base, quote, ok := pairAssets(*pair)
+	if !ok {
+		return nil, fmt.Errorf("unexpected pair name %s", pair.Wsname)
+	}
+
+	return &models.OrderRulesDTO{
+		Symbol:          pair.Altname,
+		State:           pair.Status,
+		BaseCurrency:    base,
+		QuoteCurrency:   quote,
+		MinOrderAmount:  pair.OrderMin.String(),
+		MinOrderValue:   pair.CostMin.String(),
+		AmountPrecision: pair.LotDecimals,
+		PricePrecision:  pair.PairDecimals,
+		ValuePrecision:  pair.CostDecimals,
+	}, nil
+}
+
+func (o *Service) GetOrderRules(ctx context.Context, tickers ...string) ([]*models.OrderRulesDTO, error) {
+	rules := make([]*models.OrderRulesDTO, 0, len(tickers))
+	for _, ticker := range tickers {
+		rule, err := o.GetOrderRule(ctx, ticker)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+func (o *Service) GetTickerPrice(ctx context.Context, ticker string) (*models.TickerPriceDTO, error) {
+	return o.bestPrices(ctx, ticker)
+}
+
+func (o *Service) GetOrderDetails(ctx context.Context, args *models.GetOrderByIDParams) (*models.OrderDetailsDTO, error) {
+	order := &models.OrderDetailsDTO{
+		State:     models.ExchangeOrderStatusFailed,
+		Amount:    decimal.Zero,
+		AmountUSD: decimal.Zero,
+	}
+
+	if args.ExternalOrderID == nil || *args.ExternalOrderID == "" {
+		return order, fmt.Errorf("external order id is required")
+	}
+
+	orders, err := o.exClient.Trade().QueryOrders(ctx, &krakenrequests.QueryOrdersRequest{TxID: *args.ExternalOrderID})
+	if err != nil {
+		return nil, fmt.Errorf("query order: %w", err)
+	}
+	res, ok := orders[*args.ExternalOrderID]
+	if !ok {
+		return nil, fmt.Errorf("order %s not found", *args.ExternalOrderID)
+	}
+
+	switch res.Status {
+	case responses.OrderStatusClosed:
+		order.State = models.ExchangeOrderStatusCompleted
+	case responses.OrderStatusPending, responses.OrderStatusOpen:
+		order.State = models.ExchangeOrderStatusInProgress
+	case responses.OrderStatusCanceled, responses.OrderStatusExpired:
+		order.State = models.ExchangeOrderStatusCancelled
+	default:
+		order.State = models.ExchangeOrderStatusInProgress
+	}
+
+	order.Amount = res.VolExec
+
+	rule, err := o.GetOrderRule(ctx, res.Descr.Pair)
+	if err != nil {
+		return nil, fmt.Errorf("get order rule for %s: %w", res.Descr.Pair, err)
+	}
+
+	if rule.QuoteCurrency == models.CurrencyCodeUSDT || rule.QuoteCurrency == models.CurrencyCodeUSDC {
+		order.AmountUSD = res.Cost
+		return order, nil
+	}
+
+	amountUSD, err := o.convSvc.Convert(ctx, currconv.ConvertDTO{
+		Source: models.ExchangeSlugKraken.String(),
+		From:   rule.QuoteCurrency,
+		To:     models.CurrencyCodeUSDT,
+		Amount: res.Cost.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("convert %s to usdt: %w", rule.QuoteCurrency, err)
+	}
+	order.AmountUSD = amountUSD.Round(4)
+
+	return order, nil
+}
+
+func (o *Service) GetWithdrawalRules(ctx context.Context, currencies ...string) ([]*models.WithdrawalRulesDTO, error) {
+	currEnabled, err := o.storage.ExchangeChains().GetEnabledCurrencies(ctx, models.ExchangeSlugKraken)
+	if err != nil {
+		return nil, fmt.Errorf("get enabled currencies: %w", err)
+	}
+
+	currEnabled = lo.Filter(currEnabled, func(item *repo_exchange_chains.GetEnabledCurrenciesRow, _ int) bool {
+		return lo.Contains(currencies, item.ID.String)
+	})
+
+	assets, err := o.assetsInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]*models.WithdrawalRulesDTO, 0, len(currEnabled))
+	for _, currency := range currEnabled {
+		asset := kraken.ExchangeAsset(currency.Ticker)
+		method, err := o.withdrawMethod(ctx, asset, currency.Chain)
+		if err != nil {
+			return nil, err
+		}
+
+		precision := maxWithdrawPrecision
+		for _, info := range assets {
+			if info.Altname == asset {
+				precision = min(info.Decimals, maxWithdrawPrecision)
+				break
+			}
+		}
+
+		minDepositAmount, err := o.convSvc.Convert(ctx, currconv.ConvertDTO{
+			Source:     models.ExchangeSlugKraken.String(),
+			From:       models.CurrencyCodeUSDT,
+			To:         currency.Ticker,
+			Amount:     "1",
+			StableCoin: false,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("convert minimum deposit amount for %s: %w", currency.Ticker, err)
+		}
+
+		rules = append(rules, &models.WithdrawalRulesDTO{
+			Currency:          currency.Ticker,
+			Chain:             currency.Chain,
+			MinDepositAmount:  minDepositAmount.RoundUp(int32(precision)).String(), //nolint:gosec
+			MinWithdrawAmount: method.Minimum.String(),
+			WithdrawPrecision: strconv.Itoa(precision),
+			WithdrawFeeType:   models.WithdrawalFeeTypeFixed,
+			Fee:               method.Fee.Fee.String(),
+		})
+	}
+
+	return rules, nil
+}
+
+func (o *Service) GetWithdrawalByID(ctx context.Context, args *models.GetWithdrawalByIDParams) (*models.WithdrawalStatusDTO, error) {
+	refID := ""
+	switch {
+	case args.ExternalOrderID != nil && *args.ExternalOrderID != "":
+		refID = *args.ExternalOrderID
+	case args.ClientOrderID != nil && *args.ClientOrderID != "":
+		refID = *args.ClientOrderID
+	default:
+		return nil, fmt.Errorf("either ClientOrderID or ExternalOrderID must be provided")
+	}
+
+	history, err := o.exClient.Funding().GetWithdrawStatus(ctx, &krakenrequests.GetWithdrawStatusRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("get withdrawal status: %w", err)
+	}
+
+	for _, withdrawal := range history {
+		if withdrawal.RefID != refID {
+			continue
+		}
+		// denied cancellation leaves the withdrawal in its regular flow
+		status := withdrawal.Status
+		if withdrawal.StatusProp != "" && withdrawal.StatusProp != responses.WithdrawalStatusCancelDenied {
+			status = withdrawal.StatusProp
+		}
+		return &models.WithdrawalStatusDTO{
+			ID:           withdrawal.RefID,
+			TxHash:       withdrawal.TxID,
+			NativeAmount: withdrawal.Amount,
+			Status:       status,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("withdrawal %s not found", refID)
+}
@@ -36,7 +36,7 @@ const (
 )
 
 var (
-	ErrInsufficientBalance         = errors.New("insufficient balance")
+	ErrInsufficientBalance         = exchangeclient.ErrInsufficientBalance
 	ErrUnprocessableCurrencyStatus = errors.New("unprocessable currency status")
 	ErrMaxOrderValueReached        = errors.New("max order value reached")
 )
@@ -90,6 +90,23 @@ func (o *Service) TestConnection(ctx context.Context) error {
 	return nil
 }
 
+// GetKeyPermissions maps kucoin rights, Transfer stands for withdrawals and InnerTransfer for moves between accounts
+func (o *Service) GetKeyPermissions(ctx context.Context) (*models.KeyPermissionsDTO, error) {
+	res, err := o.exClient.Account().GetAPIKeyInfo(ctx, kucoinrequests.GetAPIKeyInfo{})
+	if err != nil {
+		return nil, err
+	}
+
+	perms := strings.Split(res.Info.Permission, ",")
+	return &models.KeyPermissionsDTO{
+		CanTrade:         lo.ToPtr(lo.Contains(perms, "Spot")),
+		CanWithdraw:      lo.ToPtr(lo.Contains(perms, "Transfer")),
+		CanTransfer:      lo.ToPtr(lo.Contains(perms, "InnerTransfer")),
+		IPRestricted:     lo.ToPtr(res.Info.IPWhitelist != ""),
+		TransferRequired: true,
+	}, nil
+}
+
 func (o *Service) GetAccountBalance(ctx context.Context) ([]*models.AccountBalanceDTO, error) {
 	// Fetch all account's balances
 	res, err := o.exClient.Account().GetAccountList(ctx, kucoinrequests.GetAccountList{})
@@ -634,6 +651,125 @@ func (o *Service) CreateSpotOrder(ctx context.Context, from string, to string, s
 	return order, nil
 }
 
+func (o *Service) CreateLimitOrder(ctx context.Context, args *models.CreateLimitOrderParams) (*models.ExchangeOrderDTO, error) {
+	orderID, err := uuid.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+
+	tradingSymbol, err := o.exClient.Public().GetSymbol(ctx, kucoinrequests.GetSymbol{
+		Symbol: args.Symbol,
+	})
+	if err != nil {
+		if errors.Is(err, exchangeclient.ErrRateLimited) {
+			return nil, exchangeclient.ErrSkipOrder
+		}
+		return nil, err
+	}
+	if !tradingSymbol.Symbol.EnableTrading {
+		return nil, fmt.Errorf("trading is disabled for symbol %s", args.Symbol)
+	}
+	symbol := tradingSymbol.Symbol
+
+	price := utils.FloorToStep(args.Price, symbol.PriceIncrement)
+	if args.Side == models.OrderSideSell {
+		price = utils.CeilToStep(args.Price, symbol.PriceIncrement)
+	}
+	qty := utils.FloorToStep(args.Amount, symbol.BaseIncrement)
+	if qty.LessThan(symbol.BaseMinSize) || qty.Mul(price).LessThan(symbol.MinFunds) {
+		return nil, exchangeclient.ErrMinOrderValue
+	}
+
+	currency, spent := symbol.BaseCurrency, qty
+	if args.Side == models.OrderSideBuy {
+		currency, spent = symbol.QuoteCurrency, qty.Mul(price)
+	}
+	if err := o.topUpTrade(ctx, orderID.String(), currency, spent); err != nil {
+		return nil, err
+	}
+
+	placedOrder, err := o.exClient.Spot().CreateOrder(ctx, kucoinrequests.CreateOrder{
+		ClientOID:   orderID.String(),
+		Symbol:      args.Symbol,
+		Type:        kucoinmodels.OrderTypeLimit,
+		Side:        kucoinmodels.OrderSide(args.Side.String()),
+		Size:        qty.String(),
+		Price:       price.String(),
+		TimeInForce: kucoinmodels.TimeInForce(strings.ToUpper(args.TimeInForce.String())),
+	})
+	if err != nil {
+		if errors.Is(err, exchangeclient.ErrRateLimited) {
+			return nil, exchangeclient.ErrSkipOrder
+		}
+		return nil, fmt.Errorf("failed to place order: %w", err)
+	}
+
+	return &models.ExchangeOrderDTO{
+		ExchangeOrderID: placedOrder.Data.OrderID,
+		ClientOrderID:   orderID.String(),
+		Amount:          qty,
+	}, nil
+}
+
+// topUpTrade moves missing part of amount from main to trade account
+func (o *Service) topUpTrade(ctx context.Context, transferID, currency string, amount decimal.Decimal) error {
+	accountList, err := o.exClient.Account().GetAccountList(ctx, kucoinrequests.GetAccountList{
+		Currency: currency,
+	})
+	if err != nil {
+		if errors.Is(err, exchangeclient.ErrRateLimited) {
+			return exchangeclient.ErrSkipOrder
+		}
+		return err
+	}
+
+	tradeBalance, mainBalance := decimal.Zero, decimal.Zero
+	for _, account := range accountList.Accounts {
+		if account.Currency != currency {
+			continue
+		}
+		switch account.Type {
+		case kucoinmodels.AccountTypeTrade:
+			tradeBalance = tradeBalance.Add(account.Available)
+		case kucoinmodels.AccountTypeMain:
+			mainBalance = mainBalance.Add(account.Available)
+		}
+	}
+
+	missing := amount.Sub(tradeBalance)
+	if !missing.IsPositive() {
+		return nil
+	}
+	if mainBalance.LessThan(missing) {
+		return exchangeclient.ErrInsufficientBalance
+	}
+
+	_, err = o.exClient.Account().CreateFlexTransfer(ctx, kucoinrequests.FlexTransfer{
+		ClientOID:       transferID,
+		Currency:        currency,
+		Amount:          missing.String(),
+		Type:            kucoinmodels.TransferTypeInternal,
+		FromAccountType: kucoinmodels.TransferAccountTypeMain,
+		ToAccountType:   kucoinmodels.TransferAccountTypeTrade,
+	})
+	if err != nil {
+		if errors.Is(err, exchangeclient.ErrRateLimited) {
+			return exchangeclient.ErrSkipOrder
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (o *Service) CancelOrder(ctx context.Context, args *models.CancelOrderParams) error {
+	_, err := o.exClient.Spot().CancelOrderByOrderID(ctx, kucoinrequests.CancelOrderByOrderID{
+		OrderID: args.ExternalOrderID,
+		Symbol:  args.Symbol,
+	})
+	return err
+}
+
 func (o *Service) GetOrderRule(ctx context.Context, ticker string) (*models.OrderRulesDTO, error) {
 	symbolData, err := o.exClient.Public().GetSymbol(ctx, kucoinrequests.GetSymbol{
 		Symbol: ticker,
@@ -682,6 +818,19 @@ func (o *Service) GetOrderRules(ctx context.Context, tickers ...string) ([]*mode
 	return rules, nil
 }
 
+func (o *Service) GetTickerPrice(ctx context.Context, ticker string) (*models.TickerPriceDTO, error) {
+	res, err := o.exClient.Public().GetTicker(ctx, kucoinrequests.GetTicker{
+		Symbol: ticker,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.Ticker == nil {
+		return nil, fmt.Errorf("ticker %s not found", ticker)
+	}
+	return &models.TickerPriceDTO{Symbol: ticker, Bid: res.Ticker.BestBid, Ask: res.Ticker.BestAsk}, nil
+}
+
 func (o *Service) GetOrderDetails(ctx context.Context, args *models.GetOrderByIDParams) (*models.OrderDetailsDTO, error) {
 	order := &models.OrderDetailsDTO{
 		State: models.ExchangeOrderStatusFailed,
@@ -757,10 +906,12 @@ func (o *Service) getOrderDetails(ctx context.Context, symbol *string, order *mo
 
 	order.Amount = exchangeOrder.DealSize
 
-	// TODO: check if order is canceled and etc.
-	if exchangeOrder.Active {
+	switch {
+	case exchangeOrder.Active:
 		order.State = models.ExchangeOrderStatusInProgress
-	} else {
+	case exchangeOrder.CancelExist:
+		order.State = models.ExchangeOrderStatusCancelled
+	default:
 		order.State = models.ExchangeOrderStatusCompleted
 	}
 
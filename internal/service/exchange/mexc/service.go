@@ -27,6 +27,7 @@ import (
 	mexc "github.com/dv-net/dv-merchant/pkg/exchange_client/mexc"
 	mexcrequests "github.com/dv-net/dv-merchant/pkg/exchange_client/mexc/requests"
 	"github.com/dv-net/dv-merchant/pkg/exchange_client/mexc/responses"
+	"github.com/dv-net/dv-merchant/pkg/exchange_client/utils"
 	"github.com/dv-net/dv-merchant/pkg/iso"
 	"github.com/dv-net/dv-merchant/pkg/logger"
 )
@@ -119,6 +120,19 @@ func (o *Service) TestConnection(ctx context.Context) error {
 	return nil
 }
 
+// GetKeyPermissions uses account rights, mexc does not expose ip binding of the key
+func (o *Service) GetKeyPermissions(ctx context.Context) (*models.KeyPermissionsDTO, error) {
+	res, err := o.exClient.Account().GetAccountInfo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get account info: %w", err)
+	}
+
+	return &models.KeyPermissionsDTO{
+		CanTrade:    lo.ToPtr(res.CanTrade),
+		CanWithdraw: lo.ToPtr(res.CanWithdraw),
+	}, nil
+}
+
 func (o *Service) GetConnectionHash() string {
 	return o.connHash
 }
@@ -470,6 +484,94 @@ func (o *Service) CreateSpotOrder(ctx context.Context, _ string, _ string, side
 	}, nil
 }
 
+func (o *Service) CreateLimitOrder(ctx context.Context, args *models.CreateLimitOrderParams) (*models.ExchangeOrderDTO, error) {
+	req := &mexcrequests.PlaceOrderRequest{
+		Symbol: args.Symbol,
+		Side:   strings.ToUpper(args.Side.String()),
+		Type:   mexc.OrderTypeLimit,
+	}
+	switch args.TimeInForce {
+	case models.TimeInForceIOC:
+		req.Type = mexc.OrderTypeImmediateOrCancel
+	case models.TimeInForceFOK:
+		req.Type = mexc.OrderTypeFillOrKill
+	}
+
+	info, err := o.exClient.Market().GetExchangeInfo(ctx, args.Symbol)
+	if err != nil {
+		if errors.Is(err, exchangeclient.ErrRateLimited) {
+			return nil, exchangeclient.ErrSkipOrder
+		}
+		return nil, fmt.Errorf("get exchange info for %s: %w", args.Symbol, err)
+	}
+	if len(info.Symbols) == 0 {
+		return nil, fmt.Errorf("symbol %s not found", args.Symbol)
+	}
+	symbol := info.Symbols[0]
+	if !isTradable(symbol) || !isSideAllowed(symbol, req.Side) {
+		return nil, exchangeclient.ErrSymbolTradingHalted
+	}
+
+	price := args.Price.RoundFloor(int32(symbol.QuotePrecision)) //nolint:gosec
+	if args.Side == models.OrderSideSell {
+		price = args.Price.RoundCeil(int32(symbol.QuotePrecision)) //nolint:gosec
+	}
+	qty := roundToSizeStep(args.Amount, symbol.BaseSizePrecision, symbol.BaseAssetPrecision)
+	minOrderValue, err := decimal.NewFromString(symbol.QuoteAmountPrecision)
+	if err != nil {
+		return nil, fmt.Errorf("parse min order value: %w", err)
+	}
+	if !qty.IsPositive() || qty.Mul(price).LessThan(minOrderValue) {
+		return nil, exchangeclient.ErrMinOrderValue
+	}
+
+	currency, spent := symbol.BaseAsset, qty
+	if args.Side == models.OrderSideBuy {
+		currency, spent = symbol.QuoteAsset, qty.Mul(price)
+	}
+	balance, err := o.GetCurrencyBalance(ctx, currency)
+	if err != nil {
+		if errors.Is(err, exchangeclient.ErrRateLimited) {
+			return nil, exchangeclient.ErrSkipOrder
+		}
+		return nil, fmt.Errorf("get currency balance %s: %w", currency, err)
+	}
+	if balance.LessThan(spent) {
+		return nil, exchangeclient.ErrInsufficientBalance
+	}
+
+	clientOrderID, err := uuid.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+	req.NewClientOrderID = strings.ReplaceAll(clientOrderID.String(), "-", "")
+	req.Quantity = qty.String()
+	req.Price = price.String()
+
+	order, err := o.exClient.Spot().PlaceOrder(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("place spot order: %w", err)
+	}
+	if order.OrderID == "" {
+		return nil, fmt.Errorf("failed to create spot order for %s", args.Symbol)
+	}
+
+	return &models.ExchangeOrderDTO{
+		ExchangeOrderID: order.OrderID,
+		ClientOrderID:   req.NewClientOrderID,
+		Amount:          qty,
+	}, nil
+}
+
+func (o *Service) CancelOrder(ctx context.Context, args *models.CancelOrderParams) error {
+	_, err := o.exClient.Spot().CancelOrder(ctx, &mexcrequests.CancelOrderRequest{
+		Symbol:            args.Symbol,
+		OrderID:           args.ExternalOrderID,
+		OrigClientOrderID: args.ClientOrderID,
+	})
+	return err
+}
+
 func roundToSizeStep(amount decimal.Decimal, sizeStep string, assetPrecision int) decimal.Decimal {
 	step, err := decimal.NewFromString(sizeStep)
 	if err != nil || !step.IsPositive() {
@@ -533,6 +635,14 @@ func (o *Service) GetOrderRules(ctx context.Context, tickers ...string) ([]*mode
 	return rules, nil
 }
 
+func (o *Service) GetTickerPrice(ctx context.Context, ticker string) (*models.TickerPriceDTO, error) {
+	res, err := o.exClient.Market().GetBookTicker(ctx, ticker)
+	if err != nil {
+		return nil, err
+	}
+	return &models.TickerPriceDTO{Symbol: ticker, Bid: res.BidPrice, Ask: res.AskPrice}, nil
+}
+
 func (o *Service) GetOrderDetails(ctx context.Context, args *models.GetOrderByIDParams) (*models.OrderDetailsDTO, error) {
 	order := &models.OrderDetailsDTO{
 		State:     models.ExchangeOrderStatusFailed,
@@ -565,7 +675,7 @@ func (o *Service) GetOrderDetails(ctx context.Context, args *models.GetOrderByID
 	case responses.OrderStatusNew, responses.OrderStatusPartiallyFilled:
 		order.State = models.ExchangeOrderStatusInProgress
 	case responses.OrderStatusCanceled, responses.OrderStatusPartiallyCanceled:
-		order.State = models.ExchangeOrderStatusFailed
+		order.State = models.ExchangeOrderStatusCancelled
 	default:
 		order.State = models.ExchangeOrderStatusInProgress
 	}
@@ -623,6 +733,7 @@ func (o *Service) GetWithdrawalRules(ctx context.Context, currencies ...string)
 				continue
 			}
 
+			precision := utils.ConvertPrecision(network.WithdrawIntegerMultiple.String())
 			minDepositAmount, err := o.convSvc.Convert(ctx, currconv.ConvertDTO{
 				Source:     models.ExchangeSlugMexc.String(),
 				From:       models.CurrencyCodeUSDT,
@@ -637,11 +748,11 @@ func (o *Service) GetWithdrawalRules(ctx context.Context, currencies ...string)
 			rules = append(rules, &models.WithdrawalRulesDTO{
 				Currency:           coin.Coin,
 				Chain:              network.NetWork,
-				MinDepositAmount:   minDepositAmount.String(),
+				MinDepositAmount:   minDepositAmount.RoundUp(int32(precision.IntPart())).String(), //nolint:gosec
 				MinWithdrawAmount:  network.WithdrawMin.String(),
 				MaxWithdrawAmount:  network.WithdrawMax.String(),
 				NumOfConfirmations: strconv.FormatInt(network.MinConfirm, 10),
-				WithdrawPrecision:  network.WithdrawIntegerMultiple.String(),
+				WithdrawPrecision:  precision.String(),
 				WithdrawFeeType:    models.WithdrawalFeeTypeFixed,
 				Fee:                network.WithdrawFee.String(),
 			})
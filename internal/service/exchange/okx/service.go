@@ -18,6 +18,7 @@ import (
 	"github.com/dv-net/dv-merchant/pkg/exchange_client/okx"
 	okxmodels "github.com/dv-net/dv-merchant/pkg/exchange_client/okx/models"
 	okxrequests "github.com/dv-net/dv-merchant/pkg/exchange_client/okx/requests"
+	"github.com/dv-net/dv-merchant/pkg/exchange_client/utils"
 	"github.com/dv-net/dv-merchant/pkg/logger"
 
 	"github.com/google/uuid"
@@ -63,7 +64,12 @@ func (o *Service) GetOrderDetails(ctx context.Context, args *models.GetOrderByID
 	}
 	if len(res.Orders) > 0 {
 		orderData := res.Orders[0]
-		amt, err := decimal.NewFromString(orderData.Sz)
+		// market order size is its target, limit order is accounted by filled size
+		size := orderData.Sz
+		if orderData.OrdType != okxmodels.OrderTypeMarket.String() {
+			size = orderData.AccFillSz
+		}
+		amt, err := decimal.NewFromString(size)
 		if err != nil {
 			return nil, err
 		}
@@ -78,6 +84,8 @@ func (o *Service) GetOrderDetails(ctx context.Context, args *models.GetOrderByID
 		switch orderData.State {
 		case okxmodels.OrderStateFilled:
 			order.State = models.ExchangeOrderStatusCompleted
+		case okxmodels.OrderStateCanceled, okxmodels.OrderStateMmpCanceled:
+			order.State = models.ExchangeOrderStatusCancelled
 		default:
 			order.State = models.ExchangeOrderStatusInProgress
 		}
@@ -179,6 +187,12 @@ func (o *Service) GetWithdrawalByID(ctx context.Context, args *models.GetWithdra
 		ID:     strconv.FormatInt(singleWdRecord.WdID, 10),
 		Status: singleWdRecord.State.String(),
 	}
+	if singleWdRecord.Amt != "" {
+		res.NativeAmount, err = decimal.NewFromString(singleWdRecord.Amt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse withdrawal amount: %w", err)
+		}
+	}
 	if singleWdRecord.TxID != "" {
 		res.TxHash = singleWdRecord.TxID
 	}
@@ -249,8 +263,10 @@ func (o *Service) GetWithdrawalRules(ctx context.Context, currencies ...string)
 				Chain:              item.Chain,
 				MinDepositAmount:   item.MinDep,
 				MinWithdrawAmount:  item.MinWd,
+				MaxWithdrawAmount:  item.MaxWd,
 				NumOfConfirmations: item.MinDepArrivalConfirm,
 				WithdrawPrecision:  item.WdTckSz,
+				WithdrawFeeType:    models.WithdrawalFeeTypeFixed,
 				Fee:                item.Fee,
 			}, true
 		}
@@ -375,7 +391,7 @@ func (o *Service) CreateSpotOrder(ctx context.Context, baseSymbol string, quoteS
 		fundingAmount = o.getFundingBalance(symbolData.QuoteCcy, fundingFiltered)
 		maxAmount = fundingAmount.Add(spotAmount)
 		if maxAmount.LessThan(orderMinimumQuote) {
-			return nil, exchangeclient.ErrMinWithdrawalBalance
+			return nil, exchangeclient.ErrInsufficientBalance
 		}
 	case okxmodels.OrderSideSell.String():
 		fundsTransferRequest.Ccy = symbolData.BaseCcy
@@ -383,7 +399,7 @@ func (o *Service) CreateSpotOrder(ctx context.Context, baseSymbol string, quoteS
 		fundingAmount = o.getFundingBalance(symbolData.BaseCcy, fundingFiltered)
 		maxAmount = spotAmount.Add(fundingAmount)
 		if maxAmount.LessThan(orderMinimumBase) {
-			return nil, exchangeclient.ErrMinWithdrawalBalance
+			return nil, exchangeclient.ErrInsufficientBalance
 		}
 	default:
 		return nil, fmt.Errorf("unsupported order type %s", spotOrderRequest.Side)
@@ -433,6 +449,148 @@ func (o *Service) CreateSpotOrder(ctx context.Context, baseSymbol string, quoteS
 	}, nil
 }
 
+func (o *Service) CreateLimitOrder(ctx context.Context, args *models.CreateLimitOrderParams) (*models.ExchangeOrderDTO, error) {
+	orderID, err := uuid.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+	clientOrderID := strings.ReplaceAll(orderID.String(), "-", "")
+
+	instrumentsData, err := o.exClient.Public().GetInstruments(ctx, okxrequests.GetInstruments{
+		InstType: "SPOT",
+		InstID:   args.Symbol,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(instrumentsData.Instruments) == 0 {
+		return nil, fmt.Errorf("instrument %s not found", args.Symbol)
+	}
+	symbolData := instrumentsData.Instruments[0]
+	if symbolData.State != "live" {
+		return nil, fmt.Errorf("instrument %s is not live: %s", args.Symbol, symbolData.State)
+	}
+
+	tickSize, err := decimal.NewFromString(symbolData.TickSz)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse tick size: %w", err)
+	}
+	lotSize, err := decimal.NewFromString(symbolData.LotSz)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse lot size: %w", err)
+	}
+	minSize, err := decimal.NewFromString(symbolData.MinSz)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse minimum order size: %w", err)
+	}
+
+	price := utils.FloorToStep(args.Price, tickSize)
+	if args.Side == models.OrderSideSell {
+		price = utils.CeilToStep(args.Price, tickSize)
+	}
+	qty := utils.FloorToStep(args.Amount, lotSize)
+	if qty.LessThan(minSize) {
+		return nil, exchangeclient.ErrMinOrderValue
+	}
+
+	ccy, spent := symbolData.BaseCcy, qty
+	if args.Side == models.OrderSideBuy {
+		ccy, spent = symbolData.QuoteCcy, qty.Mul(price)
+	}
+	if err := o.topUpTrading(ctx, ccy, spent); err != nil {
+		return nil, err
+	}
+
+	ordType := okxmodels.OrderTypeLimit
+	switch args.TimeInForce {
+	case models.TimeInForceIOC:
+		ordType = okxmodels.OrderTypeIOC
+	case models.TimeInForceFOK:
+		ordType = okxmodels.OrderTypeFOK
+	}
+
+	orderSize, _ := qty.Float64()
+	orderPrice, _ := price.Float64()
+	order, err := o.exClient.Trade().PlaceOrder(ctx, []okxrequests.PlaceOrder{{
+		InstID:  symbolData.InstID,
+		ClOrdID: clientOrderID,
+		TdMode:  okxmodels.CashTradingMode.String(),
+		Side:    args.Side.String(),
+		OrdType: ordType.String(),
+		Sz:      orderSize,
+		Px:      orderPrice,
+	}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to place order: %w", err)
+	}
+	if len(order.PlaceOrders) == 0 {
+		return nil, fmt.Errorf("empty place order response")
+	}
+
+	return &models.ExchangeOrderDTO{
+		ClientOrderID:   order.PlaceOrders[0].ClientOrderID,
+		ExchangeOrderID: order.PlaceOrders[0].SystemOrderID,
+		Amount:          qty,
+	}, nil
+}
+
+// topUpTrading moves missing part of amount from funding to trading account
+func (o *Service) topUpTrading(ctx context.Context, ccy string, amount decimal.Decimal) error {
+	spotBalances, err := o.exClient.Account().GetBalance(ctx, okxrequests.GetAccountBalance{
+		Ccy: []string{ccy},
+	})
+	if err != nil {
+		return err
+	}
+
+	spotAmount := decimal.Zero
+	if len(spotBalances.Balances) > 0 {
+		spotAmount = o.getSpotBalance(ccy, spotBalances.Balances[0].Details)
+	}
+	missing := amount.Sub(spotAmount)
+	if !missing.IsPositive() {
+		return nil
+	}
+
+	fundingBalances, err := o.exClient.Funding().GetBalance(ctx, okxrequests.GetFundingBalance{
+		Ccy: []string{ccy},
+	})
+	if err != nil {
+		return err
+	}
+
+	missing = decimal.Min(missing, o.getFundingBalance(ccy, fundingBalances.Balances))
+	if !missing.IsPositive() {
+		return exchangeclient.ErrInsufficientBalance
+	}
+
+	if _, err = o.exClient.Funding().FundsTransfer(ctx, okxrequests.FundsTransfer{
+		Ccy:  ccy,
+		Amt:  missing.String(),
+		From: okxmodels.BeneficiaryAccountTypeFunding.Int(),
+		To:   okxmodels.BeneficiaryAccountTypeTrading.Int(),
+	}); err != nil {
+		return fmt.Errorf("failed to transfer funds: %w", err)
+	}
+
+	return nil
+}
+
+func (o *Service) CancelOrder(ctx context.Context, args *models.CancelOrderParams) error {
+	res, err := o.exClient.Trade().CancelOrder(ctx, okxrequests.CancelOrder{
+		InstID:  args.Symbol,
+		OrdID:   args.ExternalOrderID,
+		ClOrdID: args.ClientOrderID,
+	})
+	if err != nil {
+		return err
+	}
+	if len(res.CancelOrders) > 0 && res.CancelOrders[0].SCode != "0" {
+		return fmt.Errorf("cancel order: %s", res.CancelOrders[0].SMsg)
+	}
+	return nil
+}
+
 func (o *Service) getSpotBalance(symbol string, spot []*okxmodels.BalanceDetails) decimal.Decimal {
 	spotAmount := decimal.Zero
 	spotBalance, exists := lo.Find(spot, func(item *okxmodels.BalanceDetails) bool {
@@ -492,6 +650,26 @@ func (o *Service) TestConnection(ctx context.Context) error {
 	return nil
 }
 
+// GetKeyPermissions reads rights from account config, okx funds transfer is covered by trade right
+func (o *Service) GetKeyPermissions(ctx context.Context) (*models.KeyPermissionsDTO, error) {
+	res, err := o.exClient.Account().GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Configs) == 0 {
+		return nil, fmt.Errorf("empty account config")
+	}
+
+	perm := strings.Split(res.Configs[0].Perm, ",")
+	return &models.KeyPermissionsDTO{
+		CanTrade:         lo.ToPtr(lo.Contains(perm, "trade")),
+		CanWithdraw:      lo.ToPtr(lo.Contains(perm, "withdraw")),
+		CanTransfer:      lo.ToPtr(lo.Contains(perm, "trade")),
+		IPRestricted:     lo.ToPtr(res.Configs[0].IP != ""),
+		TransferRequired: true,
+	}, nil
+}
+
 type UniversalBalanceDTO struct {
 	Balance decimal.Decimal `json:"balance"`
 	Ccy     string          `json:"ccy"`
@@ -752,7 +930,7 @@ func (o *Service) CreateWithdrawalOrder(ctx context.Context, args *models.Create
 				"current_amount", amount.String(),
 				"min_withdrawal", minWithdrawal.String(),
 			)
-			return nil, exchangeclient.ErrWithdrawalBalanceLocked
+			return nil, exchangeclient.ErrMinWithdrawalBalance
 		}
 
 		withdrawalStep, err := o.convSvc.Convert(ctx, currconv.ConvertDTO{
@@ -818,6 +996,27 @@ func (o *Service) GetOrderRules(ctx context.Context, tickers ...string) ([]*mode
 	return rules, nil
 }
 
+func (o *Service) GetTickerPrice(ctx context.Context, ticker string) (*models.TickerPriceDTO, error) {
+	res, err := o.exClient.Market().GetTicker(ctx, okxrequests.GetTicker{
+		InstID: ticker,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Tickers) == 0 {
+		return nil, fmt.Errorf("ticker %s not found", ticker)
+	}
+	bid, err := decimal.NewFromString(res.Tickers[0].BidPx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse bid price: %w", err)
+	}
+	ask, err := decimal.NewFromString(res.Tickers[0].AskPx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ask price: %w", err)
+	}
+	return &models.TickerPriceDTO{Symbol: ticker, Bid: bid, Ask: ask}, nil
+}
+
 func (o *Service) GetConnectionHash() string {
 	return o.connHash
 }
@@ -0,0 +1,341 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/exchange_manager"
+	"github.com/dv-net/dv-merchant/internal/storage/repos"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_exchange_orders"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_user_exchange_pairs"
+	exchangeclient "github.com/dv-net/dv-merchant/pkg/exchange_client"
+)
+
+const (
+	failReasonUnfilled    = "order cancelled unfilled"
+	failReasonPairRemoved = "exchange pair removed"
+	failReasonSlicesEmpty = "no twap slice filled"
+	slicePrecision        = 8
+)
+
+type twapSlice struct {
+	Amount      decimal.Decimal
+	ScheduledAt time.Time
+	ExpiresAt   time.Time
+}
+
+// limitPrice offsets mid price by percent, positive offset keeps the order away from the market
+func limitPrice(side models.OrderSide, bid, ask, offsetPercent decimal.Decimal) decimal.Decimal {
+	mid := bid.Add(ask).Div(decimal.NewFromInt(2))
+	shift := mid.Mul(offsetPercent).Div(decimal.NewFromInt(100))
+	if side == models.OrderSideBuy {
+		return mid.Sub(shift)
+	}
+	return mid.Add(shift)
+}
+
+// baseAmount converts spent amount to base currency quantity, quote is spent on buy
+func baseAmount(side models.OrderSide, spent, price decimal.Decimal) decimal.Decimal {
+	if side == models.OrderSideBuy {
+		return spent.Div(price)
+	}
+	return spent
+}
+
+// splitTWAP splits amount into slices spread evenly over the window, the last slice takes the remainder.
+// Slices count is reduced so that every slice reaches minimum, nil is returned when amount is below it.
+func splitTWAP(amount, minimum decimal.Decimal, slices int32, window time.Duration, start time.Time) []twapSlice {
+	count := int64(slices)
+	if minimum.IsPositive() {
+		count = min(count, amount.Div(minimum).IntPart())
+	}
+	if count < 1 {
+		return nil
+	}
+
+	interval := window / time.Duration(count)
+	part := amount.Div(decimal.NewFromInt(count)).RoundDown(slicePrecision)
+
+	result := make([]twapSlice, 0, count)
+	rest := amount
+	for i := range count {
+		sliceAmount := part
+		if i == count-1 {
+			sliceAmount = rest
+		}
+		rest = rest.Sub(sliceAmount)
+
+		scheduledAt := start.Add(time.Duration(i) * interval)
+		result = append(result, twapSlice{
+			Amount:      sliceAmount,
+			ScheduledAt: scheduledAt,
+			ExpiresAt:   scheduledAt.Add(interval),
+		})
+	}
+
+	return result
+}
+
+// submitLimitOrder places single limit order priced from the current book
+func (s *Service) submitLimitOrder(ctx context.Context, pair *models.UserExchangePair, venue *routeCandidate) error {
+	price, err := s.pairLimitPrice(ctx, venue.client, venue.Symbol, pair)
+	if err != nil {
+		return err
+	}
+
+	var expiresAt pgtype.Timestamp
+	if pair.TimeInForce == models.TimeInForceGTC && s.orders.LimitTTL > 0 {
+		expiresAt = pgtype.Timestamp{Valid: true, Time: time.Now().Add(s.orders.LimitTTL)}
+	}
+
+	return repos.BeginTxFunc(ctx, s.st.PSQLConn(), pgx.TxOptions{}, func(tx pgx.Tx) error {
+		record, err := s.st.ExchangeOrders(repos.WithTx(tx)).Create(ctx, repo_exchange_orders.CreateParams{
+			ExchangeID:             venue.exchangeID,
+			UserID:                 pair.UserID,
+			Symbol:                 venue.Symbol,
+			Side:                   pair.Type,
+			Amount:                 venue.Balance,
+			OrderCreatedAt:         pgtype.Timestamp{Valid: true, Time: time.Now()},
+			Status:                 models.ExchangeOrderStatusNew,
+			ExchangeConnectionHash: pgtype.Text{Valid: true, String: venue.client.GetConnectionHash()},
+			OrderType:              models.OrderTypeLimit,
+			TimeInForce:            pgtype.Text{Valid: true, String: pair.TimeInForce.String()},
+			ExpiresAt:              expiresAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		updateParams, err := placeLimitOrder(ctx, venue.client, record, price, pair.TimeInForce)
+		if err != nil {
+			if errors.Is(err, exchangeclient.ErrSkipOrder) || errors.Is(err, exchangeclient.ErrInsufficientBalance) {
+				return err
+			}
+			updateParams.Status = pgtype.Text{Valid: true, String: models.ExchangeOrderStatusFailed.String()}
+			updateParams.FailReason = pgtype.Text{Valid: true, String: err.Error()}
+		}
+
+		return s.st.ExchangeOrders(repos.WithTx(tx)).Update(ctx, updateParams)
+	})
+}
+
+// submitTWAPOrder stores parent order with its slices, slices are placed by processExchangeOrders once due
+func (s *Service) submitTWAPOrder(ctx context.Context, pair *models.UserExchangePair, venue *routeCandidate, minimum decimal.Decimal) error {
+	now := time.Now()
+	slices := splitTWAP(venue.Balance, minimum, pair.TwapSlices, time.Duration(pair.TwapWindowSeconds)*time.Second, now)
+	if len(slices) == 0 {
+		return exchangeclient.ErrInsufficientBalance
+	}
+
+	connHash := pgtype.Text{Valid: true, String: venue.client.GetConnectionHash()}
+	timeInForce := pgtype.Text{Valid: true, String: pair.TimeInForce.String()}
+
+	return repos.BeginTxFunc(ctx, s.st.PSQLConn(), pgx.TxOptions{}, func(tx pgx.Tx) error {
+		parent, err := s.st.ExchangeOrders(repos.WithTx(tx)).Create(ctx, repo_exchange_orders.CreateParams{
+			ExchangeID:             venue.exchangeID,
+			UserID:                 pair.UserID,
+			Symbol:                 venue.Symbol,
+			Side:                   pair.Type,
+			Amount:                 venue.Balance,
+			OrderCreatedAt:         pgtype.Timestamp{Valid: true, Time: now},
+			Status:                 models.ExchangeOrderStatusInProgress,
+			ExchangeConnectionHash: connHash,
+			OrderType:              models.OrderTypeTWAP,
+			TimeInForce:            timeInForce,
+			ScheduledAt:            pgtype.Timestamp{Valid: true, Time: now},
+		})
+		if err != nil {
+			return fmt.Errorf("create twap order: %w", err)
+		}
+
+		for _, slice := range slices {
+			params := repo_exchange_orders.CreateParams{
+				ExchangeID:             venue.exchangeID,
+				UserID:                 pair.UserID,
+				Symbol:                 venue.Symbol,
+				Side:                   pair.Type,
+				Amount:                 slice.Amount,
+				OrderCreatedAt:         pgtype.Timestamp{Valid: true, Time: now},
+				Status:                 models.ExchangeOrderStatusNew,
+				ExchangeConnectionHash: connHash,
+				OrderType:              models.OrderTypeLimit,
+				TimeInForce:            timeInForce,
+				ParentID:               uuid.NullUUID{Valid: true, UUID: parent.ID},
+				ScheduledAt:            pgtype.Timestamp{Valid: true, Time: slice.ScheduledAt},
+			}
+			if pair.TimeInForce == models.TimeInForceGTC {
+				params.ExpiresAt = pgtype.Timestamp{Valid: true, Time: slice.ExpiresAt}
+			}
+			if _, err = s.st.ExchangeOrders(repos.WithTx(tx)).Create(ctx, params); err != nil {
+				return fmt.Errorf("create twap slice: %w", err)
+			}
+		}
+
+		s.log.Infow("twap order scheduled", "userID", pair.UserID, "symbol", venue.Symbol, "order_id", parent.ID, "slices", len(slices))
+		return nil
+	})
+}
+
+// placeLimitOrder places limit order for stored record and returns record update,
+// record amount is spent currency and is converted to base quantity by the price
+func placeLimitOrder(ctx context.Context, client exchange_manager.IExchangeClient, record *models.ExchangeOrder, price decimal.Decimal, timeInForce models.TimeInForce) (repo_exchange_orders.UpdateParams, error) {
+	updateParams := repo_exchange_orders.UpdateParams{
+		ID:                     record.ID,
+		ExchangeConnectionHash: pgtype.Text{Valid: true, String: client.GetConnectionHash()},
+		Price:                  decimal.NullDecimal{Valid: true, Decimal: price},
+	}
+
+	order, err := client.CreateLimitOrder(ctx, &models.CreateLimitOrderParams{
+		Symbol:      record.Symbol,
+		Side:        record.Side,
+		Amount:      baseAmount(record.Side, record.Amount, price),
+		Price:       price,
+		TimeInForce: timeInForce,
+	})
+	if err != nil {
+		return updateParams, err
+	}
+
+	updateParams.Status = pgtype.Text{Valid: true, String: models.ExchangeOrderStatusInProgress.String()}
+	updateParams.Amount = decimal.NullDecimal{Valid: true, Decimal: order.Amount}
+	if order.ClientOrderID != "" {
+		updateParams.ClientOrderID = pgtype.Text{Valid: true, String: order.ClientOrderID}
+	}
+	if order.ExchangeOrderID != "" {
+		updateParams.ExchangeOrderID = pgtype.Text{Valid: true, String: order.ExchangeOrderID}
+	}
+
+	return updateParams, nil
+}
+
+func (s *Service) pairLimitPrice(ctx context.Context, client exchange_manager.IExchangeClient, symbol string, pair *models.UserExchangePair) (decimal.Decimal, error) {
+	ticker, err := client.GetTickerPrice(ctx, symbol)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("fetch ticker price: %w", err)
+	}
+
+	price := limitPrice(pair.Type, ticker.Bid, ticker.Ask, pair.PriceOffset)
+	if !ticker.Bid.IsPositive() || !ticker.Ask.IsPositive() || !price.IsPositive() {
+		return decimal.Zero, fmt.Errorf("no price for symbol %s", symbol)
+	}
+
+	return price, nil
+}
+
+// placeDueSlices places twap slices whose time has come, slices of removed pairs are failed
+func (s *Service) placeDueSlices(ctx context.Context) {
+	slices, err := s.st.ExchangeOrders().GetDueSlices(ctx)
+	if err != nil {
+		s.log.Errorw("failed to fetch due twap slices", "error", err)
+		return
+	}
+
+	for _, slice := range slices {
+		updateParams, err := s.placeSlice(ctx, slice)
+		if err != nil {
+			s.log.Errorw("failed to place twap slice", "error", err, "order_id", slice.ID)
+			updateParams.ID = slice.ID
+			updateParams.Status = pgtype.Text{Valid: true, String: models.ExchangeOrderStatusFailed.String()}
+			updateParams.FailReason = pgtype.Text{Valid: true, String: err.Error()}
+		}
+
+		if err = s.st.ExchangeOrders().Update(ctx, updateParams); err != nil {
+			s.log.Errorw("failed to update twap slice", "error", err, "order_id", slice.ID)
+		}
+	}
+}
+
+func (s *Service) placeSlice(ctx context.Context, slice *models.ExchangeOrder) (repo_exchange_orders.UpdateParams, error) {
+	pairs, err := s.st.UserExchangePairs().Find(ctx, repo_user_exchange_pairs.FindParams{
+		ExchangeID: slice.ExchangeID,
+		UserID:     slice.UserID,
+	})
+	if err != nil {
+		return repo_exchange_orders.UpdateParams{}, fmt.Errorf("fetch user exchange pairs: %w", err)
+	}
+	pair, ok := lo.Find(pairs, func(item *models.UserExchangePair) bool {
+		return item.Symbol == slice.Symbol && item.Type == slice.Side && item.OrderType == models.OrderTypeTWAP
+	})
+	if !ok {
+		return repo_exchange_orders.UpdateParams{}, errors.New(failReasonPairRemoved)
+	}
+
+	ex, err := s.st.Exchanges().GetByID(ctx, slice.ExchangeID)
+	if err != nil {
+		return repo_exchange_orders.UpdateParams{}, fmt.Errorf("fetch exchange: %w", err)
+	}
+
+	client, err := s.exManager.GetDriver(ctx, ex.Slug, slice.UserID)
+	if err != nil {
+		return repo_exchange_orders.UpdateParams{}, fmt.Errorf("create exchange client: %w", err)
+	}
+
+	price, err := s.pairLimitPrice(ctx, client, slice.Symbol, pair)
+	if err != nil {
+		return repo_exchange_orders.UpdateParams{}, err
+	}
+
+	return placeLimitOrder(ctx, client, slice, price, models.TimeInForce(slice.TimeInForce.String))
+}
+
+// cancelExpiredOrder cancels resting limit order past its expiry, the final state is read right after
+func (s *Service) cancelExpiredOrder(ctx context.Context, client exchange_manager.IExchangeClient, order *models.ExchangeOrder) {
+	if order.OrderType != models.OrderTypeLimit || !order.ExpiresAt.Valid || time.Now().Before(order.ExpiresAt.Time) {
+		return
+	}
+
+	if err := client.CancelOrder(ctx, &models.CancelOrderParams{
+		Symbol:          order.Symbol,
+		ExternalOrderID: order.ExchangeOrderID.String,
+		ClientOrderID:   order.ClientOrderID.String,
+	}); err != nil {
+		s.log.Errorw("failed to cancel expired limit order", "error", err, "order_id", order.ID)
+	}
+}
+
+// settleTWAPOrder completes parent order once none of its slices is active,
+// amounts of filled slices are summed and parent fails when nothing was filled
+func (s *Service) settleTWAPOrder(ctx context.Context, order *models.ExchangeOrder) error {
+	children, err := s.st.ExchangeOrders().GetChildren(ctx, uuid.NullUUID{Valid: true, UUID: order.ID})
+	if err != nil {
+		return fmt.Errorf("fetch twap slices: %w", err)
+	}
+
+	amount, amountUSD := decimal.Zero, decimal.Zero
+	for _, child := range children {
+		switch child.Status {
+		case models.ExchangeOrderStatusNew, models.ExchangeOrderStatusInProgress:
+			return nil
+		case models.ExchangeOrderStatusCompleted:
+			amount = amount.Add(child.Amount)
+			if child.AmountUsd.Valid {
+				amountUSD = amountUSD.Add(child.AmountUsd.Decimal)
+			}
+		}
+	}
+
+	updateParams := repo_exchange_orders.UpdateParams{
+		ID:     order.ID,
+		Status: pgtype.Text{Valid: true, String: models.ExchangeOrderStatusCompleted.String()},
+		Amount: decimal.NullDecimal{Valid: true, Decimal: amount},
+	}
+	if amountUSD.IsPositive() {
+		updateParams.AmountUsd = decimal.NullDecimal{Valid: true, Decimal: amountUSD}
+	}
+	if amount.IsZero() {
+		updateParams.Status = pgtype.Text{Valid: true, String: models.ExchangeOrderStatusFailed.String()}
+		updateParams.FailReason = pgtype.Text{Valid: true, String: failReasonSlicesEmpty}
+		updateParams.Amount = decimal.NullDecimal{}
+	}
+
+	return s.st.ExchangeOrders().Update(ctx, updateParams)
+}
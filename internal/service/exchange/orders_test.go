@@ -0,0 +1,55 @@
+package exchange
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+)
+
+func TestLimitPrice(t *testing.T) {
+	tests := []struct {
+		name     string
+		side     models.OrderSide
+		offset   string
+		expected string
+	}{
+		{name: "buy at mid", side: models.OrderSideBuy, offset: "0", expected: "100"},
+		{name: "buy below mid", side: models.OrderSideBuy, offset: "1", expected: "99"},
+		{name: "sell above mid", side: models.OrderSideSell, offset: "1", expected: "101"},
+		{name: "sell crossing", side: models.OrderSideSell, offset: "-0.5", expected: "99.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := limitPrice(tt.side, decimal.NewFromInt(99), decimal.NewFromInt(101), decimal.RequireFromString(tt.offset))
+			require.True(t, decimal.RequireFromString(tt.expected).Equal(got), "got %s", got)
+		})
+	}
+}
+
+func TestSplitTWAP(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	slices := splitTWAP(decimal.NewFromInt(10), decimal.NewFromInt(1), 3, 30*time.Minute, start)
+	require.Len(t, slices, 3)
+	require.True(t, decimal.RequireFromString("3.33333333").Equal(slices[0].Amount), "got %s", slices[0].Amount)
+	require.True(t, decimal.RequireFromString("3.33333334").Equal(slices[2].Amount), "got %s", slices[2].Amount)
+	require.Equal(t, start.Add(20*time.Minute), slices[2].ScheduledAt)
+	require.Equal(t, start.Add(30*time.Minute), slices[2].ExpiresAt)
+
+	total := decimal.Zero
+	for _, slice := range slices {
+		total = total.Add(slice.Amount)
+	}
+	require.True(t, decimal.NewFromInt(10).Equal(total))
+
+	reduced := splitTWAP(decimal.NewFromInt(5), decimal.NewFromInt(2), 10, time.Hour, start)
+	require.Len(t, reduced, 2)
+	require.Equal(t, start.Add(30*time.Minute), reduced[1].ScheduledAt)
+
+	require.Nil(t, splitTWAP(decimal.NewFromInt(1), decimal.NewFromInt(2), 5, time.Hour, start))
+}
@@ -0,0 +1,85 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_user_exchanges"
+)
+
+// keyExpiryWarningPeriod is how long before expiry the key is reported as expiring
+const keyExpiryWarningPeriod = 14 * 24 * time.Hour
+
+type KeyPermissionsResult struct {
+	Slug        models.ExchangeSlug
+	Permissions *models.KeyPermissionsDTO
+	Warnings    []models.KeyPermissionWarning
+}
+
+// GetKeyPermissions compares rights of the stored key with swaps and withdrawals enabled for the exchange
+func (s *Service) GetKeyPermissions(ctx context.Context, user models.User, slug models.ExchangeSlug) (*KeyPermissionsResult, error) {
+	exClient, err := s.exManager.GetDriver(ctx, slug, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	perms, err := exClient.GetKeyPermissions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetch key permissions: %w", err)
+	}
+
+	ex, err := s.st.Exchanges().GetExchangeBySlug(ctx, slug)
+	if err != nil {
+		return nil, fmt.Errorf("fetch exchange: %w", err)
+	}
+
+	ue, err := s.st.UserExchanges().GetByUserAndExchangeID(ctx, repo_user_exchanges.GetByUserAndExchangeIDParams{
+		UserID:     user.ID,
+		ExchangeID: ex.ID,
+	})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("fetch user exchange: %w", err)
+	}
+
+	return &KeyPermissionsResult{
+		Slug:        slug,
+		Permissions: perms,
+		Warnings:    keyPermissionWarnings(perms, ue, time.Now()),
+	}, nil
+}
+
+// keyPermissionWarnings reports rights missing for enabled features and rights no enabled feature needs,
+// rights the exchange does not expose produce no warnings
+func keyPermissionWarnings(perms *models.KeyPermissionsDTO, ue *models.UserExchange, now time.Time) []models.KeyPermissionWarning {
+	swaps := ue != nil && ue.SwapState == models.ExchangeSwapStateEnabled
+	withdrawals := ue != nil && ue.WithdrawalState == models.ExchangeWithdrawalStateEnabled
+	transfers := perms.TransferRequired && (swaps || withdrawals)
+
+	warnings := make([]models.KeyPermissionWarning, 0)
+	check := func(granted *bool, needed bool, missing, excessive models.KeyPermissionWarning) {
+		switch {
+		case granted == nil:
+		case needed && !*granted:
+			warnings = append(warnings, missing)
+		case !needed && *granted:
+			warnings = append(warnings, excessive)
+		}
+	}
+	check(perms.CanTrade, swaps, models.KeyPermissionWarningTradeMissing, models.KeyPermissionWarningTradeExcessive)
+	check(perms.CanWithdraw, withdrawals, models.KeyPermissionWarningWithdrawMissing, models.KeyPermissionWarningWithdrawExcessive)
+	check(perms.CanTransfer, transfers, models.KeyPermissionWarningTransferMissing, models.KeyPermissionWarningTransferExcessive)
+
+	if perms.IPRestricted != nil && !*perms.IPRestricted {
+		warnings = append(warnings, models.KeyPermissionWarningNoIPRestriction)
+	}
+	if perms.ExpiresAt != nil && perms.ExpiresAt.Before(now.Add(keyExpiryWarningPeriod)) {
+		warnings = append(warnings, models.KeyPermissionWarningExpiresSoon)
+	}
+
+	return warnings
+}
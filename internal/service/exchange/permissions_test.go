@@ -0,0 +1,96 @@
+package exchange
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+)
+
+func TestKeyPermissionWarnings(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	swapsOnly := &models.UserExchange{SwapState: models.ExchangeSwapStateEnabled, WithdrawalState: models.ExchangeWithdrawalStateDisabled}
+	everything := &models.UserExchange{SwapState: models.ExchangeSwapStateEnabled, WithdrawalState: models.ExchangeWithdrawalStateEnabled}
+
+	tests := []struct {
+		name     string
+		perms    *models.KeyPermissionsDTO
+		ue       *models.UserExchange
+		expected []models.KeyPermissionWarning
+	}{
+		{
+			name: "least privilege",
+			perms: &models.KeyPermissionsDTO{
+				CanTrade:         lo.ToPtr(true),
+				CanWithdraw:      lo.ToPtr(true),
+				CanTransfer:      lo.ToPtr(true),
+				IPRestricted:     lo.ToPtr(true),
+				TransferRequired: true,
+			},
+			ue:       everything,
+			expected: []models.KeyPermissionWarning{},
+		},
+		{
+			name: "withdraw right on swaps only key",
+			perms: &models.KeyPermissionsDTO{
+				CanTrade:     lo.ToPtr(true),
+				CanWithdraw:  lo.ToPtr(true),
+				IPRestricted: lo.ToPtr(true),
+			},
+			ue:       swapsOnly,
+			expected: []models.KeyPermissionWarning{models.KeyPermissionWarningWithdrawExcessive},
+		},
+		{
+			name: "missing rights for enabled features",
+			perms: &models.KeyPermissionsDTO{
+				CanTrade:         lo.ToPtr(false),
+				CanWithdraw:      lo.ToPtr(false),
+				CanTransfer:      lo.ToPtr(false),
+				TransferRequired: true,
+			},
+			ue: everything,
+			expected: []models.KeyPermissionWarning{
+				models.KeyPermissionWarningTradeMissing,
+				models.KeyPermissionWarningWithdrawMissing,
+				models.KeyPermissionWarningTransferMissing,
+			},
+		},
+		{
+			name: "transfer right on driver without transfers",
+			perms: &models.KeyPermissionsDTO{
+				CanTrade:    lo.ToPtr(true),
+				CanTransfer: lo.ToPtr(true),
+			},
+			ue:       swapsOnly,
+			expected: []models.KeyPermissionWarning{models.KeyPermissionWarningTransferExcessive},
+		},
+		{
+			name: "exchange not configured",
+			perms: &models.KeyPermissionsDTO{
+				CanTrade:     lo.ToPtr(true),
+				IPRestricted: lo.ToPtr(false),
+				ExpiresAt:    lo.ToPtr(now.Add(72 * time.Hour)),
+			},
+			expected: []models.KeyPermissionWarning{
+				models.KeyPermissionWarningTradeExcessive,
+				models.KeyPermissionWarningNoIPRestriction,
+				models.KeyPermissionWarningExpiresSoon,
+			},
+		},
+		{
+			name:     "nothing exposed",
+			perms:    &models.KeyPermissionsDTO{ExpiresAt: lo.ToPtr(now.Add(90 * 24 * time.Hour))},
+			ue:       everything,
+			expected: []models.KeyPermissionWarning{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, keyPermissionWarnings(tt.perms, tt.ue, now))
+		})
+	}
+}
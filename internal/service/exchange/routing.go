@@ -0,0 +1,529 @@
+package exchange
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/exchange_manager"
+	"github.com/dv-net/dv-merchant/internal/service/setting"
+	"github.com/dv-net/dv-merchant/internal/storage/repos"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_exchange_chains"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_exchange_orders"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_exchange_withdrawal_settings"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_user_exchange_pairs"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_user_exchanges"
+	exchangeclient "github.com/dv-net/dv-merchant/pkg/exchange_client"
+)
+
+const routeRejectedWorse = "lower net proceeds"
+
+// swapRoute is stored with exchange order to audit why the exchange was chosen
+type swapRoute struct {
+	Mode       string              `json:"mode"`
+	Side       models.OrderSide    `json:"side"`
+	Amount     decimal.Decimal     `json:"amount"`
+	Chosen     models.ExchangeSlug `json:"chosen"`
+	Candidates []*routeCandidate   `json:"candidates"`
+	// Reserve is set when another exchange spends its own funds instead of the pair exchange
+	Reserve *routeReserve `json:"reserve,omitempty"`
+}
+
+// routeReserve is the part of pair exchange balance left out of its swaps,
+// because the same amount was already spent on another exchange
+type routeReserve struct {
+	ExchangeID uuid.UUID       `json:"exchange_id"`
+	Currency   string          `json:"currency"`
+	Amount     decimal.Decimal `json:"amount"`
+}
+
+type routeCandidate struct {
+	Slug          models.ExchangeSlug `json:"slug"`
+	Symbol        string              `json:"symbol,omitempty"`
+	Balance       decimal.Decimal     `json:"balance"`
+	Price         decimal.Decimal     `json:"price"`
+	TakerFee      decimal.Decimal     `json:"taker_fee"`
+	WithdrawalFee decimal.Decimal     `json:"withdrawal_fee"`
+	NetProceeds   decimal.Decimal     `json:"net_proceeds"`
+	Rejected      string              `json:"rejected,omitempty"`
+
+	exchangeID uuid.UUID
+	client     exchange_manager.IExchangeClient
+	rule       *models.OrderRulesDTO
+}
+
+// netProceeds returns output currency left after taker fee and withdrawal of proceeds,
+// base is sold by bid price and bought by ask price
+func netProceeds(side models.OrderSide, amount, price, takerFeePercent, withdrawalFee decimal.Decimal) decimal.Decimal {
+	if !price.IsPositive() {
+		return decimal.Zero
+	}
+
+	gross := amount.Mul(price)
+	if side == models.OrderSideBuy {
+		gross = amount.Div(price)
+	}
+
+	hundred := decimal.NewFromInt(100)
+	return gross.Mul(hundred.Sub(takerFeePercent)).Div(hundred).Sub(withdrawalFee)
+}
+
+// selectRoute returns candidate with the highest net proceeds, the earlier one wins a tie.
+// Other priced candidates are marked as rejected.
+func selectRoute(candidates []*routeCandidate) *routeCandidate {
+	var best *routeCandidate
+	for _, c := range candidates {
+		if c.Rejected != "" {
+			continue
+		}
+		if best == nil || c.NetProceeds.GreaterThan(best.NetProceeds) {
+			best = c
+		}
+	}
+
+	for _, c := range candidates {
+		if c != best && c.Rejected == "" {
+			c.Rejected = routeRejectedWorse
+		}
+	}
+
+	return best
+}
+
+func (s *Service) swapRoutingMode(ctx context.Context, userID uuid.UUID) (string, error) {
+	user, err := s.st.Users().GetByID(ctx, userID)
+	if err != nil {
+		return "", fmt.Errorf("fetch user: %w", err)
+	}
+
+	mode, err := s.settingSvc.GetModelSetting(ctx, setting.ExchangeSwapRouting, setting.IModelSetting(user))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return setting.SwapRoutingCurrent, nil
+		}
+		return "", fmt.Errorf("fetch swap routing setting: %w", err)
+	}
+
+	return mode.Value, nil
+}
+
+func (s *Service) takerFee(slug models.ExchangeSlug) decimal.Decimal {
+	if fee, ok := s.routing.TakerFees[slug.String()]; ok {
+		return decimal.NewFromFloat(fee)
+	}
+	return decimal.NewFromFloat(s.routing.DefaultTakerFee)
+}
+
+// routeSwap compares the pair on every connected exchange holding enough of the spent currency
+// and returns the one with the best net proceeds. Funds are never moved between exchanges,
+// other exchange spends only the swept amount of its own balance and the same amount is reserved
+// on the current exchange, current exchange is kept when no candidate can be priced.
+func (s *Service) routeSwap(ctx context.Context, userID uuid.UUID, side models.OrderSide, current *routeCandidate) (*routeCandidate, []byte, error) {
+	candidates := []*routeCandidate{current}
+
+	slugs, err := s.connectedExchanges(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, slug := range slugs {
+		if slug == current.Slug {
+			continue
+		}
+		candidates = append(candidates, s.routeCandidate(ctx, userID, slug, side, current))
+	}
+
+	s.priceCandidate(ctx, userID, side, current.Balance, current)
+	for _, c := range candidates[1:] {
+		if c.Rejected == "" {
+			s.priceCandidate(ctx, userID, side, current.Balance, c)
+		}
+	}
+
+	chosen := selectRoute(candidates)
+	if chosen == nil {
+		chosen = current
+	}
+
+	plan := swapRoute{
+		Mode:       setting.SwapRoutingBest,
+		Side:       side,
+		Amount:     current.Balance,
+		Chosen:     chosen.Slug,
+		Candidates: candidates,
+	}
+	if chosen != current {
+		spent, _ := routedAmounts(side, current.Balance, chosen.Price, chosen.rule)
+		plan.Reserve = &routeReserve{
+			ExchangeID: current.exchangeID,
+			Currency:   spentCurrency(side, current.rule),
+			Amount:     spent,
+		}
+	}
+
+	route, err := json.Marshal(plan)
+	if err != nil {
+		return nil, nil, fmt.Errorf("marshal swap route: %w", err)
+	}
+
+	return chosen, route, nil
+}
+
+// connectedExchanges returns active exchanges user has keys for
+func (s *Service) connectedExchanges(ctx context.Context, userID uuid.UUID) ([]models.ExchangeSlug, error) {
+	rows, err := s.st.Exchanges().GetAllActiveWithUserKeys(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("fetch connected exchanges: %w", err)
+	}
+
+	slugs := make([]models.ExchangeSlug, 0, len(rows))
+	for _, row := range rows {
+		if row.Value.Valid && !slices.Contains(slugs, row.Slug) {
+			slugs = append(slugs, row.Slug)
+		}
+	}
+	slices.Sort(slugs)
+
+	return slugs, nil
+}
+
+// routeCandidate looks up the pair of current exchange on another one and checks its balance.
+// Only idle balance is spent: exchanges with disabled swaps and the ones swapping the spent currency
+// by their own pairs are rejected.
+func (s *Service) routeCandidate(ctx context.Context, userID uuid.UUID, slug models.ExchangeSlug, side models.OrderSide, current *routeCandidate) *routeCandidate {
+	c := &routeCandidate{Slug: slug}
+
+	ex, err := s.st.Exchanges().GetExchangeBySlug(ctx, slug)
+	if err != nil {
+		c.Rejected = fmt.Sprintf("fetch exchange: %s", err)
+		return c
+	}
+	c.exchangeID = ex.ID
+
+	ue, err := s.st.UserExchanges().GetByUserAndExchangeID(ctx, repo_user_exchanges.GetByUserAndExchangeIDParams{
+		UserID:     userID,
+		ExchangeID: ex.ID,
+	})
+	if err != nil {
+		c.Rejected = fmt.Sprintf("fetch user exchange: %s", err)
+		return c
+	}
+	if ue.SwapState != models.ExchangeSwapStateEnabled {
+		c.Rejected = "swaps are disabled"
+		return c
+	}
+
+	if c.client, err = s.exManager.GetDriver(ctx, slug, userID); err != nil {
+		c.Rejected = fmt.Sprintf("create exchange client: %s", err)
+		return c
+	}
+
+	symbols, err := c.client.GetExchangeSymbols(ctx)
+	if err != nil {
+		c.Rejected = fmt.Sprintf("fetch symbols: %s", err)
+		return c
+	}
+	symbol, ok := lo.Find(symbols, func(item *models.ExchangeSymbolDTO) bool {
+		return strings.EqualFold(item.BaseSymbol, current.rule.BaseCurrency) && strings.EqualFold(item.QuoteSymbol, current.rule.QuoteCurrency)
+	})
+	if !ok {
+		c.Rejected = "pair is not listed"
+		return c
+	}
+	c.Symbol = symbol.Symbol
+
+	if c.rule, err = c.client.GetOrderRule(ctx, c.Symbol); err != nil {
+		c.Rejected = fmt.Sprintf("fetch order rules: %s", err)
+		return c
+	}
+
+	spent := spentCurrency(side, c.rule)
+	pairs, err := s.st.UserExchangePairs().Find(ctx, repo_user_exchange_pairs.FindParams{
+		ExchangeID: ex.ID,
+		UserID:     userID,
+	})
+	if err != nil {
+		c.Rejected = fmt.Sprintf("fetch exchange pairs: %s", err)
+		return c
+	}
+	if lo.ContainsBy(pairs, func(pair *models.UserExchangePair) bool {
+		return strings.EqualFold(pairSpentCurrency(pair), spent)
+	}) {
+		c.Rejected = "balance is swapped by own pair"
+		return c
+	}
+
+	balance, err := c.client.GetCurrencyBalance(ctx, spent)
+	if err != nil {
+		c.Rejected = fmt.Sprintf("fetch currency balance: %s", err)
+		return c
+	}
+	c.Balance = *balance
+
+	if c.Balance.LessThan(current.Balance) {
+		c.Rejected = "insufficient balance"
+	}
+
+	return c
+}
+
+func (s *Service) priceCandidate(ctx context.Context, userID uuid.UUID, side models.OrderSide, amount decimal.Decimal, c *routeCandidate) {
+	ticker, err := c.client.GetTickerPrice(ctx, c.Symbol)
+	if err != nil {
+		c.Rejected = fmt.Sprintf("fetch ticker price: %s", err)
+		return
+	}
+
+	c.Price = ticker.Bid
+	received := c.rule.QuoteCurrency
+	if side == models.OrderSideBuy {
+		c.Price, received = ticker.Ask, c.rule.BaseCurrency
+	}
+	if !c.Price.IsPositive() {
+		c.Rejected = "no price"
+		return
+	}
+
+	if c.WithdrawalFee, err = s.proceedsWithdrawalFee(ctx, userID, c, received); err != nil {
+		c.Rejected = fmt.Sprintf("fetch withdrawal fee: %s", err)
+		return
+	}
+
+	c.TakerFee = s.takerFee(c.Slug)
+	c.NetProceeds = netProceeds(side, amount, c.Price, c.TakerFee, c.WithdrawalFee)
+}
+
+// proceedsWithdrawalFee returns fee of enabled withdrawal of received currency from the exchange,
+// proceeds kept on exchange cost nothing
+func (s *Service) proceedsWithdrawalFee(ctx context.Context, userID uuid.UUID, c *routeCandidate, currency string) (decimal.Decimal, error) {
+	settings, err := s.st.ExchangeWithdrawalSettings().GetAllByUser(ctx, repo_exchange_withdrawal_settings.GetAllByUserParams{
+		UserID:     userID,
+		ExchangeID: c.exchangeID,
+	})
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	for _, wdSetting := range settings {
+		if !wdSetting.IsEnabled {
+			continue
+		}
+
+		ticker, err := s.st.ExchangeChains().GetTickerByCurrencyID(ctx, repo_exchange_chains.GetTickerByCurrencyIDParams{
+			CurrencyID: wdSetting.Currency,
+			Slug:       c.Slug,
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				continue
+			}
+			return decimal.Zero, err
+		}
+		if !strings.EqualFold(ticker, currency) {
+			continue
+		}
+
+		rule, err := s.exRulesSvc.GetWithdrawalRule(ctx, c.Slug, userID.String(), wdSetting.Currency)
+		if err != nil {
+			return decimal.Zero, err
+		}
+		return decimal.NewFromString(rule.Fee)
+	}
+
+	return decimal.Zero, nil
+}
+
+func spentCurrency(side models.OrderSide, rule *models.OrderRulesDTO) string {
+	if side == models.OrderSideBuy {
+		return rule.QuoteCurrency
+	}
+	return rule.BaseCurrency
+}
+
+func pairSpentCurrency(pair *models.UserExchangePair) string {
+	if pair.Type == models.OrderSideBuy {
+		return pair.CurrencyTo
+	}
+	return pair.CurrencyFrom
+}
+
+// routedReserve returns reserved part of the pair exchange balance. Reserve above the balance
+// is cut down to it, funds withdrawn from the exchange are not reserved anymore.
+func (s *Service) routedReserve(ctx context.Context, pair *models.UserExchangePair, currency string, balance decimal.Decimal) (decimal.Decimal, error) {
+	reserved, err := s.st.ExchangeOrders().GetRoutedReserve(ctx, repo_exchange_orders.GetRoutedReserveParams{
+		UserID:     pair.UserID,
+		ExchangeID: pair.ExchangeID,
+		Currency:   currency,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return decimal.Zero, nil
+		}
+		return decimal.Zero, fmt.Errorf("fetch routed reserve: %w", err)
+	}
+
+	if reserved.GreaterThan(balance) {
+		if err = s.st.ExchangeOrders().CapRoutedReserve(ctx, repo_exchange_orders.CapRoutedReserveParams{
+			UserID:     pair.UserID,
+			ExchangeID: pair.ExchangeID,
+			Currency:   currency,
+			Balance:    balance,
+		}); err != nil {
+			return decimal.Zero, fmt.Errorf("cap routed reserve: %w", err)
+		}
+		reserved = balance
+	}
+
+	return reserved, nil
+}
+
+// routedReserveRelease returns reserve of the routed order and the part of it to release once the order is done:
+// the whole reserve of a failed order and the unfilled part of a completed one
+func routedReserveRelease(order *models.ExchangeOrder, state models.ExchangeOrderStatus, filled decimal.Decimal) (*routeReserve, decimal.Decimal) {
+	if len(order.Route) == 0 {
+		return nil, decimal.Zero
+	}
+
+	var route swapRoute
+	if err := json.Unmarshal(order.Route, &route); err != nil || route.Reserve == nil {
+		return nil, decimal.Zero
+	}
+
+	switch state {
+	case models.ExchangeOrderStatusFailed:
+		return route.Reserve, route.Reserve.Amount
+	case models.ExchangeOrderStatusCompleted:
+		// limit order is accounted by filled base amount
+		spent := filled
+		if order.Side == models.OrderSideBuy {
+			spent = filled.Mul(order.Price.Decimal)
+		}
+		return route.Reserve, decimal.Max(route.Reserve.Amount.Sub(spent), decimal.Zero)
+	default:
+		return route.Reserve, decimal.Zero
+	}
+}
+
+// releaseRoutedReserve gives the pair exchange back reserved funds the routed order did not spend
+func (s *Service) releaseRoutedReserve(ctx context.Context, order *models.ExchangeOrder, state models.ExchangeOrderStatus, filled decimal.Decimal, tx pgx.Tx) error {
+	reserve, released := routedReserveRelease(order, state, filled)
+	if reserve == nil || !released.IsPositive() {
+		return nil
+	}
+
+	if err := s.st.ExchangeOrders(repos.WithTx(tx)).AddRoutedReserve(ctx, repo_exchange_orders.AddRoutedReserveParams{
+		UserID:     order.UserID,
+		ExchangeID: reserve.ExchangeID,
+		Currency:   reserve.Currency,
+		Amount:     released.Neg(),
+	}); err != nil {
+		return fmt.Errorf("release routed reserve: %w", err)
+	}
+
+	return nil
+}
+
+// routedAmounts rounds swept amount to the lot size of the chosen exchange and returns spent amount with
+// base quantity to order, rounding is always down so that no more than the swept funds are spent
+func routedAmounts(side models.OrderSide, swept, price decimal.Decimal, rule *models.OrderRulesDTO) (decimal.Decimal, decimal.Decimal) {
+	if side == models.OrderSideBuy {
+		spent := swept.RoundDown(int32(rule.ValuePrecision))
+		return spent, spent.Div(price).RoundDown(int32(rule.AmountPrecision))
+	}
+
+	qty := swept.RoundDown(int32(rule.AmountPrecision))
+	return qty, qty
+}
+
+// submitRoutedOrder spends exactly the swept amount on the chosen exchange. Market orders of exchange
+// drivers spend the whole balance, so immediate-or-cancel limit order by the priced touch is placed instead.
+// The amount spent on another exchange is reserved on the pair exchange in reserveCurrency.
+func (s *Service) submitRoutedOrder(
+	ctx context.Context,
+	pair *models.UserExchangePair,
+	venue *routeCandidate,
+	swept decimal.Decimal,
+	reserveCurrency string,
+	route []byte,
+) error {
+	spent, qty := routedAmounts(pair.Type, swept, venue.Price, venue.rule)
+	if !qty.IsPositive() {
+		return exchangeclient.ErrInsufficientBalance
+	}
+
+	return repos.BeginTxFunc(ctx, s.st.PSQLConn(), pgx.TxOptions{}, func(tx pgx.Tx) error {
+		record, err := s.st.ExchangeOrders(repos.WithTx(tx)).Create(ctx, repo_exchange_orders.CreateParams{
+			ExchangeID:             venue.exchangeID,
+			UserID:                 pair.UserID,
+			Symbol:                 venue.Symbol,
+			Side:                   pair.Type,
+			Amount:                 spent,
+			OrderCreatedAt:         pgtype.Timestamp{Valid: true, Time: time.Now()},
+			Status:                 models.ExchangeOrderStatusNew,
+			ExchangeConnectionHash: pgtype.Text{Valid: true, String: venue.client.GetConnectionHash()},
+			Route:                  route,
+			OrderType:              models.OrderTypeLimit,
+			Price:                  decimal.NullDecimal{Valid: true, Decimal: venue.Price},
+			TimeInForce:            pgtype.Text{Valid: true, String: models.TimeInForceIOC.String()},
+		})
+		if err != nil {
+			return err
+		}
+
+		updateParams := repo_exchange_orders.UpdateParams{
+			ID:                     record.ID,
+			ExchangeConnectionHash: pgtype.Text{Valid: true, String: venue.client.GetConnectionHash()},
+		}
+
+		order, err := venue.client.CreateLimitOrder(ctx, &models.CreateLimitOrderParams{
+			Symbol:      venue.Symbol,
+			Side:        pair.Type,
+			Amount:      qty,
+			Price:       venue.Price,
+			TimeInForce: models.TimeInForceIOC,
+		})
+		if err != nil {
+			if errors.Is(err, exchangeclient.ErrSkipOrder) || errors.Is(err, exchangeclient.ErrInsufficientBalance) {
+				return err
+			}
+			updateParams.Status = pgtype.Text{Valid: true, String: models.ExchangeOrderStatusFailed.String()}
+			updateParams.FailReason = pgtype.Text{Valid: true, String: err.Error()}
+
+			return s.st.ExchangeOrders(repos.WithTx(tx)).Update(ctx, updateParams)
+		}
+
+		updateParams.Status = pgtype.Text{Valid: true, String: models.ExchangeOrderStatusInProgress.String()}
+		updateParams.Amount = decimal.NullDecimal{Valid: true, Decimal: order.Amount}
+		if order.ClientOrderID != "" {
+			updateParams.ClientOrderID = pgtype.Text{Valid: true, String: order.ClientOrderID}
+		}
+		if order.ExchangeOrderID != "" {
+			updateParams.ExchangeOrderID = pgtype.Text{Valid: true, String: order.ExchangeOrderID}
+		}
+
+		if err = s.st.ExchangeOrders(repos.WithTx(tx)).Update(ctx, updateParams); err != nil {
+			return err
+		}
+
+		if venue.exchangeID == pair.ExchangeID {
+			return nil
+		}
+
+		return s.st.ExchangeOrders(repos.WithTx(tx)).AddRoutedReserve(ctx, repo_exchange_orders.AddRoutedReserveParams{
+			UserID:     pair.UserID,
+			ExchangeID: pair.ExchangeID,
+			Currency:   reserveCurrency,
+			Amount:     spent,
+		})
+	})
+}
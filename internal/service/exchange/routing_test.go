@@ -0,0 +1,119 @@
+package exchange
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+)
+
+func TestNetProceeds(t *testing.T) {
+	tests := []struct {
+		name          string
+		side          models.OrderSide
+		amount        string
+		price         string
+		takerFee      string
+		withdrawalFee string
+		expected      string
+	}{
+		{name: "sell", side: models.OrderSideSell, amount: "2", price: "100", takerFee: "0.1", withdrawalFee: "1", expected: "198.8"},
+		{name: "buy", side: models.OrderSideBuy, amount: "200", price: "100", takerFee: "0.5", withdrawalFee: "0.01", expected: "1.98"},
+		{name: "no price", side: models.OrderSideSell, amount: "2", price: "0", takerFee: "0.1", withdrawalFee: "1", expected: "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := netProceeds(tt.side,
+				decimal.RequireFromString(tt.amount),
+				decimal.RequireFromString(tt.price),
+				decimal.RequireFromString(tt.takerFee),
+				decimal.RequireFromString(tt.withdrawalFee),
+			)
+			require.True(t, decimal.RequireFromString(tt.expected).Equal(got), "got %s", got)
+		})
+	}
+}
+
+func TestSelectRoute(t *testing.T) {
+	current := &routeCandidate{Slug: models.ExchangeSlugHtx, NetProceeds: decimal.NewFromInt(100)}
+	better := &routeCandidate{Slug: models.ExchangeSlugOkx, NetProceeds: decimal.NewFromInt(101)}
+	tie := &routeCandidate{Slug: models.ExchangeSlugBybit, NetProceeds: decimal.NewFromInt(101)}
+	unpriced := &routeCandidate{Slug: models.ExchangeSlugBinance, NetProceeds: decimal.NewFromInt(500), Rejected: "insufficient balance"}
+
+	chosen := selectRoute([]*routeCandidate{current, better, tie, unpriced})
+	require.Same(t, better, chosen)
+	require.Empty(t, better.Rejected)
+	require.Equal(t, routeRejectedWorse, current.Rejected)
+	require.Equal(t, routeRejectedWorse, tie.Rejected)
+	require.Equal(t, "insufficient balance", unpriced.Rejected)
+
+	require.Nil(t, selectRoute([]*routeCandidate{{Rejected: "no price"}}))
+}
+
+func TestRoutedAmounts(t *testing.T) {
+	rule := &models.OrderRulesDTO{AmountPrecision: 4, ValuePrecision: 2}
+
+	tests := []struct {
+		name  string
+		side  models.OrderSide
+		swept string
+		price string
+		spent string
+		qty   string
+	}{
+		{name: "sell rounds to lot size", side: models.OrderSideSell, swept: "1.23456789", price: "100", spent: "1.2345", qty: "1.2345"},
+		{name: "buy spends swept quote", side: models.OrderSideBuy, swept: "100.129", price: "30", spent: "100.12", qty: "3.3373"},
+		{name: "below lot size", side: models.OrderSideSell, swept: "0.00009", price: "100", spent: "0", qty: "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spent, qty := routedAmounts(tt.side, decimal.RequireFromString(tt.swept), decimal.RequireFromString(tt.price), rule)
+			require.Equal(t, tt.spent, spent.String())
+			require.Equal(t, tt.qty, qty.String())
+		})
+	}
+}
+
+func TestRoutedReserveRelease(t *testing.T) {
+	route := func(amount string) []byte {
+		raw, err := json.Marshal(swapRoute{Reserve: &routeReserve{Currency: "BTC", Amount: decimal.RequireFromString(amount)}})
+		require.NoError(t, err)
+		return raw
+	}
+
+	tests := []struct {
+		name     string
+		order    *models.ExchangeOrder
+		state    models.ExchangeOrderStatus
+		filled   string
+		released string
+		reserve  bool
+	}{
+		{name: "not routed", order: &models.ExchangeOrder{}, state: models.ExchangeOrderStatusFailed, filled: "0", released: "0"},
+		{name: "failed", order: &models.ExchangeOrder{Route: route("2")}, state: models.ExchangeOrderStatusFailed, filled: "0", released: "2", reserve: true},
+		{name: "partly filled sell", order: &models.ExchangeOrder{Route: route("2"), Side: models.OrderSideSell}, state: models.ExchangeOrderStatusCompleted, filled: "1.5", released: "0.5", reserve: true},
+		{
+			name:     "partly filled buy",
+			order:    &models.ExchangeOrder{Route: route("100"), Side: models.OrderSideBuy, Price: decimal.NullDecimal{Decimal: decimal.NewFromInt(30), Valid: true}},
+			state:    models.ExchangeOrderStatusCompleted,
+			filled:   "3",
+			released: "10",
+			reserve:  true,
+		},
+		{name: "filled", order: &models.ExchangeOrder{Route: route("2"), Side: models.OrderSideSell}, state: models.ExchangeOrderStatusCompleted, filled: "2", released: "0", reserve: true},
+		{name: "in progress", order: &models.ExchangeOrder{Route: route("2")}, state: models.ExchangeOrderStatusInProgress, filled: "0", released: "0", reserve: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reserve, released := routedReserveRelease(tt.order, tt.state, decimal.RequireFromString(tt.filled))
+			require.Equal(t, tt.reserve, reserve != nil)
+			require.Equal(t, tt.released, released.String())
+		})
+	}
+}
@@ -9,7 +9,9 @@ import (
 	"sync"
 	"time"
 
+	"github.com/dv-net/dv-merchant/internal/config"
 	"github.com/dv-net/dv-merchant/internal/delivery/http/request/exchange_request"
+	"github.com/dv-net/dv-merchant/internal/event"
 	"github.com/dv-net/dv-merchant/internal/models"
 	"github.com/dv-net/dv-merchant/internal/service/exchange_manager"
 	"github.com/dv-net/dv-merchant/internal/service/exchange_rules"
@@ -47,6 +49,7 @@ type IExchangeService interface { //nolint:interfacebloat
 	DeleteExchangeKeys(ctx context.Context, userID uuid.UUID, slug models.ExchangeSlug) error
 	TestConnection(ctx context.Context, user models.User, slug models.ExchangeSlug) error
 	TestConnectionRaw(ctx context.Context, slug models.ExchangeSlug, apiKey, secretKey, passphrase string) error
+	GetKeyPermissions(ctx context.Context, user models.User, slug models.ExchangeSlug) (*KeyPermissionsResult, error)
 	GetExchangeBalance(ctx context.Context, slug models.ExchangeSlug, user models.User) ([]*models.AccountBalanceDTO, error)
 	GetCurrentExchangeBalance(ctx context.Context, user models.User) ([]*models.AccountBalanceDTO, error)
 	SetCurrentExchange(ctx context.Context, userID uuid.UUID, slug models.ExchangeSlug) error
@@ -72,11 +75,14 @@ type TestConnectionResult struct {
 }
 
 type Service struct {
-	st         storage.IStorage
-	exManager  exchange_manager.IExchangeManager
-	log        logger.Logger
-	exRulesSvc exchange_rules.IExchangeRules
-	settingSvc setting.ISettingService
+	st            storage.IStorage
+	exManager     exchange_manager.IExchangeManager
+	log           logger.Logger
+	exRulesSvc    exchange_rules.IExchangeRules
+	settingSvc    setting.ISettingService
+	eventListener event.IListener
+	routing       config.ExchangeRouting
+	orders        config.ExchangeOrders
 }
 
 func (s *Service) DeleteExchangeKeys(ctx context.Context, userID uuid.UUID, slug models.ExchangeSlug) error {
@@ -405,6 +411,8 @@ func (s *Service) prepareExchangeOrdersForExport(ctx context.Context, orders []*
 }
 
 func (s *Service) processExchangeOrders(ctx context.Context) {
+	s.placeDueSlices(ctx)
+
 	orders, err := s.st.ExchangeOrders().GetByStatus(ctx, repo_exchange_orders.GetExchangeOrdersByStatus{
 		Statuses: []models.ExchangeOrderStatus{models.ExchangeOrderStatusInProgress},
 	})
@@ -412,6 +420,13 @@ func (s *Service) processExchangeOrders(ctx context.Context) {
 		s.log.Errorw("failed to fetch orders", "error", err)
 	}
 	for _, order := range orders {
+		if order.OrderType == models.OrderTypeTWAP {
+			if err := s.settleTWAPOrder(ctx, order); err != nil {
+				s.log.Errorw("failed to settle twap order", "error", err, "order_id", order.ID)
+			}
+			continue
+		}
+
 		go func(order *models.ExchangeOrder) {
 			err = repos.BeginTxFunc(ctx, s.st.PSQLConn(), pgx.TxOptions{}, func(tx pgx.Tx) error {
 				ex, err := s.st.Exchanges(repos.WithTx(tx)).GetByID(ctx, order.ExchangeID)
@@ -426,6 +441,8 @@ func (s *Service) processExchangeOrders(ctx context.Context) {
 					return err
 				}
 
+				s.cancelExpiredOrder(ctx, exClient, order)
+
 				exOrder, err := exClient.GetOrderDetails(ctx, &models.GetOrderByIDParams{
 					InstrumentID:    util.Pointer(order.Symbol),
 					ExternalOrderID: util.Pointer(order.ExchangeOrderID.String),
@@ -441,15 +458,25 @@ func (s *Service) processExchangeOrders(ctx context.Context) {
 					s.log.Errorw("received nil order details from exchange", "error", err, "order_id", order.ID, "connection_hash", exClient.GetConnectionHash())
 					return err
 				}
+
+				// cancelled order is done with whatever was filled before cancellation
+				if exOrder.State == models.ExchangeOrderStatusCancelled {
+					exOrder.State = models.ExchangeOrderStatusCompleted
+					if !exOrder.Amount.IsPositive() {
+						exOrder.State = models.ExchangeOrderStatusFailed
+						exOrder.FailReason = failReasonUnfilled
+					}
+				}
+
 				updateParams := repo_exchange_orders.UpdateParams{
 					ID:     order.ID,
 					Status: pgtype.Text{Valid: true, String: exOrder.State.String()},
 				}
+				if exOrder.FailReason != "" {
+					updateParams.FailReason = pgtype.Text{Valid: true, String: exOrder.FailReason}
+				}
 
 				if exOrder.State != models.ExchangeOrderStatusFailed {
-					if exOrder.FailReason != "" {
-						updateParams.FailReason = pgtype.Text{Valid: true, String: exOrder.FailReason}
-					}
 					if !exOrder.Amount.IsZero() {
 						updateParams.Amount = decimal.NullDecimal{Valid: true, Decimal: exOrder.Amount}
 					}
@@ -458,7 +485,32 @@ func (s *Service) processExchangeOrders(ctx context.Context) {
 					}
 				}
 
-				return s.st.ExchangeOrders(repos.WithTx(tx)).Update(ctx, updateParams)
+				if err = s.st.ExchangeOrders(repos.WithTx(tx)).Update(ctx, updateParams); err != nil {
+					return err
+				}
+
+				if err = s.releaseRoutedReserve(ctx, order, exOrder.State, exOrder.Amount, tx); err != nil {
+					return err
+				}
+
+				if exOrder.State != models.ExchangeOrderStatusCompleted {
+					return nil
+				}
+
+				filled, err := s.st.ExchangeOrders(repos.WithTx(tx)).GetByID(ctx, order.ID)
+				if err != nil {
+					return fmt.Errorf("fetch filled order: %w", err)
+				}
+
+				if fireErr := s.eventListener.Fire(OrderFilledEvent{
+					Order: *filled,
+					Slug:  ex.Slug,
+					DBTx:  tx,
+				}); fireErr != nil {
+					s.log.Errorw("fire exchange order filled", "error", fireErr, "order_id", order.ID)
+				}
+
+				return nil
 			})
 
 			if err != nil {
@@ -730,6 +782,22 @@ func (s *Service) batchInsertAddresses(ctx context.Context, tx pgx.Tx, userID uu
 
 func (s *Service) SubmitExchangeOrder(ctx context.Context, userID uuid.UUID, pair *models.UserExchangePair) error {
 	balance := decimal.Zero
+	minimum := decimal.Zero
+
+	// resting limit and twap orders are given time to fill before next swap of the pair
+	if pair.OrderType == models.OrderTypeLimit || pair.OrderType == models.OrderTypeTWAP {
+		active, err := s.st.ExchangeOrders().HasActiveBySymbol(ctx, repo_exchange_orders.HasActiveBySymbolParams{
+			UserID:     pair.UserID,
+			ExchangeID: pair.ExchangeID,
+			Symbol:     pair.Symbol,
+		})
+		if err != nil {
+			return fmt.Errorf("check active exchange orders: %w", err)
+		}
+		if active {
+			return nil
+		}
+	}
 
 	slug, err := s.st.Exchanges().GetByID(ctx, pair.ExchangeID)
 	if err != nil {
@@ -749,13 +817,18 @@ func (s *Service) SubmitExchangeOrder(ctx context.Context, userID uuid.UUID, pai
 		return fmt.Errorf("fetch order rules: %w", err)
 	}
 
+	// funds already spent by routed orders on other exchanges are left out
+	reserved := decimal.Zero
 	switch pair.Type {
 	case models.OrderSideSell:
 		amt, err := exClient.GetCurrencyBalance(ctx, rule.BaseCurrency)
 		if err != nil {
 			return fmt.Errorf("fetch currency balance: %w", err)
 		}
-		balance = balance.Add(*amt)
+		if reserved, err = s.routedReserve(ctx, pair, rule.BaseCurrency, *amt); err != nil {
+			return err
+		}
+		balance = balance.Add(amt.Sub(reserved))
 		minOrderAmount, err := decimal.NewFromString(rule.MinOrderAmount)
 		if err != nil {
 			return fmt.Errorf("parse min order amount: %w", err)
@@ -763,12 +836,16 @@ func (s *Service) SubmitExchangeOrder(ctx context.Context, userID uuid.UUID, pai
 		if balance.LessThanOrEqual(minOrderAmount) {
 			return exchangeclient.ErrInsufficientBalance
 		}
+		minimum = minOrderAmount
 	case models.OrderSideBuy:
 		amt, err := exClient.GetCurrencyBalance(ctx, rule.QuoteCurrency)
 		if err != nil {
 			return fmt.Errorf("fetch currency balance: %w", err)
 		}
-		balance = balance.Add(*amt)
+		if reserved, err = s.routedReserve(ctx, pair, rule.QuoteCurrency, *amt); err != nil {
+			return err
+		}
+		balance = balance.Add(amt.Sub(reserved))
 		minOrderValue, err := decimal.NewFromString(rule.MinOrderValue)
 		if err != nil {
 			return fmt.Errorf("parse min order value: %w", err)
@@ -776,25 +853,71 @@ func (s *Service) SubmitExchangeOrder(ctx context.Context, userID uuid.UUID, pai
 		if balance.LessThanOrEqual(minOrderValue) {
 			return exchangeclient.ErrInsufficientBalance
 		}
+		minimum = minOrderValue
 	default:
 		return fmt.Errorf("unknown order type: %s", pair.Type)
 	}
 
+	venue := &routeCandidate{
+		Slug:       slug.Slug,
+		Symbol:     pair.Symbol,
+		Balance:    balance,
+		exchangeID: pair.ExchangeID,
+		client:     exClient,
+		rule:       rule,
+	}
+
+	// routing compares taker proceeds, resting orders stay on the pair exchange
+	switch pair.OrderType {
+	case models.OrderTypeLimit:
+		return s.submitLimitOrder(ctx, pair, venue)
+	case models.OrderTypeTWAP:
+		return s.submitTWAPOrder(ctx, pair, venue, minimum)
+	}
+
+	routingMode, err := s.swapRoutingMode(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	var route []byte
+	if routingMode == setting.SwapRoutingBest {
+		if venue, route, err = s.routeSwap(ctx, userID, pair.Type, venue); err != nil {
+			return fmt.Errorf("route swap: %w", err)
+		}
+		if venue.Slug != slug.Slug {
+			s.log.Infow("swap routed to another exchange", "userID", userID, "symbol", pair.Symbol, "from", slug.Slug, "to", venue.Slug)
+			return s.submitRoutedOrder(ctx, pair, venue, balance, spentCurrency(pair.Type, rule), route)
+		}
+	}
+
+	// market order would spend reserved funds as well, so only the balance left is ordered
+	if reserved.IsPositive() {
+		if !venue.Price.IsPositive() {
+			venue.Rejected = ""
+			s.priceCandidate(ctx, userID, pair.Type, balance, venue)
+			if venue.Rejected != "" {
+				return fmt.Errorf("price swap: %s", venue.Rejected)
+			}
+		}
+		return s.submitRoutedOrder(ctx, pair, venue, balance, "", route)
+	}
+
 	err = repos.BeginTxFunc(ctx, s.st.PSQLConn(), pgx.TxOptions{}, func(tx pgx.Tx) error {
-		orderRecord, err := s.createExchangeOrder(ctx, pair.ExchangeID, pair.UserID, pair.Symbol, pair.Type, balance, exClient.GetConnectionHash(), repos.WithTx(tx))
+		orderRecord, err := s.createExchangeOrder(ctx, venue.exchangeID, pair.UserID, venue.Symbol, pair.Type, venue.Balance, venue.client.GetConnectionHash(), route, repos.WithTx(tx))
 		if err != nil {
 			return err
 		}
 
-		order, err := exClient.CreateSpotOrder(ctx, "", "", pair.Type.String(), pair.Symbol, nil, rule)
+		order, err := venue.client.CreateSpotOrder(ctx, "", "", pair.Type.String(), venue.Symbol, nil, venue.rule)
 		updateParams := repo_exchange_orders.UpdateParams{
 			ID:                     orderRecord.ID,
-			ExchangeConnectionHash: pgtype.Text{Valid: true, String: exClient.GetConnectionHash()},
+			ExchangeConnectionHash: pgtype.Text{Valid: true, String: venue.client.GetConnectionHash()},
 		}
 
 		if err != nil {
 			if errors.Is(err, exchangeclient.ErrSkipOrder) {
-				s.log.Debugw("skipping order due to custom error being thrown", "userID", userID, "symbol", pair.Symbol)
+				s.log.Debugw("skipping order due to custom error being thrown", "userID", userID, "symbol", venue.Symbol)
 				return exchangeclient.ErrSkipOrder
 			}
 			if errors.Is(err, exchangeclient.ErrInsufficientBalance) {
@@ -822,7 +945,7 @@ func (s *Service) SubmitExchangeOrder(ctx context.Context, userID uuid.UUID, pai
 	return nil
 }
 
-func (s *Service) createExchangeOrder(ctx context.Context, exchangeID uuid.UUID, userID uuid.UUID, symbol string, side models.OrderSide, amount decimal.Decimal, connHash string, opts ...repos.Option) (*models.ExchangeOrder, error) {
+func (s *Service) createExchangeOrder(ctx context.Context, exchangeID uuid.UUID, userID uuid.UUID, symbol string, side models.OrderSide, amount decimal.Decimal, connHash string, route []byte, opts ...repos.Option) (*models.ExchangeOrder, error) {
 	order, err := s.st.ExchangeOrders(opts...).Create(ctx, repo_exchange_orders.CreateParams{
 		ExchangeID: exchangeID,
 		UserID:     userID,
@@ -834,6 +957,7 @@ func (s *Service) createExchangeOrder(ctx context.Context, exchangeID uuid.UUID,
 			Time:  time.Now(),
 		},
 		ExchangeConnectionHash: pgtype.Text{Valid: true, String: connHash},
+		Route:                  route,
 	})
 	if err != nil {
 		return nil, err
@@ -948,13 +1072,19 @@ func NewService(
 	exchangeManager exchange_manager.IExchangeManager,
 	exRulesSvc exchange_rules.IExchangeRules,
 	settingSvc setting.ISettingService,
+	eventListener event.IListener,
+	routing config.ExchangeRouting,
+	orders config.ExchangeOrders,
 ) IExchangeService {
 	return &Service{
-		st:         st,
-		exManager:  exchangeManager,
-		log:        log,
-		exRulesSvc: exRulesSvc,
-		settingSvc: settingSvc,
+		st:            st,
+		exManager:     exchangeManager,
+		log:           log,
+		exRulesSvc:    exRulesSvc,
+		settingSvc:    settingSvc,
+		eventListener: eventListener,
+		routing:       routing,
+		orders:        orders,
 	}
 }
 
@@ -1197,13 +1327,26 @@ func (s *Service) GetDepositExchangeAddresses(ctx context.Context, userID uuid.U
 func (s *Service) updateUserExchangePairsTx(ctx context.Context, userID uuid.UUID, exchangeID uuid.UUID, pairs []exchange_request.ExchangePair, tx pgx.Tx) error {
 	params := make([]repo_user_exchange_pairs.UpdatePairsParams, 0, len(pairs))
 	for _, pair := range pairs {
+		orderType := models.OrderType(pair.OrderType)
+		if orderType == "" {
+			orderType = models.OrderTypeMarket
+		}
+		timeInForce := models.TimeInForce(pair.TimeInForce)
+		if timeInForce == "" {
+			timeInForce = models.TimeInForceGTC
+		}
 		params = append(params, repo_user_exchange_pairs.UpdatePairsParams{
-			UserID:       userID,
-			ExchangeID:   exchangeID,
-			CurrencyFrom: pair.BaseSymbol,
-			CurrencyTo:   pair.QuoteSymbol,
-			Symbol:       pair.Symbol,
-			Type:         models.OrderSide(pair.Type),
+			UserID:            userID,
+			ExchangeID:        exchangeID,
+			CurrencyFrom:      pair.BaseSymbol,
+			CurrencyTo:        pair.QuoteSymbol,
+			Symbol:            pair.Symbol,
+			Type:              models.OrderSide(pair.Type),
+			OrderType:         orderType,
+			PriceOffset:       pair.PriceOffset,
+			TimeInForce:       timeInForce,
+			TwapSlices:        pair.TwapSlices,
+			TwapWindowSeconds: pair.TwapWindowSeconds,
 		})
 	}
 
@@ -15,8 +15,10 @@ import (
 	"github.com/dv-net/dv-merchant/internal/service/exchange/binance"
 	"github.com/dv-net/dv-merchant/internal/service/exchange/bitget"
 	"github.com/dv-net/dv-merchant/internal/service/exchange/bybit"
+	"github.com/dv-net/dv-merchant/internal/service/exchange/coinbase"
 	"github.com/dv-net/dv-merchant/internal/service/exchange/gateio"
 	"github.com/dv-net/dv-merchant/internal/service/exchange/htx"
+	"github.com/dv-net/dv-merchant/internal/service/exchange/kraken"
 	"github.com/dv-net/dv-merchant/internal/service/exchange/kucoin"
 	"github.com/dv-net/dv-merchant/internal/service/exchange/mexc"
 	"github.com/dv-net/dv-merchant/internal/service/exchange/okx"
@@ -27,14 +29,18 @@ import (
 
 type IExchangeClient interface { //nolint:interfacebloat
 	TestConnection(ctx context.Context) error
+	GetKeyPermissions(ctx context.Context) (*models.KeyPermissionsDTO, error)
 	GetAccountBalance(ctx context.Context) ([]*models.AccountBalanceDTO, error)
 	GetCurrencyBalance(ctx context.Context, currency string) (*decimal.Decimal, error)
 	GetExchangeSymbols(ctx context.Context) ([]*models.ExchangeSymbolDTO, error)
 	GetDepositAddresses(ctx context.Context, currency, network string) ([]*models.DepositAddressDTO, error)
 	CreateWithdrawalOrder(ctx context.Context, args *models.CreateWithdrawalOrderParams) (*models.ExchangeWithdrawalDTO, error)
 	CreateSpotOrder(ctx context.Context, from string, to string, side string, ticker string, amount *decimal.Decimal, rule *models.OrderRulesDTO) (*models.ExchangeOrderDTO, error)
+	CreateLimitOrder(ctx context.Context, args *models.CreateLimitOrderParams) (*models.ExchangeOrderDTO, error)
+	CancelOrder(ctx context.Context, args *models.CancelOrderParams) error
 	GetOrderRule(ctx context.Context, ticker string) (*models.OrderRulesDTO, error)
 	GetOrderRules(ctx context.Context, tickers ...string) ([]*models.OrderRulesDTO, error)
+	GetTickerPrice(ctx context.Context, ticker string) (*models.TickerPriceDTO, error)
 	GetOrderDetails(ctx context.Context, args *models.GetOrderByIDParams) (*models.OrderDetailsDTO, error)
 	GetWithdrawalRules(ctx context.Context, ccys ...string) ([]*models.WithdrawalRulesDTO, error)
 	GetWithdrawalByID(ctx context.Context, args *models.GetWithdrawalByIDParams) (*models.WithdrawalStatusDTO, error)
@@ -85,6 +91,10 @@ func (o *Manager) CreateDriver(ctx context.Context, slug models.ExchangeSlug, ap
 		return o.createGateioServiceRaw(ctx, apiKey, secretKey)
 	case models.ExchangeSlugMexc:
 		return o.createMexcServiceRaw(ctx, apiKey, secretKey)
+	case models.ExchangeSlugKraken:
+		return o.createKrakenServiceRaw(ctx, apiKey, secretKey)
+	case models.ExchangeSlugCoinbase:
+		return o.createCoinbaseServiceRaw(ctx, apiKey, secretKey)
 	}
 	return nil, fmt.Errorf("slug %s does not exists", slug.String())
 }
@@ -107,6 +117,10 @@ func (o *Manager) GetPublicDriver(ctx context.Context, slug models.ExchangeSlug)
 		return o.createPublicGateioService(ctx)
 	case models.ExchangeSlugMexc:
 		return nil, fmt.Errorf("mexc public client is not supported")
+	case models.ExchangeSlugKraken:
+		return o.createPublicKrakenService(ctx)
+	case models.ExchangeSlugCoinbase:
+		return o.createPublicCoinbaseService(ctx)
 	default:
 		return nil, fmt.Errorf("slug %s does not exists", slug.String())
 	}
@@ -139,6 +153,10 @@ func (o *Manager) GetDefaultDriver(ctx context.Context, userID uuid.UUID) (IExch
 		return o.createBybitService(ctx, userID)
 	case models.ExchangeSlugMexc:
 		return o.createMexcService(ctx, userID)
+	case models.ExchangeSlugKraken:
+		return o.createKrakenService(ctx, userID)
+	case models.ExchangeSlugCoinbase:
+		return o.createCoinbaseService(ctx, userID)
 	default:
 		return nil, fmt.Errorf("user is missing current driver")
 	}
@@ -165,6 +183,10 @@ func (o *Manager) GetDriver(ctx context.Context, slug models.ExchangeSlug, userI
 		return o.createBybitService(ctx, userID)
 	case models.ExchangeSlugMexc:
 		return o.createMexcService(ctx, userID)
+	case models.ExchangeSlugKraken:
+		return o.createKrakenService(ctx, userID)
+	case models.ExchangeSlugCoinbase:
+		return o.createCoinbaseService(ctx, userID)
 	default:
 		return nil, fmt.Errorf("slug %s does not exists", slug.String())
 	}
@@ -597,6 +619,104 @@ func (o *Manager) createMexcService(ctx context.Context, userID uuid.UUID) (IExc
 	)
 }
 
+func (o *Manager) createKrakenServiceRaw(ctx context.Context, apiKey, secretKey string) (IExchangeClient, error) {
+	baseURL, err := o.exchangeBaseURL(ctx, models.ExchangeSlugKraken)
+	if err != nil {
+		return nil, err
+	}
+
+	return kraken.NewService(o.l, apiKey, secretKey, baseURL, o.storage, o.store, o.currConvService)
+}
+
+func (o *Manager) createKrakenService(ctx context.Context, userID uuid.UUID) (IExchangeClient, error) {
+	keys, err := o.storage.ExchangeUserKeys().GetKeysByExchangeSlug(ctx, repo_exchange_user_keys.GetKeysByExchangeSlugParams{
+		UserID:       userID,
+		ExchangeSlug: models.ExchangeSlugKraken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	keyMap := make(map[string]string)
+	for _, key := range keys {
+		keyMap[string(key.Name)] = key.Value
+	}
+
+	baseURL, err := o.exchangeBaseURL(ctx, models.ExchangeSlugKraken)
+	if err != nil {
+		return nil, err
+	}
+
+	return kraken.NewService(
+		o.l,
+		keyMap[models.ExchangeKeyNameAPIKey.String()],
+		keyMap[models.ExchangeKeyNameSecretKey.String()],
+		baseURL,
+		o.storage,
+		o.store,
+		o.currConvService,
+	)
+}
+
+// createPublicKrakenService serves market data endpoints only, they are not signed
+func (o *Manager) createPublicKrakenService(ctx context.Context) (IExchangeClient, error) {
+	baseURL, err := o.exchangeBaseURL(ctx, models.ExchangeSlugKraken)
+	if err != nil {
+		return nil, err
+	}
+
+	return kraken.NewService(o.l, "-", "-", baseURL, o.storage, o.store, o.currConvService)
+}
+
+func (o *Manager) createCoinbaseServiceRaw(ctx context.Context, apiKey, secretKey string) (IExchangeClient, error) {
+	baseURL, err := o.exchangeBaseURL(ctx, models.ExchangeSlugCoinbase)
+	if err != nil {
+		return nil, err
+	}
+
+	return coinbase.NewService(o.l, apiKey, secretKey, baseURL, o.storage, o.store, o.currConvService)
+}
+
+func (o *Manager) createCoinbaseService(ctx context.Context, userID uuid.UUID) (IExchangeClient, error) {
+	keys, err := o.storage.ExchangeUserKeys().GetKeysByExchangeSlug(ctx, repo_exchange_user_keys.GetKeysByExchangeSlugParams{
+		UserID:       userID,
+		ExchangeSlug: models.ExchangeSlugCoinbase,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	keyMap := make(map[string]string)
+	for _, key := range keys {
+		keyMap[string(key.Name)] = key.Value
+	}
+
+	baseURL, err := o.exchangeBaseURL(ctx, models.ExchangeSlugCoinbase)
+	if err != nil {
+		return nil, err
+	}
+
+	return coinbase.NewService(
+		o.l,
+		keyMap[models.ExchangeKeyNameAPIKey.String()],
+		keyMap[models.ExchangeKeyNameSecretKey.String()],
+		baseURL,
+		o.storage,
+		o.store,
+		o.currConvService,
+	)
+}
+
+// createPublicCoinbaseService serves market data endpoints only, they are not signed
+func (o *Manager) createPublicCoinbaseService(ctx context.Context) (IExchangeClient, error) {
+	baseURL, err := o.exchangeBaseURL(ctx, models.ExchangeSlugCoinbase)
+	if err != nil {
+		return nil, err
+	}
+
+	return coinbase.NewService(o.l, "-", "-", baseURL, o.storage, o.store, o.currConvService)
+}
+
 func (o *Manager) exchangeBaseURL(ctx context.Context, slug models.ExchangeSlug) (*url.URL, error) {
 	ex, err := o.storage.Exchanges().GetExchangeBySlug(ctx, slug)
 	if err != nil {
@@ -103,6 +103,36 @@ func (o *Service) handleBinanceOrder(ctx context.Context, ticker string) (*model
 	return rule, nil
 }
 
+func (o *Service) handleKrakenOrder(ctx context.Context, ticker string) (*models.OrderRulesDTO, error) {
+	rule := &models.OrderRulesDTO{}
+	data, err := o.storage.KeyValue().Get(ctx, formatKey(models.ExchangeSlugKraken, SpotOrderRuleType, ticker, "default"))
+	if err != nil {
+		if errors.Is(err, redis.Nil) || errors.Is(err, key_value.ErrEntryNotFound) {
+			return o.fetchAndCacheKrakenOrderRules(ctx, ticker)
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(data.Bytes(), rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (o *Service) handleCoinbaseOrder(ctx context.Context, ticker string) (*models.OrderRulesDTO, error) {
+	rule := &models.OrderRulesDTO{}
+	data, err := o.storage.KeyValue().Get(ctx, formatKey(models.ExchangeSlugCoinbase, SpotOrderRuleType, ticker, "default"))
+	if err != nil {
+		if errors.Is(err, redis.Nil) || errors.Is(err, key_value.ErrEntryNotFound) {
+			return o.fetchAndCacheCoinbaseOrderRules(ctx, ticker)
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(data.Bytes(), rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
 /*
 
 	End handlers
@@ -133,6 +163,10 @@ func (o *Service) fetchSpotOrderRule(ctx context.Context, exchange models.Exchan
 		return o.handleBinanceOrder(ctx, ticker)
 	case models.ExchangeSlugKucoin:
 		return o.handleKucoinOrder(ctx, ticker)
+	case models.ExchangeSlugKraken:
+		return o.handleKrakenOrder(ctx, ticker)
+	case models.ExchangeSlugCoinbase:
+		return o.handleCoinbaseOrder(ctx, ticker)
 	default:
 		return nil, fmt.Errorf("exchange %s not supported", exchange.String())
 	}
@@ -237,3 +271,43 @@ func (o *Service) fetchDefaultHtxOrderRules(ctx context.Context, tickers ...stri
 	}
 	return publicExClient.GetOrderRules(ctx, tickers...)
 }
+
+func (o *Service) fetchAndCacheKrakenOrderRules(ctx context.Context, ticker string) (*models.OrderRulesDTO, error) {
+	rules, err := o.fetchDefaultKrakenOrderRules(ctx, ticker)
+	if err != nil {
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return &models.OrderRulesDTO{}, nil
+	}
+	err = o.storage.KeyValue().Set(ctx, formatKey(models.ExchangeSlugKraken, SpotOrderRuleType, ticker, "default"), structs.Map(rules[0]), 30*time.Minute)
+	return rules[0], err
+}
+
+func (o *Service) fetchDefaultKrakenOrderRules(ctx context.Context, tickers ...string) ([]*models.OrderRulesDTO, error) {
+	publicExClient, err := o.manager.GetPublicDriver(ctx, models.ExchangeSlugKraken)
+	if err != nil {
+		return nil, err
+	}
+	return publicExClient.GetOrderRules(ctx, tickers...)
+}
+
+func (o *Service) fetchAndCacheCoinbaseOrderRules(ctx context.Context, ticker string) (*models.OrderRulesDTO, error) {
+	rules, err := o.fetchDefaultCoinbaseOrderRules(ctx, ticker)
+	if err != nil {
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return &models.OrderRulesDTO{}, nil
+	}
+	err = o.storage.KeyValue().Set(ctx, formatKey(models.ExchangeSlugCoinbase, SpotOrderRuleType, ticker, "default"), structs.Map(rules[0]), 30*time.Minute)
+	return rules[0], err
+}
+
+func (o *Service) fetchDefaultCoinbaseOrderRules(ctx context.Context, tickers ...string) ([]*models.OrderRulesDTO, error) {
+	publicExClient, err := o.manager.GetPublicDriver(ctx, models.ExchangeSlugCoinbase)
+	if err != nil {
+		return nil, err
+	}
+	return publicExClient.GetOrderRules(ctx, tickers...)
+}
@@ -47,6 +47,10 @@ func (o *Service) fetchWithdrawalRule(ctx context.Context, exchange models.Excha
 		return o.handleBybitWithdrawal(ctx, userID, currency)
 	case models.ExchangeSlugMexc:
 		return o.handleMexcWithdrawal(ctx, userID, currency)
+	case models.ExchangeSlugKraken:
+		return o.handleKrakenWithdrawal(ctx, userID, currency)
+	case models.ExchangeSlugCoinbase:
+		return o.handleCoinbaseWithdrawal(ctx, userID, currency)
 	default:
 		return nil, fmt.Errorf("exchange %s not supported", exchange.String())
 	}
@@ -136,6 +140,30 @@ func (o *Service) handleMexcWithdrawal(ctx context.Context, userID, currency str
 	return rule, json.Unmarshal(data, rule)
 }
 
+func (o *Service) handleKrakenWithdrawal(ctx context.Context, userID, currency string) (*models.WithdrawalRulesDTO, error) {
+	rule := &models.WithdrawalRulesDTO{}
+	data, err := o.storage.KeyValue().Get(ctx, formatKey(models.ExchangeSlugKraken, WithdrawalRuleType, currency, userID))
+	if err != nil {
+		if errors.Is(err, redis.Nil) || errors.Is(err, key_value.ErrEntryNotFound) {
+			return o.fetchAndCacheKrakenWithdrawalRules(ctx, userID, currency)
+		}
+		return nil, err
+	}
+	return rule, json.Unmarshal(data, rule)
+}
+
+func (o *Service) handleCoinbaseWithdrawal(ctx context.Context, userID, currency string) (*models.WithdrawalRulesDTO, error) {
+	rule := &models.WithdrawalRulesDTO{}
+	data, err := o.storage.KeyValue().Get(ctx, formatKey(models.ExchangeSlugCoinbase, WithdrawalRuleType, currency, userID))
+	if err != nil {
+		if errors.Is(err, redis.Nil) || errors.Is(err, key_value.ErrEntryNotFound) {
+			return o.fetchAndCacheCoinbaseWithdrawalRules(ctx, userID, currency)
+		}
+		return nil, err
+	}
+	return rule, json.Unmarshal(data, rule)
+}
+
 func (o *Service) handleBybitWithdrawal(ctx context.Context, userID, currency string) (*models.WithdrawalRulesDTO, error) {
 	rule := &models.WithdrawalRulesDTO{}
 	data, err := o.storage.KeyValue().Get(ctx, formatKey(models.ExchangeSlugBybit, WithdrawalRuleType, currency, userID))
@@ -186,6 +214,38 @@ func (o *Service) fetchAndCacheMexcWithdrawalRules(ctx context.Context, userID,
 	return rules[0], err
 }
 
+func (o *Service) fetchAndCacheKrakenWithdrawalRules(ctx context.Context, userID, currency string) (*models.WithdrawalRulesDTO, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("parse user id: %w", err)
+	}
+	rules, err := o.fetchKrakenWithdrawalRules(ctx, userUUID, currency)
+	if err != nil {
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return &models.WithdrawalRulesDTO{}, nil
+	}
+	err = o.storage.KeyValue().Set(ctx, formatKey(models.ExchangeSlugKraken, WithdrawalRuleType, currency, userID), structs.Map(rules[0]), 30*time.Minute)
+	return rules[0], err
+}
+
+func (o *Service) fetchAndCacheCoinbaseWithdrawalRules(ctx context.Context, userID, currency string) (*models.WithdrawalRulesDTO, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, fmt.Errorf("parse user id: %w", err)
+	}
+	rules, err := o.fetchCoinbaseWithdrawalRules(ctx, userUUID, currency)
+	if err != nil {
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return &models.WithdrawalRulesDTO{}, nil
+	}
+	err = o.storage.KeyValue().Set(ctx, formatKey(models.ExchangeSlugCoinbase, WithdrawalRuleType, currency, userID), structs.Map(rules[0]), 30*time.Minute)
+	return rules[0], err
+}
+
 func (o *Service) fetchAndCacheKucoinWithdrawalRules(ctx context.Context, userID string, currency string) (*models.WithdrawalRulesDTO, error) {
 	userUUID, err := uuid.Parse(userID)
 	if err != nil {
@@ -344,6 +404,30 @@ func (o *Service) fetchMexcWithdrawalRules(ctx context.Context, userID uuid.UUID
 	return rules, nil
 }
 
+func (o *Service) fetchKrakenWithdrawalRules(ctx context.Context, userID uuid.UUID, currencies ...string) ([]*models.WithdrawalRulesDTO, error) {
+	krakenClient, err := o.manager.GetDriver(ctx, models.ExchangeSlugKraken, userID)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := krakenClient.GetWithdrawalRules(ctx, currencies...)
+	if err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+func (o *Service) fetchCoinbaseWithdrawalRules(ctx context.Context, userID uuid.UUID, currencies ...string) ([]*models.WithdrawalRulesDTO, error) {
+	coinbaseClient, err := o.manager.GetDriver(ctx, models.ExchangeSlugCoinbase, userID)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := coinbaseClient.GetWithdrawalRules(ctx, currencies...)
+	if err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
 func (o *Service) fetchKucoinWithdrawalRules(ctx context.Context, userID uuid.UUID, currencies ...string) ([]*models.WithdrawalRulesDTO, error) {
 	kucoinClient, err := o.manager.GetDriver(ctx, models.ExchangeSlugKucoin, userID)
 	if err != nil {
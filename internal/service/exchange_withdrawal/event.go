@@ -0,0 +1,27 @@
+package exchange_withdrawal
+
+import (
+	"fmt"
+
+	"github.com/dv-net/dv-merchant/internal/event"
+	"github.com/dv-net/dv-merchant/internal/models"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const StatusChangedEventType = "exchange_withdrawal_status_changed"
+
+// StatusChangedEvent is fired on every exchange withdrawal status change within the database transaction that caused it
+type StatusChangedEvent struct {
+	Withdrawal     models.ExchangeWithdrawalHistory
+	PreviousStatus models.WithdrawalHistoryStatus
+	DBTx           pgx.Tx
+}
+
+func (e StatusChangedEvent) Type() event.Type {
+	return StatusChangedEventType
+}
+
+func (e StatusChangedEvent) String() string {
+	return fmt.Sprintf("ExchangeWithdrawalStatusChanged: withdrawal=%s, from=%s, to=%s", e.Withdrawal.ID, e.PreviousStatus, e.Withdrawal.Status)
+}
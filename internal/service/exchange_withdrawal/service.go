@@ -13,6 +13,7 @@ import (
 
 	"github.com/dv-net/dv-merchant/internal/delivery/http/request/exchange_request"
 	"github.com/dv-net/dv-merchant/internal/dto"
+	"github.com/dv-net/dv-merchant/internal/event"
 	"github.com/dv-net/dv-merchant/internal/models"
 	"github.com/dv-net/dv-merchant/internal/service/currconv"
 	"github.com/dv-net/dv-merchant/internal/service/exchange_manager"
@@ -30,8 +31,10 @@ import (
 	binancemodels "github.com/dv-net/dv-merchant/pkg/exchange_client/binance/models"
 	bitgetmodels "github.com/dv-net/dv-merchant/pkg/exchange_client/bitget/models"
 	bybitmodels "github.com/dv-net/dv-merchant/pkg/exchange_client/bybit/models"
+	coinbaseresponses "github.com/dv-net/dv-merchant/pkg/exchange_client/coinbase/responses"
 	gateio "github.com/dv-net/dv-merchant/pkg/exchange_client/gate"
 	htxmodels "github.com/dv-net/dv-merchant/pkg/exchange_client/htx/models"
+	krakenresponses "github.com/dv-net/dv-merchant/pkg/exchange_client/kraken/responses"
 	kucoinmodels "github.com/dv-net/dv-merchant/pkg/exchange_client/kucoin/models"
 	mexcresponses "github.com/dv-net/dv-merchant/pkg/exchange_client/mexc/responses"
 	okxmodels "github.com/dv-net/dv-merchant/pkg/exchange_client/okx/models"
@@ -61,12 +64,13 @@ type IExchangeWithdrawalService interface {
 }
 
 type Service struct {
-	logger      logger.Logger
-	st          storage.IStorage
-	exManager   exchange_manager.IExchangeManager
-	currConvSvc currconv.ICurrencyConvertor
-	exRulesSvc  exchange_rules.IExchangeRules
-	settingSvc  setting.ISettingService
+	logger        logger.Logger
+	st            storage.IStorage
+	exManager     exchange_manager.IExchangeManager
+	currConvSvc   currconv.ICurrencyConvertor
+	exRulesSvc    exchange_rules.IExchangeRules
+	settingSvc    setting.ISettingService
+	eventListener event.IListener
 }
 
 func (s *Service) DeleteWithdrawalSetting(ctx context.Context, userID uuid.UUID, slug models.ExchangeSlug, settingID uuid.UUID) error {
@@ -319,14 +323,16 @@ func NewService(
 	currConvSvc currconv.ICurrencyConvertor,
 	exRulesSvc exchange_rules.IExchangeRules,
 	settingSvc setting.ISettingService,
+	eventListener event.IListener,
 ) IExchangeWithdrawalService {
 	return &Service{
-		logger:      logger,
-		st:          st,
-		exManager:   exManager,
-		currConvSvc: currConvSvc,
-		exRulesSvc:  exRulesSvc,
-		settingSvc:  settingSvc,
+		logger:        logger,
+		st:            st,
+		exManager:     exManager,
+		currConvSvc:   currConvSvc,
+		exRulesSvc:    exRulesSvc,
+		settingSvc:    settingSvc,
+		eventListener: eventListener,
 	}
 }
 
@@ -768,6 +774,10 @@ func (s *Service) handleWithdrawal(ctx context.Context, slug models.ExchangeSlug
 		return s.handleBybitWithdrawal(ctx, transferRecord, order, tx)
 	case models.ExchangeSlugMexc:
 		return s.handleMexcWithdrawal(ctx, transferRecord, order, tx)
+	case models.ExchangeSlugKraken:
+		return s.handleKrakenWithdrawal(ctx, transferRecord, order, tx)
+	case models.ExchangeSlugCoinbase:
+		return s.handleCoinbaseWithdrawal(ctx, transferRecord, order, tx)
 	default:
 		return fmt.Errorf("exchange %s not supported", slug.String())
 	}
@@ -803,6 +813,55 @@ func (s *Service) handleMexcWithdrawal(ctx context.Context, record *models.Withd
 	return s.updateExchangeWithdrawal(ctx, order.UserID, updateParams, tx)
 }
 
+func (s *Service) handleKrakenWithdrawal(ctx context.Context, record *models.WithdrawalStatusDTO, order *models.ExchangeWithdrawalHistory, tx pgx.Tx) error {
+	updateParams := repo_exchange_withdrawal_history.UpdateParams{
+		ID: order.ID,
+	}
+
+	switch record.Status {
+	case krakenresponses.WithdrawalStatusInitial, krakenresponses.WithdrawalStatusPending, krakenresponses.WithdrawalStatusSettled,
+		krakenresponses.WithdrawalStatusCancelPending:
+		updateParams.Status = pgtype.Text{Valid: true, String: models.WithdrawalHistoryStatusInProgress.String()}
+	case krakenresponses.WithdrawalStatusSuccess:
+		updateParams.Status = pgtype.Text{Valid: true, String: models.WithdrawalHistoryStatusCompleted.String()}
+		updateParams.Txid = pgtype.Text{Valid: true, String: record.TxHash}
+		updateParams.NativeAmount = decimal.NullDecimal{Valid: true, Decimal: record.NativeAmount}
+	case krakenresponses.WithdrawalStatusFailure, krakenresponses.WithdrawalStatusCanceled, krakenresponses.WithdrawalStatusReturn:
+		updateParams.Status = pgtype.Text{Valid: true, String: models.WithdrawalHistoryStatusFailed.String()}
+	case krakenresponses.WithdrawalStatusOnHold:
+		updateParams.Status = pgtype.Text{Valid: true, String: models.WithdrawalHistoryStatusRecovery.String()}
+		updateParams.FailReason = pgtype.Text{Valid: true, String: "withdrawal is on hold by exchange"}
+	default:
+		updateParams.Status = pgtype.Text{Valid: true, String: models.WithdrawalHistoryStatusFailed.String()}
+	}
+
+	return s.updateExchangeWithdrawal(ctx, order.UserID, updateParams, tx)
+}
+
+func (s *Service) handleCoinbaseWithdrawal(ctx context.Context, record *models.WithdrawalStatusDTO, order *models.ExchangeWithdrawalHistory, tx pgx.Tx) error {
+	updateParams := repo_exchange_withdrawal_history.UpdateParams{
+		ID: order.ID,
+	}
+
+	switch record.Status {
+	case coinbaseresponses.TransactionStatusPending, coinbaseresponses.TransactionStatusWaitingForClearing:
+		updateParams.Status = pgtype.Text{Valid: true, String: models.WithdrawalHistoryStatusInProgress.String()}
+	case coinbaseresponses.TransactionStatusCompleted:
+		updateParams.Status = pgtype.Text{Valid: true, String: models.WithdrawalHistoryStatusCompleted.String()}
+		updateParams.Txid = pgtype.Text{Valid: true, String: record.TxHash}
+		updateParams.NativeAmount = decimal.NullDecimal{Valid: true, Decimal: record.NativeAmount}
+	case coinbaseresponses.TransactionStatusFailed, coinbaseresponses.TransactionStatusExpired, coinbaseresponses.TransactionStatusCanceled:
+		updateParams.Status = pgtype.Text{Valid: true, String: models.WithdrawalHistoryStatusFailed.String()}
+	case coinbaseresponses.TransactionStatusWaitingForSignature:
+		updateParams.Status = pgtype.Text{Valid: true, String: models.WithdrawalHistoryStatusRecovery.String()}
+		updateParams.FailReason = pgtype.Text{Valid: true, String: "manual verification required"}
+	default:
+		updateParams.Status = pgtype.Text{Valid: true, String: models.WithdrawalHistoryStatusFailed.String()}
+	}
+
+	return s.updateExchangeWithdrawal(ctx, order.UserID, updateParams, tx)
+}
+
 func (s *Service) handleGateioWithdrawal(ctx context.Context, record *models.WithdrawalStatusDTO, order *models.ExchangeWithdrawalHistory, tx pgx.Tx) error {
 	updateParams := repo_exchange_withdrawal_history.UpdateParams{
 		ID: order.ID,
@@ -967,13 +1026,37 @@ func (s *Service) updateExchangeWithdrawal(
 		return fmt.Errorf("get user by id: %w", err)
 	}
 
-	if fields.Status.Valid {
-		if err := s.handleOrderStatusChanged(ctx, user, fields); err != nil {
-			return fmt.Errorf("handle order status changed: %w", err)
-		}
+	if !fields.Status.Valid {
+		return s.st.ExchangeWithdrawalHistory(repos.WithTx(tx)).Update(ctx, fields)
+	}
+
+	if err := s.handleOrderStatusChanged(ctx, user, fields); err != nil {
+		return fmt.Errorf("handle order status changed: %w", err)
 	}
 
-	return s.st.ExchangeWithdrawalHistory(repos.WithTx(tx)).Update(ctx, fields)
+	current, err := s.st.ExchangeWithdrawalHistory(repos.WithTx(tx)).GetByID(ctx, fields.ID)
+	if err != nil {
+		return fmt.Errorf("fetch withdrawal: %w", err)
+	}
+
+	if err = s.st.ExchangeWithdrawalHistory(repos.WithTx(tx)).Update(ctx, fields); err != nil {
+		return err
+	}
+
+	if current.Status.String() == fields.Status.String {
+		return nil
+	}
+
+	updated, err := s.st.ExchangeWithdrawalHistory(repos.WithTx(tx)).GetByID(ctx, fields.ID)
+	if err != nil {
+		return fmt.Errorf("fetch updated withdrawal: %w", err)
+	}
+
+	return s.eventListener.Fire(StatusChangedEvent{
+		Withdrawal:     *updated,
+		PreviousStatus: current.Status,
+		DBTx:           tx,
+	})
 }
 
 func (s *Service) handleOrderStatusChanged(ctx context.Context, usr *models.User, updateFields repo_exchange_withdrawal_history.UpdateParams) error {
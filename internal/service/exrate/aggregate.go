@@ -0,0 +1,144 @@
+package exrate
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/dv-net/dv-merchant/internal/config"
+	"github.com/dv-net/dv-merchant/internal/models"
+)
+
+type sourceQuote struct {
+	value     decimal.Decimal
+	volume    decimal.Decimal
+	fetchedAt time.Time
+}
+
+type pairKey struct {
+	from, to string
+}
+
+// aggregator keeps the latest quote of every source and combines fresh ones into aggregate rate
+type aggregator struct {
+	cfg    config.ExrateAggregate
+	mu     sync.Mutex
+	quotes map[pairKey]map[string]sourceQuote
+}
+
+func newAggregator(cfg config.ExrateAggregate) *aggregator {
+	return &aggregator{
+		cfg:    cfg,
+		quotes: make(map[pairKey]map[string]sourceQuote),
+	}
+}
+
+// observe records source rate and returns aggregate rate of the pair,
+// ok is false while fewer than min sources agree on the rate
+func (a *aggregator) observe(rate ExRate, now time.Time) (ExRate, bool) {
+	value, err := decimal.NewFromString(rate.Value)
+	if err != nil || !value.IsPositive() {
+		return ExRate{}, false
+	}
+
+	volume, err := decimal.NewFromString(rate.Volume)
+	if err != nil || volume.IsNegative() {
+		volume = decimal.Zero
+	}
+
+	key := pairKey{from: rate.From, to: rate.To}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.quotes[key] == nil {
+		a.quotes[key] = make(map[string]sourceQuote)
+	}
+	a.quotes[key][rate.Source] = sourceQuote{value: value, volume: volume, fetchedAt: now}
+
+	fresh := make([]sourceQuote, 0, len(a.quotes[key]))
+	for _, q := range a.quotes[key] {
+		if now.Sub(q.fetchedAt) <= a.cfg.MaxAge {
+			fresh = append(fresh, q)
+		}
+	}
+
+	aggregated, ok := aggregateQuotes(fresh, a.cfg)
+	if !ok {
+		return ExRate{}, false
+	}
+
+	return ExRate{
+		Source: models.RateSourceAggregate.String(),
+		From:   rate.From,
+		To:     rate.To,
+		Value:  aggregated.String(),
+	}, true
+}
+
+// aggregateQuotes drops quotes deviating from median more than allowed
+// and combines the rest with configured method
+func aggregateQuotes(quotes []sourceQuote, cfg config.ExrateAggregate) (decimal.Decimal, bool) {
+	if len(quotes) < cfg.MinSources || len(quotes) == 0 {
+		return decimal.Zero, false
+	}
+
+	median := medianValue(quotes)
+	maxDeviation := decimal.NewFromFloat(cfg.MaxDeviation).Div(decimal.NewFromInt(100))
+
+	accepted := make([]sourceQuote, 0, len(quotes))
+	for _, q := range quotes {
+		if q.value.Sub(median).Abs().Div(median).LessThanOrEqual(maxDeviation) {
+			accepted = append(accepted, q)
+		}
+	}
+
+	if len(accepted) < cfg.MinSources {
+		return decimal.Zero, false
+	}
+
+	if cfg.Method == config.ExrateAggregateMethodVWAP {
+		if vwap, ok := volumeWeighted(accepted); ok {
+			return vwap, true
+		}
+	}
+
+	return medianValue(accepted), true
+}
+
+func medianValue(quotes []sourceQuote) decimal.Decimal {
+	values := make([]decimal.Decimal, 0, len(quotes))
+	for _, q := range quotes {
+		values = append(values, q.value)
+	}
+	slices.SortFunc(values, func(a, b decimal.Decimal) int {
+		return a.Cmp(b)
+	})
+
+	mid := len(values) / 2
+	if len(values)%2 == 1 {
+		return values[mid]
+	}
+
+	return values[mid-1].Add(values[mid]).Div(decimal.NewFromInt(2))
+}
+
+// volumeWeighted weights quotes by reported volume, sources without volume are skipped
+func volumeWeighted(quotes []sourceQuote) (decimal.Decimal, bool) {
+	sum, volume := decimal.Zero, decimal.Zero
+	for _, q := range quotes {
+		if !q.volume.IsPositive() {
+			continue
+		}
+		sum = sum.Add(q.value.Mul(q.volume))
+		volume = volume.Add(q.volume)
+	}
+
+	if !volume.IsPositive() {
+		return decimal.Zero, false
+	}
+
+	return sum.Div(volume), true
+}
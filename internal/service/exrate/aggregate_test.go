@@ -0,0 +1,93 @@
+package exrate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dv-net/dv-merchant/internal/config"
+)
+
+func TestAggregator(t *testing.T) {
+	cfg := config.ExrateAggregate{
+		Method:       config.ExrateAggregateMethodMedian,
+		MaxDeviation: 2,
+		MinSources:   3,
+		MaxAge:       time.Minute,
+	}
+	now := time.Now()
+
+	observe := func(a *aggregator, source, value, volume string, at time.Time) (ExRate, bool) {
+		return a.observe(ExRate{Source: source, From: "BTC", To: "USDT", Value: value, Volume: volume}, at)
+	}
+
+	t.Run("not enough sources", func(t *testing.T) {
+		a := newAggregator(cfg)
+		_, ok := observe(a, "binance", "100", "", now)
+		require.False(t, ok)
+		_, ok = observe(a, "okx", "101", "", now)
+		require.False(t, ok)
+
+		rate, ok := observe(a, "htx", "102", "", now)
+		require.True(t, ok)
+		require.Equal(t, "aggregate", rate.Source)
+		require.Equal(t, "101", rate.Value)
+	})
+
+	t.Run("outlier rejected", func(t *testing.T) {
+		a := newAggregator(cfg)
+		observe(a, "binance", "100", "", now)
+		observe(a, "okx", "101", "", now)
+		observe(a, "htx", "99", "", now)
+
+		rate, ok := observe(a, "mexc", "150", "", now)
+		require.True(t, ok)
+		require.Equal(t, "100", rate.Value)
+	})
+
+	t.Run("outliers leave too few sources", func(t *testing.T) {
+		a := newAggregator(cfg)
+		observe(a, "binance", "100", "", now)
+		observe(a, "okx", "150", "", now)
+		observe(a, "htx", "200", "", now)
+
+		_, ok := observe(a, "mexc", "50", "", now)
+		require.False(t, ok)
+	})
+
+	t.Run("stale sources ignored", func(t *testing.T) {
+		a := newAggregator(cfg)
+		observe(a, "binance", "100", "", now.Add(-2*time.Minute))
+		observe(a, "okx", "101", "", now)
+
+		_, ok := observe(a, "htx", "102", "", now)
+		require.False(t, ok)
+	})
+
+	t.Run("volume weighted", func(t *testing.T) {
+		vwapCfg := cfg
+		vwapCfg.Method = config.ExrateAggregateMethodVWAP
+
+		a := newAggregator(vwapCfg)
+		observe(a, "binance", "100", "", now)
+		observe(a, "htx", "101", "300", now)
+
+		rate, ok := observe(a, "bybit", "99", "100", now)
+		require.True(t, ok)
+		require.Equal(t, "100.5", rate.Value)
+	})
+
+	t.Run("volume weighted falls back to median", func(t *testing.T) {
+		vwapCfg := cfg
+		vwapCfg.Method = config.ExrateAggregateMethodVWAP
+
+		a := newAggregator(vwapCfg)
+		observe(a, "binance", "100", "", now)
+		observe(a, "okx", "101", "", now)
+
+		rate, ok := observe(a, "mexc", "103", "", now)
+		require.True(t, ok)
+		require.Equal(t, "101", rate.Value)
+	})
+}
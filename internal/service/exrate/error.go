@@ -1,6 +1,9 @@
 package exrate
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 type ExchangeRateNotFoundError struct {
 	Source, From, To string
@@ -12,3 +15,23 @@ func (e *ExchangeRateNotFoundError) Error() string {
 		e.From, e.To, e.Source,
 	)
 }
+
+// StaleExchangeRateError is returned when quoted rate is missing or older than max age of the source
+type StaleExchangeRateError struct {
+	Source, From, To string
+	UpdatedAt        time.Time
+}
+
+func (e *StaleExchangeRateError) Error() string {
+	if e.UpdatedAt.IsZero() {
+		return fmt.Sprintf(
+			"currency exchange rate from [%s] to [%s] is not available in source [%s]",
+			e.From, e.To, e.Source,
+		)
+	}
+
+	return fmt.Sprintf(
+		"currency exchange rate from [%s] to [%s] in source [%s] is stale since %s",
+		e.From, e.To, e.Source, e.UpdatedAt.Format(time.RFC3339),
+	)
+}
@@ -0,0 +1,42 @@
+package exrate
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dv-net/dv-merchant/internal/event"
+)
+
+const (
+	SourceStaleEventType     = "exrate_source_stale"
+	SourceRecoveredEventType = "exrate_source_recovered"
+)
+
+// SourceStaleEvent is fired once when rate source was not updated for longer than its max age
+type SourceStaleEvent struct {
+	Source    string
+	UpdatedAt time.Time
+	MaxAge    time.Duration
+}
+
+func (e SourceStaleEvent) Type() event.Type {
+	return SourceStaleEventType
+}
+
+func (e SourceStaleEvent) String() string {
+	return fmt.Sprintf("ExrateSourceStale: source=%s, updated_at=%s", e.Source, e.UpdatedAt.Format(time.RFC3339))
+}
+
+// SourceRecoveredEvent is fired once when stale rate source is updated again
+type SourceRecoveredEvent struct {
+	Source    string
+	UpdatedAt time.Time
+}
+
+func (e SourceRecoveredEvent) Type() event.Type {
+	return SourceRecoveredEventType
+}
+
+func (e SourceRecoveredEvent) String() string {
+	return fmt.Sprintf("ExrateSourceRecovered: source=%s, updated_at=%s", e.Source, e.UpdatedAt.Format(time.RFC3339))
+}
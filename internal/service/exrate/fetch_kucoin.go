@@ -17,8 +17,9 @@ import (
 var ErrInvalidResponseFromKucoin = errors.New("invalid response from kucoin")
 
 type KucoinSymbol struct {
-	Symbol string `json:"symbol"`
-	Last   string `json:"last,omitempty"`
+	Symbol   string `json:"symbol"`
+	Last     string `json:"last,omitempty"`
+	VolValue string `json:"volValue,omitempty"`
 }
 
 type KucoinResponse struct {
@@ -202,12 +203,14 @@ func filterKucoinResponse(r *KucoinResponse, currencyFilter CurrencyFilter, out
 				From:   s.From,
 				To:     s.To,
 				Value:  symbol.Last,
+				Volume: symbol.VolValue,
 			}
 			out <- ExRate{
 				Source: "kucoin",
 				From:   s.To,
 				To:     s.From,
 				Value:  strconv.FormatFloat(1/floatValue, 'f', -1, 64),
+				Volume: symbol.VolValue,
 			}
 		}
 	}
@@ -217,12 +217,14 @@ func filterBitgetResponse(r *BitgetResponse, currencyFilter CurrencyFilter, out
 				From:   s.From,
 				To:     s.To,
 				Value:  askRounded,
+				Volume: strconv.FormatFloat(symbol.QuoteVolume, 'f', -1, 64),
 			}
 			out <- ExRate{
 				Source: "bitget",
 				From:   s.To,
 				To:     s.From,
 				Value:  strconv.FormatFloat(roundToSixDecimalPlaces(1/symbol.AskPr), 'f', 6, 64),
+				Volume: strconv.FormatFloat(symbol.QuoteVolume, 'f', -1, 64),
 			}
 		}
 	}
@@ -16,8 +16,9 @@ import (
 )
 
 type BybitSymbol struct {
-	Symbol    string          `json:"symbol"`
-	LastPrice decimal.Decimal `json:"lastPrice"`
+	Symbol      string          `json:"symbol"`
+	LastPrice   decimal.Decimal `json:"lastPrice"`
+	Turnover24h decimal.Decimal `json:"turnover24h"`
 }
 
 type BybitResponse struct {
@@ -190,12 +191,14 @@ func filterBybitResponse(r *BybitResponse, currencyFilter CurrencyFilter, out ch
 				From:   s.From,
 				To:     s.To,
 				Value:  symbol.LastPrice.String(),
+				Volume: symbol.Turnover24h.String(),
 			}
 			out <- ExRate{
 				Source: "bybit",
 				From:   s.To,
 				To:     s.From,
 				Value:  strconv.FormatFloat(1/symbol.LastPrice.InexactFloat64(), 'f', -1, 64),
+				Volume: symbol.Turnover24h.String(),
 			}
 		}
 	}
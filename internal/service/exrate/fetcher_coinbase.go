@@ -0,0 +1,177 @@
+package exrate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/dv-net/dv-merchant/pkg/logger"
+	"github.com/shopspring/decimal"
+)
+
+type CoinbaseProduct struct {
+	ProductID string          `json:"product_id"`
+	Price     decimal.Decimal `json:"price"`
+}
+
+type CoinbaseResponse struct {
+	Products []CoinbaseProduct `json:"products"`
+}
+
+func NewCoinbaseFetcher(url string, proxies []string, httpClient *http.Client, log logger.Logger) IFetcher {
+	return &coinbaseFetcher{url: url, proxies: proxies, httpClient: httpClient, log: log}
+}
+
+type coinbaseFetcher struct {
+	url        string
+	httpClient *http.Client
+	proxies    []string
+	log        logger.Logger
+}
+
+var _ IFetcher = (*coinbaseFetcher)(nil)
+
+func (f *coinbaseFetcher) Source() string {
+	return "coinbase"
+}
+
+func (f *coinbaseFetcher) Fetch(ctx context.Context, currencyFilter CurrencyFilter, out chan<- ExRate) error {
+	err := f.fetchWithClient(ctx, f.httpClient, "direct", currencyFilter, out)
+	if err == nil {
+		return nil
+	}
+
+	f.log.Warnw("[EXRATE-COINBASE] direct request failed, trying proxies", "error", err)
+
+	if len(f.proxies) == 0 {
+		return err
+	}
+
+	shuffledProxies := make([]string, len(f.proxies))
+	copy(shuffledProxies, f.proxies)
+	rand.Shuffle(len(shuffledProxies), func(i, j int) {
+		shuffledProxies[i], shuffledProxies[j] = shuffledProxies[j], shuffledProxies[i]
+	})
+
+	lastErr := err
+
+	for _, proxyURL := range shuffledProxies {
+		client, err := f.createProxyClient(proxyURL)
+		if err != nil {
+			f.log.Warnw("[EXRATE-COINBASE] failed to create proxy client", "proxy", proxyURL, "error", err)
+			lastErr = err
+			continue
+		}
+
+		if err := f.fetchWithClient(ctx, client, "proxy", currencyFilter, out); err != nil {
+			lastErr = err
+			continue
+		}
+
+		return nil
+	}
+
+	return lastErr
+}
+
+func (f *coinbaseFetcher) createProxyClient(proxyURL string) (*http.Client, error) {
+	parsedURL, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, fmt.Errorf("parse proxy url: %w", err)
+	}
+
+	return &http.Client{
+		Timeout:   f.httpClient.Timeout,
+		Transport: &http.Transport{Proxy: http.ProxyURL(parsedURL)},
+	}, nil
+}
+
+func (f *coinbaseFetcher) fetchWithClient(ctx context.Context, client *http.Client, connectionType string, currencyFilter CurrencyFilter, out chan<- ExRate) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, f.url, http.NoBody)
+	if err != nil {
+		f.log.Errorw("[EXRATE-COINBASE] failed to create request", "error", err, "url", f.url, "connection", connectionType)
+		return err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		f.log.Errorw("[EXRATE-COINBASE] http client error", "error", err, "url", f.url, "connection", connectionType)
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		f.log.Errorw("[EXRATE-COINBASE] failed to read response body", "error", err, "status_code", resp.StatusCode, "connection", connectionType)
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		f.log.Errorw("[EXRATE-COINBASE] non-OK HTTP status",
+			"status_code", resp.StatusCode,
+			"status", resp.Status,
+			"raw_response", string(bodyBytes),
+			"connection", connectionType)
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	body, err := parseCoinbaseResponseBytes(bodyBytes)
+	if err != nil {
+		f.log.Errorw("[EXRATE-COINBASE] response parsing error", "error", err, "status_code", resp.StatusCode, "connection", connectionType)
+		return err
+	}
+
+	if err := filterCoinbaseResponse(body, currencyFilter, out); err != nil {
+		f.log.Errorw("[EXRATE-COINBASE] failed to filter response", "error", err, "symbol_count", len(body.Products), "connection", connectionType)
+		return err
+	}
+
+	return nil
+}
+
+func parseCoinbaseResponseBytes(b []byte) (*CoinbaseResponse, error) {
+	r := &CoinbaseResponse{}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return nil, fmt.Errorf("json unmarshal failed: %w", err)
+	}
+	return r, nil
+}
+
+// filterCoinbaseResponse matches products by id without the dash, e.g. BTC-USDT
+func filterCoinbaseResponse(r *CoinbaseResponse, currencyFilter CurrencyFilter, out chan<- ExRate) error {
+	if r == nil || len(r.Products) == 0 {
+		return fmt.Errorf("empty response data")
+	}
+
+	for _, product := range r.Products {
+		symbol := strings.ReplaceAll(product.ProductID, "-", "")
+		s, ok := currencyFilter.symbols[symbol]
+		if !ok {
+			continue
+		}
+
+		if product.Price.IsZero() || product.Price.IsNegative() {
+			return fmt.Errorf("invalid price for symbol %s: %s", product.ProductID, product.Price.String())
+		}
+
+		out <- ExRate{
+			Source: "coinbase",
+			From:   s.From,
+			To:     s.To,
+			Value:  product.Price.String(),
+		}
+		out <- ExRate{
+			Source: "coinbase",
+			From:   s.To,
+			To:     s.From,
+			Value:  strconv.FormatFloat(1/product.Price.InexactFloat64(), 'f', -1, 64),
+		}
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+//nolint:tagliatelle
+package exrate
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/dv-net/dv-merchant/internal/config"
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/pkg/logger"
+)
+
+// FiatSource stores reference rates between USD and fiat currencies, it is not selectable as store rate source
+const FiatSource = "fiat"
+
+var ErrInvalidFiatFeed = errors.New("invalid fiat reference rates feed")
+
+// ecbEnvelope is the daily reference rates document of European Central Bank,
+// rates are quoted as units of currency per one EUR
+type ecbEnvelope struct {
+	Cube struct {
+		Cube []struct {
+			Time string `xml:"time,attr"`
+			Cube []struct {
+				Currency string `xml:"currency,attr"`
+				Rate     string `xml:"rate,attr"`
+			} `xml:"Cube"`
+		} `xml:"Cube"`
+	} `xml:"Cube"`
+}
+
+// fiatJSONFeed is a generic feed, rates are quoted as units of currency per one base
+type fiatJSONFeed struct {
+	Base  string                     `json:"base"`
+	Rates map[string]decimal.Decimal `json:"rates"`
+}
+
+func NewFiatFetcher(feed string, format config.ExrateFiatFormat, httpClient *http.Client, log logger.Logger) IFetcher {
+	return &fiatFetcher{feed: feed, format: format, httpClient: httpClient, log: log}
+}
+
+type fiatFetcher struct {
+	feed       string
+	format     config.ExrateFiatFormat
+	httpClient *http.Client
+	log        logger.Logger
+}
+
+var _ IFetcher = (*fiatFetcher)(nil)
+
+func (f *fiatFetcher) Source() string {
+	return FiatSource
+}
+
+// Fetch sends USD rates of every currency in the feed, crypto currency filter does not apply to fiat
+func (f *fiatFetcher) Fetch(ctx context.Context, _ CurrencyFilter, out chan<- ExRate) error {
+	body, err := f.read(ctx)
+	if err != nil {
+		f.log.Errorw("[EXRATE-FIAT] failed to read feed", "error", err, "feed", f.feed)
+		return err
+	}
+
+	var (
+		base  string
+		rates map[string]decimal.Decimal
+	)
+	switch f.format {
+	case config.ExrateFiatFormatJSON:
+		base, rates, err = parseFiatJSON(body)
+	default:
+		base, rates, err = parseECBXML(body)
+	}
+	if err != nil {
+		f.log.Errorw("[EXRATE-FIAT] response parsing error", "error", err, "feed", f.feed)
+		return err
+	}
+
+	usdRates, err := rebaseToUSD(base, rates)
+	if err != nil {
+		return err
+	}
+
+	for code, rate := range usdRates {
+		out <- ExRate{
+			Source: FiatSource,
+			From:   models.CurrencyCodeUSD,
+			To:     code,
+			Value:  rate.Round(8).String(),
+		}
+		out <- ExRate{
+			Source: FiatSource,
+			From:   code,
+			To:     models.CurrencyCodeUSD,
+			Value:  decimal.NewFromInt(1).Div(rate).Round(8).String(),
+		}
+	}
+
+	return nil
+}
+
+// read loads feed from url or local file
+func (f *fiatFetcher) read(ctx context.Context) ([]byte, error) {
+	if !strings.HasPrefix(f.feed, "http://") && !strings.HasPrefix(f.feed, "https://") {
+		return os.ReadFile(f.feed)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, f.feed, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+func parseECBXML(b []byte) (string, map[string]decimal.Decimal, error) {
+	envelope := &ecbEnvelope{}
+	if err := xml.Unmarshal(b, envelope); err != nil {
+		return "", nil, fmt.Errorf("xml unmarshal failed: %w", err)
+	}
+
+	if len(envelope.Cube.Cube) == 0 {
+		return "", nil, fmt.Errorf("%w: no rates", ErrInvalidFiatFeed)
+	}
+
+	// daily feed holds a single day, historical ones start from the latest
+	latest := envelope.Cube.Cube[0]
+	rates := make(map[string]decimal.Decimal, len(latest.Cube))
+	for _, c := range latest.Cube {
+		rate, err := decimal.NewFromString(c.Rate)
+		if err != nil {
+			return "", nil, fmt.Errorf("%w: rate of %s: %w", ErrInvalidFiatFeed, c.Currency, err)
+		}
+		rates[strings.ToUpper(c.Currency)] = rate
+	}
+
+	return "EUR", rates, nil
+}
+
+func parseFiatJSON(b []byte) (string, map[string]decimal.Decimal, error) {
+	feed := &fiatJSONFeed{}
+	if err := json.Unmarshal(b, feed); err != nil {
+		return "", nil, fmt.Errorf("json unmarshal failed: %w", err)
+	}
+
+	if feed.Base == "" || len(feed.Rates) == 0 {
+		return "", nil, fmt.Errorf("%w: base and rates are required", ErrInvalidFiatFeed)
+	}
+
+	rates := make(map[string]decimal.Decimal, len(feed.Rates))
+	for code, rate := range feed.Rates {
+		rates[strings.ToUpper(code)] = rate
+	}
+
+	return strings.ToUpper(feed.Base), rates, nil
+}
+
+// rebaseToUSD converts rates quoted per base currency into units of currency per one USD
+func rebaseToUSD(base string, rates map[string]decimal.Decimal) (map[string]decimal.Decimal, error) {
+	usdPerBase := decimal.NewFromInt(1)
+	if base != models.CurrencyCodeUSD {
+		var ok bool
+		if usdPerBase, ok = rates[models.CurrencyCodeUSD]; !ok || !usdPerBase.IsPositive() {
+			return nil, fmt.Errorf("%w: no USD rate for base %s", ErrInvalidFiatFeed, base)
+		}
+	}
+
+	result := make(map[string]decimal.Decimal, len(rates))
+	if base != models.CurrencyCodeUSD {
+		result[base] = decimal.NewFromInt(1).Div(usdPerBase)
+	}
+
+	for code, rate := range rates {
+		if code == models.CurrencyCodeUSD || code == base {
+			continue
+		}
+		if !rate.IsPositive() {
+			return nil, fmt.Errorf("%w: rate of %s is not positive", ErrInvalidFiatFeed, code)
+		}
+		result[code] = rate.Div(usdPerBase)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,70 @@
+package exrate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dv-net/dv-merchant/internal/config"
+)
+
+func TestFiatFetcher(t *testing.T) {
+	tests := []struct {
+		name     string
+		feed     string
+		format   config.ExrateFiatFormat
+		expected map[string]string
+	}{
+		{
+			name:   "ecb xml",
+			feed:   "testdata/ecb_daily.xml",
+			format: config.ExrateFiatFormatECB,
+			expected: map[string]string{
+				"USD:EUR": "0.8",
+				"EUR:USD": "1.25",
+				"USD:JPY": "130",
+				"JPY:USD": "0.00769231",
+				"USD:GBP": "0.68",
+				"GBP:USD": "1.47058824",
+			},
+		},
+		{
+			name:   "json",
+			feed:   "testdata/fiat_rates.json",
+			format: config.ExrateFiatFormatJSON,
+			expected: map[string]string{
+				"USD:EUR": "0.8",
+				"EUR:USD": "1.25",
+				"USD:RUB": "92.5",
+				"RUB:USD": "0.01081081",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewFiatFetcher(tt.feed, tt.format, nil, nil)
+			require.Equal(t, FiatSource, f.Source())
+
+			out := make(chan ExRate, 2*len(tt.expected))
+			require.NoError(t, f.Fetch(context.Background(), CurrencyFilter{}, out))
+			close(out)
+
+			got := make(map[string]string, len(tt.expected))
+			for rate := range out {
+				require.Equal(t, FiatSource, rate.Source)
+				got[rate.From+":"+rate.To] = rate.Value
+			}
+			require.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestRebaseToUSDRequiresUSDRate(t *testing.T) {
+	_, rates, err := parseECBXML([]byte(`<Envelope><Cube><Cube time="2026-10-16"><Cube currency="GBP" rate="0.85"/></Cube></Cube></Envelope>`))
+	require.NoError(t, err)
+
+	_, err = rebaseToUSD("EUR", rates)
+	require.ErrorIs(t, err, ErrInvalidFiatFeed)
+}
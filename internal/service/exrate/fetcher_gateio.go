@@ -18,6 +18,7 @@ import (
 type GateioSymbol struct {
 	CurrencyPair string          `json:"currency_pair"`
 	Last         decimal.Decimal `json:"last"`
+	QuoteVolume  decimal.Decimal `json:"quote_volume"`
 }
 
 type GateioResponse struct {
@@ -189,12 +190,14 @@ func filterGateioResponse(r *GateioResponse, currencyFilter CurrencyFilter, out
 				From:   s.From,
 				To:     s.To,
 				Value:  symbol.Last.String(),
+				Volume: symbol.QuoteVolume.String(),
 			}
 			out <- ExRate{
 				Source: "gate",
 				From:   s.To,
 				To:     s.From,
 				Value:  strconv.FormatFloat(1/symbol.Last.InexactFloat64(), 'f', -1, 64),
+				Volume: symbol.QuoteVolume.String(),
 			}
 		}
 	}
@@ -215,12 +215,14 @@ func filterHtxResponse(r *HtxResponse, currencyFilter CurrencyFilter, out chan<-
 				From:   s.From,
 				To:     s.To,
 				Value:  askRounded,
+				Volume: strconv.FormatFloat(symbol.Vol, 'f', -1, 64),
 			}
 			out <- ExRate{
 				Source: "htx",
 				From:   s.To,
 				To:     s.From,
 				Value:  strconv.FormatFloat(roundToSixDecimalPlaces(1/symbol.Ask), 'f', 6, 64),
+				Volume: strconv.FormatFloat(symbol.Vol, 'f', -1, 64),
 			}
 		}
 	}
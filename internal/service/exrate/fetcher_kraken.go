@@ -0,0 +1,217 @@
+package exrate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/dv-net/dv-merchant/pkg/logger"
+	"github.com/shopspring/decimal"
+)
+
+type KrakenAssetPair struct {
+	Wsname string `json:"wsname"`
+}
+
+type KrakenTicker struct {
+	Last []decimal.Decimal `json:"c"`
+}
+
+type KrakenResponse[T any] struct {
+	Error  []string     `json:"error"`
+	Result map[string]T `json:"result"`
+}
+
+// krakenLegacyAssets maps kraken asset codes which differ from common tickers
+var krakenLegacyAssets = map[string]string{
+	"XBT": "BTC",
+	"XDG": "DOGE",
+}
+
+func NewKrakenFetcher(url string, proxies []string, httpClient *http.Client, log logger.Logger) IFetcher {
+	return &krakenFetcher{url: url, proxies: proxies, httpClient: httpClient, log: log}
+}
+
+type krakenFetcher struct {
+	url        string
+	httpClient *http.Client
+	proxies    []string
+	log        logger.Logger
+}
+
+var _ IFetcher = (*krakenFetcher)(nil)
+
+func (f *krakenFetcher) Source() string {
+	return "kraken"
+}
+
+func (f *krakenFetcher) Fetch(ctx context.Context, currencyFilter CurrencyFilter, out chan<- ExRate) error {
+	err := f.fetchWithClient(ctx, f.httpClient, "direct", currencyFilter, out)
+	if err == nil {
+		return nil
+	}
+
+	f.log.Warnw("[EXRATE-KRAKEN] direct request failed, trying proxies", "error", err)
+
+	if len(f.proxies) == 0 {
+		return err
+	}
+
+	shuffledProxies := make([]string, len(f.proxies))
+	copy(shuffledProxies, f.proxies)
+	rand.Shuffle(len(shuffledProxies), func(i, j int) {
+		shuffledProxies[i], shuffledProxies[j] = shuffledProxies[j], shuffledProxies[i]
+	})
+
+	lastErr := err
+
+	for _, proxyURL := range shuffledProxies {
+		client, err := f.createProxyClient(proxyURL)
+		if err != nil {
+			f.log.Warnw("[EXRATE-KRAKEN] failed to create proxy client", "proxy", proxyURL, "error", err)
+			lastErr = err
+			continue
+		}
+
+		if err := f.fetchWithClient(ctx, client, "proxy", currencyFilter, out); err != nil {
+			lastErr = err
+			continue
+		}
+
+		return nil
+	}
+
+	return lastErr
+}
+
+func (f *krakenFetcher) createProxyClient(proxyURL string) (*http.Client, error) {
+	parsedURL, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, fmt.Errorf("parse proxy url: %w", err)
+	}
+
+	return &http.Client{
+		Timeout:   f.httpClient.Timeout,
+		Transport: &http.Transport{Proxy: http.ProxyURL(parsedURL)},
+	}, nil
+}
+
+// fetchWithClient loads pair names first, ticker keys are canonical pair names like XXBTZUSD
+func (f *krakenFetcher) fetchWithClient(ctx context.Context, client *http.Client, connectionType string, currencyFilter CurrencyFilter, out chan<- ExRate) error {
+	pairs := &KrakenResponse[KrakenAssetPair]{}
+	if err := f.get(ctx, client, connectionType, "/AssetPairs", pairs); err != nil {
+		return err
+	}
+
+	tickers := &KrakenResponse[KrakenTicker]{}
+	if err := f.get(ctx, client, connectionType, "/Ticker", tickers); err != nil {
+		return err
+	}
+
+	if err := filterKrakenResponse(pairs, tickers, currencyFilter, out); err != nil {
+		f.log.Errorw("[EXRATE-KRAKEN] failed to filter response", "error", err, "symbol_count", len(tickers.Result), "connection", connectionType)
+		return err
+	}
+
+	return nil
+}
+
+func (f *krakenFetcher) get(ctx context.Context, client *http.Client, connectionType, path string, dest interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, f.url+path, http.NoBody)
+	if err != nil {
+		f.log.Errorw("[EXRATE-KRAKEN] failed to create request", "error", err, "url", f.url+path, "connection", connectionType)
+		return err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		f.log.Errorw("[EXRATE-KRAKEN] http client error", "error", err, "url", f.url+path, "connection", connectionType)
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		f.log.Errorw("[EXRATE-KRAKEN] failed to read response body", "error", err, "status_code", resp.StatusCode, "connection", connectionType)
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		f.log.Errorw("[EXRATE-KRAKEN] non-OK HTTP status",
+			"status_code", resp.StatusCode,
+			"status", resp.Status,
+			"raw_response", string(bodyBytes),
+			"connection", connectionType)
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	if err := json.Unmarshal(bodyBytes, dest); err != nil {
+		f.log.Errorw("[EXRATE-KRAKEN] response parsing error", "error", err, "status_code", resp.StatusCode, "connection", connectionType)
+		return fmt.Errorf("json unmarshal failed: %w", err)
+	}
+
+	return nil
+}
+
+func krakenSymbol(wsname string) (string, bool) {
+	base, quote, ok := strings.Cut(wsname, "/")
+	if !ok {
+		return "", false
+	}
+	if code, found := krakenLegacyAssets[base]; found {
+		base = code
+	}
+	if code, found := krakenLegacyAssets[quote]; found {
+		quote = code
+	}
+	return base + quote, true
+}
+
+func filterKrakenResponse(pairs *KrakenResponse[KrakenAssetPair], tickers *KrakenResponse[KrakenTicker], currencyFilter CurrencyFilter, out chan<- ExRate) error {
+	if len(pairs.Error) > 0 || len(tickers.Error) > 0 {
+		return fmt.Errorf("kraken error: %s", strings.Join(append(pairs.Error, tickers.Error...), "; "))
+	}
+	if len(tickers.Result) == 0 {
+		return fmt.Errorf("empty response data")
+	}
+
+	for pairName, ticker := range tickers.Result {
+		pair, ok := pairs.Result[pairName]
+		if !ok {
+			continue
+		}
+		symbol, ok := krakenSymbol(pair.Wsname)
+		if !ok {
+			continue
+		}
+		s, ok := currencyFilter.symbols[symbol]
+		if !ok {
+			continue
+		}
+
+		if len(ticker.Last) == 0 || !ticker.Last[0].IsPositive() {
+			return fmt.Errorf("invalid price for symbol %s", symbol)
+		}
+		price := ticker.Last[0]
+
+		out <- ExRate{
+			Source: "kraken",
+			From:   s.From,
+			To:     s.To,
+			Value:  price.String(),
+		}
+		out <- ExRate{
+			Source: "kraken",
+			From:   s.To,
+			To:     s.From,
+			Value:  strconv.FormatFloat(1/price.InexactFloat64(), 'f', -1, 64),
+		}
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package exrate
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+)
+
+// fiatCodeSet keeps currencies of the last fetched fiat feed
+type fiatCodeSet struct {
+	mu    sync.RWMutex
+	codes []string
+}
+
+func (s *fiatCodeSet) set(codes []string) {
+	slices.Sort(codes)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.codes = codes
+}
+
+func (s *fiatCodeSet) list() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return slices.Clone(s.codes)
+}
+
+// fiatKeeper fetches fiat reference rates apart from crypto sources,
+// they neither take part in aggregate rate nor are selectable as store rate source
+func (srv *service) fiatKeeper(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	if srv.fiatFetcher == nil {
+		return
+	}
+
+	interval := srv.fiat.FetchInterval
+	if interval <= 0 {
+		interval = time.Hour
+	}
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			timer.Reset(interval)
+			srv.fetchFiatRates(ctx)
+		}
+	}
+}
+
+func (srv *service) fetchFiatRates(ctx context.Context) {
+	out := make(chan ExRate)
+	done := make(chan error, 1)
+	go func() {
+		done <- srv.fiatFetcher.Fetch(ctx, CurrencyFilter{}, out)
+		close(out)
+	}()
+
+	codes := make([]string, 0)
+	for rate := range out {
+		if err := srv.storage.CurrencyExchange().StoreRate(ctx, rate.Source, rate.From, rate.To, rate.Value, srv.fiat.MaxAge); err != nil {
+			srv.logger.Errorw(
+				"save fiat exchange rate", "error", err,
+				"from", rate.From,
+				"to", rate.To,
+			)
+			continue
+		}
+		if rate.From == models.CurrencyCodeUSD {
+			codes = append(codes, rate.To)
+		}
+	}
+
+	if err := <-done; err != nil {
+		srv.logger.Errorw("fetch fiat rates", "error", err)
+		return
+	}
+
+	srv.fiatCodes.set(codes)
+	srv.health.touch(FiatSource, time.Now())
+	srv.logger.Debugw("fiat rates stored", "count", len(codes))
+}
+
+// GetFiatRate returns reference rate between USD and fiat currency,
+// rate snapshotted at given time is used when at is set
+func (srv *service) GetFiatRate(ctx context.Context, from, to string, at *time.Time) (string, error) {
+	from, to = strings.ToUpper(from), strings.ToUpper(to)
+	if from == to {
+		return "1", nil
+	}
+
+	if at != nil {
+		snapshot, err := srv.rateSnapshot(ctx, FiatSource, from, to, at.UTC())
+		if err != nil {
+			return "", err
+		}
+		return snapshot.Value.String(), nil
+	}
+
+	v, err := srv.storage.CurrencyExchange().GetSourceRate(ctx, FiatSource, from, to)
+	if err != nil {
+		if isRateNotFound(err) {
+			return "", &ExchangeRateNotFoundError{Source: FiatSource, From: from, To: to}
+		}
+		return "", fmt.Errorf("get fiat exchange rate: %w [%s/%s]", err, from, to)
+	}
+
+	return v, nil
+}
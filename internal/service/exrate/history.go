@@ -0,0 +1,179 @@
+package exrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_exchange_rate_history"
+	"github.com/dv-net/dv-merchant/pkg/pgtypeutils"
+)
+
+type sourcePair struct {
+	source string
+	pair   CurrencyPair
+}
+
+// historyKeeper snapshots rates of every source into database once per resolution
+func (srv *service) historyKeeper(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	if !srv.history.Enabled || srv.history.Resolution <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(srv.history.Resolution)
+	defer ticker.Stop()
+
+	lastCleanup := time.Time{}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := srv.snapshotRates(ctx, now); err != nil {
+				srv.logger.Errorw("snapshot exchange rates", "error", err)
+			}
+
+			if now.Sub(lastCleanup) >= time.Hour {
+				lastCleanup = now
+				srv.deleteExpiredSnapshots(ctx, now)
+			}
+		}
+	}
+}
+
+func (srv *service) snapshotRates(ctx context.Context, now time.Time) error {
+	currencyList, err := srv.getCurrencyList(ctx)
+	if err != nil {
+		return err
+	}
+
+	sources, err := srv.LoadSources(ctx)
+	if err != nil {
+		return err
+	}
+
+	filter := NewCurrencyFilter(currencyList)
+	params := repo_exchange_rate_history.SnapshotParams{
+		SnapshotAt: pgtypeutils.EncodeTime(now.UTC().Truncate(srv.history.Resolution)),
+	}
+
+	pairs := make([]sourcePair, 0, len(sources)*len(filter.symbols))
+	for _, source := range sources {
+		for _, pair := range filter.symbols {
+			pairs = append(pairs, sourcePair{source: source, pair: pair})
+		}
+	}
+	for _, code := range srv.fiatCodes.list() {
+		pairs = append(pairs,
+			sourcePair{source: FiatSource, pair: CurrencyPair{From: models.CurrencyCodeUSD, To: code}},
+			sourcePair{source: FiatSource, pair: CurrencyPair{From: code, To: models.CurrencyCodeUSD}},
+		)
+	}
+
+	for _, sp := range pairs {
+		value, err := srv.storage.CurrencyExchange().GetSourceRate(ctx, sp.source, sp.pair.From, sp.pair.To)
+		if err != nil || value == "" {
+			continue
+		}
+
+		params.Sources = append(params.Sources, sp.source)
+		params.FromCurrencies = append(params.FromCurrencies, sp.pair.From)
+		params.ToCurrencies = append(params.ToCurrencies, sp.pair.To)
+		params.Values = append(params.Values, value)
+	}
+
+	if len(params.Values) == 0 {
+		return nil
+	}
+
+	stored, err := srv.storage.ExchangeRateHistory().Snapshot(ctx, params)
+	if err != nil {
+		return fmt.Errorf("store rate snapshot: %w", err)
+	}
+
+	srv.logger.Debugw("exchange rates snapshotted", "count", stored)
+
+	return nil
+}
+
+func (srv *service) deleteExpiredSnapshots(ctx context.Context, now time.Time) {
+	if srv.history.Retention <= 0 {
+		return
+	}
+
+	deleted, err := srv.storage.ExchangeRateHistory().DeleteBefore(ctx, pgtypeutils.EncodeTime(now.UTC().Add(-srv.history.Retention)))
+	if err != nil {
+		srv.logger.Errorw("delete expired rate snapshots", "error", err)
+		return
+	}
+
+	if deleted > 0 {
+		srv.logger.Debugw("expired rate snapshots deleted", "count", deleted)
+	}
+}
+
+// GetRateAt returns rate of the latest snapshot taken at or before given time
+func (srv *service) GetRateAt(ctx context.Context, source, from, to string, at time.Time, scale ...decimal.Decimal) (*ExRate, error) {
+	if !models.RateSource(source).Valid() {
+		return nil, fmt.Errorf("invalid source %s", source)
+	}
+
+	if strings.EqualFold(to, models.CurrencyCodeUSD) {
+		to = models.CurrencyCodeUSDT
+	}
+
+	at = at.UTC()
+	if strings.EqualFold(from, to) {
+		return &ExRate{Source: source, From: from, To: to, Value: "1", ValueScale: "1", UpdatedAt: &at}, nil
+	}
+
+	snapshot, err := srv.rateSnapshot(ctx, source, from, to, at)
+	if err != nil {
+		return nil, err
+	}
+
+	rateValue := normalizeStableRate(from, to, snapshot.Value)
+	valScale := rateValue
+	if len(scale) > 0 {
+		multiplier := decimal.NewFromInt(100).Add(scale[0]).Div(decimal.NewFromInt(100))
+		valScale = rateValue.Mul(multiplier)
+	}
+
+	snapshotAt := snapshot.SnapshotAt.Time
+	return &ExRate{
+		Source:     source,
+		From:       from,
+		To:         to,
+		Value:      rateValue.String(),
+		ValueScale: valScale.String(),
+		UpdatedAt:  &snapshotAt,
+	}, nil
+}
+
+// rateSnapshot looks up the latest snapshot of the pair within lookup window before given time
+func (srv *service) rateSnapshot(ctx context.Context, source, from, to string, at time.Time) (*models.ExchangeRateHistory, error) {
+	snapshot, err := srv.storage.ExchangeRateHistory().GetRateAt(ctx, repo_exchange_rate_history.GetRateAtParams{
+		Source:       source,
+		FromCurrency: from,
+		ToCurrency:   to,
+		At:           pgtypeutils.EncodeTime(at),
+		NotBefore:    pgtypeutils.EncodeTime(at.Add(-srv.history.LookupWindow)),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &ExchangeRateNotFoundError{Source: source, From: from, To: to}
+		}
+		return nil, fmt.Errorf("get rate snapshot: %w", err)
+	}
+
+	return snapshot, nil
+}
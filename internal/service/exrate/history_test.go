@@ -0,0 +1,124 @@
+package exrate
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dv-net/dv-merchant/internal/config"
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/storage"
+	"github.com/dv-net/dv-merchant/internal/storage/repos"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_exchange_rate_history"
+	"github.com/dv-net/dv-merchant/pkg/pgtypeutils"
+)
+
+// historyStorage serves snapshots from memory with the same selection as GetRateAt query
+type historyStorage struct {
+	storage.IStorage
+	repo_exchange_rate_history.Querier
+	snapshots []*models.ExchangeRateHistory
+}
+
+func (s *historyStorage) ExchangeRateHistory(...repos.Option) repo_exchange_rate_history.Querier {
+	return s
+}
+
+func (s *historyStorage) GetRateAt(_ context.Context, arg repo_exchange_rate_history.GetRateAtParams) (*models.ExchangeRateHistory, error) {
+	var found *models.ExchangeRateHistory
+	for _, snapshot := range s.snapshots {
+		if snapshot.Source != arg.Source || snapshot.FromCurrency != arg.FromCurrency || snapshot.ToCurrency != arg.ToCurrency {
+			continue
+		}
+		if snapshot.SnapshotAt.Time.After(arg.At.Time) || snapshot.SnapshotAt.Time.Before(arg.NotBefore.Time) {
+			continue
+		}
+		if found == nil || snapshot.SnapshotAt.Time.After(found.SnapshotAt.Time) {
+			found = snapshot
+		}
+	}
+	if found == nil {
+		return nil, pgx.ErrNoRows
+	}
+
+	return found, nil
+}
+
+func TestGetRateAt(t *testing.T) {
+	at := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	snapshot := func(age time.Duration, value string) *models.ExchangeRateHistory {
+		return &models.ExchangeRateHistory{
+			Source:       "binance",
+			FromCurrency: "BTC",
+			ToCurrency:   "USDT",
+			Value:        decimal.RequireFromString(value),
+			SnapshotAt:   pgtypeutils.EncodeTime(at.Add(-age)),
+		}
+	}
+
+	tests := []struct {
+		name      string
+		snapshots []*models.ExchangeRateHistory
+		value     string
+		takenAt   time.Time
+		notFound  bool
+	}{
+		{
+			name:      "snapshot within window",
+			snapshots: []*models.ExchangeRateHistory{snapshot(10*time.Minute, "60000")},
+			value:     "60000",
+			takenAt:   at.Add(-10 * time.Minute),
+		},
+		{
+			name:      "snapshot at window edge",
+			snapshots: []*models.ExchangeRateHistory{snapshot(time.Hour, "60000")},
+			value:     "60000",
+			takenAt:   at.Add(-time.Hour),
+		},
+		{
+			name:      "snapshot outside window",
+			snapshots: []*models.ExchangeRateHistory{snapshot(time.Hour+time.Minute, "60000")},
+			notFound:  true,
+		},
+		{
+			name: "latest snapshot before requested time",
+			snapshots: []*models.ExchangeRateHistory{
+				snapshot(50*time.Minute, "59000"),
+				snapshot(5*time.Minute, "61000"),
+				snapshot(-5*time.Minute, "62000"),
+			},
+			value:   "61000",
+			takenAt: at.Add(-5 * time.Minute),
+		},
+		{
+			name:      "only later snapshot",
+			snapshots: []*models.ExchangeRateHistory{snapshot(-time.Minute, "62000")},
+			notFound:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := &service{
+				storage: &historyStorage{snapshots: tt.snapshots},
+				history: config.ExrateHistory{LookupWindow: time.Hour},
+			}
+
+			rate, err := srv.GetRateAt(context.Background(), "binance", "BTC", "USD", at)
+			if tt.notFound {
+				var notFound *ExchangeRateNotFoundError
+				require.True(t, errors.As(err, &notFound), "unexpected error %v", err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.value, rate.Value)
+			require.Equal(t, tt.takenAt, *rate.UpdatedAt)
+		})
+	}
+}
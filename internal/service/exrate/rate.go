@@ -13,6 +13,8 @@ type ExRate struct {
 	Value      string     `json:"value"`
 	ValueScale string     `json:"value_scale"`
 	UpdatedAt  *time.Time `json:"updated_at" format:"date-time"`
+	// Volume is 24h quote volume of the pair when source reports it, used to weight aggregate rate
+	Volume string `json:"-"`
 } //	@name	ExchangeRate
 
 type Rates struct {
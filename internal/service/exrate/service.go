@@ -2,7 +2,6 @@ package exrate
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
 	"slices"
@@ -11,14 +10,13 @@ import (
 	"time"
 
 	"github.com/dv-net/dv-merchant/internal/models"
-	"github.com/dv-net/dv-merchant/internal/util"
-	"github.com/redis/go-redis/v9"
 	"github.com/shopspring/decimal"
 
 	"github.com/dv-net/dv-merchant/internal/config"
+	"github.com/dv-net/dv-merchant/internal/event"
+	"github.com/dv-net/dv-merchant/internal/metrics"
 	"github.com/dv-net/dv-merchant/internal/service/currency"
 	"github.com/dv-net/dv-merchant/internal/storage"
-	"github.com/dv-net/dv-merchant/pkg/key_value"
 	"github.com/dv-net/dv-merchant/pkg/logger"
 )
 
@@ -29,6 +27,9 @@ type IExRateSource interface {
 	LoadRatesList(ctx context.Context, rateSource string) (*Rates, error)
 	LoadSources(ctx context.Context) ([]string, error)
 	GetStoreCurrencyRate(ctx context.Context, currencies []*models.Currency, source string, scale ...decimal.Decimal) (map[string]string, error)
+	GetRateAt(ctx context.Context, source, from, to string, at time.Time, scale ...decimal.Decimal) (*ExRate, error)
+	GetFiatRate(ctx context.Context, from, to string, at *time.Time) (string, error)
+	GetFreshCurrencyRate(ctx context.Context, source, from, to string, scale ...decimal.Decimal) (*ExRate, error)
 }
 
 // New creates new exchange rate source service
@@ -37,12 +38,23 @@ func New(
 	currencyService currency.ICurrency,
 	logger logger.Logger,
 	storage storage.IStorage,
+	eventListener event.IListener,
+	m *metrics.PrometheusMetrics,
 ) (IExRateSource, error) {
 	srv := &service{
 		storage:         storage,
 		currencyService: currencyService,
 		fetchInterval:   cfg.Exrate.FetchInterval,
 		fetchers:        make(map[string]fetcherData, 8),
+		aggregator:      newAggregator(cfg.Exrate.Aggregate),
+		aggregateMaxAge: cfg.Exrate.Aggregate.MaxAge,
+		history:         cfg.Exrate.History,
+		fiat:            cfg.Exrate.Fiat,
+		fiatCodes:       &fiatCodeSet{},
+		staleness:       cfg.Exrate.Staleness,
+		health:          newSourceHealth(time.Now()),
+		eventListener:   eventListener,
+		metrics:         m,
 		logger:          logger,
 	}
 
@@ -101,6 +113,22 @@ func New(
 		cfChan:  make(chan CurrencyFilter),
 	}
 
+	f = NewKrakenFetcher("https://api.kraken.com/0/public", proxies, httpClient, logger)
+	srv.fetchers[f.Source()] = fetcherData{
+		fetcher: f,
+		cfChan:  make(chan CurrencyFilter),
+	}
+
+	f = NewCoinbaseFetcher("https://api.coinbase.com/api/v3/brokerage/market/products?product_type=SPOT", proxies, httpClient, logger)
+	srv.fetchers[f.Source()] = fetcherData{
+		fetcher: f,
+		cfChan:  make(chan CurrencyFilter),
+	}
+
+	if cfg.Exrate.Fiat.Enabled {
+		srv.fiatFetcher = NewFiatFetcher(cfg.Exrate.Fiat.Feed, cfg.Exrate.Fiat.Format, httpClient, logger)
+	}
+
 	return srv, nil
 }
 
@@ -109,14 +137,25 @@ type service struct {
 	currencyService currency.ICurrency
 	fetchInterval   time.Duration
 	fetchers        map[string]fetcherData
+	aggregator      *aggregator
+	aggregateMaxAge time.Duration
+	history         config.ExrateHistory
+	fiat            config.ExrateFiat
+	fiatFetcher     IFetcher
+	fiatCodes       *fiatCodeSet
+	staleness       config.ExrateStaleness
+	health          *sourceHealth
+	eventListener   event.IListener
+	metrics         *metrics.PrometheusMetrics
 	logger          logger.Logger
 }
 
 func (srv *service) LoadSources(_ context.Context) ([]string, error) {
-	sources := make([]string, 0, len(srv.fetchers))
+	sources := make([]string, 0, len(srv.fetchers)+1)
 	for source := range srv.fetchers {
 		sources = append(sources, source)
 	}
+	sources = append(sources, models.RateSourceAggregate.String())
 	return sources, nil
 }
 
@@ -132,6 +171,12 @@ func (srv *service) Run(ctx context.Context) {
 	outChan := make(chan ExRate)
 	wg.Add(1)
 	go srv.rateKeeper(ctx, outChan, &wg)
+	wg.Add(1)
+	go srv.historyKeeper(ctx, &wg)
+	wg.Add(1)
+	go srv.fiatKeeper(ctx, &wg)
+	wg.Add(1)
+	go srv.stalenessMonitor(ctx, &wg)
 	for _, fd := range srv.fetchers {
 		wg.Add(1)
 		go srv.fetchWorker(ctx, fd.fetcher, fd.cfChan, outChan, &wg)
@@ -181,6 +226,7 @@ func (srv *service) rateKeeper(ctx context.Context, in <-chan ExRate, wg *sync.W
 					"value", rate.Value,
 				)
 			} else {
+				srv.health.touch(rate.Source, time.Now())
 				srv.logger.Debugw(
 					"exrate stored",
 					"source", rate.Source,
@@ -189,10 +235,27 @@ func (srv *service) rateKeeper(ctx context.Context, in <-chan ExRate, wg *sync.W
 					"value", rate.Value,
 				)
 			}
+			srv.storeAggregateRate(ctx, rate)
 		}
 	}
 }
 
+// storeAggregateRate publishes aggregate rate of the pair, it expires unless enough sources stay fresh
+func (srv *service) storeAggregateRate(ctx context.Context, rate ExRate) {
+	aggregated, ok := srv.aggregator.observe(rate, time.Now())
+	if !ok {
+		return
+	}
+
+	if err := srv.storage.CurrencyExchange().StoreRate(ctx, aggregated.Source, aggregated.From, aggregated.To, aggregated.Value, srv.aggregateMaxAge); err != nil {
+		srv.logger.Errorw(
+			"save aggregate exchange rate", "error", err,
+			"from", aggregated.From,
+			"to", aggregated.To,
+		)
+	}
+}
+
 func (srv *service) GetCurrencyRate(ctx context.Context, source, from, to string, scale ...decimal.Decimal) (v string, err error) {
 	if !models.RateSource(source).Valid() {
 		return "", fmt.Errorf("invalid source %s", source)
@@ -206,8 +269,14 @@ func (srv *service) GetCurrencyRate(ctx context.Context, source, from, to string
 		return "1", nil
 	}
 
-	if v, err = srv.storage.CurrencyExchange().GetRate(ctx, source, from, to); err != nil {
-		if errors.Is(err, key_value.ErrEntryNotFound) || errors.Is(err, redis.Nil) {
+	getRate := srv.storage.CurrencyExchange().GetRate
+	if source == models.RateSourceAggregate.String() {
+		// aggregate rate is not published without enough fresh sources, single source must not replace it
+		getRate = srv.storage.CurrencyExchange().GetSourceRate
+	}
+
+	if v, err = getRate(ctx, source, from, to); err != nil {
+		if isRateNotFound(err) {
 			err = &ExchangeRateNotFoundError{Source: source, From: from, To: to}
 		} else {
 			err = fmt.Errorf("get currency exchange rate: %w [%s/%s]", err, from, to)
@@ -273,11 +342,16 @@ func (srv *service) GetAllCurrencyRate(ctx context.Context, source string, scale
 			valScale = rateValue.Mul(multiplier)
 		}
 
+		var updatedAt *time.Time
+		if at, err := srv.storage.CurrencyExchange().GetRateUpdatedAt(ctx, source, pair.From, pair.To); err == nil {
+			updatedAt = &at
+		}
+
 		result = append(result, ExRate{
 			Source:     source,
 			From:       pair.From,
 			To:         pair.To,
-			UpdatedAt:  util.Pointer(time.Now().UTC()),
+			UpdatedAt:  updatedAt,
 			Value:      rateStr,
 			ValueScale: valScale.String(),
 		})
@@ -377,11 +451,12 @@ func (srv *service) GetStoreCurrencyRate(ctx context.Context, currencies []*mode
 			rates[cur.ID] = "1"
 			continue
 		}
-		rate, err := srv.GetCurrencyRate(ctx, source, cur.Code, "USDT", valScale)
+		// wallets are quoted only with fresh rates
+		rate, err := srv.GetFreshCurrencyRate(ctx, source, cur.Code, "USDT", valScale)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get currency rate: %w", err)
 		}
-		rates[cur.ID] = rate
+		rates[cur.ID] = rate.ValueScale
 	}
 	return rates, nil
 }
@@ -0,0 +1,222 @@
+package exrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/pkg/key_value"
+)
+
+// sourceHealth keeps the last update time and staleness of every rate source
+type sourceHealth struct {
+	mu        sync.Mutex
+	startedAt time.Time
+	updatedAt map[string]time.Time
+	stale     map[string]bool
+}
+
+func newSourceHealth(now time.Time) *sourceHealth {
+	return &sourceHealth{
+		startedAt: now,
+		updatedAt: make(map[string]time.Time),
+		stale:     make(map[string]bool),
+	}
+}
+
+func (h *sourceHealth) touch(source string, at time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.updatedAt[source] = at
+}
+
+// check returns the last update of the source, sources never updated are aged from service start,
+// changed is true when staleness differs from the previous check
+func (h *sourceHealth) check(source string, now time.Time, maxAge time.Duration) (updatedAt time.Time, stale, changed bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	updatedAt, ok := h.updatedAt[source]
+	since := updatedAt
+	if !ok {
+		since = h.startedAt
+	}
+
+	stale = now.Sub(since) > maxAge
+	changed = h.stale[source] != stale
+	h.stale[source] = stale
+
+	return updatedAt, stale, changed
+}
+
+// maxAge returns max age configured for the source
+func (srv *service) maxAge(source string) time.Duration {
+	if maxAge, ok := srv.staleness.SourceMaxAge[source]; ok && maxAge > 0 {
+		return maxAge
+	}
+	if source == FiatSource {
+		return srv.fiat.MaxAge
+	}
+	return srv.staleness.MaxAge
+}
+
+// stalenessMonitor reports age of every source and fires events when source goes stale or recovers
+func (srv *service) stalenessMonitor(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	interval := srv.staleness.CheckInterval
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			srv.checkStaleness(now)
+		}
+	}
+}
+
+func (srv *service) checkStaleness(now time.Time) {
+	sources := make([]string, 0, len(srv.fetchers)+1)
+	for source := range srv.fetchers {
+		sources = append(sources, source)
+	}
+	if srv.fiatFetcher != nil {
+		sources = append(sources, FiatSource)
+	}
+	slices.Sort(sources)
+
+	for _, source := range sources {
+		maxAge := srv.maxAge(source)
+		updatedAt, stale, changed := srv.health.check(source, now, maxAge)
+
+		if srv.metrics != nil {
+			if !updatedAt.IsZero() {
+				srv.metrics.ExrateSourceAge(source, now.Sub(updatedAt).Seconds())
+			}
+			srv.metrics.ExrateSourceStale(source, stale)
+		}
+
+		if !changed {
+			continue
+		}
+
+		if stale {
+			srv.logger.Warnw("exchange rate source is stale", "source", source, "updated_at", updatedAt, "max_age", maxAge)
+			if err := srv.eventListener.Fire(SourceStaleEvent{Source: source, UpdatedAt: updatedAt, MaxAge: maxAge}); err != nil {
+				srv.logger.Errorw("fire exrate source stale event", "error", err, "source", source)
+			}
+			continue
+		}
+
+		srv.logger.Infow("exchange rate source recovered", "source", source, "updated_at", updatedAt)
+		if err := srv.eventListener.Fire(SourceRecoveredEvent{Source: source, UpdatedAt: updatedAt}); err != nil {
+			srv.logger.Errorw("fire exrate source recovered event", "error", err, "source", source)
+		}
+	}
+}
+
+// GetFreshCurrencyRate returns rate not older than max age of the source.
+// Configured fallback source is quoted when the requested one is stale,
+// StaleExchangeRateError of the requested source is returned when both are.
+func (srv *service) GetFreshCurrencyRate(ctx context.Context, source, from, to string, scale ...decimal.Decimal) (*ExRate, error) {
+	if !models.RateSource(source).Valid() {
+		return nil, fmt.Errorf("invalid source %s", source)
+	}
+
+	if strings.EqualFold(to, models.CurrencyCodeUSD) {
+		to = models.CurrencyCodeUSDT
+	}
+
+	now := time.Now().UTC()
+	if strings.EqualFold(from, to) {
+		return &ExRate{Source: source, From: from, To: to, Value: "1", ValueScale: "1", UpdatedAt: &now}, nil
+	}
+
+	sources := []string{source}
+	if fallback := srv.staleness.Fallback; fallback != "" && fallback != source {
+		sources = append(sources, fallback)
+	}
+
+	var staleErr error
+	for _, src := range sources {
+		rate, err := srv.freshSourceRate(ctx, src, from, to, now, scale...)
+		if err == nil {
+			if src != source {
+				srv.logger.Warnw("stale rate source replaced with fallback", "source", source, "fallback", src, "from", from, "to", to)
+			}
+			return rate, nil
+		}
+
+		var stale *StaleExchangeRateError
+		if !errors.As(err, &stale) {
+			return nil, err
+		}
+		if staleErr == nil {
+			staleErr = err
+		}
+	}
+
+	return nil, staleErr
+}
+
+func (srv *service) freshSourceRate(ctx context.Context, source, from, to string, now time.Time, scale ...decimal.Decimal) (*ExRate, error) {
+	value, err := srv.storage.CurrencyExchange().GetSourceRate(ctx, source, from, to)
+	if err != nil {
+		if isRateNotFound(err) {
+			return nil, &StaleExchangeRateError{Source: source, From: from, To: to}
+		}
+		return nil, fmt.Errorf("get currency exchange rate: %w [%s/%s]", err, from, to)
+	}
+
+	updatedAt, err := srv.storage.CurrencyExchange().GetRateUpdatedAt(ctx, source, from, to)
+	if err != nil {
+		if isRateNotFound(err) {
+			return nil, &StaleExchangeRateError{Source: source, From: from, To: to}
+		}
+		return nil, fmt.Errorf("get currency exchange rate update time: %w [%s/%s]", err, from, to)
+	}
+
+	if now.Sub(updatedAt) > srv.maxAge(source) {
+		return nil, &StaleExchangeRateError{Source: source, From: from, To: to, UpdatedAt: updatedAt}
+	}
+
+	rateValue, err := decimal.NewFromString(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse rate: %w", err)
+	}
+
+	rateValue = normalizeStableRate(from, to, rateValue)
+	valScale := rateValue
+	if len(scale) > 0 {
+		multiplier := decimal.NewFromInt(100).Add(scale[0]).Div(decimal.NewFromInt(100))
+		valScale = rateValue.Mul(multiplier)
+	}
+
+	return &ExRate{
+		Source:     source,
+		From:       from,
+		To:         to,
+		Value:      rateValue.String(),
+		ValueScale: valScale.String(),
+		UpdatedAt:  &updatedAt,
+	}, nil
+}
+
+func isRateNotFound(err error) bool {
+	return errors.Is(err, key_value.ErrEntryNotFound) || errors.Is(err, redis.Nil)
+}
@@ -0,0 +1,32 @@
+package exrate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSourceHealth(t *testing.T) {
+	start := time.Now()
+	h := newSourceHealth(start)
+
+	_, stale, changed := h.check("binance", start.Add(time.Minute), 5*time.Minute)
+	require.False(t, stale)
+	require.False(t, changed)
+
+	updatedAt, stale, changed := h.check("binance", start.Add(6*time.Minute), 5*time.Minute)
+	require.True(t, stale, "source never updated is aged from start")
+	require.True(t, changed)
+	require.True(t, updatedAt.IsZero())
+
+	_, stale, changed = h.check("binance", start.Add(7*time.Minute), 5*time.Minute)
+	require.True(t, stale)
+	require.False(t, changed, "stale source is reported once")
+
+	h.touch("binance", start.Add(8*time.Minute))
+	updatedAt, stale, changed = h.check("binance", start.Add(9*time.Minute), 5*time.Minute)
+	require.False(t, stale)
+	require.True(t, changed)
+	require.Equal(t, start.Add(8*time.Minute), updatedAt)
+}
@@ -0,0 +1,38 @@
+package invoice
+
+import (
+	"time"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/storage/storecmn"
+
+	"github.com/shopspring/decimal"
+)
+
+type CreateDTO struct {
+	StoreExternalID string
+	CurrencyID      string
+	// Amount is a fixed crypto amount, mutually exclusive with AmountUSD
+	Amount decimal.NullDecimal
+	// AmountUSD is a fixed fiat amount converted to crypto at creation time
+	AmountUSD   decimal.NullDecimal
+	OrderID     *string
+	Description *string
+	Lifetime    time.Duration
+	IP          *string
+	Email       *string
+	Locale      *string
+}
+
+type ListDTO struct {
+	Statuses       []models.InvoiceStatus
+	OrderID        *string
+	Page, PageSize *uint32
+}
+
+type WithHistoryDTO struct {
+	Invoice *models.Invoice
+	History []*models.InvoiceStatusHistory
+}
+
+type ListResult = storecmn.FindResponseWithFullPagination[*models.Invoice]
@@ -0,0 +1,14 @@
+package invoice
+
+import "errors"
+
+var (
+	ErrInvoiceNotFound       = errors.New("invoice not found")
+	ErrInvoiceNotOpen        = errors.New("invoice is not open")
+	ErrInvalidAmount         = errors.New("exactly one of amount or amount_usd must be positive")
+	ErrInvalidLifetime       = errors.New("invoice lifetime exceeds allowed maximum")
+	ErrCurrencyNotAvailable  = errors.New("currency is not available for store")
+	ErrAddressBusy           = errors.New("customer already has an open invoice in this currency")
+	ErrDuplicateOrderID      = errors.New("invoice with this order id already exists")
+	ErrInvoiceAddressMissing = errors.New("deposit address for currency was not generated")
+)
@@ -0,0 +1,28 @@
+package invoice
+
+import (
+	"fmt"
+
+	"github.com/dv-net/dv-merchant/internal/event"
+	"github.com/dv-net/dv-merchant/internal/models"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const StatusChangedEventType = "invoice_status_changed"
+
+// StatusChangedEvent is fired on every invoice state change within the database transaction that caused it
+type StatusChangedEvent struct {
+	Invoice models.Invoice
+	History models.InvoiceStatusHistory
+	Tx      *models.Transaction
+	DBTx    pgx.Tx
+}
+
+func (e StatusChangedEvent) Type() event.Type {
+	return StatusChangedEventType
+}
+
+func (e StatusChangedEvent) String() string {
+	return fmt.Sprintf("InvoiceStatusChanged: invoice=%s, from=%s, to=%s", e.Invoice.ID, e.History.StatusFrom, e.History.StatusTo)
+}
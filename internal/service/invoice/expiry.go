@@ -0,0 +1,46 @@
+package invoice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/storage/repos"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (s *Service) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.ExpireCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.expireOverdue(ctx); err != nil {
+				s.log.Errorw("expire overdue invoices failed", "error", err)
+			}
+		case <-ctx.Done():
+			s.log.Info("invoice expiration worker finished by ctx")
+			return
+		}
+	}
+}
+
+func (s *Service) expireOverdue(ctx context.Context) error {
+	return repos.BeginTxFunc(ctx, s.storage.PSQLConn(), pgx.TxOptions{}, func(tx pgx.Tx) error {
+		overdue, err := s.storage.Invoices(repos.WithTx(tx)).GetOverdueForUpdate(ctx, expireBatchSize)
+		if err != nil {
+			return fmt.Errorf("fetch overdue invoices: %w", err)
+		}
+
+		for _, inv := range overdue {
+			if _, err = s.changeStatus(ctx, inv, models.InvoiceStatusExpired, tx); err != nil {
+				return fmt.Errorf("expire invoice %s: %w", inv.ID, err)
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,200 @@
+package invoice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dv-net/dv-merchant/internal/event"
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/payment_policy"
+	"github.com/dv-net/dv-merchant/internal/service/transactions"
+	"github.com/dv-net/dv-merchant/internal/storage/repos"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_invoice_payments"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_invoices"
+	"github.com/dv-net/dv-merchant/pkg/pgtypeutils"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+)
+
+// EvaluatePayment matches invoice payments and credits against the invoice price under store tolerance policy.
+// Paid crypto amount is valued at the rate locked on invoice creation.
+func EvaluatePayment(policy models.StorePaymentPolicy, inv *models.Invoice, paid decimal.Decimal) models.PaymentOutcome {
+	receivedUSD := inv.CreditAmountUsd
+	if inv.Amount.IsPositive() {
+		receivedUSD = receivedUSD.Add(paid.Mul(inv.AmountUsd).Div(inv.Amount))
+	}
+
+	outcome := payment_policy.Evaluate(policy, inv.AmountUsd, receivedUSD)
+	outcome.InvoiceID = &inv.ID
+
+	return outcome
+}
+
+// EvaluatePaymentStatus resolves invoice status by the evaluated payment outcome
+func EvaluatePaymentStatus(outcome models.PaymentOutcome) models.InvoiceStatus {
+	switch outcome.Result {
+	case models.PaymentOutcomeOverpaid:
+		return models.InvoiceStatusOverpaid
+	case models.PaymentOutcomePaid:
+		return models.InvoiceStatusPaid
+	}
+
+	if outcome.ReceivedAmountUSD.IsPositive() {
+		return models.InvoiceStatusPartiallyPaid
+	}
+
+	return models.InvoiceStatusPending
+}
+
+// handleDepositReceived matches confirmed deposit to the open invoice listening on the same address
+func (s *Service) handleDepositReceived(ev event.IEvent) error {
+	depositEv, ok := ev.(transactions.DepositReceivedEvent)
+	if !ok {
+		return fmt.Errorf("invalid event type %s", ev.Type())
+	}
+
+	ctx := context.Background()
+	// deposit events are delivered at least once, payment must not be counted twice
+	applied, err := s.storage.InvoicePayments(repos.WithTx(depositEv.DBTx)).ExistsByTransactionID(ctx, depositEv.Tx.ID)
+	if err != nil {
+		return fmt.Errorf("check applied invoice payment: %w", err)
+	}
+	if applied {
+		return nil
+	}
+
+	inv, err := s.storage.Invoices(repos.WithTx(depositEv.DBTx)).GetOpenByAddressForUpdate(ctx, repo_invoices.GetOpenByAddressForUpdateParams{
+		Address:    depositEv.Tx.ToAddress,
+		CurrencyID: depositEv.Tx.CurrencyID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("fetch open invoice: %w", err)
+	}
+
+	if inv.StoreID != depositEv.Store.ID {
+		s.log.Warnw("invoice store mismatch", "invoice_id", inv.ID, "tx_id", depositEv.Tx.ID)
+		return nil
+	}
+
+	return s.applyPayment(ctx, inv, depositEv.Tx, depositEv.DBTx)
+}
+
+func (s *Service) applyPayment(ctx context.Context, inv *models.Invoice, tx models.Transaction, dbTx pgx.Tx) error {
+	policy, err := s.policies.GetByStore(ctx, inv.StoreID, repos.WithTx(dbTx))
+	if err != nil {
+		return err
+	}
+
+	paid := inv.PaidAmount.Add(tx.Amount)
+	paidUSD := inv.PaidAmountUsd.Add(tx.AmountUsd.Decimal)
+
+	if _, err = s.storage.InvoicePayments(repos.WithTx(dbTx)).Create(ctx, repo_invoice_payments.CreateParams{
+		InvoiceID:     inv.ID,
+		TransactionID: tx.ID,
+		Amount:        tx.Amount,
+		AmountUsd:     tx.AmountUsd.Decimal,
+	}); err != nil {
+		return fmt.Errorf("create invoice payment: %w", err)
+	}
+
+	updated, err := s.updatePayment(ctx, inv, policy, paid, paidUSD, dbTx)
+	if err != nil {
+		return err
+	}
+
+	// further partial payments keep the status, they reach the store by the deposit webhook only
+	if updated.Status == inv.Status {
+		return nil
+	}
+
+	return s.recordStateChange(ctx, updated, inv.Status, &tx, dbTx)
+}
+
+func (s *Service) updatePayment(
+	ctx context.Context,
+	inv *models.Invoice,
+	policy models.StorePaymentPolicy,
+	paid, paidUSD decimal.Decimal,
+	dbTx pgx.Tx,
+) (*models.Invoice, error) {
+	outcome := EvaluatePayment(policy, inv, paid)
+	status := EvaluatePaymentStatus(outcome)
+
+	paidAt := inv.PaidAt
+	if !paidAt.Valid && (status == models.InvoiceStatusPaid || status == models.InvoiceStatusOverpaid) {
+		paidAt = pgtypeutils.EncodeTime(time.Now())
+	}
+
+	updated, err := s.storage.Invoices(repos.WithTx(dbTx)).UpdatePayment(ctx, repo_invoices.UpdatePaymentParams{
+		PaidAmount:        paid,
+		PaidAmountUsd:     paidUSD.Round(4),
+		Status:            status,
+		PaidAt:            paidAt,
+		LeftoverAmountUsd: outcome.LeftoverUSD.Round(4),
+		LeftoverAction:    outcome.LeftoverAction,
+		ID:                inv.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update invoice payment: %w", err)
+	}
+
+	return updated, nil
+}
+
+// applyCredits moves leftovers of earlier invoices of the same wallet, kept as credit by store policy, onto the new invoice
+func (s *Service) applyCredits(ctx context.Context, inv *models.Invoice, dbTx pgx.Tx) (*models.Invoice, error) {
+	credits, err := s.storage.Invoices(repos.WithTx(dbTx)).GetUnusedCreditsForUpdate(ctx, inv.WalletID)
+	if err != nil {
+		return nil, fmt.Errorf("fetch invoice credits: %w", err)
+	}
+	if len(credits) == 0 {
+		return inv, nil
+	}
+
+	creditUSD := decimal.Zero
+	ids := make([]uuid.UUID, 0, len(credits))
+	for _, credit := range credits {
+		creditUSD = creditUSD.Add(credit.LeftoverAmountUsd)
+		ids = append(ids, credit.ID)
+	}
+
+	if err = s.storage.Invoices(repos.WithTx(dbTx)).MarkCredited(ctx, repo_invoices.MarkCreditedParams{
+		CreditedToInvoiceID: uuid.NullUUID{UUID: inv.ID, Valid: true},
+		Ids:                 ids,
+	}); err != nil {
+		return nil, fmt.Errorf("mark invoice credits used: %w", err)
+	}
+
+	credited, err := s.storage.Invoices(repos.WithTx(dbTx)).SetCredit(ctx, repo_invoices.SetCreditParams{
+		CreditAmountUsd: creditUSD,
+		ID:              inv.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("set invoice credit: %w", err)
+	}
+
+	policy, err := s.policies.GetByStore(ctx, inv.StoreID, repos.WithTx(dbTx))
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.updatePayment(ctx, credited, policy, credited.PaidAmount, credited.PaidAmountUsd, dbTx)
+	if err != nil {
+		return nil, err
+	}
+
+	if updated.Status != credited.Status {
+		if err = s.recordStateChange(ctx, updated, credited.Status, nil, dbTx); err != nil {
+			return nil, err
+		}
+	}
+
+	return updated, nil
+}
@@ -0,0 +1,44 @@
+package invoice
+
+import (
+	"testing"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/payment_policy"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluatePaymentStatus(t *testing.T) {
+	tolerant := payment_policy.DefaultPolicy()
+	tolerant.Threshold = decimal.RequireFromString("1")
+
+	tests := []struct {
+		name   string
+		policy models.StorePaymentPolicy
+		paid   string
+		credit string
+		want   models.InvoiceStatus
+	}{
+		{name: "nothing paid", policy: payment_policy.DefaultPolicy(), paid: "0", credit: "0", want: models.InvoiceStatusPending},
+		{name: "partially paid", policy: payment_policy.DefaultPolicy(), paid: "0.0099", credit: "0", want: models.InvoiceStatusPartiallyPaid},
+		{name: "exact amount", policy: payment_policy.DefaultPolicy(), paid: "0.01", credit: "0", want: models.InvoiceStatusPaid},
+		{name: "overpaid", policy: payment_policy.DefaultPolicy(), paid: "0.011", credit: "0", want: models.InvoiceStatusOverpaid},
+		{name: "short within tolerance", policy: tolerant, paid: "0.00995", credit: "0", want: models.InvoiceStatusPaid},
+		{name: "covered by credit", policy: payment_policy.DefaultPolicy(), paid: "0.005", credit: "50", want: models.InvoiceStatusPaid},
+		{name: "credit only", policy: payment_policy.DefaultPolicy(), paid: "0", credit: "10", want: models.InvoiceStatusPartiallyPaid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inv := &models.Invoice{
+				Amount:          decimal.RequireFromString("0.01"),
+				AmountUsd:       decimal.RequireFromString("100"),
+				CreditAmountUsd: decimal.RequireFromString(tt.credit),
+			}
+			outcome := EvaluatePayment(tt.policy, inv, decimal.RequireFromString(tt.paid))
+			require.Equal(t, tt.want, EvaluatePaymentStatus(outcome))
+		})
+	}
+}
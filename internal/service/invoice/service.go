@@ -0,0 +1,341 @@
+package invoice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dv-net/dv-merchant/internal/config"
+	"github.com/dv-net/dv-merchant/internal/event"
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/currconv"
+	"github.com/dv-net/dv-merchant/internal/service/payment_policy"
+	"github.com/dv-net/dv-merchant/internal/service/rate_quote"
+	"github.com/dv-net/dv-merchant/internal/service/transactions"
+	"github.com/dv-net/dv-merchant/internal/service/wallet"
+	"github.com/dv-net/dv-merchant/internal/storage"
+	"github.com/dv-net/dv-merchant/internal/storage/repos"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_invoice_status_history"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_invoices"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_store_currencies"
+	"github.com/dv-net/dv-merchant/internal/storage/storecmn"
+	"github.com/dv-net/dv-merchant/pkg/dbutils/pgerror"
+	"github.com/dv-net/dv-merchant/pkg/logger"
+	"github.com/dv-net/dv-merchant/pkg/pgtypeutils"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+)
+
+type IInvoiceService interface {
+	Create(ctx context.Context, store *models.Store, dto CreateDTO) (*models.Invoice, error)
+	GetByStore(ctx context.Context, store *models.Store, id uuid.UUID) (*WithHistoryDTO, error)
+	List(ctx context.Context, store *models.Store, dto ListDTO) (*ListResult, error)
+	Cancel(ctx context.Context, store *models.Store, id uuid.UUID) (*models.Invoice, error)
+	Run(ctx context.Context)
+}
+
+type Service struct {
+	cfg           config.Invoices
+	storage       storage.IStorage
+	log           logger.Logger
+	eventListener event.IListener
+	wallets       wallet.IWalletService
+	currConv      currconv.ICurrencyConvertor
+	policies      payment_policy.IPaymentPolicyService
+	rateQuotes    rate_quote.IRateQuoteService
+}
+
+var _ IInvoiceService = (*Service)(nil)
+
+const expireBatchSize = 100
+
+func New(
+	cfg config.Invoices,
+	storage storage.IStorage,
+	log logger.Logger,
+	eventListener event.IListener,
+	wallets wallet.IWalletService,
+	currConv currconv.ICurrencyConvertor,
+	policies payment_policy.IPaymentPolicyService,
+	rateQuotes rate_quote.IRateQuoteService,
+) *Service {
+	srv := &Service{
+		cfg:           cfg,
+		storage:       storage,
+		log:           log,
+		eventListener: eventListener,
+		wallets:       wallets,
+		currConv:      currConv,
+		policies:      policies,
+		rateQuotes:    rateQuotes,
+	}
+
+	srv.eventListener.Register(transactions.DepositReceivedEventType, srv.handleDepositReceived)
+
+	return srv
+}
+
+func (s *Service) Create(ctx context.Context, store *models.Store, dto CreateDTO) (*models.Invoice, error) {
+	hasAmount := dto.Amount.Valid && dto.Amount.Decimal.IsPositive()
+	hasAmountUSD := dto.AmountUSD.Valid && dto.AmountUSD.Decimal.IsPositive()
+	if hasAmount == hasAmountUSD {
+		return nil, ErrInvalidAmount
+	}
+
+	lifetime := dto.Lifetime
+	if lifetime <= 0 {
+		lifetime = s.cfg.DefaultLifetime
+	}
+	if lifetime > s.cfg.MaxLifetime {
+		return nil, ErrInvalidLifetime
+	}
+
+	curr, err := s.storage.Currencies().GetByID(ctx, dto.CurrencyID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCurrencyNotAvailable
+		}
+		return nil, fmt.Errorf("fetch currency: %w", err)
+	}
+	if curr.IsFiat || !curr.Status {
+		return nil, ErrCurrencyNotAvailable
+	}
+
+	if _, err = s.storage.StoreCurrencies().FindByStoreID(ctx, repo_store_currencies.FindByStoreIDParams{
+		StoreID:    store.ID,
+		CurrencyID: curr.ID,
+	}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCurrencyNotAvailable
+		}
+		return nil, fmt.Errorf("fetch store currency: %w", err)
+	}
+
+	amount, amountUSD, err := s.lockAmounts(ctx, store, curr, dto)
+	if err != nil {
+		return nil, err
+	}
+
+	walletWithAddress, err := s.wallets.StoreWalletWithAddress(ctx, wallet.CreateStoreWalletWithAddressDTO{
+		StoreID:         store.ID,
+		StoreExternalID: dto.StoreExternalID,
+		IP:              dto.IP,
+		Email:           dto.Email,
+		UntrustedEmail:  dto.Email,
+		Locale:          dto.Locale,
+	}, amountUSD.String())
+	if err != nil {
+		return nil, fmt.Errorf("prepare wallet: %w", err)
+	}
+
+	var address *models.WalletAddress
+	for _, addr := range walletWithAddress.Address {
+		if addr.CurrencyID == curr.ID {
+			address = addr
+			break
+		}
+	}
+	if address == nil {
+		return nil, ErrInvoiceAddressMissing
+	}
+
+	var inv *models.Invoice
+	err = repos.BeginTxFunc(ctx, s.storage.PSQLConn(), pgx.TxOptions{}, func(tx pgx.Tx) error {
+		// overdue invoices not yet reached by the expiration worker must not keep the address busy
+		overdue, err := s.storage.Invoices(repos.WithTx(tx)).GetOverdueByAddressForUpdate(ctx, repo_invoices.GetOverdueByAddressForUpdateParams{
+			Address:    address.Address,
+			CurrencyID: curr.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("fetch overdue invoices: %w", err)
+		}
+		for _, prev := range overdue {
+			if _, err = s.changeStatus(ctx, prev, models.InvoiceStatusExpired, tx); err != nil {
+				return fmt.Errorf("expire invoice %s: %w", prev.ID, err)
+			}
+		}
+
+		inv, err = s.storage.Invoices(repos.WithTx(tx)).Create(ctx, repo_invoices.CreateParams{
+			StoreID:     store.ID,
+			WalletID:    walletWithAddress.ID,
+			CurrencyID:  curr.ID,
+			Address:     address.Address,
+			OrderID:     pgtypeutils.EncodeText(dto.OrderID),
+			Description: pgtypeutils.EncodeText(dto.Description),
+			Status:      models.InvoiceStatusPending,
+			Amount:      amount,
+			AmountUsd:   amountUSD,
+			ExpiresAt:   pgtypeutils.EncodeTime(time.Now().Add(lifetime)),
+		})
+		if err != nil {
+			var uniqueErr *pgerror.UniqueConstraintError
+			if errors.As(pgerror.ParseError(err), &uniqueErr) {
+				if uniqueErr.Constraint == "uq_invoices_store_order_id" {
+					return ErrDuplicateOrderID
+				}
+				return ErrAddressBusy
+			}
+			return fmt.Errorf("create invoice: %w", err)
+		}
+
+		// deposits to the invoice address are valued at the invoice rate until it expires
+		if _, err = s.rateQuotes.CreateForInvoice(ctx, store, inv, repos.WithTx(tx)); err != nil {
+			return err
+		}
+
+		inv, err = s.applyCredits(ctx, inv, tx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return inv, nil
+}
+
+// lockAmounts fixes both crypto and USD amounts of the invoice at the current store rate
+func (s *Service) lockAmounts(ctx context.Context, store *models.Store, curr *models.Currency, dto CreateDTO) (decimal.Decimal, decimal.Decimal, error) {
+	rate, err := s.currConv.Convert(ctx, currconv.ConvertDTO{
+		Source:     store.RateSource.String(),
+		From:       curr.Code,
+		To:         models.CurrencyCodeUSDT,
+		Amount:     decimal.NewFromInt(1).String(),
+		StableCoin: curr.IsStablecoin,
+		Scale:      &store.RateScale,
+	})
+	if err != nil {
+		return decimal.Zero, decimal.Zero, fmt.Errorf("fetch currency rate: %w", err)
+	}
+	if !rate.IsPositive() {
+		return decimal.Zero, decimal.Zero, fmt.Errorf("invalid currency rate %s for %s", rate, curr.Code)
+	}
+
+	if dto.Amount.Valid && dto.Amount.Decimal.IsPositive() {
+		amount := dto.Amount.Decimal.Round(int32(curr.Precision))
+		return amount, amount.Mul(rate).Round(4), nil
+	}
+
+	amountUSD := dto.AmountUSD.Decimal.Round(4)
+	// round crypto amount up so the customer never underpays the fiat price
+	amount := amountUSD.DivRound(rate, int32(curr.Precision)+1).RoundCeil(int32(curr.Precision))
+
+	return amount, amountUSD, nil
+}
+
+func (s *Service) GetByStore(ctx context.Context, store *models.Store, id uuid.UUID) (*WithHistoryDTO, error) {
+	inv, err := s.getStoreInvoice(ctx, store, id)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := s.storage.InvoiceStatusHistory().GetByInvoiceID(ctx, inv.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetch invoice history: %w", err)
+	}
+
+	return &WithHistoryDTO{
+		Invoice: inv,
+		History: history,
+	}, nil
+}
+
+func (s *Service) List(ctx context.Context, store *models.Store, dto ListDTO) (*ListResult, error) {
+	commonParams := storecmn.NewCommonFindParams()
+	commonParams.SetPage(dto.Page)
+	commonParams.SetPageSize(dto.PageSize)
+
+	return s.storage.Invoices().GetByStore(ctx, repo_invoices.GetByStoreParams{
+		CommonFindParams: *commonParams,
+		StoreID:          store.ID,
+		Statuses:         dto.Statuses,
+		OrderID:          dto.OrderID,
+	})
+}
+
+func (s *Service) Cancel(ctx context.Context, store *models.Store, id uuid.UUID) (*models.Invoice, error) {
+	if _, err := s.getStoreInvoice(ctx, store, id); err != nil {
+		return nil, err
+	}
+
+	var cancelled *models.Invoice
+	err := repos.BeginTxFunc(ctx, s.storage.PSQLConn(), pgx.TxOptions{}, func(tx pgx.Tx) error {
+		inv, err := s.storage.Invoices(repos.WithTx(tx)).GetByIDForUpdate(ctx, id)
+		if err != nil {
+			return fmt.Errorf("fetch invoice: %w", err)
+		}
+		if !inv.Status.IsOpen() {
+			return ErrInvoiceNotOpen
+		}
+
+		cancelled, err = s.changeStatus(ctx, inv, models.InvoiceStatusCancelled, tx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return cancelled, nil
+}
+
+func (s *Service) getStoreInvoice(ctx context.Context, store *models.Store, id uuid.UUID) (*models.Invoice, error) {
+	inv, err := s.storage.Invoices().GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvoiceNotFound
+		}
+		return nil, fmt.Errorf("fetch invoice: %w", err)
+	}
+	if inv.StoreID != store.ID {
+		return nil, ErrInvoiceNotFound
+	}
+
+	return inv, nil
+}
+
+// changeStatus moves invoice to the given status, records history and notifies listeners
+func (s *Service) changeStatus(ctx context.Context, inv *models.Invoice, status models.InvoiceStatus, dbTx pgx.Tx) (*models.Invoice, error) {
+	updated, err := s.storage.Invoices(repos.WithTx(dbTx)).UpdateStatus(ctx, repo_invoices.UpdateStatusParams{
+		Status: status,
+		ID:     inv.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update invoice status: %w", err)
+	}
+
+	if err = s.recordStateChange(ctx, updated, inv.Status, nil, dbTx); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (s *Service) recordStateChange(ctx context.Context, inv *models.Invoice, from models.InvoiceStatus, tx *models.Transaction, dbTx pgx.Tx) error {
+	var txID uuid.NullUUID
+	if tx != nil {
+		txID = uuid.NullUUID{UUID: tx.ID, Valid: true}
+	}
+
+	history, err := s.storage.InvoiceStatusHistory(repos.WithTx(dbTx)).Create(ctx, repo_invoice_status_history.CreateParams{
+		InvoiceID:     inv.ID,
+		StatusFrom:    from,
+		StatusTo:      inv.Status,
+		TransactionID: txID,
+	})
+	if err != nil {
+		return fmt.Errorf("create invoice history: %w", err)
+	}
+
+	if err = s.eventListener.Fire(StatusChangedEvent{
+		Invoice: *inv,
+		History: *history,
+		Tx:      tx,
+		DBTx:    dbTx,
+	}); err != nil {
+		return fmt.Errorf("fire invoice status changed: %w", err)
+	}
+
+	return nil
+}
@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"html"
 	"strconv"
+	"strings"
 
 	"github.com/dv-net/dv-merchant/internal/service/notify"
 	"github.com/dv-net/dv-merchant/internal/service/templater"
@@ -386,3 +388,147 @@ func (svc *Service) handleUserCryptoReceipt(_ context.Context, email string, enc
 	err = svc.mailerClient.Send(svc.mailerSettings.MailerSender, []string{email}, bytes.NewBuffer(bodyBytes))
 	return bodyBytes, err
 }
+
+// handleExrateSourceStale sends operational alert to root user, it has no localized template
+func (svc *Service) handleExrateSourceStale(_ context.Context, email string, encodedVariables []byte) ([]byte, error) {
+	pBody, err := notify.ParseNotificationBody[notify.ExrateSourceStaleData](encodedVariables)
+	if err != nil {
+		return nil, fmt.Errorf("parse exrate source stale payload: %w", err)
+	}
+
+	updatedAt := pBody.UpdatedAt
+	if updatedAt == "" {
+		updatedAt = "never since service start"
+	}
+
+	content := new(bytes.Buffer)
+	fmt.Fprintf(content,
+		"<p>Exchange rate source <b>%s</b> was not updated for longer than %s.</p>"+
+			"<p>Last update: %s.</p>"+
+			"<p>Wallets of stores using this source are not quoted until it recovers or fallback source is configured.</p>",
+		html.EscapeString(pBody.Source), html.EscapeString(pBody.MaxAge), html.EscapeString(updatedAt),
+	)
+
+	header := &templater.EmailHeader{
+		Sender:   svc.mailerSettings.MailerSender,
+		Receiver: email,
+		Subject:  fmt.Sprintf("Exchange rate source %s is stale", pBody.Source),
+	}
+	body, err := header.Build(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build email: %w", err)
+	}
+
+	bodyBytes := body.Bytes()
+	err = svc.mailerClient.Send(svc.mailerSettings.MailerSender, []string{email}, bytes.NewBuffer(bodyBytes))
+	return bodyBytes, err
+}
+
+// handleWithdrawalLimitExceeded sends security alert about refused withdrawal, it has no localized template
+func (svc *Service) handleWithdrawalLimitExceeded(_ context.Context, email string, encodedVariables []byte) ([]byte, error) {
+	pBody, err := notify.ParseNotificationBody[notify.WithdrawalLimitExceededData](encodedVariables)
+	if err != nil {
+		return nil, fmt.Errorf("parse withdrawal limit exceeded payload: %w", err)
+	}
+
+	scope := pBody.Scope
+	if pBody.ScopeValue != "" {
+		scope += " " + pBody.ScopeValue
+	}
+
+	content := new(bytes.Buffer)
+	fmt.Fprintf(content,
+		"<p>Withdrawal of <b>%s USD</b> in %s to <b>%s</b> was refused by the %s limit per %s.</p>",
+		html.EscapeString(pBody.AmountUSD), html.EscapeString(pBody.CurrencyID), html.EscapeString(pBody.AddressTo),
+		html.EscapeString(scope), html.EscapeString(pBody.Window),
+	)
+	if pBody.MaxAmountUSD != "" {
+		fmt.Fprintf(content, "<p>Amount used within the window: %s of %s USD.</p>",
+			html.EscapeString(pBody.UsedAmountUSD), html.EscapeString(pBody.MaxAmountUSD))
+	}
+	if pBody.MaxCount > 0 {
+		fmt.Fprintf(content, "<p>Withdrawals made within the window: %d of %d.</p>", pBody.UsedCount, pBody.MaxCount)
+	}
+	content.WriteString("<p>If you did not request this withdrawal, revoke your API keys and change your password.</p>")
+
+	header := &templater.EmailHeader{
+		Sender:   svc.mailerSettings.MailerSender,
+		Receiver: email,
+		Subject:  "Withdrawal limit exceeded",
+	}
+	body, err := header.Build(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build email: %w", err)
+	}
+
+	bodyBytes := body.Bytes()
+	err = svc.mailerClient.Send(svc.mailerSettings.MailerSender, []string{email}, bytes.NewBuffer(bodyBytes))
+	return bodyBytes, err
+}
+
+// handleAddressBookEntryAdded sends security alert about new withdrawal destination, it has no localized template
+func (svc *Service) handleAddressBookEntryAdded(_ context.Context, email string, encodedVariables []byte) ([]byte, error) {
+	pBody, err := notify.ParseNotificationBody[notify.AddressBookEntryAddedData](encodedVariables)
+	if err != nil {
+		return nil, fmt.Errorf("parse address book entry added payload: %w", err)
+	}
+
+	address := html.EscapeString(pBody.Address)
+	if pBody.Name != "" {
+		address = fmt.Sprintf("%s (%s)", address, html.EscapeString(pBody.Name))
+	}
+
+	content := new(bytes.Buffer)
+	fmt.Fprintf(content, "<p>Address <b>%s</b> was added to your address book for %s.</p>",
+		address, html.EscapeString(strings.Join(pBody.Currencies, ", ")))
+	fmt.Fprintf(content, "<p>Withdrawals to this address become available at %s.</p>", html.EscapeString(pBody.ActiveAt))
+	if pBody.RevokeLink != "" {
+		fmt.Fprintf(content,
+			"<p>If you did not add this address, <a href=\"%s\">revoke it</a> and change your password.</p>",
+			html.EscapeString(pBody.RevokeLink),
+		)
+	} else {
+		content.WriteString("<p>If you did not add this address, delete it from the address book and change your password.</p>")
+	}
+
+	header := &templater.EmailHeader{
+		Sender:   svc.mailerSettings.MailerSender,
+		Receiver: email,
+		Subject:  "New withdrawal address added",
+	}
+	body, err := header.Build(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build email: %w", err)
+	}
+
+	bodyBytes := body.Bytes()
+	err = svc.mailerClient.Send(svc.mailerSettings.MailerSender, []string{email}, bytes.NewBuffer(bodyBytes))
+	return bodyBytes, err
+}
+
+// handleWithdrawalAllowlistDisabling sends security alert about disabled withdrawal allowlist, it has no localized template
+func (svc *Service) handleWithdrawalAllowlistDisabling(_ context.Context, email string, encodedVariables []byte) ([]byte, error) {
+	pBody, err := notify.ParseNotificationBody[notify.WithdrawalAllowlistDisablingData](encodedVariables)
+	if err != nil {
+		return nil, fmt.Errorf("parse withdrawal allowlist disabling payload: %w", err)
+	}
+
+	content := new(bytes.Buffer)
+	content.WriteString("<p>Disabling of the withdrawal address allowlist was requested for your account.</p>")
+	fmt.Fprintf(content, "<p>Withdrawals to addresses missing in the address book become available at %s.</p>", html.EscapeString(pBody.DisabledAt))
+	content.WriteString("<p>If you did not request this, enable the allowlist again in the settings and change your password.</p>")
+
+	header := &templater.EmailHeader{
+		Sender:   svc.mailerSettings.MailerSender,
+		Receiver: email,
+		Subject:  "Withdrawal allowlist is being disabled",
+	}
+	body, err := header.Build(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build email: %w", err)
+	}
+
+	bodyBytes := body.Bytes()
+	err = svc.mailerClient.Send(svc.mailerSettings.MailerSender, []string{email}, bytes.NewBuffer(bodyBytes))
+	return bodyBytes, err
+}
@@ -81,6 +81,10 @@ func New(ctx context.Context, log logger.Logger, eventListener event.IListener,
 		models.NotificationTypeUserTestEmail:                  svc.handleUserTestEmail,
 		models.NotificationTypeTwoFactorAuthentication:        svc.handleTwoFactorAuthentication,
 		models.NotificationTypeUserCryptoReceipt:              svc.handleUserCryptoReceipt,
+		models.NotificationTypeExrateSourceStale:              svc.handleExrateSourceStale,
+		models.NotificationTypeWithdrawalLimitExceeded:        svc.handleWithdrawalLimitExceeded,
+		models.NotificationTypeAddressBookEntryAdded:          svc.handleAddressBookEntryAdded,
+		models.NotificationTypeWithdrawalAllowlistDisabling:   svc.handleWithdrawalAllowlistDisabling,
 	}
 
 	eventListener.Register(setting.MailerSettingsChanged, svc.handleMailerSettingsChanged)
@@ -281,6 +281,81 @@ func (d *UserAccessKeyChangedNotification) Encode() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// ExrateSourceStaleData is sent to root users when exchange rate source stops updating
+type ExrateSourceStaleData struct {
+	Language  string `json:"language"`
+	Source    string `json:"source"`
+	UpdatedAt string `json:"updated_at"`
+	MaxAge    string `json:"max_age"`
+}
+
+func (d *ExrateSourceStaleData) Encode() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	if err := json.NewEncoder(buf).Encode(d); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// WithdrawalLimitExceededData is sent to the user when a withdrawal is refused by velocity limit
+type WithdrawalLimitExceededData struct {
+	Language      string `json:"language"`
+	Scope         string `json:"scope"`
+	ScopeValue    string `json:"scope_value"`
+	Window        string `json:"window"`
+	MaxAmountUSD  string `json:"max_amount_usd"`
+	MaxCount      int32  `json:"max_count"`
+	UsedAmountUSD string `json:"used_amount_usd"`
+	UsedCount     int64  `json:"used_count"`
+	CurrencyID    string `json:"currency_id"`
+	AddressTo     string `json:"address_to"`
+	AmountUSD     string `json:"amount_usd"`
+}
+
+func (d *WithdrawalLimitExceededData) Encode() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	if err := json.NewEncoder(buf).Encode(d); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// AddressBookEntryAddedData is sent to the user when a destination is added to the address book
+type AddressBookEntryAddedData struct {
+	Language   string   `json:"language"`
+	Address    string   `json:"address"`
+	Name       string   `json:"name"`
+	Currencies []string `json:"currencies"`
+	ActiveAt   string   `json:"active_at"`
+	RevokeLink string   `json:"revoke_link"`
+}
+
+func (d *AddressBookEntryAddedData) Encode() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	if err := json.NewEncoder(buf).Encode(d); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// WithdrawalAllowlistDisablingData is sent to the user when disabling of the withdrawal allowlist is requested
+type WithdrawalAllowlistDisablingData struct {
+	Language   string `json:"language"`
+	DisabledAt string `json:"disabled_at"`
+}
+
+func (d *WithdrawalAllowlistDisablingData) Encode() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	if err := json.NewEncoder(buf).Encode(d); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
 func ParseNotificationBody[T any](data []byte) (T, error) {
 	t := NotificationBody[T]{}
 	v := &t.Body
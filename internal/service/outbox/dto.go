@@ -0,0 +1,17 @@
+package outbox
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+)
+
+type ReplayDTO struct {
+	ID          *uuid.UUID
+	EventType   *string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	Statuses    []models.EventOutboxStatus
+}
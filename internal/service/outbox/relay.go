@@ -0,0 +1,150 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/dv-net/dv-merchant/internal/event"
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/storage/repos"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_event_outbox"
+)
+
+// cleanupInterval is how often processed events older than retention are removed
+const cleanupInterval = time.Hour
+
+// Run publishes stored events to listener handlers until ctx is done.
+// Events are leased with SKIP LOCKED, so several instances share the outbox safely;
+// an event is marked processed in the transaction its handlers run in.
+func (s *Service) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.PollInterval)
+	defer ticker.Stop()
+
+	cleanup := time.NewTicker(cleanupInterval)
+	defer cleanup.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.relay(ctx)
+		case <-cleanup.C:
+			s.deleteProcessed(ctx)
+		}
+	}
+}
+
+func (s *Service) relay(ctx context.Context) {
+	events, err := s.storage.EventOutbox().Claim(ctx, repo_event_outbox.ClaimParams{
+		BatchSize:    s.cfg.BatchSize,
+		LeaseSeconds: durationSeconds(s.cfg.LeaseDuration),
+	})
+	if err != nil {
+		s.log.Errorw("claim outbox events", "error", err)
+		return
+	}
+
+	for _, ev := range events {
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err := s.publish(ctx, ev); err != nil {
+			s.markFailed(ctx, ev, err)
+		}
+	}
+}
+
+func (s *Service) publish(ctx context.Context, ev *models.EventOutbox) error {
+	decode, ok := s.decoder(event.Type(ev.EventType))
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrNoDecoder, ev.EventType)
+	}
+
+	return repos.BeginTxFunc(ctx, s.storage.PSQLConn(), pgx.TxOptions{}, func(tx pgx.Tx) error {
+		// lease may have expired and event published by another instance meanwhile
+		if _, err := s.storage.EventOutbox(repos.WithTx(tx)).LockPending(ctx, ev.ID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil
+			}
+			return fmt.Errorf("lock outbox event: %w", err)
+		}
+
+		domainEvent, err := decode(ev.Payload, tx)
+		if err != nil {
+			return fmt.Errorf("decode outbox event: %w", err)
+		}
+
+		if err = s.listener.Fire(domainEvent); err != nil {
+			return fmt.Errorf("handle outbox event: %w", err)
+		}
+
+		return s.storage.EventOutbox(repos.WithTx(tx)).MarkProcessed(ctx, ev.ID)
+	})
+}
+
+func (s *Service) markFailed(ctx context.Context, ev *models.EventOutbox, publishErr error) {
+	attempt := ev.Attempts + 1
+	status := models.EventOutboxStatusPending
+	if attempt >= s.cfg.MaxAttempts {
+		status = models.EventOutboxStatusFailed
+	}
+
+	s.log.Errorw("publish outbox event",
+		"error", publishErr,
+		"id", ev.ID,
+		"type", ev.EventType,
+		"attempt", attempt,
+		"status", status,
+	)
+
+	err := s.storage.EventOutbox().MarkAttemptFailed(ctx, repo_event_outbox.MarkAttemptFailedParams{
+		Status:       status,
+		LastError:    pgtype.Text{String: publishErr.Error(), Valid: true},
+		DelaySeconds: durationSeconds(retryDelay(s.cfg.RetryDelay, s.cfg.MaxRetryDelay, attempt)),
+		ID:           ev.ID,
+	})
+	if err != nil {
+		s.log.Errorw("mark outbox event attempt failed", "error", err, "id", ev.ID)
+	}
+}
+
+func (s *Service) deleteProcessed(ctx context.Context) {
+	deleted, err := s.storage.EventOutbox().DeleteProcessedBefore(ctx, pgtype.Timestamp{
+		Time:  time.Now().Add(-s.cfg.RetainProcessed),
+		Valid: true,
+	})
+	if err != nil {
+		s.log.Errorw("delete processed outbox events", "error", err)
+		return
+	}
+
+	if deleted > 0 {
+		s.log.Debugw("processed outbox events deleted", "count", deleted)
+	}
+}
+
+// retryDelay doubles base delay with every attempt, capped by maxDelay
+func retryDelay(base, maxDelay time.Duration, attempt int32) time.Duration {
+	if attempt <= 1 {
+		return min(base, maxDelay)
+	}
+
+	delay := float64(base) * math.Pow(2, float64(attempt-1))
+	if delay >= float64(maxDelay) {
+		return maxDelay
+	}
+
+	return time.Duration(delay)
+}
+
+func durationSeconds(d time.Duration) int32 {
+	return int32(min(math.Ceil(d.Seconds()), math.MaxInt32))
+}
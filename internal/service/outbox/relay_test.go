@@ -0,0 +1,150 @@
+package outbox
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	mxlogger "github.com/dv-net/mx/logger"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dv-net/dv-merchant/internal/config"
+	"github.com/dv-net/dv-merchant/internal/event"
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/webhook"
+	"github.com/dv-net/dv-merchant/internal/storage/repos"
+	"github.com/dv-net/dv-merchant/pkg/database"
+	"github.com/dv-net/dv-merchant/pkg/key_value"
+	"github.com/dv-net/dv-merchant/pkg/logger"
+)
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		name    string
+		attempt int32
+		want    time.Duration
+	}{
+		{name: "first attempt", attempt: 1, want: 5 * time.Second},
+		{name: "second attempt", attempt: 2, want: 10 * time.Second},
+		{name: "fifth attempt", attempt: 5, want: 80 * time.Second},
+		{name: "capped", attempt: 10, want: 10 * time.Minute},
+		{name: "overflow", attempt: 2000, want: 10 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, retryDelay(5*time.Second, 10*time.Minute, tt.attempt))
+		})
+	}
+}
+
+const testEventType event.Type = "OutboxRedeliveryTestEvent"
+
+type redeliveryEvent struct {
+	WebhookID uuid.UUID `json:"webhook_id"`
+	TxID      uuid.UUID `json:"tx_id"`
+	Key       string    `json:"key"`
+	DBTx      pgx.Tx    `json:"-"`
+}
+
+func (e redeliveryEvent) Type() event.Type       { return testEventType }
+func (e redeliveryEvent) String() string         { return string(testEventType) }
+func (e redeliveryEvent) IdempotencyKey() string { return e.Key }
+
+type testStorage struct {
+	repos.IRepository
+	pool *pgxpool.Pool
+}
+
+func (s testStorage) PSQLConn() *pgxpool.Pool { return s.pool }
+func (s testStorage) Close() error            { s.pool.Close(); return nil }
+
+// TestRelay_RedeliveredEvent needs migrated database in TEST_POSTGRES_DSN.
+// Replayed event queues the same webhook again, the second delivery must be processed without queue duplicate.
+func TestRelay_RedeliveredEvent(t *testing.T) {
+	dsn := os.Getenv("TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("TEST_POSTGRES_DSN is not set")
+	}
+
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, dsn)
+	require.NoError(t, err)
+
+	st := testStorage{
+		IRepository: repos.InitRepository(&database.PostgresClient{DB: pool}, key_value.NewInMemory()),
+		pool:        pool,
+	}
+	t.Cleanup(func() { _ = st.Close() })
+
+	log := logger.New("test", mxlogger.Config{Format: mxlogger.LoggerFormatJSON, Level: mxlogger.LogLevelError, Trace: mxlogger.LogLevelFatal})
+	whSvc, err := webhook.New(config.WebHook{
+		RequestTimeout: time.Second,
+		Dispatcher:     config.WebHookDispatcher{Workers: 1, EndpointConcurrency: 1, LeaseDuration: time.Hour},
+	}, st, log)
+	require.NoError(t, err)
+
+	listener := event.New()
+	listener.Register(testEventType, func(ev event.IEvent) error {
+		e := ev.(redeliveryEvent)
+		return whSvc.Send(&webhook.Message{
+			TxID:      e.TxID,
+			WebhookID: e.WebhookID,
+			Type:      models.WebhookEventPaymentReceived.String(),
+			Data:      []byte(`{}`),
+			Signature: "signature",
+		}, e.DBTx)
+	})
+
+	svc := New(config.Outbox{
+		BatchSize:     10,
+		LeaseDuration: time.Minute,
+		MaxAttempts:   3,
+		RetryDelay:    time.Second,
+		MaxRetryDelay: time.Second,
+	}, st, log, listener)
+	svc.RegisterDecoder(testEventType, func(payload []byte, dbTx pgx.Tx) (event.IEvent, error) {
+		var e redeliveryEvent
+		if err := json.Unmarshal(payload, &e); err != nil {
+			return nil, err
+		}
+		e.DBTx = dbTx
+		return e, nil
+	})
+
+	ev := redeliveryEvent{WebhookID: uuid.New(), TxID: uuid.New(), Key: uuid.NewString()}
+	t.Cleanup(func() {
+		_, _ = pool.Exec(ctx, "DELETE FROM webhook_send_queue WHERE transaction_id = $1", ev.TxID)
+		_, _ = pool.Exec(ctx, "DELETE FROM event_outbox WHERE idempotency_key = $1", ev.Key)
+	})
+
+	require.NoError(t, repos.BeginTxFunc(ctx, pool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		return svc.Enqueue(ctx, tx, ev)
+	}))
+
+	var eventID uuid.UUID
+	require.NoError(t, pool.QueryRow(ctx, "SELECT id FROM event_outbox WHERE idempotency_key = $1", ev.Key).Scan(&eventID))
+
+	for range 2 {
+		row, err := st.EventOutbox().LockPending(ctx, eventID)
+		require.NoError(t, err)
+		require.NoError(t, svc.publish(ctx, row))
+
+		var status string
+		require.NoError(t, pool.QueryRow(ctx, "SELECT status FROM event_outbox WHERE id = $1", eventID).Scan(&status))
+		require.Equal(t, models.EventOutboxStatusProcessed.String(), status)
+
+		replayed, err := svc.Replay(ctx, ReplayDTO{ID: &eventID, Statuses: []models.EventOutboxStatus{models.EventOutboxStatusProcessed}})
+		require.NoError(t, err)
+		require.EqualValues(t, 1, replayed)
+	}
+
+	var queued int
+	require.NoError(t, pool.QueryRow(ctx, "SELECT count(*) FROM webhook_send_queue WHERE transaction_id = $1", ev.TxID).Scan(&queued))
+	require.Equal(t, 1, queued)
+}
@@ -0,0 +1,132 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/goccy/go-json"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/dv-net/dv-merchant/internal/config"
+	"github.com/dv-net/dv-merchant/internal/event"
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/storage"
+	"github.com/dv-net/dv-merchant/internal/storage/repos"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_event_outbox"
+	"github.com/dv-net/dv-merchant/pkg/logger"
+)
+
+var (
+	ErrNoDecoder     = errors.New("outbox decoder is not registered")
+	ErrInvalidStatus = errors.New("only failed and processed events can be replayed")
+)
+
+// Event is stored in outbox and published to listener handlers by relay
+type Event interface {
+	event.IEvent
+	// IdempotencyKey identifies domain change, the same key is stored once
+	IdempotencyKey() string
+}
+
+// Decoder restores event from stored payload, dbTx is the transaction handlers run in
+type Decoder func(payload []byte, dbTx pgx.Tx) (event.IEvent, error)
+
+type IOutbox interface {
+	Enqueue(ctx context.Context, dbTx pgx.Tx, ev Event) error
+	RegisterDecoder(t event.Type, d Decoder)
+	Replay(ctx context.Context, dto ReplayDTO) (int64, error)
+	Run(ctx context.Context)
+}
+
+type Service struct {
+	cfg      config.Outbox
+	storage  storage.IStorage
+	log      logger.Logger
+	listener event.IListener
+	decoders map[event.Type]Decoder
+	mu       sync.RWMutex
+}
+
+var _ IOutbox = (*Service)(nil)
+
+func New(cfg config.Outbox, st storage.IStorage, l logger.Logger, listener event.IListener) *Service {
+	return &Service{
+		cfg:      cfg,
+		storage:  st,
+		log:      l,
+		listener: listener,
+		decoders: make(map[event.Type]Decoder),
+	}
+}
+
+// Enqueue stores event in the same transaction as the domain change
+func (s *Service) Enqueue(ctx context.Context, dbTx pgx.Tx, ev Event) error {
+	payload, err := json.Marshal(ev)
+	if err != nil {
+		return fmt.Errorf("marshal %s event: %w", ev.Type(), err)
+	}
+
+	inserted, err := s.storage.EventOutbox(repos.WithTx(dbTx)).Enqueue(ctx, repo_event_outbox.EnqueueParams{
+		EventType:      string(ev.Type()),
+		IdempotencyKey: ev.IdempotencyKey(),
+		Payload:        payload,
+	})
+	if err != nil {
+		return fmt.Errorf("enqueue %s event: %w", ev.Type(), err)
+	}
+
+	if inserted == 0 {
+		s.log.Debugw("outbox event already stored", "type", ev.Type(), "idempotency_key", ev.IdempotencyKey())
+	}
+
+	return nil
+}
+
+func (s *Service) RegisterDecoder(t event.Type, d Decoder) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.decoders[t] = d
+}
+
+func (s *Service) decoder(t event.Type) (Decoder, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	d, ok := s.decoders[t]
+	return d, ok
+}
+
+// Replay returns matching failed or processed events to pending state
+func (s *Service) Replay(ctx context.Context, dto ReplayDTO) (int64, error) {
+	statuses := dto.Statuses
+	if len(statuses) == 0 {
+		statuses = []models.EventOutboxStatus{models.EventOutboxStatusFailed}
+	}
+
+	params := repo_event_outbox.ReplayParams{
+		Statuses: make([]string, 0, len(statuses)),
+	}
+	for _, st := range statuses {
+		if st != models.EventOutboxStatusFailed && st != models.EventOutboxStatusProcessed {
+			return 0, fmt.Errorf("%w: %s", ErrInvalidStatus, st)
+		}
+		params.Statuses = append(params.Statuses, st.String())
+	}
+
+	if dto.ID != nil {
+		params.ID.UUID, params.ID.Valid = *dto.ID, true
+	}
+	if dto.EventType != nil {
+		params.EventType = pgtype.Text{String: *dto.EventType, Valid: true}
+	}
+	if dto.CreatedFrom != nil {
+		params.CreatedFrom = pgtype.Timestamp{Time: dto.CreatedFrom.UTC(), Valid: true}
+	}
+	if dto.CreatedTo != nil {
+		params.CreatedTo = pgtype.Timestamp{Time: dto.CreatedTo.UTC(), Valid: true}
+	}
+
+	return s.storage.EventOutbox().Replay(ctx, params)
+}
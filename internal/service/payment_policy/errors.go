@@ -0,0 +1,8 @@
+package payment_policy
+
+import "errors"
+
+var (
+	ErrInvalidThreshold = errors.New("tolerance threshold must not be negative")
+	ErrInvalidPercent   = errors.New("percent tolerance threshold must not exceed 100")
+)
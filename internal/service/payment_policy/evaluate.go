@@ -0,0 +1,100 @@
+package payment_policy
+
+import (
+	"github.com/dv-net/dv-merchant/internal/models"
+
+	"github.com/shopspring/decimal"
+)
+
+// usdScale is the precision expected and received amounts are rounded to before they are compared
+const usdScale = 2
+
+var hundred = decimal.NewFromInt(100)
+
+// DefaultPolicy is applied to stores which never configured tolerance: exact match, leftover ignored
+func DefaultPolicy() models.StorePaymentPolicy {
+	return models.StorePaymentPolicy{
+		ThresholdType:  models.ToleranceTypePercent,
+		Threshold:      decimal.Zero,
+		Rounding:       models.ToleranceRoundingHalf,
+		LeftoverAction: models.LeftoverActionIgnore,
+	}
+}
+
+// Evaluate matches received amount against the expected one. Differences within the tolerance
+// count as paid, positive leftover is reported together with the configured action.
+func Evaluate(policy models.StorePaymentPolicy, expectedUSD, receivedUSD decimal.Decimal) models.PaymentOutcome {
+	expected := round(expectedUSD, policy.Rounding)
+	received := round(receivedUSD, policy.Rounding)
+	diff := received.Sub(expected)
+
+	outcome := models.PaymentOutcome{
+		Result:            models.PaymentOutcomePaid,
+		ExpectedAmountUSD: expected,
+		ReceivedAmountUSD: received,
+		DifferenceUSD:     diff,
+		LeftoverUSD:       decimal.Zero,
+	}
+
+	switch {
+	case diff.IsZero():
+	case diff.Abs().LessThanOrEqual(tolerance(policy, expected)):
+		outcome.WithinTolerance = true
+	case diff.IsNegative():
+		outcome.Result = models.PaymentOutcomeUnderpaid
+	default:
+		outcome.Result = models.PaymentOutcomeOverpaid
+	}
+
+	if outcome.Result != models.PaymentOutcomeUnderpaid && diff.IsPositive() {
+		action := policy.LeftoverAction
+		outcome.LeftoverUSD = diff
+		outcome.LeftoverAction = &action
+	}
+
+	return outcome
+}
+
+// EvaluateMinimum checks a free top-up against store minimal payment; anything above minimum is a regular payment
+func EvaluateMinimum(policy models.StorePaymentPolicy, minimumUSD, receivedUSD decimal.Decimal) models.PaymentOutcome {
+	minimum := round(minimumUSD, policy.Rounding)
+	received := round(receivedUSD, policy.Rounding)
+	diff := received.Sub(minimum)
+
+	outcome := models.PaymentOutcome{
+		Result:            models.PaymentOutcomePaid,
+		ExpectedAmountUSD: minimum,
+		ReceivedAmountUSD: received,
+		DifferenceUSD:     diff,
+		LeftoverUSD:       decimal.Zero,
+	}
+
+	if diff.IsNegative() {
+		if diff.Abs().LessThanOrEqual(tolerance(policy, minimum)) {
+			outcome.WithinTolerance = true
+		} else {
+			outcome.Result = models.PaymentOutcomeUnderpaid
+		}
+	}
+
+	return outcome
+}
+
+func tolerance(policy models.StorePaymentPolicy, expectedUSD decimal.Decimal) decimal.Decimal {
+	if policy.ThresholdType == models.ToleranceTypeAbsolute {
+		return policy.Threshold
+	}
+
+	return expectedUSD.Mul(policy.Threshold).Div(hundred)
+}
+
+func round(amount decimal.Decimal, rounding models.ToleranceRounding) decimal.Decimal {
+	switch rounding {
+	case models.ToleranceRoundingUp:
+		return amount.RoundCeil(usdScale)
+	case models.ToleranceRoundingDown:
+		return amount.RoundFloor(usdScale)
+	default:
+		return amount.Round(usdScale)
+	}
+}
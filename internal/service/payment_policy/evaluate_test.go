@@ -0,0 +1,60 @@
+package payment_policy
+
+import (
+	"testing"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluate(t *testing.T) {
+	policy := func(thresholdType models.ToleranceType, threshold string, rounding models.ToleranceRounding) models.StorePaymentPolicy {
+		return models.StorePaymentPolicy{
+			ThresholdType:  thresholdType,
+			Threshold:      decimal.RequireFromString(threshold),
+			Rounding:       rounding,
+			LeftoverAction: models.LeftoverActionCreditNextInvoice,
+		}
+	}
+
+	tests := []struct {
+		name            string
+		policy          models.StorePaymentPolicy
+		expected        string
+		received        string
+		result          models.PaymentOutcomeResult
+		withinTolerance bool
+		leftover        string
+	}{
+		{name: "exact", policy: policy(models.ToleranceTypePercent, "0", models.ToleranceRoundingHalf), received: "100", result: models.PaymentOutcomePaid, leftover: "0"},
+		{name: "short without tolerance", policy: policy(models.ToleranceTypePercent, "0", models.ToleranceRoundingHalf), received: "99.5", result: models.PaymentOutcomeUnderpaid, leftover: "0"},
+		{name: "short within percent", policy: policy(models.ToleranceTypePercent, "0.5", models.ToleranceRoundingHalf), received: "99.5", result: models.PaymentOutcomePaid, withinTolerance: true, leftover: "0"},
+		{name: "short beyond percent", policy: policy(models.ToleranceTypePercent, "0.5", models.ToleranceRoundingHalf), received: "99.49", result: models.PaymentOutcomeUnderpaid, leftover: "0"},
+		{name: "short within absolute", policy: policy(models.ToleranceTypeAbsolute, "1", models.ToleranceRoundingHalf), received: "99", result: models.PaymentOutcomePaid, withinTolerance: true, leftover: "0"},
+		{name: "rounded up to exact", policy: policy(models.ToleranceTypePercent, "0", models.ToleranceRoundingUp), received: "99.991", result: models.PaymentOutcomePaid, leftover: "0"},
+		{name: "rounded down stays short", policy: policy(models.ToleranceTypePercent, "0", models.ToleranceRoundingDown), received: "99.999", result: models.PaymentOutcomeUnderpaid, leftover: "0"},
+		{name: "over within tolerance keeps leftover", policy: policy(models.ToleranceTypeAbsolute, "1", models.ToleranceRoundingHalf), received: "100.5", result: models.PaymentOutcomePaid, withinTolerance: true, leftover: "0.5"},
+		{name: "overpaid", policy: policy(models.ToleranceTypeAbsolute, "1", models.ToleranceRoundingHalf), received: "105", result: models.PaymentOutcomeOverpaid, leftover: "5"},
+		{name: "4 decimals exact half", policy: policy(models.ToleranceTypePercent, "0", models.ToleranceRoundingHalf), expected: "100.0049", received: "100.0049", result: models.PaymentOutcomePaid, leftover: "0"},
+		{name: "4 decimals exact up", policy: policy(models.ToleranceTypePercent, "0", models.ToleranceRoundingUp), expected: "100.0049", received: "100.0049", result: models.PaymentOutcomePaid, leftover: "0"},
+		{name: "4 decimals exact down", policy: policy(models.ToleranceTypePercent, "0", models.ToleranceRoundingDown), expected: "100.0049", received: "100.0049", result: models.PaymentOutcomePaid, leftover: "0"},
+		{name: "4 decimals crypto rounded up", policy: policy(models.ToleranceTypePercent, "0", models.ToleranceRoundingHalf), expected: "100.0049", received: "100.00493", result: models.PaymentOutcomePaid, leftover: "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expected := decimal.NewFromInt(100)
+			if tt.expected != "" {
+				expected = decimal.RequireFromString(tt.expected)
+			}
+
+			outcome := Evaluate(tt.policy, expected, decimal.RequireFromString(tt.received))
+			require.Equal(t, tt.result, outcome.Result)
+			require.Equal(t, tt.withinTolerance, outcome.WithinTolerance)
+			require.True(t, decimal.RequireFromString(tt.leftover).Equal(outcome.LeftoverUSD), "leftover %s", outcome.LeftoverUSD)
+			require.Equal(t, outcome.LeftoverUSD.IsPositive(), outcome.LeftoverAction != nil)
+		})
+	}
+}
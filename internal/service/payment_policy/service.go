@@ -0,0 +1,77 @@
+package payment_policy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/storage"
+	"github.com/dv-net/dv-merchant/internal/storage/repos"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_store_payment_policies"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+)
+
+type IPaymentPolicyService interface {
+	GetByStore(ctx context.Context, storeID uuid.UUID, opts ...repos.Option) (models.StorePaymentPolicy, error)
+	Update(ctx context.Context, storeID uuid.UUID, dto UpdateDTO) (*models.StorePaymentPolicy, error)
+}
+
+type UpdateDTO struct {
+	ThresholdType  models.ToleranceType
+	Threshold      decimal.Decimal
+	Rounding       models.ToleranceRounding
+	LeftoverAction models.LeftoverAction
+}
+
+type Service struct {
+	storage storage.IStorage
+}
+
+var _ IPaymentPolicyService = (*Service)(nil)
+
+func New(storage storage.IStorage) *Service {
+	return &Service{
+		storage: storage,
+	}
+}
+
+// GetByStore returns store tolerance policy or the default one when store has not configured it yet
+func (s *Service) GetByStore(ctx context.Context, storeID uuid.UUID, opts ...repos.Option) (models.StorePaymentPolicy, error) {
+	policy, err := s.storage.StorePaymentPolicies(opts...).GetByStoreID(ctx, storeID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			defaultPolicy := DefaultPolicy()
+			defaultPolicy.StoreID = storeID
+			return defaultPolicy, nil
+		}
+		return models.StorePaymentPolicy{}, fmt.Errorf("fetch store payment policy: %w", err)
+	}
+
+	return *policy, nil
+}
+
+func (s *Service) Update(ctx context.Context, storeID uuid.UUID, dto UpdateDTO) (*models.StorePaymentPolicy, error) {
+	if dto.Threshold.IsNegative() {
+		return nil, ErrInvalidThreshold
+	}
+	if dto.ThresholdType == models.ToleranceTypePercent && dto.Threshold.GreaterThan(hundred) {
+		return nil, ErrInvalidPercent
+	}
+
+	policy, err := s.storage.StorePaymentPolicies().Upsert(ctx, repo_store_payment_policies.UpsertParams{
+		StoreID:        storeID,
+		ThresholdType:  dto.ThresholdType,
+		Threshold:      dto.Threshold,
+		Rounding:       dto.Rounding,
+		LeftoverAction: dto.LeftoverAction,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update store payment policy: %w", err)
+	}
+
+	return policy, nil
+}
@@ -0,0 +1,9 @@
+package rate_quote
+
+import "errors"
+
+var (
+	ErrQuoteNotFound        = errors.New("rate quote not found")
+	ErrInvalidAmount        = errors.New("amount_usd must be positive")
+	ErrCurrencyNotAvailable = errors.New("currency is not available for store")
+)
@@ -0,0 +1,215 @@
+package rate_quote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dv-net/dv-merchant/internal/config"
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/exrate"
+	"github.com/dv-net/dv-merchant/internal/storage"
+	"github.com/dv-net/dv-merchant/internal/storage/repos"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_rate_quotes"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_store_currencies"
+	"github.com/dv-net/dv-merchant/pkg/pgtypeutils"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+)
+
+type IRateQuoteService interface {
+	Create(ctx context.Context, store *models.Store, walletID uuid.UUID, dto CreateDTO) (*models.RateQuote, error)
+	CreateForInvoice(ctx context.Context, store *models.Store, inv *models.Invoice, opts ...repos.Option) (*models.RateQuote, error)
+	GetByWallet(ctx context.Context, walletID, id uuid.UUID) (*models.RateQuote, error)
+	GetLocked(ctx context.Context, walletID uuid.UUID, currencyID string, at time.Time, opts ...repos.Option) (*models.RateQuote, error)
+	Use(ctx context.Context, quote *models.RateQuote, amount decimal.Decimal, opts ...repos.Option) error
+}
+
+type CreateDTO struct {
+	CurrencyID string
+	// AmountUSD is converted to crypto amount at the quoted rate, deposits above it are valued at the live rate
+	AmountUSD decimal.Decimal
+}
+
+type Service struct {
+	cfg     config.RateQuotes
+	storage storage.IStorage
+	exRate  exrate.IExRateSource
+}
+
+var _ IRateQuoteService = (*Service)(nil)
+
+func New(cfg config.RateQuotes, storage storage.IStorage, exRate exrate.IExRateSource) *Service {
+	return &Service{
+		cfg:     cfg,
+		storage: storage,
+		exRate:  exRate,
+	}
+}
+
+// Create locks current store rate of the currency for the wallet payer until quote TTL passes
+func (s *Service) Create(ctx context.Context, store *models.Store, walletID uuid.UUID, dto CreateDTO) (*models.RateQuote, error) {
+	if !dto.AmountUSD.IsPositive() {
+		return nil, ErrInvalidAmount
+	}
+
+	curr, err := s.storage.Currencies().GetByID(ctx, dto.CurrencyID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCurrencyNotAvailable
+		}
+		return nil, fmt.Errorf("fetch currency: %w", err)
+	}
+	if curr.IsFiat || !curr.Status {
+		return nil, ErrCurrencyNotAvailable
+	}
+
+	if _, err = s.storage.StoreCurrencies().FindByStoreID(ctx, repo_store_currencies.FindByStoreIDParams{
+		StoreID:    store.ID,
+		CurrencyID: curr.ID,
+	}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCurrencyNotAvailable
+		}
+		return nil, fmt.Errorf("fetch store currency: %w", err)
+	}
+
+	source, rate, err := s.currentRate(ctx, store, curr)
+	if err != nil {
+		return nil, err
+	}
+
+	amountUSD, amount := quoteAmount(rate, dto.AmountUSD, curr.Precision)
+	quote, err := s.storage.RateQuotes().Create(ctx, repo_rate_quotes.CreateParams{
+		StoreID:    store.ID,
+		WalletID:   walletID,
+		CurrencyID: curr.ID,
+		Source:     source,
+		Rate:       rate,
+		Amount:     decimal.NullDecimal{Decimal: amount, Valid: true},
+		AmountUsd:  decimal.NullDecimal{Decimal: amountUSD, Valid: true},
+		ExpiresAt:  pgtypeutils.EncodeTime(time.Now().Add(s.ttl())),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create rate quote: %w", err)
+	}
+
+	return quote, nil
+}
+
+// CreateForInvoice stores the rate invoice amounts were locked at, the quote lives as long as the invoice
+func (s *Service) CreateForInvoice(ctx context.Context, store *models.Store, inv *models.Invoice, opts ...repos.Option) (*models.RateQuote, error) {
+	if !inv.Amount.IsPositive() {
+		return nil, ErrInvalidAmount
+	}
+
+	quote, err := s.storage.RateQuotes(opts...).Create(ctx, repo_rate_quotes.CreateParams{
+		StoreID:    store.ID,
+		WalletID:   inv.WalletID,
+		InvoiceID:  uuid.NullUUID{UUID: inv.ID, Valid: true},
+		CurrencyID: inv.CurrencyID,
+		Source:     store.RateSource.String(),
+		Rate:       inv.AmountUsd.Div(inv.Amount),
+		Amount:     decimal.NullDecimal{Decimal: inv.Amount, Valid: true},
+		AmountUsd:  decimal.NullDecimal{Decimal: inv.AmountUsd, Valid: true},
+		ExpiresAt:  inv.ExpiresAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create invoice rate quote: %w", err)
+	}
+
+	return quote, nil
+}
+
+func (s *Service) GetByWallet(ctx context.Context, walletID, id uuid.UUID) (*models.RateQuote, error) {
+	quote, err := s.storage.RateQuotes().GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrQuoteNotFound
+		}
+		return nil, fmt.Errorf("fetch rate quote: %w", err)
+	}
+
+	if quote.WalletID != walletID {
+		return nil, ErrQuoteNotFound
+	}
+
+	return quote, nil
+}
+
+// GetLocked returns the latest quote of the wallet currency which was active at given time,
+// the quote stays locked until the transaction of opts ends
+func (s *Service) GetLocked(ctx context.Context, walletID uuid.UUID, currencyID string, at time.Time, opts ...repos.Option) (*models.RateQuote, error) {
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	quote, err := s.storage.RateQuotes(opts...).GetLocked(ctx, repo_rate_quotes.GetLockedParams{
+		WalletID:   walletID,
+		CurrencyID: currencyID,
+		At:         pgtypeutils.EncodeTime(at),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrQuoteNotFound
+		}
+		return nil, fmt.Errorf("fetch locked rate quote: %w", err)
+	}
+
+	return quote, nil
+}
+
+// Use takes the amount valued at the quote rate from the quoted amount left
+func (s *Service) Use(ctx context.Context, quote *models.RateQuote, amount decimal.Decimal, opts ...repos.Option) error {
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	if err := s.storage.RateQuotes(opts...).AddUsedAmount(ctx, repo_rate_quotes.AddUsedAmountParams{
+		Amount: amount,
+		ID:     quote.ID,
+	}); err != nil {
+		return fmt.Errorf("use rate quote: %w", err)
+	}
+
+	return nil
+}
+
+// currentRate returns USD price of one currency unit with store scale applied, stale rates are never quoted
+func (s *Service) currentRate(ctx context.Context, store *models.Store, curr *models.Currency) (string, decimal.Decimal, error) {
+	if curr.IsStablecoin {
+		return store.RateSource.String(), decimal.NewFromInt(1), nil
+	}
+
+	rate, err := s.exRate.GetFreshCurrencyRate(ctx, store.RateSource.String(), curr.Code, models.CurrencyCodeUSD, store.RateScale)
+	if err != nil {
+		return "", decimal.Zero, fmt.Errorf("fetch currency rate: %w", err)
+	}
+
+	value, err := decimal.NewFromString(rate.ValueScale)
+	if err != nil {
+		return "", decimal.Zero, fmt.Errorf("parse currency rate: %w", err)
+	}
+	if !value.IsPositive() {
+		return "", decimal.Zero, fmt.Errorf("invalid currency rate %s for %s", value, curr.Code)
+	}
+
+	return rate.Source, value, nil
+}
+
+func (s *Service) ttl() time.Duration {
+	if s.cfg.TTL <= 0 {
+		return 15 * time.Minute
+	}
+	return s.cfg.TTL
+}
+
+// quoteAmount returns USD amount and crypto amount to pay for it at given rate,
+// crypto amount is rounded up so the payer never underpays the quoted price
+func quoteAmount(rate, amountUSD decimal.Decimal, precision int16) (decimal.Decimal, decimal.Decimal) {
+	amountUSD = amountUSD.Round(4)
+	return amountUSD, amountUSD.DivRound(rate, int32(precision)+1).RoundCeil(int32(precision))
+}
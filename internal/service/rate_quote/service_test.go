@@ -0,0 +1,52 @@
+package rate_quote
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuoteAmount(t *testing.T) {
+	tests := []struct {
+		name           string
+		rate           string
+		amountUSD      string
+		precision      int16
+		expectedUSD    string
+		expectedAmount string
+	}{
+		{
+			name:           "exact",
+			rate:           "2",
+			amountUSD:      "10",
+			precision:      6,
+			expectedUSD:    "10",
+			expectedAmount: "5",
+		},
+		{
+			name:           "rounded up",
+			rate:           "3",
+			amountUSD:      "10",
+			precision:      6,
+			expectedUSD:    "10",
+			expectedAmount: "3.333334",
+		},
+		{
+			name:           "usd rounded to four places",
+			rate:           "65000",
+			amountUSD:      "100.00005",
+			precision:      8,
+			expectedUSD:    "100.0001",
+			expectedAmount: "0.00153847",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amountUSD, amount := quoteAmount(decimal.RequireFromString(tt.rate), decimal.RequireFromString(tt.amountUSD), tt.precision)
+			require.Equal(t, tt.expectedUSD, amountUSD.String())
+			require.Equal(t, tt.expectedAmount, amount.String())
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package refund
+
+import (
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/storage/storecmn"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type CreateDTO struct {
+	TransactionID uuid.UUID
+	// Amount defaults to the not yet refunded part of the transaction
+	Amount decimal.NullDecimal
+	// AddressTo defaults to the transaction source address
+	AddressTo   *string
+	RequestedBy uuid.NullUUID
+}
+
+type ListDTO struct {
+	StoreID        *uuid.UUID
+	TransactionID  *uuid.UUID
+	Statuses       []models.RefundStatus
+	Reasons        []models.RefundReason
+	Page, PageSize *uint32
+}
+
+type WithHistoryDTO struct {
+	Refund  *models.Refund
+	History []*models.RefundStatusHistory
+}
+
+type ListResult = storecmn.FindResponseWithFullPagination[*models.Refund]
@@ -0,0 +1,14 @@
+package refund
+
+import "errors"
+
+var (
+	ErrRefundNotFound           = errors.New("refund not found")
+	ErrRefundInProgress         = errors.New("transaction already has a refund in progress")
+	ErrRefundNotPending         = errors.New("refund is not pending approval")
+	ErrTransactionNotFound      = errors.New("transaction not found")
+	ErrTransactionNotRefundable = errors.New("only deposit transactions can be refunded")
+	ErrRefundAmountExceeded     = errors.New("refund amount exceeds the not yet refunded transaction amount")
+	ErrRefundAddressRequired    = errors.New("refund address is required")
+	ErrInvalidRefundAddress     = errors.New("refund address is not valid for transaction blockchain")
+)
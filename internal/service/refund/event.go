@@ -0,0 +1,27 @@
+package refund
+
+import (
+	"fmt"
+
+	"github.com/dv-net/dv-merchant/internal/event"
+	"github.com/dv-net/dv-merchant/internal/models"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const StatusChangedEventType = "refund_status_changed"
+
+// StatusChangedEvent is fired on refund creation and every state change within the database transaction that caused it
+type StatusChangedEvent struct {
+	Refund  models.Refund
+	History models.RefundStatusHistory
+	DBTx    pgx.Tx
+}
+
+func (e StatusChangedEvent) Type() event.Type {
+	return StatusChangedEventType
+}
+
+func (e StatusChangedEvent) String() string {
+	return fmt.Sprintf("RefundStatusChanged: refund=%s, to=%s", e.Refund.ID, e.History.StatusTo)
+}
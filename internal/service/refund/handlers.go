@@ -0,0 +1,148 @@
+package refund
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dv-net/dv-merchant/internal/event"
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/aml"
+	"github.com/dv-net/dv-merchant/internal/service/invoice"
+	"github.com/dv-net/dv-merchant/internal/service/transactions"
+	"github.com/dv-net/dv-merchant/internal/storage/repos"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_refunds"
+	"github.com/dv-net/dv-merchant/pkg/pgtypeutils"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+)
+
+// handleDepositBlocked requests refund of the whole deposit back to its source address
+func (s *Service) handleDepositBlocked(ev event.IEvent) error {
+	blockedEv, ok := ev.(aml.DepositBlockedEvent)
+	if !ok {
+		return fmt.Errorf("invalid event type %s", ev.Type())
+	}
+
+	ctx := context.Background()
+
+	tx, err := s.storage.Transactions(repos.WithTx(blockedEv.DBTx)).GetById(ctx, blockedEv.TransactionID)
+	if err != nil {
+		return fmt.Errorf("fetch transaction: %w", err)
+	}
+
+	return s.createAutomatic(ctx, tx, createParams{
+		reason: models.RefundReasonAMLBlocked,
+	}, blockedEv.DBTx)
+}
+
+// handleInvoiceStatusChanged requests refund of the overpaid part when store policy asks for it
+func (s *Service) handleInvoiceStatusChanged(ev event.IEvent) error {
+	changedEv, ok := ev.(invoice.StatusChangedEvent)
+	if !ok {
+		return fmt.Errorf("invalid event type %s", ev.Type())
+	}
+
+	inv := changedEv.Invoice
+	if changedEv.Tx == nil ||
+		changedEv.History.StatusTo != models.InvoiceStatusOverpaid ||
+		inv.LeftoverAction == nil || *inv.LeftoverAction != models.LeftoverActionRefundRequest ||
+		!inv.LeftoverAmountUsd.IsPositive() {
+		return nil
+	}
+
+	ctx := context.Background()
+
+	curr, err := s.storage.Currencies(repos.WithTx(changedEv.DBTx)).GetByID(ctx, changedEv.Tx.CurrencyID)
+	if err != nil {
+		return fmt.Errorf("fetch currency: %w", err)
+	}
+
+	amount := overpaidAmount(changedEv.Tx, inv.LeftoverAmountUsd, int32(curr.Precision))
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	return s.createAutomatic(ctx, changedEv.Tx, createParams{
+		reason:    models.RefundReasonOverpayment,
+		amount:    decimal.NullDecimal{Decimal: amount, Valid: true},
+		invoiceID: uuid.NullUUID{UUID: inv.ID, Valid: true},
+	}, changedEv.DBTx)
+}
+
+// overpaidAmount converts leftover back to crypto at the deposit rate, never exceeding the deposit itself
+func overpaidAmount(tx *models.Transaction, leftoverUSD decimal.Decimal, precision int32) decimal.Decimal {
+	if !tx.AmountUsd.Valid || !tx.AmountUsd.Decimal.IsPositive() {
+		return decimal.Zero
+	}
+
+	amount := leftoverUSD.Mul(tx.Amount).Div(tx.AmountUsd.Decimal).RoundFloor(precision)
+	return decimal.Min(amount, tx.Amount)
+}
+
+// createAutomatic requests refund once per transaction and reason, keeping deposit processing going on validation errors
+func (s *Service) createAutomatic(ctx context.Context, tx *models.Transaction, params createParams, dbTx pgx.Tx) error {
+	existing, err := s.storage.Refunds(repos.WithTx(dbTx)).GetByParams(ctx, repo_refunds.GetByParamsParams{
+		TransactionID: &tx.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("fetch transaction refunds: %w", err)
+	}
+	// the insert must not hit the active refund unique index, it would abort the deposit database transaction
+	for _, refund := range existing.Items {
+		if refund.Reason == params.reason || refund.Status.IsActive() {
+			return nil
+		}
+	}
+
+	if _, err = s.create(ctx, tx, params, dbTx); err != nil {
+		if errors.Is(err, ErrRefundAmountExceeded) ||
+			errors.Is(err, ErrRefundAddressRequired) ||
+			errors.Is(err, ErrInvalidRefundAddress) ||
+			errors.Is(err, ErrTransactionNotRefundable) {
+			s.log.Warnw("automatic refund skipped", "tx_id", tx.ID, "reason", params.reason, "error", err)
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// handleWithdrawalReceived completes refund once its withdrawal reached the payer
+func (s *Service) handleWithdrawalReceived(ev event.IEvent) error {
+	receivedEv, ok := ev.(transactions.WithdrawalFromProcessingReceivedEvent)
+	if !ok {
+		return fmt.Errorf("invalid event type %s", ev.Type())
+	}
+
+	withdrawalID, err := uuid.Parse(receivedEv.WithdrawalID)
+	if err != nil {
+		return fmt.Errorf("parse withdrawal id: %w", err)
+	}
+
+	ctx := context.Background()
+
+	refund, err := s.storage.Refunds(repos.WithTx(receivedEv.DBTx)).GetByWithdrawalIDForUpdate(ctx, uuid.NullUUID{UUID: withdrawalID, Valid: true})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("fetch refund: %w", err)
+	}
+	if refund.Status != models.RefundStatusProcessing {
+		return nil
+	}
+
+	completed, err := s.storage.Refunds(repos.WithTx(receivedEv.DBTx)).SetCompleted(ctx, repo_refunds.SetCompletedParams{
+		RefundTxHash: pgtypeutils.EncodeText(&receivedEv.Tx.TxHash),
+		ID:           refund.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("complete refund: %w", err)
+	}
+
+	return s.recordStateChange(ctx, completed, &refund.Status, uuid.NullUUID{}, receivedEv.DBTx)
+}
@@ -0,0 +1,51 @@
+package refund
+
+import (
+	"testing"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOverpaidAmount(t *testing.T) {
+	tx := func(amount, amountUSD string) *models.Transaction {
+		res := &models.Transaction{Amount: decimal.RequireFromString(amount)}
+		if amountUSD != "" {
+			res.AmountUsd = decimal.NullDecimal{Decimal: decimal.RequireFromString(amountUSD), Valid: true}
+		}
+		return res
+	}
+
+	tests := []struct {
+		name      string
+		tx        *models.Transaction
+		leftover  string
+		precision int32
+		expected  string
+	}{
+		{name: "deposit rate", tx: tx("0.01", "600"), leftover: "60", precision: 8, expected: "0.001"},
+		{name: "rounded down", tx: tx("3", "10"), leftover: "1", precision: 6, expected: "0.3"},
+		{name: "rounded down to precision", tx: tx("7", "3"), leftover: "1", precision: 2, expected: "2.33"},
+		{name: "capped by deposit", tx: tx("1", "10"), leftover: "15", precision: 8, expected: "1"},
+		{name: "unknown usd amount", tx: tx("1", ""), leftover: "5", precision: 8, expected: "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := overpaidAmount(tt.tx, decimal.RequireFromString(tt.leftover), tt.precision)
+			require.True(t, decimal.RequireFromString(tt.expected).Equal(res), "got %s", res)
+		})
+	}
+}
+
+func TestAmountUSD(t *testing.T) {
+	tx := &models.Transaction{
+		Amount:    decimal.RequireFromString("2"),
+		AmountUsd: decimal.NullDecimal{Decimal: decimal.RequireFromString("100.5"), Valid: true},
+	}
+
+	require.True(t, decimal.RequireFromString("50.25").Equal(amountUSD(tx, decimal.RequireFromString("1"))))
+	require.True(t, decimal.Zero.Equal(amountUSD(&models.Transaction{Amount: decimal.NewFromInt(1)}, decimal.NewFromInt(1))))
+}
@@ -0,0 +1,125 @@
+package refund
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/storage/repos"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_withdrawal_approvals"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_withdrawal_from_processing_wallets"
+	"github.com/dv-net/dv-merchant/pkg/pgtypeutils"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+func (s *Service) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.StatusCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.failBrokenTransfers(ctx); err != nil {
+				s.log.Errorw("check processing refunds failed", "error", err)
+			}
+			if err := s.resumeApproved(ctx); err != nil {
+				s.log.Errorw("resume approved refunds failed", "error", err)
+			}
+		case <-ctx.Done():
+			s.log.Info("refund status worker finished by ctx")
+			return
+		}
+	}
+}
+
+// failBrokenTransfers marks refunds failed when processing gave up on their withdrawal transfer
+func (s *Service) failBrokenTransfers(ctx context.Context) error {
+	return repos.BeginTxFunc(ctx, s.storage.PSQLConn(), pgx.TxOptions{}, func(dbTx pgx.Tx) error {
+		processing, err := s.storage.Refunds(repos.WithTx(dbTx)).GetProcessingForUpdate(ctx, processingBatchSize)
+		if err != nil {
+			return fmt.Errorf("fetch processing refunds: %w", err)
+		}
+
+		for _, refund := range processing {
+			withdrawal, err := s.storage.WithdrawalsFromProcessing(repos.WithTx(dbTx)).GetByID(ctx, repo_withdrawal_from_processing_wallets.GetByIDParams{
+				ID:      refund.WithdrawalID.UUID,
+				StoreID: refund.StoreID,
+			})
+			if err != nil {
+				return fmt.Errorf("fetch refund %s withdrawal: %w", refund.ID, err)
+			}
+			if !withdrawal.TransferID.Valid {
+				continue
+			}
+
+			transfer, err := s.storage.Transfers(repos.WithTx(dbTx)).GetById(ctx, withdrawal.TransferID.UUID)
+			if err != nil {
+				return fmt.Errorf("fetch refund %s transfer: %w", refund.ID, err)
+			}
+			if transfer.Status != models.TransferStatusFailed {
+				continue
+			}
+
+			if _, err = s.fail(ctx, refund, "withdrawal transfer failed", dbTx); err != nil {
+				return fmt.Errorf("fail refund %s: %w", refund.ID, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// resumeApproved queues withdrawals of approved refunds left without one,
+// e.g. the process stopped after approval or the withdrawal waited for approvals
+func (s *Service) resumeApproved(ctx context.Context) error {
+	approved, err := s.storage.Refunds().GetApprovedWithoutWithdrawal(ctx, processingBatchSize)
+	if err != nil {
+		return fmt.Errorf("fetch approved refunds: %w", err)
+	}
+
+	for _, refund := range approved {
+		if err = s.resume(ctx, refund.ID); err != nil {
+			s.log.Errorw("resume approved refund failed", "refund_id", refund.ID, "error", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) resume(ctx context.Context, id uuid.UUID) error {
+	return repos.BeginTxFunc(ctx, s.storage.PSQLConn(), pgx.TxOptions{}, func(dbTx pgx.Tx) error {
+		refund, err := s.storage.Refunds(repos.WithTx(dbTx)).GetByIDForUpdate(ctx, id)
+		if err != nil {
+			return fmt.Errorf("fetch refund: %w", err)
+		}
+		if refund.Status != models.RefundStatusApproved || refund.WithdrawalID.Valid {
+			return nil
+		}
+
+		requestID := refundRequestID(refund.ID)
+		approval, err := s.storage.WithdrawalApprovals(repos.WithTx(dbTx)).GetLatestByRequestID(ctx, repo_withdrawal_approvals.GetLatestByRequestIDParams{
+			Kind:      models.WithdrawalApprovalKindFromProcessing,
+			StoreID:   uuid.NullUUID{UUID: refund.StoreID, Valid: true},
+			RequestID: pgtypeutils.EncodeText(&requestID),
+		})
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("fetch refund withdrawal approval: %w", err)
+		}
+		if err == nil {
+			switch approval.Status {
+			case models.WithdrawalApprovalStatusPending, models.WithdrawalApprovalStatusApproved:
+				return nil
+			case models.WithdrawalApprovalStatusRejected, models.WithdrawalApprovalStatusExpired, models.WithdrawalApprovalStatusFailed:
+				_, err = s.fail(ctx, refund, "withdrawal approval "+approval.Status.String(), dbTx)
+				return err
+			}
+		}
+
+		_, err = s.queueWithdrawal(ctx, refund, dbTx)
+		return err
+	})
+}
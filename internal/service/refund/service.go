@@ -0,0 +1,517 @@
+package refund
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dv-net/dv-merchant/internal/config"
+	"github.com/dv-net/dv-merchant/internal/event"
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/aml"
+	"github.com/dv-net/dv-merchant/internal/service/invoice"
+	"github.com/dv-net/dv-merchant/internal/service/transactions"
+	"github.com/dv-net/dv-merchant/internal/service/withdraw"
+	"github.com/dv-net/dv-merchant/internal/storage"
+	"github.com/dv-net/dv-merchant/internal/storage/repos"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_refund_status_history"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_refunds"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_withdrawal_from_processing_wallets"
+	"github.com/dv-net/dv-merchant/internal/storage/storecmn"
+	"github.com/dv-net/dv-merchant/pkg/dbutils/pgerror"
+	"github.com/dv-net/dv-merchant/pkg/logger"
+	"github.com/dv-net/dv-merchant/pkg/pgtypeutils"
+	"github.com/dv-net/dv-processing/pkg/avalidator"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+)
+
+type IRefundService interface {
+	Create(ctx context.Context, store *models.Store, dto CreateDTO) (*models.Refund, error)
+	GetByStore(ctx context.Context, store *models.Store, id uuid.UUID) (*WithHistoryDTO, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*WithHistoryDTO, error)
+	List(ctx context.Context, dto ListDTO) (*ListResult, error)
+	UpdateAddress(ctx context.Context, store *models.Store, id uuid.UUID, address string) (*models.Refund, error)
+	Cancel(ctx context.Context, store *models.Store, id uuid.UUID, userID uuid.NullUUID) (*models.Refund, error)
+	Approve(ctx context.Context, approver *models.User, id uuid.UUID) (*models.Refund, error)
+	Reject(ctx context.Context, approver *models.User, id uuid.UUID, reason *string) (*models.Refund, error)
+	Run(ctx context.Context)
+}
+
+type Service struct {
+	cfg           config.Refunds
+	storage       storage.IStorage
+	log           logger.Logger
+	eventListener event.IListener
+	withdrawals   withdraw.IWithdrawalService
+}
+
+var _ IRefundService = (*Service)(nil)
+
+const processingBatchSize = 100
+
+func New(
+	cfg config.Refunds,
+	storage storage.IStorage,
+	log logger.Logger,
+	eventListener event.IListener,
+	withdrawals withdraw.IWithdrawalService,
+) *Service {
+	srv := &Service{
+		cfg:           cfg,
+		storage:       storage,
+		log:           log,
+		eventListener: eventListener,
+		withdrawals:   withdrawals,
+	}
+
+	srv.eventListener.Register(aml.DepositBlockedEventType, srv.handleDepositBlocked)
+	srv.eventListener.Register(invoice.StatusChangedEventType, srv.handleInvoiceStatusChanged)
+	srv.eventListener.Register(transactions.WithdrawalFromProcessingReceivedEventType, srv.handleWithdrawalReceived)
+
+	return srv
+}
+
+func (s *Service) Create(ctx context.Context, store *models.Store, dto CreateDTO) (*models.Refund, error) {
+	var created *models.Refund
+	err := repos.BeginTxFunc(ctx, s.storage.PSQLConn(), pgx.TxOptions{}, func(dbTx pgx.Tx) error {
+		tx, err := s.storage.Transactions(repos.WithTx(dbTx)).GetById(ctx, dto.TransactionID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrTransactionNotFound
+			}
+			return fmt.Errorf("fetch transaction: %w", err)
+		}
+		if !tx.StoreID.Valid || tx.StoreID.UUID != store.ID {
+			return ErrTransactionNotFound
+		}
+
+		created, err = s.create(ctx, tx, createParams{
+			reason:      models.RefundReasonManual,
+			amount:      dto.Amount,
+			addressTo:   dto.AddressTo,
+			requestedBy: dto.RequestedBy,
+		}, dbTx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+type createParams struct {
+	reason      models.RefundReason
+	amount      decimal.NullDecimal
+	addressTo   *string
+	invoiceID   uuid.NullUUID
+	requestedBy uuid.NullUUID
+}
+
+// create validates refund against the deposit and stores it waiting for approval
+func (s *Service) create(ctx context.Context, tx *models.Transaction, params createParams, dbTx pgx.Tx) (*models.Refund, error) {
+	if tx.Type != models.TransactionsTypeDeposit || !tx.StoreID.Valid {
+		return nil, ErrTransactionNotRefundable
+	}
+
+	curr, err := s.storage.Currencies(repos.WithTx(dbTx)).GetByID(ctx, tx.CurrencyID)
+	if err != nil {
+		return nil, fmt.Errorf("fetch currency: %w", err)
+	}
+
+	addressTo := tx.FromAddress
+	if params.addressTo != nil {
+		addressTo = *params.addressTo
+	}
+	if addressTo == "" {
+		return nil, ErrRefundAddressRequired
+	}
+	if !avalidator.ValidateAddressByBlockchain(addressTo, curr.Blockchain.String()) {
+		return nil, ErrInvalidRefundAddress
+	}
+
+	refunded, err := s.storage.Refunds(repos.WithTx(dbTx)).GetRefundedAmount(ctx, tx.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetch refunded amount: %w", err)
+	}
+
+	available := tx.Amount.Sub(refunded)
+	amount := available
+	if params.amount.Valid {
+		amount = params.amount.Decimal.Round(int32(curr.Precision))
+	}
+	if !amount.IsPositive() || amount.GreaterThan(available) {
+		return nil, ErrRefundAmountExceeded
+	}
+
+	refund, err := s.storage.Refunds(repos.WithTx(dbTx)).Create(ctx, repo_refunds.CreateParams{
+		StoreID:       tx.StoreID.UUID,
+		TransactionID: tx.ID,
+		InvoiceID:     params.invoiceID,
+		CurrencyID:    tx.CurrencyID,
+		Reason:        params.reason,
+		Status:        models.RefundStatusPendingApproval,
+		Amount:        amount,
+		AmountUsd:     amountUSD(tx, amount),
+		AddressTo:     addressTo,
+		RequestedBy:   params.requestedBy,
+	})
+	if err != nil {
+		var uniqueErr *pgerror.UniqueConstraintError
+		if errors.As(pgerror.ParseError(err), &uniqueErr) {
+			return nil, ErrRefundInProgress
+		}
+		return nil, fmt.Errorf("create refund: %w", err)
+	}
+
+	if err = s.recordStateChange(ctx, refund, nil, params.requestedBy, dbTx); err != nil {
+		return nil, err
+	}
+
+	return refund, nil
+}
+
+// amountUSD values refunded part of the deposit at the rate the deposit was received
+func amountUSD(tx *models.Transaction, amount decimal.Decimal) decimal.Decimal {
+	if !tx.AmountUsd.Valid || !tx.Amount.IsPositive() {
+		return decimal.Zero
+	}
+
+	return amount.Mul(tx.AmountUsd.Decimal).DivRound(tx.Amount, 4)
+}
+
+func (s *Service) GetByStore(ctx context.Context, store *models.Store, id uuid.UUID) (*WithHistoryDTO, error) {
+	refund, err := s.getStoreRefund(ctx, store, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.withHistory(ctx, refund)
+}
+
+func (s *Service) GetByID(ctx context.Context, id uuid.UUID) (*WithHistoryDTO, error) {
+	refund, err := s.storage.Refunds().GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrRefundNotFound
+		}
+		return nil, fmt.Errorf("fetch refund: %w", err)
+	}
+
+	return s.withHistory(ctx, refund)
+}
+
+func (s *Service) withHistory(ctx context.Context, refund *models.Refund) (*WithHistoryDTO, error) {
+	history, err := s.storage.RefundStatusHistory().GetByRefundID(ctx, refund.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetch refund history: %w", err)
+	}
+
+	return &WithHistoryDTO{
+		Refund:  refund,
+		History: history,
+	}, nil
+}
+
+func (s *Service) List(ctx context.Context, dto ListDTO) (*ListResult, error) {
+	commonParams := storecmn.NewCommonFindParams()
+	commonParams.SetPage(dto.Page)
+	commonParams.SetPageSize(dto.PageSize)
+
+	return s.storage.Refunds().GetByParams(ctx, repo_refunds.GetByParamsParams{
+		CommonFindParams: *commonParams,
+		StoreID:          dto.StoreID,
+		TransactionID:    dto.TransactionID,
+		Statuses:         dto.Statuses,
+		Reasons:          dto.Reasons,
+	})
+}
+
+func (s *Service) UpdateAddress(ctx context.Context, store *models.Store, id uuid.UUID, address string) (*models.Refund, error) {
+	if _, err := s.getStoreRefund(ctx, store, id); err != nil {
+		return nil, err
+	}
+
+	var updated *models.Refund
+	err := repos.BeginTxFunc(ctx, s.storage.PSQLConn(), pgx.TxOptions{}, func(dbTx pgx.Tx) error {
+		refund, err := s.getPendingForUpdate(ctx, id, dbTx)
+		if err != nil {
+			return err
+		}
+
+		curr, err := s.storage.Currencies(repos.WithTx(dbTx)).GetByID(ctx, refund.CurrencyID)
+		if err != nil {
+			return fmt.Errorf("fetch currency: %w", err)
+		}
+		if !avalidator.ValidateAddressByBlockchain(address, curr.Blockchain.String()) {
+			return ErrInvalidRefundAddress
+		}
+
+		updated, err = s.storage.Refunds(repos.WithTx(dbTx)).UpdateAddress(ctx, repo_refunds.UpdateAddressParams{
+			AddressTo: address,
+			ID:        refund.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("update refund address: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (s *Service) Cancel(ctx context.Context, store *models.Store, id uuid.UUID, userID uuid.NullUUID) (*models.Refund, error) {
+	if _, err := s.getStoreRefund(ctx, store, id); err != nil {
+		return nil, err
+	}
+
+	var cancelled *models.Refund
+	err := repos.BeginTxFunc(ctx, s.storage.PSQLConn(), pgx.TxOptions{}, func(dbTx pgx.Tx) error {
+		refund, err := s.getPendingForUpdate(ctx, id, dbTx)
+		if err != nil {
+			return err
+		}
+
+		cancelled, err = s.storage.Refunds(repos.WithTx(dbTx)).UpdateStatus(ctx, repo_refunds.UpdateStatusParams{
+			Status: models.RefundStatusCancelled,
+			ID:     refund.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("update refund status: %w", err)
+		}
+
+		return s.recordStateChange(ctx, cancelled, &refund.Status, userID, dbTx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return cancelled, nil
+}
+
+func (s *Service) Reject(ctx context.Context, approver *models.User, id uuid.UUID, reason *string) (*models.Refund, error) {
+	var rejected *models.Refund
+	err := repos.BeginTxFunc(ctx, s.storage.PSQLConn(), pgx.TxOptions{}, func(dbTx pgx.Tx) error {
+		refund, err := s.getPendingForUpdate(ctx, id, dbTx)
+		if err != nil {
+			return err
+		}
+
+		rejected, err = s.decide(ctx, refund, models.RefundStatusRejected, approver, reason, dbTx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rejected, nil
+}
+
+// Approve confirms refund and hands it over to processing withdrawal of the store owner.
+// Withdrawal is checked against approval rules like any other, the refund stays approved while it is held.
+// Refund is marked failed if the withdrawal can not be queued.
+func (s *Service) Approve(ctx context.Context, approver *models.User, id uuid.UUID) (*models.Refund, error) {
+	var approved *models.Refund
+	err := repos.BeginTxFunc(ctx, s.storage.PSQLConn(), pgx.TxOptions{}, func(dbTx pgx.Tx) error {
+		refund, err := s.getPendingForUpdate(ctx, id, dbTx)
+		if err != nil {
+			return err
+		}
+
+		approved, err = s.decide(ctx, refund, models.RefundStatusApproved, approver, nil, dbTx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.execute(ctx, approved)
+}
+
+func (s *Service) decide(
+	ctx context.Context,
+	refund *models.Refund,
+	status models.RefundStatus,
+	approver *models.User,
+	reason *string,
+	dbTx pgx.Tx,
+) (*models.Refund, error) {
+	approverID := uuid.NullUUID{UUID: approver.ID, Valid: true}
+	updated, err := s.storage.Refunds(repos.WithTx(dbTx)).SetDecision(ctx, repo_refunds.SetDecisionParams{
+		Status:          status,
+		DecidedBy:       approverID,
+		RejectionReason: pgtypeutils.EncodeText(reason),
+		ID:              refund.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update refund decision: %w", err)
+	}
+
+	if err = s.recordStateChange(ctx, updated, &refund.Status, approverID, dbTx); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// execute queues the withdrawal of an approved refund, the refund row is locked so a resumed refund is not withdrawn twice
+func (s *Service) execute(ctx context.Context, refund *models.Refund) (*models.Refund, error) {
+	var executed *models.Refund
+	err := repos.BeginTxFunc(ctx, s.storage.PSQLConn(), pgx.TxOptions{}, func(dbTx pgx.Tx) error {
+		locked, err := s.storage.Refunds(repos.WithTx(dbTx)).GetByIDForUpdate(ctx, refund.ID)
+		if err != nil {
+			return fmt.Errorf("fetch refund: %w", err)
+		}
+
+		executed, err = s.queueWithdrawal(ctx, locked, dbTx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return executed, nil
+}
+
+// queueWithdrawal links the withdrawal created under the refund request id or creates it.
+// Refund stays approved while its withdrawal is held for approvals.
+func (s *Service) queueWithdrawal(ctx context.Context, refund *models.Refund, dbTx pgx.Tx) (*models.Refund, error) {
+	if refund.Status != models.RefundStatusApproved || refund.WithdrawalID.Valid {
+		return refund, nil
+	}
+
+	requestID := refundRequestID(refund.ID)
+	existing, err := s.storage.WithdrawalsFromProcessing(repos.WithTx(dbTx)).GetWithdrawalWithTransfer(ctx, repo_withdrawal_from_processing_wallets.GetWithdrawalWithTransferParams{
+		RequestID: requestID,
+		StoreID:   refund.StoreID,
+	})
+	if err == nil {
+		return s.linkWithdrawal(ctx, refund, existing.WithdrawalFromProcessingWallet.ID, dbTx)
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("fetch refund withdrawal: %w", err)
+	}
+
+	store, err := s.storage.Stores(repos.WithTx(dbTx)).GetByID(ctx, refund.StoreID)
+	if err != nil {
+		return nil, fmt.Errorf("fetch store: %w", err)
+	}
+
+	withdrawal, err := s.withdrawals.CreateWithdrawalFromProcessing(ctx, withdraw.CreateWithdrawalFromProcessingDTO{
+		CurrencyID: refund.CurrencyID,
+		Amount:     refund.Amount,
+		AddressTo:  refund.AddressTo,
+		UserID:     store.UserID,
+		StoreID:    &store.ID,
+		RequestID:  &requestID,
+		// the finance manager who approved the refund can not sign its withdrawal
+		InitiatedBy: refund.DecidedBy,
+	})
+	if err != nil {
+		var approvalErr *withdraw.ApprovalRequiredError
+		if errors.As(err, &approvalErr) {
+			s.log.Infow("refund withdrawal held for approval", "refund_id", refund.ID, "approval_id", approvalErr.Approval.ID)
+			return refund, nil
+		}
+
+		s.log.Errorw("refund withdrawal failed", "refund_id", refund.ID, "error", err)
+		return s.fail(ctx, refund, err.Error(), dbTx)
+	}
+
+	return s.linkWithdrawal(ctx, refund, withdrawal.ID, dbTx)
+}
+
+func (s *Service) linkWithdrawal(ctx context.Context, refund *models.Refund, withdrawalID uuid.UUID, dbTx pgx.Tx) (*models.Refund, error) {
+	linked, err := s.storage.Refunds(repos.WithTx(dbTx)).SetWithdrawal(ctx, repo_refunds.SetWithdrawalParams{
+		WithdrawalID: uuid.NullUUID{UUID: withdrawalID, Valid: true},
+		ID:           refund.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update refund withdrawal: %w", err)
+	}
+
+	if err = s.recordStateChange(ctx, linked, &refund.Status, uuid.NullUUID{}, dbTx); err != nil {
+		return nil, err
+	}
+
+	return linked, nil
+}
+
+func refundRequestID(id uuid.UUID) string {
+	return "refund-" + id.String()
+}
+
+func (s *Service) fail(ctx context.Context, refund *models.Refund, reason string, dbTx pgx.Tx) (*models.Refund, error) {
+	failed, err := s.storage.Refunds(repos.WithTx(dbTx)).SetFailed(ctx, repo_refunds.SetFailedParams{
+		FailureReason: pgtypeutils.EncodeText(&reason),
+		ID:            refund.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update refund failure: %w", err)
+	}
+
+	if err = s.recordStateChange(ctx, failed, &refund.Status, uuid.NullUUID{}, dbTx); err != nil {
+		return nil, err
+	}
+
+	return failed, nil
+}
+
+func (s *Service) getStoreRefund(ctx context.Context, store *models.Store, id uuid.UUID) (*models.Refund, error) {
+	refund, err := s.storage.Refunds().GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrRefundNotFound
+		}
+		return nil, fmt.Errorf("fetch refund: %w", err)
+	}
+	if refund.StoreID != store.ID {
+		return nil, ErrRefundNotFound
+	}
+
+	return refund, nil
+}
+
+func (s *Service) getPendingForUpdate(ctx context.Context, id uuid.UUID, dbTx pgx.Tx) (*models.Refund, error) {
+	refund, err := s.storage.Refunds(repos.WithTx(dbTx)).GetByIDForUpdate(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrRefundNotFound
+		}
+		return nil, fmt.Errorf("fetch refund: %w", err)
+	}
+	if refund.Status != models.RefundStatusPendingApproval {
+		return nil, ErrRefundNotPending
+	}
+
+	return refund, nil
+}
+
+func (s *Service) recordStateChange(ctx context.Context, refund *models.Refund, from *models.RefundStatus, userID uuid.NullUUID, dbTx pgx.Tx) error {
+	history, err := s.storage.RefundStatusHistory(repos.WithTx(dbTx)).Create(ctx, repo_refund_status_history.CreateParams{
+		RefundID:   refund.ID,
+		StatusFrom: from,
+		StatusTo:   refund.Status,
+		UserID:     userID,
+	})
+	if err != nil {
+		return fmt.Errorf("create refund history: %w", err)
+	}
+
+	if err = s.eventListener.Fire(StatusChangedEvent{
+		Refund:  *refund,
+		History: *history,
+		DBTx:    dbTx,
+	}); err != nil {
+		return fmt.Errorf("fire refund status changed: %w", err)
+	}
+
+	return nil
+}
@@ -39,15 +39,20 @@ import (
 	"github.com/dv-net/dv-merchant/internal/service/exchange_rules"
 	"github.com/dv-net/dv-merchant/internal/service/exchange_withdrawal"
 	"github.com/dv-net/dv-merchant/internal/service/exrate"
+	"github.com/dv-net/dv-merchant/internal/service/invoice"
 	"github.com/dv-net/dv-merchant/internal/service/log"
 	"github.com/dv-net/dv-merchant/internal/service/notification_sender"
 	"github.com/dv-net/dv-merchant/internal/service/notification_sender/external_sender"
 	"github.com/dv-net/dv-merchant/internal/service/notification_sender/mail_sender"
 	"github.com/dv-net/dv-merchant/internal/service/notification_sender/telegram_sender"
 	"github.com/dv-net/dv-merchant/internal/service/notify"
+	"github.com/dv-net/dv-merchant/internal/service/outbox"
+	"github.com/dv-net/dv-merchant/internal/service/payment_policy"
 	"github.com/dv-net/dv-merchant/internal/service/permission"
 	"github.com/dv-net/dv-merchant/internal/service/processing"
+	"github.com/dv-net/dv-merchant/internal/service/rate_quote"
 	"github.com/dv-net/dv-merchant/internal/service/receipts"
+	"github.com/dv-net/dv-merchant/internal/service/refund"
 	"github.com/dv-net/dv-merchant/internal/service/setting"
 	"github.com/dv-net/dv-merchant/internal/service/store"
 	"github.com/dv-net/dv-merchant/internal/service/system"
@@ -113,6 +118,7 @@ type Services struct {
 	SystemService                 system.ISystemService
 	TemplaterService              templater.ITemplaterService
 	UnconfirmedCollapser          transactions.IUnconfirmedTransactionCollapser
+	OutboxService                 outbox.IOutbox
 	ExchangeRulesService          exchange_rules.IExchangeRules
 	LogService                    log.ILogService
 	NotificationSettings          notification_settings.INotificationSettings
@@ -123,6 +129,10 @@ type Services struct {
 	AMLKeysService                aml.KeysService
 	AMLStatusChecker              aml.StatusChecker
 	AMLUserSettings               aml.IUserAmlSettings
+	InvoiceService                invoice.IInvoiceService
+	PaymentPolicyService          payment_policy.IPaymentPolicyService
+	RateQuoteService              rate_quote.IRateQuoteService
+	RefundService                 refund.IRefundService
 }
 
 func NewServices(
@@ -139,7 +149,12 @@ func NewServices(
 	eventListener := event.New()
 
 	adminSvc := admin_gateway.New(conf.Admin.BaseURL, appVersion, logger, conf.Admin.LogStatus)
-	exrateService, err := exrate.New(conf, currencyService, logger, storage)
+	processingMetrics, err := metrics.New()
+	if err != nil {
+		return nil, fmt.Errorf("register metrics: %w", err)
+	}
+
+	exrateService, err := exrate.New(conf, currencyService, logger, storage, eventListener, processingMetrics)
 	if err != nil {
 		logger.Errorw("init currency exchange rate service failed", "error", err)
 		return nil, err
@@ -156,7 +171,12 @@ func NewServices(
 		return nil, err
 	}
 
-	webhookService := webhook.New(conf.WebHook, storage, logger)
+	webhookService, err := webhook.New(conf.WebHook, storage, logger)
+	if err != nil {
+		logger.Errorw("init webhook service", "error", err)
+		return nil, err
+	}
+
 	eprClient, err := epr.NewClient(conf.EProxy.GRPC.Addr)
 	if err != nil {
 		logger.Errorw("init epr client", "error", err)
@@ -166,11 +186,6 @@ func NewServices(
 	eProxyService := eproxy.New(eprClient)
 	receiptService := receipts.New(storage, currencyService)
 
-	processingMetrics, err := metrics.New()
-	if err != nil {
-		return nil, fmt.Errorf("register metrics: %w", err)
-	}
-
 	settingService := setting.New(conf, storage, cache, eventListener, logger)
 	processingService := processing.New(
 		ctx,
@@ -203,8 +218,8 @@ func NewServices(
 	transactionService := transactions.New(logger, storage, eProxyService, currConvService, eventListener, notificationService)
 	addressesService := address.New(conf, storage, logger, processingService)
 	withdrawalWalletService := withdrawal_wallet.New(storage, logger, currencyService, currConvService, processingService)
-	addressBookService := address_book.New(storage, logger, currencyService, withdrawalWalletService, processingService)
-	walletService := wallet.New(conf, storage, logger, currencyService, processingService, exrateService, currConvService, settingService, eProxyService, notificationService)
+	addressBookService := address_book.New(storage, logger, currencyService, withdrawalWalletService, processingService, settingService, notificationService, conf.WithdrawalAllowlist)
+	walletService := wallet.New(conf, storage, logger, currencyService, processingService, exrateService, currConvService, settingService, eProxyService, notificationService, eventListener)
 	storeRateLimiter := rate.NewLimiter(
 		storage.KeyValue(),
 		rate.WithMaxLimit(conf.ExternalStoreLimits.MaxRequestsPerInterval),
@@ -216,26 +231,32 @@ func NewServices(
 		return nil, err
 	}
 
-	storeService := store.New(storage, currencyService, logger, webhookService, eventListener, exrateService, walletService, notificationService, storeRateLimiter, conf.ExternalStoreLimits.Enabled, processingService, settingService, amlService)
+	paymentPolicyService := payment_policy.New(storage)
+	storeService := store.New(storage, currencyService, logger, webhookService, eventListener, exrateService, walletService, notificationService, storeRateLimiter, conf.ExternalStoreLimits.Enabled, processingService, settingService, amlService, paymentPolicyService)
+	rateQuoteService := rate_quote.New(conf.RateQuotes, storage, exrateService)
+	invoiceService := invoice.New(conf.Invoices, storage, logger, eventListener, walletService, currConvService, paymentPolicyService, rateQuoteService)
 	otpSvc := otp.New(&otp.Config{TTL: time.Minute * 10}, tools.RandomCodeGenerator, storage.KeyValue())
 	userService := user.New(conf, storage, storeService, permissionService, processingService, notificationService, logger, settingService, adminSvc, otpSvc)
 
-	adminService := admin.New(conf, storage, logger, permissionService, userService, notificationService)
+	adminService := admin.New(conf, storage, logger, permissionService, userService, notificationService, eventListener)
 
 	authService := auth.New(conf, logger, storage, userService, userService, notificationService, settingService)
-	withdrawService := withdraw.New(storage, logger, processingService, processingService, currConvService, currencyService, exrateService, settingService)
+	withdrawService := withdraw.New(storage, logger, processingService, processingService, currConvService, currencyService, exrateService, settingService, conf.WithdrawalApprovals, conf.WithdrawalAllowlist, notificationService, eProxyService)
+	refundService := refund.New(conf.Refunds, storage, logger, eventListener, withdrawService)
 	updaterClient, _ := updater.NewClient(logger, conf)
 	upd := updater.New(logger, conf, processingService, appVersion)
 	analyticsService := analytics.NewService(storage, cache, settingService, adminSvc, processingService, updaterClient, appVersion, commitHash)
 	systemService := system.New(settingService, permissionService, adminSvc, logger, appVersion, commitHash, conf, analyticsService)
 
 	dictionaryService := dictionary.New(storage, exrateService, systemService)
-	callbackService := callback.New(logger, eventListener, storage, transactionService, transactionService, storeService, currConvService, receiptService)
+	outboxService := outbox.New(conf.Outbox, storage, logger, eventListener)
+	transactions.RegisterOutboxDecoders(outboxService)
+	callbackService := callback.New(logger, eventListener, storage, transactionService, transactionService, storeService, currConvService, receiptService, outboxService, rateQuoteService)
 
 	exchangeManager := exchange_manager.NewManager(logger, storage, currConvService)
 	exchangeRulesService := exchange_rules.NewService(logger, storage, exchangeManager)
-	exchangeService := exchange.NewService(logger, storage, exchangeManager, exchangeRulesService, settingService)
-	exchangeWithdrawalService := exchange_withdrawal.NewService(logger, storage, exchangeManager, currConvService, exchangeRulesService, settingService)
+	exchangeService := exchange.NewService(logger, storage, exchangeManager, exchangeRulesService, settingService, eventListener, conf.ExchangeRouting, conf.ExchangeOrders)
+	exchangeWithdrawalService := exchange_withdrawal.NewService(logger, storage, exchangeManager, currConvService, exchangeRulesService, settingService, eventListener)
 
 	notificationSettings := notification_settings.New(storage)
 
@@ -290,6 +311,7 @@ func NewServices(
 		SystemService:                 systemService,
 		TemplaterService:              templaterService,
 		UnconfirmedCollapser:          transactionService,
+		OutboxService:                 outboxService,
 		LogService:                    logService,
 		ExchangeWithdrawalService:     exchangeWithdrawalService,
 		ExchangeRulesService:          exchangeRulesService,
@@ -301,6 +323,10 @@ func NewServices(
 		AMLKeysService:                amlService,
 		AMLStatusChecker:              amlService,
 		AMLUserSettings:               amlService,
+		InvoiceService:                invoiceService,
+		PaymentPolicyService:          paymentPolicyService,
+		RateQuoteService:              rateQuoteService,
+		RefundService:                 refundService,
 	}, nil
 }
 
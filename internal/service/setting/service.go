@@ -129,6 +129,7 @@ func (s *Service) validateSetting(isRoot bool, name, value string) error {
 func (s *Service) Is2faRequired(name string) bool {
 	ffaSettings := map[string]struct{}{
 		WithdrawFromProcessing: {},
+		WithdrawalAllowlist:    {},
 	}
 
 	_, ok := ffaSettings[name]
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/dv-net/dv-merchant/internal/models"
 	"github.com/dv-net/dv-merchant/internal/storage/repos"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_settings"
+	"github.com/dv-net/dv-merchant/pkg/pgtypeutils"
 )
 
 // TransfersStatus Common settings
@@ -29,6 +31,13 @@ const (
 
 const (
 	WithdrawFromProcessing = "withdraw_from_processing"
+	// WithdrawalAllowlist restricts withdrawal destinations to active address book entries
+	WithdrawalAllowlist = "withdrawal_allowlist"
+)
+
+// ExchangeSwapRouting Exchange settings
+const (
+	ExchangeSwapRouting = "exchange_swap_routing"
 )
 
 var validModelSettings = map[string][]string{
@@ -36,6 +45,8 @@ var validModelSettings = map[string][]string{
 	TransferType:           {string(TransferByBurnTRX), string(TransferByResource), string(TransferByCloudDelegate)},
 	QuickStartGuideStatus:  {FlagValueIncompleted, FlagValueCompleted},
 	WithdrawFromProcessing: {FlagValueDisabled, FlagValueEnabled},
+	WithdrawalAllowlist:    {FlagValueDisabled, FlagValueEnabled, AllowlistValueDisabling},
+	ExchangeSwapRouting:    {SwapRoutingCurrent, SwapRoutingBest},
 }
 
 type IUserSettings interface {
@@ -161,6 +172,7 @@ func (s *Service) SetModelSetting(ctx context.Context, dto UpdateDTO, opts ...re
 			ModelType: dto.Model.ModelName(),
 			Name:      dto.Name,
 			Value:     dto.Value,
+			UpdatedAt: pgtypeutils.EncodeTime(time.Now()),
 			ID:        setting.ID,
 			IsMutable: true,
 		}
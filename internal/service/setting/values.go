@@ -35,3 +35,11 @@ const (
 const (
 	TransferStatusSystemSuspended string = "system_suspended"
 )
+
+// AllowlistValueDisabling keeps withdrawal allowlist enforced until the cooling period since the setting update passes
+const AllowlistValueDisabling = "disabling"
+
+const (
+	SwapRoutingCurrent = "current"
+	SwapRoutingBest    = "best"
+)
@@ -5,3 +5,4 @@ import "errors"
 var ErrUserHasNoAccess = errors.New("user has no access for current store")
 var ErrStoreSecretNotFound = errors.New("store secret not found")
 var ErrInvalidOTP = errors.New("invalid OTP")
+var ErrInvalidRotationWindow = errors.New("secret rotation window must not exceed 30 days")
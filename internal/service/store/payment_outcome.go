@@ -0,0 +1,84 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/invoice"
+	"github.com/dv-net/dv-merchant/internal/service/payment_policy"
+	"github.com/dv-net/dv-merchant/internal/storage/repos"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_invoices"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+)
+
+// evaluatePaymentOutcome applies store tolerance policy to the deposit. Deposits paying an invoice
+// are matched against the invoice price, free top-ups against store minimal payment.
+// Invoice is returned with the deposit counted in, nil for free top-ups.
+func (s *Service) evaluatePaymentOutcome(ctx context.Context, tx models.ITransaction, store models.Store, dbTx pgx.Tx) (models.PaymentOutcome, *models.Invoice, error) {
+	policy, err := s.paymentPolicies.GetByStore(ctx, store.ID, repos.WithTx(dbTx))
+	if err != nil {
+		return models.PaymentOutcome{}, nil, err
+	}
+
+	row, err := s.storage.Invoices(repos.WithTx(dbTx)).GetByDepositTx(ctx, repo_invoices.GetByDepositTxParams{
+		TransactionID: tx.GetID(),
+		Address:       tx.GetToAddress(),
+		CurrencyID:    tx.GetCurrencyID(),
+	})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return models.PaymentOutcome{}, nil, fmt.Errorf("fetch deposit invoice: %w", err)
+	}
+
+	if err == nil && row.Invoice.StoreID == store.ID {
+		inv := row.Invoice
+		outcome := invoice.EvaluatePayment(policy, &inv, inv.PaidAmount)
+		// invoice listener may not have seen this deposit yet
+		if !row.PaymentApplied {
+			inv.PaidAmount = inv.PaidAmount.Add(tx.GetAmount())
+			inv.PaidAmountUsd = inv.PaidAmountUsd.Add(tx.GetAmountUsd())
+			outcome = invoice.EvaluatePayment(policy, &inv, inv.PaidAmount)
+			inv.Status = invoice.EvaluatePaymentStatus(outcome)
+		}
+		return outcome, &inv, nil
+	}
+
+	return payment_policy.EvaluateMinimum(policy, store.MinimalPayment, tx.GetAmountUsd()), nil, nil
+}
+
+func preparePaymentOutcomePayload(prefix string, outcome models.PaymentOutcome) map[string]any {
+	payload := map[string]any{
+		prefix + "result":              outcome.Result,
+		prefix + "expected_amount_usd": outcome.ExpectedAmountUSD.String(),
+		prefix + "received_amount_usd": outcome.ReceivedAmountUSD.String(),
+		prefix + "difference_usd":      outcome.DifferenceUSD.String(),
+		prefix + "within_tolerance":    outcome.WithinTolerance,
+		prefix + "leftover_usd":        outcome.LeftoverUSD.String(),
+	}
+	if outcome.LeftoverAction != nil {
+		payload[prefix+"leftover_action"] = *outcome.LeftoverAction
+	}
+	if outcome.InvoiceID != nil {
+		payload[prefix+"invoice_id"] = outcome.InvoiceID.String()
+	}
+
+	return payload
+}
+
+// prepareInvoicePaymentPayload shows the invoice payment progress, partial payments do not change invoice status
+// and are reported by the deposit webhook only
+func prepareInvoicePaymentPayload(prefix string, inv *models.Invoice) map[string]any {
+	return map[string]any{
+		prefix + "id":               inv.ID.String(),
+		prefix + "order_id":         inv.OrderID.String,
+		prefix + "status":           inv.Status,
+		prefix + "amount":           inv.Amount.String(),
+		prefix + "amount_usd":       inv.AmountUsd.String(),
+		prefix + "paid_amount":      inv.PaidAmount.String(),
+		prefix + "paid_amount_usd":  inv.PaidAmountUsd.String(),
+		prefix + "remaining_amount": decimal.Max(inv.Amount.Sub(inv.PaidAmount), decimal.Zero).String(),
+	}
+}
@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/dv-net/dv-merchant/internal/models"
 	"github.com/dv-net/dv-merchant/internal/storage/repos"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_store_secrets"
 	"github.com/dv-net/dv-merchant/internal/tools/str"
+	"github.com/dv-net/dv-merchant/pkg/pgtypeutils"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -16,10 +19,17 @@ import (
 type ISecret interface {
 	GetSecretByStore(ctx context.Context, storeID uuid.UUID) (string, error)
 	GenerateSecret(ctx context.Context, storeID uuid.UUID, opts ...repos.Option) (string, error)
-	GenerateNewSecret(ctx context.Context, storeID uuid.UUID, opts ...repos.Option) (string, error)
+	GetStoreSecret(ctx context.Context, storeID uuid.UUID) (*models.StoreSecret, error)
+	GenerateNewSecret(ctx context.Context, storeID uuid.UUID, rotationWindow time.Duration, opts ...repos.Option) (*models.StoreSecret, error)
+	RevokePreviousSecret(ctx context.Context, storeID uuid.UUID) (*models.StoreSecret, error)
 	RemoveSecretByStore(ctx context.Context, storeID uuid.UUID) error
 }
 
+const (
+	DefaultSecretRotationWindow = 24 * time.Hour
+	MaxSecretRotationWindow     = 30 * 24 * time.Hour
+)
+
 func (s *Service) GetSecretByStore(ctx context.Context, storeID uuid.UUID) (string, error) {
 	secret, err := s.storage.StoreSecrets().GetSecretByStoreID(ctx, storeID)
 	if errors.Is(err, pgx.ErrNoRows) {
@@ -49,21 +59,59 @@ func (s *Service) GenerateSecret(ctx context.Context, storeID uuid.UUID, opts ..
 	return createdSecret.Secret, nil
 }
 
-func (s *Service) GenerateNewSecret(ctx context.Context, storeID uuid.UUID, opts ...repos.Option) (string, error) {
+func (s *Service) GetStoreSecret(ctx context.Context, storeID uuid.UUID) (*models.StoreSecret, error) {
+	secret, err := s.storage.StoreSecrets().GetByStoreID(ctx, storeID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrStoreSecretNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("fetch secret by store: %w", err)
+	}
+
+	return secret, nil
+}
+
+// GenerateNewSecret replaces store secret, the replaced one keeps signing webhooks during rotation window
+func (s *Service) GenerateNewSecret(ctx context.Context, storeID uuid.UUID, rotationWindow time.Duration, opts ...repos.Option) (*models.StoreSecret, error) {
+	if rotationWindow < 0 || rotationWindow > MaxSecretRotationWindow {
+		return nil, ErrInvalidRotationWindow
+	}
+
 	secret, err := str.RandomString(40)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	createdSecret, err := s.storage.StoreSecrets(opts...).UpdateSecret(ctx, repo_store_secrets.UpdateSecretParams{
-		StoreID: storeID,
-		Secret:  secret,
+	rotatedSecret, err := s.storage.StoreSecrets(opts...).Rotate(ctx, repo_store_secrets.RotateParams{
+		PreviousExpiresAt: pgtypeutils.EncodeTime(time.Now().Add(rotationWindow)),
+		Secret:            secret,
+		StoreID:           storeID,
 	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		// secret was removed before, nothing to rotate
+		rotatedSecret, err = s.storage.StoreSecrets(opts...).Create(ctx, repo_store_secrets.CreateParams{
+			StoreID: storeID,
+			Secret:  secret,
+		})
+	}
 	if err != nil {
-		return "", fmt.Errorf("update secret: %w", err)
+		return nil, fmt.Errorf("rotate secret: %w", err)
 	}
 
-	return createdSecret.Secret, nil
+	return rotatedSecret, nil
+}
+
+// RevokePreviousSecret stops signing webhooks with the replaced secret before rotation window ends
+func (s *Service) RevokePreviousSecret(ctx context.Context, storeID uuid.UUID) (*models.StoreSecret, error) {
+	secret, err := s.storage.StoreSecrets().RevokePrevious(ctx, storeID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrStoreSecretNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("revoke previous secret: %w", err)
+	}
+
+	return secret, nil
 }
 
 func (s *Service) RemoveSecretByStore(ctx context.Context, storeID uuid.UUID) error {
@@ -10,13 +10,19 @@ import (
 	"github.com/dv-net/dv-merchant/internal/models"
 	"github.com/dv-net/dv-merchant/internal/service/aml"
 	"github.com/dv-net/dv-merchant/internal/service/currency"
+	"github.com/dv-net/dv-merchant/internal/service/exchange"
+	"github.com/dv-net/dv-merchant/internal/service/exchange_withdrawal"
 	"github.com/dv-net/dv-merchant/internal/service/exrate"
+	"github.com/dv-net/dv-merchant/internal/service/invoice"
 	"github.com/dv-net/dv-merchant/internal/service/notify"
+	"github.com/dv-net/dv-merchant/internal/service/payment_policy"
 	"github.com/dv-net/dv-merchant/internal/service/processing"
+	"github.com/dv-net/dv-merchant/internal/service/refund"
 	"github.com/dv-net/dv-merchant/internal/service/setting"
 	"github.com/dv-net/dv-merchant/internal/service/transactions"
 	"github.com/dv-net/dv-merchant/internal/service/wallet"
 	"github.com/dv-net/dv-merchant/internal/service/webhook"
+	"github.com/dv-net/dv-merchant/internal/service/withdraw"
 	"github.com/dv-net/dv-merchant/internal/storage"
 	"github.com/dv-net/dv-merchant/internal/storage/repos"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_stores"
@@ -59,6 +65,7 @@ type Service struct {
 	processingSvc       processing.IProcessingOwner
 	settingSvc          setting.ISettingService
 	amlService          aml.IService
+	paymentPolicies     payment_policy.IPaymentPolicyService
 }
 
 var _ IStore = (*Service)(nil)
@@ -77,6 +84,7 @@ func New(
 	processingSvc processing.IProcessingOwner,
 	settingSvc setting.ISettingService,
 	amlService aml.IService,
+	paymentPolicies payment_policy.IPaymentPolicyService,
 ) *Service {
 	srv := &Service{
 		storage:             storage,
@@ -92,12 +100,20 @@ func New(
 		processingSvc:       processingSvc,
 		settingSvc:          settingSvc,
 		amlService:          amlService,
+		paymentPolicies:     paymentPolicies,
 	}
 	// register event
 	srv.eventListener.Register(transactions.DepositReceivedEventType, srv.handleDepositReceived)
 	srv.eventListener.Register(transactions.DepositUnconfirmedEventType, srv.handleDepositReceived)
 	srv.eventListener.Register(transactions.WithdrawalFromProcessingReceivedEventType, srv.handleWithdrawalReceived)
 	srv.eventListener.Register(aml.CheckCompletedEventType, srv.handleAMLCheckCompleted)
+	srv.eventListener.Register(invoice.StatusChangedEventType, srv.handleInvoiceStatusChanged)
+	srv.eventListener.Register(refund.StatusChangedEventType, srv.handleRefundStatusChanged)
+	srv.eventListener.Register(aml.CheckCompletedEventType, srv.handleAMLCheckCompletedWebhook)
+	srv.eventListener.Register(withdraw.TransferStatusChangedEventType, srv.handleTransferStatusChanged)
+	srv.eventListener.Register(exchange.OrderFilledEventType, srv.handleExchangeOrderFilled)
+	srv.eventListener.Register(exchange_withdrawal.StatusChangedEventType, srv.handleExchangeWithdrawalStatusChanged)
+	srv.eventListener.Register(wallet.AddressCreatedEventType, srv.handleWalletAddressCreated)
 
 	return srv
 }
@@ -5,9 +5,13 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/dv-net/dv-merchant/internal/service/aml"
+	"github.com/dv-net/dv-merchant/internal/service/invoice"
+	"github.com/dv-net/dv-merchant/internal/service/payment_policy"
+	"github.com/dv-net/dv-merchant/internal/service/refund"
 	"github.com/dv-net/dv-merchant/internal/service/transactions"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_store_currencies"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_user_aml_settings"
@@ -48,11 +52,22 @@ func (s *Service) GetStoreWebhookByID(ctx context.Context, id uuid.UUID) (*model
 }
 
 func (s *Service) CreateStoreWebhooks(ctx context.Context, store *models.Store, dto *store_webhook_request.CreateRequest, opts ...repos.Option) (*models.StoreWebhook, error) {
+	delivery, err := applyDeliverySettings(webhook.DefaultDeliverySettings(), dto.DeliverySettings)
+	if err != nil {
+		return nil, err
+	}
+
 	params := repo_store_webhooks.CreateParams{
-		Url:     dto.URL,
-		StoreID: store.ID,
-		Enabled: dto.Enabled,
-		Events:  dto.Events,
+		Url:                dto.URL,
+		StoreID:            store.ID,
+		Enabled:            dto.Enabled,
+		Events:             dto.Events,
+		SuccessMode:        delivery.SuccessMode,
+		SuccessStatusCodes: delivery.SuccessStatusCodes,
+		SuccessJsonPath:    delivery.SuccessJSONPath,
+		SuccessJsonValue:   delivery.SuccessJSONValue,
+		TimeoutSeconds:     int32(delivery.Timeout / time.Second),
+		SchemaVersion:      delivery.SchemaVersion,
 	}
 	storeWebhook, err := s.storage.StoreWebhooks(opts...).Create(ctx, params)
 	if err != nil {
@@ -62,11 +77,27 @@ func (s *Service) CreateStoreWebhooks(ctx context.Context, store *models.Store,
 }
 
 func (s *Service) UpdateStoreWebhooks(ctx context.Context, id uuid.UUID, dto *store_webhook_request.UpdateRequest, opts ...repos.Option) (*models.StoreWebhook, error) {
+	current, err := s.storage.StoreWebhooks(opts...).GetById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := applyDeliverySettings(webhook.DeliverySettingsFromModel(*current), dto.DeliverySettings)
+	if err != nil {
+		return nil, err
+	}
+
 	params := repo_store_webhooks.UpdateParams{
-		Url:     dto.URL,
-		Enabled: dto.Enabled,
-		Events:  dto.Events,
-		ID:      id,
+		Url:                dto.URL,
+		Enabled:            dto.Enabled,
+		Events:             dto.Events,
+		SuccessMode:        delivery.SuccessMode,
+		SuccessStatusCodes: delivery.SuccessStatusCodes,
+		SuccessJsonPath:    delivery.SuccessJSONPath,
+		SuccessJsonValue:   delivery.SuccessJSONValue,
+		TimeoutSeconds:     int32(delivery.Timeout / time.Second),
+		SchemaVersion:      delivery.SchemaVersion,
+		ID:                 id,
 	}
 	storeWebhook, err := s.storage.StoreWebhooks(opts...).Update(ctx, params)
 	if err != nil {
@@ -75,6 +106,37 @@ func (s *Service) UpdateStoreWebhooks(ctx context.Context, id uuid.UUID, dto *st
 	return storeWebhook, nil
 }
 
+// applyDeliverySettings overrides current delivery settings with the ones present in request
+func applyDeliverySettings(current webhook.DeliverySettings, dto store_webhook_request.DeliverySettings) (webhook.DeliverySettings, error) {
+	if dto.SuccessMode != nil {
+		current.SuccessMode = *dto.SuccessMode
+	}
+	if dto.SuccessStatusCodes != nil {
+		current.SuccessStatusCodes = dto.SuccessStatusCodes
+	}
+	if dto.SuccessJSONPath != nil {
+		current.SuccessJSONPath = *dto.SuccessJSONPath
+	}
+	if dto.SuccessJSONValue != nil {
+		current.SuccessJSONValue = *dto.SuccessJSONValue
+	}
+	if dto.TimeoutSeconds != nil {
+		current.Timeout = time.Duration(*dto.TimeoutSeconds) * time.Second
+	}
+	if dto.SchemaVersion != nil {
+		current.SchemaVersion = *dto.SchemaVersion
+	}
+	if current.SuccessStatusCodes == nil {
+		current.SuccessStatusCodes = []int32{}
+	}
+
+	if err := current.Validate(); err != nil {
+		return webhook.DeliverySettings{}, err
+	}
+
+	return current, nil
+}
+
 func (s *Service) GetStoreWebhookByStoreID(ctx context.Context, storeID uuid.UUID) ([]*models.StoreWebhook, error) {
 	storeWebhooks, err := s.storage.StoreWebhooks().GetByStoreId(ctx, storeID)
 	if err != nil {
@@ -97,26 +159,41 @@ func (s *Service) handleDepositReceived(ev event.IEvent) error {
 		return fmt.Errorf("invalid event type %s", ev.Type())
 	}
 
+	ctx := context.Background()
+
+	policy, err := s.paymentPolicies.GetByStore(ctx, convertedEv.GetStore().ID, repos.WithTx(convertedEv.GetDatabaseTx()))
+	if err != nil {
+		return err
+	}
+
+	// payments below store minimum are dropped unless they fit into the store tolerance
+	minimum := payment_policy.EvaluateMinimum(policy, convertedEv.GetStore().MinimalPayment, convertedEv.GetTx().GetAmountUsd())
+	if minimum.Result == models.PaymentOutcomeUnderpaid {
+		return nil
+	}
+
+	outcome, inv, err := s.evaluatePaymentOutcome(ctx, convertedEv.GetTx(), convertedEv.GetStore(), convertedEv.GetDatabaseTx())
+	if err != nil {
+		return fmt.Errorf("evaluate payment outcome: %w", err)
+	}
+
 	payload, err := s.prepareDepositHookPayload(
 		convertedEv.GetTx(),
 		convertedEv.GetCurrency(),
 		convertedEv.GetWebhookEvent(),
 		convertedEv.GetStoreExternalID(),
+		outcome,
+		inv,
 	)
 	if err != nil {
 		return fmt.Errorf("prepare deposit hook payload: %w", err)
 	}
 
-	if convertedEv.GetStore().MinimalPayment.GreaterThan(convertedEv.GetTx().GetAmountUsd()) {
-		return nil
-	}
 	params := repo_store_currencies.FindByStoreIDParams{
 		StoreID:    convertedEv.GetStore().ID,
 		CurrencyID: convertedEv.GetCurrency().ID,
 	}
 
-	ctx := context.Background()
-
 	_, err = s.storage.StoreCurrencies().FindByStoreID(ctx, params)
 	if err != nil {
 		s.log.Errorw("store available currency not found", "error", err)
@@ -284,7 +361,17 @@ func (s *Service) SendWebhookManual(ctx context.Context, txID, userID uuid.UUID)
 		whType = models.WebhookEventPaymentReceived
 	}
 
-	preparedPayload, err := s.prepareDepositHookPayload(tx, *curr, whType, storeExternalID)
+	store, err := s.storage.Stores().GetByID(ctx, tx.GetStoreID())
+	if err != nil {
+		return fmt.Errorf("fetch store: %w", err)
+	}
+
+	outcome, inv, err := s.evaluatePaymentOutcome(ctx, tx, *store, nil)
+	if err != nil {
+		return fmt.Errorf("evaluate payment outcome: %w", err)
+	}
+
+	preparedPayload, err := s.prepareDepositHookPayload(tx, *curr, whType, storeExternalID, outcome, inv)
 	if err != nil {
 		return fmt.Errorf("prepare hook body: %w", err)
 	}
@@ -295,9 +382,14 @@ func (s *Service) SendWebhookManual(ctx context.Context, txID, userID uuid.UUID)
 	}
 
 	for _, hook := range webhooks {
+		body, encodeErr := webhook.EncodePayload(hook.StoreWebhook.SchemaVersion, tx.GetID(), whType, preparedPayload, time.Now())
+		if encodeErr != nil {
+			return fmt.Errorf("encode hook body: %w", encodeErr)
+		}
+
 		var signature string
 		if hook.Secret.Valid {
-			signature = hash.SHA256Signature(preparedPayload, hook.Secret.String)
+			signature = hash.SHA256Signature(body, hook.Secret.String)
 		}
 
 		whSendErr := s.webhookService.ProcessPlainMessage(ctx, webhook.PreparedHookDto{
@@ -306,9 +398,10 @@ func (s *Service) SendWebhookManual(ctx context.Context, txID, userID uuid.UUID)
 			StoreID:       tx.GetStoreID(),
 			IsManual:      true,
 			Event:         whType.String(),
-			Payload:       preparedPayload,
+			Payload:       body,
 			Signature:     signature,
 			URL:           hook.StoreWebhook.Url,
+			Delivery:      webhook.DeliverySettingsFromModel(hook.StoreWebhook),
 		})
 		if whSendErr != nil {
 			s.log.Errorw(
@@ -387,6 +480,8 @@ func (s *Service) prepareDepositHookPayload(
 	curr models.Currency,
 	whType models.WebhookEvent,
 	storeExternalID string,
+	outcome models.PaymentOutcome,
+	inv *models.Invoice,
 ) ([]byte, error) {
 	var prefix string
 	if !tx.IsConfirmed() {
@@ -413,6 +508,10 @@ func (s *Service) prepareDepositHookPayload(
 			prefix + "id":                tx.GetWalletID(),
 			prefix + "store_external_id": storeExternalID,
 		},
+		prefix + "payment_outcome": preparePaymentOutcomePayload(prefix, outcome),
+	}
+	if inv != nil {
+		payload[prefix+"invoice"] = prepareInvoicePaymentPayload(prefix, inv)
 	}
 
 	preparedPayload, err := json.Marshal(payload)
@@ -444,11 +543,17 @@ func (s *Service) SendMockWebhook(ctx context.Context, user *models.User, whID u
 		return webhook.Result{}, fmt.Errorf("fetch currency: %w", err)
 	}
 
-	payload, err := s.prepareDepositHookPayload(mockTxData, *curr, whType, "store_external_example")
+	mockOutcome := payment_policy.Evaluate(payment_policy.DefaultPolicy(), mockTxData.GetAmountUsd(), mockTxData.GetAmountUsd())
+	payload, err := s.prepareDepositHookPayload(mockTxData, *curr, whType, "store_external_example", mockOutcome, nil)
 	if err != nil {
 		return webhook.Result{}, fmt.Errorf("preapare payload: %w", err)
 	}
 
+	payload, err = webhook.EncodePayload(wh.SchemaVersion, mockTxData.GetID(), whType, payload, time.Now())
+	if err != nil {
+		return webhook.Result{}, fmt.Errorf("encode payload: %w", err)
+	}
+
 	secret, err := s.storage.StoreSecrets().GetSecretByStoreID(ctx, wh.StoreID)
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return webhook.Result{}, fmt.Errorf("get store webhook secret: %w", err)
@@ -459,7 +564,7 @@ func (s *Service) SendMockWebhook(ctx context.Context, user *models.User, whID u
 		sign = hash.SHA256Signature(payload, secret)
 	}
 
-	return s.webhookService.SendWebhook(ctx, wh.Url, payload, sign)
+	return s.webhookService.SendWebhook(ctx, wh.StoreID, wh.Url, payload, sign, webhook.DeliverySettingsFromModel(*wh))
 }
 
 func (s *Service) prepareMockTransactionDataForWhTest(whType models.WebhookEvent, wh models.StoreWebhook, userID uuid.UUID) (models.ITransaction, error) {
@@ -592,7 +697,12 @@ func (s *Service) handleAMLCheckCompleted(ev event.IEvent) error {
 		return s.sendAMLBlockedWebhook(ctx, tx, store.ID, *curr, storeExternalID, &completedEv.Check, nil)
 	}
 
-	payload, err := s.prepareDepositHookPayload(tx, *curr, models.WebhookEventPaymentReceived, storeExternalID)
+	outcome, inv, err := s.evaluatePaymentOutcome(ctx, tx, *store, nil)
+	if err != nil {
+		return fmt.Errorf("evaluate payment outcome: %w", err)
+	}
+
+	payload, err := s.prepareDepositHookPayload(tx, *curr, models.WebhookEventPaymentReceived, storeExternalID, outcome, inv)
 	if err != nil {
 		return fmt.Errorf("prepare deposit hook payload: %w", err)
 	}
@@ -618,16 +728,40 @@ func (s *Service) sendWebhookForTx(
 		s.log.Errorw("store webhook not found", "error", err)
 		return nil
 	}
+
+	s.queueWebhooks(ctx, txID, storeID, whType, payload, webhooks, dbTx)
+
+	return nil
+}
+
+// queueWebhooks renders payload in schema version of every webhook and puts it to the send queue
+func (s *Service) queueWebhooks(
+	ctx context.Context,
+	txID uuid.UUID,
+	storeID uuid.UUID,
+	whType models.WebhookEvent,
+	payload []byte,
+	webhooks []*repo_store_webhooks.GetByStoreAndTypeRow,
+	dbTx pgx.Tx,
+) {
+	now := time.Now()
 	for _, wh := range webhooks {
 		if s.isWebhookAlreadySent(ctx, wh.StoreWebhook.Url, whType.String(), txID, dbTx) {
 			continue
 		}
+
+		body, err := webhook.EncodePayload(wh.StoreWebhook.SchemaVersion, txID, whType, payload, now)
+		if err != nil {
+			s.log.Errorw("encode webhook payload", "error", err, "webhook_id", wh.StoreWebhook.ID, "wh_type", whType.String())
+			continue
+		}
+
 		message := webhook.Message{
 			TxID:      txID,
 			WebhookID: wh.StoreWebhook.ID,
 			Type:      whType.String(),
-			Data:      payload,
-			Signature: hash.SHA256Signature(payload, wh.Secret.String),
+			Data:      body,
+			Signature: hash.SHA256Signature(body, wh.Secret.String),
 		}
 
 		if whSendErr := s.webhookService.Send(&message, dbTx); whSendErr != nil {
@@ -637,11 +771,10 @@ func (s *Service) sendWebhookForTx(
 				"store_id", storeID.String(),
 				"tx_id", txID.String(),
 				"wh_type", whType.String(),
-				"wh_body", string(payload),
+				"wh_body", string(body),
 			)
 		}
 	}
-	return nil
 }
 
 func (s *Service) sendAMLBlockedWebhook(
@@ -653,38 +786,42 @@ func (s *Service) sendAMLBlockedWebhook(
 	amlCheck *models.AmlCheck,
 	dbTx pgx.Tx,
 ) error {
+	if err := s.eventListener.Fire(aml.DepositBlockedEvent{
+		TransactionID: tx.GetID(),
+		StoreID:       storeID,
+		Check:         *amlCheck,
+		DBTx:          dbTx,
+	}); err != nil {
+		return fmt.Errorf("fire deposit blocked: %w", err)
+	}
+
 	payload, err := s.prepareAMLBlockedHookPayload(tx, curr, storeExternalID, amlCheck)
 	if err != nil {
 		return fmt.Errorf("prepare AML blocked hook payload: %w", err)
 	}
-	webhooks, err := s.getWebhooksByStore(
-		ctx,
-		storeID,
-		models.WebhookEventPaymentNotConfirmed.String(), // using uncofirmed wh for no created new
-		dbTx,
-	)
+
+	webhooks, err := s.getWebhooksByStore(ctx, storeID, models.WebhookEventPaymentAMLBlocked.String(), dbTx)
 	if err != nil {
 		s.log.Errorw("store webhook not found", "error", err)
 		return nil
 	}
 
-	for _, wh := range webhooks {
-		if s.isWebhookAlreadySent(ctx, wh.StoreWebhook.Url, models.WebhookEventPaymentAMLBlocked.String(), tx.GetID(), dbTx) {
-			continue
-		}
-
-		message := webhook.Message{
-			TxID:      tx.GetID(),
-			WebhookID: wh.StoreWebhook.ID,
-			Type:      models.WebhookEventPaymentAMLBlocked.String(),
-			Data:      payload,
-			Signature: hash.SHA256Signature(payload, wh.Secret.String),
-		}
-		if whSendErr := s.webhookService.Send(&message, dbTx); whSendErr != nil {
-			s.log.Errorw("aml blocked webhook send error", "error", whSendErr, "store_id", storeID, "tx_id", tx.GetID())
+	// webhooks created before PaymentAMLBlocked became subscribable receive it along with unconfirmed payments
+	legacy, err := s.getWebhooksByStore(ctx, storeID, models.WebhookEventPaymentNotConfirmed.String(), dbTx)
+	if err != nil {
+		s.log.Errorw("store webhook not found", "error", err)
+		return nil
+	}
+	for _, wh := range legacy {
+		if !slices.ContainsFunc(webhooks, func(subscribed *repo_store_webhooks.GetByStoreAndTypeRow) bool {
+			return subscribed.StoreWebhook.ID == wh.StoreWebhook.ID
+		}) {
+			webhooks = append(webhooks, wh)
 		}
 	}
 
+	s.queueWebhooks(ctx, tx.GetID(), storeID, models.WebhookEventPaymentAMLBlocked, payload, webhooks, dbTx)
+
 	return nil
 }
 
@@ -727,3 +864,133 @@ func (s *Service) prepareAMLBlockedHookPayload(
 
 	return json.Marshal(payload)
 }
+
+func (s *Service) handleInvoiceStatusChanged(ev event.IEvent) error {
+	changedEv, ok := ev.(invoice.StatusChangedEvent)
+	if !ok {
+		return fmt.Errorf("invalid event type %s", ev.Type())
+	}
+
+	ctx := context.Background()
+
+	policy, err := s.paymentPolicies.GetByStore(ctx, changedEv.Invoice.StoreID, repos.WithTx(changedEv.DBTx))
+	if err != nil {
+		return err
+	}
+
+	outcome := invoice.EvaluatePayment(policy, &changedEv.Invoice, changedEv.Invoice.PaidAmount)
+
+	payload, err := s.prepareInvoiceHookPayload(changedEv, outcome)
+	if err != nil {
+		return fmt.Errorf("prepare invoice hook payload: %w", err)
+	}
+
+	// every state change has own history record, so it is used as webhook deduplication key
+	return s.sendWebhookForTx(
+		ctx,
+		changedEv.History.ID,
+		changedEv.Invoice.StoreID,
+		models.WebhookEventInvoiceStatusChanged,
+		payload,
+		changedEv.DBTx,
+	)
+}
+
+func (s *Service) prepareInvoiceHookPayload(ev invoice.StatusChangedEvent, outcome models.PaymentOutcome) ([]byte, error) {
+	inv := ev.Invoice
+	payload := map[string]any{
+		"type":            models.WebhookEventInvoiceStatusChanged,
+		"status":          inv.Status,
+		"previous_status": ev.History.StatusFrom,
+		"changed_at":      ev.History.CreatedAt,
+		"invoice": map[string]any{
+			"id":                  inv.ID.String(),
+			"order_id":            inv.OrderID.String,
+			"currency_id":         inv.CurrencyID,
+			"address":             inv.Address,
+			"amount":              inv.Amount.String(),
+			"amount_usd":          inv.AmountUsd.String(),
+			"paid_amount":         inv.PaidAmount.String(),
+			"paid_amount_usd":     inv.PaidAmountUsd.String(),
+			"credit_amount_usd":   inv.CreditAmountUsd.String(),
+			"leftover_amount_usd": inv.LeftoverAmountUsd.String(),
+			"leftover_action":     inv.LeftoverAction,
+			"expires_at":          inv.ExpiresAt,
+			"paid_at":             inv.PaidAt,
+			"created_at":          inv.CreatedAt,
+		},
+		"wallet": map[string]any{
+			"id": inv.WalletID.String(),
+		},
+		"payment_outcome": preparePaymentOutcomePayload("", outcome),
+	}
+
+	if ev.Tx != nil {
+		payload["transaction"] = map[string]any{
+			"tx_id":       ev.Tx.ID.String(),
+			"tx_hash":     ev.Tx.TxHash,
+			"bc_uniq_key": ev.Tx.BcUniqKey,
+			"created_at":  ev.Tx.CreatedAt,
+			"currency_id": ev.Tx.CurrencyID,
+			"blockchain":  ev.Tx.Blockchain.String(),
+			"amount":      ev.Tx.Amount.String(),
+			"amount_usd":  ev.Tx.GetAmountUsd().String(),
+		}
+	}
+
+	return json.Marshal(payload)
+}
+
+func (s *Service) handleRefundStatusChanged(ev event.IEvent) error {
+	changedEv, ok := ev.(refund.StatusChangedEvent)
+	if !ok {
+		return fmt.Errorf("invalid event type %s", ev.Type())
+	}
+
+	payload, err := prepareRefundHookPayload(changedEv)
+	if err != nil {
+		return fmt.Errorf("prepare refund hook payload: %w", err)
+	}
+
+	return s.sendWebhookForTx(
+		context.Background(),
+		changedEv.History.ID,
+		changedEv.Refund.StoreID,
+		models.WebhookEventRefundStatusChanged,
+		payload,
+		changedEv.DBTx,
+	)
+}
+
+func prepareRefundHookPayload(ev refund.StatusChangedEvent) ([]byte, error) {
+	r := ev.Refund
+	payload := map[string]any{
+		"type":            models.WebhookEventRefundStatusChanged,
+		"status":          r.Status,
+		"previous_status": ev.History.StatusFrom,
+		"changed_at":      ev.History.CreatedAt,
+		"refund": map[string]any{
+			"id":               r.ID.String(),
+			"reason":           r.Reason,
+			"currency_id":      r.CurrencyID,
+			"amount":           r.Amount.String(),
+			"amount_usd":       r.AmountUsd.String(),
+			"address_to":       r.AddressTo,
+			"refund_tx_hash":   r.RefundTxHash.String,
+			"rejection_reason": r.RejectionReason.String,
+			"failure_reason":   r.FailureReason.String,
+			"created_at":       r.CreatedAt,
+		},
+		"transaction": map[string]any{
+			"tx_id": r.TransactionID.String(),
+		},
+	}
+
+	if r.InvoiceID.Valid {
+		payload["invoice"] = map[string]any{
+			"id": r.InvoiceID.UUID.String(),
+		}
+	}
+
+	return json.Marshal(payload)
+}
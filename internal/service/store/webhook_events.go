@@ -0,0 +1,268 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dv-net/dv-merchant/internal/event"
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/aml"
+	"github.com/dv-net/dv-merchant/internal/service/exchange"
+	"github.com/dv-net/dv-merchant/internal/service/exchange_withdrawal"
+	"github.com/dv-net/dv-merchant/internal/service/wallet"
+	"github.com/dv-net/dv-merchant/internal/service/withdraw"
+	"github.com/dv-net/dv-merchant/internal/storage/repos"
+
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// sendUserWebhook delivers account wide event to every store of the user subscribed to it
+func (s *Service) sendUserWebhook(
+	ctx context.Context,
+	dedupKey uuid.UUID,
+	userID uuid.UUID,
+	whType models.WebhookEvent,
+	payload []byte,
+	dbTx pgx.Tx,
+) error {
+	stores, err := s.storage.Stores(repos.WithTx(dbTx)).GetByUser(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("fetch user stores: %w", err)
+	}
+
+	for _, store := range stores {
+		if err = s.sendWebhookForTx(ctx, dedupKey, store.ID, whType, payload, dbTx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// stateDedupKey identifies state change of the entity, so a redelivered change is queued once per webhook
+// while entity returning to an earlier state is reported again
+func stateDedupKey(entityID uuid.UUID, state string, changedAt pgtype.Timestamp) uuid.UUID {
+	return uuid.NewSHA1(entityID, []byte(state+"@"+changedAt.Time.UTC().Format(time.RFC3339Nano)))
+}
+
+func (s *Service) handleTransferStatusChanged(ev event.IEvent) error {
+	changedEv, ok := ev.(withdraw.TransferStatusChangedEvent)
+	if !ok {
+		return fmt.Errorf("invalid event type %s", ev.Type())
+	}
+
+	t := changedEv.Transfer
+	payload, err := json.Marshal(map[string]any{
+		"type":            models.WebhookEventTransferStatusChanged,
+		"status":          t.Status,
+		"previous_status": changedEv.PreviousStatus,
+		"changed_at":      t.UpdatedAt,
+		"transfer": map[string]any{
+			"id":             t.ID.String(),
+			"number":         t.Number,
+			"kind":           t.Kind,
+			"stage":          t.Stage,
+			"step":           t.Step,
+			"currency_id":    t.CurrencyID,
+			"blockchain":     t.Blockchain.String(),
+			"amount":         t.Amount.String(),
+			"amount_usd":     t.AmountUsd.String(),
+			"from_addresses": t.FromAddresses,
+			"to_addresses":   t.ToAddresses,
+			"tx_hash":        t.TxHash,
+			"message":        t.Message,
+			"created_at":     t.CreatedAt,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("prepare transfer hook payload: %w", err)
+	}
+
+	return s.sendUserWebhook(
+		context.Background(),
+		stateDedupKey(t.ID, t.Status.String(), t.UpdatedAt),
+		t.UserID,
+		models.WebhookEventTransferStatusChanged,
+		payload,
+		changedEv.DBTx,
+	)
+}
+
+func (s *Service) handleExchangeOrderFilled(ev event.IEvent) error {
+	filledEv, ok := ev.(exchange.OrderFilledEvent)
+	if !ok {
+		return fmt.Errorf("invalid event type %s", ev.Type())
+	}
+
+	o := filledEv.Order
+	payload, err := json.Marshal(map[string]any{
+		"type":      models.WebhookEventExchangeOrderFilled,
+		"status":    o.Status,
+		"exchange":  filledEv.Slug,
+		"filled_at": o.UpdatedAt,
+		"order": map[string]any{
+			"id":                o.ID.String(),
+			"exchange_order_id": o.ExchangeOrderID.String,
+			"client_order_id":   o.ClientOrderID.String,
+			"symbol":            o.Symbol,
+			"side":              o.Side,
+			"amount":            o.Amount.String(),
+			"amount_usd":        o.AmountUsd,
+			"order_created_at":  o.OrderCreatedAt,
+			"created_at":        o.CreatedAt,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("prepare exchange order hook payload: %w", err)
+	}
+
+	// order is filled only once, so its id is enough for deduplication
+	return s.sendUserWebhook(
+		context.Background(),
+		o.ID,
+		o.UserID,
+		models.WebhookEventExchangeOrderFilled,
+		payload,
+		filledEv.DBTx,
+	)
+}
+
+func (s *Service) handleExchangeWithdrawalStatusChanged(ev event.IEvent) error {
+	changedEv, ok := ev.(exchange_withdrawal.StatusChangedEvent)
+	if !ok {
+		return fmt.Errorf("invalid event type %s", ev.Type())
+	}
+
+	ctx := context.Background()
+	w := changedEv.Withdrawal
+
+	ex, err := s.storage.Exchanges(repos.WithTx(changedEv.DBTx)).GetByID(ctx, w.ExchangeID)
+	if err != nil {
+		return fmt.Errorf("fetch exchange: %w", err)
+	}
+
+	payload, err := json.Marshal(map[string]any{
+		"type":            models.WebhookEventExchangeWithdrawalStatusChanged,
+		"status":          w.Status,
+		"previous_status": changedEv.PreviousStatus,
+		"changed_at":      w.UpdatedAt,
+		"exchange":        ex.Slug,
+		"withdrawal": map[string]any{
+			"id":                w.ID.String(),
+			"exchange_order_id": w.ExchangeOrderID.String,
+			"address":           w.Address,
+			"currency":          w.Currency,
+			"chain":             w.Chain,
+			"native_amount":     w.NativeAmount,
+			"fiat_amount":       w.FiatAmount,
+			"txid":              w.Txid.String,
+			"fail_reason":       w.FailReason.String,
+			"created_at":        w.CreatedAt,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("prepare exchange withdrawal hook payload: %w", err)
+	}
+
+	return s.sendUserWebhook(
+		ctx,
+		stateDedupKey(w.ID, w.Status.String(), w.UpdatedAt),
+		w.UserID,
+		models.WebhookEventExchangeWithdrawalStatusChanged,
+		payload,
+		changedEv.DBTx,
+	)
+}
+
+func (s *Service) handleWalletAddressCreated(ev event.IEvent) error {
+	createdEv, ok := ev.(wallet.AddressCreatedEvent)
+	if !ok {
+		return fmt.Errorf("invalid event type %s", ev.Type())
+	}
+
+	addr := createdEv.Address
+	payload, err := json.Marshal(map[string]any{
+		"type":       models.WebhookEventWalletAddressCreated,
+		"created_at": addr.CreatedAt,
+		"wallet": map[string]any{
+			"id":                createdEv.Wallet.ID.String(),
+			"store_external_id": createdEv.Wallet.StoreExternalID,
+		},
+		"address": map[string]any{
+			"id":          addr.ID.String(),
+			"currency_id": addr.CurrencyID,
+			"blockchain":  addr.Blockchain.String(),
+			"address":     addr.Address,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("prepare wallet address hook payload: %w", err)
+	}
+
+	return s.sendWebhookForTx(
+		context.Background(),
+		addr.ID,
+		createdEv.Wallet.StoreID,
+		models.WebhookEventWalletAddressCreated,
+		payload,
+		createdEv.DBTx,
+	)
+}
+
+// handleAMLCheckCompletedWebhook reports every finished AML check, whether it blocked the deposit or not
+func (s *Service) handleAMLCheckCompletedWebhook(ev event.IEvent) error {
+	completedEv, ok := ev.(aml.CheckCompletedEvent)
+	if !ok {
+		return fmt.Errorf("invalid event type %s", ev.Type())
+	}
+
+	ctx := context.Background()
+	check := completedEv.Check
+
+	data := map[string]any{
+		"type":   models.WebhookEventAMLCheckCompleted,
+		"status": check.Status,
+		"aml_check": map[string]any{
+			"id":          check.ID.String(),
+			"external_id": check.ExternalID,
+			"score":       check.Score,
+			"risk_level":  check.RiskLevel,
+			"created_at":  check.CreatedAt,
+			"updated_at":  check.UpdatedAt,
+		},
+	}
+
+	storeID := uuid.NullUUID{}
+	if check.TransactionID.Valid {
+		tx, err := s.storage.Transactions().GetById(ctx, check.TransactionID.UUID)
+		if err != nil {
+			return fmt.Errorf("fetch tx: %w", err)
+		}
+
+		storeID = tx.StoreID
+		data["transaction"] = map[string]any{
+			"tx_id":       tx.ID.String(),
+			"tx_hash":     tx.TxHash,
+			"currency_id": tx.CurrencyID,
+			"blockchain":  tx.Blockchain.String(),
+			"amount":      tx.Amount.String(),
+			"amount_usd":  tx.GetAmountUsd().String(),
+		}
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("prepare aml check hook payload: %w", err)
+	}
+
+	dedupKey := stateDedupKey(check.ID, check.Status.String(), check.UpdatedAt)
+	if !storeID.Valid {
+		return s.sendUserWebhook(ctx, dedupKey, check.UserID, models.WebhookEventAMLCheckCompleted, payload, nil)
+	}
+
+	return s.sendWebhookForTx(ctx, dedupKey, storeID.UUID, models.WebhookEventAMLCheckCompleted, payload, nil)
+}
@@ -37,7 +37,7 @@ type DepositReceivedEvent struct {
 	Currency        models.Currency
 	StoreExternalID string
 	WebhookEvent    models.WebhookEvent
-	DBTx            pgx.Tx
+	DBTx            pgx.Tx `json:"-"`
 }
 
 type DepositUnconfirmedEvent struct {
@@ -46,7 +46,7 @@ type DepositUnconfirmedEvent struct {
 	Currency        models.Currency
 	StoreExternalID string
 	WebhookEvent    models.WebhookEvent
-	DBTx            pgx.Tx
+	DBTx            pgx.Tx `json:"-"`
 }
 
 type WithdrawalFromProcessingReceivedEvent struct {
@@ -55,7 +55,7 @@ type WithdrawalFromProcessingReceivedEvent struct {
 	Store        models.Store
 	Currency     models.Currency
 	WebhookEvent models.WebhookEvent
-	DBTx         pgx.Tx
+	DBTx         pgx.Tx `json:"-"`
 }
 
 type DepositReceiptSentEvent struct {
@@ -69,7 +69,7 @@ type DepositReceiptSentEvent struct {
 	WalletLocale    string
 	WalletEmail     string
 	UsdFee          decimal.Decimal
-	DBTx            pgx.Tx
+	DBTx            pgx.Tx `json:"-"`
 }
 
 // withdrawal_from_processing received event
@@ -114,6 +114,10 @@ func (e WithdrawalFromProcessingReceivedEvent) GetDatabaseTx() pgx.Tx {
 	return e.DBTx
 }
 
+func (e WithdrawalFromProcessingReceivedEvent) IdempotencyKey() string {
+	return WithdrawalFromProcessingReceivedEventType + ":" + e.Tx.ID.String()
+}
+
 func (e WithdrawalFromProcessingReceivedEvent) GetWalletLocale() string {
 	return ""
 }
@@ -163,6 +167,10 @@ func (e DepositReceivedEvent) GetDatabaseTx() pgx.Tx {
 	return e.DBTx
 }
 
+func (e DepositReceivedEvent) IdempotencyKey() string {
+	return DepositReceivedEventType + ":" + e.Tx.ID.String()
+}
+
 func (e DepositReceivedEvent) String() string {
 	return fmt.Sprintf("DepositReceived: tx=%v, store=%d, store_external_id=%s, webhook_event=%v", e.Tx, e.Store.ID, e.StoreExternalID, e.WebhookEvent)
 }
@@ -216,6 +224,10 @@ func (e DepositUnconfirmedEvent) GetDatabaseTx() pgx.Tx {
 	return e.DBTx
 }
 
+func (e DepositUnconfirmedEvent) IdempotencyKey() string {
+	return DepositUnconfirmedEventType + ":" + e.Tx.ID.String()
+}
+
 func (e DepositUnconfirmedEvent) String() string {
 	return fmt.Sprintf("DepositReceived: tx=%v, store=%d, store_external_id=%s, webhook_event=%v", e.Tx, e.Store.ID, e.StoreExternalID, e.WebhookEvent)
 }
@@ -269,6 +281,10 @@ func (e DepositReceiptSentEvent) GetDatabaseTx() pgx.Tx {
 	return e.DBTx
 }
 
+func (e DepositReceiptSentEvent) IdempotencyKey() string {
+	return DepositReceiptSentEventType + ":" + e.Tx.ID.String()
+}
+
 func (e DepositReceiptSentEvent) GetWalletLocale() string {
 	return e.WalletLocale
 }
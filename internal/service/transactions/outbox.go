@@ -0,0 +1,55 @@
+package transactions
+
+import (
+	"github.com/goccy/go-json"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/dv-net/dv-merchant/internal/event"
+	"github.com/dv-net/dv-merchant/internal/service/outbox"
+)
+
+var (
+	_ outbox.Event = DepositReceivedEvent{}
+	_ outbox.Event = DepositUnconfirmedEvent{}
+	_ outbox.Event = DepositReceiptSentEvent{}
+	_ outbox.Event = WithdrawalFromProcessingReceivedEvent{}
+)
+
+// RegisterOutboxDecoders allows relay to restore transaction events stored in outbox
+func RegisterOutboxDecoders(o outbox.IOutbox) {
+	o.RegisterDecoder(DepositReceivedEventType, func(payload []byte, dbTx pgx.Tx) (event.IEvent, error) {
+		ev := DepositReceivedEvent{}
+		if err := json.Unmarshal(payload, &ev); err != nil {
+			return nil, err
+		}
+		ev.DBTx = dbTx
+		return ev, nil
+	})
+
+	o.RegisterDecoder(DepositUnconfirmedEventType, func(payload []byte, dbTx pgx.Tx) (event.IEvent, error) {
+		ev := DepositUnconfirmedEvent{}
+		if err := json.Unmarshal(payload, &ev); err != nil {
+			return nil, err
+		}
+		ev.DBTx = dbTx
+		return ev, nil
+	})
+
+	o.RegisterDecoder(DepositReceiptSentEventType, func(payload []byte, dbTx pgx.Tx) (event.IEvent, error) {
+		ev := DepositReceiptSentEvent{}
+		if err := json.Unmarshal(payload, &ev); err != nil {
+			return nil, err
+		}
+		ev.DBTx = dbTx
+		return ev, nil
+	})
+
+	o.RegisterDecoder(WithdrawalFromProcessingReceivedEventType, func(payload []byte, dbTx pgx.Tx) (event.IEvent, error) {
+		ev := WithdrawalFromProcessingReceivedEvent{}
+		if err := json.Unmarshal(payload, &ev); err != nil {
+			return nil, err
+		}
+		ev.DBTx = dbTx
+		return ev, nil
+	})
+}
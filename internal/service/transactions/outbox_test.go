@@ -0,0 +1,108 @@
+package transactions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dv-net/dv-merchant/internal/event"
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/outbox"
+)
+
+type decoderRegistry struct {
+	decoders map[event.Type]outbox.Decoder
+}
+
+func (r *decoderRegistry) Enqueue(context.Context, pgx.Tx, outbox.Event) error { return nil }
+
+func (r *decoderRegistry) RegisterDecoder(t event.Type, d outbox.Decoder) { r.decoders[t] = d }
+
+func (r *decoderRegistry) Replay(context.Context, outbox.ReplayDTO) (int64, error) { return 0, nil }
+
+func (r *decoderRegistry) Run(context.Context) {}
+
+func TestOutboxDecoders(t *testing.T) {
+	registry := &decoderRegistry{decoders: make(map[event.Type]outbox.Decoder)}
+	RegisterOutboxDecoders(registry)
+
+	txID := uuid.New()
+	tx := models.Transaction{
+		ID:        txID,
+		StoreID:   uuid.NullUUID{UUID: uuid.New(), Valid: true},
+		Amount:    decimal.RequireFromString("12.345"),
+		AmountUsd: decimal.NewNullDecimal(decimal.RequireFromString("12.34")),
+		TxHash:    "0xhash",
+	}
+	store := models.Store{
+		ID:             uuid.New(),
+		Name:           "store",
+		RateScale:      decimal.RequireFromString("1.01"),
+		MinimalPayment: decimal.RequireFromString("5"),
+	}
+	currency := models.Currency{ID: "USDT.Tron"}
+
+	tests := []outbox.Event{
+		DepositReceivedEvent{
+			Tx:              tx,
+			Store:           store,
+			Currency:        currency,
+			StoreExternalID: "external",
+			WebhookEvent:    models.WebhookEventPaymentReceived,
+		},
+		DepositUnconfirmedEvent{
+			Tx:           models.UnconfirmedTransaction{ID: txID, Amount: tx.Amount},
+			Store:        store,
+			Currency:     currency,
+			WebhookEvent: models.WebhookEventPaymentNotConfirmed,
+		},
+		DepositReceiptSentEvent{
+			Tx:           tx,
+			Store:        store,
+			Currency:     currency,
+			ExchangeRate: decimal.RequireFromString("0.99"),
+			UsdFee:       decimal.RequireFromString("1.5"),
+			WalletEmail:  "user@example.com",
+			WalletLocale: "en",
+		},
+		WithdrawalFromProcessingReceivedEvent{
+			WithdrawalID: uuid.NewString(),
+			Tx:           tx,
+			Store:        store,
+			Currency:     currency,
+			WebhookEvent: models.WebhookEventWithdrawalFromProcessingReceived,
+		},
+	}
+
+	for _, ev := range tests {
+		t.Run(string(ev.Type()), func(t *testing.T) {
+			require.Equal(t, string(ev.Type())+":"+txID.String(), ev.IdempotencyKey())
+
+			payload, err := json.Marshal(ev)
+			require.NoError(t, err)
+
+			decode, ok := registry.decoders[ev.Type()]
+			require.True(t, ok)
+
+			decoded, err := decode(payload, nil)
+			require.NoError(t, err)
+			require.Equal(t, ev.Type(), decoded.Type())
+
+			converted, ok := decoded.(TransactionEvent)
+			require.True(t, ok)
+			expected, ok := ev.(TransactionEvent)
+			require.True(t, ok)
+			require.Equal(t, expected.GetTx().GetAmountUsd(), converted.GetTx().GetAmountUsd())
+			require.Equal(t, expected.GetStore(), converted.GetStore())
+			require.Equal(t, expected.GetWebhookEvent(), converted.GetWebhookEvent())
+			require.Equal(t, expected.GetStoreExternalID(), converted.GetStoreExternalID())
+			require.True(t, expected.GetExchangeRate().Equal(converted.GetExchangeRate()))
+			require.Equal(t, expected.GetWalletEmail(), converted.GetWalletEmail())
+		})
+	}
+}
@@ -377,11 +377,32 @@ func (s *Service) prepareTransactionsForExport(ctx context.Context, txs []*repo_
 		if tx.AmountUsd.Valid {
 			userTxModel.AmountUsd = tx.AmountUsd.Decimal.String()
 		}
+		if tx.NetworkCreatedAt.Valid {
+			userTxModel.RateSource = store.RateSource.String()
+			userTxModel.ExchangeRateUsd = s.exportExchangeRate(ctx, store.RateSource.String(), tx.Currency.Code, tx.NetworkCreatedAt.Time)
+		}
 		transactions = append(transactions, userTxModel)
 	}
 	return transactions, nil
 }
 
+// exportExchangeRate returns USD rate snapshotted at transaction network time, empty when no snapshot exists
+func (s *Service) exportExchangeRate(ctx context.Context, source, currencyCode string, at time.Time) string {
+	rate, err := s.conv.Convert(ctx, currconv.ConvertDTO{
+		Source: source,
+		From:   currencyCode,
+		To:     models.CurrencyCodeUSDT,
+		Amount: "1",
+		At:     &at,
+	})
+	if err != nil {
+		s.log.Debugw("historical rate for export not found", "error", err, "currency", currencyCode, "at", at)
+		return ""
+	}
+
+	return rate.String()
+}
+
 func (s *Service) GetLastWalletDepositTransactions(ctx context.Context, walletID uuid.UUID) ([]ShortTransactionInfo, error) {
 	res, err := s.storage.Transactions().FindLastWalletTransactions(ctx, repo_transactions.FindLastWalletTransactionsParams{
 		WalletID: uuid.NullUUID{UUID: walletID, Valid: true},
@@ -158,6 +158,8 @@ type UserTransactionModel struct {
 	Amount           string    `json:"amount" csv:"amount" excel:"amount"`
 	AmountUsd        string    `json:"amount_usd" csv:"amount_usd" excel:"amount_usd"`
 	Fee              string    `json:"fee" csv:"fee" excel:"fee"`
+	RateSource       string    `json:"rate_source" csv:"rate_source" excel:"rate_source"`
+	ExchangeRateUsd  string    `json:"exchange_rate_usd" csv:"exchange_rate_usd" excel:"exchange_rate_usd"`
 	NetworkCreatedAt time.Time `json:"network_created_at" csv:"network_created_at" excel:"network_created_at"`
 	CreatedAt        time.Time `json:"created_at" csv:"created_at" excel:"created_at"`
 	IsSystem         bool      `json:"is_system" csv:"is_system" excel:"is_system"`
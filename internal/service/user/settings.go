@@ -3,10 +3,15 @@ package user
 import (
 	"context"
 	"errors"
+	"fmt"
 	"slices"
+	"time"
 
 	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/notify"
 	"github.com/dv-net/dv-merchant/internal/service/setting"
+
+	"github.com/jackc/pgx/v5"
 )
 
 type SettingUpdateDTO struct {
@@ -83,6 +88,10 @@ func (s *Service) processUserSettingUpdate(
 		return errors.New("invalid user data")
 	}
 
+	if settingName == setting.WithdrawalAllowlist {
+		return s.updateWithdrawalAllowlist(ctx, usr, newValue, settingTarget)
+	}
+
 	if newValue == nil {
 		return s.settingsService.RemoveSetting(ctx, usr, settingName)
 	}
@@ -94,6 +103,44 @@ func (s *Service) processUserSettingUpdate(
 	})
 }
 
+// updateWithdrawalAllowlist enables the allowlist at once, disabling is delayed by the address book cooling period
+// and the user is notified about it like about a new address book entry
+func (s *Service) updateWithdrawalAllowlist(ctx context.Context, usr *models.User, newValue *string, settingTarget setting.IModelSetting) error {
+	if newValue != nil && *newValue == setting.FlagValueEnabled {
+		return s.settingsService.SetModelSetting(ctx, setting.UpdateDTO{
+			Name:  setting.WithdrawalAllowlist,
+			Value: setting.FlagValueEnabled,
+			Model: settingTarget,
+		})
+	}
+
+	current, err := s.settingsService.GetModelSetting(ctx, setting.WithdrawalAllowlist, settingTarget)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("get allowlist setting: %w", err)
+	}
+	// allowlist is not enforced or disabling is already in progress
+	if current == nil || current.Value != setting.FlagValueEnabled {
+		return nil
+	}
+
+	if err = s.settingsService.SetModelSetting(ctx, setting.UpdateDTO{
+		Name:  setting.WithdrawalAllowlist,
+		Value: setting.AllowlistValueDisabling,
+		Model: settingTarget,
+	}); err != nil {
+		return err
+	}
+
+	s.notificationService.SendUser(ctx, models.NotificationTypeWithdrawalAllowlistDisabling, usr, &notify.WithdrawalAllowlistDisablingData{
+		Language:   usr.Language,
+		DisabledAt: time.Now().Add(s.cfg.WithdrawalAllowlist.CoolingPeriod).UTC().Format("2006-01-02T15:04:05Z"),
+	}, &models.NotificationArgs{
+		UserID: &usr.ID,
+	})
+
+	return nil
+}
+
 func (s *Service) ensure2faEnabledByUser(ctx context.Context, usr *models.User) error {
 	if !usr.ProcessingOwnerID.Valid {
 		return ErrOwnerIDIsNotSet
@@ -0,0 +1,27 @@
+package wallet
+
+import (
+	"fmt"
+
+	"github.com/dv-net/dv-merchant/internal/event"
+	"github.com/dv-net/dv-merchant/internal/models"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const AddressCreatedEventType = "wallet_address_created"
+
+// AddressCreatedEvent is fired when a new deposit address is issued for a store wallet
+type AddressCreatedEvent struct {
+	Wallet  models.Wallet
+	Address models.WalletAddress
+	DBTx    pgx.Tx
+}
+
+func (e AddressCreatedEvent) Type() event.Type {
+	return AddressCreatedEventType
+}
+
+func (e AddressCreatedEvent) String() string {
+	return fmt.Sprintf("WalletAddressCreated: wallet=%s, address=%s", e.Wallet.ID, e.Address.Address)
+}
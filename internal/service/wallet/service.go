@@ -7,6 +7,7 @@ import (
 	"sync/atomic"
 
 	"github.com/dv-net/dv-merchant/internal/config"
+	"github.com/dv-net/dv-merchant/internal/event"
 	"github.com/dv-net/dv-merchant/internal/models"
 	"github.com/dv-net/dv-merchant/internal/service/currconv"
 	"github.com/dv-net/dv-merchant/internal/service/currency"
@@ -73,6 +74,7 @@ type Service struct {
 	settingService    setting.ISettingService
 	eproxyService     eproxy.IExplorerProxy
 	notification      notify.INotificationService
+	eventListener     event.IListener
 
 	updateBalanceInProgress         atomic.Bool
 	updateProcessingStatsInProgress atomic.Bool
@@ -94,6 +96,7 @@ func New(
 	settingsService setting.ISettingService,
 	eproxyService eproxy.IExplorerProxy,
 	notification notify.INotificationService,
+	eventListener event.IListener,
 ) *Service {
 	return &Service{
 		cfg:               cfg,
@@ -106,5 +109,6 @@ func New(
 		settingService:    settingsService,
 		eproxyService:     eproxyService,
 		notification:      notification,
+		eventListener:     eventListener,
 	}
 }
@@ -244,6 +244,14 @@ func (s *Service) createNewWalletAddress(
 		return nil, fmt.Errorf("failed to create new wallet address: %w", err)
 	}
 
+	if fireErr := s.eventListener.Fire(AddressCreatedEvent{
+		Wallet:  *wallet,
+		Address: *walletAddress,
+		DBTx:    dbTx,
+	}); fireErr != nil {
+		s.logger.Errorw("fire wallet address created", "error", fireErr, "wallet_id", wallet.ID, "currency_id", c.ID)
+	}
+
 	return walletAddress, nil
 }
 
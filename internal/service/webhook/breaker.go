@@ -0,0 +1,76 @@
+package webhook
+
+import (
+	"sync"
+	"time"
+)
+
+// hostBreakers keeps circuit breaker per receiver host, so one dead receiver does not occupy workers.
+// Breaker opens after threshold failures in a row, once open duration passes single probe delivery
+// is let through: its success closes the breaker, failure opens it again.
+type hostBreakers struct {
+	mu        sync.Mutex
+	threshold int
+	openFor   time.Duration
+	hosts     map[string]*hostBreaker
+	now       func() time.Time
+}
+
+type hostBreaker struct {
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+func newHostBreakers(threshold int, openFor time.Duration) *hostBreakers {
+	return &hostBreakers{
+		threshold: threshold,
+		openFor:   openFor,
+		hosts:     make(map[string]*hostBreaker),
+		now:       time.Now,
+	}
+}
+
+// allow reports whether delivery to host may start, otherwise returns time left until next probe
+func (b *hostBreakers) allow(host string) (time.Duration, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	br, ok := b.hosts[host]
+	if !ok || br.failures < b.threshold {
+		return 0, true
+	}
+
+	if wait := br.openUntil.Sub(b.now()); wait > 0 {
+		return wait, false
+	}
+	if br.probing {
+		return b.openFor, false
+	}
+
+	br.probing = true
+	return 0, true
+}
+
+// record registers delivery outcome for host
+func (b *hostBreakers) record(host string, healthy bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if healthy {
+		delete(b.hosts, host)
+		return
+	}
+
+	br, ok := b.hosts[host]
+	if !ok {
+		br = &hostBreaker{}
+		b.hosts[host] = br
+	}
+
+	br.probing = false
+	br.failures++
+	if br.failures >= b.threshold {
+		br.openUntil = b.now().Add(b.openFor)
+	}
+}
@@ -0,0 +1,42 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHostBreakers(t *testing.T) {
+	now := time.Now()
+	b := newHostBreakers(2, time.Minute)
+	b.now = func() time.Time { return now }
+
+	b.record("a.example", false)
+	_, ok := b.allow("a.example")
+	assert.True(t, ok, "below threshold")
+
+	b.record("a.example", false)
+	wait, ok := b.allow("a.example")
+	assert.False(t, ok, "opened")
+	assert.Equal(t, time.Minute, wait)
+	_, ok = b.allow("b.example")
+	assert.True(t, ok, "other hosts are not affected")
+
+	now = now.Add(time.Minute)
+	_, ok = b.allow("a.example")
+	assert.True(t, ok, "probe after open duration")
+	_, ok = b.allow("a.example")
+	assert.False(t, ok, "single probe at a time")
+
+	b.record("a.example", false)
+	_, ok = b.allow("a.example")
+	assert.False(t, ok, "failed probe opens again")
+
+	now = now.Add(time.Minute)
+	_, ok = b.allow("a.example")
+	assert.True(t, ok)
+	b.record("a.example", true)
+	_, ok = b.allow("a.example")
+	assert.True(t, ok, "successful probe closes")
+}
@@ -0,0 +1,99 @@
+package webhook
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_store_webhook_client_certificates"
+	"github.com/dv-net/dv-merchant/pkg/pgtypeutils"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+func (s *service) GetClientCertificate(ctx context.Context, storeID uuid.UUID) (*models.StoreWebhookClientCertificate, error) {
+	cert, err := s.storage.StoreWebhookClientCertificates().GetByStoreID(ctx, storeID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrClientCertificateNotFound
+		}
+		return nil, fmt.Errorf("fetch webhook client certificate: %w", err)
+	}
+
+	return cert, nil
+}
+
+// SetClientCertificate stores PEM encoded certificate chain and its private key presented to store webhook endpoints
+func (s *service) SetClientCertificate(ctx context.Context, storeID uuid.UUID, certPEM, keyPEM string) (*models.StoreWebhookClientCertificate, error) {
+	keyPair, err := tls.X509KeyPair([]byte(certPEM), []byte(keyPEM))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidClientCertificate, err)
+	}
+
+	leaf, err := x509.ParseCertificate(keyPair.Certificate[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidClientCertificate, err)
+	}
+	if time.Now().After(leaf.NotAfter) {
+		return nil, ErrClientCertificateExpired
+	}
+
+	fingerprint := sha256.Sum256(leaf.Raw)
+	cert, err := s.storage.StoreWebhookClientCertificates().Upsert(ctx, repo_store_webhook_client_certificates.UpsertParams{
+		StoreID:     storeID,
+		Certificate: certPEM,
+		PrivateKey:  keyPEM,
+		Fingerprint: hex.EncodeToString(fingerprint[:]),
+		Subject:     truncate(leaf.Subject.String(), 255),
+		ExpiresAt:   pgtypeutils.EncodeTime(leaf.NotAfter),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("save webhook client certificate: %w", err)
+	}
+
+	return cert, nil
+}
+
+func (s *service) DeleteClientCertificate(ctx context.Context, storeID uuid.UUID) error {
+	affected, err := s.storage.StoreWebhookClientCertificates().DeleteByStoreID(ctx, storeID)
+	if err != nil {
+		return fmt.Errorf("delete webhook client certificate: %w", err)
+	}
+	if affected == 0 {
+		return ErrClientCertificateNotFound
+	}
+
+	s.transport.forget(storeID)
+
+	return nil
+}
+
+func (s *service) SourceIPs() []string {
+	return s.transport.SourceIPs()
+}
+
+// storeClient returns http client presenting store client certificate if one is configured
+func (s *service) deliveryClient(ctx context.Context, storeID uuid.UUID) (*http.Client, error) {
+	cert, err := s.storage.StoreWebhookClientCertificates().GetByStoreID(ctx, storeID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("fetch webhook client certificate: %w", err)
+	}
+
+	return s.transport.client(storeID, cert)
+}
+
+func truncate(value string, limit int) string {
+	if len(value) <= limit {
+		return value
+	}
+
+	return value[:limit]
+}
@@ -0,0 +1,154 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/storage/repos"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_webhook_dead_letters"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_webhook_send_queue"
+	"github.com/dv-net/dv-merchant/internal/storage/storecmn"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const requeueBatchSize = 1000
+
+func (s *service) ListDeadLetters(ctx context.Context, storeID uuid.UUID, dto ListDeadLettersDTO) (*storecmn.FindResponseWithFullPagination[*models.WebhookDeadLetter], error) {
+	commonParams := storecmn.NewCommonFindParams()
+	commonParams.SetPage(dto.Page)
+	commonParams.SetPageSize(dto.PageSize)
+
+	return s.storage.WebhookDeadLetters().GetByParams(ctx, repo_webhook_dead_letters.GetByParamsParams{
+		CommonFindParams: *commonParams,
+		StoreID:          storeID,
+		TransactionID:    dto.TransactionID,
+		Event:            dto.Event,
+		Requeued:         dto.Requeued,
+	})
+}
+
+func (s *service) GetDeadLetter(ctx context.Context, storeID uuid.UUID, id uuid.UUID) (*models.WebhookDeadLetter, error) {
+	deadLetter, err := s.storage.WebhookDeadLetters().GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrDeadLetterNotFound
+		}
+		return nil, fmt.Errorf("fetch webhook dead letter: %w", err)
+	}
+	if deadLetter.StoreID != storeID {
+		return nil, ErrDeadLetterNotFound
+	}
+
+	return deadLetter, nil
+}
+
+// RequeueDeadLetters puts the given dead letters back to the send queue, all pending ones when ids are empty
+func (s *service) RequeueDeadLetters(ctx context.Context, storeID uuid.UUID, ids []uuid.UUID) (*RequeueResultDTO, error) {
+	if len(ids) > requeueBatchSize {
+		return nil, ErrTooManyDeadLetterIDs
+	}
+
+	res := &RequeueResultDTO{}
+	err := repos.BeginTxFunc(ctx, s.storage.PSQLConn(), pgx.TxOptions{}, func(tx pgx.Tx) error {
+		var (
+			deadLetters []*models.WebhookDeadLetter
+			err         error
+		)
+		if len(ids) > 0 {
+			deadLetters, err = s.storage.WebhookDeadLetters(repos.WithTx(tx)).GetPendingByIDsForUpdate(ctx, repo_webhook_dead_letters.GetPendingByIDsForUpdateParams{
+				StoreID: storeID,
+				Ids:     ids,
+			})
+		} else {
+			deadLetters, err = s.storage.WebhookDeadLetters(repos.WithTx(tx)).GetPendingByStoreForUpdate(ctx, repo_webhook_dead_letters.GetPendingByStoreForUpdateParams{
+				StoreID: storeID,
+				Limit:   requeueBatchSize,
+			})
+		}
+		if err != nil {
+			return fmt.Errorf("fetch webhook dead letters: %w", err)
+		}
+
+		for _, deadLetter := range deadLetters {
+			queued, err := s.storage.WebHookSendQueue(repos.WithTx(tx)).Requeue(ctx, repo_webhook_send_queue.RequeueParams{
+				WebhookID:     deadLetter.WebhookID,
+				TransactionID: deadLetter.TransactionID,
+				Payload:       deadLetter.Payload,
+				Signature:     deadLetter.Signature,
+				Event:         deadLetter.Event,
+			})
+			if err != nil {
+				return fmt.Errorf("requeue webhook: %w", err)
+			}
+			if queued == 0 {
+				res.AlreadyQueued++
+				continue
+			}
+
+			if err = s.storage.WebhookDeadLetters(repos.WithTx(tx)).MarkRequeued(ctx, deadLetter.ID); err != nil {
+				return fmt.Errorf("mark webhook dead letter requeued: %w", err)
+			}
+			res.Requeued++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// moveToDeadLetters stores exhausted message and removes it from the send queue
+func (s *service) moveToDeadLetters(ctx context.Context, dto PreparedHookDto, result Result, attempts int) error {
+	var deadLetter *models.WebhookDeadLetter
+	err := repos.BeginTxFunc(ctx, s.storage.PSQLConn(), pgx.TxOptions{}, func(tx pgx.Tx) error {
+		var lastResponse *string
+		if result.Response != "" {
+			lastResponse = &result.Response
+		}
+
+		var err error
+		deadLetter, err = s.storage.WebhookDeadLetters(repos.WithTx(tx)).Create(ctx, repo_webhook_dead_letters.CreateParams{
+			SendQueueJobID:         dto.ID.UUID,
+			WebhookID:              dto.WebhookID,
+			StoreID:                dto.StoreID,
+			TransactionID:          dto.TransactionID,
+			Event:                  dto.Event,
+			Url:                    dto.URL,
+			Payload:                dto.Payload,
+			Signature:              dto.Signature,
+			Attempts:               int32(attempts),
+			LastResponse:           lastResponse,
+			LastResponseStatusCode: int32(result.ResponseStatusCode),
+		})
+		if err != nil {
+			return fmt.Errorf("create webhook dead letter: %w", err)
+		}
+
+		if err = s.storage.WebHookSendQueue(repos.WithTx(tx)).Delete(ctx, dto.ID.UUID); err != nil {
+			return fmt.Errorf("delete webhook from queue: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.log.Warnw(
+		"webhook moved to dead letters",
+		"dead_letter_id", deadLetter.ID.String(),
+		"store_id", dto.StoreID.String(),
+		"tx_id", dto.TransactionID.String(),
+		"event", dto.Event,
+		"attempts", attempts,
+	)
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package webhook
+
+import (
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+)
+
+const (
+	DefaultSuccessJSONPath  = "success"
+	DefaultSuccessJSONValue = "true"
+	MaxRequestTimeout       = 2 * time.Minute
+	maxSuccessStatusCodes   = 20
+)
+
+// DeliverySettings describes payload schema of store webhook, how long to wait for its response
+// and which responses count as delivered
+type DeliverySettings struct {
+	SuccessMode        models.WebhookSuccessMode
+	SuccessStatusCodes []int32
+	SuccessJSONPath    string
+	SuccessJSONValue   string
+	// Timeout overrides configured request timeout when positive
+	Timeout       time.Duration
+	SchemaVersion int32
+}
+
+// DefaultDeliverySettings keeps legacy behaviour: 2xx response with {"success": true} body
+func DefaultDeliverySettings() DeliverySettings {
+	return DeliverySettings{
+		SuccessMode:      models.WebhookSuccessJSONBody,
+		SuccessJSONPath:  DefaultSuccessJSONPath,
+		SuccessJSONValue: DefaultSuccessJSONValue,
+		SchemaVersion:    SchemaVersionLegacy,
+	}
+}
+
+func DeliverySettingsFromModel(wh models.StoreWebhook) DeliverySettings {
+	return DeliverySettings{
+		SuccessMode:        wh.SuccessMode,
+		SuccessStatusCodes: wh.SuccessStatusCodes,
+		SuccessJSONPath:    wh.SuccessJsonPath,
+		SuccessJSONValue:   wh.SuccessJsonValue,
+		Timeout:            time.Duration(wh.TimeoutSeconds) * time.Second,
+		SchemaVersion:      wh.SchemaVersion,
+	}
+}
+
+func (d DeliverySettings) Validate() error {
+	if !d.SuccessMode.Valid() {
+		return ErrInvalidSuccessMode
+	}
+	if d.SuccessMode == models.WebhookSuccessStatusCodes && len(d.SuccessStatusCodes) == 0 {
+		return ErrInvalidSuccessStatusCodes
+	}
+	if len(d.SuccessStatusCodes) > maxSuccessStatusCodes {
+		return ErrInvalidSuccessStatusCodes
+	}
+	for _, code := range d.SuccessStatusCodes {
+		if code < 100 || code > 599 {
+			return ErrInvalidSuccessStatusCodes
+		}
+	}
+	if d.SuccessMode == models.WebhookSuccessJSONBody && strings.TrimSpace(d.SuccessJSONPath) == "" {
+		return ErrInvalidSuccessJSONPath
+	}
+	if d.Timeout < 0 || d.Timeout > MaxRequestTimeout {
+		return ErrInvalidRequestTimeout
+	}
+
+	return ValidateSchemaVersion(d.SchemaVersion)
+}
+
+// IsSuccess reports whether store webhook response satisfies delivery settings
+func (d DeliverySettings) IsSuccess(statusCode int, body []byte) bool {
+	switch d.SuccessMode {
+	case models.WebhookSuccessAny2xx:
+		return is2xx(statusCode)
+	case models.WebhookSuccessStatusCodes:
+		return slices.ContainsFunc(d.SuccessStatusCodes, func(code int32) bool {
+			return int(code) == statusCode
+		})
+	case models.WebhookSuccessJSONBody:
+		return is2xx(statusCode) && matchJSONValue(body, d.SuccessJSONPath, d.SuccessJSONValue)
+	default:
+		return false
+	}
+}
+
+func (d DeliverySettings) requestTimeout(fallback time.Duration) time.Duration {
+	if d.Timeout > 0 {
+		return d.Timeout
+	}
+
+	return fallback
+}
+
+func is2xx(statusCode int) bool {
+	return statusCode >= http.StatusOK && statusCode < http.StatusMultipleChoices
+}
+
+// matchJSONValue looks up dot separated path in body, numeric segments address array items.
+// Expected value is compared as JSON literal, or as plain string when it is not valid JSON.
+func matchJSONValue(body []byte, path, expected string) bool {
+	var current any
+	if err := json.Unmarshal(body, &current); err != nil {
+		return false
+	}
+
+	for _, key := range strings.Split(path, ".") {
+		switch node := current.(type) {
+		case map[string]any:
+			value, ok := node[key]
+			if !ok {
+				return false
+			}
+			current = value
+		case []any:
+			idx, err := strconv.Atoi(key)
+			if err != nil || idx < 0 || idx >= len(node) {
+				return false
+			}
+			current = node[idx]
+		default:
+			return false
+		}
+	}
+
+	var want any
+	if err := json.Unmarshal([]byte(expected), &want); err != nil {
+		want = expected
+	}
+
+	return reflect.DeepEqual(current, want)
+}
@@ -0,0 +1,37 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeliverySettings_IsSuccess(t *testing.T) {
+	legacy := DefaultDeliverySettings()
+	assert.True(t, legacy.IsSuccess(200, []byte(`{"success": true}`)))
+	assert.False(t, legacy.IsSuccess(200, []byte(`{"success": false}`)))
+	assert.False(t, legacy.IsSuccess(500, []byte(`{"success": true}`)))
+	assert.False(t, legacy.IsSuccess(204, nil))
+
+	any2xx := DeliverySettings{SuccessMode: models.WebhookSuccessAny2xx}
+	assert.True(t, any2xx.IsSuccess(204, nil))
+	assert.False(t, any2xx.IsSuccess(302, nil))
+
+	statuses := DeliverySettings{SuccessMode: models.WebhookSuccessStatusCodes, SuccessStatusCodes: []int32{200, 409}}
+	assert.True(t, statuses.IsSuccess(409, nil))
+	assert.False(t, statuses.IsSuccess(201, nil))
+
+	nested := DeliverySettings{SuccessMode: models.WebhookSuccessJSONBody, SuccessJSONPath: "data.items.1.state", SuccessJSONValue: "accepted"}
+	assert.True(t, nested.IsSuccess(201, []byte(`{"data": {"items": [{"state": "new"}, {"state": "accepted"}]}}`)))
+	assert.False(t, nested.IsSuccess(201, []byte(`{"data": {"items": [{"state": "accepted"}]}}`)))
+}
+
+func TestDeliverySettings_Validate(t *testing.T) {
+	assert.NoError(t, DefaultDeliverySettings().Validate())
+	assert.ErrorIs(t, DeliverySettings{SuccessMode: "unknown"}.Validate(), ErrInvalidSuccessMode)
+	assert.ErrorIs(t, DeliverySettings{SuccessMode: models.WebhookSuccessStatusCodes}.Validate(), ErrInvalidSuccessStatusCodes)
+	assert.ErrorIs(t, DeliverySettings{SuccessMode: models.WebhookSuccessJSONBody}.Validate(), ErrInvalidSuccessJSONPath)
+	assert.ErrorIs(t, DeliverySettings{SuccessMode: models.WebhookSuccessAny2xx, Timeout: MaxRequestTimeout + 1}.Validate(), ErrInvalidRequestTimeout)
+}
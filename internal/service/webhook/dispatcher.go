@@ -0,0 +1,131 @@
+package webhook
+
+import (
+	"context"
+	"math"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dv-net/dv-merchant/internal/config"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_webhook_send_queue"
+
+	"github.com/google/uuid"
+)
+
+// endpointBusyDelay postpones message whose webhook already has max deliveries in flight
+const endpointBusyDelay = time.Second
+
+// dispatcher claims due queue messages and delivers them with a pool of workers.
+// Messages are leased in database with SKIP LOCKED, so several instances share the queue safely.
+type dispatcher struct {
+	svc       *service
+	cfg       config.WebHookDispatcher
+	endpoints *endpointLimiter
+	breakers  *hostBreakers
+	busy      atomic.Int64
+}
+
+func newDispatcher(svc *service, cfg config.WebHookDispatcher) *dispatcher {
+	return &dispatcher{
+		svc:       svc,
+		cfg:       cfg,
+		endpoints: newEndpointLimiter(cfg.EndpointConcurrency),
+		breakers:  newHostBreakers(cfg.BreakerFailures, cfg.BreakerOpenDuration),
+	}
+}
+
+func (d *dispatcher) run(ctx context.Context) {
+	jobs := make(chan *repo_webhook_send_queue.ClaimQueuedWebhooksRow)
+	wg := sync.WaitGroup{}
+	for range d.cfg.Workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for msg := range jobs {
+				d.deliver(ctx, msg)
+				d.busy.Add(-1)
+			}
+		}()
+	}
+	defer func() {
+		close(jobs)
+		wg.Wait()
+	}()
+
+	ticker := time.NewTicker(d.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			free := d.cfg.Workers - int(d.busy.Load())
+			if free <= 0 {
+				continue
+			}
+
+			messages, err := d.svc.storage.WebHookSendQueue().ClaimQueuedWebhooks(ctx, repo_webhook_send_queue.ClaimQueuedWebhooksParams{
+				BatchSize:    int32(free), //nolint:gosec
+				LeaseSeconds: durationSeconds(d.cfg.LeaseDuration),
+			})
+			if err != nil {
+				d.svc.log.Errorw("claim webhook queue messages", "error", err)
+				continue
+			}
+
+			for _, msg := range messages {
+				d.busy.Add(1)
+				jobs <- msg
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (d *dispatcher) deliver(ctx context.Context, msg *repo_webhook_send_queue.ClaimQueuedWebhooksRow) {
+	if !d.endpoints.acquire(msg.WebhookID) {
+		d.release(ctx, msg.ID, endpointBusyDelay)
+		return
+	}
+	defer d.endpoints.release(msg.WebhookID)
+
+	host := receiverHost(msg.Url)
+	if wait, ok := d.breakers.allow(host); !ok {
+		d.release(ctx, msg.ID, wait)
+		return
+	}
+
+	result, err := d.svc.processMessage(ctx, prepareHookDtoByRaw(msg))
+	if err != nil {
+		d.svc.log.Errorw("Processing wh message", "error", err)
+	}
+
+	// receiver responding with client errors is alive, only transport failures and 5xx trip the breaker
+	d.breakers.record(host, result.ResponseStatusCode > 0 && result.ResponseStatusCode < 500)
+}
+
+// release gives message back to the queue without counting delivery attempt
+func (d *dispatcher) release(ctx context.Context, id uuid.UUID, delay time.Duration) {
+	if err := d.svc.storage.WebHookSendQueue().Release(ctx, repo_webhook_send_queue.ReleaseParams{
+		ID:    id,
+		Delay: durationSeconds(delay),
+	}); err != nil {
+		d.svc.log.Errorw("release webhook queue message", "error", err, "id", id)
+	}
+}
+
+func receiverHost(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return rawURL
+	}
+
+	return strings.ToLower(u.Host)
+}
+
+// durationSeconds rounds duration up to whole seconds
+func durationSeconds(d time.Duration) int32 {
+	return int32(min(math.Ceil(d.Seconds()), math.MaxInt32))
+}
@@ -0,0 +1,32 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+
+	"github.com/google/uuid"
+)
+
+type UpdateRetryPolicyDTO struct {
+	PolicyType  models.WebhookRetryPolicyType
+	MaxAttempts int32
+	Delay       time.Duration
+	MaxDelay    time.Duration
+	Schedule    []time.Duration
+}
+
+type ListDeadLettersDTO struct {
+	TransactionID *uuid.UUID
+	Event         *string
+	Requeued      *bool
+	Page          *uint32
+	PageSize      *uint32
+}
+
+type RequeueResultDTO struct {
+	// Requeued dead letters that were put back to the send queue
+	Requeued int
+	// AlreadyQueued dead letters whose webhook is queued for the same transaction already
+	AlreadyQueued int
+}
@@ -0,0 +1,44 @@
+package webhook
+
+import (
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// endpointLimiter bounds concurrent deliveries to the same store webhook
+type endpointLimiter struct {
+	mu       sync.Mutex
+	limit    int
+	inFlight map[uuid.UUID]int
+}
+
+func newEndpointLimiter(limit int) *endpointLimiter {
+	return &endpointLimiter{
+		limit:    limit,
+		inFlight: make(map[uuid.UUID]int),
+	}
+}
+
+func (l *endpointLimiter) acquire(webhookID uuid.UUID) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.inFlight[webhookID] >= l.limit {
+		return false
+	}
+	l.inFlight[webhookID]++
+
+	return true
+}
+
+func (l *endpointLimiter) release(webhookID uuid.UUID) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.inFlight[webhookID] <= 1 {
+		delete(l.inFlight, webhookID)
+		return
+	}
+	l.inFlight[webhookID]--
+}
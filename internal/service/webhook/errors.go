@@ -0,0 +1,20 @@
+package webhook
+
+import "errors"
+
+var (
+	ErrInvalidRetryPolicy        = errors.New("invalid webhook retry policy type")
+	ErrInvalidMaxAttempts        = errors.New("max attempts must be between 1 and 100")
+	ErrInvalidRetryDelay         = errors.New("retry delay must be positive and must not exceed 7 days")
+	ErrInvalidRetrySchedule      = errors.New("custom retry policy requires 1 to 100 positive delays")
+	ErrDeadLetterNotFound        = errors.New("webhook dead letter not found")
+	ErrTooManyDeadLetterIDs      = errors.New("too many dead letters requested at once")
+	ErrInvalidSuccessMode        = errors.New("invalid webhook success mode")
+	ErrInvalidSuccessStatusCodes = errors.New("success status codes must contain 1 to 20 http status codes")
+	ErrInvalidSuccessJSONPath    = errors.New("json body success mode requires json path")
+	ErrInvalidRequestTimeout     = errors.New("webhook request timeout must not exceed 2 minutes")
+	ErrClientCertificateNotFound = errors.New("webhook client certificate not found")
+	ErrInvalidClientCertificate  = errors.New("invalid webhook client certificate or private key")
+	ErrClientCertificateExpired  = errors.New("webhook client certificate is expired")
+	ErrInvalidSchemaVersion      = errors.New("unsupported webhook schema version")
+)
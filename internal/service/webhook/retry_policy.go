@@ -0,0 +1,138 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_store_webhook_retry_policies"
+	"github.com/dv-net/dv-merchant/pkg/retry"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	maxRetryAttempts  = 100
+	maxRetryDelay     = 7 * 24 * time.Hour
+	maxRetryScheduled = 100
+)
+
+// GetRetryPolicy returns store retry policy or the default one when store has not configured it yet
+func (s *service) GetRetryPolicy(ctx context.Context, storeID uuid.UUID) (models.StoreWebhookRetryPolicy, error) {
+	policy, err := s.storage.StoreWebhookRetryPolicies().GetByStoreID(ctx, storeID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			defaultPolicy := s.defaultRetryPolicy()
+			defaultPolicy.StoreID = storeID
+			return defaultPolicy, nil
+		}
+		return models.StoreWebhookRetryPolicy{}, fmt.Errorf("fetch store webhook retry policy: %w", err)
+	}
+
+	return *policy, nil
+}
+
+func (s *service) UpdateRetryPolicy(ctx context.Context, storeID uuid.UUID, dto UpdateRetryPolicyDTO) (*models.StoreWebhookRetryPolicy, error) {
+	if err := validateRetryPolicy(dto); err != nil {
+		return nil, err
+	}
+
+	params := repo_store_webhook_retry_policies.UpsertParams{
+		StoreID:         storeID,
+		PolicyType:      dto.PolicyType,
+		MaxAttempts:     dto.MaxAttempts,
+		ScheduleSeconds: []int32{},
+	}
+	// only the settings used by the chosen policy are kept
+	switch dto.PolicyType {
+	case models.WebhookRetryPolicyFixed:
+		params.DelaySeconds = int32(dto.Delay / time.Second)
+	case models.WebhookRetryPolicyExponential:
+		params.DelaySeconds = int32(dto.Delay / time.Second)
+		params.MaxDelaySeconds = int32(dto.MaxDelay / time.Second)
+	case models.WebhookRetryPolicyCustom:
+		for _, delay := range dto.Schedule {
+			params.ScheduleSeconds = append(params.ScheduleSeconds, int32(delay/time.Second))
+		}
+	}
+
+	policy, err := s.storage.StoreWebhookRetryPolicies().Upsert(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("update store webhook retry policy: %w", err)
+	}
+
+	return policy, nil
+}
+
+// defaultRetryPolicy doubles the delay after each failed attempt like webhooks always did
+func (s *service) defaultRetryPolicy() models.StoreWebhookRetryPolicy {
+	return models.StoreWebhookRetryPolicy{
+		PolicyType:      models.WebhookRetryPolicyExponential,
+		MaxAttempts:     int32(s.maxTries),
+		DelaySeconds:    int32(s.retryDelay / time.Second),
+		MaxDelaySeconds: int32(s.maxRetryDelay / time.Second),
+		ScheduleSeconds: []int32{},
+	}
+}
+
+func validateRetryPolicy(dto UpdateRetryPolicyDTO) error {
+	if !dto.PolicyType.Valid() {
+		return ErrInvalidRetryPolicy
+	}
+	if dto.MaxAttempts < 1 || dto.MaxAttempts > maxRetryAttempts {
+		return ErrInvalidMaxAttempts
+	}
+
+	switch dto.PolicyType {
+	case models.WebhookRetryPolicyFixed:
+		if !validRetryDelay(dto.Delay) {
+			return ErrInvalidRetryDelay
+		}
+	case models.WebhookRetryPolicyExponential:
+		if !validRetryDelay(dto.Delay) || !validRetryDelay(dto.MaxDelay) || dto.MaxDelay < dto.Delay {
+			return ErrInvalidRetryDelay
+		}
+	case models.WebhookRetryPolicyCustom:
+		if len(dto.Schedule) == 0 || len(dto.Schedule) > maxRetryScheduled {
+			return ErrInvalidRetrySchedule
+		}
+		for _, delay := range dto.Schedule {
+			if !validRetryDelay(delay) {
+				return ErrInvalidRetrySchedule
+			}
+		}
+	}
+
+	return nil
+}
+
+func validRetryDelay(delay time.Duration) bool {
+	return delay >= time.Second && delay <= maxRetryDelay
+}
+
+// newRetry maps stored store policy onto retry policies
+func newRetry(policy models.StoreWebhookRetryPolicy) *retry.Retry {
+	r := retry.New(
+		retry.WithMaxAttempts(int(policy.MaxAttempts)),
+		retry.WithDelay(time.Duration(policy.DelaySeconds)*time.Second),
+		retry.WithMaxDelay(time.Duration(policy.MaxDelaySeconds)*time.Second),
+	)
+
+	switch policy.PolicyType {
+	case models.WebhookRetryPolicyFixed:
+		r.SetPolicy(retry.PolicyLinear)
+	case models.WebhookRetryPolicyCustom:
+		schedule := make([]time.Duration, 0, len(policy.ScheduleSeconds))
+		for _, seconds := range policy.ScheduleSeconds {
+			schedule = append(schedule, time.Duration(seconds)*time.Second)
+		}
+		r.SetPolicy(retry.PolicyCustom).SetSchedule(schedule...)
+	default:
+		r.SetPolicy(retry.PolicyBackoff)
+	}
+
+	return r
+}
@@ -0,0 +1,49 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRetry(t *testing.T) {
+	exponential := newRetry(models.StoreWebhookRetryPolicy{
+		PolicyType:      models.WebhookRetryPolicyExponential,
+		MaxAttempts:     30,
+		DelaySeconds:    60,
+		MaxDelaySeconds: 3600,
+	})
+	for attempt, expected := range map[int]time.Duration{1: time.Minute, 2: 2 * time.Minute, 4: 8 * time.Minute, 10: time.Hour} {
+		delay, ok := exponential.NextDelay(attempt)
+		assert.True(t, ok)
+		assert.Equal(t, expected, delay, "attempt %d", attempt)
+	}
+	_, ok := exponential.NextDelay(30)
+	assert.False(t, ok)
+
+	fixed := newRetry(models.StoreWebhookRetryPolicy{
+		PolicyType:   models.WebhookRetryPolicyFixed,
+		MaxAttempts:  3,
+		DelaySeconds: 10,
+	})
+	delay, ok := fixed.NextDelay(2)
+	assert.True(t, ok)
+	assert.Equal(t, 10*time.Second, delay)
+
+	custom := newRetry(models.StoreWebhookRetryPolicy{
+		PolicyType:      models.WebhookRetryPolicyCustom,
+		MaxAttempts:     5,
+		ScheduleSeconds: []int32{5, 30, 300},
+	})
+	delay, ok = custom.NextDelay(2)
+	assert.True(t, ok)
+	assert.Equal(t, 30*time.Second, delay)
+	delay, ok = custom.NextDelay(4)
+	assert.True(t, ok)
+	assert.Equal(t, 300*time.Second, delay)
+	_, ok = custom.NextDelay(5)
+	assert.False(t, ok)
+}
@@ -0,0 +1,55 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+)
+
+const (
+	// SchemaVersionLegacy delivers event payload as is
+	SchemaVersionLegacy int32 = 1
+	// SchemaVersionEnvelope wraps event payload into Envelope
+	SchemaVersionEnvelope int32 = 2
+
+	LatestSchemaVersion = SchemaVersionEnvelope
+)
+
+// Envelope is the versioned body of webhooks delivered with SchemaVersionEnvelope
+type Envelope struct {
+	ID            uuid.UUID       `json:"id"`
+	Type          string          `json:"type"`
+	SchemaVersion int32           `json:"schema_version"`
+	CreatedAt     time.Time       `json:"created_at"`
+	Data          json.RawMessage `json:"data"`
+}
+
+func ValidateSchemaVersion(version int32) error {
+	if version < SchemaVersionLegacy || version > LatestSchemaVersion {
+		return ErrInvalidSchemaVersion
+	}
+	return nil
+}
+
+// EventID derives stable event identifier from deduplication key, so redelivered events keep the same id
+func EventID(dedupKey uuid.UUID, whType models.WebhookEvent) uuid.UUID {
+	return uuid.NewSHA1(dedupKey, []byte(whType.String()))
+}
+
+// EncodePayload renders event payload according to the webhook schema version
+func EncodePayload(version int32, dedupKey uuid.UUID, whType models.WebhookEvent, payload []byte, createdAt time.Time) ([]byte, error) {
+	if version <= SchemaVersionLegacy {
+		return payload, nil
+	}
+
+	return json.Marshal(Envelope{
+		ID:            EventID(dedupKey, whType),
+		Type:          whType.String(),
+		SchemaVersion: version,
+		CreatedAt:     createdAt.UTC(),
+		Data:          payload,
+	})
+}
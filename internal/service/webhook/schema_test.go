@@ -0,0 +1,41 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodePayload(t *testing.T) {
+	payload := []byte(`{"type":"TransferStatusChanged","status":"completed"}`)
+	key := uuid.New()
+
+	legacy, err := EncodePayload(SchemaVersionLegacy, key, models.WebhookEventTransferStatusChanged, payload, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, payload, legacy)
+
+	body, err := EncodePayload(SchemaVersionEnvelope, key, models.WebhookEventTransferStatusChanged, payload, time.Now())
+	require.NoError(t, err)
+
+	var envelope Envelope
+	require.NoError(t, json.Unmarshal(body, &envelope))
+	assert.Equal(t, EventID(key, models.WebhookEventTransferStatusChanged), envelope.ID)
+	assert.Equal(t, SchemaVersionEnvelope, envelope.SchemaVersion)
+	assert.Equal(t, "TransferStatusChanged", envelope.Type)
+	assert.JSONEq(t, string(payload), string(envelope.Data))
+
+	assert.NotEqual(t, EventID(key, models.WebhookEventTransferStatusChanged), EventID(key, models.WebhookEventExchangeOrderFilled))
+}
+
+func TestValidateSchemaVersion(t *testing.T) {
+	assert.NoError(t, ValidateSchemaVersion(SchemaVersionLegacy))
+	assert.NoError(t, ValidateSchemaVersion(SchemaVersionEnvelope))
+	assert.ErrorIs(t, ValidateSchemaVersion(0), ErrInvalidSchemaVersion)
+	assert.ErrorIs(t, ValidateSchemaVersion(3), ErrInvalidSchemaVersion)
+}
@@ -3,14 +3,12 @@ package webhook
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
-	"math"
 	"net/http"
-	"sync"
 	"time"
 
-	"github.com/goccy/go-json"
 	"github.com/jackc/pgx/v5"
 
 	"github.com/dv-net/dv-merchant/internal/config"
@@ -25,6 +23,7 @@ import (
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_webhook_send_histories"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_webhook_send_queue"
 	"github.com/dv-net/dv-merchant/pkg/logger"
+	"github.com/dv-net/dv-merchant/pkg/webhook_sign"
 
 	"github.com/google/uuid"
 )
@@ -32,44 +31,72 @@ import (
 const (
 	WebhookSendStatusFailed  string = "failed"
 	WebhookSendStatusSuccess string = "success"
-	webhookPollingInterval          = time.Second * 2
 )
 
 type IWebHook interface {
 	Run(ctx context.Context)
 	Send(message *Message, dbTx pgx.Tx) error
 	ProcessPlainMessage(ctx context.Context, dto PreparedHookDto) error
-	SendWebhook(ctx context.Context, url string, payload []byte, sign string) (Result, error)
+	SendWebhook(ctx context.Context, storeID uuid.UUID, url string, payload []byte, sign string, delivery DeliverySettings) (Result, error)
 	GetHistory(ctx context.Context, user models.User, storeUUIDs []uuid.UUID, page, pageSize *uint32) (*storecmn.FindResponseWithPagingFlag[*repo_webhook_send_histories.FindRow], error)
+	GetRetryPolicy(ctx context.Context, storeID uuid.UUID) (models.StoreWebhookRetryPolicy, error)
+	UpdateRetryPolicy(ctx context.Context, storeID uuid.UUID, dto UpdateRetryPolicyDTO) (*models.StoreWebhookRetryPolicy, error)
+	ListDeadLetters(ctx context.Context, storeID uuid.UUID, dto ListDeadLettersDTO) (*storecmn.FindResponseWithFullPagination[*models.WebhookDeadLetter], error)
+	GetDeadLetter(ctx context.Context, storeID uuid.UUID, id uuid.UUID) (*models.WebhookDeadLetter, error)
+	RequeueDeadLetters(ctx context.Context, storeID uuid.UUID, ids []uuid.UUID) (*RequeueResultDTO, error)
+	GetClientCertificate(ctx context.Context, storeID uuid.UUID) (*models.StoreWebhookClientCertificate, error)
+	SetClientCertificate(ctx context.Context, storeID uuid.UUID, certPEM, keyPEM string) (*models.StoreWebhookClientCertificate, error)
+	DeleteClientCertificate(ctx context.Context, storeID uuid.UUID) error
+	SourceIPs() []string
 }
 
 type service struct {
-	storage  storage.IStorage
-	log      logger.Logger
-	maxTries int
-	locker   queueLocker
+	storage       storage.IStorage
+	log           logger.Logger
+	maxTries      int
+	retryDelay    time.Duration
+	maxRetryDelay time.Duration
+	timeout       time.Duration
+	transport     *transport
+	dispatcher    *dispatcher
 }
 
 var _ IWebHook = (*service)(nil)
 
-func New(c config.WebHook, s storage.IStorage, l logger.Logger) IWebHook {
+func New(c config.WebHook, s storage.IStorage, l logger.Logger) (IWebHook, error) {
+	tr, err := newTransport(c.Transport)
+	if err != nil {
+		return nil, fmt.Errorf("init webhook transport: %w", err)
+	}
+	if c.Transport.Proxy != "" {
+		l.Warnw("webhook proxy resolves delivery hostnames itself, it must deny private networks")
+	}
+
+	// claimed message must not be handed to another instance while its delivery is in progress
+	if c.Dispatcher.LeaseDuration <= max(c.RequestTimeout, MaxRequestTimeout) {
+		return nil, fmt.Errorf("webhook lease duration %s must exceed request timeout", c.Dispatcher.LeaseDuration)
+	}
+
 	srv := service{
-		storage:  s,
-		log:      l,
-		maxTries: c.MaxTries,
-		locker: queueLocker{
-			mu:           &sync.Mutex{},
-			whInProgress: make(map[uuid.UUID]struct{}),
-		},
+		storage:       s,
+		log:           l,
+		maxTries:      c.MaxTries,
+		retryDelay:    c.RetryDelay,
+		maxRetryDelay: c.MaxRetryDelay,
+		timeout:       c.RequestTimeout,
+		transport:     tr,
 	}
+	srv.dispatcher = newDispatcher(&srv, c.Dispatcher)
 
-	return &srv
+	return &srv, nil
 }
 
+// Send queues message in dbTx, message already queued for the webhook and transaction is skipped
+// so that a redelivered event does not abort the caller transaction with unique violation
 func (s *service) Send(message *Message, dbTx pgx.Tx) error {
-	_, err := s.storage.WebHookSendQueue(repos.WithTx(dbTx)).Create(
+	queued, err := s.storage.WebHookSendQueue(repos.WithTx(dbTx)).Enqueue(
 		context.Background(),
-		repo_webhook_send_queue.CreateParams{
+		repo_webhook_send_queue.EnqueueParams{
 			WebhookID:     message.WebhookID,
 			SecondsDelay:  message.Delay,
 			TransactionID: message.TxID,
@@ -82,31 +109,40 @@ func (s *service) Send(message *Message, dbTx pgx.Tx) error {
 		s.log.Errorw("create webhook_send_queue", "error", err)
 		return fmt.Errorf("create webhook_send_queue: %w", err)
 	}
+	if queued == 0 {
+		s.log.Debugw("webhook already queued", "webhook_id", message.WebhookID, "tx_id", message.TxID, "event", message.Type)
+	}
 
 	return nil
 }
 
 func (s *service) Run(ctx context.Context) {
-	ticker := time.NewTicker(webhookPollingInterval)
-	defer ticker.Stop()
-	for {
-		select {
-		case <-ticker.C:
-			go func() {
-				if err := s.processWhQueue(ctx); err != nil {
-					s.log.Errorw("Precess webhook queue error", "error", err)
-				}
-			}()
-		case <-ctx.Done():
-			return
-		}
-	}
+	s.dispatcher.run(ctx)
 }
 
-func (s *service) SendWebhook(ctx context.Context, url string, payload []byte, sign string) (Result, error) {
+func (s *service) SendWebhook(ctx context.Context, storeID uuid.UUID, url string, payload []byte, sign string, delivery DeliverySettings) (Result, error) {
 	result := Result{
 		Status: WebhookSendStatusFailed,
 	}
+
+	keys, err := s.signingKeys(ctx, storeID)
+	if err != nil {
+		return result, err
+	}
+
+	client, err := s.deliveryClient(ctx, storeID)
+	if err != nil {
+		return result, err
+	}
+
+	if err = s.transport.checkURL(ctx, url); err != nil {
+		result.Response = err.Error()
+		return result, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, delivery.requestTimeout(s.timeout))
+	defer cancel()
+
 	req, err := http.NewRequestWithContext(
 		ctx, http.MethodPost, url, bytes.NewBuffer(payload),
 	)
@@ -117,10 +153,16 @@ func (s *service) SendWebhook(ctx context.Context, url string, payload []byte, s
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("X-Sign", sign)
+	if len(keys) > 0 {
+		// signed right before sending so receivers may reject stale or replayed deliveries
+		req.Header.Set(webhook_sign.HeaderName, webhook_sign.Sign(payload, time.Now(), keys...))
+	}
 
-	resp, err := http.DefaultClient.Do(req)
+	// per webhook timeout is applied through request context
+	resp, err := client.Do(req)
 	if err != nil {
 		s.log.Errorw("webhook sent http", "error", err)
+		result.Response = err.Error()
 		return result, nil
 	}
 	defer func() {
@@ -135,51 +177,24 @@ func (s *service) SendWebhook(ctx context.Context, url string, payload []byte, s
 		s.log.Errorw("read web hook response body failed", "error", err)
 	}
 
-	whResp := &StoreWhResponse{}
-	if err = json.NewDecoder(bytes.NewBuffer(body)).Decode(whResp); err != nil {
-		s.log.Errorw("decode body failed", "error", err)
+	if delivery.IsSuccess(resp.StatusCode, body) {
+		result.Status = WebhookSendStatusSuccess
 	}
 
-	whStatus := WebhookSendStatusFailed
-	if whResp.Success {
-		whStatus = WebhookSendStatusSuccess
-	}
-
-	result.Status = whStatus
 	result.Response = string(body)
 	result.Request = string(payload)
 
 	return result, nil
 }
 
-func (s *service) processWhQueue(ctx context.Context) error {
-	webhooksData, err := s.storage.WebHookSendQueue().GetQueuedWebhooks(ctx)
-	if err != nil {
-		return fmt.Errorf("fetch wh queue: %w", err)
-	}
-
-	for _, v := range webhooksData {
-		if v.LastSentAt.Valid && time.Now().Before(v.LastSentAt.Time.Add(time.Second*time.Duration(v.SecondsDelay))) {
-			continue
-		}
-
-		if !s.locker.Acquire(v.WebhookID) {
-			// Webhook currently processing from another goroutine
-			continue
-		}
-
-		if err := s.ProcessPlainMessage(ctx, prepareHookDtoByRaw(v)); err != nil {
-			s.log.Errorw("Processing wh message", "error", err)
-		}
-
-		s.locker.Release(v.WebhookID)
-	}
-
-	return nil
+func (s *service) ProcessPlainMessage(ctx context.Context, dto PreparedHookDto) error {
+	_, err := s.processMessage(ctx, dto)
+	return err
 }
 
-func (s *service) ProcessPlainMessage(ctx context.Context, dto PreparedHookDto) error {
-	result, sendWhErr := s.SendWebhook(ctx, dto.URL, dto.Payload, dto.Signature)
+// processMessage delivers message, records the attempt and moves queued message forward
+func (s *service) processMessage(ctx context.Context, dto PreparedHookDto) (Result, error) {
+	result, sendWhErr := s.SendWebhook(ctx, dto.StoreID, dto.URL, dto.Payload, dto.Signature, dto.Delivery)
 	if sendWhErr != nil {
 		s.log.Errorw("send webhook error", "error", sendWhErr)
 	}
@@ -189,21 +204,33 @@ func (s *service) ProcessPlainMessage(ctx context.Context, dto PreparedHookDto)
 	}
 
 	if dto.ID.Valid && !dto.IsManual {
-		if result.Status == WebhookSendStatusSuccess || dto.RetriesCount >= int64(s.maxTries) {
-			return s.storage.WebHookSendQueue().Delete(ctx, dto.ID.UUID)
+		if result.Status == WebhookSendStatusSuccess {
+			return result, s.storage.WebHookSendQueue().Delete(ctx, dto.ID.UUID)
 		}
 
-		return s.storage.WebHookSendQueue().UpdateDelay(ctx, repo_webhook_send_queue.UpdateDelayParams{
+		policy, err := s.GetRetryPolicy(ctx, dto.StoreID)
+		if err != nil {
+			return result, err
+		}
+
+		// current attempt is not counted in retries yet
+		attempts := int(dto.RetriesCount) + 1
+		delay, ok := newRetry(policy).NextDelay(attempts)
+		if !ok {
+			return result, s.moveToDeadLetters(ctx, dto, result, attempts)
+		}
+
+		return result, s.storage.WebHookSendQueue().UpdateDelay(ctx, repo_webhook_send_queue.UpdateDelayParams{
 			ID:    dto.ID.UUID,
-			Delay: int16(60 * math.Pow(float64(2), float64(dto.RetriesCount))),
+			Delay: durationSeconds(delay),
 		})
 	}
 
 	if sendWhErr != nil {
-		return fmt.Errorf("send webhook failed: %w", sendWhErr)
+		return result, fmt.Errorf("send webhook failed: %w", sendWhErr)
 	}
 
-	return nil
+	return result, nil
 }
 
 func (s *service) GetHistory(
@@ -238,6 +265,24 @@ func (s *service) GetHistory(
 	return s.storage.WebHookSendHistories().GetByStores(ctx, params)
 }
 
+// signingKeys returns store secrets active at the moment, current one goes first
+func (s *service) signingKeys(ctx context.Context, storeID uuid.UUID) ([]webhook_sign.Key, error) {
+	secret, err := s.storage.StoreSecrets().GetByStoreID(ctx, storeID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("fetch store secret: %w", err)
+	}
+
+	keys := []webhook_sign.Key{webhook_sign.NewKey(secret.Secret)}
+	if previous, ok := secret.ActivePreviousSecret(); ok {
+		keys = append(keys, webhook_sign.NewKey(previous))
+	}
+
+	return keys, nil
+}
+
 func (s *service) createSendHistory(ctx context.Context, hookData PreparedHookDto, result Result) error {
 	params := repo_webhook_send_histories.CreateParams{
 		TxID:               hookData.TransactionID,
@@ -0,0 +1,229 @@
+package webhook
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dv-net/dv-merchant/internal/config"
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/pkg/netguard"
+
+	"github.com/google/uuid"
+)
+
+// transport builds http clients for store webhook deliveries: optional egress proxy,
+// private network denylist checked at dial time and fixed source addresses.
+type transport struct {
+	guard    *netguard.Guard
+	proxy    func(*http.Request) (*url.URL, error)
+	resolver *net.Resolver
+	// proxyAddrs holds addresses of operator proxies requests were sent through, they are exempt from the guard
+	proxyAddrs sync.Map
+	sourceIPs  []netip.Addr
+	next       atomic.Uint64
+	base       *http.Transport
+	shared     *http.Client
+
+	mu      sync.Mutex
+	clients map[uuid.UUID]storeClient
+}
+
+// storeClient presents store client certificate, rebuilt once certificate changes
+type storeClient struct {
+	fingerprint string
+	client      *http.Client
+}
+
+func newTransport(c config.WebHookTransport) (*transport, error) {
+	guard, err := netguard.New(c.BlockPrivateNetworks, c.DeniedNetworks...)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &transport{
+		guard:     guard,
+		proxy:     http.ProxyFromEnvironment,
+		resolver:  net.DefaultResolver,
+		sourceIPs: make([]netip.Addr, 0, len(c.SourceAddresses)),
+		clients:   make(map[uuid.UUID]storeClient),
+	}
+	for _, raw := range c.SourceAddresses {
+		ip, err := netip.ParseAddr(raw)
+		if err != nil {
+			return nil, fmt.Errorf("parse webhook source ip %q: %w", raw, err)
+		}
+		t.sourceIPs = append(t.sourceIPs, ip)
+	}
+
+	if c.Proxy != "" {
+		proxyURL, err := url.Parse(c.Proxy)
+		if err != nil || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid webhook proxy URL %q", c.Proxy)
+		}
+		t.proxy = http.ProxyURL(proxyURL)
+	}
+
+	t.base = &http.Transport{
+		Proxy:                 t.proxyURL,
+		DialContext:           t.dialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: time.Second,
+	}
+	t.shared = &http.Client{Transport: t.base, CheckRedirect: noRedirect}
+
+	return t, nil
+}
+
+// client returns http client presenting store certificate, shared one when store has none
+func (t *transport) client(storeID uuid.UUID, cert *models.StoreWebhookClientCertificate) (*http.Client, error) {
+	if cert == nil {
+		return t.shared, nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if cached, ok := t.clients[storeID]; ok {
+		if cached.fingerprint == cert.Fingerprint {
+			return cached.client, nil
+		}
+		cached.client.CloseIdleConnections()
+	}
+
+	keyPair, err := tls.X509KeyPair([]byte(cert.Certificate), []byte(cert.PrivateKey))
+	if err != nil {
+		return nil, fmt.Errorf("load store client certificate: %w", err)
+	}
+
+	storeTransport := t.base.Clone()
+	storeTransport.TLSClientConfig = &tls.Config{
+		Certificates: []tls.Certificate{keyPair},
+		MinVersion:   tls.VersionTLS12,
+	}
+	client := &http.Client{Transport: storeTransport, CheckRedirect: noRedirect}
+	t.clients[storeID] = storeClient{fingerprint: cert.Fingerprint, client: client}
+
+	return client, nil
+}
+
+// forget drops cached store client after its certificate is removed
+func (t *transport) forget(storeID uuid.UUID) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if cached, ok := t.clients[storeID]; ok {
+		cached.client.CloseIdleConnections()
+		delete(t.clients, storeID)
+	}
+}
+
+// proxyURL returns configured or environment proxy for the request and remembers its address,
+// proxy is configured by operator and may live in private network
+func (t *transport) proxyURL(req *http.Request) (*url.URL, error) {
+	proxyURL, err := t.proxy(req)
+	if err == nil && proxyURL != nil {
+		t.proxyAddrs.Store(canonicalAddr(proxyURL), struct{}{})
+	}
+
+	return proxyURL, err
+}
+
+// checkURL rejects denied IP literal destinations early, direct requests to hostnames are checked
+// once resolved at dial time. Requests sent through proxy are resolved by the proxy,
+// so their hostnames are resolved here and every address must be allowed.
+// The proxy resolves the hostname again and the address can not be pinned, so the proxy
+// has to deny private networks itself to be safe from DNS rebinding.
+func (t *transport) checkURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("parse webhook url: %w", err)
+	}
+
+	if ip, err := netip.ParseAddr(u.Hostname()); err == nil {
+		if !t.guard.Allowed(ip) {
+			return fmt.Errorf("%w: %s", netguard.ErrDeniedAddress, ip)
+		}
+		return nil
+	}
+
+	proxyURL, err := t.proxy(&http.Request{URL: u})
+	if err != nil {
+		return fmt.Errorf("resolve webhook proxy: %w", err)
+	}
+	if proxyURL == nil {
+		return nil
+	}
+
+	ips, err := t.resolver.LookupNetIP(ctx, "ip", u.Hostname())
+	if err != nil {
+		return fmt.Errorf("resolve webhook host: %w", err)
+	}
+	for _, ip := range ips {
+		if !t.guard.Allowed(ip.Unmap()) {
+			return fmt.Errorf("%w: %s resolves to %s", netguard.ErrDeniedAddress, u.Hostname(), ip)
+		}
+	}
+
+	return nil
+}
+
+func (t *transport) dialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+	if _, isProxy := t.proxyAddrs.Load(addr); !isProxy {
+		dialer.Control = t.guard.Control
+	}
+
+	if len(t.sourceIPs) > 0 {
+		source := t.sourceIPs[t.next.Add(1)%uint64(len(t.sourceIPs))]
+		dialer.LocalAddr = &net.TCPAddr{IP: source.AsSlice()}
+		// destination must be of the same family as source address
+		network = "tcp6"
+		if source.Is4() {
+			network = "tcp4"
+		}
+	}
+
+	return dialer.DialContext(ctx, network, addr)
+}
+
+// noRedirect returns redirect response as delivery result, the redirect target would bypass checkURL
+func noRedirect(*http.Request, []*http.Request) error {
+	return http.ErrUseLastResponse
+}
+
+// SourceIPs returns addresses merchants should allow webhooks from
+func (t *transport) SourceIPs() []string {
+	res := make([]string, 0, len(t.sourceIPs))
+	for _, ip := range t.sourceIPs {
+		res = append(res, ip.String())
+	}
+
+	return res
+}
+
+func canonicalAddr(u *url.URL) string {
+	if u.Port() != "" {
+		return u.Host
+	}
+
+	port := "80"
+	if u.Scheme == "https" {
+		port = "443"
+	}
+
+	return net.JoinHostPort(u.Hostname(), port)
+}
@@ -0,0 +1,87 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dv-net/dv-merchant/internal/config"
+	"github.com/dv-net/dv-merchant/pkg/netguard"
+)
+
+func TestTransport_CheckURL(t *testing.T) {
+	direct, err := newTransport(config.WebHookTransport{BlockPrivateNetworks: true})
+	require.NoError(t, err)
+	direct.proxy = func(*http.Request) (*url.URL, error) { return nil, nil }
+
+	proxied, err := newTransport(config.WebHookTransport{BlockPrivateNetworks: true, Proxy: "http://10.0.0.1:3128"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		tr      *transport
+		url     string
+		wantErr error
+	}{
+		{name: "direct private ip", tr: direct, url: "http://127.0.0.1/hook", wantErr: netguard.ErrDeniedAddress},
+		{name: "direct hostname is checked at dial", tr: direct, url: "http://localhost/hook"},
+		{name: "proxied private ip", tr: proxied, url: "http://127.0.0.1/hook", wantErr: netguard.ErrDeniedAddress},
+		{name: "proxied hostname resolving to private ip", tr: proxied, url: "http://localhost/hook", wantErr: netguard.ErrDeniedAddress},
+		{name: "public ip", tr: proxied, url: "http://93.184.216.34/hook"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.tr.checkURL(context.Background(), tt.url)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestTransport_ProxyIsExempt(t *testing.T) {
+	tr, err := newTransport(config.WebHookTransport{BlockPrivateNetworks: true})
+	require.NoError(t, err)
+	// environment proxy of the operator
+	tr.proxy = func(*http.Request) (*url.URL, error) { return url.Parse("http://10.0.0.1:3128") }
+
+	req, err := http.NewRequest(http.MethodPost, "https://example.com/hook", nil)
+	require.NoError(t, err)
+
+	proxyURL, err := tr.proxyURL(req)
+	require.NoError(t, err)
+	require.Equal(t, "10.0.0.1:3128", proxyURL.Host)
+
+	_, isProxy := tr.proxyAddrs.Load("10.0.0.1:3128")
+	require.True(t, isProxy)
+	_, isProxy = tr.proxyAddrs.Load("10.0.0.2:3128")
+	require.False(t, isProxy)
+}
+
+func TestTransport_NoRedirect(t *testing.T) {
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer target.Close()
+
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, target.URL, http.StatusTemporaryRedirect)
+	}))
+	defer hook.Close()
+
+	tr, err := newTransport(config.WebHookTransport{})
+	require.NoError(t, err)
+	tr.proxy = func(*http.Request) (*url.URL, error) { return nil, nil }
+
+	resp, err := tr.shared.Post(hook.URL, "application/json", nil)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusTemporaryRedirect, resp.StatusCode)
+}
@@ -1,17 +1,16 @@
 package webhook
 
 import (
+	"time"
+
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_webhook_send_queue"
 
 	"github.com/google/uuid"
 )
 
-type StoreWhResponse struct {
-	Success bool `json:"success"`
-}
-
 type PreparedHookDto struct {
 	ID            uuid.NullUUID
+	WebhookID     uuid.UUID
 	TransactionID uuid.UUID
 	StoreID       uuid.UUID
 	IsManual      bool
@@ -20,6 +19,7 @@ type PreparedHookDto struct {
 	Signature     string
 	URL           string
 	RetriesCount  int64
+	Delivery      DeliverySettings
 }
 
 type Message struct {
@@ -27,7 +27,7 @@ type Message struct {
 	WebhookID uuid.UUID `json:"webhook_id"`
 	Type      string    `json:"type"`
 	Data      []byte    `json:"data"`
-	Delay     int16     `json:"delay"`
+	Delay     int32     `json:"delay"`
 	Signature string    `json:"signature"`
 }
 
@@ -38,12 +38,13 @@ type Result struct {
 	ResponseStatusCode int
 }
 
-func prepareHookDtoByRaw(v *repo_webhook_send_queue.GetQueuedWebhooksRow) PreparedHookDto {
+func prepareHookDtoByRaw(v *repo_webhook_send_queue.ClaimQueuedWebhooksRow) PreparedHookDto {
 	return PreparedHookDto{
 		ID: uuid.NullUUID{
 			UUID:  v.ID,
 			Valid: true,
 		},
+		WebhookID:     v.WebhookID,
 		TransactionID: v.TransactionID,
 		StoreID:       v.StoreID,
 		Event:         v.Event,
@@ -51,5 +52,12 @@ func prepareHookDtoByRaw(v *repo_webhook_send_queue.GetQueuedWebhooksRow) Prepar
 		Signature:     v.Signature,
 		URL:           v.Url,
 		RetriesCount:  v.RetriesCount,
+		Delivery: DeliverySettings{
+			SuccessMode:        v.SuccessMode,
+			SuccessStatusCodes: v.SuccessStatusCodes,
+			SuccessJSONPath:    v.SuccessJsonPath,
+			SuccessJSONValue:   v.SuccessJsonValue,
+			Timeout:            time.Duration(v.TimeoutSeconds) * time.Second,
+		},
 	}
 }
@@ -0,0 +1,103 @@
+package withdraw
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/setting"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_user_address_book"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// allowlistEnabled reports whether the user accepts withdrawals only to the address book entries
+func (s *service) allowlistEnabled(ctx context.Context, user *models.User) (bool, error) {
+	res, err := s.settings.GetModelSetting(ctx, setting.WithdrawalAllowlist, setting.IModelSetting(user))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("get allowlist setting: %w", err)
+	}
+
+	if res == nil {
+		return false, nil
+	}
+
+	switch res.Value {
+	case setting.FlagValueEnabled:
+		return true, nil
+	case setting.AllowlistValueDisabling:
+		// disabling takes effect after the same cooling period as a new address book entry
+		return !res.UpdatedAt.Valid || time.Now().Before(res.UpdatedAt.Time.Add(s.allowlistCfg.CoolingPeriod)), nil
+	default:
+		return false, nil
+	}
+}
+
+// checkDestinationAllowed refuses the destination missing in the address book or still in the cooling period
+func (s *service) checkDestinationAllowed(ctx context.Context, user *models.User, currencyID, address string) error {
+	enabled, err := s.allowlistEnabled(ctx, user)
+	if err != nil || !enabled {
+		return err
+	}
+
+	destination, err := s.storage.UserAddressBook().GetDestination(ctx, repo_user_address_book.GetDestinationParams{
+		UserID:     user.ID,
+		CurrencyID: currencyID,
+		Address:    address,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrDestinationNotAllowlisted
+	}
+	if err != nil {
+		return fmt.Errorf("fetch address book entry: %w", err)
+	}
+	if !destination.Active {
+		return ErrDestinationCoolingDown
+	}
+
+	return nil
+}
+
+// allowlistedDestinations narrows the withdrawal addresses down to the active address book entries
+func (s *service) allowlistedDestinations(ctx context.Context, user *models.User, currencyID string, addresses []string) ([]string, error) {
+	enabled, err := s.allowlistEnabled(ctx, user)
+	if err != nil || !enabled {
+		return addresses, err
+	}
+
+	active, err := s.storage.UserAddressBook().GetActiveAddressesByCurrency(ctx, repo_user_address_book.GetActiveAddressesByCurrencyParams{
+		UserID:     user.ID,
+		CurrencyID: currencyID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetch address book entries: %w", err)
+	}
+
+	allowed := filterAllowlisted(addresses, active)
+	if len(allowed) == 0 {
+		return nil, ErrDestinationNotAllowlisted
+	}
+
+	return allowed, nil
+}
+
+func filterAllowlisted(addresses, active []string) []string {
+	activeSet := make(map[string]struct{}, len(active))
+	for _, addr := range active {
+		activeSet[addr] = struct{}{}
+	}
+
+	res := make([]string, 0, len(addresses))
+	for _, addr := range addresses {
+		if _, ok := activeSet[addr]; ok {
+			res = append(res, addr)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,40 @@
+package withdraw
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterAllowlisted(t *testing.T) {
+	tests := []struct {
+		name      string
+		addresses []string
+		active    []string
+		expected  []string
+	}{
+		{
+			name:      "only active entries kept",
+			addresses: []string{"TAddressOne", "TAddressTwo", "TAddressThree"},
+			active:    []string{"TAddressThree", "TAddressOne"},
+			expected:  []string{"TAddressOne", "TAddressThree"},
+		},
+		{
+			name:      "no active entries",
+			addresses: []string{"TAddressOne"},
+			expected:  []string{},
+		},
+		{
+			name:      "address is case sensitive",
+			addresses: []string{"TAddressOne"},
+			active:    []string{"taddressone"},
+			expected:  []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, filterAllowlisted(tt.addresses, tt.active))
+		})
+	}
+}
@@ -0,0 +1,468 @@
+package withdraw
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/storage/repos"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_withdrawal_approval_decisions"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_withdrawal_approval_rules"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_withdrawal_approvals"
+	"github.com/dv-net/dv-merchant/internal/storage/storecmn"
+	"github.com/dv-net/dv-merchant/pkg/dbutils/pgerror"
+	"github.com/dv-net/dv-merchant/pkg/pgtypeutils"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+)
+
+type IWithdrawalApprovalService interface {
+	CreateApprovalRule(ctx context.Context, creator *models.User, dto CreateApprovalRuleDTO) (*models.WithdrawalApprovalRule, error)
+	GetApprovalRules(ctx context.Context) ([]*models.WithdrawalApprovalRule, error)
+	DeleteApprovalRule(ctx context.Context, id uuid.UUID) error
+	GetApprovals(ctx context.Context, dto ApprovalListDTO) (*ApprovalListResult, error)
+	GetApproval(ctx context.Context, id uuid.UUID) (*ApprovalWithDecisionsDTO, error)
+	ApproveWithdrawal(ctx context.Context, approver *models.User, id uuid.UUID, comment *string) (*models.WithdrawalApproval, error)
+	RejectWithdrawal(ctx context.Context, approver *models.User, id uuid.UUID, comment *string) (*models.WithdrawalApproval, error)
+}
+
+// approvalRequest is a withdrawal checked against approval rules right before it is executed
+type approvalRequest struct {
+	kind        models.WithdrawalApprovalKind
+	userID      uuid.UUID
+	initiatedBy uuid.NullUUID
+	// storeID and requestID reserve the client request id while the withdrawal is held
+	storeID    uuid.NullUUID
+	requestID  *string
+	currencyID string
+	addressTo  string
+	amount     decimal.Decimal
+	amountUSD  decimal.Decimal
+	// payload is the operation input replayed once the withdrawal is approved
+	payload any
+}
+
+type fromAddressPayload struct {
+	WalletAddressID uuid.UUID `json:"wallet_address_id"`
+	CurrencyID      string    `json:"currency_id"`
+}
+
+func (s *service) CreateApprovalRule(ctx context.Context, creator *models.User, dto CreateApprovalRuleDTO) (*models.WithdrawalApprovalRule, error) {
+	if dto.RequiredApprovals < 1 {
+		return nil, ErrInvalidRequiredApprovals
+	}
+
+	params := repo_withdrawal_approval_rules.CreateParams{
+		AddressTo:         pgtypeutils.EncodeText(dto.AddressTo),
+		MinAmountUsd:      dto.MinAmountUSD,
+		RequiredApprovals: dto.RequiredApprovals,
+		CreatedBy:         uuid.NullUUID{UUID: creator.ID, Valid: true},
+	}
+	if dto.CurrencyID != nil {
+		curr, err := s.currencyService.GetCurrencyByID(ctx, *dto.CurrencyID)
+		if err != nil {
+			return nil, fmt.Errorf("fetch currency: %w", err)
+		}
+		params.CurrencyID = pgtypeutils.EncodeText(&curr.ID)
+	}
+
+	rule, err := s.storage.WithdrawalApprovalRules().Create(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("create withdrawal approval rule: %w", err)
+	}
+
+	s.logger.Infow("withdrawal approval rule created", "rule_id", rule.ID, "user_id", creator.ID, "required_approvals", rule.RequiredApprovals)
+
+	return rule, nil
+}
+
+func (s *service) GetApprovalRules(ctx context.Context) ([]*models.WithdrawalApprovalRule, error) {
+	return s.storage.WithdrawalApprovalRules().GetAll(ctx)
+}
+
+func (s *service) DeleteApprovalRule(ctx context.Context, id uuid.UUID) error {
+	affected, err := s.storage.WithdrawalApprovalRules().Delete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("delete withdrawal approval rule: %w", err)
+	}
+	if affected == 0 {
+		return ErrApprovalRuleNotFound
+	}
+
+	s.logger.Infow("withdrawal approval rule deleted", "rule_id", id)
+
+	return nil
+}
+
+func (s *service) GetApprovals(ctx context.Context, dto ApprovalListDTO) (*ApprovalListResult, error) {
+	commonParams := storecmn.NewCommonFindParams()
+	commonParams.SetPage(dto.Page)
+	commonParams.SetPageSize(dto.PageSize)
+
+	return s.storage.WithdrawalApprovals().GetByParams(ctx, repo_withdrawal_approvals.GetByParamsParams{
+		CommonFindParams: *commonParams,
+		UserID:           dto.UserID,
+		Statuses:         dto.Statuses,
+	})
+}
+
+func (s *service) GetApproval(ctx context.Context, id uuid.UUID) (*ApprovalWithDecisionsDTO, error) {
+	approval, err := s.storage.WithdrawalApprovals().GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrApprovalNotFound
+		}
+		return nil, fmt.Errorf("fetch withdrawal approval: %w", err)
+	}
+
+	decisions, err := s.storage.WithdrawalApprovalDecisions().GetByApprovalID(ctx, approval.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetch withdrawal approval decisions: %w", err)
+	}
+
+	return &ApprovalWithDecisionsDTO{
+		Approval:  approval,
+		Decisions: decisions,
+	}, nil
+}
+
+// ApproveWithdrawal signs the withdrawal, the last required signature executes it
+func (s *service) ApproveWithdrawal(ctx context.Context, approver *models.User, id uuid.UUID, comment *string) (*models.WithdrawalApproval, error) {
+	var approval *models.WithdrawalApproval
+	err := repos.BeginTxFunc(ctx, s.storage.PSQLConn(), pgx.TxOptions{}, func(dbTx pgx.Tx) error {
+		pending, err := s.getPendingApprovalForUpdate(ctx, id, dbTx)
+		if err != nil {
+			return err
+		}
+
+		if err = s.recordDecision(ctx, pending, approver, models.WithdrawalApprovalDecisionApprove, comment, dbTx); err != nil {
+			return err
+		}
+
+		approvals, err := s.storage.WithdrawalApprovalDecisions(repos.WithTx(dbTx)).CountApprovals(ctx, pending.ID)
+		if err != nil {
+			return fmt.Errorf("count withdrawal approvals: %w", err)
+		}
+
+		approval = pending
+		if approvals < int64(pending.RequiredApprovals) {
+			return nil
+		}
+
+		approval, err = s.storage.WithdrawalApprovals(repos.WithTx(dbTx)).UpdateStatus(ctx, repo_withdrawal_approvals.UpdateStatusParams{
+			Status: models.WithdrawalApprovalStatusApproved,
+			ID:     pending.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("update withdrawal approval status: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if approval.Status != models.WithdrawalApprovalStatusApproved {
+		return approval, nil
+	}
+
+	return s.executeApproval(ctx, approval)
+}
+
+// RejectWithdrawal cancels the withdrawal, a single rejection is enough
+func (s *service) RejectWithdrawal(ctx context.Context, approver *models.User, id uuid.UUID, comment *string) (*models.WithdrawalApproval, error) {
+	var rejected *models.WithdrawalApproval
+	err := repos.BeginTxFunc(ctx, s.storage.PSQLConn(), pgx.TxOptions{}, func(dbTx pgx.Tx) error {
+		pending, err := s.getPendingApprovalForUpdate(ctx, id, dbTx)
+		if err != nil {
+			return err
+		}
+
+		if err = s.recordDecision(ctx, pending, approver, models.WithdrawalApprovalDecisionReject, comment, dbTx); err != nil {
+			return err
+		}
+
+		rejected, err = s.storage.WithdrawalApprovals(repos.WithTx(dbTx)).UpdateStatus(ctx, repo_withdrawal_approvals.UpdateStatusParams{
+			Status: models.WithdrawalApprovalStatusRejected,
+			ID:     pending.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("update withdrawal approval status: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rejected, nil
+}
+
+// requireApproval holds the withdrawal when an approval rule matches it.
+// Withdrawal replayed from an approved request may not exceed the approved amount or change destination.
+func (s *service) requireApproval(ctx context.Context, req approvalRequest, approved *models.WithdrawalApproval) error {
+	if approved != nil {
+		if req.amount.GreaterThan(approved.Amount) {
+			return ErrApprovedAmountExceeded
+		}
+		if req.addressTo != approved.AddressTo {
+			return ErrApprovedDestinationChanged
+		}
+		return nil
+	}
+
+	held, err := s.heldApproval(ctx, req)
+	if err != nil {
+		return err
+	}
+	if held != nil {
+		return &ApprovalRequiredError{Approval: held}
+	}
+
+	rules, err := s.storage.WithdrawalApprovalRules().GetAll(ctx)
+	if err != nil {
+		return fmt.Errorf("fetch withdrawal approval rules: %w", err)
+	}
+
+	rule := matchApprovalRule(rules, req.currencyID, req.addressTo, req.amountUSD)
+	if rule == nil {
+		return nil
+	}
+
+	payload, err := json.Marshal(req.payload)
+	if err != nil {
+		return fmt.Errorf("marshal withdrawal payload: %w", err)
+	}
+
+	approval, err := s.storage.WithdrawalApprovals().Create(ctx, repo_withdrawal_approvals.CreateParams{
+		UserID:            req.userID,
+		Kind:              req.kind,
+		CurrencyID:        req.currencyID,
+		AddressTo:         req.addressTo,
+		Amount:            req.amount,
+		AmountUsd:         req.amountUSD.Round(4),
+		Payload:           payload,
+		RequiredApprovals: rule.RequiredApprovals,
+		RuleID:            uuid.NullUUID{UUID: rule.ID, Valid: true},
+		InitiatedBy:       req.initiatedBy,
+		StoreID:           req.storeID,
+		RequestID:         pgtypeutils.EncodeText(req.requestID),
+		ExpiresAt:         pgtypeutils.EncodeTime(time.Now().Add(s.approvalsCfg.TTL)),
+	})
+	if err != nil {
+		var uniqueErr *pgerror.UniqueConstraintError
+		if req.requestID != nil && errors.As(pgerror.ParseError(err), &uniqueErr) {
+			// concurrent retry of the same request has just been held
+			if held, err = s.heldApproval(ctx, req); err != nil {
+				return err
+			}
+			if held != nil {
+				return &ApprovalRequiredError{Approval: held}
+			}
+		}
+		return fmt.Errorf("create withdrawal approval: %w", err)
+	}
+
+	s.logger.Infow(
+		"withdrawal held for approval",
+		"approval_id", approval.ID,
+		"rule_id", rule.ID,
+		"kind", approval.Kind,
+		"amount_usd", approval.AmountUsd.String(),
+		"required_approvals", approval.RequiredApprovals,
+	)
+
+	return &ApprovalRequiredError{Approval: approval}
+}
+
+// heldApproval returns the pending request holding the same request id.
+// Request id of an approved request being executed is taken until its withdrawal is created.
+func (s *service) heldApproval(ctx context.Context, req approvalRequest) (*models.WithdrawalApproval, error) {
+	if req.requestID == nil {
+		return nil, nil //nolint:nilnil
+	}
+
+	approval, err := s.storage.WithdrawalApprovals().GetLatestByRequestID(ctx, repo_withdrawal_approvals.GetLatestByRequestIDParams{
+		Kind:      req.kind,
+		StoreID:   req.storeID,
+		RequestID: pgtypeutils.EncodeText(req.requestID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil //nolint:nilnil
+		}
+		return nil, fmt.Errorf("fetch withdrawal approval by request id: %w", err)
+	}
+
+	switch approval.Status {
+	case models.WithdrawalApprovalStatusPending:
+		return approval, nil
+	case models.WithdrawalApprovalStatusApproved:
+		return nil, ErrWithdrawFromProcessingDuplicateRequestID
+	default:
+		return nil, nil //nolint:nilnil
+	}
+}
+
+// matchApprovalRule picks the strictest rule whose every set condition matches the withdrawal
+func matchApprovalRule(
+	rules []*models.WithdrawalApprovalRule,
+	currencyID string,
+	addressTo string,
+	amountUSD decimal.Decimal,
+) *models.WithdrawalApprovalRule {
+	var matched *models.WithdrawalApprovalRule
+	for _, rule := range rules {
+		if rule.CurrencyID.Valid && rule.CurrencyID.String != currencyID {
+			continue
+		}
+		if rule.AddressTo.Valid && !strings.EqualFold(rule.AddressTo.String, addressTo) {
+			continue
+		}
+		if rule.MinAmountUsd.Valid && amountUSD.LessThan(rule.MinAmountUsd.Decimal) {
+			continue
+		}
+		if matched == nil || rule.RequiredApprovals > matched.RequiredApprovals {
+			matched = rule
+		}
+	}
+
+	return matched
+}
+
+// executeApproval replays the approved withdrawal, its errors are stored on the request
+func (s *service) executeApproval(ctx context.Context, approval *models.WithdrawalApproval) (*models.WithdrawalApproval, error) {
+	if execErr := s.replayApproved(ctx, approval); execErr != nil {
+		s.logger.Errorw("approved withdrawal failed", "approval_id", approval.ID, "error", execErr)
+
+		reason := execErr.Error()
+		failed, err := s.storage.WithdrawalApprovals().SetFailed(ctx, repo_withdrawal_approvals.SetFailedParams{
+			FailureReason: pgtypeutils.EncodeText(&reason),
+			ID:            approval.ID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("update withdrawal approval failure: %w", err)
+		}
+
+		return failed, nil
+	}
+
+	executed, err := s.storage.WithdrawalApprovals().SetExecuted(ctx, approval.ID)
+	if err != nil {
+		return nil, fmt.Errorf("update withdrawal approval status: %w", err)
+	}
+
+	s.logger.Infow("approved withdrawal executed", "approval_id", approval.ID)
+
+	return executed, nil
+}
+
+func (s *service) replayApproved(ctx context.Context, approval *models.WithdrawalApproval) error {
+	switch approval.Kind {
+	case models.WithdrawalApprovalKindFromAddress:
+		var payload fromAddressPayload
+		if err := json.Unmarshal(approval.Payload, &payload); err != nil {
+			return fmt.Errorf("unmarshal withdrawal payload: %w", err)
+		}
+
+		user, err := s.storage.Users().GetByID(ctx, approval.UserID)
+		if err != nil {
+			return fmt.Errorf("fetch user: %w", err)
+		}
+
+		return s.withdrawFromAddress(ctx, user, payload.WalletAddressID, payload.CurrencyID, approval)
+	case models.WithdrawalApprovalKindFromAddresses:
+		var payload MultipleWithdrawalDTO
+		if err := json.Unmarshal(approval.Payload, &payload); err != nil {
+			return fmt.Errorf("unmarshal withdrawal payload: %w", err)
+		}
+
+		user, err := s.storage.Users().GetByID(ctx, approval.UserID)
+		if err != nil {
+			return fmt.Errorf("fetch user: %w", err)
+		}
+
+		return s.withdrawFromAddresses(ctx, user, payload, approval)
+	case models.WithdrawalApprovalKindFromProcessing:
+		var payload CreateWithdrawalFromProcessingDTO
+		if err := json.Unmarshal(approval.Payload, &payload); err != nil {
+			return fmt.Errorf("unmarshal withdrawal payload: %w", err)
+		}
+
+		_, err := s.createWithdrawalFromProcessing(ctx, payload, approval)
+		return err
+	}
+
+	return fmt.Errorf("unknown withdrawal approval kind %q", approval.Kind)
+}
+
+func (s *service) getPendingApprovalForUpdate(ctx context.Context, id uuid.UUID, dbTx pgx.Tx) (*models.WithdrawalApproval, error) {
+	approval, err := s.storage.WithdrawalApprovals(repos.WithTx(dbTx)).GetByIDForUpdate(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrApprovalNotFound
+		}
+		return nil, fmt.Errorf("fetch withdrawal approval: %w", err)
+	}
+	if approval.Status != models.WithdrawalApprovalStatusPending {
+		return nil, ErrApprovalNotPending
+	}
+	// expired requests are marked by the withdrawal runner
+	if !time.Now().Before(approval.ExpiresAt.Time) {
+		return nil, ErrApprovalExpired
+	}
+
+	return approval, nil
+}
+
+func (s *service) recordDecision(
+	ctx context.Context,
+	approval *models.WithdrawalApproval,
+	approver *models.User,
+	decision models.WithdrawalApprovalDecisionType,
+	comment *string,
+	dbTx pgx.Tx,
+) error {
+	if approval.InitiatedBy.Valid && approval.InitiatedBy.UUID == approver.ID {
+		return ErrApproverIsInitiator
+	}
+
+	_, err := s.storage.WithdrawalApprovalDecisions(repos.WithTx(dbTx)).Create(ctx, repo_withdrawal_approval_decisions.CreateParams{
+		ApprovalID: approval.ID,
+		UserID:     approver.ID,
+		Decision:   decision,
+		Comment:    pgtypeutils.EncodeText(comment),
+	})
+	if err != nil {
+		var uniqueErr *pgerror.UniqueConstraintError
+		if errors.As(pgerror.ParseError(err), &uniqueErr) {
+			return ErrApprovalAlreadyDecided
+		}
+		return fmt.Errorf("create withdrawal approval decision: %w", err)
+	}
+
+	s.logger.Infow("withdrawal approval decision", "approval_id", approval.ID, "user_id", approver.ID, "decision", decision)
+
+	return nil
+}
+
+// expireApprovals closes requests nobody signed in time
+func (s *service) expireApprovals(ctx context.Context) {
+	expired, err := s.storage.WithdrawalApprovals().ExpirePending(ctx, pgtypeutils.EncodeTime(time.Now()))
+	if err != nil {
+		s.logger.Errorw("failed to expire withdrawal approvals", "error", err)
+		return
+	}
+
+	for _, approval := range expired {
+		s.logger.Infow("withdrawal approval expired", "approval_id", approval.ID)
+	}
+}
@@ -0,0 +1,73 @@
+package withdraw
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+)
+
+func TestMatchApprovalRule(t *testing.T) {
+	anyLarge := &models.WithdrawalApprovalRule{
+		ID:                uuid.New(),
+		MinAmountUsd:      decimal.NullDecimal{Decimal: decimal.NewFromInt(10000), Valid: true},
+		RequiredApprovals: 2,
+	}
+	usdtAny := &models.WithdrawalApprovalRule{
+		ID:                uuid.New(),
+		CurrencyID:        pgtype.Text{String: "USDT.Tron", Valid: true},
+		RequiredApprovals: 1,
+	}
+	coldWallet := &models.WithdrawalApprovalRule{
+		ID:                uuid.New(),
+		AddressTo:         pgtype.Text{String: "TColdWalletAddress", Valid: true},
+		RequiredApprovals: 3,
+	}
+	rules := []*models.WithdrawalApprovalRule{anyLarge, usdtAny, coldWallet}
+
+	tests := []struct {
+		name       string
+		currencyID string
+		addressTo  string
+		amountUSD  decimal.Decimal
+		expected   *models.WithdrawalApprovalRule
+	}{
+		{
+			name:       "no rule matches",
+			currencyID: "BTC.Bitcoin",
+			addressTo:  "bc1qaddress",
+			amountUSD:  decimal.NewFromInt(500),
+		},
+		{
+			name:       "amount threshold reached",
+			currencyID: "BTC.Bitcoin",
+			addressTo:  "bc1qaddress",
+			amountUSD:  decimal.NewFromInt(10000),
+			expected:   anyLarge,
+		},
+		{
+			name:       "currency rule",
+			currencyID: "USDT.Tron",
+			addressTo:  "TOtherAddress",
+			amountUSD:  decimal.NewFromInt(5),
+			expected:   usdtAny,
+		},
+		{
+			name:       "strictest of several matching rules",
+			currencyID: "USDT.Tron",
+			addressTo:  "tcoldwalletaddress",
+			amountUSD:  decimal.NewFromInt(20000),
+			expected:   coldWallet,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, matchApprovalRule(rules, tt.currencyID, tt.addressTo, tt.amountUSD))
+		})
+	}
+}
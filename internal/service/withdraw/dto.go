@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/storage/storecmn"
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
@@ -16,6 +17,8 @@ type CreateWithdrawalFromProcessingDTO struct {
 	UserID     uuid.UUID       `json:"user_id"`
 	StoreID    *uuid.UUID      `json:"store_id"`
 	RequestID  *string         `json:"request_id"`
+	// InitiatedBy is the user who requested the withdrawal, empty for API requests
+	InitiatedBy uuid.NullUUID `json:"-"`
 } //	@name	CreateWithdrawalFromProcessingDTO
 
 type WithdrawalFromProcessingDto struct {
@@ -49,6 +52,8 @@ type TransferDto struct {
 	AmountUsd     decimal.Decimal     `json:"amount_usd"`
 	CurrencyID    string              `json:"currency_id"`
 	Blockchain    models.Blockchain   `json:"blockchain"`
+	// ExactAmount transfers the amount instead of the whole balance of from addresses
+	ExactAmount bool `json:"exact_amount"`
 }
 
 type WithdrawalToProcessingDTO struct {
@@ -63,3 +68,42 @@ type MultipleWithdrawalDTO struct {
 	ExcludedWalletAddressesIDs []uuid.UUID `json:"excluded_wallet_addresses_ids"` //nolint:tagliatelle
 	CurrencyID                 string      `json:"currency_id"`
 }
+
+type CreateApprovalRuleDTO struct {
+	// empty conditions match any withdrawal
+	CurrencyID        *string
+	AddressTo         *string
+	MinAmountUSD      decimal.NullDecimal
+	RequiredApprovals int32
+}
+
+type ApprovalListDTO struct {
+	UserID         *uuid.UUID
+	Statuses       []models.WithdrawalApprovalStatus
+	Page, PageSize *uint32
+}
+
+type ApprovalWithDecisionsDTO struct {
+	Approval  *models.WithdrawalApproval
+	Decisions []*models.WithdrawalApprovalDecision
+}
+
+type ApprovalListResult = storecmn.FindResponseWithFullPagination[*models.WithdrawalApproval]
+
+type CreateWithdrawalLimitDTO struct {
+	Scope models.WithdrawalLimitScope
+	// empty scope value limits every store, currency or destination separately
+	ScopeValue    *string
+	WindowSeconds int32
+	MaxAmountUSD  decimal.NullDecimal
+	MaxCount      *int32
+}
+
+type SetWithdrawalScheduleDTO struct {
+	CronExpression *string
+	// empty timezone falls back to the user location
+	Timezone        *string
+	MinBalanceUSD   decimal.NullDecimal
+	MaxGasPriceGwei decimal.NullDecimal
+	KeepFloatUSD    decimal.NullDecimal
+}
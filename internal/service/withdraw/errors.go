@@ -3,8 +3,12 @@ package withdraw
 import (
 	"errors"
 	"fmt"
+	"time"
+
+	"github.com/dv-net/dv-merchant/internal/models"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
 )
 
 var (
@@ -25,6 +29,35 @@ var (
 	ErrWithdrawalAddressEmptyBalances           = errors.New("withdrawal addresses have empty balances")
 	ErrProcessingExplorerUnavailable            = errors.New("explorer is unavailable")
 	ErrPendingProcessingWithdrawal              = errors.New("pending processing withdrawal exists for blockchain")
+	ErrApprovalNotFound                         = errors.New("withdrawal approval not found")
+	ErrApprovalNotPending                       = errors.New("withdrawal approval is not pending")
+	ErrApprovalExpired                          = errors.New("withdrawal approval expired")
+	ErrApprovalAlreadyDecided                   = errors.New("withdrawal approval already decided by this user")
+	ErrApproverIsInitiator                      = errors.New("withdrawal can not be approved by its initiator")
+	ErrApprovedAmountExceeded                   = errors.New("withdrawal amount exceeds the approved amount")
+	ErrApprovedDestinationChanged               = errors.New("withdrawal destination differs from the approved one")
+	ErrApprovalRuleNotFound                     = errors.New("withdrawal approval rule not found")
+	ErrInvalidRequiredApprovals                 = errors.New("required approvals must be at least 1")
+	ErrWithdrawalLimitNotFound                  = errors.New("withdrawal limit not found")
+	ErrInvalidWithdrawalLimitScope              = errors.New("invalid withdrawal limit scope")
+	ErrInvalidWithdrawalLimitWindow             = errors.New("withdrawal limit window must be between 1 second and 30 days")
+	ErrWithdrawalLimitThresholdRequired         = errors.New("withdrawal limit requires max amount or max count")
+	ErrDestinationNotAllowlisted                = errors.New("withdrawal destination is not in the address book")
+	ErrDestinationCoolingDown                   = errors.New("withdrawal destination is not active yet, the address book entry is in the cooling period")
+	ErrWithdrawalScheduleNotFound               = errors.New("withdrawal schedule not found")
+	ErrWithdrawalScheduleTriggerRequired        = errors.New("withdrawal schedule requires cron expression or at least one threshold")
+	ErrInvalidWithdrawalScheduleThreshold       = errors.New("withdrawal schedule thresholds must be positive")
+	ErrInvalidWithdrawalScheduleCron            = errors.New("invalid withdrawal schedule cron expression")
+	ErrInvalidWithdrawalScheduleTimezone        = errors.New("invalid withdrawal schedule timezone")
+	ErrGasPriceTriggerNotSupported              = errors.New("gas price threshold is supported only for evm blockchains")
+	ErrWithdrawalScheduleNotDue                 = errors.New("withdrawal schedule is not due")
+	ErrWithdrawalScheduleFloatReached           = errors.New("hot wallet balance reached the float kept by withdrawal schedule")
+	ErrSplitLegsNotSet                          = errors.New("split withdrawal legs are not set")
+	ErrSplitLegAddressNotApproved               = errors.New("split withdrawal leg address is not in withdrawal wallet addresses")
+	ErrSplitLegsBelowMinimum                    = errors.New("split withdrawal legs are below their minimum amounts")
+	ErrSplitBatchInProgress                     = errors.New("split withdrawal batch waits for its transfers to complete")
+	ErrSplitBatchUnderfunded                    = errors.New("hot wallet balance is below pending split withdrawal transfers")
+	ErrSplitBatchSourcesChanged                 = errors.New("hot wallets of pending split withdrawal batch changed")
 )
 
 type InvalidCurrencyForAddressError struct {
@@ -36,10 +69,44 @@ func (e *InvalidCurrencyForAddressError) Error() string {
 	return fmt.Sprintf("invalid wallet '%s' for blockchain '%s'", e.Wallet, e.Blockchain)
 }
 
+// ApprovalRequiredError is returned when the withdrawal is held until approvers sign it
+type ApprovalRequiredError struct {
+	Approval *models.WithdrawalApproval
+}
+
+func (e *ApprovalRequiredError) Error() string {
+	return fmt.Sprintf("withdrawal requires %d approvals, approval request %s created", e.Approval.RequiredApprovals, e.Approval.ID)
+}
+
+// WithdrawalLimitExceededError is returned when the withdrawal does not fit into the limit window
+type WithdrawalLimitExceededError struct {
+	Limit         *models.WithdrawalLimit
+	UsedAmountUSD decimal.Decimal
+	UsedCount     int64
+}
+
+func (e *WithdrawalLimitExceededError) Error() string {
+	window := time.Duration(e.Limit.WindowSeconds) * time.Second
+	msg := fmt.Sprintf("withdrawal exceeds %s limit per %s", e.Limit.Scope, window)
+	if e.Limit.MaxAmountUsd.Valid {
+		msg += fmt.Sprintf(", %s of %s USD used", e.UsedAmountUSD.StringFixed(2), e.Limit.MaxAmountUsd.Decimal.StringFixed(2))
+	}
+	if e.Limit.MaxCount.Valid {
+		msg += fmt.Sprintf(", %d of %d withdrawals used", e.UsedCount, e.Limit.MaxCount.Int32)
+	}
+
+	return msg
+}
+
 func isIgnoredLogError(err error) bool {
 	return errors.Is(err, ErrTransfersDisabled) ||
 		errors.Is(err, pgx.ErrNoRows) ||
 		errors.Is(err, ErrWithdrawalsFromProcessingDisabled) ||
 		errors.Is(err, ErrWithdrawalAddressListEmpty) ||
-		errors.Is(err, ErrPendingProcessingWithdrawal)
+		errors.Is(err, ErrPendingProcessingWithdrawal) ||
+		errors.Is(err, ErrDestinationNotAllowlisted) ||
+		errors.Is(err, ErrWithdrawalScheduleNotDue) ||
+		errors.Is(err, ErrWithdrawalScheduleFloatReached) ||
+		errors.Is(err, ErrSplitLegsBelowMinimum) ||
+		errors.Is(err, ErrSplitBatchInProgress)
 }
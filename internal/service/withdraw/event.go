@@ -0,0 +1,27 @@
+package withdraw
+
+import (
+	"fmt"
+
+	"github.com/dv-net/dv-merchant/internal/event"
+	"github.com/dv-net/dv-merchant/internal/models"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const TransferStatusChangedEventType = "transfer_status_changed"
+
+// TransferStatusChangedEvent is fired when processing reports a new transfer status within the database transaction that stored it
+type TransferStatusChangedEvent struct {
+	Transfer       models.Transfer
+	PreviousStatus models.TransferStatus
+	DBTx           pgx.Tx
+}
+
+func (e TransferStatusChangedEvent) Type() event.Type {
+	return TransferStatusChangedEventType
+}
+
+func (e TransferStatusChangedEvent) String() string {
+	return fmt.Sprintf("TransferStatusChanged: transfer=%s, from=%s, to=%s", e.Transfer.ID, e.PreviousStatus, e.Transfer.Status)
+}
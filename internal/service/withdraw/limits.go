@@ -0,0 +1,312 @@
+package withdraw
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/notify"
+	"github.com/dv-net/dv-merchant/internal/storage/repos"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_withdrawal_limit_usages"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_withdrawal_limits"
+	"github.com/dv-net/dv-merchant/pkg/pgtypeutils"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// maxWithdrawalLimitWindow is the longest limit window, usages older than it are cleaned up
+	maxWithdrawalLimitWindow = 30 * 24 * time.Hour
+	// withdrawalLimitAlertInterval throttles alerts about the same limit
+	withdrawalLimitAlertInterval = 10 * time.Minute
+)
+
+type IWithdrawalLimitService interface {
+	CreateWithdrawalLimit(ctx context.Context, user *models.User, dto CreateWithdrawalLimitDTO) (*models.WithdrawalLimit, error)
+	GetWithdrawalLimits(ctx context.Context, user *models.User) ([]*models.WithdrawalLimit, error)
+	DeleteWithdrawalLimit(ctx context.Context, user *models.User, id uuid.UUID) error
+}
+
+// limitRequest is a withdrawal checked against velocity limits right before it is executed
+type limitRequest struct {
+	storeID    uuid.NullUUID
+	currencyID string
+	addressTo  string
+	amountUSD  decimal.Decimal
+}
+
+func (s *service) CreateWithdrawalLimit(ctx context.Context, user *models.User, dto CreateWithdrawalLimitDTO) (*models.WithdrawalLimit, error) {
+	if !dto.Scope.Valid() {
+		return nil, ErrInvalidWithdrawalLimitScope
+	}
+	if dto.WindowSeconds < 1 || time.Duration(dto.WindowSeconds)*time.Second > maxWithdrawalLimitWindow {
+		return nil, ErrInvalidWithdrawalLimitWindow
+	}
+	if !dto.MaxAmountUSD.Valid && dto.MaxCount == nil {
+		return nil, ErrWithdrawalLimitThresholdRequired
+	}
+
+	params := repo_withdrawal_limits.CreateParams{
+		UserID:        user.ID,
+		Scope:         dto.Scope,
+		WindowSeconds: dto.WindowSeconds,
+		MaxAmountUsd:  dto.MaxAmountUSD,
+	}
+	if dto.MaxCount != nil {
+		params.MaxCount = pgtype.Int4{Int32: *dto.MaxCount, Valid: true}
+	}
+
+	if dto.ScopeValue != nil && dto.Scope != models.WithdrawalLimitScopeUser {
+		scopeValue, err := s.prepareLimitScopeValue(ctx, user, dto.Scope, *dto.ScopeValue)
+		if err != nil {
+			return nil, err
+		}
+		params.ScopeValue = pgtypeutils.EncodeText(&scopeValue)
+	}
+
+	limit, err := s.storage.WithdrawalLimits().Create(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("create withdrawal limit: %w", err)
+	}
+
+	s.logger.Infow("withdrawal limit created", "limit_id", limit.ID, "user_id", user.ID, "scope", limit.Scope)
+
+	return limit, nil
+}
+
+func (s *service) GetWithdrawalLimits(ctx context.Context, user *models.User) ([]*models.WithdrawalLimit, error) {
+	return s.storage.WithdrawalLimits().GetByUserID(ctx, user.ID)
+}
+
+func (s *service) DeleteWithdrawalLimit(ctx context.Context, user *models.User, id uuid.UUID) error {
+	affected, err := s.storage.WithdrawalLimits().Delete(ctx, repo_withdrawal_limits.DeleteParams{
+		ID:     id,
+		UserID: user.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("delete withdrawal limit: %w", err)
+	}
+	if affected == 0 {
+		return ErrWithdrawalLimitNotFound
+	}
+
+	s.logger.Infow("withdrawal limit deleted", "limit_id", id, "user_id", user.ID)
+
+	return nil
+}
+
+func (s *service) prepareLimitScopeValue(ctx context.Context, user *models.User, scope models.WithdrawalLimitScope, value string) (string, error) {
+	switch scope {
+	case models.WithdrawalLimitScopeStore:
+		storeID, err := uuid.Parse(value)
+		if err != nil {
+			return "", ErrStoreIsNotOwnedByUser
+		}
+		store, err := s.storage.Stores().GetByID(ctx, storeID)
+		if err != nil || store.UserID != user.ID {
+			return "", ErrStoreIsNotOwnedByUser
+		}
+		return store.ID.String(), nil
+	case models.WithdrawalLimitScopeCurrency:
+		curr, err := s.currencyService.GetCurrencyByID(ctx, value)
+		if err != nil {
+			return "", fmt.Errorf("fetch currency: %w", err)
+		}
+		return curr.ID, nil
+	default:
+		return value, nil
+	}
+}
+
+// enforceWithdrawalLimits refuses the withdrawal when it does not fit into any limit window of the user,
+// accepted withdrawal is recorded into the window usage. The returned usage must be released
+// with releaseWithdrawalLimitUsage when the withdrawal is not created afterwards
+func (s *service) enforceWithdrawalLimits(ctx context.Context, user *models.User, req limitRequest) (*models.WithdrawalLimitUsage, error) {
+	limits, err := s.storage.WithdrawalLimits().GetByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetch withdrawal limits: %w", err)
+	}
+	if len(limits) == 0 {
+		return nil, nil
+	}
+
+	var recorded *models.WithdrawalLimitUsage
+	now := time.Now()
+	err = repos.BeginTxFunc(ctx, s.storage.PSQLConn(), pgx.TxOptions{}, func(dbTx pgx.Tx) error {
+		// parallel withdrawals of the user must not fit into the same window remainder
+		if err := s.storage.WithdrawalLimitUsages(repos.WithTx(dbTx)).LockUser(ctx, user.ID.String()); err != nil {
+			return fmt.Errorf("lock withdrawal limits: %w", err)
+		}
+
+		for _, limit := range limits {
+			params, ok := limitUsageParams(limit, req)
+			if !ok {
+				continue
+			}
+			params.UserID = user.ID
+			params.Since = pgtypeutils.EncodeTime(now.Add(-time.Duration(limit.WindowSeconds) * time.Second))
+
+			usage, err := s.storage.WithdrawalLimitUsages(repos.WithTx(dbTx)).GetWindowUsage(ctx, params)
+			if err != nil {
+				return fmt.Errorf("fetch withdrawal limit usage: %w", err)
+			}
+
+			if limitErr := checkWithdrawalLimit(limit, usage, req.amountUSD); limitErr != nil {
+				return limitErr
+			}
+		}
+
+		var err error
+		recorded, err = s.storage.WithdrawalLimitUsages(repos.WithTx(dbTx)).Create(ctx, repo_withdrawal_limit_usages.CreateParams{
+			UserID:     user.ID,
+			StoreID:    req.storeID,
+			CurrencyID: req.currencyID,
+			AddressTo:  req.addressTo,
+			AmountUsd:  req.amountUSD,
+		})
+		if err != nil {
+			return fmt.Errorf("record withdrawal limit usage: %w", err)
+		}
+
+		return nil
+	})
+
+	var limitErr *WithdrawalLimitExceededError
+	if errors.As(err, &limitErr) {
+		s.logger.Infow("withdrawal refused by limit", "user_id", user.ID, "limit_id", limitErr.Limit.ID, "error", limitErr)
+		s.alertWithdrawalLimitExceeded(ctx, user, req, limitErr)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return recorded, nil
+}
+
+// releaseWithdrawalLimitUsage gives the window remainder back when the withdrawal
+// recorded by enforceWithdrawalLimits failed to be created
+func (s *service) releaseWithdrawalLimitUsage(ctx context.Context, usage *models.WithdrawalLimitUsage) {
+	if usage == nil {
+		return
+	}
+
+	if err := s.storage.WithdrawalLimitUsages().DeleteByID(context.WithoutCancel(ctx), usage.ID); err != nil {
+		s.logger.Errorw("release withdrawal limit usage", "usage_id", usage.ID, "error", err)
+	}
+}
+
+// initializeLimitedTransfer initializes scheduled withdrawal wallet sweep within withdrawal limits,
+// the window usage is released when the transfer is not created or fails at once.
+// Sweeps to the owner processing wallet stay out of limits as the funds do not leave the owner.
+func (s *service) initializeLimitedTransfer(ctx context.Context, dto TransferDto, user *models.User) (*models.Transfer, error) {
+	limitUsage, err := s.enforceWithdrawalLimits(ctx, user, limitRequest{
+		currencyID: dto.CurrencyID,
+		addressTo:  dto.ToAddress,
+		amountUSD:  dto.AmountUsd,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	transfer, err := s.initializeTransfer(ctx, dto, user, nil)
+	if err != nil || (transfer != nil && transfer.Status == models.TransferStatusFailed) {
+		s.releaseWithdrawalLimitUsage(ctx, limitUsage)
+	}
+
+	return transfer, err
+}
+
+// limitUsageParams narrows the window usage to withdrawals of the limit scope,
+// false is returned when the limit does not apply to the withdrawal
+func limitUsageParams(limit *models.WithdrawalLimit, req limitRequest) (repo_withdrawal_limit_usages.GetWindowUsageParams, bool) {
+	params := repo_withdrawal_limit_usages.GetWindowUsageParams{}
+
+	switch limit.Scope {
+	case models.WithdrawalLimitScopeUser:
+		return params, true
+	case models.WithdrawalLimitScopeStore:
+		// withdrawals from hot wallets are not bound to a store
+		if !req.storeID.Valid || (limit.ScopeValue.Valid && limit.ScopeValue.String != req.storeID.UUID.String()) {
+			return params, false
+		}
+		params.StoreID = req.storeID
+	case models.WithdrawalLimitScopeCurrency:
+		if limit.ScopeValue.Valid && limit.ScopeValue.String != req.currencyID {
+			return params, false
+		}
+		params.CurrencyID = pgtypeutils.EncodeText(&req.currencyID)
+	case models.WithdrawalLimitScopeDestination:
+		if limit.ScopeValue.Valid && !strings.EqualFold(limit.ScopeValue.String, req.addressTo) {
+			return params, false
+		}
+		params.AddressTo = pgtypeutils.EncodeText(&req.addressTo)
+	default:
+		return params, false
+	}
+
+	return params, true
+}
+
+func checkWithdrawalLimit(
+	limit *models.WithdrawalLimit,
+	usage repo_withdrawal_limit_usages.GetWindowUsageRow,
+	amountUSD decimal.Decimal,
+) *WithdrawalLimitExceededError {
+	countExceeded := limit.MaxCount.Valid && usage.Count+1 > int64(limit.MaxCount.Int32)
+	amountExceeded := limit.MaxAmountUsd.Valid && usage.AmountUsd.Add(amountUSD).GreaterThan(limit.MaxAmountUsd.Decimal)
+	if !countExceeded && !amountExceeded {
+		return nil
+	}
+
+	return &WithdrawalLimitExceededError{
+		Limit:         limit,
+		UsedAmountUSD: usage.AmountUsd,
+		UsedCount:     usage.Count,
+	}
+}
+
+func (s *service) alertWithdrawalLimitExceeded(ctx context.Context, user *models.User, req limitRequest, limitErr *WithdrawalLimitExceededError) {
+	now := time.Now()
+	if last, ok := s.limitAlerts.Load(limitErr.Limit.ID); ok && now.Sub(last.(time.Time)) < withdrawalLimitAlertInterval {
+		return
+	}
+	s.limitAlerts.Store(limitErr.Limit.ID, now)
+
+	payload := &notify.WithdrawalLimitExceededData{
+		Language:      user.Language,
+		Scope:         limitErr.Limit.Scope.String(),
+		ScopeValue:    limitErr.Limit.ScopeValue.String,
+		Window:        (time.Duration(limitErr.Limit.WindowSeconds) * time.Second).String(),
+		MaxCount:      limitErr.Limit.MaxCount.Int32,
+		UsedAmountUSD: limitErr.UsedAmountUSD.StringFixed(2),
+		UsedCount:     limitErr.UsedCount,
+		CurrencyID:    req.currencyID,
+		AddressTo:     req.addressTo,
+		AmountUSD:     req.amountUSD.StringFixed(2),
+	}
+	if limitErr.Limit.MaxAmountUsd.Valid {
+		payload.MaxAmountUSD = limitErr.Limit.MaxAmountUsd.Decimal.StringFixed(2)
+	}
+
+	s.notificationSvc.SendUser(ctx, models.NotificationTypeWithdrawalLimitExceeded, user, payload, &models.NotificationArgs{
+		UserID: &user.ID,
+	})
+}
+
+func (s *service) cleanupWithdrawalLimitUsages(ctx context.Context) {
+	deleted, err := s.storage.WithdrawalLimitUsages().DeleteBefore(ctx, pgtypeutils.EncodeTime(time.Now().Add(-maxWithdrawalLimitWindow)))
+	if err != nil {
+		s.logger.Errorw("failed to clean up withdrawal limit usages", "error", err)
+		return
+	}
+
+	if deleted > 0 {
+		s.logger.Debugw("withdrawal limit usages cleaned up", "deleted", deleted)
+	}
+}
@@ -0,0 +1,137 @@
+package withdraw
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_withdrawal_limit_usages"
+)
+
+func TestLimitUsageParams(t *testing.T) {
+	storeID := uuid.New()
+	req := limitRequest{
+		storeID:    uuid.NullUUID{UUID: storeID, Valid: true},
+		currencyID: "USDT.Tron",
+		addressTo:  "TDestinationAddress",
+		amountUSD:  decimal.NewFromInt(100),
+	}
+	text := func(s string) pgtype.Text { return pgtype.Text{String: s, Valid: true} }
+
+	tests := []struct {
+		name     string
+		limit    *models.WithdrawalLimit
+		req      limitRequest
+		expected repo_withdrawal_limit_usages.GetWindowUsageParams
+		applies  bool
+	}{
+		{
+			name:    "user scope sums every withdrawal",
+			limit:   &models.WithdrawalLimit{Scope: models.WithdrawalLimitScopeUser},
+			req:     req,
+			applies: true,
+		},
+		{
+			name:     "each store separately",
+			limit:    &models.WithdrawalLimit{Scope: models.WithdrawalLimitScopeStore},
+			req:      req,
+			expected: repo_withdrawal_limit_usages.GetWindowUsageParams{StoreID: req.storeID},
+			applies:  true,
+		},
+		{
+			name:  "store scope skips hot wallet withdrawals",
+			limit: &models.WithdrawalLimit{Scope: models.WithdrawalLimitScopeStore},
+			req:   limitRequest{currencyID: "USDT.Tron", addressTo: "TDestinationAddress"},
+		},
+		{
+			name:  "other store",
+			limit: &models.WithdrawalLimit{Scope: models.WithdrawalLimitScopeStore, ScopeValue: text(uuid.NewString())},
+			req:   req,
+		},
+		{
+			name:     "specific currency",
+			limit:    &models.WithdrawalLimit{Scope: models.WithdrawalLimitScopeCurrency, ScopeValue: text("USDT.Tron")},
+			req:      req,
+			expected: repo_withdrawal_limit_usages.GetWindowUsageParams{CurrencyID: text("USDT.Tron")},
+			applies:  true,
+		},
+		{
+			name:  "other currency",
+			limit: &models.WithdrawalLimit{Scope: models.WithdrawalLimitScopeCurrency, ScopeValue: text("BTC.Bitcoin")},
+			req:   req,
+		},
+		{
+			name:     "destination matched case insensitively",
+			limit:    &models.WithdrawalLimit{Scope: models.WithdrawalLimitScopeDestination, ScopeValue: text("tdestinationaddress")},
+			req:      req,
+			expected: repo_withdrawal_limit_usages.GetWindowUsageParams{AddressTo: text("TDestinationAddress")},
+			applies:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params, ok := limitUsageParams(tt.limit, tt.req)
+			require.Equal(t, tt.applies, ok)
+			if ok {
+				require.Equal(t, tt.expected, params)
+			}
+		})
+	}
+}
+
+func TestCheckWithdrawalLimit(t *testing.T) {
+	limit := &models.WithdrawalLimit{
+		ID:            uuid.New(),
+		Scope:         models.WithdrawalLimitScopeUser,
+		WindowSeconds: 3600,
+		MaxAmountUsd:  decimal.NullDecimal{Decimal: decimal.NewFromInt(1000), Valid: true},
+		MaxCount:      pgtype.Int4{Int32: 3, Valid: true},
+	}
+
+	tests := []struct {
+		name      string
+		usage     repo_withdrawal_limit_usages.GetWindowUsageRow
+		amountUSD decimal.Decimal
+		exceeded  bool
+	}{
+		{
+			name:      "empty window",
+			amountUSD: decimal.NewFromInt(999),
+		},
+		{
+			name:      "amount reaches limit exactly",
+			usage:     repo_withdrawal_limit_usages.GetWindowUsageRow{Count: 1, AmountUsd: decimal.NewFromInt(600)},
+			amountUSD: decimal.NewFromInt(400),
+		},
+		{
+			name:      "amount over limit",
+			usage:     repo_withdrawal_limit_usages.GetWindowUsageRow{Count: 1, AmountUsd: decimal.NewFromInt(600)},
+			amountUSD: decimal.RequireFromString("400.01"),
+			exceeded:  true,
+		},
+		{
+			name:      "count over limit",
+			usage:     repo_withdrawal_limit_usages.GetWindowUsageRow{Count: 3, AmountUsd: decimal.NewFromInt(30)},
+			amountUSD: decimal.NewFromInt(10),
+			exceeded:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkWithdrawalLimit(limit, tt.usage, tt.amountUSD)
+			if !tt.exceeded {
+				require.Nil(t, err)
+				return
+			}
+			require.NotNil(t, err)
+			require.Equal(t, tt.usage.Count, err.UsedCount)
+			require.True(t, tt.usage.AmountUsd.Equal(err.UsedAmountUSD))
+		})
+	}
+}
@@ -0,0 +1,307 @@
+package withdraw
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_withdrawal_wallet_schedules"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_withdrawal_wallets"
+	"github.com/dv-net/dv-merchant/pkg/cron"
+	"github.com/dv-net/dv-merchant/pkg/pgtypeutils"
+
+	"connectrpc.com/connect"
+	evmv2 "github.com/dv-net/dv-proto/gen/go/eproxy/evm/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+)
+
+type IWithdrawalScheduleService interface {
+	SetWithdrawalSchedule(ctx context.Context, user *models.User, currencyID string, dto SetWithdrawalScheduleDTO) (*models.WithdrawalWalletSchedule, error)
+	GetWithdrawalSchedule(ctx context.Context, user *models.User, currencyID string) (*models.WithdrawalWalletSchedule, error)
+	DeleteWithdrawalSchedule(ctx context.Context, user *models.User, currencyID string) error
+}
+
+func (s *service) SetWithdrawalSchedule(
+	ctx context.Context,
+	user *models.User,
+	currencyID string,
+	dto SetWithdrawalScheduleDTO,
+) (*models.WithdrawalWalletSchedule, error) {
+	wallet, err := s.userWithdrawalWallet(ctx, user, currencyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if dto.CronExpression != nil && strings.TrimSpace(*dto.CronExpression) == "" {
+		dto.CronExpression = nil
+	}
+	if dto.CronExpression == nil && !dto.MinBalanceUSD.Valid && !dto.MaxGasPriceGwei.Valid && !dto.KeepFloatUSD.Valid {
+		return nil, ErrWithdrawalScheduleTriggerRequired
+	}
+	for _, threshold := range []decimal.NullDecimal{dto.MinBalanceUSD, dto.MaxGasPriceGwei, dto.KeepFloatUSD} {
+		if threshold.Valid && !threshold.Decimal.IsPositive() {
+			return nil, ErrInvalidWithdrawalScheduleThreshold
+		}
+	}
+	if dto.MaxGasPriceGwei.Valid && !wallet.Blockchain.IsEVMLike() {
+		return nil, ErrGasPriceTriggerNotSupported
+	}
+
+	if dto.CronExpression != nil {
+		if _, err = cron.Parse(*dto.CronExpression); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidWithdrawalScheduleCron, err)
+		}
+	}
+
+	timezone := user.Location
+	if dto.Timezone != nil && *dto.Timezone != "" {
+		timezone = *dto.Timezone
+	}
+	if timezone == "" {
+		timezone = time.UTC.String()
+	}
+	if _, err = time.LoadLocation(timezone); err != nil {
+		return nil, ErrInvalidWithdrawalScheduleTimezone
+	}
+
+	// the first cron activation is counted from the moment the schedule is saved
+	schedule, err := s.storage.WithdrawalWalletSchedules().Upsert(ctx, repo_withdrawal_wallet_schedules.UpsertParams{
+		WithdrawalWalletID: wallet.ID,
+		CronExpression:     pgtypeutils.EncodeText(dto.CronExpression),
+		Timezone:           timezone,
+		MinBalanceUsd:      dto.MinBalanceUSD,
+		MaxGasPriceGwei:    dto.MaxGasPriceGwei,
+		KeepFloatUsd:       dto.KeepFloatUSD,
+		LastRunAt:          pgtypeutils.EncodeTime(time.Now().UTC()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("save withdrawal schedule: %w", err)
+	}
+
+	s.logger.Infow("withdrawal schedule saved", "schedule_id", schedule.ID, "user_id", user.ID, "currency_id", currencyID)
+
+	return schedule, nil
+}
+
+func (s *service) GetWithdrawalSchedule(ctx context.Context, user *models.User, currencyID string) (*models.WithdrawalWalletSchedule, error) {
+	wallet, err := s.userWithdrawalWallet(ctx, user, currencyID)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := s.storage.WithdrawalWalletSchedules().GetByWithdrawalWalletID(ctx, wallet.ID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrWithdrawalScheduleNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("fetch withdrawal schedule: %w", err)
+	}
+
+	return schedule, nil
+}
+
+func (s *service) DeleteWithdrawalSchedule(ctx context.Context, user *models.User, currencyID string) error {
+	wallet, err := s.userWithdrawalWallet(ctx, user, currencyID)
+	if err != nil {
+		return err
+	}
+
+	affected, err := s.storage.WithdrawalWalletSchedules().DeleteByWithdrawalWalletID(ctx, wallet.ID)
+	if err != nil {
+		return fmt.Errorf("delete withdrawal schedule: %w", err)
+	}
+	if affected == 0 {
+		return ErrWithdrawalScheduleNotFound
+	}
+
+	s.logger.Infow("withdrawal schedule deleted", "user_id", user.ID, "currency_id", currencyID)
+
+	return nil
+}
+
+func (s *service) userWithdrawalWallet(ctx context.Context, user *models.User, currencyID string) (*models.WithdrawalWallet, error) {
+	wallet, err := s.storage.WithdrawalWallets().GetWithdrawalWalletByCurrency(ctx, repo_withdrawal_wallets.GetWithdrawalWalletByCurrencyParams{
+		UserID:     user.ID,
+		CurrencyID: currencyID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetch withdrawal wallet: %w", err)
+	}
+
+	return wallet, nil
+}
+
+// opensRuns reports whether the schedule sweeps in runs: a run is opened by the cron activation
+// or by the hot wallet balance crossing the threshold and lasts until nothing is left to sweep
+func opensRuns(schedule *models.WithdrawalWalletSchedule) bool {
+	return schedule.CronExpression.Valid || schedule.MinBalanceUsd.Valid
+}
+
+// cronDue reports whether a cron activation has passed since the last finished run
+func cronDue(schedule *models.WithdrawalWalletSchedule, now time.Time) (bool, error) {
+	if !schedule.CronExpression.Valid {
+		return true, nil
+	}
+
+	expr, err := cron.Parse(schedule.CronExpression.String)
+	if err != nil {
+		return false, err
+	}
+	loc, err := time.LoadLocation(schedule.Timezone)
+	if err != nil {
+		return false, err
+	}
+
+	next := expr.Next(schedule.LastRunAt.Time.In(loc))
+
+	return !next.IsZero() && !next.After(now), nil
+}
+
+// startScheduledRun returns the schedule of the withdrawal wallet when the wallet may be swept now,
+// nil schedule means the wallet is swept on every iteration as before
+func (s *service) startScheduledRun(
+	ctx context.Context,
+	wallet models.WithdrawalWallet,
+	user *models.User,
+) (*models.WithdrawalWalletSchedule, error) {
+	schedule, err := s.storage.WithdrawalWalletSchedules().GetByWithdrawalWalletID(ctx, wallet.ID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("fetch withdrawal schedule: %w", err)
+	}
+
+	if opensRuns(schedule) && !schedule.RunStartedAt.Valid {
+		now := time.Now().UTC()
+		due, err := cronDue(schedule, now)
+		if err != nil {
+			return nil, fmt.Errorf("evaluate withdrawal schedule %s: %w", schedule.ID, err)
+		}
+		if !due {
+			return nil, ErrWithdrawalScheduleNotDue
+		}
+
+		if schedule.MinBalanceUsd.Valid {
+			balanceUSD, err := s.hotWalletBalanceUSD(ctx, wallet, user)
+			if err != nil {
+				return nil, err
+			}
+			if balanceUSD.LessThanOrEqual(schedule.MinBalanceUsd.Decimal) {
+				return nil, ErrWithdrawalScheduleNotDue
+			}
+		}
+	}
+
+	// gas price is checked on every sweep, the run waits while the network is expensive
+	if schedule.MaxGasPriceGwei.Valid {
+		gasPrice, err := s.gasPriceGwei(ctx, wallet.Blockchain)
+		if err != nil {
+			return nil, err
+		}
+		if gasPrice.GreaterThan(schedule.MaxGasPriceGwei.Decimal) {
+			return nil, ErrWithdrawalScheduleNotDue
+		}
+	}
+
+	if opensRuns(schedule) && !schedule.RunStartedAt.Valid {
+		schedule.RunStartedAt = pgtypeutils.EncodeTime(time.Now().UTC())
+		if err = s.storage.WithdrawalWalletSchedules().StartRun(ctx, repo_withdrawal_wallet_schedules.StartRunParams{
+			ID:           schedule.ID,
+			RunStartedAt: schedule.RunStartedAt,
+		}); err != nil {
+			return nil, fmt.Errorf("start withdrawal schedule run: %w", err)
+		}
+
+		s.logger.Infow("withdrawal schedule run started", "schedule_id", schedule.ID, "user_id", user.ID, "currency_id", wallet.CurrencyID)
+	}
+
+	return schedule, nil
+}
+
+// checkScheduledFloat refuses the sweep which would leave less than the kept float on hot wallets
+func (s *service) checkScheduledFloat(
+	ctx context.Context,
+	schedule *models.WithdrawalWalletSchedule,
+	wallet models.WithdrawalWallet,
+	user *models.User,
+	dto TransferDto,
+) error {
+	if schedule == nil || !schedule.KeepFloatUsd.Valid {
+		return nil
+	}
+
+	balanceUSD, err := s.hotWalletBalanceUSD(ctx, wallet, user)
+	if err != nil {
+		return err
+	}
+	if balanceUSD.Sub(dto.AmountUsd).LessThan(schedule.KeepFloatUsd.Decimal) {
+		s.finishScheduledRun(ctx, schedule)
+		return ErrWithdrawalScheduleFloatReached
+	}
+
+	return nil
+}
+
+// finishScheduledRun closes the open run, next cron activation is counted from now
+func (s *service) finishScheduledRun(ctx context.Context, schedule *models.WithdrawalWalletSchedule) {
+	if schedule == nil || !schedule.RunStartedAt.Valid {
+		return
+	}
+
+	err := s.storage.WithdrawalWalletSchedules().FinishRun(ctx, repo_withdrawal_wallet_schedules.FinishRunParams{
+		ID:        schedule.ID,
+		LastRunAt: pgtypeutils.EncodeTime(time.Now().UTC()),
+	})
+	if err != nil {
+		s.logger.Errorw("failed to finish withdrawal schedule run", "schedule_id", schedule.ID, "error", err)
+		return
+	}
+
+	s.logger.Infow("withdrawal schedule run finished", "schedule_id", schedule.ID)
+}
+
+// hotWalletBalanceUSD sums hot wallet balances of the currency which are not being swept already
+func (s *service) hotWalletBalanceUSD(ctx context.Context, wallet models.WithdrawalWallet, user *models.User) (decimal.Decimal, error) {
+	curr, err := s.currencyService.GetCurrencyByID(ctx, wallet.CurrencyID)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("fetch currency: %w", err)
+	}
+
+	amount, err := s.storage.WalletAddresses().GetTotalAmountByCurrency(ctx, user.ID, wallet.CurrencyID)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("fetch hot wallets balance: %w", err)
+	}
+
+	rate, err := s.currencyRate(ctx, user.RateSource.String(), curr)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return amount.Mul(rate), nil
+}
+
+func (s *service) gasPriceGwei(ctx context.Context, blockchain models.Blockchain) (decimal.Decimal, error) {
+	eBlockchain, err := blockchain.ToEPb()
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("failed to convert blockchain [%s] to pb: %w", blockchain, err)
+	}
+
+	res, err := s.eproxyService.EVM().SuggestGasPrice(ctx, connect.NewRequest(&evmv2.SuggestGasPriceRequest{
+		Blockchain: eBlockchain,
+	}))
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("failed to get gas price: %w", err)
+	}
+
+	gasPriceWei, err := decimal.NewFromString(res.Msg.GasFeeWei)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("failed to parse gas price: %w", err)
+	}
+
+	return gasPriceWei.Shift(-9), nil
+}
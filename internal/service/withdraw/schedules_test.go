@@ -0,0 +1,63 @@
+package withdraw
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+)
+
+func TestCronDue(t *testing.T) {
+	lastRun := time.Date(2026, time.October, 17, 6, 0, 0, 0, time.UTC)
+	text := func(s string) pgtype.Text { return pgtype.Text{String: s, Valid: true} }
+
+	tests := []struct {
+		name     string
+		schedule *models.WithdrawalWalletSchedule
+		now      time.Time
+		due      bool
+	}{
+		{
+			name:     "without cron always due",
+			schedule: &models.WithdrawalWalletSchedule{Timezone: "UTC"},
+			now:      lastRun,
+			due:      true,
+		},
+		{
+			name:     "activation not reached",
+			schedule: &models.WithdrawalWalletSchedule{CronExpression: text("0 9 * * *"), Timezone: "UTC"},
+			now:      time.Date(2026, time.October, 17, 8, 59, 0, 0, time.UTC),
+		},
+		{
+			name:     "activation reached",
+			schedule: &models.WithdrawalWalletSchedule{CronExpression: text("0 9 * * *"), Timezone: "UTC"},
+			now:      time.Date(2026, time.October, 17, 9, 0, 0, 0, time.UTC),
+			due:      true,
+		},
+		{
+			name:     "activation in schedule timezone",
+			schedule: &models.WithdrawalWalletSchedule{CronExpression: text("0 9 * * *"), Timezone: "Asia/Tokyo"},
+			now:      time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC),
+			due:      true,
+		},
+		{
+			name:     "missed activations collapse into one",
+			schedule: &models.WithdrawalWalletSchedule{CronExpression: text("@hourly"), Timezone: "UTC"},
+			now:      lastRun.Add(72 * time.Hour),
+			due:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.schedule.LastRunAt = pgtype.Timestamp{Time: lastRun, Valid: true}
+
+			due, err := cronDue(tt.schedule, tt.now)
+			require.NoError(t, err)
+			require.Equal(t, tt.due, due)
+		})
+	}
+}
@@ -17,10 +17,13 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/dv-net/dv-merchant/internal/cache/settings"
+	"github.com/dv-net/dv-merchant/internal/config"
 	"github.com/dv-net/dv-merchant/internal/models"
 	"github.com/dv-net/dv-merchant/internal/service/currconv"
 	"github.com/dv-net/dv-merchant/internal/service/currency"
+	"github.com/dv-net/dv-merchant/internal/service/eproxy"
 	"github.com/dv-net/dv-merchant/internal/service/exrate"
+	"github.com/dv-net/dv-merchant/internal/service/notify"
 	"github.com/dv-net/dv-merchant/internal/service/processing"
 	"github.com/dv-net/dv-merchant/internal/service/setting"
 	"github.com/dv-net/dv-merchant/internal/storage"
@@ -72,6 +75,12 @@ type service struct {
 	currencyService    currency.ICurrency
 	exRateService      exrate.IExRateSource
 	settings           setting.ISettingService
+	approvalsCfg       config.WithdrawalApprovals
+	allowlistCfg       config.WithdrawalAllowlist
+	notificationSvc    notify.INotificationService
+	eproxyService      eproxy.IExplorerProxy
+	// limitAlerts holds last alert time by withdrawal limit id
+	limitAlerts sync.Map
 }
 
 var _ IWithdrawService = (*service)(nil)
@@ -85,6 +94,10 @@ func New(
 	currencyService currency.ICurrency,
 	exRateService exrate.IExRateSource,
 	settingsSrv setting.ISettingService,
+	approvalsCfg config.WithdrawalApprovals,
+	allowlistCfg config.WithdrawalAllowlist,
+	notificationSvc notify.INotificationService,
+	eproxyService eproxy.IExplorerProxy,
 ) IWithdrawService {
 	return &service{
 		transfersInProcess: blockchainsInProcess{
@@ -99,6 +112,10 @@ func New(
 		currencyService:  currencyService,
 		exRateService:    exRateService,
 		settings:         settingsSrv,
+		approvalsCfg:     approvalsCfg,
+		allowlistCfg:     allowlistCfg,
+		notificationSvc:  notificationSvc,
+		eproxyService:    eproxyService,
 	}
 }
 
@@ -126,13 +143,19 @@ func (s *service) Run(ctx context.Context, blockchains []models.Blockchain) {
 	interval := 2 * time.Second
 	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
+	cleanupTicker := time.NewTicker(time.Hour)
+	defer cleanupTicker.Stop()
 
 	for {
 		select {
 		case <-ctx.Done():
 			ticker.Stop()
 			return
+		case <-cleanupTicker.C:
+			s.cleanupWithdrawalLimitUsages(ctx)
 		case <-ticker.C:
+			s.expireApprovals(ctx)
+
 			// Handle processing withdrawals with high priority
 			s.processWithdrawalsFromProcessing(ctx)
 
@@ -322,11 +345,38 @@ func (s *service) processTransferByWallet(ctx context.Context, wallet models.Wit
 		return nil, ErrPendingProcessingWithdrawal
 	}
 
+	schedule, err := s.startScheduledRun(ctx, wallet, user)
+	if err != nil {
+		return nil, err
+	}
+
 	dto, err := s.prepareTransferDto(ctx, wallet, user, models.TransferKindFromAddress)
 	if err != nil {
+		// nothing is left to sweep, the scheduled run is complete
+		if errors.Is(err, pgx.ErrNoRows) {
+			s.finishScheduledRun(ctx, schedule)
+		}
 		return nil, fmt.Errorf("preapre transfer: %w", err)
 	}
 
+	if err = s.checkScheduledFloat(ctx, schedule, wallet, user, dto); err != nil {
+		return nil, err
+	}
+
+	rule, err := s.storage.MultiWithdrawalRules().GetByWalletID(ctx, wallet.ID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("fetch multi withdrawal rule: %w", err)
+	}
+	if err == nil && rule.Mode == models.MultiWithdrawalModeSplit {
+		curr, currErr := s.currencyService.GetCurrencyByID(ctx, wallet.CurrencyID)
+		if currErr != nil {
+			return nil, fmt.Errorf("fetch currency: %w", currErr)
+		}
+
+		_, err = s.initializeSplitTransfers(ctx, dto, user, *rule, curr)
+		return nil, err
+	}
+
 	s.logger.Infoln(
 		"send processing request to transfer",
 		"from", dto.FromAddresses,
@@ -335,7 +385,7 @@ func (s *service) processTransferByWallet(ctx context.Context, wallet models.Wit
 		"amount_usd", dto.AmountUsd.String(),
 	)
 
-	return s.initializeTransfer(ctx, dto, user, nil)
+	return s.initializeLimitedTransfer(ctx, dto, user)
 }
 
 func (s *service) prepareTransferDto(
@@ -371,6 +421,11 @@ func (s *service) prepareTransferDto(
 		return TransferDto{}, ErrWithdrawalAddressListEmpty
 	}
 
+	withdrawalAddrList, err = s.allowlistedDestinations(ctx, user, wallet.CurrencyID, withdrawalAddrList)
+	if err != nil {
+		return TransferDto{}, err
+	}
+
 	return TransferDto{
 		ID:            uuid.New(),
 		UserID:        user.ID,
@@ -403,7 +458,7 @@ func (s *service) initializeTransfer(
 		WholeAmount:     true,
 	}
 
-	if dto.Kind == models.TransferKindFromProcessing {
+	if dto.Kind == models.TransferKindFromProcessing || dto.ExactAmount {
 		params.Amount = dto.Amount.String()
 		params.WholeAmount = false
 	}
@@ -508,10 +563,12 @@ func (s *service) processMultiTransfers(ctx context.Context) {
 		}
 
 		var preparedWallet string
-		preparedWallet, err = s.prepareWalletToByMode(ctx, &wallet.User, wallet.MultiWithdrawalRule, wallet.Currency, wallet.Addresses)
-		if err != nil {
-			s.logger.Debugw("failed to prepare wallet", "error", err)
-			continue
+		if wallet.MultiWithdrawalRule.Mode != models.MultiWithdrawalModeSplit {
+			preparedWallet, err = s.prepareWalletToByMode(ctx, &wallet.User, wallet.MultiWithdrawalRule, wallet.Currency, wallet.Addresses)
+			if err != nil {
+				s.logger.Debugw("failed to prepare wallet", "error", err)
+				continue
+			}
 		}
 
 		hotWalletData, err := s.storage.WalletAddresses().GetAddressForMultiWithdrawal(
@@ -545,7 +602,14 @@ func (s *service) processMultiTransfers(ctx context.Context) {
 			Blockchain:    *wallet.Currency.Blockchain,
 		}
 
-		transfer, err := s.initializeTransfer(ctx, dto, &wallet.User, nil)
+		if wallet.MultiWithdrawalRule.Mode == models.MultiWithdrawalModeSplit {
+			if _, err = s.initializeSplitTransfers(ctx, dto, &wallet.User, wallet.MultiWithdrawalRule, &wallet.Currency); err != nil && !isIgnoredLogError(err) {
+				s.logger.Errorw("failed to initialize split transfer", "error", err)
+			}
+			continue
+		}
+
+		transfer, err := s.initializeLimitedTransfer(ctx, dto, &wallet.User)
 		if err != nil {
 			s.logger.Errorw("failed to initialize transfer", "error", err)
 			continue
@@ -586,6 +650,10 @@ func (s *service) prepareWalletToByMode(
 			return "", fmt.Errorf("failed to find manual withdrawal wallet in approved addresses")
 		}
 
+		if err = s.checkDestinationAllowed(ctx, u, curr.ID, rule.ManualAddress.String); err != nil {
+			return "", err
+		}
+
 		return rule.ManualAddress.String, nil
 	case models.MultiWithdrawalModeProcessing:
 		res, err := s.processingWallet.GetOwnerProcessingWallet(ctx, processing.GetOwnerProcessingWalletsParams{
@@ -601,7 +669,11 @@ func (s *service) prepareWalletToByMode(
 		if len(withdrawalAddresses) == 0 {
 			return "", errors.New("withdrawal addresses list is empty")
 		}
-		return tools.RandomSliceElement(withdrawalAddresses), nil
+		allowed, err := s.allowlistedDestinations(ctx, u, curr.ID, withdrawalAddresses)
+		if err != nil {
+			return "", err
+		}
+		return tools.RandomSliceElement(allowed), nil
 	default:
 		return "", fmt.Errorf("mode '%s' is not supported", rule.Mode)
 	}
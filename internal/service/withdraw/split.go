@@ -0,0 +1,297 @@
+package withdraw
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/storage/repos"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_multi_withdrawal_rules"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+)
+
+// splitPart is a share of the sweep sent to one split leg
+type splitPart struct {
+	address   string
+	amount    decimal.Decimal
+	amountUSD decimal.Decimal
+	// remainder part transfers the whole balance left after other parts, it also covers the network fee
+	remainder bool
+}
+
+// splitAmount divides the amount by leg percents rounding down to the currency precision.
+// Legs with the share below their minimum are skipped, rounding dust and skipped shares go to
+// the remainder leg or stay on hot wallets when there is none
+func splitAmount(amount, rate decimal.Decimal, precision int32, legs []*models.MultiWithdrawalSplitLeg) []splitPart {
+	hundred := decimal.NewFromInt(100)
+	belowMin := func(leg *models.MultiWithdrawalSplitLeg, value decimal.Decimal) bool {
+		return !value.IsPositive() ||
+			(leg.MinAmountUsd.Valid && value.Mul(rate).LessThan(leg.MinAmountUsd.Decimal))
+	}
+
+	parts := make([]splitPart, 0, len(legs))
+	sent := decimal.Zero
+	var remainderLeg *models.MultiWithdrawalSplitLeg
+	for _, leg := range legs {
+		if leg.ReceivesRemainder {
+			remainderLeg = leg
+			continue
+		}
+
+		share := amount.Mul(leg.Percent).Div(hundred).RoundFloor(precision)
+		if belowMin(leg, share) {
+			continue
+		}
+
+		parts = append(parts, splitPart{address: leg.Address, amount: share, amountUSD: share.Mul(rate)})
+		sent = sent.Add(share)
+	}
+
+	if remainderLeg != nil {
+		rest := amount.Sub(sent)
+		if !belowMin(remainderLeg, rest) {
+			parts = append(parts, splitPart{address: remainderLeg.Address, amount: rest, amountUSD: rest.Mul(rate), remainder: true})
+		}
+	}
+
+	return parts
+}
+
+// nextSplitLegs returns planned legs of the batch to send now: not yet sent and failed ones.
+// The remainder leg takes the whole balance left, so it is sent only after every exact leg is completed.
+// done reports batch with every leg completed, an empty batch is done as well.
+func nextSplitLegs(batch []*repo_multi_withdrawal_rules.GetLatestSplitBatchRow) ([]*repo_multi_withdrawal_rules.GetLatestSplitBatchRow, bool) {
+	needsSend := func(leg *repo_multi_withdrawal_rules.GetLatestSplitBatchRow) bool {
+		status := models.TransferStatus(leg.TransferStatus)
+		return status == "" || status == models.TransferStatusFailed
+	}
+
+	var (
+		send      []*repo_multi_withdrawal_rules.GetLatestSplitBatchRow
+		remainder *repo_multi_withdrawal_rules.GetLatestSplitBatchRow
+		pending   bool
+	)
+	for _, leg := range batch {
+		if leg.MultiWithdrawalSplitTransfer.ReceivesRemainder {
+			remainder = leg
+			continue
+		}
+		if models.TransferStatus(leg.TransferStatus) == models.TransferStatusCompleted {
+			continue
+		}
+		pending = true
+		if needsSend(leg) {
+			send = append(send, leg)
+		}
+	}
+
+	switch {
+	case pending:
+		return send, false
+	case remainder == nil || models.TransferStatus(remainder.TransferStatus) == models.TransferStatusCompleted:
+		return nil, true
+	case needsSend(remainder):
+		return []*repo_multi_withdrawal_rules.GetLatestSplitBatchRow{remainder}, false
+	default:
+		return nil, false
+	}
+}
+
+// initializeSplitTransfers sends weighted shares of the sweep to split legs of the rule as separate transfers.
+// Shares are stored as a batch once, so a partially sent batch is resumed with the planned amounts
+// instead of splitting the balance left again.
+func (s *service) initializeSplitTransfers(
+	ctx context.Context,
+	dto TransferDto,
+	user *models.User,
+	rule models.MultiWithdrawalRule,
+	curr *models.Currency,
+) ([]*models.Transfer, error) {
+	batch, err := s.storage.MultiWithdrawalRules().GetLatestSplitBatch(ctx, rule.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetch split batch: %w", err)
+	}
+
+	legs, done := nextSplitLegs(batch)
+	if done {
+		if batch, err = s.createSplitBatch(ctx, dto, user, rule, curr); err != nil {
+			return nil, err
+		}
+		legs, _ = nextSplitLegs(batch)
+	}
+	if len(legs) == 0 {
+		return nil, ErrSplitBatchInProgress
+	}
+
+	addresses, err := s.storage.WithdrawalWalletAddresses().GetAddressesList(ctx, rule.WithdrawalWalletID)
+	if err != nil {
+		return nil, fmt.Errorf("fetch withdrawal addresses: %w", err)
+	}
+
+	// legs are sent from the hot wallets the batch was planned from, the current sweep balance
+	// describes them only while the sweep picks the same wallets
+	sameSources := true
+	exactTotal := decimal.Zero
+	for _, leg := range legs {
+		planned := leg.MultiWithdrawalSplitTransfer
+		if err = s.checkSplitLegAddress(ctx, user, dto.CurrencyID, addresses, planned.Address); err != nil {
+			return nil, err
+		}
+		if !sameAddresses(planned.FromAddresses, dto.FromAddresses) {
+			sameSources = false
+			// the remainder leg takes whole balance of its sources, other wallets must not be swept into it
+			if planned.ReceivesRemainder {
+				return nil, fmt.Errorf("%w: batch %s", ErrSplitBatchSourcesChanged, planned.BatchID)
+			}
+		}
+		if !planned.ReceivesRemainder {
+			exactTotal = exactTotal.Add(planned.Amount)
+		}
+	}
+	if sameSources && exactTotal.GreaterThan(dto.Amount) {
+		return nil, fmt.Errorf("%w: %s planned, %s available", ErrSplitBatchUnderfunded, exactTotal, dto.Amount)
+	}
+
+	transfers := make([]*models.Transfer, 0, len(legs))
+	for _, leg := range legs {
+		transfer, err := s.sendSplitLeg(ctx, dto, user, leg)
+		if err != nil {
+			return transfers, fmt.Errorf("split transfer to %s: %w", leg.MultiWithdrawalSplitTransfer.Address, err)
+		}
+		transfers = append(transfers, transfer)
+	}
+
+	return transfers, nil
+}
+
+// createSplitBatch splits the sweep by legs of the rule and stores the planned transfers
+func (s *service) createSplitBatch(
+	ctx context.Context,
+	dto TransferDto,
+	user *models.User,
+	rule models.MultiWithdrawalRule,
+	curr *models.Currency,
+) ([]*repo_multi_withdrawal_rules.GetLatestSplitBatchRow, error) {
+	legs, err := s.storage.MultiWithdrawalRules().GetSplitLegsByRuleID(ctx, rule.ID)
+	if err != nil {
+		return nil, fmt.Errorf("fetch split legs: %w", err)
+	}
+	if len(legs) == 0 {
+		return nil, ErrSplitLegsNotSet
+	}
+
+	// a single unavailable leg would break the ratio, so the whole split waits
+	addresses, err := s.storage.WithdrawalWalletAddresses().GetAddressesList(ctx, rule.WithdrawalWalletID)
+	if err != nil {
+		return nil, fmt.Errorf("fetch withdrawal addresses: %w", err)
+	}
+	for _, leg := range legs {
+		if err = s.checkSplitLegAddress(ctx, user, dto.CurrencyID, addresses, leg.Address); err != nil {
+			return nil, err
+		}
+	}
+
+	rate := decimal.Zero
+	if dto.Amount.IsPositive() {
+		rate = dto.AmountUsd.Div(dto.Amount)
+	}
+
+	parts := splitAmount(dto.Amount, rate, int32(curr.Precision), legs)
+	if len(parts) == 0 {
+		return nil, ErrSplitLegsBelowMinimum
+	}
+
+	batchID := uuid.New()
+	batch := make([]*repo_multi_withdrawal_rules.GetLatestSplitBatchRow, 0, len(parts))
+	err = repos.BeginTxFunc(ctx, s.storage.PSQLConn(), pgx.TxOptions{}, func(dbTx pgx.Tx) error {
+		for _, part := range parts {
+			planned, err := s.storage.MultiWithdrawalRules(repos.WithTx(dbTx)).CreateSplitTransfer(ctx, repo_multi_withdrawal_rules.CreateSplitTransferParams{
+				BatchID:               batchID,
+				MultiWithdrawalRuleID: rule.ID,
+				Address:               part.address,
+				Amount:                part.amount,
+				AmountUsd:             part.amountUSD,
+				ReceivesRemainder:     part.remainder,
+				FromAddresses:         dto.FromAddresses,
+			})
+			if err != nil {
+				return err
+			}
+			batch = append(batch, &repo_multi_withdrawal_rules.GetLatestSplitBatchRow{MultiWithdrawalSplitTransfer: *planned})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("store split batch: %w", err)
+	}
+
+	s.logger.Infow("split batch created", "rule_id", rule.ID, "batch_id", batchID, "legs", len(batch), "amount", dto.Amount.String())
+
+	return batch, nil
+}
+
+// sendSplitLeg requests planned transfer of the leg. Transfer id is stored before the request,
+// so a request whose transfer was never recorded is retried with the same id, failed one gets a new id.
+func (s *service) sendSplitLeg(
+	ctx context.Context,
+	dto TransferDto,
+	user *models.User,
+	leg *repo_multi_withdrawal_rules.GetLatestSplitBatchRow,
+) (*models.Transfer, error) {
+	planned := leg.MultiWithdrawalSplitTransfer
+	transferID := planned.TransferID
+	if !transferID.Valid || models.TransferStatus(leg.TransferStatus) == models.TransferStatusFailed {
+		transferID = uuid.NullUUID{UUID: uuid.New(), Valid: true}
+		if err := s.storage.MultiWithdrawalRules().SetSplitTransferTransferID(ctx, repo_multi_withdrawal_rules.SetSplitTransferTransferIDParams{
+			ID:         planned.ID,
+			TransferID: transferID,
+		}); err != nil {
+			return nil, fmt.Errorf("store split transfer id: %w", err)
+		}
+	}
+
+	partDto := dto
+	partDto.ID = transferID.UUID
+	partDto.FromAddresses = planned.FromAddresses
+	partDto.ToAddress = planned.Address
+	partDto.Amount = planned.Amount
+	partDto.AmountUsd = planned.AmountUsd
+	partDto.ExactAmount = !planned.ReceivesRemainder
+
+	transfer, err := s.initializeLimitedTransfer(ctx, partDto, user)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Infow(
+		"split transfer initialized",
+		"batch_id", planned.BatchID,
+		"from", transfer.FromAddresses,
+		"to", transfer.ToAddresses,
+		"amount", planned.Amount.String(),
+		"remainder", planned.ReceivesRemainder,
+	)
+
+	return transfer, nil
+}
+
+func (s *service) checkSplitLegAddress(ctx context.Context, user *models.User, currencyID string, addresses []string, address string) error {
+	if !slices.Contains(addresses, address) {
+		return fmt.Errorf("%w: %s", ErrSplitLegAddressNotApproved, address)
+	}
+
+	return s.checkDestinationAllowed(ctx, user, currencyID, address)
+}
+
+// sameAddresses reports whether both lists hold the same addresses regardless of order
+func sameAddresses(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+
+	return slices.Equal(a, b)
+}
@@ -0,0 +1,161 @@
+package withdraw
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_multi_withdrawal_rules"
+)
+
+func TestSplitAmount(t *testing.T) {
+	leg := func(address, percent string, minUSD string, remainder bool) *models.MultiWithdrawalSplitLeg {
+		l := &models.MultiWithdrawalSplitLeg{
+			Address:           address,
+			Percent:           decimal.RequireFromString(percent),
+			ReceivesRemainder: remainder,
+		}
+		if minUSD != "" {
+			l.MinAmountUsd = decimal.NewNullDecimal(decimal.RequireFromString(minUSD))
+		}
+		return l
+	}
+
+	tests := []struct {
+		name     string
+		amount   string
+		rate     string
+		legs     []*models.MultiWithdrawalSplitLeg
+		expected map[string]string
+	}{
+		{
+			name:     "exact split",
+			amount:   "100",
+			rate:     "1",
+			legs:     []*models.MultiWithdrawalSplitLeg{leg("cold", "70", "", false), leg("exchange", "20", "", false), leg("ops", "10", "", false)},
+			expected: map[string]string{"cold": "70", "exchange": "20", "ops": "10"},
+		},
+		{
+			name:     "rounding dust goes to remainder",
+			amount:   "1",
+			rate:     "1",
+			legs:     []*models.MultiWithdrawalSplitLeg{leg("a", "33.3333", "", false), leg("b", "33.3333", "", false), leg("c", "33.3334", "", true)},
+			expected: map[string]string{"a": "0.33", "b": "0.33", "c": "0.34"},
+		},
+		{
+			name:     "rounding dust stays without remainder",
+			amount:   "1",
+			rate:     "1",
+			legs:     []*models.MultiWithdrawalSplitLeg{leg("a", "50.5", "", false), leg("b", "49.5", "", false)},
+			expected: map[string]string{"a": "0.5", "b": "0.49"},
+		},
+		{
+			name:     "leg below minimum goes to remainder",
+			amount:   "100",
+			rate:     "2",
+			legs:     []*models.MultiWithdrawalSplitLeg{leg("cold", "70", "", true), leg("exchange", "20", "", false), leg("ops", "10", "25", false)},
+			expected: map[string]string{"cold": "80", "exchange": "20"},
+		},
+		{
+			name:     "remainder below minimum stays",
+			amount:   "10",
+			rate:     "1",
+			legs:     []*models.MultiWithdrawalSplitLeg{leg("a", "90", "", false), leg("b", "10", "5", true)},
+			expected: map[string]string{"a": "9"},
+		},
+		{
+			name:     "every leg below minimum",
+			amount:   "10",
+			rate:     "1",
+			legs:     []*models.MultiWithdrawalSplitLeg{leg("a", "50", "20", false), leg("b", "50", "20", true)},
+			expected: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parts := splitAmount(decimal.RequireFromString(tt.amount), decimal.RequireFromString(tt.rate), 2, tt.legs)
+
+			res := make(map[string]string, len(parts))
+			for i, part := range parts {
+				res[part.address] = part.amount.String()
+				// remainder part is always the last one
+				require.Equal(t, i == len(parts)-1 && part.remainder, part.remainder)
+			}
+			require.Equal(t, tt.expected, res)
+		})
+	}
+}
+
+func TestNextSplitLegs(t *testing.T) {
+	leg := func(address string, remainder bool, status models.TransferStatus) *repo_multi_withdrawal_rules.GetLatestSplitBatchRow {
+		return &repo_multi_withdrawal_rules.GetLatestSplitBatchRow{
+			MultiWithdrawalSplitTransfer: models.MultiWithdrawalSplitTransfer{Address: address, ReceivesRemainder: remainder},
+			TransferStatus:               string(status),
+		}
+	}
+
+	tests := []struct {
+		name     string
+		batch    []*repo_multi_withdrawal_rules.GetLatestSplitBatchRow
+		expected []string
+		done     bool
+	}{
+		{name: "no batch", done: true},
+		{
+			name:     "new batch sends exact legs first",
+			batch:    []*repo_multi_withdrawal_rules.GetLatestSplitBatchRow{leg("a", false, ""), leg("b", false, ""), leg("c", true, "")},
+			expected: []string{"a", "b"},
+		},
+		{
+			name:  "remainder waits for exact legs in flight",
+			batch: []*repo_multi_withdrawal_rules.GetLatestSplitBatchRow{leg("a", false, models.TransferStatusCompleted), leg("b", false, models.TransferStatusProcessing), leg("c", true, "")},
+		},
+		{
+			name:     "failed exact leg is resent alone",
+			batch:    []*repo_multi_withdrawal_rules.GetLatestSplitBatchRow{leg("a", false, models.TransferStatusCompleted), leg("b", false, models.TransferStatusFailed), leg("c", true, "")},
+			expected: []string{"b"},
+		},
+		{
+			name:     "remainder after exact legs completed",
+			batch:    []*repo_multi_withdrawal_rules.GetLatestSplitBatchRow{leg("a", false, models.TransferStatusCompleted), leg("b", false, models.TransferStatusCompleted), leg("c", true, "")},
+			expected: []string{"c"},
+		},
+		{
+			name:  "remainder in flight",
+			batch: []*repo_multi_withdrawal_rules.GetLatestSplitBatchRow{leg("a", false, models.TransferStatusCompleted), leg("c", true, models.TransferStatusInMempool)},
+		},
+		{
+			name:  "all completed",
+			batch: []*repo_multi_withdrawal_rules.GetLatestSplitBatchRow{leg("a", false, models.TransferStatusCompleted), leg("c", true, models.TransferStatusCompleted)},
+			done:  true,
+		},
+		{
+			name:  "exact legs only completed",
+			batch: []*repo_multi_withdrawal_rules.GetLatestSplitBatchRow{leg("a", false, models.TransferStatusCompleted), leg("b", false, models.TransferStatusCompleted)},
+			done:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			legs, done := nextSplitLegs(tt.batch)
+			require.Equal(t, tt.done, done)
+
+			addresses := make([]string, 0, len(legs))
+			for _, l := range legs {
+				addresses = append(addresses, l.MultiWithdrawalSplitTransfer.Address)
+			}
+			require.ElementsMatch(t, tt.expected, addresses)
+		})
+	}
+}
+
+func TestSameAddresses(t *testing.T) {
+	require.True(t, sameAddresses([]string{"a", "b"}, []string{"b", "a"}))
+	require.True(t, sameAddresses(nil, []string{}))
+	require.False(t, sameAddresses([]string{"a", "b"}, []string{"a"}))
+	require.False(t, sameAddresses([]string{"a", "b"}, []string{"a", "c"}))
+}
@@ -21,6 +21,9 @@ import (
 )
 
 type IWithdrawalService interface {
+	IWithdrawalApprovalService
+	IWithdrawalLimitService
+	IWithdrawalScheduleService
 	WithdrawFromAddress(ctx context.Context, user *models.User, walletID uuid.UUID, currencyID string) error
 	WithdrawFromAddresses(ctx context.Context, user *models.User, dto MultipleWithdrawalDTO) error
 	WithdrawToProcessingWallet(ctx context.Context, user *models.User, dto WithdrawalToProcessingDTO) error
@@ -34,6 +37,16 @@ func (s *service) WithdrawFromAddress(
 	user *models.User,
 	walletAddressID uuid.UUID,
 	currencyID string,
+) error {
+	return s.withdrawFromAddress(ctx, user, walletAddressID, currencyID, nil)
+}
+
+func (s *service) withdrawFromAddress(
+	ctx context.Context,
+	user *models.User,
+	walletAddressID uuid.UUID,
+	currencyID string,
+	approved *models.WithdrawalApproval,
 ) error {
 	curr, err := s.currencyService.GetCurrencyByID(ctx, currencyID)
 	if err != nil {
@@ -64,6 +77,11 @@ func (s *service) WithdrawFromAddress(
 		return fmt.Errorf("wallet for withdrawal not found")
 	}
 
+	wallets, err = s.allowlistedDestinations(ctx, user, curr.ID, wallets)
+	if err != nil {
+		return err
+	}
+
 	decRate, err := s.currencyRate(ctx, user.RateSource.String(), curr)
 	if err != nil {
 		return err
@@ -82,13 +100,48 @@ func (s *service) WithdrawFromAddress(
 		CurrencyID:    curr.ID,
 		Blockchain:    targetWalletAddress.Blockchain,
 	}
+	// approvers signed off the destination picked when the withdrawal was requested
+	if approved != nil {
+		dto.ToAddress = approved.AddressTo
+		if err = s.checkDestinationAllowed(ctx, user, curr.ID, dto.ToAddress); err != nil {
+			return err
+		}
+	}
+
+	if err = s.requireApproval(ctx, approvalRequest{
+		kind:        models.WithdrawalApprovalKindFromAddress,
+		userID:      user.ID,
+		initiatedBy: uuid.NullUUID{UUID: user.ID, Valid: true},
+		currencyID:  curr.ID,
+		addressTo:   dto.ToAddress,
+		amount:      dto.Amount,
+		amountUSD:   dto.AmountUsd,
+		payload: fromAddressPayload{
+			WalletAddressID: walletAddressID,
+			CurrencyID:      currencyID,
+		},
+	}, approved); err != nil {
+		return err
+	}
+
+	limitUsage, err := s.enforceWithdrawalLimits(ctx, user, limitRequest{
+		currencyID: dto.CurrencyID,
+		addressTo:  dto.ToAddress,
+		amountUSD:  dto.AmountUsd,
+	})
+	if err != nil {
+		return err
+	}
 
 	transfer, err := s.initializeTransfer(ctx, dto, user, nil)
 	if err != nil {
+		s.releaseWithdrawalLimitUsage(ctx, limitUsage)
 		return fmt.Errorf("transfer init: %w", err)
 	}
 
 	if transfer.Status == models.TransferStatusFailed {
+		s.releaseWithdrawalLimitUsage(ctx, limitUsage)
+
 		var msg string
 		if transfer.Message != nil {
 			msg = *transfer.Message
@@ -101,6 +154,10 @@ func (s *service) WithdrawFromAddress(
 }
 
 func (s *service) WithdrawFromAddresses(ctx context.Context, user *models.User, dto MultipleWithdrawalDTO) error {
+	return s.withdrawFromAddresses(ctx, user, dto, nil)
+}
+
+func (s *service) withdrawFromAddresses(ctx context.Context, user *models.User, dto MultipleWithdrawalDTO, approved *models.WithdrawalApproval) error {
 	params := repo_wallet_addresses.GetListByCurrencyWithAmountParams{
 		CurrID:      dto.CurrencyID,
 		UserID:      user.ID,
@@ -134,6 +191,10 @@ func (s *service) WithdrawFromAddresses(ctx context.Context, user *models.User,
 		return err
 	}
 
+	if err = s.checkDestinationAllowed(ctx, user, walletList.Currency.ID, withdrawalAddr.Address); err != nil {
+		return err
+	}
+
 	transferDto := TransferDto{
 		ID:            uuid.New(),
 		UserID:        user.ID,
@@ -148,12 +209,37 @@ func (s *service) WithdrawFromAddresses(ctx context.Context, user *models.User,
 		Blockchain:    *walletList.Currency.Blockchain,
 	}
 
+	if err = s.requireApproval(ctx, approvalRequest{
+		kind:        models.WithdrawalApprovalKindFromAddresses,
+		userID:      user.ID,
+		initiatedBy: uuid.NullUUID{UUID: user.ID, Valid: true},
+		currencyID:  transferDto.CurrencyID,
+		addressTo:   transferDto.ToAddress,
+		amount:      transferDto.Amount,
+		amountUSD:   transferDto.AmountUsd,
+		payload:     dto,
+	}, approved); err != nil {
+		return err
+	}
+
+	limitUsage, err := s.enforceWithdrawalLimits(ctx, user, limitRequest{
+		currencyID: transferDto.CurrencyID,
+		addressTo:  transferDto.ToAddress,
+		amountUSD:  transferDto.AmountUsd,
+	})
+	if err != nil {
+		return err
+	}
+
 	transfer, err := s.initializeTransfer(ctx, transferDto, user, nil)
 	if err != nil {
+		s.releaseWithdrawalLimitUsage(ctx, limitUsage)
 		return fmt.Errorf("transfer init: %w", err)
 	}
 
 	if transfer.Status == models.TransferStatusFailed {
+		s.releaseWithdrawalLimitUsage(ctx, limitUsage)
+
 		var msg string
 		if transfer.Message != nil {
 			msg = *transfer.Message
@@ -235,6 +321,14 @@ func (s *service) WithdrawToProcessingWallet(ctx context.Context, user *models.U
 }
 
 func (s *service) CreateWithdrawalFromProcessing(ctx context.Context, dto CreateWithdrawalFromProcessingDTO) (*models.WithdrawalFromProcessingWallet, error) {
+	return s.createWithdrawalFromProcessing(ctx, dto, nil)
+}
+
+func (s *service) createWithdrawalFromProcessing(
+	ctx context.Context,
+	dto CreateWithdrawalFromProcessingDTO,
+	approved *models.WithdrawalApproval,
+) (*models.WithdrawalFromProcessingWallet, error) {
 	usr, err := s.storage.Users().GetByID(ctx, dto.UserID)
 	if err != nil {
 		return nil, fmt.Errorf("fetch user: %w", err)
@@ -300,6 +394,10 @@ func (s *service) CreateWithdrawalFromProcessing(ctx context.Context, dto Create
 		return nil, ErrWithdrawFromProcessingToHotNotAllowed
 	}
 
+	if err = s.checkDestinationAllowed(ctx, usr, curr.ID, dto.AddressTo); err != nil {
+		return nil, err
+	}
+
 	if !usr.ProcessingOwnerID.Valid {
 		return nil, ErrProcessingUninitialized
 	}
@@ -330,8 +428,39 @@ func (s *service) CreateWithdrawalFromProcessing(ctx context.Context, dto Create
 	if dto.StoreID != nil {
 		createParams.StoreID = *dto.StoreID
 	}
+
+	decRate, err := s.currencyRate(ctx, usr.RateSource.String(), curr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.requireApproval(ctx, approvalRequest{
+		kind:        models.WithdrawalApprovalKindFromProcessing,
+		userID:      usr.ID,
+		initiatedBy: dto.InitiatedBy,
+		storeID:     uuid.NullUUID{UUID: createParams.StoreID, Valid: createParams.StoreID != uuid.Nil},
+		requestID:   dto.RequestID,
+		currencyID:  curr.ID,
+		addressTo:   dto.AddressTo,
+		amount:      dto.Amount,
+		amountUSD:   decRate.Mul(dto.Amount),
+		payload:     dto,
+	}, approved); err != nil {
+		return nil, err
+	}
+
+	limitUsage, err := s.enforceWithdrawalLimits(ctx, usr, limitRequest{
+		storeID:    uuid.NullUUID{UUID: createParams.StoreID, Valid: createParams.StoreID != uuid.Nil},
+		currencyID: curr.ID,
+		addressTo:  dto.AddressTo,
+		amountUSD:  decRate.Mul(dto.Amount),
+	})
+	if err != nil {
+		return nil, err
+	}
 	withdrawal, err := s.storage.WithdrawalsFromProcessing().Create(ctx, createParams)
 	if err != nil {
+		s.releaseWithdrawalLimitUsage(ctx, limitUsage)
 		return nil, fmt.Errorf("withdrawal creation: %w", err)
 	}
 
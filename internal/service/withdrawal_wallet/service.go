@@ -171,7 +171,7 @@ func (s Service) UpdateWithdrawalRules(ctx context.Context, dto UpdateRulesDTO)
 			return s.storage.MultiWithdrawalRules().RemoveByWalletID(ctx, withdrawalWallet.ID)
 		}
 
-		if !dto.Currency.Blockchain.IsBitcoinLike() {
+		if !dto.MultiWithdrawal.Mode.IsValidByCurrency(*dto.Currency) {
 			return fmt.Errorf("multi withdrawal rules supported only for bitcoin-like chains")
 		}
 
@@ -196,7 +196,11 @@ func (s Service) UpdateWithdrawalRules(ctx context.Context, dto UpdateRulesDTO)
 			multiWithdrawalsParam.ManualAddress = pgtype.Text{Valid: true, String: *dto.MultiWithdrawal.ManualAddress}
 		}
 
-		return s.storage.MultiWithdrawalRules(repos.WithTx(tx)).CreateOrUpdate(ctx, multiWithdrawalsParam)
+		if err = s.storage.MultiWithdrawalRules(repos.WithTx(tx)).CreateOrUpdate(ctx, multiWithdrawalsParam); err != nil {
+			return err
+		}
+
+		return s.saveSplitLegs(ctx, withdrawalWallet.ID, dto.MultiWithdrawal, tx)
 	})
 }
 
@@ -452,6 +456,11 @@ func (s Service) buildWithdrawalWithAddress(ctx context.Context, wallet *models.
 	if multiWithdrawalRules.ManualAddress.Valid {
 		multiRuleDTO.ManualAddress = &multiWithdrawalRules.ManualAddress.String
 	}
+	if multiWithdrawalRules.Mode == models.MultiWithdrawalModeSplit {
+		if multiRuleDTO.SplitLegs, err = s.loadSplitLegs(ctx, multiWithdrawalRules.ID, opts...); err != nil {
+			return nil, err
+		}
+	}
 
 	var rate decimal.Decimal
 	if currency.IsStableCoin {
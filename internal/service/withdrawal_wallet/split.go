@@ -0,0 +1,127 @@
+package withdrawal_wallet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/storage/repos"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_multi_withdrawal_rules"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_withdrawal_wallet_addresses"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+)
+
+const maxSplitLegs = 10
+
+var (
+	ErrSplitLegsCount           = fmt.Errorf("split withdrawal requires from 2 to %d legs", maxSplitLegs)
+	ErrSplitLegsPercentSum      = errors.New("split withdrawal legs percents must sum to 100")
+	ErrSplitLegPercent          = errors.New("split withdrawal leg percent must be positive")
+	ErrSplitLegMinAmount        = errors.New("split withdrawal leg min amount must be positive")
+	ErrSplitLegDuplicateAddress = errors.New("split withdrawal leg addresses must be unique")
+	ErrSplitLegsRemainder       = errors.New("only one split withdrawal leg can receive the remainder")
+)
+
+// ValidateSplitLegs checks the weights of split legs, each leg address may be used once
+func ValidateSplitLegs(legs []SplitLegDTO) error {
+	if len(legs) < 2 || len(legs) > maxSplitLegs {
+		return ErrSplitLegsCount
+	}
+
+	total := decimal.Zero
+	addresses := make(map[string]struct{}, len(legs))
+	remainderLegs := 0
+	for _, leg := range legs {
+		if !leg.Percent.IsPositive() {
+			return ErrSplitLegPercent
+		}
+		if leg.MinAmountUSD.Valid && !leg.MinAmountUSD.Decimal.IsPositive() {
+			return ErrSplitLegMinAmount
+		}
+		if _, ok := addresses[leg.Address]; ok {
+			return ErrSplitLegDuplicateAddress
+		}
+		addresses[leg.Address] = struct{}{}
+		if leg.ReceivesRemainder {
+			remainderLegs++
+		}
+		total = total.Add(leg.Percent)
+	}
+
+	if remainderLegs > 1 {
+		return ErrSplitLegsRemainder
+	}
+	if !total.Equal(decimal.NewFromInt(100)) {
+		return ErrSplitLegsPercentSum
+	}
+
+	return nil
+}
+
+// saveSplitLegs replaces split legs of the rule, legs are kept only for split mode
+func (s Service) saveSplitLegs(ctx context.Context, withdrawalWalletID uuid.UUID, dto *MultiWithdrawalRuleDTO, tx pgx.Tx) error {
+	rule, err := s.storage.MultiWithdrawalRules(repos.WithTx(tx)).GetByWalletID(ctx, withdrawalWalletID)
+	if err != nil {
+		return fmt.Errorf("failed to get multi withdrawal rule: %w", err)
+	}
+
+	if err = s.storage.MultiWithdrawalRules(repos.WithTx(tx)).RemoveSplitLegsByRuleID(ctx, rule.ID); err != nil {
+		return fmt.Errorf("failed to remove split legs: %w", err)
+	}
+
+	if dto.Mode != models.MultiWithdrawalModeSplit {
+		return nil
+	}
+
+	if err = ValidateSplitLegs(dto.SplitLegs); err != nil {
+		return err
+	}
+
+	for _, leg := range dto.SplitLegs {
+		exists, err := s.storage.WithdrawalWalletAddresses(repos.WithTx(tx)).CheckAddressExists(
+			ctx,
+			repo_withdrawal_wallet_addresses.CheckAddressExistsParams{
+				WithdrawalWalletID: withdrawalWalletID,
+				Address:            leg.Address,
+			},
+		)
+		if !exists || err != nil {
+			return fmt.Errorf("failed to find split leg wallet %s in approved addresses", leg.Address)
+		}
+
+		if _, err = s.storage.MultiWithdrawalRules(repos.WithTx(tx)).CreateSplitLeg(ctx, repo_multi_withdrawal_rules.CreateSplitLegParams{
+			MultiWithdrawalRuleID: rule.ID,
+			Address:               leg.Address,
+			Percent:               leg.Percent,
+			MinAmountUsd:          leg.MinAmountUSD,
+			ReceivesRemainder:     leg.ReceivesRemainder,
+		}); err != nil {
+			return fmt.Errorf("failed to create split leg: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s Service) loadSplitLegs(ctx context.Context, ruleID uuid.UUID, opts ...repos.Option) ([]SplitLegDTO, error) {
+	legs, err := s.storage.MultiWithdrawalRules(opts...).GetSplitLegsByRuleID(ctx, ruleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get split legs: %w", err)
+	}
+
+	res := make([]SplitLegDTO, 0, len(legs))
+	for _, leg := range legs {
+		res = append(res, SplitLegDTO{
+			Address:           leg.Address,
+			Percent:           leg.Percent,
+			MinAmountUSD:      leg.MinAmountUsd,
+			ReceivesRemainder: leg.ReceivesRemainder,
+		})
+	}
+
+	return res, nil
+}
@@ -32,6 +32,14 @@ type UpdateRulesDTO struct {
 type MultiWithdrawalRuleDTO struct {
 	Mode          models.MultiWithdrawalMode `json:"mode"`
 	ManualAddress *string                    `json:"manual_address"`
+	SplitLegs     []SplitLegDTO              `json:"split_legs"`
+}
+
+type SplitLegDTO struct {
+	Address           string              `json:"address"`
+	Percent           decimal.Decimal     `json:"percent"`
+	MinAmountUSD      decimal.NullDecimal `json:"min_amount_usd"`
+	ReceivesRemainder bool                `json:"receives_remainder"`
 }
 
 type UpdateAddressesListDTO struct {
@@ -3,6 +3,8 @@ package repo_currency_exrate
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/dv-net/dv-merchant/pkg/key_value"
@@ -12,14 +14,24 @@ import (
 type ICurrencyRateRepo interface {
 	StoreRate(ctx context.Context, from, to, source, value string, ttl time.Duration) error
 	GetRate(ctx context.Context, from, to, source string) (string, error)
+	GetSourceRate(ctx context.Context, source, from, to string) (string, error)
+	GetRateUpdatedAt(ctx context.Context, source, from, to string) (time.Time, error)
 }
 
+const updatedAtSuffix = ":updated_at"
+
 type Repo struct {
 	driver key_value.IKeyValue
 }
 
+// StoreRate stores rate with the time it was fetched at
 func (r *Repo) StoreRate(ctx context.Context, source, from, to, value string, ttl time.Duration) error {
-	return r.driver.Set(ctx, source+":"+from+":"+to, value, ttl)
+	key := source + ":" + from + ":" + to
+	if err := r.driver.Set(ctx, key, value, ttl); err != nil {
+		return err
+	}
+
+	return r.driver.Set(ctx, key+updatedAtSuffix, strconv.FormatInt(time.Now().UnixMilli(), 10), ttl)
 }
 
 func (r *Repo) GetRate(ctx context.Context, source, from, to string) (string, error) {
@@ -36,6 +48,31 @@ func (r *Repo) GetRate(ctx context.Context, source, from, to string) (string, er
 	return r.getBestRate(ctx, pattern)
 }
 
+// GetSourceRate returns rate of the source only, without falling back to other sources
+func (r *Repo) GetSourceRate(ctx context.Context, source, from, to string) (string, error) {
+	res, err := r.driver.Get(ctx, source+":"+from+":"+to)
+	if err != nil {
+		return "", err
+	}
+
+	return res.String(), nil
+}
+
+// GetRateUpdatedAt returns the time rate of the source was fetched at
+func (r *Repo) GetRateUpdatedAt(ctx context.Context, source, from, to string) (time.Time, error) {
+	res, err := r.driver.Get(ctx, source+":"+from+":"+to+updatedAtSuffix)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	ms, err := strconv.ParseInt(res.String(), 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse rate update time: %w", err)
+	}
+
+	return time.UnixMilli(ms).UTC(), nil
+}
+
 func (r *Repo) getBestRate(ctx context.Context, pattern string) (string, error) {
 	keys, err := r.driver.Keys(ctx, pattern)
 
@@ -0,0 +1,31 @@
+package repo_currency_exrate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dv-net/dv-merchant/pkg/key_value"
+)
+
+func TestRateUpdatedAt(t *testing.T) {
+	ctx := context.Background()
+	repo := New(key_value.NewInMemory())
+
+	before := time.Now().Add(-time.Millisecond)
+	require.NoError(t, repo.StoreRate(ctx, "binance", "BTC", "USDT", "100", time.Minute))
+
+	updatedAt, err := repo.GetRateUpdatedAt(ctx, "binance", "BTC", "USDT")
+	require.NoError(t, err)
+	require.False(t, updatedAt.Before(before.Truncate(time.Millisecond)))
+
+	_, err = repo.GetRateUpdatedAt(ctx, "okx", "BTC", "USDT")
+	require.ErrorIs(t, err, key_value.ErrEntryNotFound)
+
+	// timestamps must not be picked as rate of other sources
+	rate, err := repo.GetRate(ctx, "okx", "BTC", "USDT")
+	require.NoError(t, err)
+	require.Equal(t, "100", rate)
+}
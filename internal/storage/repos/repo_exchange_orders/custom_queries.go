@@ -71,6 +71,13 @@ func (s *CustomQuerier) GetByStatus(ctx context.Context, params GetExchangeOrder
 			FailReason:      row.FailReason,
 			Status:          row.Status,
 			UserID:          row.UserID,
+			Route:           row.Route,
+			OrderType:       row.OrderType,
+			Price:           row.Price,
+			TimeInForce:     row.TimeInForce,
+			ParentID:        row.ParentID,
+			ScheduledAt:     row.ScheduledAt,
+			ExpiresAt:       row.ExpiresAt,
 		})
 	}
 
@@ -168,6 +175,13 @@ func (s *CustomQuerier) GetByUserAndExchangeID(ctx context.Context, params GetEx
 				FailReason:      row.FailReason,
 				Status:          row.Status,
 				UserID:          row.UserID,
+				Route:           row.Route,
+				OrderType:       row.OrderType,
+				Price:           row.Price,
+				TimeInForce:     row.TimeInForce,
+				ParentID:        row.ParentID,
+				ScheduledAt:     row.ScheduledAt,
+				ExpiresAt:       row.ExpiresAt,
 			},
 			Slug: row.Slug,
 		})
@@ -226,6 +240,13 @@ func (s *CustomQuerier) GetAllByUserFiltered(ctx context.Context, params GetExpo
 				FailReason:      row.FailReason,
 				Status:          row.Status,
 				UserID:          row.UserID,
+				Route:           row.Route,
+				OrderType:       row.OrderType,
+				Price:           row.Price,
+				TimeInForce:     row.TimeInForce,
+				ParentID:        row.ParentID,
+				ScheduledAt:     row.ScheduledAt,
+				ExpiresAt:       row.ExpiresAt,
 			},
 			Slug: row.Slug,
 		})
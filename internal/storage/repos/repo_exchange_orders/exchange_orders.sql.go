@@ -8,11 +8,137 @@ package repo_exchange_orders
 import (
 	"context"
 
+	"github.com/dv-net/dv-merchant/internal/models"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/shopspring/decimal"
 )
 
+const getChildren = `-- name: GetChildren :many
+SELECT id, exchange_id, exchange_order_id, client_order_id, symbol, side, amount, order_created_at, created_at, updated_at, fail_reason, status, user_id, amount_usd, exchange_connection_hash, route, order_type, price, time_in_force, parent_id, scheduled_at, expires_at
+FROM exchange_orders
+WHERE parent_id = $1
+ORDER BY scheduled_at
+`
+
+func (q *Queries) GetChildren(ctx context.Context, parentID uuid.NullUUID) ([]*models.ExchangeOrder, error) {
+	rows, err := q.db.Query(ctx, getChildren, parentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*models.ExchangeOrder{}
+	for rows.Next() {
+		var i models.ExchangeOrder
+		if err := rows.Scan(
+			&i.ID,
+			&i.ExchangeID,
+			&i.ExchangeOrderID,
+			&i.ClientOrderID,
+			&i.Symbol,
+			&i.Side,
+			&i.Amount,
+			&i.OrderCreatedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.FailReason,
+			&i.Status,
+			&i.UserID,
+			&i.AmountUsd,
+			&i.ExchangeConnectionHash,
+			&i.Route,
+			&i.OrderType,
+			&i.Price,
+			&i.TimeInForce,
+			&i.ParentID,
+			&i.ScheduledAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDueSlices = `-- name: GetDueSlices :many
+SELECT id, exchange_id, exchange_order_id, client_order_id, symbol, side, amount, order_created_at, created_at, updated_at, fail_reason, status, user_id, amount_usd, exchange_connection_hash, route, order_type, price, time_in_force, parent_id, scheduled_at, expires_at
+FROM exchange_orders
+WHERE status = 'new'
+  AND parent_id IS NOT NULL
+  AND scheduled_at <= now()
+ORDER BY scheduled_at
+`
+
+func (q *Queries) GetDueSlices(ctx context.Context) ([]*models.ExchangeOrder, error) {
+	rows, err := q.db.Query(ctx, getDueSlices)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*models.ExchangeOrder{}
+	for rows.Next() {
+		var i models.ExchangeOrder
+		if err := rows.Scan(
+			&i.ID,
+			&i.ExchangeID,
+			&i.ExchangeOrderID,
+			&i.ClientOrderID,
+			&i.Symbol,
+			&i.Side,
+			&i.Amount,
+			&i.OrderCreatedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.FailReason,
+			&i.Status,
+			&i.UserID,
+			&i.AmountUsd,
+			&i.ExchangeConnectionHash,
+			&i.Route,
+			&i.OrderType,
+			&i.Price,
+			&i.TimeInForce,
+			&i.ParentID,
+			&i.ScheduledAt,
+			&i.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const hasActiveBySymbol = `-- name: HasActiveBySymbol :one
+SELECT EXISTS (SELECT 1
+              FROM exchange_orders
+              WHERE user_id = $1
+                AND exchange_id = $2
+                AND symbol = $3
+                AND parent_id IS NULL
+                AND status IN ('new', 'in_progress'))
+`
+
+type HasActiveBySymbolParams struct {
+	UserID     uuid.UUID `db:"user_id" json:"user_id"`
+	ExchangeID uuid.UUID `db:"exchange_id" json:"exchange_id"`
+	Symbol     string    `db:"symbol" json:"symbol"`
+}
+
+func (q *Queries) HasActiveBySymbol(ctx context.Context, arg HasActiveBySymbolParams) (bool, error) {
+	row := q.db.QueryRow(ctx, hasActiveBySymbol, arg.UserID, arg.ExchangeID, arg.Symbol)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
 const update = `-- name: Update :exec
 UPDATE exchange_orders
 SET updated_at        = now(),
@@ -22,8 +148,9 @@ SET updated_at        = now(),
     amount_usd        = COALESCE($4, amount_usd),
     exchange_order_id = COALESCE($5, exchange_order_id),
     client_order_id   = COALESCE($6, client_order_id),
-    exchange_connection_hash = COALESCE($7, exchange_connection_hash)
-WHERE id = $8
+    exchange_connection_hash = COALESCE($7, exchange_connection_hash),
+    price             = COALESCE($8, price)
+WHERE id = $9
 `
 
 type UpdateParams struct {
@@ -34,6 +161,7 @@ type UpdateParams struct {
 	ExchangeOrderID        pgtype.Text         `db:"exchange_order_id" json:"exchange_order_id"`
 	ClientOrderID          pgtype.Text         `db:"client_order_id" json:"client_order_id"`
 	ExchangeConnectionHash pgtype.Text         `db:"exchange_connection_hash" json:"exchange_connection_hash"`
+	Price                  decimal.NullDecimal `db:"price" json:"price"`
 	ID                     uuid.UUID           `db:"id" json:"id"`
 }
 
@@ -46,6 +174,7 @@ func (q *Queries) Update(ctx context.Context, arg UpdateParams) error {
 		arg.ExchangeOrderID,
 		arg.ClientOrderID,
 		arg.ExchangeConnectionHash,
+		arg.Price,
 		arg.ID,
 	)
 	return err
@@ -15,9 +15,9 @@ import (
 )
 
 const create = `-- name: Create :one
-INSERT INTO exchange_orders (exchange_id, exchange_order_id, client_order_id, symbol, side, amount, order_created_at, created_at, fail_reason, status, user_id, amount_usd, exchange_connection_hash)
-	VALUES ($1, $2, $3, $4, $5, $6, $7, now(), $8, $9, $10, $11, $12)
-	RETURNING id, exchange_id, exchange_order_id, client_order_id, symbol, side, amount, order_created_at, created_at, updated_at, fail_reason, status, user_id, amount_usd, exchange_connection_hash
+INSERT INTO exchange_orders (exchange_id, exchange_order_id, client_order_id, symbol, side, amount, order_created_at, created_at, fail_reason, status, user_id, amount_usd, exchange_connection_hash, route, order_type, price, time_in_force, parent_id, scheduled_at, expires_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, now(), $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
+	RETURNING id, exchange_id, exchange_order_id, client_order_id, symbol, side, amount, order_created_at, created_at, updated_at, fail_reason, status, user_id, amount_usd, exchange_connection_hash, route, order_type, price, time_in_force, parent_id, scheduled_at, expires_at
 `
 
 type CreateParams struct {
@@ -33,6 +33,13 @@ type CreateParams struct {
 	UserID                 uuid.UUID                  `db:"user_id" json:"user_id"`
 	AmountUsd              decimal.NullDecimal        `db:"amount_usd" json:"amount_usd"`
 	ExchangeConnectionHash pgtype.Text                `db:"exchange_connection_hash" json:"exchange_connection_hash"`
+	Route                  []byte                     `db:"route" json:"route"`
+	OrderType              models.OrderType           `db:"order_type" json:"order_type"`
+	Price                  decimal.NullDecimal        `db:"price" json:"price"`
+	TimeInForce            pgtype.Text                `db:"time_in_force" json:"time_in_force"`
+	ParentID               uuid.NullUUID              `db:"parent_id" json:"parent_id"`
+	ScheduledAt            pgtype.Timestamp           `db:"scheduled_at" json:"scheduled_at"`
+	ExpiresAt              pgtype.Timestamp           `db:"expires_at" json:"expires_at"`
 }
 
 func (q *Queries) Create(ctx context.Context, arg CreateParams) (*models.ExchangeOrder, error) {
@@ -49,6 +56,13 @@ func (q *Queries) Create(ctx context.Context, arg CreateParams) (*models.Exchang
 		arg.UserID,
 		arg.AmountUsd,
 		arg.ExchangeConnectionHash,
+		arg.Route,
+		arg.OrderType,
+		arg.Price,
+		arg.TimeInForce,
+		arg.ParentID,
+		arg.ScheduledAt,
+		arg.ExpiresAt,
 	)
 	var i models.ExchangeOrder
 	err := row.Scan(
@@ -67,12 +81,19 @@ func (q *Queries) Create(ctx context.Context, arg CreateParams) (*models.Exchang
 		&i.UserID,
 		&i.AmountUsd,
 		&i.ExchangeConnectionHash,
+		&i.Route,
+		&i.OrderType,
+		&i.Price,
+		&i.TimeInForce,
+		&i.ParentID,
+		&i.ScheduledAt,
+		&i.ExpiresAt,
 	)
 	return &i, err
 }
 
 const getByID = `-- name: GetByID :one
-SELECT id, exchange_id, exchange_order_id, client_order_id, symbol, side, amount, order_created_at, created_at, updated_at, fail_reason, status, user_id, amount_usd, exchange_connection_hash FROM exchange_orders WHERE id=$1 LIMIT 1
+SELECT id, exchange_id, exchange_order_id, client_order_id, symbol, side, amount, order_created_at, created_at, updated_at, fail_reason, status, user_id, amount_usd, exchange_connection_hash, route, order_type, price, time_in_force, parent_id, scheduled_at, expires_at FROM exchange_orders WHERE id=$1 LIMIT 1
 `
 
 func (q *Queries) GetByID(ctx context.Context, id uuid.UUID) (*models.ExchangeOrder, error) {
@@ -94,6 +115,13 @@ func (q *Queries) GetByID(ctx context.Context, id uuid.UUID) (*models.ExchangeOr
 		&i.UserID,
 		&i.AmountUsd,
 		&i.ExchangeConnectionHash,
+		&i.Route,
+		&i.OrderType,
+		&i.Price,
+		&i.TimeInForce,
+		&i.ParentID,
+		&i.ScheduledAt,
+		&i.ExpiresAt,
 	)
 	return &i, err
 }
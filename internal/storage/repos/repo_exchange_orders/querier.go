@@ -9,11 +9,20 @@ import (
 
 	"github.com/dv-net/dv-merchant/internal/models"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 type Querier interface {
+	// negative amount releases the reserve, it never goes below zero
+	AddRoutedReserve(ctx context.Context, arg AddRoutedReserveParams) error
+	// reserve can not exceed the balance left on the exchange
+	CapRoutedReserve(ctx context.Context, arg CapRoutedReserveParams) error
 	Create(ctx context.Context, arg CreateParams) (*models.ExchangeOrder, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*models.ExchangeOrder, error)
+	GetChildren(ctx context.Context, parentID uuid.NullUUID) ([]*models.ExchangeOrder, error)
+	GetDueSlices(ctx context.Context) ([]*models.ExchangeOrder, error)
+	GetRoutedReserve(ctx context.Context, arg GetRoutedReserveParams) (decimal.Decimal, error)
+	HasActiveBySymbol(ctx context.Context, arg HasActiveBySymbolParams) (bool, error)
 	Update(ctx context.Context, arg UpdateParams) error
 }
 
@@ -0,0 +1,34 @@
+package repo_invoices
+
+import (
+	"context"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/storage/storecmn"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type ICustomQuerier interface {
+	Querier
+	GetByStore(ctx context.Context, params GetByStoreParams) (*storecmn.FindResponseWithFullPagination[*models.Invoice], error)
+}
+
+type CustomQuerier struct {
+	*Queries
+	psql DBTX
+}
+
+func NewCustom(psql DBTX) *CustomQuerier {
+	return &CustomQuerier{
+		Queries: New(psql),
+		psql:    psql,
+	}
+}
+
+func (s *CustomQuerier) WithTx(tx pgx.Tx) *CustomQuerier {
+	return &CustomQuerier{
+		Queries: New(tx),
+		psql:    tx,
+	}
+}
@@ -0,0 +1,91 @@
+package repo_invoices
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/storage/storecmn"
+	"github.com/dv-net/dv-merchant/pkg/dbutils"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/huandu/go-sqlbuilder"
+)
+
+type GetByStoreParams struct {
+	storecmn.CommonFindParams
+	StoreID  uuid.UUID
+	Statuses []models.InvoiceStatus
+	OrderID  *string
+}
+
+const maxLimit = 1000
+
+func (s *CustomQuerier) GetByStore(ctx context.Context, params GetByStoreParams) (*storecmn.FindResponseWithFullPagination[*models.Invoice], error) {
+	sb := sqlbuilder.PostgreSQL.NewSelectBuilder()
+	sb.Select("invoices.*").
+		From("invoices").
+		Where(sb.Equal("invoices.store_id", params.StoreID.String()))
+
+	countSb := sqlbuilder.PostgreSQL.NewSelectBuilder()
+	countSb.Select("COUNT(invoices.id)").
+		From("invoices").
+		Where(countSb.Equal("invoices.store_id", params.StoreID.String()))
+
+	if len(params.Statuses) > 0 {
+		statuses := make([]interface{}, 0, len(params.Statuses))
+		for _, status := range params.Statuses {
+			statuses = append(statuses, status.String())
+		}
+		sb.Where(sb.In("invoices.status", statuses...))
+		countSb.Where(countSb.In("invoices.status", statuses...))
+	}
+
+	if params.OrderID != nil {
+		sb.Where(sb.Equal("invoices.order_id", *params.OrderID))
+		countSb.Where(countSb.Equal("invoices.order_id", *params.OrderID))
+	}
+
+	limit, offset, err := dbutils.Pagination(params.Page, params.PageSize, dbutils.WithMaxLimit(maxLimit))
+	if err != nil {
+		return nil, err
+	}
+
+	sb.OrderBy("invoices.created_at").Desc()
+	sb.Limit(int(limit))
+	sb.Offset(int(offset))
+
+	items := make([]*models.Invoice, 0)
+	sql, args := sb.Build()
+	if err := pgxscan.Select(ctx, s.psql, &items, sql, args...); err != nil {
+		return nil, fmt.Errorf("select invoices: %w", err)
+	}
+
+	var totalCnt uint64
+	pagingSQL, args := countSb.Build()
+	if err := pgxscan.Get(ctx, s.psql, &totalCnt, pagingSQL, args...); err != nil {
+		return nil, fmt.Errorf("select paging query: %w", err)
+	}
+
+	var page uint64 = 1
+	if params.Page != nil {
+		page = uint64(*params.Page)
+	}
+
+	var pagesCnt uint64 = 1
+	if params.PageSize != nil {
+		pagesCnt = uint64(math.Ceil(float64(totalCnt) / float64(*params.PageSize)))
+	}
+
+	return &storecmn.FindResponseWithFullPagination[*models.Invoice]{
+		Items: items,
+		Pagination: storecmn.FullPagingData{
+			Total:    totalCnt,
+			PageSize: uint64(limit),
+			Page:     page,
+			LastPage: pagesCnt,
+		},
+	}, nil
+}
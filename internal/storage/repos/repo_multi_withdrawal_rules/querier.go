@@ -14,8 +14,15 @@ import (
 type Querier interface {
 	Create(ctx context.Context, arg CreateParams) (*models.MultiWithdrawalRule, error)
 	CreateOrUpdate(ctx context.Context, arg CreateOrUpdateParams) error
+	CreateSplitLeg(ctx context.Context, arg CreateSplitLegParams) (*models.MultiWithdrawalSplitLeg, error)
+	CreateSplitTransfer(ctx context.Context, arg CreateSplitTransferParams) (*models.MultiWithdrawalSplitTransfer, error)
 	GetByWalletID(ctx context.Context, withdrawalWalletID uuid.UUID) (*models.MultiWithdrawalRule, error)
+	// planned transfers of the latest split batch of the rule with status of their transfers, empty when there is none
+	GetLatestSplitBatch(ctx context.Context, multiWithdrawalRuleID uuid.UUID) ([]*GetLatestSplitBatchRow, error)
+	GetSplitLegsByRuleID(ctx context.Context, multiWithdrawalRuleID uuid.UUID) ([]*models.MultiWithdrawalSplitLeg, error)
 	RemoveByWalletID(ctx context.Context, withdrawalWalletID uuid.UUID) error
+	RemoveSplitLegsByRuleID(ctx context.Context, multiWithdrawalRuleID uuid.UUID) error
+	SetSplitTransferTransferID(ctx context.Context, arg SetSplitTransferTransferIDParams) error
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,102 @@
+package repo_refunds
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/storage/storecmn"
+	"github.com/dv-net/dv-merchant/pkg/dbutils"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/huandu/go-sqlbuilder"
+)
+
+type GetByParamsParams struct {
+	storecmn.CommonFindParams
+	StoreID       *uuid.UUID
+	TransactionID *uuid.UUID
+	Statuses      []models.RefundStatus
+	Reasons       []models.RefundReason
+}
+
+const maxLimit = 1000
+
+func (s *CustomQuerier) GetByParams(ctx context.Context, params GetByParamsParams) (*storecmn.FindResponseWithFullPagination[*models.Refund], error) {
+	sb := sqlbuilder.PostgreSQL.NewSelectBuilder()
+	sb.Select("refunds.*").From("refunds")
+
+	countSb := sqlbuilder.PostgreSQL.NewSelectBuilder()
+	countSb.Select("COUNT(refunds.id)").From("refunds")
+
+	if params.StoreID != nil {
+		sb.Where(sb.Equal("refunds.store_id", params.StoreID.String()))
+		countSb.Where(countSb.Equal("refunds.store_id", params.StoreID.String()))
+	}
+
+	if params.TransactionID != nil {
+		sb.Where(sb.Equal("refunds.transaction_id", params.TransactionID.String()))
+		countSb.Where(countSb.Equal("refunds.transaction_id", params.TransactionID.String()))
+	}
+
+	if len(params.Statuses) > 0 {
+		statuses := make([]interface{}, 0, len(params.Statuses))
+		for _, status := range params.Statuses {
+			statuses = append(statuses, status.String())
+		}
+		sb.Where(sb.In("refunds.status", statuses...))
+		countSb.Where(countSb.In("refunds.status", statuses...))
+	}
+
+	if len(params.Reasons) > 0 {
+		reasons := make([]interface{}, 0, len(params.Reasons))
+		for _, reason := range params.Reasons {
+			reasons = append(reasons, reason.String())
+		}
+		sb.Where(sb.In("refunds.reason", reasons...))
+		countSb.Where(countSb.In("refunds.reason", reasons...))
+	}
+
+	limit, offset, err := dbutils.Pagination(params.Page, params.PageSize, dbutils.WithMaxLimit(maxLimit))
+	if err != nil {
+		return nil, err
+	}
+
+	sb.OrderBy("refunds.created_at").Desc()
+	sb.Limit(int(limit))
+	sb.Offset(int(offset))
+
+	items := make([]*models.Refund, 0)
+	sql, args := sb.Build()
+	if err := pgxscan.Select(ctx, s.psql, &items, sql, args...); err != nil {
+		return nil, fmt.Errorf("select refunds: %w", err)
+	}
+
+	var totalCnt uint64
+	pagingSQL, args := countSb.Build()
+	if err := pgxscan.Get(ctx, s.psql, &totalCnt, pagingSQL, args...); err != nil {
+		return nil, fmt.Errorf("select paging query: %w", err)
+	}
+
+	var page uint64 = 1
+	if params.Page != nil {
+		page = uint64(*params.Page)
+	}
+
+	var pagesCnt uint64 = 1
+	if params.PageSize != nil {
+		pagesCnt = uint64(math.Ceil(float64(totalCnt) / float64(*params.PageSize)))
+	}
+
+	return &storecmn.FindResponseWithFullPagination[*models.Refund]{
+		Items: items,
+		Pagination: storecmn.FullPagingData{
+			Total:    totalCnt,
+			PageSize: uint64(limit),
+			Page:     page,
+			LastPage: pagesCnt,
+		},
+	}, nil
+}
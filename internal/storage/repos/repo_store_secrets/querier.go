@@ -1,6 +1,6 @@
 // Code generated by sqlc. DO NOT EDIT.
 // versions:
-//   sqlc v1.30.0
+//   sqlc v1.31.1
 
 package repo_store_secrets
 
@@ -14,7 +14,11 @@ import (
 type Querier interface {
 	Create(ctx context.Context, arg CreateParams) (*models.StoreSecret, error)
 	DeleteBuStoreID(ctx context.Context, storeID uuid.UUID) error
+	GetByStoreID(ctx context.Context, storeID uuid.UUID) (*models.StoreSecret, error)
 	GetSecretByStoreID(ctx context.Context, storeID uuid.UUID) (string, error)
+	RevokePrevious(ctx context.Context, storeID uuid.UUID) (*models.StoreSecret, error)
+	// keeps replaced secret active until the rotation window ends
+	Rotate(ctx context.Context, arg RotateParams) (*models.StoreSecret, error)
 	UpdateSecret(ctx context.Context, arg UpdateSecretParams) (*models.StoreSecret, error)
 }
 
@@ -1,6 +1,6 @@
 // Code generated by sqlc. DO NOT EDIT.
 // versions:
-//   sqlc v1.30.0
+//   sqlc v1.31.1
 // source: store_secrets.sql
 
 package repo_store_secrets
@@ -10,12 +10,13 @@ import (
 
 	"github.com/dv-net/dv-merchant/internal/models"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const create = `-- name: Create :one
 INSERT INTO store_secrets (store_id, secret)
 VALUES ($1, $2) ON CONFLICT (store_id) DO UPDATE SET secret = $2, updated_at = now()
-RETURNING id, store_id, secret, created_at, updated_at
+RETURNING id, store_id, secret, created_at, updated_at, previous_secret, previous_expires_at
 `
 
 type CreateParams struct {
@@ -32,6 +33,8 @@ func (q *Queries) Create(ctx context.Context, arg CreateParams) (*models.StoreSe
 		&i.Secret,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PreviousSecret,
+		&i.PreviousExpiresAt,
 	)
 	return &i, err
 }
@@ -45,6 +48,28 @@ func (q *Queries) DeleteBuStoreID(ctx context.Context, storeID uuid.UUID) error
 	return err
 }
 
+const getByStoreID = `-- name: GetByStoreID :one
+SELECT id, store_id, secret, created_at, updated_at, previous_secret, previous_expires_at
+FROM store_secrets
+WHERE store_id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetByStoreID(ctx context.Context, storeID uuid.UUID) (*models.StoreSecret, error) {
+	row := q.db.QueryRow(ctx, getByStoreID, storeID)
+	var i models.StoreSecret
+	err := row.Scan(
+		&i.ID,
+		&i.StoreID,
+		&i.Secret,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.PreviousSecret,
+		&i.PreviousExpiresAt,
+	)
+	return &i, err
+}
+
 const getSecretByStoreID = `-- name: GetSecretByStoreID :one
 SELECT secret FROM store_secrets WHERE store_id = $1 LIMIT 1
 `
@@ -56,11 +81,67 @@ func (q *Queries) GetSecretByStoreID(ctx context.Context, storeID uuid.UUID) (st
 	return secret, err
 }
 
+const revokePrevious = `-- name: RevokePrevious :one
+UPDATE store_secrets
+SET previous_secret     = NULL,
+    previous_expires_at = NULL,
+    updated_at          = now()
+WHERE store_id = $1
+RETURNING id, store_id, secret, created_at, updated_at, previous_secret, previous_expires_at
+`
+
+func (q *Queries) RevokePrevious(ctx context.Context, storeID uuid.UUID) (*models.StoreSecret, error) {
+	row := q.db.QueryRow(ctx, revokePrevious, storeID)
+	var i models.StoreSecret
+	err := row.Scan(
+		&i.ID,
+		&i.StoreID,
+		&i.Secret,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.PreviousSecret,
+		&i.PreviousExpiresAt,
+	)
+	return &i, err
+}
+
+const rotate = `-- name: Rotate :one
+UPDATE store_secrets
+SET previous_secret     = secret,
+    previous_expires_at = $1,
+    secret              = $2,
+    updated_at          = now()
+WHERE store_id = $3
+RETURNING id, store_id, secret, created_at, updated_at, previous_secret, previous_expires_at
+`
+
+type RotateParams struct {
+	PreviousExpiresAt pgtype.Timestamp `db:"previous_expires_at" json:"previous_expires_at"`
+	Secret            string           `db:"secret" json:"secret"`
+	StoreID           uuid.UUID        `db:"store_id" json:"store_id"`
+}
+
+// keeps replaced secret active until the rotation window ends
+func (q *Queries) Rotate(ctx context.Context, arg RotateParams) (*models.StoreSecret, error) {
+	row := q.db.QueryRow(ctx, rotate, arg.PreviousExpiresAt, arg.Secret, arg.StoreID)
+	var i models.StoreSecret
+	err := row.Scan(
+		&i.ID,
+		&i.StoreID,
+		&i.Secret,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.PreviousSecret,
+		&i.PreviousExpiresAt,
+	)
+	return &i, err
+}
+
 const updateSecret = `-- name: UpdateSecret :one
 UPDATE store_secrets
 SET secret=$1, updated_at=now()
 WHERE store_id=$2
-    RETURNING id, store_id, secret, created_at, updated_at
+    RETURNING id, store_id, secret, created_at, updated_at, previous_secret, previous_expires_at
 `
 
 type UpdateSecretParams struct {
@@ -77,6 +158,8 @@ func (q *Queries) UpdateSecret(ctx context.Context, arg UpdateSecretParams) (*mo
 		&i.Secret,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.PreviousSecret,
+		&i.PreviousExpiresAt,
 	)
 	return &i, err
 }
@@ -32,7 +32,7 @@ func (q *Queries) EnableAllByStore(ctx context.Context, storeID uuid.UUID) error
 }
 
 const getByStoreAndType = `-- name: GetByStoreAndType :many
-SELECT sw.id, sw.store_id, sw.url, sw.enabled, sw.events, sw.created_at, sw.updated_at, ss.secret
+SELECT sw.id, sw.store_id, sw.url, sw.enabled, sw.events, sw.created_at, sw.updated_at, sw.success_mode, sw.success_status_codes, sw.success_json_path, sw.success_json_value, sw.timeout_seconds, sw.schema_version, ss.secret
 FROM store_webhooks sw
 LEFT JOIN store_secrets ss on ss.store_id = $1
 WHERE sw.store_id = $1
@@ -68,6 +68,12 @@ func (q *Queries) GetByStoreAndType(ctx context.Context, arg GetByStoreAndTypePa
 			&i.StoreWebhook.Events,
 			&i.StoreWebhook.CreatedAt,
 			&i.StoreWebhook.UpdatedAt,
+			&i.StoreWebhook.SuccessMode,
+			&i.StoreWebhook.SuccessStatusCodes,
+			&i.StoreWebhook.SuccessJsonPath,
+			&i.StoreWebhook.SuccessJsonValue,
+			&i.StoreWebhook.TimeoutSeconds,
+			&i.StoreWebhook.SchemaVersion,
 			&i.Secret,
 		); err != nil {
 			return nil, err
@@ -81,7 +87,7 @@ func (q *Queries) GetByStoreAndType(ctx context.Context, arg GetByStoreAndTypePa
 }
 
 const getByStoreId = `-- name: GetByStoreId :many
-SELECT id, store_id, url, enabled, events, created_at, updated_at FROM store_webhooks WHERE store_id=$1 ORDER BY created_at
+SELECT id, store_id, url, enabled, events, created_at, updated_at, success_mode, success_status_codes, success_json_path, success_json_value, timeout_seconds, schema_version FROM store_webhooks WHERE store_id=$1 ORDER BY created_at
 `
 
 func (q *Queries) GetByStoreId(ctx context.Context, storeID uuid.UUID) ([]*models.StoreWebhook, error) {
@@ -101,6 +107,12 @@ func (q *Queries) GetByStoreId(ctx context.Context, storeID uuid.UUID) ([]*model
 			&i.Events,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SuccessMode,
+			&i.SuccessStatusCodes,
+			&i.SuccessJsonPath,
+			&i.SuccessJsonValue,
+			&i.TimeoutSeconds,
+			&i.SchemaVersion,
 		); err != nil {
 			return nil, err
 		}
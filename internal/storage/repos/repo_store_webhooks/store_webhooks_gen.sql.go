@@ -14,16 +14,22 @@ import (
 )
 
 const create = `-- name: Create :one
-INSERT INTO store_webhooks (store_id, url, enabled, events, created_at)
-	VALUES ($1, $2, $3, $4, now())
-	RETURNING id, store_id, url, enabled, events, created_at, updated_at
+INSERT INTO store_webhooks (store_id, url, enabled, events, success_mode, success_status_codes, success_json_path, success_json_value, timeout_seconds, schema_version, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, now())
+	RETURNING id, store_id, url, enabled, events, created_at, updated_at, success_mode, success_status_codes, success_json_path, success_json_value, timeout_seconds, schema_version
 `
 
 type CreateParams struct {
-	StoreID uuid.UUID              `db:"store_id" json:"store_id"`
-	Url     string                 `db:"url" json:"url"`
-	Enabled bool                   `db:"enabled" json:"enabled"`
-	Events  []*models.WebhookEvent `db:"events" json:"events"`
+	StoreID            uuid.UUID                 `db:"store_id" json:"store_id"`
+	Url                string                    `db:"url" json:"url"`
+	Enabled            bool                      `db:"enabled" json:"enabled"`
+	Events             []*models.WebhookEvent    `db:"events" json:"events"`
+	SuccessMode        models.WebhookSuccessMode `db:"success_mode" json:"success_mode"`
+	SuccessStatusCodes []int32                   `db:"success_status_codes" json:"success_status_codes"`
+	SuccessJsonPath    string                    `db:"success_json_path" json:"success_json_path"`
+	SuccessJsonValue   string                    `db:"success_json_value" json:"success_json_value"`
+	TimeoutSeconds     int32                     `db:"timeout_seconds" json:"timeout_seconds"`
+	SchemaVersion      int32                     `db:"schema_version" json:"schema_version"`
 }
 
 func (q *Queries) Create(ctx context.Context, arg CreateParams) (*models.StoreWebhook, error) {
@@ -32,6 +38,12 @@ func (q *Queries) Create(ctx context.Context, arg CreateParams) (*models.StoreWe
 		arg.Url,
 		arg.Enabled,
 		arg.Events,
+		arg.SuccessMode,
+		arg.SuccessStatusCodes,
+		arg.SuccessJsonPath,
+		arg.SuccessJsonValue,
+		arg.TimeoutSeconds,
+		arg.SchemaVersion,
 	)
 	var i models.StoreWebhook
 	err := row.Scan(
@@ -42,6 +54,12 @@ func (q *Queries) Create(ctx context.Context, arg CreateParams) (*models.StoreWe
 		&i.Events,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SuccessMode,
+		&i.SuccessStatusCodes,
+		&i.SuccessJsonPath,
+		&i.SuccessJsonValue,
+		&i.TimeoutSeconds,
+		&i.SchemaVersion,
 	)
 	return &i, err
 }
@@ -56,7 +74,7 @@ func (q *Queries) Delete(ctx context.Context, id uuid.UUID) error {
 }
 
 const getById = `-- name: GetById :one
-SELECT id, store_id, url, enabled, events, created_at, updated_at FROM store_webhooks WHERE id=$1 LIMIT 1
+SELECT id, store_id, url, enabled, events, created_at, updated_at, success_mode, success_status_codes, success_json_path, success_json_value, timeout_seconds, schema_version FROM store_webhooks WHERE id=$1 LIMIT 1
 `
 
 func (q *Queries) GetById(ctx context.Context, id uuid.UUID) (*models.StoreWebhook, error) {
@@ -70,23 +88,35 @@ func (q *Queries) GetById(ctx context.Context, id uuid.UUID) (*models.StoreWebho
 		&i.Events,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SuccessMode,
+		&i.SuccessStatusCodes,
+		&i.SuccessJsonPath,
+		&i.SuccessJsonValue,
+		&i.TimeoutSeconds,
+		&i.SchemaVersion,
 	)
 	return &i, err
 }
 
 const update = `-- name: Update :one
 UPDATE store_webhooks
-	SET url=$1, enabled=$2, events=$3, updated_at=$4
-WHERE id=$5
-	RETURNING id, store_id, url, enabled, events, created_at, updated_at
+	SET url=$1, enabled=$2, events=$3, updated_at=$4, success_mode=$5, success_status_codes=$6, success_json_path=$7, success_json_value=$8, timeout_seconds=$9, schema_version=$10
+WHERE id=$11
+	RETURNING id, store_id, url, enabled, events, created_at, updated_at, success_mode, success_status_codes, success_json_path, success_json_value, timeout_seconds, schema_version
 `
 
 type UpdateParams struct {
-	Url       string                 `db:"url" json:"url"`
-	Enabled   bool                   `db:"enabled" json:"enabled"`
-	Events    []*models.WebhookEvent `db:"events" json:"events"`
-	UpdatedAt pgtype.Timestamp       `db:"updated_at" json:"updated_at"`
-	ID        uuid.UUID              `db:"id" json:"id"`
+	Url                string                    `db:"url" json:"url"`
+	Enabled            bool                      `db:"enabled" json:"enabled"`
+	Events             []*models.WebhookEvent    `db:"events" json:"events"`
+	UpdatedAt          pgtype.Timestamp          `db:"updated_at" json:"updated_at"`
+	SuccessMode        models.WebhookSuccessMode `db:"success_mode" json:"success_mode"`
+	SuccessStatusCodes []int32                   `db:"success_status_codes" json:"success_status_codes"`
+	SuccessJsonPath    string                    `db:"success_json_path" json:"success_json_path"`
+	SuccessJsonValue   string                    `db:"success_json_value" json:"success_json_value"`
+	TimeoutSeconds     int32                     `db:"timeout_seconds" json:"timeout_seconds"`
+	SchemaVersion      int32                     `db:"schema_version" json:"schema_version"`
+	ID                 uuid.UUID                 `db:"id" json:"id"`
 }
 
 func (q *Queries) Update(ctx context.Context, arg UpdateParams) (*models.StoreWebhook, error) {
@@ -95,6 +125,12 @@ func (q *Queries) Update(ctx context.Context, arg UpdateParams) (*models.StoreWe
 		arg.Enabled,
 		arg.Events,
 		arg.UpdatedAt,
+		arg.SuccessMode,
+		arg.SuccessStatusCodes,
+		arg.SuccessJsonPath,
+		arg.SuccessJsonValue,
+		arg.TimeoutSeconds,
+		arg.SchemaVersion,
 		arg.ID,
 	)
 	var i models.StoreWebhook
@@ -106,6 +142,12 @@ func (q *Queries) Update(ctx context.Context, arg UpdateParams) (*models.StoreWe
 		&i.Events,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SuccessMode,
+		&i.SuccessStatusCodes,
+		&i.SuccessJsonPath,
+		&i.SuccessJsonValue,
+		&i.TimeoutSeconds,
+		&i.SchemaVersion,
 	)
 	return &i, err
 }
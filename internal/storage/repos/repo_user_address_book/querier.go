@@ -16,15 +16,20 @@ type Querier interface {
 	CheckExistsWithTrashed(ctx context.Context, arg CheckExistsWithTrashedParams) (bool, error)
 	Create(ctx context.Context, arg CreateParams) (*models.UserAddressBook, error)
 	Delete(ctx context.Context, id uuid.UUID) error
+	GetActiveAddressesByCurrency(ctx context.Context, arg GetActiveAddressesByCurrencyParams) ([]string, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*models.UserAddressBook, error)
+	GetByRevokeToken(ctx context.Context, revokeToken uuid.NullUUID) ([]*models.UserAddressBook, error)
 	GetByUserAddressAndBlockchain(ctx context.Context, arg GetByUserAddressAndBlockchainParams) ([]*models.UserAddressBook, error)
 	GetByUserAndAddress(ctx context.Context, arg GetByUserAndAddressParams) (*models.UserAddressBook, error)
 	GetByUserAndAddressAllCurrencies(ctx context.Context, arg GetByUserAndAddressAllCurrenciesParams) ([]*models.UserAddressBook, error)
 	GetByUserAndBlockchain(ctx context.Context, arg GetByUserAndBlockchainParams) ([]*models.UserAddressBook, error)
 	GetByUserAndCurrency(ctx context.Context, arg GetByUserAndCurrencyParams) ([]*models.UserAddressBook, error)
 	GetByUserID(ctx context.Context, userID uuid.UUID) ([]*models.UserAddressBook, error)
+	GetDestination(ctx context.Context, arg GetDestinationParams) (GetDestinationRow, error)
 	GetTrashedEntry(ctx context.Context, arg GetTrashedEntryParams) (*models.UserAddressBook, error)
 	RestoreFromTrash(ctx context.Context, arg RestoreFromTrashParams) (*models.UserAddressBook, error)
+	// revoke token is cleared so the link can be used once
+	RevokeByToken(ctx context.Context, revokeToken uuid.NullUUID) ([]*models.UserAddressBook, error)
 	SoftDelete(ctx context.Context, id uuid.UUID) error
 	Update(ctx context.Context, arg UpdateParams) (*models.UserAddressBook, error)
 }
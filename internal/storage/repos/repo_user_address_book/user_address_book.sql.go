@@ -60,19 +60,26 @@ func (q *Queries) CheckExistsWithTrashed(ctx context.Context, arg CheckExistsWit
 }
 
 const create = `-- name: Create :one
-INSERT INTO user_address_book (user_id, address, currency_id, name, tag, blockchain, type, submitted_at, created_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
-RETURNING id, user_id, address, currency_id, name, tag, blockchain, type, submitted_at, created_at, updated_at, deleted_at
+INSERT INTO user_address_book (user_id, address, currency_id, name, tag, blockchain, type, active_at, revoke_token, revoke_token_expires_at, submitted_at, created_at)
+VALUES (
+    $1, $2, $3, $4, $5, $6, $7,
+    CURRENT_TIMESTAMP + make_interval(secs => $8::int), $9,
+    CURRENT_TIMESTAMP + make_interval(secs => $10::int), CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+)
+RETURNING id, user_id, address, currency_id, name, tag, blockchain, type, submitted_at, created_at, updated_at, deleted_at, active_at, revoke_token, revoke_token_expires_at
 `
 
 type CreateParams struct {
-	UserID     uuid.UUID              `db:"user_id" json:"user_id"`
-	Address    string                 `db:"address" json:"address"`
-	CurrencyID string                 `db:"currency_id" json:"currency_id"`
-	Name       pgtype.Text            `db:"name" json:"name"`
-	Tag        pgtype.Text            `db:"tag" json:"tag"`
-	Blockchain *models.Blockchain     `db:"blockchain" json:"blockchain"`
-	Type       models.AddressBookType `db:"type" json:"type"`
+	UserID           uuid.UUID              `db:"user_id" json:"user_id"`
+	Address          string                 `db:"address" json:"address"`
+	CurrencyID       string                 `db:"currency_id" json:"currency_id"`
+	Name             pgtype.Text            `db:"name" json:"name"`
+	Tag              pgtype.Text            `db:"tag" json:"tag"`
+	Blockchain       *models.Blockchain     `db:"blockchain" json:"blockchain"`
+	Type             models.AddressBookType `db:"type" json:"type"`
+	CoolingSeconds   int32                  `db:"cooling_seconds" json:"cooling_seconds"`
+	RevokeToken      uuid.NullUUID          `db:"revoke_token" json:"revoke_token"`
+	RevokeTtlSeconds int32                  `db:"revoke_ttl_seconds" json:"revoke_ttl_seconds"`
 }
 
 func (q *Queries) Create(ctx context.Context, arg CreateParams) (*models.UserAddressBook, error) {
@@ -84,6 +91,9 @@ func (q *Queries) Create(ctx context.Context, arg CreateParams) (*models.UserAdd
 		arg.Tag,
 		arg.Blockchain,
 		arg.Type,
+		arg.CoolingSeconds,
+		arg.RevokeToken,
+		arg.RevokeTtlSeconds,
 	)
 	var i models.UserAddressBook
 	err := row.Scan(
@@ -99,6 +109,9 @@ func (q *Queries) Create(ctx context.Context, arg CreateParams) (*models.UserAdd
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.ActiveAt,
+		&i.RevokeToken,
+		&i.RevokeTokenExpiresAt,
 	)
 	return &i, err
 }
@@ -113,8 +126,38 @@ func (q *Queries) Delete(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
+const getActiveAddressesByCurrency = `-- name: GetActiveAddressesByCurrency :many
+SELECT DISTINCT address FROM user_address_book
+WHERE user_id = $1 AND currency_id = $2 AND deleted_at IS NULL AND active_at <= CURRENT_TIMESTAMP
+`
+
+type GetActiveAddressesByCurrencyParams struct {
+	UserID     uuid.UUID `db:"user_id" json:"user_id"`
+	CurrencyID string    `db:"currency_id" json:"currency_id"`
+}
+
+func (q *Queries) GetActiveAddressesByCurrency(ctx context.Context, arg GetActiveAddressesByCurrencyParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, getActiveAddressesByCurrency, arg.UserID, arg.CurrencyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var address string
+		if err := rows.Scan(&address); err != nil {
+			return nil, err
+		}
+		items = append(items, address)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getByID = `-- name: GetByID :one
-SELECT id, user_id, address, currency_id, name, tag, blockchain, type, submitted_at, created_at, updated_at, deleted_at FROM user_address_book 
+SELECT id, user_id, address, currency_id, name, tag, blockchain, type, submitted_at, created_at, updated_at, deleted_at, active_at, revoke_token, revoke_token_expires_at FROM user_address_book 
 WHERE id = $1 AND deleted_at IS NULL
 `
 
@@ -134,12 +177,56 @@ func (q *Queries) GetByID(ctx context.Context, id uuid.UUID) (*models.UserAddres
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.ActiveAt,
+		&i.RevokeToken,
+		&i.RevokeTokenExpiresAt,
 	)
 	return &i, err
 }
 
+const getByRevokeToken = `-- name: GetByRevokeToken :many
+SELECT id, user_id, address, currency_id, name, tag, blockchain, type, submitted_at, created_at, updated_at, deleted_at, active_at, revoke_token, revoke_token_expires_at FROM user_address_book
+WHERE revoke_token = $1 AND deleted_at IS NULL AND revoke_token_expires_at > CURRENT_TIMESTAMP
+`
+
+func (q *Queries) GetByRevokeToken(ctx context.Context, revokeToken uuid.NullUUID) ([]*models.UserAddressBook, error) {
+	rows, err := q.db.Query(ctx, getByRevokeToken, revokeToken)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*models.UserAddressBook{}
+	for rows.Next() {
+		var i models.UserAddressBook
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Address,
+			&i.CurrencyID,
+			&i.Name,
+			&i.Tag,
+			&i.Blockchain,
+			&i.Type,
+			&i.SubmittedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.ActiveAt,
+			&i.RevokeToken,
+			&i.RevokeTokenExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getByUserAddressAndBlockchain = `-- name: GetByUserAddressAndBlockchain :many
-SELECT id, user_id, address, currency_id, name, tag, blockchain, type, submitted_at, created_at, updated_at, deleted_at FROM user_address_book 
+SELECT id, user_id, address, currency_id, name, tag, blockchain, type, submitted_at, created_at, updated_at, deleted_at, active_at, revoke_token, revoke_token_expires_at FROM user_address_book 
 WHERE user_id = $1 AND address = $2 AND blockchain = $3 AND type = $4 AND deleted_at IS NULL
 `
 
@@ -177,6 +264,9 @@ func (q *Queries) GetByUserAddressAndBlockchain(ctx context.Context, arg GetByUs
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.ActiveAt,
+			&i.RevokeToken,
+			&i.RevokeTokenExpiresAt,
 		); err != nil {
 			return nil, err
 		}
@@ -189,7 +279,7 @@ func (q *Queries) GetByUserAddressAndBlockchain(ctx context.Context, arg GetByUs
 }
 
 const getByUserAndAddress = `-- name: GetByUserAndAddress :one
-SELECT id, user_id, address, currency_id, name, tag, blockchain, type, submitted_at, created_at, updated_at, deleted_at FROM user_address_book 
+SELECT id, user_id, address, currency_id, name, tag, blockchain, type, submitted_at, created_at, updated_at, deleted_at, active_at, revoke_token, revoke_token_expires_at FROM user_address_book 
 WHERE user_id = $1 AND address = $2 AND currency_id = $3 AND type = $4
 `
 
@@ -221,12 +311,15 @@ func (q *Queries) GetByUserAndAddress(ctx context.Context, arg GetByUserAndAddre
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.ActiveAt,
+		&i.RevokeToken,
+		&i.RevokeTokenExpiresAt,
 	)
 	return &i, err
 }
 
 const getByUserAndAddressAllCurrencies = `-- name: GetByUserAndAddressAllCurrencies :many
-SELECT id, user_id, address, currency_id, name, tag, blockchain, type, submitted_at, created_at, updated_at, deleted_at FROM user_address_book 
+SELECT id, user_id, address, currency_id, name, tag, blockchain, type, submitted_at, created_at, updated_at, deleted_at, active_at, revoke_token, revoke_token_expires_at FROM user_address_book 
 WHERE user_id = $1 AND address = $2 AND type = $3 AND deleted_at IS NULL
 `
 
@@ -258,6 +351,9 @@ func (q *Queries) GetByUserAndAddressAllCurrencies(ctx context.Context, arg GetB
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.ActiveAt,
+			&i.RevokeToken,
+			&i.RevokeTokenExpiresAt,
 		); err != nil {
 			return nil, err
 		}
@@ -270,7 +366,7 @@ func (q *Queries) GetByUserAndAddressAllCurrencies(ctx context.Context, arg GetB
 }
 
 const getByUserAndBlockchain = `-- name: GetByUserAndBlockchain :many
-SELECT id, user_id, address, currency_id, name, tag, blockchain, type, submitted_at, created_at, updated_at, deleted_at FROM user_address_book 
+SELECT id, user_id, address, currency_id, name, tag, blockchain, type, submitted_at, created_at, updated_at, deleted_at, active_at, revoke_token, revoke_token_expires_at FROM user_address_book 
 WHERE user_id = $1 AND blockchain = $2 AND deleted_at IS NULL
 ORDER BY submitted_at DESC
 `
@@ -302,6 +398,9 @@ func (q *Queries) GetByUserAndBlockchain(ctx context.Context, arg GetByUserAndBl
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.ActiveAt,
+			&i.RevokeToken,
+			&i.RevokeTokenExpiresAt,
 		); err != nil {
 			return nil, err
 		}
@@ -314,7 +413,7 @@ func (q *Queries) GetByUserAndBlockchain(ctx context.Context, arg GetByUserAndBl
 }
 
 const getByUserAndCurrency = `-- name: GetByUserAndCurrency :many
-SELECT id, user_id, address, currency_id, name, tag, blockchain, type, submitted_at, created_at, updated_at, deleted_at FROM user_address_book 
+SELECT id, user_id, address, currency_id, name, tag, blockchain, type, submitted_at, created_at, updated_at, deleted_at, active_at, revoke_token, revoke_token_expires_at FROM user_address_book 
 WHERE user_id = $1 AND currency_id = $2 AND deleted_at IS NULL
 ORDER BY submitted_at DESC
 `
@@ -346,6 +445,9 @@ func (q *Queries) GetByUserAndCurrency(ctx context.Context, arg GetByUserAndCurr
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.ActiveAt,
+			&i.RevokeToken,
+			&i.RevokeTokenExpiresAt,
 		); err != nil {
 			return nil, err
 		}
@@ -358,7 +460,7 @@ func (q *Queries) GetByUserAndCurrency(ctx context.Context, arg GetByUserAndCurr
 }
 
 const getByUserID = `-- name: GetByUserID :many
-SELECT id, user_id, address, currency_id, name, tag, blockchain, type, submitted_at, created_at, updated_at, deleted_at FROM user_address_book 
+SELECT id, user_id, address, currency_id, name, tag, blockchain, type, submitted_at, created_at, updated_at, deleted_at, active_at, revoke_token, revoke_token_expires_at FROM user_address_book 
 WHERE user_id = $1 AND deleted_at IS NULL
 ORDER BY submitted_at DESC
 `
@@ -385,6 +487,9 @@ func (q *Queries) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*models.
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.ActiveAt,
+			&i.RevokeToken,
+			&i.RevokeTokenExpiresAt,
 		); err != nil {
 			return nil, err
 		}
@@ -396,8 +501,33 @@ func (q *Queries) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*models.
 	return items, nil
 }
 
+const getDestination = `-- name: GetDestination :one
+SELECT active_at, active_at <= CURRENT_TIMESTAMP AS active FROM user_address_book
+WHERE user_id = $1 AND currency_id = $2 AND address = $3 AND deleted_at IS NULL
+ORDER BY active_at
+LIMIT 1
+`
+
+type GetDestinationParams struct {
+	UserID     uuid.UUID `db:"user_id" json:"user_id"`
+	CurrencyID string    `db:"currency_id" json:"currency_id"`
+	Address    string    `db:"address" json:"address"`
+}
+
+type GetDestinationRow struct {
+	ActiveAt pgtype.Timestamp `db:"active_at" json:"active_at"`
+	Active   bool             `db:"active" json:"active"`
+}
+
+func (q *Queries) GetDestination(ctx context.Context, arg GetDestinationParams) (GetDestinationRow, error) {
+	row := q.db.QueryRow(ctx, getDestination, arg.UserID, arg.CurrencyID, arg.Address)
+	var i GetDestinationRow
+	err := row.Scan(&i.ActiveAt, &i.Active)
+	return i, err
+}
+
 const getTrashedEntry = `-- name: GetTrashedEntry :one
-SELECT id, user_id, address, currency_id, name, tag, blockchain, type, submitted_at, created_at, updated_at, deleted_at FROM user_address_book 
+SELECT id, user_id, address, currency_id, name, tag, blockchain, type, submitted_at, created_at, updated_at, deleted_at, active_at, revoke_token, revoke_token_expires_at FROM user_address_book 
 WHERE user_id = $1 AND address = $2 AND currency_id = $3 AND deleted_at IS NOT NULL
 `
 
@@ -423,34 +553,46 @@ func (q *Queries) GetTrashedEntry(ctx context.Context, arg GetTrashedEntryParams
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.ActiveAt,
+		&i.RevokeToken,
+		&i.RevokeTokenExpiresAt,
 	)
 	return &i, err
 }
 
 const restoreFromTrash = `-- name: RestoreFromTrash :one
 UPDATE user_address_book 
-SET deleted_at = NULL, name = $5, tag = $6, updated_at = CURRENT_TIMESTAMP
-WHERE user_id = $1 AND address = $2 AND currency_id = $3 AND type = $4 AND deleted_at IS NOT NULL
-RETURNING id, user_id, address, currency_id, name, tag, blockchain, type, submitted_at, created_at, updated_at, deleted_at
+SET deleted_at = NULL, name = $1, tag = $2,
+    active_at = CURRENT_TIMESTAMP + make_interval(secs => $3::int), revoke_token = $4,
+    revoke_token_expires_at = CURRENT_TIMESTAMP + make_interval(secs => $5::int),
+    updated_at = CURRENT_TIMESTAMP
+WHERE user_id = $6 AND address = $7 AND currency_id = $8 AND type = $9 AND deleted_at IS NOT NULL
+RETURNING id, user_id, address, currency_id, name, tag, blockchain, type, submitted_at, created_at, updated_at, deleted_at, active_at, revoke_token, revoke_token_expires_at
 `
 
 type RestoreFromTrashParams struct {
-	UserID     uuid.UUID              `db:"user_id" json:"user_id"`
-	Address    string                 `db:"address" json:"address"`
-	CurrencyID string                 `db:"currency_id" json:"currency_id"`
-	Type       models.AddressBookType `db:"type" json:"type"`
-	Name       pgtype.Text            `db:"name" json:"name"`
-	Tag        pgtype.Text            `db:"tag" json:"tag"`
+	Name             pgtype.Text            `db:"name" json:"name"`
+	Tag              pgtype.Text            `db:"tag" json:"tag"`
+	CoolingSeconds   int32                  `db:"cooling_seconds" json:"cooling_seconds"`
+	RevokeToken      uuid.NullUUID          `db:"revoke_token" json:"revoke_token"`
+	RevokeTtlSeconds int32                  `db:"revoke_ttl_seconds" json:"revoke_ttl_seconds"`
+	UserID           uuid.UUID              `db:"user_id" json:"user_id"`
+	Address          string                 `db:"address" json:"address"`
+	CurrencyID       string                 `db:"currency_id" json:"currency_id"`
+	Type             models.AddressBookType `db:"type" json:"type"`
 }
 
 func (q *Queries) RestoreFromTrash(ctx context.Context, arg RestoreFromTrashParams) (*models.UserAddressBook, error) {
 	row := q.db.QueryRow(ctx, restoreFromTrash,
+		arg.Name,
+		arg.Tag,
+		arg.CoolingSeconds,
+		arg.RevokeToken,
+		arg.RevokeTtlSeconds,
 		arg.UserID,
 		arg.Address,
 		arg.CurrencyID,
 		arg.Type,
-		arg.Name,
-		arg.Tag,
 	)
 	var i models.UserAddressBook
 	err := row.Scan(
@@ -466,10 +608,58 @@ func (q *Queries) RestoreFromTrash(ctx context.Context, arg RestoreFromTrashPara
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.ActiveAt,
+		&i.RevokeToken,
+		&i.RevokeTokenExpiresAt,
 	)
 	return &i, err
 }
 
+const revokeByToken = `-- name: RevokeByToken :many
+UPDATE user_address_book
+SET deleted_at = CURRENT_TIMESTAMP, revoke_token = NULL, revoke_token_expires_at = NULL, updated_at = CURRENT_TIMESTAMP
+WHERE revoke_token = $1 AND deleted_at IS NULL AND revoke_token_expires_at > CURRENT_TIMESTAMP
+RETURNING id, user_id, address, currency_id, name, tag, blockchain, type, submitted_at, created_at, updated_at, deleted_at, active_at, revoke_token, revoke_token_expires_at
+`
+
+// revoke token is cleared so the link can be used once
+
+func (q *Queries) RevokeByToken(ctx context.Context, revokeToken uuid.NullUUID) ([]*models.UserAddressBook, error) {
+	rows, err := q.db.Query(ctx, revokeByToken, revokeToken)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*models.UserAddressBook{}
+	for rows.Next() {
+		var i models.UserAddressBook
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Address,
+			&i.CurrencyID,
+			&i.Name,
+			&i.Tag,
+			&i.Blockchain,
+			&i.Type,
+			&i.SubmittedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.ActiveAt,
+			&i.RevokeToken,
+			&i.RevokeTokenExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const softDelete = `-- name: SoftDelete :exec
 UPDATE user_address_book 
 SET deleted_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
@@ -485,7 +675,7 @@ const update = `-- name: Update :one
 UPDATE user_address_book 
 SET name = $2, tag = $3, updated_at = CURRENT_TIMESTAMP
 WHERE id = $1 AND deleted_at IS NULL
-RETURNING id, user_id, address, currency_id, name, tag, blockchain, type, submitted_at, created_at, updated_at, deleted_at
+RETURNING id, user_id, address, currency_id, name, tag, blockchain, type, submitted_at, created_at, updated_at, deleted_at, active_at, revoke_token, revoke_token_expires_at
 `
 
 type UpdateParams struct {
@@ -510,6 +700,9 @@ func (q *Queries) Update(ctx context.Context, arg UpdateParams) (*models.UserAdd
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.ActiveAt,
+		&i.RevokeToken,
+		&i.RevokeTokenExpiresAt,
 	)
 	return &i, err
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/dv-net/dv-merchant/internal/models"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
 )
 
 var (
@@ -19,8 +20,8 @@ var (
 )
 
 const updatePairs = `-- name: UpdatePairs :batchexec
-INSERT INTO user_exchange_pairs (exchange_id, user_id, currency_from, currency_to, symbol, type)
-VALUES ($1, $2, $3, $4, $5, $6)
+INSERT INTO user_exchange_pairs (exchange_id, user_id, currency_from, currency_to, symbol, type, order_type, price_offset, time_in_force, twap_slices, twap_window_seconds)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 ON CONFLICT DO NOTHING
 `
 
@@ -31,12 +32,17 @@ type UpdatePairsBatchResults struct {
 }
 
 type UpdatePairsParams struct {
-	ExchangeID   uuid.UUID        `db:"exchange_id" json:"exchange_id"`
-	UserID       uuid.UUID        `db:"user_id" json:"user_id"`
-	CurrencyFrom string           `db:"currency_from" json:"currency_from"`
-	CurrencyTo   string           `db:"currency_to" json:"currency_to"`
-	Symbol       string           `db:"symbol" json:"symbol"`
-	Type         models.OrderSide `db:"type" json:"type"`
+	ExchangeID        uuid.UUID          `db:"exchange_id" json:"exchange_id"`
+	UserID            uuid.UUID          `db:"user_id" json:"user_id"`
+	CurrencyFrom      string             `db:"currency_from" json:"currency_from"`
+	CurrencyTo        string             `db:"currency_to" json:"currency_to"`
+	Symbol            string             `db:"symbol" json:"symbol"`
+	Type              models.OrderSide   `db:"type" json:"type"`
+	OrderType         models.OrderType   `db:"order_type" json:"order_type"`
+	PriceOffset       decimal.Decimal    `db:"price_offset" json:"price_offset"`
+	TimeInForce       models.TimeInForce `db:"time_in_force" json:"time_in_force"`
+	TwapSlices        int32              `db:"twap_slices" json:"twap_slices"`
+	TwapWindowSeconds int32              `db:"twap_window_seconds" json:"twap_window_seconds"`
 }
 
 func (q *Queries) UpdatePairs(ctx context.Context, arg []UpdatePairsParams) *UpdatePairsBatchResults {
@@ -49,6 +55,11 @@ func (q *Queries) UpdatePairs(ctx context.Context, arg []UpdatePairsParams) *Upd
 			a.CurrencyTo,
 			a.Symbol,
 			a.Type,
+			a.OrderType,
+			a.PriceOffset,
+			a.TimeInForce,
+			a.TwapSlices,
+			a.TwapWindowSeconds,
 		}
 		batch.Queue(updatePairs, vals...)
 	}
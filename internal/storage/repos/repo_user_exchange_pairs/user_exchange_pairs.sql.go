@@ -41,7 +41,7 @@ func (q *Queries) DeleteByUserAndExchangeID(ctx context.Context, arg DeleteByUse
 }
 
 const getAll = `-- name: GetAll :many
-SELECT uep.id, uep.exchange_id, uep.user_id, uep.currency_from, uep.currency_to, uep.symbol, uep.type FROM user_exchange_pairs uep
+SELECT uep.id, uep.exchange_id, uep.user_id, uep.currency_from, uep.currency_to, uep.symbol, uep.type, uep.order_type, uep.price_offset, uep.time_in_force, uep.twap_slices, uep.twap_window_seconds FROM user_exchange_pairs uep
 INNER JOIN user_exchanges ue ON ue.user_id = uep.user_id AND ue.exchange_id = uep.exchange_id
 WHERE ue.swap_state = 'enabled'
 `
@@ -63,6 +63,11 @@ func (q *Queries) GetAll(ctx context.Context) ([]*models.UserExchangePair, error
 			&i.CurrencyTo,
 			&i.Symbol,
 			&i.Type,
+			&i.OrderType,
+			&i.PriceOffset,
+			&i.TimeInForce,
+			&i.TwapSlices,
+			&i.TwapWindowSeconds,
 		); err != nil {
 			return nil, err
 		}
@@ -10,21 +10,27 @@ import (
 
 	"github.com/dv-net/dv-merchant/internal/models"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 const create = `-- name: Create :one
-INSERT INTO user_exchange_pairs (exchange_id, user_id, currency_from, currency_to, symbol, type)
-	VALUES ($1, $2, $3, $4, $5, $6)
-	RETURNING id, exchange_id, user_id, currency_from, currency_to, symbol, type
+INSERT INTO user_exchange_pairs (exchange_id, user_id, currency_from, currency_to, symbol, type, order_type, price_offset, time_in_force, twap_slices, twap_window_seconds)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	RETURNING id, exchange_id, user_id, currency_from, currency_to, symbol, type, order_type, price_offset, time_in_force, twap_slices, twap_window_seconds
 `
 
 type CreateParams struct {
-	ExchangeID   uuid.UUID        `db:"exchange_id" json:"exchange_id"`
-	UserID       uuid.UUID        `db:"user_id" json:"user_id"`
-	CurrencyFrom string           `db:"currency_from" json:"currency_from"`
-	CurrencyTo   string           `db:"currency_to" json:"currency_to"`
-	Symbol       string           `db:"symbol" json:"symbol"`
-	Type         models.OrderSide `db:"type" json:"type"`
+	ExchangeID        uuid.UUID          `db:"exchange_id" json:"exchange_id"`
+	UserID            uuid.UUID          `db:"user_id" json:"user_id"`
+	CurrencyFrom      string             `db:"currency_from" json:"currency_from"`
+	CurrencyTo        string             `db:"currency_to" json:"currency_to"`
+	Symbol            string             `db:"symbol" json:"symbol"`
+	Type              models.OrderSide   `db:"type" json:"type"`
+	OrderType         models.OrderType   `db:"order_type" json:"order_type"`
+	PriceOffset       decimal.Decimal    `db:"price_offset" json:"price_offset"`
+	TimeInForce       models.TimeInForce `db:"time_in_force" json:"time_in_force"`
+	TwapSlices        int32              `db:"twap_slices" json:"twap_slices"`
+	TwapWindowSeconds int32              `db:"twap_window_seconds" json:"twap_window_seconds"`
 }
 
 func (q *Queries) Create(ctx context.Context, arg CreateParams) (*models.UserExchangePair, error) {
@@ -35,6 +41,11 @@ func (q *Queries) Create(ctx context.Context, arg CreateParams) (*models.UserExc
 		arg.CurrencyTo,
 		arg.Symbol,
 		arg.Type,
+		arg.OrderType,
+		arg.PriceOffset,
+		arg.TimeInForce,
+		arg.TwapSlices,
+		arg.TwapWindowSeconds,
 	)
 	var i models.UserExchangePair
 	err := row.Scan(
@@ -45,12 +56,17 @@ func (q *Queries) Create(ctx context.Context, arg CreateParams) (*models.UserExc
 		&i.CurrencyTo,
 		&i.Symbol,
 		&i.Type,
+		&i.OrderType,
+		&i.PriceOffset,
+		&i.TimeInForce,
+		&i.TwapSlices,
+		&i.TwapWindowSeconds,
 	)
 	return &i, err
 }
 
 const find = `-- name: Find :many
-SELECT id, exchange_id, user_id, currency_from, currency_to, symbol, type FROM user_exchange_pairs WHERE exchange_id=$1 AND user_id=$2
+SELECT id, exchange_id, user_id, currency_from, currency_to, symbol, type, order_type, price_offset, time_in_force, twap_slices, twap_window_seconds FROM user_exchange_pairs WHERE exchange_id=$1 AND user_id=$2
 `
 
 type FindParams struct {
@@ -75,6 +91,11 @@ func (q *Queries) Find(ctx context.Context, arg FindParams) ([]*models.UserExcha
 			&i.CurrencyTo,
 			&i.Symbol,
 			&i.Type,
+			&i.OrderType,
+			&i.PriceOffset,
+			&i.TimeInForce,
+			&i.TwapSlices,
+			&i.TwapWindowSeconds,
 		); err != nil {
 			return nil, err
 		}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/dv-net/dv-merchant/internal/models"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 type Querier interface {
@@ -22,6 +23,7 @@ type Querier interface {
 	GetHotWalletsTotalBalanceWithDust(ctx context.Context, arg GetHotWalletsTotalBalanceWithDustParams) (*GetHotWalletsTotalBalanceWithDustRow, error)
 	GetListByCurrencyWithAmount(ctx context.Context, arg GetListByCurrencyWithAmountParams) (*GetListByCurrencyWithAmountRow, error)
 	GetPrefetchWalletAddressByUserID(ctx context.Context, arg GetPrefetchWalletAddressByUserIDParams) ([]*GetPrefetchWalletAddressByUserIDRow, error)
+	GetTotalAmountByCurrency(ctx context.Context, userID uuid.UUID, currencyID string) (decimal.Decimal, error)
 	GetWalletAddressesByAddress(ctx context.Context, arg GetWalletAddressesByAddressParams) (*models.WalletAddress, error)
 	GetWalletAddressesByUserID(ctx context.Context, userID uuid.UUID) ([]*models.WalletAddress, error)
 	GetWalletAddressesByWalletId(ctx context.Context, walletID uuid.UUID) ([]*models.WalletAddress, error)
@@ -537,6 +537,28 @@ func (q *Queries) GetPrefetchWalletAddressByUserID(ctx context.Context, arg GetP
 	return items, nil
 }
 
+const getTotalAmountByCurrency = `-- name: GetTotalAmountByCurrency :one
+SELECT COALESCE(SUM(amount), 0)::numeric as total_amount
+FROM wallet_addresses
+WHERE user_id = $1
+  AND currency_id = $2
+  AND deleted_at IS NULL
+  AND address not in (select unnest(from_addresses)
+                      from transfers
+                      where user_id = $1
+                        and kind = 'from_address'
+                        and transfers.currency_id = $2
+                        and (transfers.stage = 'in_progress'
+                          or (transfers.stage = 'completed' and updated_at > (now() - interval '5 minutes'))))
+`
+
+func (q *Queries) GetTotalAmountByCurrency(ctx context.Context, userID uuid.UUID, currencyID string) (decimal.Decimal, error) {
+	row := q.db.QueryRow(ctx, getTotalAmountByCurrency, userID, currencyID)
+	var total_amount decimal.Decimal
+	err := row.Scan(&total_amount)
+	return total_amount, err
+}
+
 const getWalletAddressesByAddress = `-- name: GetWalletAddressesByAddress :one
 SELECT id, wallet_id, user_id, currency_id, blockchain, address, amount, created_at, updated_at, deleted_at, dirty
 FROM wallet_addresses
@@ -0,0 +1,96 @@
+package repo_webhook_dead_letters
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/storage/storecmn"
+	"github.com/dv-net/dv-merchant/pkg/dbutils"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/huandu/go-sqlbuilder"
+)
+
+type GetByParamsParams struct {
+	storecmn.CommonFindParams
+	StoreID       uuid.UUID
+	TransactionID *uuid.UUID
+	Event         *string
+	Requeued      *bool
+}
+
+const maxLimit = 1000
+
+func (s *CustomQuerier) GetByParams(ctx context.Context, params GetByParamsParams) (*storecmn.FindResponseWithFullPagination[*models.WebhookDeadLetter], error) {
+	sb := sqlbuilder.PostgreSQL.NewSelectBuilder()
+	sb.Select("webhook_dead_letters.*").From("webhook_dead_letters")
+	sb.Where(sb.Equal("webhook_dead_letters.store_id", params.StoreID.String()))
+
+	countSb := sqlbuilder.PostgreSQL.NewSelectBuilder()
+	countSb.Select("COUNT(webhook_dead_letters.id)").From("webhook_dead_letters")
+	countSb.Where(countSb.Equal("webhook_dead_letters.store_id", params.StoreID.String()))
+
+	if params.TransactionID != nil {
+		sb.Where(sb.Equal("webhook_dead_letters.transaction_id", params.TransactionID.String()))
+		countSb.Where(countSb.Equal("webhook_dead_letters.transaction_id", params.TransactionID.String()))
+	}
+
+	if params.Event != nil {
+		sb.Where(sb.Equal("webhook_dead_letters.event", *params.Event))
+		countSb.Where(countSb.Equal("webhook_dead_letters.event", *params.Event))
+	}
+
+	if params.Requeued != nil {
+		if *params.Requeued {
+			sb.Where(sb.IsNotNull("webhook_dead_letters.requeued_at"))
+			countSb.Where(countSb.IsNotNull("webhook_dead_letters.requeued_at"))
+		} else {
+			sb.Where(sb.IsNull("webhook_dead_letters.requeued_at"))
+			countSb.Where(countSb.IsNull("webhook_dead_letters.requeued_at"))
+		}
+	}
+
+	limit, offset, err := dbutils.Pagination(params.Page, params.PageSize, dbutils.WithMaxLimit(maxLimit))
+	if err != nil {
+		return nil, err
+	}
+
+	sb.OrderBy("webhook_dead_letters.created_at").Desc()
+	sb.Limit(int(limit))
+	sb.Offset(int(offset))
+
+	items := make([]*models.WebhookDeadLetter, 0)
+	sql, args := sb.Build()
+	if err := pgxscan.Select(ctx, s.psql, &items, sql, args...); err != nil {
+		return nil, fmt.Errorf("select webhook dead letters: %w", err)
+	}
+
+	var totalCnt uint64
+	pagingSQL, args := countSb.Build()
+	if err := pgxscan.Get(ctx, s.psql, &totalCnt, pagingSQL, args...); err != nil {
+		return nil, fmt.Errorf("select paging query: %w", err)
+	}
+
+	var page uint64 = 1
+	if params.Page != nil {
+		page = uint64(*params.Page)
+	}
+
+	var pagesCnt uint64 = 1
+	if params.PageSize != nil {
+		pagesCnt = uint64(math.Ceil(float64(totalCnt) / float64(*params.PageSize)))
+	}
+
+	return &storecmn.FindResponseWithFullPagination[*models.WebhookDeadLetter]{
+		Items: items,
+		Pagination: storecmn.FullPagingData{
+			Total:    totalCnt,
+			PageSize: uint64(limit),
+			Page:     page,
+			LastPage: pagesCnt,
+		},
+	}, nil
+}
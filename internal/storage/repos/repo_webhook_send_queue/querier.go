@@ -12,10 +12,17 @@ import (
 )
 
 type Querier interface {
+	// leases due messages to the caller, rows claimed by other replicas are skipped
+	ClaimQueuedWebhooks(ctx context.Context, arg ClaimQueuedWebhooksParams) ([]*ClaimQueuedWebhooksRow, error)
 	Create(ctx context.Context, arg CreateParams) (*models.WebhookSendQueue, error)
 	Delete(ctx context.Context, id uuid.UUID) error
+	// queues message unless the same webhook is already queued for the transaction
+	Enqueue(ctx context.Context, arg EnqueueParams) (int64, error)
 	GetById(ctx context.Context, id uuid.UUID) (*models.WebhookSendQueue, error)
-	GetQueuedWebhooks(ctx context.Context) ([]*GetQueuedWebhooksRow, error)
+	// gives claimed message back to the queue, it is not claimed again until the delay passes
+	Release(ctx context.Context, arg ReleaseParams) error
+	// puts message back to the queue unless the same webhook is already queued for the transaction
+	Requeue(ctx context.Context, arg RequeueParams) (int64, error)
 	UpdateDelay(ctx context.Context, arg UpdateDelayParams) error
 }
 
@@ -8,69 +8,86 @@ package repo_webhook_send_queue
 import (
 	"context"
 
+	"github.com/dv-net/dv-merchant/internal/models"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-const delete = `-- name: Delete :exec
-DELETE
-FROM webhook_send_queue
-WHERE id = $1
+const claimQueuedWebhooks = `-- name: ClaimQueuedWebhooks :many
+WITH claimed AS (SELECT whsq.id
+                 FROM webhook_send_queue whsq
+                          join store_webhooks sw on whsq.webhook_id = sw.id and sw.enabled = true
+                 WHERE (whsq.locked_until is null or whsq.locked_until < now())
+                   AND (whsq.last_sent_at is null or whsq.last_sent_at + whsq.seconds_delay * interval '1 second' <= now())
+                   AND NOT EXISTS (select 1
+                                   from webhook_send_histories whsh
+                                   where whsh.send_queue_job_id = whsq.id
+                                     and whsh.status = 'success')
+                 ORDER BY whsq.created_at
+                 LIMIT $1 FOR UPDATE OF whsq SKIP LOCKED)
+UPDATE webhook_send_queue whsq
+SET locked_until = now() + $2::integer * interval '1 second'
+FROM claimed,
+     store_webhooks sw
+WHERE whsq.id = claimed.id
+  AND sw.id = whsq.webhook_id
+RETURNING whsq.id,
+    whsq.webhook_id,
+    whsq.seconds_delay,
+    whsq.transaction_id,
+    whsq.event,
+    whsq.payload,
+    whsq.signature,
+    whsq.created_at,
+    whsq.last_sent_at,
+    sw.store_id,
+    sw.url,
+    sw.success_mode,
+    sw.success_status_codes,
+    sw.success_json_path,
+    sw.success_json_value,
+    sw.timeout_seconds,
+    (select count(distinct id)
+     from webhook_send_histories
+     where webhook_send_histories.status = 'failed'
+       and webhook_send_histories.send_queue_job_id = whsq.id) as retries_count
 `
 
-func (q *Queries) Delete(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.Exec(ctx, delete, id)
-	return err
+type ClaimQueuedWebhooksParams struct {
+	BatchSize    int32 `db:"batch_size" json:"batch_size"`
+	LeaseSeconds int32 `db:"lease_seconds" json:"lease_seconds"`
 }
 
-const getQueuedWebhooks = `-- name: GetQueuedWebhooks :many
-SELECT whsq.id,
-       whsq.webhook_id,
-       whsq.seconds_delay,
-       whsq.transaction_id,
-       whsq.event,
-       whsq.payload,
-       whsq.signature,
-       whsq.created_at,
-       whsq.last_sent_at,
-       sw.store_id,
-       sw.url,
-       (select count(distinct id)
-        from webhook_send_histories
-        where webhook_send_histories.status = 'failed'
-          and webhook_send_histories.send_queue_job_id = whsq.id) as retries_count
-FROM webhook_send_queue whsq
-         join store_webhooks sw on whsq.webhook_id = sw.id and sw.enabled = true
-         left join webhook_send_histories whsh on whsh.send_queue_job_id = whsq.id and whsh.status = 'success'
-WHERE whsh.id is null
-ORDER BY whsq.created_at
-LIMIT 500
-`
-
-type GetQueuedWebhooksRow struct {
-	ID            uuid.UUID        `db:"id" json:"id"`
-	WebhookID     uuid.UUID        `db:"webhook_id" json:"webhook_id"`
-	SecondsDelay  int16            `db:"seconds_delay" json:"seconds_delay"`
-	TransactionID uuid.UUID        `db:"transaction_id" json:"transaction_id"`
-	Event         string           `db:"event" json:"event"`
-	Payload       []byte           `db:"payload" json:"payload"`
-	Signature     string           `db:"signature" json:"signature"`
-	CreatedAt     pgtype.Timestamp `db:"created_at" json:"created_at"`
-	LastSentAt    pgtype.Timestamp `db:"last_sent_at" json:"last_sent_at"`
-	StoreID       uuid.UUID        `db:"store_id" json:"store_id"`
-	Url           string           `db:"url" json:"url"`
-	RetriesCount  int64            `db:"retries_count" json:"retries_count"`
+type ClaimQueuedWebhooksRow struct {
+	ID                 uuid.UUID                 `db:"id" json:"id"`
+	WebhookID          uuid.UUID                 `db:"webhook_id" json:"webhook_id"`
+	SecondsDelay       int32                     `db:"seconds_delay" json:"seconds_delay"`
+	TransactionID      uuid.UUID                 `db:"transaction_id" json:"transaction_id"`
+	Event              string                    `db:"event" json:"event"`
+	Payload            []byte                    `db:"payload" json:"payload"`
+	Signature          string                    `db:"signature" json:"signature"`
+	CreatedAt          pgtype.Timestamp          `db:"created_at" json:"created_at"`
+	LastSentAt         pgtype.Timestamp          `db:"last_sent_at" json:"last_sent_at"`
+	StoreID            uuid.UUID                 `db:"store_id" json:"store_id"`
+	Url                string                    `db:"url" json:"url"`
+	SuccessMode        models.WebhookSuccessMode `db:"success_mode" json:"success_mode"`
+	SuccessStatusCodes []int32                   `db:"success_status_codes" json:"success_status_codes"`
+	SuccessJsonPath    string                    `db:"success_json_path" json:"success_json_path"`
+	SuccessJsonValue   string                    `db:"success_json_value" json:"success_json_value"`
+	TimeoutSeconds     int32                     `db:"timeout_seconds" json:"timeout_seconds"`
+	RetriesCount       int64                     `db:"retries_count" json:"retries_count"`
 }
 
-func (q *Queries) GetQueuedWebhooks(ctx context.Context) ([]*GetQueuedWebhooksRow, error) {
-	rows, err := q.db.Query(ctx, getQueuedWebhooks)
+// leases due messages to the caller, rows claimed by other replicas are skipped
+func (q *Queries) ClaimQueuedWebhooks(ctx context.Context, arg ClaimQueuedWebhooksParams) ([]*ClaimQueuedWebhooksRow, error) {
+	rows, err := q.db.Query(ctx, claimQueuedWebhooks, arg.BatchSize, arg.LeaseSeconds)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []*GetQueuedWebhooksRow{}
+	items := []*ClaimQueuedWebhooksRow{}
 	for rows.Next() {
-		var i GetQueuedWebhooksRow
+		var i ClaimQueuedWebhooksRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.WebhookID,
@@ -83,6 +100,11 @@ func (q *Queries) GetQueuedWebhooks(ctx context.Context) ([]*GetQueuedWebhooksRo
 			&i.LastSentAt,
 			&i.StoreID,
 			&i.Url,
+			&i.SuccessMode,
+			&i.SuccessStatusCodes,
+			&i.SuccessJsonPath,
+			&i.SuccessJsonValue,
+			&i.TimeoutSeconds,
 			&i.RetriesCount,
 		); err != nil {
 			return nil, err
@@ -95,15 +117,103 @@ func (q *Queries) GetQueuedWebhooks(ctx context.Context) ([]*GetQueuedWebhooksRo
 	return items, nil
 }
 
+const delete = `-- name: Delete :exec
+DELETE
+FROM webhook_send_queue
+WHERE id = $1
+`
+
+func (q *Queries) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, delete, id)
+	return err
+}
+
+const enqueue = `-- name: Enqueue :execrows
+INSERT INTO webhook_send_queue (webhook_id, seconds_delay, transaction_id, payload, signature, event, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, now())
+ON CONFLICT (webhook_id, transaction_id) DO NOTHING
+`
+
+type EnqueueParams struct {
+	WebhookID     uuid.UUID `db:"webhook_id" json:"webhook_id"`
+	SecondsDelay  int32     `db:"seconds_delay" json:"seconds_delay"`
+	TransactionID uuid.UUID `db:"transaction_id" json:"transaction_id"`
+	Payload       []byte    `db:"payload" json:"payload"`
+	Signature     string    `db:"signature" json:"signature"`
+	Event         string    `db:"event" json:"event"`
+}
+
+// queues message unless the same webhook is already queued for the transaction
+func (q *Queries) Enqueue(ctx context.Context, arg EnqueueParams) (int64, error) {
+	result, err := q.db.Exec(ctx, enqueue,
+		arg.WebhookID,
+		arg.SecondsDelay,
+		arg.TransactionID,
+		arg.Payload,
+		arg.Signature,
+		arg.Event,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const release = `-- name: Release :exec
+UPDATE webhook_send_queue
+set locked_until = now() + $2::integer * interval '1 second'
+where id = $1
+`
+
+type ReleaseParams struct {
+	ID    uuid.UUID `db:"id" json:"id"`
+	Delay int32     `db:"delay" json:"delay"`
+}
+
+// gives claimed message back to the queue, it is not claimed again until the delay passes
+func (q *Queries) Release(ctx context.Context, arg ReleaseParams) error {
+	_, err := q.db.Exec(ctx, release, arg.ID, arg.Delay)
+	return err
+}
+
+const requeue = `-- name: Requeue :execrows
+INSERT INTO webhook_send_queue (webhook_id, seconds_delay, transaction_id, payload, signature, event, created_at)
+VALUES ($1, 0, $2, $3, $4, $5, now())
+ON CONFLICT (webhook_id, transaction_id) DO NOTHING
+`
+
+type RequeueParams struct {
+	WebhookID     uuid.UUID `db:"webhook_id" json:"webhook_id"`
+	TransactionID uuid.UUID `db:"transaction_id" json:"transaction_id"`
+	Payload       []byte    `db:"payload" json:"payload"`
+	Signature     string    `db:"signature" json:"signature"`
+	Event         string    `db:"event" json:"event"`
+}
+
+// puts message back to the queue unless the same webhook is already queued for the transaction
+func (q *Queries) Requeue(ctx context.Context, arg RequeueParams) (int64, error) {
+	result, err := q.db.Exec(ctx, requeue,
+		arg.WebhookID,
+		arg.TransactionID,
+		arg.Payload,
+		arg.Signature,
+		arg.Event,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateDelay = `-- name: UpdateDelay :exec
 UPDATE webhook_send_queue
-set seconds_delay=$2, last_sent_at = now()
+set seconds_delay=$2, last_sent_at = now(), locked_until = null
 where id = $1
 `
 
 type UpdateDelayParams struct {
 	ID    uuid.UUID `db:"id" json:"id"`
-	Delay int16     `db:"delay" json:"delay"`
+	Delay int32     `db:"delay" json:"delay"`
 }
 
 func (q *Queries) UpdateDelay(ctx context.Context, arg UpdateDelayParams) error {
@@ -16,12 +16,12 @@ import (
 const create = `-- name: Create :one
 INSERT INTO webhook_send_queue (webhook_id, seconds_delay, transaction_id, payload, signature, event, last_sent_at, created_at)
 	VALUES ($1, $2, $3, $4, $5, $6, $7, now())
-	RETURNING id, webhook_id, seconds_delay, transaction_id, payload, signature, event, last_sent_at, created_at
+	RETURNING id, webhook_id, seconds_delay, transaction_id, payload, signature, event, last_sent_at, created_at, locked_until
 `
 
 type CreateParams struct {
 	WebhookID     uuid.UUID        `db:"webhook_id" json:"webhook_id"`
-	SecondsDelay  int16            `db:"seconds_delay" json:"seconds_delay"`
+	SecondsDelay  int32            `db:"seconds_delay" json:"seconds_delay"`
 	TransactionID uuid.UUID        `db:"transaction_id" json:"transaction_id"`
 	Payload       []byte           `db:"payload" json:"payload"`
 	Signature     string           `db:"signature" json:"signature"`
@@ -50,12 +50,13 @@ func (q *Queries) Create(ctx context.Context, arg CreateParams) (*models.Webhook
 		&i.Event,
 		&i.LastSentAt,
 		&i.CreatedAt,
+		&i.LockedUntil,
 	)
 	return &i, err
 }
 
 const getById = `-- name: GetById :one
-SELECT id, webhook_id, seconds_delay, transaction_id, payload, signature, event, last_sent_at, created_at FROM webhook_send_queue WHERE id=$1 LIMIT 1
+SELECT id, webhook_id, seconds_delay, transaction_id, payload, signature, event, last_sent_at, created_at, locked_until FROM webhook_send_queue WHERE id=$1 LIMIT 1
 `
 
 func (q *Queries) GetById(ctx context.Context, id uuid.UUID) (*models.WebhookSendQueue, error) {
@@ -71,6 +72,7 @@ func (q *Queries) GetById(ctx context.Context, id uuid.UUID) (*models.WebhookSen
 		&i.Event,
 		&i.LastSentAt,
 		&i.CreatedAt,
+		&i.LockedUntil,
 	)
 	return &i, err
 }
@@ -0,0 +1,86 @@
+package repo_withdrawal_approvals
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/storage/storecmn"
+	"github.com/dv-net/dv-merchant/pkg/dbutils"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/huandu/go-sqlbuilder"
+)
+
+type GetByParamsParams struct {
+	storecmn.CommonFindParams
+	UserID   *uuid.UUID
+	Statuses []models.WithdrawalApprovalStatus
+}
+
+const maxLimit = 1000
+
+func (s *CustomQuerier) GetByParams(ctx context.Context, params GetByParamsParams) (*storecmn.FindResponseWithFullPagination[*models.WithdrawalApproval], error) {
+	sb := sqlbuilder.PostgreSQL.NewSelectBuilder()
+	sb.Select("withdrawal_approvals.*").From("withdrawal_approvals")
+
+	countSb := sqlbuilder.PostgreSQL.NewSelectBuilder()
+	countSb.Select("COUNT(withdrawal_approvals.id)").From("withdrawal_approvals")
+
+	if params.UserID != nil {
+		sb.Where(sb.Equal("withdrawal_approvals.user_id", params.UserID.String()))
+		countSb.Where(countSb.Equal("withdrawal_approvals.user_id", params.UserID.String()))
+	}
+
+	if len(params.Statuses) > 0 {
+		statuses := make([]interface{}, 0, len(params.Statuses))
+		for _, status := range params.Statuses {
+			statuses = append(statuses, status.String())
+		}
+		sb.Where(sb.In("withdrawal_approvals.status", statuses...))
+		countSb.Where(countSb.In("withdrawal_approvals.status", statuses...))
+	}
+
+	limit, offset, err := dbutils.Pagination(params.Page, params.PageSize, dbutils.WithMaxLimit(maxLimit))
+	if err != nil {
+		return nil, err
+	}
+
+	sb.OrderBy("withdrawal_approvals.created_at").Desc()
+	sb.Limit(int(limit))
+	sb.Offset(int(offset))
+
+	items := make([]*models.WithdrawalApproval, 0)
+	sql, args := sb.Build()
+	if err := pgxscan.Select(ctx, s.psql, &items, sql, args...); err != nil {
+		return nil, fmt.Errorf("select withdrawal approvals: %w", err)
+	}
+
+	var totalCnt uint64
+	pagingSQL, args := countSb.Build()
+	if err := pgxscan.Get(ctx, s.psql, &totalCnt, pagingSQL, args...); err != nil {
+		return nil, fmt.Errorf("select paging query: %w", err)
+	}
+
+	var page uint64 = 1
+	if params.Page != nil {
+		page = uint64(*params.Page)
+	}
+
+	var pagesCnt uint64 = 1
+	if params.PageSize != nil {
+		pagesCnt = uint64(math.Ceil(float64(totalCnt) / float64(*params.PageSize)))
+	}
+
+	return &storecmn.FindResponseWithFullPagination[*models.WithdrawalApproval]{
+		Items: items,
+		Pagination: storecmn.FullPagingData{
+			Total:    totalCnt,
+			PageSize: uint64(limit),
+			Page:     page,
+			LastPage: pagesCnt,
+		},
+	}, nil
+}
@@ -11,13 +11,18 @@ import (
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_analytics"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_currencies"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_currency_exrate"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_event_outbox"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_exchange_addresses"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_exchange_chains"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_exchange_orders"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_exchange_rate_history"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_exchange_user_keys"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_exchange_withdrawal_history"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_exchange_withdrawal_settings"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_exchanges"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_invoice_payments"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_invoice_status_history"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_invoices"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_log_types"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_logs"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_multi_withdrawal_rules"
@@ -25,11 +30,17 @@ import (
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_notification_send_queue"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_notifications"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_personal_access_tokens"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_rate_quotes"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_receipts"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_refund_status_history"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_refunds"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_settings"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_store_api_keys"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_store_currencies"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_store_payment_policies"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_store_secrets"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_store_webhook_client_certificates"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_store_webhook_retry_policies"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_store_webhooks"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_store_whitelist"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_stores"
@@ -50,10 +61,17 @@ import (
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_wallet_addresses"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_wallet_addresses_activity_logs"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_wallets"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_webhook_dead_letters"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_webhook_send_histories"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_webhook_send_queue"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_withdrawal_approval_decisions"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_withdrawal_approval_rules"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_withdrawal_approvals"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_withdrawal_from_processing_wallets"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_withdrawal_limit_usages"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_withdrawal_limits"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_withdrawal_wallet_addresses"
+	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_withdrawal_wallet_schedules"
 	"github.com/dv-net/dv-merchant/internal/storage/repos/repo_withdrawal_wallets"
 	"github.com/dv-net/dv-merchant/pkg/database"
 	"github.com/dv-net/dv-merchant/pkg/key_value"
@@ -114,123 +132,177 @@ type IRepository interface {
 	UserStores(opts ...Option) repo_user_stores.Querier
 	UserNotifications(opts ...Option) repo_user_notifications.Querier
 	UserAmlSettings(opts ...Option) repo_user_aml_settings.Querier
+	Invoices(opts ...Option) repo_invoices.ICustomQuerier
+	InvoiceStatusHistory(opts ...Option) repo_invoice_status_history.Querier
+	InvoicePayments(opts ...Option) repo_invoice_payments.Querier
+	StorePaymentPolicies(opts ...Option) repo_store_payment_policies.Querier
+	Refunds(opts ...Option) repo_refunds.ICustomQuerier
+	RefundStatusHistory(opts ...Option) repo_refund_status_history.Querier
+	StoreWebhookRetryPolicies(opts ...Option) repo_store_webhook_retry_policies.Querier
+	WebhookDeadLetters(opts ...Option) repo_webhook_dead_letters.ICustomQuerier
+	StoreWebhookClientCertificates(opts ...Option) repo_store_webhook_client_certificates.Querier
+	EventOutbox(opts ...Option) repo_event_outbox.Querier
+	ExchangeRateHistory(opts ...Option) repo_exchange_rate_history.Querier
+	RateQuotes(opts ...Option) repo_rate_quotes.Querier
+	WithdrawalApprovalRules(opts ...Option) repo_withdrawal_approval_rules.Querier
+	WithdrawalApprovals(opts ...Option) repo_withdrawal_approvals.ICustomQuerier
+	WithdrawalApprovalDecisions(opts ...Option) repo_withdrawal_approval_decisions.Querier
+	WithdrawalLimits(opts ...Option) repo_withdrawal_limits.Querier
+	WithdrawalLimitUsages(opts ...Option) repo_withdrawal_limit_usages.Querier
+	WithdrawalWalletSchedules(opts ...Option) repo_withdrawal_wallet_schedules.Querier
 }
 
 type repository struct {
-	currencies                  *repo_currencies.Queries
-	users                       *repo_users.CustomQuerier
-	personalAccessToken         *repo_personal_access_tokens.Queries
-	stores                      *repo_stores.CustomQuerier
-	storeAPIKeys                *repo_store_api_keys.Queries
-	storeWebhooks               *repo_store_webhooks.Queries
-	storeCurrencies             *repo_store_currencies.Queries
-	storeWhitelist              *repo_store_whitelist.Queries
-	webhookSendHistories        *repo_webhook_send_histories.CustomQuerier
-	transactions                *repo_transactions.CustomQuerier
-	unconfirmedTransactions     *repo_unconfirmed_transactions.Queries
-	wallets                     *repo_wallets.CustomQuerier
-	walletAddresses             *repo_wallet_addresses.CustomQuerier
-	walletAddressesActivityLog  *repo_wallet_addresses_activity_logs.Queries
-	receipts                    *repo_receipts.Queries
-	settings                    *repo_settings.Queries
-	transfers                   *repo_transfers.CustomQuerier
-	withdrawalWallets           *repo_withdrawal_wallets.Queries
-	withdrawalWalletAddresses   *repo_withdrawal_wallet_addresses.Queries
-	webhookSendQueue            *repo_webhook_send_queue.Queries
-	storeSecrets                *repo_store_secrets.Queries
-	currencyExchange            *repo_currency_exrate.Repo
-	verificationCodes           *repo_user_verification.Repo
-	keyValue                    key_value.IKeyValue
-	exchanges                   *repo_exchanges.Queries
-	exchangeUserKeys            *repo_exchange_user_keys.Queries
-	notificationSendHistory     *repo_notification_send_history.CustomQuerier
-	withdrawalsFromProcessing   *repo_withdrawal_from_processing_wallets.Queries
-	exchangeOrders              *repo_exchange_orders.CustomQuerier
-	exchangeAddresses           *repo_exchange_addresses.Queries
-	notificationSendQueue       *repo_notification_send_queue.Queries
-	exchangeWithdrawalHistory   *repo_exchange_withdrawal_history.CustomQuerier
-	exchangeWithdrawalSettings  *repo_exchange_withdrawal_settings.Queries
-	exchangeChains              *repo_exchange_chains.Queries
-	logTypes                    *repo_log_types.Queries
-	logs                        *repo_logs.Queries
-	notifications               *repo_notifications.Queries
-	updateBalanceQueue          *repo_update_balance_queue.Queries
-	multiWithdrawalRules        *repo_multi_withdrawal_rules.Queries
-	transferTransactions        *repo_transfer_transactions.Queries
-	tronWalletBalanceStatistics *repo_tron_wallet_balance_statistics.Queries
-	analytics                   *repo_analytics.Queries
-	amlServices                 *repo_aml_services.Queries
-	amlServiceKeys              *repo_aml_service_keys.Queries
-	amlUserKeys                 *repo_aml_user_keys.CustomQueries
-	amlChecks                   *repo_aml_checks.CustomQuerier
-	amlCheckQueue               *repo_aml_check_queue.Queries
-	amlCheckHistory             *repo_aml_check_history.Queries
-	amlSupportedAssets          *repo_aml_supported_assets.Queries
-	userAddressBook             *repo_user_address_book.Queries
-	userExchanges               *repo_user_exchanges.CustomQuerier
-	userExchangePairs           *repo_user_exchange_pairs.Queries
-	userStores                  *repo_user_stores.Queries
-	userNotifications           *repo_user_notifications.Queries
-	userAmlSettings             *repo_user_aml_settings.Queries
+	currencies                     *repo_currencies.Queries
+	users                          *repo_users.CustomQuerier
+	personalAccessToken            *repo_personal_access_tokens.Queries
+	stores                         *repo_stores.CustomQuerier
+	storeAPIKeys                   *repo_store_api_keys.Queries
+	storeWebhooks                  *repo_store_webhooks.Queries
+	storeCurrencies                *repo_store_currencies.Queries
+	storeWhitelist                 *repo_store_whitelist.Queries
+	webhookSendHistories           *repo_webhook_send_histories.CustomQuerier
+	transactions                   *repo_transactions.CustomQuerier
+	unconfirmedTransactions        *repo_unconfirmed_transactions.Queries
+	wallets                        *repo_wallets.CustomQuerier
+	walletAddresses                *repo_wallet_addresses.CustomQuerier
+	walletAddressesActivityLog     *repo_wallet_addresses_activity_logs.Queries
+	receipts                       *repo_receipts.Queries
+	settings                       *repo_settings.Queries
+	transfers                      *repo_transfers.CustomQuerier
+	withdrawalWallets              *repo_withdrawal_wallets.Queries
+	withdrawalWalletAddresses      *repo_withdrawal_wallet_addresses.Queries
+	webhookSendQueue               *repo_webhook_send_queue.Queries
+	storeSecrets                   *repo_store_secrets.Queries
+	currencyExchange               *repo_currency_exrate.Repo
+	verificationCodes              *repo_user_verification.Repo
+	keyValue                       key_value.IKeyValue
+	exchanges                      *repo_exchanges.Queries
+	exchangeUserKeys               *repo_exchange_user_keys.Queries
+	notificationSendHistory        *repo_notification_send_history.CustomQuerier
+	withdrawalsFromProcessing      *repo_withdrawal_from_processing_wallets.Queries
+	exchangeOrders                 *repo_exchange_orders.CustomQuerier
+	exchangeAddresses              *repo_exchange_addresses.Queries
+	notificationSendQueue          *repo_notification_send_queue.Queries
+	exchangeWithdrawalHistory      *repo_exchange_withdrawal_history.CustomQuerier
+	exchangeWithdrawalSettings     *repo_exchange_withdrawal_settings.Queries
+	exchangeChains                 *repo_exchange_chains.Queries
+	logTypes                       *repo_log_types.Queries
+	logs                           *repo_logs.Queries
+	notifications                  *repo_notifications.Queries
+	updateBalanceQueue             *repo_update_balance_queue.Queries
+	multiWithdrawalRules           *repo_multi_withdrawal_rules.Queries
+	transferTransactions           *repo_transfer_transactions.Queries
+	tronWalletBalanceStatistics    *repo_tron_wallet_balance_statistics.Queries
+	analytics                      *repo_analytics.Queries
+	amlServices                    *repo_aml_services.Queries
+	amlServiceKeys                 *repo_aml_service_keys.Queries
+	amlUserKeys                    *repo_aml_user_keys.CustomQueries
+	amlChecks                      *repo_aml_checks.CustomQuerier
+	amlCheckQueue                  *repo_aml_check_queue.Queries
+	amlCheckHistory                *repo_aml_check_history.Queries
+	amlSupportedAssets             *repo_aml_supported_assets.Queries
+	userAddressBook                *repo_user_address_book.Queries
+	userExchanges                  *repo_user_exchanges.CustomQuerier
+	userExchangePairs              *repo_user_exchange_pairs.Queries
+	userStores                     *repo_user_stores.Queries
+	userNotifications              *repo_user_notifications.Queries
+	userAmlSettings                *repo_user_aml_settings.Queries
+	invoices                       *repo_invoices.CustomQuerier
+	invoiceStatusHistory           *repo_invoice_status_history.Queries
+	invoicePayments                *repo_invoice_payments.Queries
+	storePaymentPolicies           *repo_store_payment_policies.Queries
+	refunds                        *repo_refunds.CustomQuerier
+	refundStatusHistory            *repo_refund_status_history.Queries
+	repoStoreWebhookRetryPolicies  *repo_store_webhook_retry_policies.Queries
+	repoWebhookDeadLetters         *repo_webhook_dead_letters.CustomQuerier
+	storeWebhookClientCertificates *repo_store_webhook_client_certificates.Queries
+	eventOutbox                    *repo_event_outbox.Queries
+	exchangeRateHistory            *repo_exchange_rate_history.Queries
+	rateQuotes                     *repo_rate_quotes.Queries
+	withdrawalApprovalRules        *repo_withdrawal_approval_rules.Queries
+	withdrawalApprovals            *repo_withdrawal_approvals.CustomQuerier
+	withdrawalApprovalDecisions    *repo_withdrawal_approval_decisions.Queries
+	withdrawalLimits               *repo_withdrawal_limits.Queries
+	withdrawalLimitUsages          *repo_withdrawal_limit_usages.Queries
+	withdrawalWalletSchedules      *repo_withdrawal_wallet_schedules.Queries
 }
 
 func InitRepository(psql *database.PostgresClient, keyValue key_value.IKeyValue) IRepository {
 	return &repository{
-		currencies:                  repo_currencies.New(psql.DB),
-		users:                       repo_users.NewCustom(psql.DB),
-		personalAccessToken:         repo_personal_access_tokens.New(psql.DB),
-		stores:                      repo_stores.NewCustom(psql.DB),
-		storeAPIKeys:                repo_store_api_keys.New(psql.DB),
-		storeWebhooks:               repo_store_webhooks.New(psql.DB),
-		storeCurrencies:             repo_store_currencies.New(psql.DB),
-		storeWhitelist:              repo_store_whitelist.New(psql.DB),
-		webhookSendHistories:        repo_webhook_send_histories.NewCustom(psql.DB),
-		transactions:                repo_transactions.NewCustom(psql.DB),
-		unconfirmedTransactions:     repo_unconfirmed_transactions.New(psql.DB),
-		wallets:                     repo_wallets.NewCustom(psql.DB),
-		walletAddresses:             repo_wallet_addresses.NewCustom(psql.DB),
-		walletAddressesActivityLog:  repo_wallet_addresses_activity_logs.New(psql.DB),
-		receipts:                    repo_receipts.New(psql.DB),
-		settings:                    repo_settings.New(psql.DB),
-		transfers:                   repo_transfers.NewCustom(psql.DB),
-		withdrawalWallets:           repo_withdrawal_wallets.New(psql.DB),
-		withdrawalWalletAddresses:   repo_withdrawal_wallet_addresses.New(psql.DB),
-		webhookSendQueue:            repo_webhook_send_queue.New(psql.DB),
-		currencyExchange:            repo_currency_exrate.New(keyValue),
-		verificationCodes:           repo_user_verification.New(keyValue),
-		exchanges:                   repo_exchanges.New(psql.DB),
-		exchangeUserKeys:            repo_exchange_user_keys.New(psql.DB),
-		withdrawalsFromProcessing:   repo_withdrawal_from_processing_wallets.New(psql.DB),
-		storeSecrets:                repo_store_secrets.New(psql.DB),
-		keyValue:                    keyValue,
-		notificationSendHistory:     repo_notification_send_history.NewCustom(psql.DB),
-		notificationSendQueue:       repo_notification_send_queue.New(psql.DB),
-		exchangeOrders:              repo_exchange_orders.NewCustom(psql.DB),
-		exchangeAddresses:           repo_exchange_addresses.New(psql.DB),
-		logTypes:                    repo_log_types.New(psql.DB),
-		logs:                        repo_logs.New(psql.DB),
-		exchangeWithdrawalHistory:   repo_exchange_withdrawal_history.NewCustom(psql.DB),
-		exchangeWithdrawalSettings:  repo_exchange_withdrawal_settings.New(psql.DB),
-		exchangeChains:              repo_exchange_chains.New(psql.DB),
-		notifications:               repo_notifications.New(psql.DB),
-		updateBalanceQueue:          repo_update_balance_queue.New(psql.DB),
-		multiWithdrawalRules:        repo_multi_withdrawal_rules.New(psql.DB),
-		transferTransactions:        repo_transfer_transactions.New(psql.DB),
-		tronWalletBalanceStatistics: repo_tron_wallet_balance_statistics.New(psql.DB),
-		analytics:                   repo_analytics.New(psql.DB),
-		amlServices:                 repo_aml_services.New(psql.DB),
-		amlServiceKeys:              repo_aml_service_keys.New(psql.DB),
-		amlUserKeys:                 repo_aml_user_keys.NewCustom(psql.DB),
-		amlChecks:                   repo_aml_checks.NewCustom(psql.DB),
-		amlCheckQueue:               repo_aml_check_queue.New(psql.DB),
-		amlCheckHistory:             repo_aml_check_history.New(psql.DB),
-		amlSupportedAssets:          repo_aml_supported_assets.New(psql.DB),
-		userAddressBook:             repo_user_address_book.New(psql.DB),
-		userExchangePairs:           repo_user_exchange_pairs.New(psql.DB),
-		userExchanges:               repo_user_exchanges.NewCustom(psql.DB),
-		userStores:                  repo_user_stores.New(psql.DB),
-		userNotifications:           repo_user_notifications.New(psql.DB),
-		userAmlSettings:             repo_user_aml_settings.New(psql.DB),
+		currencies:                     repo_currencies.New(psql.DB),
+		users:                          repo_users.NewCustom(psql.DB),
+		personalAccessToken:            repo_personal_access_tokens.New(psql.DB),
+		stores:                         repo_stores.NewCustom(psql.DB),
+		storeAPIKeys:                   repo_store_api_keys.New(psql.DB),
+		storeWebhooks:                  repo_store_webhooks.New(psql.DB),
+		storeCurrencies:                repo_store_currencies.New(psql.DB),
+		storeWhitelist:                 repo_store_whitelist.New(psql.DB),
+		webhookSendHistories:           repo_webhook_send_histories.NewCustom(psql.DB),
+		transactions:                   repo_transactions.NewCustom(psql.DB),
+		unconfirmedTransactions:        repo_unconfirmed_transactions.New(psql.DB),
+		wallets:                        repo_wallets.NewCustom(psql.DB),
+		walletAddresses:                repo_wallet_addresses.NewCustom(psql.DB),
+		walletAddressesActivityLog:     repo_wallet_addresses_activity_logs.New(psql.DB),
+		receipts:                       repo_receipts.New(psql.DB),
+		settings:                       repo_settings.New(psql.DB),
+		transfers:                      repo_transfers.NewCustom(psql.DB),
+		withdrawalWallets:              repo_withdrawal_wallets.New(psql.DB),
+		withdrawalWalletAddresses:      repo_withdrawal_wallet_addresses.New(psql.DB),
+		webhookSendQueue:               repo_webhook_send_queue.New(psql.DB),
+		currencyExchange:               repo_currency_exrate.New(keyValue),
+		verificationCodes:              repo_user_verification.New(keyValue),
+		exchanges:                      repo_exchanges.New(psql.DB),
+		exchangeUserKeys:               repo_exchange_user_keys.New(psql.DB),
+		withdrawalsFromProcessing:      repo_withdrawal_from_processing_wallets.New(psql.DB),
+		storeSecrets:                   repo_store_secrets.New(psql.DB),
+		keyValue:                       keyValue,
+		notificationSendHistory:        repo_notification_send_history.NewCustom(psql.DB),
+		notificationSendQueue:          repo_notification_send_queue.New(psql.DB),
+		exchangeOrders:                 repo_exchange_orders.NewCustom(psql.DB),
+		exchangeAddresses:              repo_exchange_addresses.New(psql.DB),
+		logTypes:                       repo_log_types.New(psql.DB),
+		logs:                           repo_logs.New(psql.DB),
+		exchangeWithdrawalHistory:      repo_exchange_withdrawal_history.NewCustom(psql.DB),
+		exchangeWithdrawalSettings:     repo_exchange_withdrawal_settings.New(psql.DB),
+		exchangeChains:                 repo_exchange_chains.New(psql.DB),
+		notifications:                  repo_notifications.New(psql.DB),
+		updateBalanceQueue:             repo_update_balance_queue.New(psql.DB),
+		multiWithdrawalRules:           repo_multi_withdrawal_rules.New(psql.DB),
+		transferTransactions:           repo_transfer_transactions.New(psql.DB),
+		tronWalletBalanceStatistics:    repo_tron_wallet_balance_statistics.New(psql.DB),
+		analytics:                      repo_analytics.New(psql.DB),
+		amlServices:                    repo_aml_services.New(psql.DB),
+		amlServiceKeys:                 repo_aml_service_keys.New(psql.DB),
+		amlUserKeys:                    repo_aml_user_keys.NewCustom(psql.DB),
+		amlChecks:                      repo_aml_checks.NewCustom(psql.DB),
+		amlCheckQueue:                  repo_aml_check_queue.New(psql.DB),
+		amlCheckHistory:                repo_aml_check_history.New(psql.DB),
+		amlSupportedAssets:             repo_aml_supported_assets.New(psql.DB),
+		userAddressBook:                repo_user_address_book.New(psql.DB),
+		userExchangePairs:              repo_user_exchange_pairs.New(psql.DB),
+		userExchanges:                  repo_user_exchanges.NewCustom(psql.DB),
+		userStores:                     repo_user_stores.New(psql.DB),
+		userNotifications:              repo_user_notifications.New(psql.DB),
+		userAmlSettings:                repo_user_aml_settings.New(psql.DB),
+		invoices:                       repo_invoices.NewCustom(psql.DB),
+		invoiceStatusHistory:           repo_invoice_status_history.New(psql.DB),
+		invoicePayments:                repo_invoice_payments.New(psql.DB),
+		storePaymentPolicies:           repo_store_payment_policies.New(psql.DB),
+		refunds:                        repo_refunds.NewCustom(psql.DB),
+		refundStatusHistory:            repo_refund_status_history.New(psql.DB),
+		repoStoreWebhookRetryPolicies:  repo_store_webhook_retry_policies.New(psql.DB),
+		repoWebhookDeadLetters:         repo_webhook_dead_letters.NewCustom(psql.DB),
+		storeWebhookClientCertificates: repo_store_webhook_client_certificates.New(psql.DB),
+		eventOutbox:                    repo_event_outbox.New(psql.DB),
+		exchangeRateHistory:            repo_exchange_rate_history.New(psql.DB),
+		rateQuotes:                     repo_rate_quotes.New(psql.DB),
+		withdrawalApprovalRules:        repo_withdrawal_approval_rules.New(psql.DB),
+		withdrawalApprovals:            repo_withdrawal_approvals.NewCustom(psql.DB),
+		withdrawalApprovalDecisions:    repo_withdrawal_approval_decisions.New(psql.DB),
+		withdrawalLimits:               repo_withdrawal_limits.New(psql.DB),
+		withdrawalLimitUsages:          repo_withdrawal_limit_usages.New(psql.DB),
+		withdrawalWalletSchedules:      repo_withdrawal_wallet_schedules.New(psql.DB),
 	}
 }
 
@@ -690,3 +762,165 @@ func (r *repository) UserAmlSettings(opts ...Option) repo_user_aml_settings.Quer
 
 	return r.userAmlSettings
 }
+
+func (r *repository) Invoices(opts ...Option) repo_invoices.ICustomQuerier {
+	options := parseOptions(opts...)
+	if options.Tx != nil {
+		return r.invoices.WithTx(options.Tx)
+	}
+
+	return r.invoices
+}
+
+func (r *repository) InvoiceStatusHistory(opts ...Option) repo_invoice_status_history.Querier {
+	options := parseOptions(opts...)
+	if options.Tx != nil {
+		return r.invoiceStatusHistory.WithTx(options.Tx)
+	}
+
+	return r.invoiceStatusHistory
+}
+
+func (r *repository) InvoicePayments(opts ...Option) repo_invoice_payments.Querier {
+	options := parseOptions(opts...)
+	if options.Tx != nil {
+		return r.invoicePayments.WithTx(options.Tx)
+	}
+
+	return r.invoicePayments
+}
+
+func (r *repository) StorePaymentPolicies(opts ...Option) repo_store_payment_policies.Querier {
+	options := parseOptions(opts...)
+	if options.Tx != nil {
+		return r.storePaymentPolicies.WithTx(options.Tx)
+	}
+
+	return r.storePaymentPolicies
+}
+
+func (r *repository) Refunds(opts ...Option) repo_refunds.ICustomQuerier {
+	options := parseOptions(opts...)
+	if options.Tx != nil {
+		return r.refunds.WithTx(options.Tx)
+	}
+
+	return r.refunds
+}
+
+func (r *repository) RefundStatusHistory(opts ...Option) repo_refund_status_history.Querier {
+	options := parseOptions(opts...)
+	if options.Tx != nil {
+		return r.refundStatusHistory.WithTx(options.Tx)
+	}
+
+	return r.refundStatusHistory
+}
+
+func (r *repository) StoreWebhookRetryPolicies(opts ...Option) repo_store_webhook_retry_policies.Querier {
+	options := parseOptions(opts...)
+	if options.Tx != nil {
+		return r.repoStoreWebhookRetryPolicies.WithTx(options.Tx)
+	}
+
+	return r.repoStoreWebhookRetryPolicies
+}
+
+func (r *repository) WebhookDeadLetters(opts ...Option) repo_webhook_dead_letters.ICustomQuerier {
+	options := parseOptions(opts...)
+	if options.Tx != nil {
+		return r.repoWebhookDeadLetters.WithTx(options.Tx)
+	}
+
+	return r.repoWebhookDeadLetters
+}
+
+func (r *repository) StoreWebhookClientCertificates(opts ...Option) repo_store_webhook_client_certificates.Querier {
+	options := parseOptions(opts...)
+	if options.Tx != nil {
+		return r.storeWebhookClientCertificates.WithTx(options.Tx)
+	}
+
+	return r.storeWebhookClientCertificates
+}
+
+func (r *repository) EventOutbox(opts ...Option) repo_event_outbox.Querier {
+	options := parseOptions(opts...)
+	if options.Tx != nil {
+		return r.eventOutbox.WithTx(options.Tx)
+	}
+
+	return r.eventOutbox
+}
+
+func (r *repository) ExchangeRateHistory(opts ...Option) repo_exchange_rate_history.Querier {
+	options := parseOptions(opts...)
+	if options.Tx != nil {
+		return r.exchangeRateHistory.WithTx(options.Tx)
+	}
+
+	return r.exchangeRateHistory
+}
+
+func (r *repository) RateQuotes(opts ...Option) repo_rate_quotes.Querier {
+	options := parseOptions(opts...)
+	if options.Tx != nil {
+		return r.rateQuotes.WithTx(options.Tx)
+	}
+
+	return r.rateQuotes
+}
+
+func (r *repository) WithdrawalApprovalRules(opts ...Option) repo_withdrawal_approval_rules.Querier {
+	options := parseOptions(opts...)
+	if options.Tx != nil {
+		return r.withdrawalApprovalRules.WithTx(options.Tx)
+	}
+
+	return r.withdrawalApprovalRules
+}
+
+func (r *repository) WithdrawalApprovals(opts ...Option) repo_withdrawal_approvals.ICustomQuerier {
+	options := parseOptions(opts...)
+	if options.Tx != nil {
+		return r.withdrawalApprovals.WithTx(options.Tx)
+	}
+
+	return r.withdrawalApprovals
+}
+
+func (r *repository) WithdrawalApprovalDecisions(opts ...Option) repo_withdrawal_approval_decisions.Querier {
+	options := parseOptions(opts...)
+	if options.Tx != nil {
+		return r.withdrawalApprovalDecisions.WithTx(options.Tx)
+	}
+
+	return r.withdrawalApprovalDecisions
+}
+
+func (r *repository) WithdrawalLimits(opts ...Option) repo_withdrawal_limits.Querier {
+	options := parseOptions(opts...)
+	if options.Tx != nil {
+		return r.withdrawalLimits.WithTx(options.Tx)
+	}
+
+	return r.withdrawalLimits
+}
+
+func (r *repository) WithdrawalLimitUsages(opts ...Option) repo_withdrawal_limit_usages.Querier {
+	options := parseOptions(opts...)
+	if options.Tx != nil {
+		return r.withdrawalLimitUsages.WithTx(options.Tx)
+	}
+
+	return r.withdrawalLimitUsages
+}
+
+func (r *repository) WithdrawalWalletSchedules(opts ...Option) repo_withdrawal_wallet_schedules.Querier {
+	options := parseOptions(opts...)
+	if options.Tx != nil {
+		return r.withdrawalWalletSchedules.WithTx(options.Tx)
+	}
+
+	return r.withdrawalWalletSchedules
+}
@@ -102,6 +102,10 @@ func ToAddressBookEntryResponse(entry *models.UserAddressBook) *withdrawal_respo
 		resp.SubmittedAt = entry.SubmittedAt.Time.Format("2006-01-02T15:04:05Z")
 	}
 
+	if entry.ActiveAt.Valid {
+		resp.ActiveAt = entry.ActiveAt.Time.Format("2006-01-02T15:04:05Z")
+	}
+
 	return resp
 }
 
@@ -111,6 +115,10 @@ func ToAddressBookShortEntryResponse(entry *models.UserAddressBook) *withdrawal_
 		CurrencyID: entry.CurrencyID,
 	}
 
+	if entry.ActiveAt.Valid {
+		resp.ActiveAt = entry.ActiveAt.Time.Format("2006-01-02T15:04:05Z")
+	}
+
 	return resp
 }
 
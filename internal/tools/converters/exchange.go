@@ -103,10 +103,31 @@ func FromExchangeTestConnectionModelsToResponses(m []exchange.TestConnectionResu
 	return res
 }
 
+func FromExchangeKeyPermissionsToResponse(m *exchange.KeyPermissionsResult) exchange_response.ExchangeKeyPermissionsResponse {
+	return exchange_response.ExchangeKeyPermissionsResponse{
+		Exchange:         m.Slug.String(),
+		CanTrade:         m.Permissions.CanTrade,
+		CanWithdraw:      m.Permissions.CanWithdraw,
+		CanTransfer:      m.Permissions.CanTransfer,
+		IPRestricted:     m.Permissions.IPRestricted,
+		ExpiresAt:        m.Permissions.ExpiresAt,
+		TransferRequired: m.Permissions.TransferRequired,
+		Warnings: lo.Map(m.Warnings, func(item models.KeyPermissionWarning, _ int) string {
+			return item.String()
+		}),
+	}
+}
+
 func GetUserExchangePairsResponse(m []*models.UserExchangePair) []exchange_response.ExchangeUserPairResponse {
 	res := make([]exchange_response.ExchangeUserPairResponse, 0, len(m))
 	for _, v := range m {
-		r := exchange_response.ExchangeUserPairResponse{}
+		r := exchange_response.ExchangeUserPairResponse{
+			OrderType:         v.OrderType.String(),
+			PriceOffset:       v.PriceOffset,
+			TimeInForce:       v.TimeInForce.String(),
+			TwapSlices:        v.TwapSlices,
+			TwapWindowSeconds: v.TwapWindowSeconds,
+		}
 		switch v.Type {
 		case "sell":
 			r.DisplayName = v.CurrencyFrom + "/" + v.CurrencyTo
@@ -193,14 +214,25 @@ func GetOrdersHistoryResponse(m *storecmn.FindResponseWithFullPagination[*repo_e
 			Side:            v.Side.String(),
 			Amount:          v.Amount.String(),
 			Status:          v.Status.String(),
+			OrderType:       v.OrderType.String(),
+			TimeInForce:     v.TimeInForce.String,
 		}
 		if v.AmountUsd.Valid {
 			amt := v.AmountUsd.Decimal.String()
 			item.AmountUsd = amt
 		}
+		if v.Price.Valid {
+			item.Price = v.Price.Decimal.String()
+		}
+		if v.ParentID.Valid {
+			item.ParentID = v.ParentID.UUID.String()
+		}
 		if v.FailReason.Valid {
 			item.FailReason = v.FailReason.String
 		}
+		if len(v.Route) > 0 {
+			item.Route = v.Route
+		}
 
 		items = append(items, item)
 	}
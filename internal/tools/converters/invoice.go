@@ -0,0 +1,97 @@
+package converters
+
+import (
+	"time"
+
+	"github.com/dv-net/dv-merchant/internal/delivery/http/request/invoice_request"
+	"github.com/dv-net/dv-merchant/internal/delivery/http/responses/invoice_response"
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/invoice"
+	"github.com/dv-net/dv-merchant/internal/storage/storecmn"
+	"github.com/dv-net/dv-merchant/pkg/pgtypeutils"
+)
+
+func FromInvoiceCreateRequestToDTO(req *invoice_request.CreateRequest) invoice.CreateDTO {
+	dto := invoice.CreateDTO{
+		StoreExternalID: req.StoreExternalID,
+		CurrencyID:      req.CurrencyID,
+		Amount:          req.Amount,
+		AmountUSD:       req.AmountUSD,
+		OrderID:         req.OrderID,
+		Description:     req.Description,
+		IP:              req.IP,
+		Email:           req.Email,
+		Locale:          req.Locale,
+	}
+	if req.LifetimeMinutes != nil {
+		dto.Lifetime = time.Duration(*req.LifetimeMinutes) * time.Minute
+	}
+
+	return dto
+}
+
+func FromInvoiceListRequestToDTO(req *invoice_request.ListRequest) invoice.ListDTO {
+	return invoice.ListDTO{
+		Statuses: req.Statuses,
+		OrderID:  req.OrderID,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}
+}
+
+func FromInvoiceModelToResponse(inv *models.Invoice) *invoice_response.InvoiceResponse {
+	return &invoice_response.InvoiceResponse{
+		ID:                inv.ID.String(),
+		StoreID:           inv.StoreID.String(),
+		WalletID:          inv.WalletID.String(),
+		CurrencyID:        inv.CurrencyID,
+		Address:           inv.Address,
+		OrderID:           pgtypeutils.DecodeText(inv.OrderID),
+		Description:       pgtypeutils.DecodeText(inv.Description),
+		Status:            inv.Status,
+		Amount:            inv.Amount,
+		AmountUSD:         inv.AmountUsd,
+		PaidAmount:        inv.PaidAmount,
+		PaidAmountUSD:     inv.PaidAmountUsd,
+		CreditAmountUSD:   inv.CreditAmountUsd,
+		LeftoverAmountUSD: inv.LeftoverAmountUsd,
+		LeftoverAction:    inv.LeftoverAction,
+		ExpiresAt:         inv.ExpiresAt.Time,
+		PaidAt:            pgtypeutils.DecodeTime(inv.PaidAt),
+		CreatedAt:         inv.CreatedAt.Time,
+		UpdatedAt:         pgtypeutils.DecodeTime(inv.UpdatedAt),
+	}
+}
+
+func FromInvoiceWithHistoryToResponse(dto *invoice.WithHistoryDTO) *invoice_response.InvoiceWithHistoryResponse {
+	history := make([]*invoice_response.InvoiceStatusHistoryResponse, 0, len(dto.History))
+	for _, h := range dto.History {
+		item := &invoice_response.InvoiceStatusHistoryResponse{
+			StatusFrom: h.StatusFrom,
+			StatusTo:   h.StatusTo,
+			CreatedAt:  h.CreatedAt.Time,
+		}
+		if h.TransactionID.Valid {
+			txID := h.TransactionID.UUID.String()
+			item.TransactionID = &txID
+		}
+		history = append(history, item)
+	}
+
+	return &invoice_response.InvoiceWithHistoryResponse{
+		InvoiceResponse: *FromInvoiceModelToResponse(dto.Invoice),
+		History:         history,
+	}
+}
+
+func FromInvoiceListToResponse(res *invoice.ListResult) *storecmn.FindResponseWithFullPagination[*invoice_response.InvoiceResponse] {
+	items := make([]*invoice_response.InvoiceResponse, 0, len(res.Items))
+	for _, inv := range res.Items {
+		items = append(items, FromInvoiceModelToResponse(inv))
+	}
+
+	return &storecmn.FindResponseWithFullPagination[*invoice_response.InvoiceResponse]{
+		Items:      items,
+		Pagination: res.Pagination,
+	}
+}
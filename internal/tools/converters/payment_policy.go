@@ -0,0 +1,26 @@
+package converters
+
+import (
+	"github.com/dv-net/dv-merchant/internal/delivery/http/request/store_request"
+	"github.com/dv-net/dv-merchant/internal/delivery/http/responses/store_response"
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/payment_policy"
+)
+
+func FromPaymentPolicyRequestToDTO(req *store_request.UpdatePaymentPolicyRequest) payment_policy.UpdateDTO {
+	return payment_policy.UpdateDTO{
+		ThresholdType:  req.ThresholdType,
+		Threshold:      req.Threshold,
+		Rounding:       req.Rounding,
+		LeftoverAction: req.LeftoverAction,
+	}
+}
+
+func FromPaymentPolicyModelToResponse(policy models.StorePaymentPolicy) *store_response.StorePaymentPolicyResponse {
+	return &store_response.StorePaymentPolicyResponse{
+		ThresholdType:  policy.ThresholdType,
+		Threshold:      policy.Threshold,
+		Rounding:       policy.Rounding,
+		LeftoverAction: policy.LeftoverAction,
+	}
+}
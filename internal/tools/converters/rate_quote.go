@@ -0,0 +1,37 @@
+package converters
+
+import (
+	"github.com/dv-net/dv-merchant/internal/delivery/http/request/public_request"
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/rate_quote"
+)
+
+func FromCreateRateQuoteRequestToDTO(req *public_request.CreateRateQuoteRequest) rate_quote.CreateDTO {
+	return rate_quote.CreateDTO{
+		CurrencyID: req.CurrencyID,
+		AmountUSD:  req.AmountUSD,
+	}
+}
+
+func FromRateQuoteModelToResponse(quote *models.RateQuote) *public_request.RateQuoteDto {
+	res := &public_request.RateQuoteDto{
+		ID:         quote.ID,
+		WalletID:   quote.WalletID,
+		CurrencyID: quote.CurrencyID,
+		Source:     quote.Source,
+		Rate:       quote.Rate,
+		ExpiresAt:  quote.ExpiresAt.Time,
+		CreatedAt:  quote.CreatedAt.Time,
+	}
+	if quote.InvoiceID.Valid {
+		res.InvoiceID = &quote.InvoiceID.UUID
+	}
+	if quote.Amount.Valid {
+		res.Amount = &quote.Amount.Decimal
+	}
+	if quote.AmountUsd.Valid {
+		res.AmountUSD = &quote.AmountUsd.Decimal
+	}
+
+	return res
+}
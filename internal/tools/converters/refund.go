@@ -0,0 +1,92 @@
+package converters
+
+import (
+	"github.com/dv-net/dv-merchant/internal/delivery/http/request/refund_request"
+	"github.com/dv-net/dv-merchant/internal/delivery/http/responses/refund_response"
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/refund"
+	"github.com/dv-net/dv-merchant/internal/storage/storecmn"
+	"github.com/dv-net/dv-merchant/pkg/pgtypeutils"
+
+	"github.com/google/uuid"
+)
+
+func FromRefundCreateRequestToDTO(req *refund_request.CreateRequest, txID uuid.UUID, requestedBy uuid.NullUUID) refund.CreateDTO {
+	return refund.CreateDTO{
+		TransactionID: txID,
+		Amount:        req.Amount,
+		AddressTo:     req.AddressTo,
+		RequestedBy:   requestedBy,
+	}
+}
+
+func FromRefundListRequestToDTO(req *refund_request.ListRequest, storeID, txID *uuid.UUID) refund.ListDTO {
+	return refund.ListDTO{
+		StoreID:       storeID,
+		TransactionID: txID,
+		Statuses:      req.Statuses,
+		Reasons:       req.Reasons,
+		Page:          req.Page,
+		PageSize:      req.PageSize,
+	}
+}
+
+func FromRefundModelToResponse(r *models.Refund) *refund_response.RefundResponse {
+	return &refund_response.RefundResponse{
+		ID:              r.ID.String(),
+		StoreID:         r.StoreID.String(),
+		TransactionID:   r.TransactionID.String(),
+		InvoiceID:       nullUUIDToString(r.InvoiceID),
+		CurrencyID:      r.CurrencyID,
+		Reason:          r.Reason,
+		Status:          r.Status,
+		Amount:          r.Amount,
+		AmountUSD:       r.AmountUsd,
+		AddressTo:       r.AddressTo,
+		WithdrawalID:    nullUUIDToString(r.WithdrawalID),
+		RefundTxHash:    pgtypeutils.DecodeText(r.RefundTxHash),
+		RejectionReason: pgtypeutils.DecodeText(r.RejectionReason),
+		FailureReason:   pgtypeutils.DecodeText(r.FailureReason),
+		DecidedAt:       pgtypeutils.DecodeTime(r.DecidedAt),
+		CreatedAt:       r.CreatedAt.Time,
+		UpdatedAt:       pgtypeutils.DecodeTime(r.UpdatedAt),
+	}
+}
+
+func FromRefundWithHistoryToResponse(dto *refund.WithHistoryDTO) *refund_response.RefundWithHistoryResponse {
+	history := make([]*refund_response.RefundStatusHistoryResponse, 0, len(dto.History))
+	for _, h := range dto.History {
+		history = append(history, &refund_response.RefundStatusHistoryResponse{
+			StatusFrom: h.StatusFrom,
+			StatusTo:   h.StatusTo,
+			UserID:     nullUUIDToString(h.UserID),
+			CreatedAt:  h.CreatedAt.Time,
+		})
+	}
+
+	return &refund_response.RefundWithHistoryResponse{
+		RefundResponse: *FromRefundModelToResponse(dto.Refund),
+		History:        history,
+	}
+}
+
+func FromRefundListToResponse(res *refund.ListResult) *storecmn.FindResponseWithFullPagination[*refund_response.RefundResponse] {
+	items := make([]*refund_response.RefundResponse, 0, len(res.Items))
+	for _, r := range res.Items {
+		items = append(items, FromRefundModelToResponse(r))
+	}
+
+	return &storecmn.FindResponseWithFullPagination[*refund_response.RefundResponse]{
+		Items:      items,
+		Pagination: res.Pagination,
+	}
+}
+
+func nullUUIDToString(id uuid.NullUUID) *string {
+	if !id.Valid {
+		return nil
+	}
+
+	value := id.UUID.String()
+	return &value
+}
@@ -0,0 +1,22 @@
+package converters
+
+import (
+	"github.com/dv-net/dv-merchant/internal/delivery/http/responses/store_response"
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/pkg/webhook_sign"
+)
+
+func FromStoreSecretModelToResponse(model *models.StoreSecret) *store_response.StoreSecretResponse {
+	res := &store_response.StoreSecretResponse{
+		Secret: model.Secret,
+		KeyID:  webhook_sign.KeyID(model.Secret),
+	}
+
+	if previous, ok := model.ActivePreviousSecret(); ok {
+		previousKeyID := webhook_sign.KeyID(previous)
+		res.PreviousKeyID = &previousKeyID
+		res.PreviousExpiresAt = &model.PreviousExpiresAt.Time
+	}
+
+	return res
+}
@@ -16,10 +16,16 @@ func FromStoreWebhookModelToResponse(webhook *models.StoreWebhook) *store_respon
 	}
 
 	return &store_response.StoreWebhookResponse{
-		ID:      webhook.ID.String(),
-		URL:     webhook.Url,
-		Enabled: webhook.Enabled,
-		Events:  events,
+		ID:                 webhook.ID.String(),
+		URL:                webhook.Url,
+		Enabled:            webhook.Enabled,
+		Events:             events,
+		SuccessMode:        webhook.SuccessMode.String(),
+		SuccessStatusCodes: webhook.SuccessStatusCodes,
+		SuccessJSONPath:    webhook.SuccessJsonPath,
+		SuccessJSONValue:   webhook.SuccessJsonValue,
+		TimeoutSeconds:     webhook.TimeoutSeconds,
+		SchemaVersion:      webhook.SchemaVersion,
 	}
 }
 
@@ -0,0 +1,27 @@
+package converters
+
+import (
+	"github.com/dv-net/dv-merchant/internal/delivery/http/responses/webhook_response"
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/webhook"
+)
+
+func FromWebhookEventsToCatalogueResponse(events []models.WebhookEvent) *webhook_response.EventCatalogueResponse {
+	res := &webhook_response.EventCatalogueResponse{
+		Events:         make([]webhook_response.EventDescription, 0, len(events)),
+		SchemaVersions: []int32{webhook.SchemaVersionLegacy, webhook.SchemaVersionEnvelope},
+	}
+
+	for _, e := range events {
+		scope := "store"
+		if !e.IsStoreScoped() {
+			scope = "account"
+		}
+		res.Events = append(res.Events, webhook_response.EventDescription{
+			Event: e.String(),
+			Scope: scope,
+		})
+	}
+
+	return res
+}
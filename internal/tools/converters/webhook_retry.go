@@ -0,0 +1,92 @@
+package converters
+
+import (
+	"time"
+
+	"github.com/dv-net/dv-merchant/internal/delivery/http/request/store_webhook_request"
+	"github.com/dv-net/dv-merchant/internal/delivery/http/responses/webhook_response"
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/webhook"
+	"github.com/dv-net/dv-merchant/internal/storage/storecmn"
+	"github.com/dv-net/dv-merchant/pkg/pgtypeutils"
+
+	"github.com/google/uuid"
+)
+
+func FromRetryPolicyRequestToDTO(req *store_webhook_request.UpdateRetryPolicyRequest) webhook.UpdateRetryPolicyDTO {
+	schedule := make([]time.Duration, 0, len(req.ScheduleSeconds))
+	for _, seconds := range req.ScheduleSeconds {
+		schedule = append(schedule, time.Duration(seconds)*time.Second)
+	}
+
+	return webhook.UpdateRetryPolicyDTO{
+		PolicyType:  req.PolicyType,
+		MaxAttempts: req.MaxAttempts,
+		Delay:       time.Duration(req.DelaySeconds) * time.Second,
+		MaxDelay:    time.Duration(req.MaxDelaySeconds) * time.Second,
+		Schedule:    schedule,
+	}
+}
+
+func FromRetryPolicyModelToResponse(policy models.StoreWebhookRetryPolicy) *webhook_response.RetryPolicyResponse {
+	return &webhook_response.RetryPolicyResponse{
+		PolicyType:      policy.PolicyType,
+		MaxAttempts:     policy.MaxAttempts,
+		DelaySeconds:    policy.DelaySeconds,
+		MaxDelaySeconds: policy.MaxDelaySeconds,
+		ScheduleSeconds: policy.ScheduleSeconds,
+	}
+}
+
+func FromDeadLettersListRequestToDTO(req *store_webhook_request.ListDeadLettersRequest, txID *uuid.UUID) webhook.ListDeadLettersDTO {
+	return webhook.ListDeadLettersDTO{
+		TransactionID: txID,
+		Event:         req.Event,
+		Requeued:      req.Requeued,
+		Page:          req.Page,
+		PageSize:      req.PageSize,
+	}
+}
+
+func FromDeadLetterModelToResponse(dl *models.WebhookDeadLetter) *webhook_response.DeadLetterResponse {
+	return &webhook_response.DeadLetterResponse{
+		ID:                     dl.ID.String(),
+		WebhookID:              dl.WebhookID.String(),
+		StoreID:                dl.StoreID.String(),
+		TransactionID:          dl.TransactionID.String(),
+		Event:                  dl.Event,
+		URL:                    dl.Url,
+		Attempts:               dl.Attempts,
+		LastResponse:           dl.LastResponse,
+		LastResponseStatusCode: dl.LastResponseStatusCode,
+		RequeuedAt:             pgtypeutils.DecodeTime(dl.RequeuedAt),
+		CreatedAt:              dl.CreatedAt.Time,
+	}
+}
+
+func FromDeadLetterModelToPayloadResponse(dl *models.WebhookDeadLetter) *webhook_response.DeadLetterWithPayloadResponse {
+	return &webhook_response.DeadLetterWithPayloadResponse{
+		DeadLetterResponse: *FromDeadLetterModelToResponse(dl),
+		Payload:            string(dl.Payload),
+		Signature:          dl.Signature,
+	}
+}
+
+func FromDeadLettersListToResponse(res *storecmn.FindResponseWithFullPagination[*models.WebhookDeadLetter]) *storecmn.FindResponseWithFullPagination[*webhook_response.DeadLetterResponse] {
+	items := make([]*webhook_response.DeadLetterResponse, 0, len(res.Items))
+	for _, dl := range res.Items {
+		items = append(items, FromDeadLetterModelToResponse(dl))
+	}
+
+	return &storecmn.FindResponseWithFullPagination[*webhook_response.DeadLetterResponse]{
+		Items:      items,
+		Pagination: res.Pagination,
+	}
+}
+
+func FromRequeueResultToResponse(res *webhook.RequeueResultDTO) *webhook_response.RequeueDeadLettersResponse {
+	return &webhook_response.RequeueDeadLettersResponse{
+		Requeued:      res.Requeued,
+		AlreadyQueued: res.AlreadyQueued,
+	}
+}
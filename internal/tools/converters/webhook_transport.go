@@ -0,0 +1,17 @@
+package converters
+
+import (
+	"github.com/dv-net/dv-merchant/internal/delivery/http/responses/webhook_response"
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/pkg/pgtypeutils"
+)
+
+func FromClientCertificateModelToResponse(cert *models.StoreWebhookClientCertificate) *webhook_response.ClientCertificateResponse {
+	return &webhook_response.ClientCertificateResponse{
+		Fingerprint: cert.Fingerprint,
+		Subject:     cert.Subject,
+		ExpiresAt:   cert.ExpiresAt.Time,
+		CreatedAt:   cert.CreatedAt.Time,
+		UpdatedAt:   pgtypeutils.DecodeTime(cert.UpdatedAt),
+	}
+}
@@ -66,10 +66,23 @@ func FromWithdrawalWithAddressToByCurrencyResponse(dto *withdrawal_wallet.Withdr
 }
 
 func FromMultiWithdrawalRuleToLowBalanceRulesResponse(dto withdrawal_wallet.MultiWithdrawalRuleDTO) withdrawal_response.LowBalanceWithdrawalRuleResponse {
-	return withdrawal_response.LowBalanceWithdrawalRuleResponse{
+	res := withdrawal_response.LowBalanceWithdrawalRuleResponse{
 		Mode:          dto.Mode,
 		ManualAddress: dto.ManualAddress,
 	}
+	for _, leg := range dto.SplitLegs {
+		legRes := withdrawal_response.SplitLegResponse{
+			Address:           leg.Address,
+			Percent:           leg.Percent,
+			ReceivesRemainder: leg.ReceivesRemainder,
+		}
+		if leg.MinAmountUSD.Valid {
+			legRes.MinAmountUSD = &leg.MinAmountUSD.Decimal
+		}
+		res.SplitLegs = append(res.SplitLegs, legRes)
+	}
+
+	return res
 }
 
 func FromProcessingWithdrawalToResponse(model models.WithdrawalFromProcessingWallet) withdrawal_response.ProcessingWithdrawalResponse {
@@ -0,0 +1,105 @@
+package converters
+
+import (
+	"github.com/dv-net/dv-merchant/internal/delivery/http/request/withdrawal_requests"
+	"github.com/dv-net/dv-merchant/internal/delivery/http/responses/withdrawal_response"
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/withdraw"
+	"github.com/dv-net/dv-merchant/internal/storage/storecmn"
+	"github.com/dv-net/dv-merchant/pkg/pgtypeutils"
+
+	"github.com/google/uuid"
+)
+
+func FromCreateApprovalRuleRequestToDTO(req *withdrawal_requests.CreateApprovalRuleRequest) withdraw.CreateApprovalRuleDTO {
+	return withdraw.CreateApprovalRuleDTO{
+		CurrencyID:        req.CurrencyID,
+		AddressTo:         req.AddressTo,
+		MinAmountUSD:      req.MinAmountUSD,
+		RequiredApprovals: req.RequiredApprovals,
+	}
+}
+
+func FromListApprovalsRequestToDTO(req *withdrawal_requests.ListApprovalsRequest, userID *uuid.UUID) withdraw.ApprovalListDTO {
+	return withdraw.ApprovalListDTO{
+		UserID:   userID,
+		Statuses: req.Statuses,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	}
+}
+
+func FromWithdrawalApprovalRuleToResponse(r *models.WithdrawalApprovalRule) *withdrawal_response.WithdrawalApprovalRuleResponse {
+	res := &withdrawal_response.WithdrawalApprovalRuleResponse{
+		ID:                r.ID.String(),
+		CurrencyID:        pgtypeutils.DecodeText(r.CurrencyID),
+		AddressTo:         pgtypeutils.DecodeText(r.AddressTo),
+		RequiredApprovals: r.RequiredApprovals,
+		CreatedBy:         nullUUIDToString(r.CreatedBy),
+		CreatedAt:         r.CreatedAt.Time,
+	}
+	if r.MinAmountUsd.Valid {
+		res.MinAmountUSD = &r.MinAmountUsd.Decimal
+	}
+
+	return res
+}
+
+func FromWithdrawalApprovalRulesToResponse(rules []*models.WithdrawalApprovalRule) []*withdrawal_response.WithdrawalApprovalRuleResponse {
+	res := make([]*withdrawal_response.WithdrawalApprovalRuleResponse, 0, len(rules))
+	for _, r := range rules {
+		res = append(res, FromWithdrawalApprovalRuleToResponse(r))
+	}
+
+	return res
+}
+
+func FromWithdrawalApprovalToResponse(a *models.WithdrawalApproval) *withdrawal_response.WithdrawalApprovalResponse {
+	return &withdrawal_response.WithdrawalApprovalResponse{
+		ID:                a.ID.String(),
+		UserID:            a.UserID.String(),
+		Kind:              a.Kind,
+		Status:            a.Status,
+		CurrencyID:        a.CurrencyID,
+		AddressTo:         a.AddressTo,
+		Amount:            a.Amount,
+		AmountUSD:         a.AmountUsd,
+		RequiredApprovals: a.RequiredApprovals,
+		RuleID:            nullUUIDToString(a.RuleID),
+		InitiatedBy:       nullUUIDToString(a.InitiatedBy),
+		ExpiresAt:         a.ExpiresAt.Time,
+		ExecutedAt:        pgtypeutils.DecodeTime(a.ExecutedAt),
+		FailureReason:     pgtypeutils.DecodeText(a.FailureReason),
+		CreatedAt:         a.CreatedAt.Time,
+		UpdatedAt:         pgtypeutils.DecodeTime(a.UpdatedAt),
+	}
+}
+
+func FromWithdrawalApprovalWithDecisionsToResponse(dto *withdraw.ApprovalWithDecisionsDTO) *withdrawal_response.WithdrawalApprovalWithDecisionsResponse {
+	decisions := make([]*withdrawal_response.WithdrawalApprovalDecisionResponse, 0, len(dto.Decisions))
+	for _, d := range dto.Decisions {
+		decisions = append(decisions, &withdrawal_response.WithdrawalApprovalDecisionResponse{
+			UserID:    d.UserID.String(),
+			Decision:  d.Decision,
+			Comment:   pgtypeutils.DecodeText(d.Comment),
+			CreatedAt: d.CreatedAt.Time,
+		})
+	}
+
+	return &withdrawal_response.WithdrawalApprovalWithDecisionsResponse{
+		WithdrawalApprovalResponse: *FromWithdrawalApprovalToResponse(dto.Approval),
+		Decisions:                  decisions,
+	}
+}
+
+func FromWithdrawalApprovalListToResponse(res *withdraw.ApprovalListResult) *storecmn.FindResponseWithFullPagination[*withdrawal_response.WithdrawalApprovalResponse] {
+	items := make([]*withdrawal_response.WithdrawalApprovalResponse, 0, len(res.Items))
+	for _, a := range res.Items {
+		items = append(items, FromWithdrawalApprovalToResponse(a))
+	}
+
+	return &storecmn.FindResponseWithFullPagination[*withdrawal_response.WithdrawalApprovalResponse]{
+		Items:      items,
+		Pagination: res.Pagination,
+	}
+}
@@ -0,0 +1,46 @@
+package converters
+
+import (
+	"github.com/dv-net/dv-merchant/internal/delivery/http/request/withdrawal_requests"
+	"github.com/dv-net/dv-merchant/internal/delivery/http/responses/withdrawal_response"
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/withdraw"
+	"github.com/dv-net/dv-merchant/pkg/pgtypeutils"
+)
+
+func FromCreateWithdrawalLimitRequestToDTO(req *withdrawal_requests.CreateWithdrawalLimitRequest) withdraw.CreateWithdrawalLimitDTO {
+	return withdraw.CreateWithdrawalLimitDTO{
+		Scope:         req.Scope,
+		ScopeValue:    req.ScopeValue,
+		WindowSeconds: req.WindowSeconds,
+		MaxAmountUSD:  req.MaxAmountUSD,
+		MaxCount:      req.MaxCount,
+	}
+}
+
+func FromWithdrawalLimitToResponse(l *models.WithdrawalLimit) *withdrawal_response.WithdrawalLimitResponse {
+	res := &withdrawal_response.WithdrawalLimitResponse{
+		ID:            l.ID.String(),
+		Scope:         l.Scope,
+		ScopeValue:    pgtypeutils.DecodeText(l.ScopeValue),
+		WindowSeconds: l.WindowSeconds,
+		CreatedAt:     l.CreatedAt.Time,
+	}
+	if l.MaxAmountUsd.Valid {
+		res.MaxAmountUSD = &l.MaxAmountUsd.Decimal
+	}
+	if l.MaxCount.Valid {
+		res.MaxCount = &l.MaxCount.Int32
+	}
+
+	return res
+}
+
+func FromWithdrawalLimitsToResponse(limits []*models.WithdrawalLimit) []*withdrawal_response.WithdrawalLimitResponse {
+	res := make([]*withdrawal_response.WithdrawalLimitResponse, 0, len(limits))
+	for _, l := range limits {
+		res = append(res, FromWithdrawalLimitToResponse(l))
+	}
+
+	return res
+}
@@ -0,0 +1,41 @@
+package converters
+
+import (
+	"github.com/dv-net/dv-merchant/internal/delivery/http/request/withdrawal_requests"
+	"github.com/dv-net/dv-merchant/internal/delivery/http/responses/withdrawal_response"
+	"github.com/dv-net/dv-merchant/internal/models"
+	"github.com/dv-net/dv-merchant/internal/service/withdraw"
+	"github.com/dv-net/dv-merchant/pkg/pgtypeutils"
+)
+
+func FromSetWithdrawalScheduleRequestToDTO(req *withdrawal_requests.SetWithdrawalScheduleRequest) withdraw.SetWithdrawalScheduleDTO {
+	return withdraw.SetWithdrawalScheduleDTO{
+		CronExpression:  req.CronExpression,
+		Timezone:        req.Timezone,
+		MinBalanceUSD:   req.MinBalanceUSD,
+		MaxGasPriceGwei: req.MaxGasPriceGwei,
+		KeepFloatUSD:    req.KeepFloatUSD,
+	}
+}
+
+func FromWithdrawalScheduleToResponse(s *models.WithdrawalWalletSchedule) *withdrawal_response.WithdrawalScheduleResponse {
+	res := &withdrawal_response.WithdrawalScheduleResponse{
+		ID:             s.ID.String(),
+		CronExpression: pgtypeutils.DecodeText(s.CronExpression),
+		Timezone:       s.Timezone,
+		RunStartedAt:   pgtypeutils.DecodeTime(s.RunStartedAt),
+		LastRunAt:      pgtypeutils.DecodeTime(s.LastRunAt),
+		CreatedAt:      s.CreatedAt.Time,
+	}
+	if s.MinBalanceUsd.Valid {
+		res.MinBalanceUSD = &s.MinBalanceUsd.Decimal
+	}
+	if s.MaxGasPriceGwei.Valid {
+		res.MaxGasPriceGwei = &s.MaxGasPriceGwei.Decimal
+	}
+	if s.KeepFloatUsd.Valid {
+		res.KeepFloatUSD = &s.KeepFloatUsd.Decimal
+	}
+
+	return res
+}
@@ -124,6 +124,36 @@ func newStruckValidator() *StructValidator {
 		panic(err)
 	}
 
+	if err := validate.RegisterValidation("decimal_lte", func(fl validator.FieldLevel) bool {
+		data, ok := fl.Field().Interface().(string)
+		if !ok {
+			return false
+		}
+
+		value, err := decimal.NewFromString(data)
+		if err != nil {
+			return false
+		}
+
+		baseValue, err := decimal.NewFromString(fl.Param())
+		if err != nil {
+			return false
+		}
+
+		return value.LessThanOrEqual(baseValue)
+	}); err != nil {
+		panic(err)
+	}
+
+	if err := validate.RegisterTranslation("decimal_lte", trans, func(ut ut.Translator) error {
+		return ut.Add("decimal_lte", "{0} must be less than or equal to {1}", true)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		t, _ := ut.T("decimal_lte", fe.Field(), fe.Param())
+		return t
+	}); err != nil {
+		panic(err)
+	}
+
 	_ = enTranslations.RegisterDefaultTranslations(validate, trans)
 
 	return &StructValidator{
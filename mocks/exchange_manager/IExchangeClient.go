@@ -25,6 +25,112 @@ func (_m *IExchangeClient) EXPECT() *IExchangeClient_Expecter {
 	return &IExchangeClient_Expecter{mock: &_m.Mock}
 }
 
+// CancelOrder provides a mock function with given fields: ctx, args
+func (_m *IExchangeClient) CancelOrder(ctx context.Context, args *models.CancelOrderParams) error {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelOrder")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.CancelOrderParams) error); ok {
+		r0 = rf(ctx, args)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IExchangeClient_CancelOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelOrder'
+type IExchangeClient_CancelOrder_Call struct {
+	*mock.Call
+}
+
+// CancelOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *models.CancelOrderParams
+func (_e *IExchangeClient_Expecter) CancelOrder(ctx interface{}, args interface{}) *IExchangeClient_CancelOrder_Call {
+	return &IExchangeClient_CancelOrder_Call{Call: _e.mock.On("CancelOrder", ctx, args)}
+}
+
+func (_c *IExchangeClient_CancelOrder_Call) Run(run func(ctx context.Context, args *models.CancelOrderParams)) *IExchangeClient_CancelOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.CancelOrderParams))
+	})
+	return _c
+}
+
+func (_c *IExchangeClient_CancelOrder_Call) Return(_a0 error) *IExchangeClient_CancelOrder_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IExchangeClient_CancelOrder_Call) RunAndReturn(run func(context.Context, *models.CancelOrderParams) error) *IExchangeClient_CancelOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateLimitOrder provides a mock function with given fields: ctx, args
+func (_m *IExchangeClient) CreateLimitOrder(ctx context.Context, args *models.CreateLimitOrderParams) (*models.ExchangeOrderDTO, error) {
+	ret := _m.Called(ctx, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLimitOrder")
+	}
+
+	var r0 *models.ExchangeOrderDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *models.CreateLimitOrderParams) (*models.ExchangeOrderDTO, error)); ok {
+		return rf(ctx, args)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *models.CreateLimitOrderParams) *models.ExchangeOrderDTO); ok {
+		r0 = rf(ctx, args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ExchangeOrderDTO)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *models.CreateLimitOrderParams) error); ok {
+		r1 = rf(ctx, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IExchangeClient_CreateLimitOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLimitOrder'
+type IExchangeClient_CreateLimitOrder_Call struct {
+	*mock.Call
+}
+
+// CreateLimitOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args *models.CreateLimitOrderParams
+func (_e *IExchangeClient_Expecter) CreateLimitOrder(ctx interface{}, args interface{}) *IExchangeClient_CreateLimitOrder_Call {
+	return &IExchangeClient_CreateLimitOrder_Call{Call: _e.mock.On("CreateLimitOrder", ctx, args)}
+}
+
+func (_c *IExchangeClient_CreateLimitOrder_Call) Run(run func(ctx context.Context, args *models.CreateLimitOrderParams)) *IExchangeClient_CreateLimitOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*models.CreateLimitOrderParams))
+	})
+	return _c
+}
+
+func (_c *IExchangeClient_CreateLimitOrder_Call) Return(_a0 *models.ExchangeOrderDTO, _a1 error) *IExchangeClient_CreateLimitOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IExchangeClient_CreateLimitOrder_Call) RunAndReturn(run func(context.Context, *models.CreateLimitOrderParams) (*models.ExchangeOrderDTO, error)) *IExchangeClient_CreateLimitOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateSpotOrder provides a mock function with given fields: ctx, from, to, side, ticker, amount, rule
 func (_m *IExchangeClient) CreateSpotOrder(ctx context.Context, from string, to string, side string, ticker string, amount *decimal.Decimal, rule *models.OrderRulesDTO) (*models.ExchangeOrderDTO, error) {
 	ret := _m.Called(ctx, from, to, side, ticker, amount, rule)
@@ -428,6 +534,64 @@ func (_c *IExchangeClient_GetExchangeSymbols_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// GetKeyPermissions provides a mock function with given fields: ctx
+func (_m *IExchangeClient) GetKeyPermissions(ctx context.Context) (*models.KeyPermissionsDTO, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetKeyPermissions")
+	}
+
+	var r0 *models.KeyPermissionsDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*models.KeyPermissionsDTO, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *models.KeyPermissionsDTO); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.KeyPermissionsDTO)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IExchangeClient_GetKeyPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetKeyPermissions'
+type IExchangeClient_GetKeyPermissions_Call struct {
+	*mock.Call
+}
+
+// GetKeyPermissions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *IExchangeClient_Expecter) GetKeyPermissions(ctx interface{}) *IExchangeClient_GetKeyPermissions_Call {
+	return &IExchangeClient_GetKeyPermissions_Call{Call: _e.mock.On("GetKeyPermissions", ctx)}
+}
+
+func (_c *IExchangeClient_GetKeyPermissions_Call) Run(run func(ctx context.Context)) *IExchangeClient_GetKeyPermissions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *IExchangeClient_GetKeyPermissions_Call) Return(_a0 *models.KeyPermissionsDTO, _a1 error) *IExchangeClient_GetKeyPermissions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IExchangeClient_GetKeyPermissions_Call) RunAndReturn(run func(context.Context) (*models.KeyPermissionsDTO, error)) *IExchangeClient_GetKeyPermissions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetOrderDetails provides a mock function with given fields: ctx, args
 func (_m *IExchangeClient) GetOrderDetails(ctx context.Context, args *models.GetOrderByIDParams) (*models.OrderDetailsDTO, error) {
 	ret := _m.Called(ctx, args)
@@ -619,6 +783,65 @@ func (_c *IExchangeClient_GetOrderRules_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// GetTickerPrice provides a mock function with given fields: ctx, ticker
+func (_m *IExchangeClient) GetTickerPrice(ctx context.Context, ticker string) (*models.TickerPriceDTO, error) {
+	ret := _m.Called(ctx, ticker)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTickerPrice")
+	}
+
+	var r0 *models.TickerPriceDTO
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*models.TickerPriceDTO, error)); ok {
+		return rf(ctx, ticker)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *models.TickerPriceDTO); ok {
+		r0 = rf(ctx, ticker)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.TickerPriceDTO)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, ticker)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IExchangeClient_GetTickerPrice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTickerPrice'
+type IExchangeClient_GetTickerPrice_Call struct {
+	*mock.Call
+}
+
+// GetTickerPrice is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ticker string
+func (_e *IExchangeClient_Expecter) GetTickerPrice(ctx interface{}, ticker interface{}) *IExchangeClient_GetTickerPrice_Call {
+	return &IExchangeClient_GetTickerPrice_Call{Call: _e.mock.On("GetTickerPrice", ctx, ticker)}
+}
+
+func (_c *IExchangeClient_GetTickerPrice_Call) Run(run func(ctx context.Context, ticker string)) *IExchangeClient_GetTickerPrice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *IExchangeClient_GetTickerPrice_Call) Return(_a0 *models.TickerPriceDTO, _a1 error) *IExchangeClient_GetTickerPrice_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IExchangeClient_GetTickerPrice_Call) RunAndReturn(run func(context.Context, string) (*models.TickerPriceDTO, error)) *IExchangeClient_GetTickerPrice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetWithdrawalByID provides a mock function with given fields: ctx, args
 func (_m *IExchangeClient) GetWithdrawalByID(ctx context.Context, args *models.GetWithdrawalByIDParams) (*models.WithdrawalStatusDTO, error) {
 	ret := _m.Called(ctx, args)
@@ -0,0 +1,203 @@
+package cron
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidExpression = errors.New("invalid cron expression")
+
+// maxLookahead limits the search for the next activation, expressions like "0 0 30 2 *" never fire
+const maxLookahead = 5 * 366 * 24 * time.Hour
+
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+type field struct {
+	min, max int
+}
+
+var (
+	minutesField = field{min: 0, max: 59}
+	hoursField   = field{min: 0, max: 23}
+	domField     = field{min: 1, max: 31}
+	monthsField  = field{min: 1, max: 12}
+	dowField     = field{min: 0, max: 7}
+)
+
+// Schedule is a parsed standard five-field cron expression: minute, hour, day of month, month, day of week
+type Schedule struct {
+	minutes uint64
+	hours   uint64
+	dom     uint64
+	months  uint64
+	dow     uint64
+	// domAny and dowAny mark unrestricted day fields, when both are restricted either of them matches
+	domAny bool
+	dowAny bool
+}
+
+// Parse parses a five-field cron expression or one of the @yearly, @monthly, @weekly, @daily, @hourly macros
+func Parse(expr string) (*Schedule, error) {
+	expr = strings.TrimSpace(expr)
+	if m, ok := macros[strings.ToLower(expr)]; ok {
+		expr = m
+	}
+
+	parts := strings.Fields(expr)
+	if len(parts) != 5 {
+		return nil, fmt.Errorf("%w: expected 5 fields, got %d", ErrInvalidExpression, len(parts))
+	}
+
+	s := &Schedule{
+		domAny: parts[2] == "*" || parts[2] == "?",
+		dowAny: parts[4] == "*" || parts[4] == "?",
+	}
+
+	var err error
+	if s.minutes, err = parseField(parts[0], minutesField); err != nil {
+		return nil, fmt.Errorf("minute: %w", err)
+	}
+	if s.hours, err = parseField(parts[1], hoursField); err != nil {
+		return nil, fmt.Errorf("hour: %w", err)
+	}
+	if s.dom, err = parseField(parts[2], domField); err != nil {
+		return nil, fmt.Errorf("day of month: %w", err)
+	}
+	if s.months, err = parseField(parts[3], monthsField); err != nil {
+		return nil, fmt.Errorf("month: %w", err)
+	}
+	if s.dow, err = parseField(parts[4], dowField); err != nil {
+		return nil, fmt.Errorf("day of week: %w", err)
+	}
+
+	// 7 is an alias for sunday
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+
+	return s, nil
+}
+
+// Next returns the first activation strictly after t in the location of t, zero time if there is none
+func (s *Schedule) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(maxLookahead)
+
+	for t.Before(limit) {
+		if s.months&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.hours&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.minutes&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+func (s *Schedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+
+	if s.domAny || s.dowAny {
+		return domMatch && dowMatch
+	}
+
+	return domMatch || dowMatch
+}
+
+func parseField(value string, f field) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(value, ",") {
+		b, err := parseItem(item, f)
+		if err != nil {
+			return 0, err
+		}
+		bits |= b
+	}
+
+	return bits, nil
+}
+
+// parseItem parses one list item: *, N, N-M, and any of them with a /step suffix
+func parseItem(item string, f field) (uint64, error) {
+	rangePart, stepPart, hasStep := strings.Cut(item, "/")
+
+	step := 1
+	if hasStep {
+		var err error
+		if step, err = strconv.Atoi(stepPart); err != nil || step <= 0 {
+			return 0, fmt.Errorf("%w: bad step %q", ErrInvalidExpression, item)
+		}
+	}
+
+	var from, to int
+	switch {
+	case rangePart == "*" || rangePart == "?":
+		from, to = f.min, f.max
+	case strings.Contains(rangePart, "-"):
+		lo, hi, _ := strings.Cut(rangePart, "-")
+		var err error
+		if from, err = parseValue(lo, f); err != nil {
+			return 0, err
+		}
+		if to, err = parseValue(hi, f); err != nil {
+			return 0, err
+		}
+		if from > to {
+			return 0, fmt.Errorf("%w: bad range %q", ErrInvalidExpression, item)
+		}
+	default:
+		var err error
+		if from, err = parseValue(rangePart, f); err != nil {
+			return 0, err
+		}
+		to = from
+		// "N/step" runs from N up to the field maximum
+		if hasStep {
+			to = f.max
+		}
+	}
+
+	var bits uint64
+	for i := from; i <= to; i += step {
+		bits |= 1 << uint(i)
+	}
+
+	return bits, nil
+}
+
+func parseValue(value string, f field) (int, error) {
+	v, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%w: bad value %q", ErrInvalidExpression, value)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("%w: value %d out of range [%d-%d]", ErrInvalidExpression, v, f.min, f.max)
+	}
+
+	return v, nil
+}
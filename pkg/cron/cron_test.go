@@ -0,0 +1,73 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"a * * * *",
+		"@every 5m",
+	}
+
+	for _, expr := range tests {
+		t.Run(expr, func(t *testing.T) {
+			_, err := Parse(expr)
+			require.ErrorIs(t, err, ErrInvalidExpression)
+		})
+	}
+}
+
+func TestSchedule_Next(t *testing.T) {
+	from := time.Date(2026, time.October, 17, 10, 30, 15, 0, time.UTC) // saturday
+
+	tests := []struct {
+		name     string
+		expr     string
+		expected time.Time
+	}{
+		{name: "every minute", expr: "* * * * *", expected: time.Date(2026, time.October, 17, 10, 31, 0, 0, time.UTC)},
+		{name: "step minutes", expr: "*/15 * * * *", expected: time.Date(2026, time.October, 17, 10, 45, 0, 0, time.UTC)},
+		{name: "hourly macro", expr: "@hourly", expected: time.Date(2026, time.October, 17, 11, 0, 0, 0, time.UTC)},
+		{name: "daily at hour", expr: "0 9 * * *", expected: time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)},
+		{name: "list of hours", expr: "0 8,12,18 * * *", expected: time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC)},
+		{name: "weekdays", expr: "0 9 * * 1-5", expected: time.Date(2026, time.October, 19, 9, 0, 0, 0, time.UTC)},
+		{name: "sunday as 7", expr: "0 0 * * 7", expected: time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC)},
+		{name: "monthly macro", expr: "@monthly", expected: time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "dom or dow", expr: "0 0 20 * 0", expected: time.Date(2026, time.October, 18, 0, 0, 0, 0, time.UTC)},
+		{name: "next year", expr: "0 0 1 1 *", expected: time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "leap day", expr: "0 0 29 2 *", expected: time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{name: "never", expr: "0 0 30 2 *", expected: time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := Parse(tt.expr)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, s.Next(from))
+		})
+	}
+}
+
+func TestSchedule_NextLocation(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+
+	s, err := Parse("0 9 * * *")
+	require.NoError(t, err)
+
+	next := s.Next(time.Date(2026, time.October, 17, 10, 0, 0, 0, time.UTC).In(loc))
+	require.Equal(t, time.Date(2026, time.October, 18, 7, 0, 0, 0, time.UTC), next.UTC())
+}
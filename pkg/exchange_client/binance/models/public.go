@@ -45,6 +45,7 @@ const (
 type MarketFilters struct {
 	LotSizeFilter  *LotSizeFilter  `json:"lot_size_filter"`
 	NotionalFilter *NotionalFilter `json:"notional_filter"`
+	PriceFilter    *PriceFilter    `json:"price_filter"`
 }
 
 type BaseFilter struct {
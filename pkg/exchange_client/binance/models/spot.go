@@ -24,6 +24,7 @@ func (o OrderType) String() string { return string(o) }
 
 const (
 	OrderTypeMarket OrderType = "MARKET"
+	OrderTypeLimit  OrderType = "LIMIT"
 )
 
 type OrderStatus string
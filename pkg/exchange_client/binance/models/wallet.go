@@ -108,3 +108,16 @@ type AssetBalance struct {
 	Withdrawing  string `json:"withdrawing"`
 	BtcValuation string `json:"btcValuation"`
 }
+
+type APIRestrictions struct {
+	IPRestrict                     bool  `json:"ipRestrict"`
+	CreateTime                     int64 `json:"createTime"`
+	EnableReading                  bool  `json:"enableReading"`
+	EnableSpotAndMarginTrading     bool  `json:"enableSpotAndMarginTrading"`
+	EnableWithdrawals              bool  `json:"enableWithdrawals"`
+	EnableInternalTransfer         bool  `json:"enableInternalTransfer"`
+	PermitsUniversalTransfer       bool  `json:"permitsUniversalTransfer"`
+	EnableMargin                   bool  `json:"enableMargin"`
+	EnableFutures                  bool  `json:"enableFutures"`
+	TradingAuthorityExpirationTime int64 `json:"tradingAuthorityExpirationTime"`
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"net/url"
+	"strings"
 
 	binancerequests "github.com/dv-net/dv-merchant/pkg/exchange_client/binance/requests"
 	binanceresponses "github.com/dv-net/dv-merchant/pkg/exchange_client/binance/responses"
@@ -15,12 +16,13 @@ type IMarketClient interface {
 	GetExchangeInfo(ctx context.Context, request *binancerequests.GetExchangeInfoRequest) (*binanceresponses.GetExchangeInfoResponse, error)
 	GetSymbolPriceTicker(ctx context.Context, request *binancerequests.GetSymbolPriceTickerRequest) (*binanceresponses.GetSymbolPriceTickerResponse, error)
 	GetSymbolsPriceTicker(ctx context.Context, request *binancerequests.GetSymbolsPriceTickerRequest) (*binanceresponses.GetSymbolsPriceTickerResponse, error)
+	GetSymbolBookTicker(ctx context.Context, request *binancerequests.GetSymbolBookTickerRequest) (*binanceresponses.GetSymbolBookTickerResponse, error)
 }
 
+const marketDataURL = "https://data-api.binance.vision"
+
 func NewMarketData(opt *ClientOptions) (IMarketClient, error) {
-	// We use specific endpoint for reference data.
-	mdp := "https://data-api.binance.vision"
-	bURL, err := url.Parse(mdp)
+	bURL, err := marketDataBaseURL(opt.BaseURL)
 	if err != nil {
 		return nil, err
 	}
@@ -42,6 +44,15 @@ func NewMarketData(opt *ClientOptions) (IMarketClient, error) {
 	return marketData, nil
 }
 
+// marketDataBaseURL returns specific endpoint for reference data of production API,
+// any other base URL (testnet, proxy) serves market data itself
+func marketDataBaseURL(baseURL *url.URL) (*url.URL, error) {
+	if baseURL == nil || strings.HasSuffix(baseURL.Hostname(), "binance.com") {
+		return url.Parse(marketDataURL)
+	}
+	return baseURL, nil
+}
+
 type MarketDataClient struct {
 	client *Client
 }
@@ -140,3 +151,24 @@ func (o *MarketDataClient) GetSymbolsPriceTicker(ctx context.Context, request *b
 	}
 	return response, nil
 }
+
+func (o *MarketDataClient) GetSymbolBookTicker(ctx context.Context, request *binancerequests.GetSymbolBookTickerRequest) (*binanceresponses.GetSymbolBookTickerResponse, error) {
+	response := &binanceresponses.GetSymbolBookTickerResponse{}
+
+	path, err := url.JoinPath(o.client.baseURL.String(), "/api/v3/ticker/bookTicker")
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, path, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	req, err = o.client.assembleRequest(request, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.client.Do(ctx, req, SecurityLevelNone, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
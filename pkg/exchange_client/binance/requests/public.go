@@ -16,6 +16,10 @@ type GetSymbolPriceTickerRequest struct {
 	Symbol string `json:"symbol,omitempty" url:"symbol"`
 }
 
+type GetSymbolBookTickerRequest struct {
+	Symbol string `json:"symbol,omitempty" url:"symbol"`
+}
+
 type GetSymbolsPriceTickerRequest struct {
 	Symbols []string `json:"symbols,omitempty" url:"symbols,brackets,comma,omitempty"` //fixme: brackets
 }
@@ -21,6 +21,14 @@ type GetSymbolPriceTickerResponse struct {
 	Price  string `json:"price"`
 }
 
+type GetSymbolBookTickerResponse struct {
+	Symbol   string `json:"symbol"`
+	BidPrice string `json:"bidPrice"`
+	BidQty   string `json:"bidQty"`
+	AskPrice string `json:"askPrice"`
+	AskQty   string `json:"askQty"`
+}
+
 type Symbols []GetSymbolPriceTickerResponse
 
 type GetSymbolsPriceTickerResponse struct {
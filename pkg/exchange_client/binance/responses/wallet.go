@@ -46,6 +46,10 @@ type GetWithdrawalAddressesResponse struct {
 	Data []binancemodels.WithdrawalWallet
 }
 
+type GetAPIRestrictionsResponse struct {
+	Data binancemodels.APIRestrictions
+}
+
 type GetWithdrawalHistoryResponse struct {
 	Data []binancemodels.WithdrawalInfo
 }
@@ -20,6 +20,7 @@ type IWalletClient interface {
 	GetWithdrawalAddresses(ctx context.Context) (*binanceresponses.GetWithdrawalAddressesResponse, error)
 	GetWithdrawalHistory(ctx context.Context, request *binancerequests.GetWithdrawalHistoryRequest) (*binanceresponses.GetWithdrawalHistoryResponse, error)
 	UniversalTransfer(ctx context.Context, request *binancerequests.UniversalTransferRequest) (*binanceresponses.UniversalTransferResponse, error)
+	GetAPIRestrictions(ctx context.Context) (*binanceresponses.GetAPIRestrictionsResponse, error)
 }
 
 func NewWallet(opt *ClientOptions) (IWalletClient, error) {
@@ -219,6 +220,23 @@ func (o *WalletClient) GetWithdrawalAddresses(ctx context.Context) (*binanceresp
 	return response, nil
 }
 
+func (o *WalletClient) GetAPIRestrictions(ctx context.Context) (*binanceresponses.GetAPIRestrictionsResponse, error) {
+	response := &binanceresponses.GetAPIRestrictionsResponse{}
+
+	path, err := url.JoinPath(o.client.baseURL.String(), "/sapi/v1/account/apiRestrictions")
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, path, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.client.Do(ctx, req, SecurityLevelSigned, &response.Data); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (o *WalletClient) UniversalTransfer(ctx context.Context, request *binancerequests.UniversalTransferRequest) (*binanceresponses.UniversalTransferResponse, error) {
 	response := &binanceresponses.UniversalTransferResponse{}
 
@@ -15,6 +15,7 @@ import (
 var _ IBitgetAccount = (*AccountClient)(nil)
 
 const (
+	getAccountInfoEndpoint    = "/api/v2/spot/account/info"
 	getAccountAssetsEndpoint  = "/api/v2/spot/account/assets"
 	getDepositAddressEndpoint = "/api/v2/spot/wallet/deposit-address"
 	getDepositRecordsEndpoint = "/api/v2/spot/wallet/deposit-records"
@@ -23,6 +24,7 @@ const (
 )
 
 type IBitgetAccount interface {
+	AccountInfo(context.Context) (*bitgetresponses.AccountInfoResponse, error)
 	AccountAssets(context.Context, *bitgetrequests.AccountAssetsRequest) (*bitgetresponses.AccountAssetsResponse, error)
 	DepositAddress(context.Context, *bitgetrequests.DepositAddressRequest) (*bitgetresponses.DepositAddressResponse, error)
 	DepositRecords(context.Context, *bitgetrequests.DepositRecordsRequest) (*bitgetresponses.DepositRecordsResponse, error)
@@ -44,6 +46,7 @@ type AccountClient struct {
 
 func (o *AccountClient) initLimiters() {
 	o.client.limiters = map[string]*limiter.Limiter{
+		getAccountInfoEndpoint:    limiter.New(o.client.store, limiter.Rate{Limit: 1, Period: time.Second}),
 		getAccountAssetsEndpoint:  limiter.New(o.client.store, limiter.Rate{Limit: 10, Period: time.Second}),
 		getDepositAddressEndpoint: limiter.New(o.client.store, limiter.Rate{Limit: 10, Period: time.Second}),
 		getDepositRecordsEndpoint: limiter.New(o.client.store, limiter.Rate{Limit: 10, Period: time.Second}),
@@ -52,6 +55,15 @@ func (o *AccountClient) initLimiters() {
 	}
 }
 
+func (o *AccountClient) AccountInfo(ctx context.Context) (*bitgetresponses.AccountInfoResponse, error) {
+	response := &bitgetresponses.AccountInfoResponse{}
+	err := o.client.Do(ctx, http.MethodGet, getAccountInfoEndpoint, true, response)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (o *AccountClient) AccountAssets(ctx context.Context, req *bitgetrequests.AccountAssetsRequest) (*bitgetresponses.AccountAssetsResponse, error) {
 	response := &bitgetresponses.AccountAssetsResponse{}
 	p := getAccountAssetsEndpoint
@@ -245,5 +245,5 @@ func errorFromResponse(o *bitget.ResponseError) error {
 	if o.Code == bitgetresponses.ResponseCodeInvalidIP {
 		return exchangeclient.ErrInvalidIPAddress
 	}
-	return fmt.Errorf("bitget error: %s", o.Msg)
+	return fmt.Errorf("bitget error: %w", o)
 }
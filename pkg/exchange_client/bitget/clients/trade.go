@@ -15,6 +15,7 @@ import (
 const (
 	getOrderInformationEndpoint = "/api/v2/spot/trade/orderInfo"
 	postPlaceOrderEndpoint      = "/api/v2/spot/trade/place-order"
+	postCancelOrderEndpoint     = "/api/v2/spot/trade/cancel-order"
 )
 
 var _ IBitgetTrade = (*TradeClient)(nil)
@@ -22,6 +23,7 @@ var _ IBitgetTrade = (*TradeClient)(nil)
 type IBitgetTrade interface {
 	OrderInformation(context.Context, *bitgetrequests.OrderInformationRequest) (*bitgetresponses.OrderInformationResponse, error)
 	PlaceOrder(context.Context, *bitgetrequests.PlaceOrderRequest) (*bitgetresponses.PlaceOrderResponse, error)
+	CancelOrder(context.Context, *bitgetrequests.CancelOrderRequest) (*bitgetresponses.CancelOrderResponse, error)
 }
 
 func NewTradeClient(opt *ClientOptions, store limiter.Store, signer bitget.ISigner, opts ...SubClientOption) *TradeClient {
@@ -39,6 +41,7 @@ type TradeClient struct {
 func (o *TradeClient) initLimiters() {
 	o.client.limiters = map[string]*limiter.Limiter{
 		getOrderInformationEndpoint: limiter.New(o.client.store, limiter.Rate{Limit: 20, Period: time.Second}),
+		postCancelOrderEndpoint:     limiter.New(o.client.store, limiter.Rate{Limit: 10, Period: time.Second}),
 	}
 }
 
@@ -63,3 +66,14 @@ func (o *TradeClient) PlaceOrder(ctx context.Context, req *bitgetrequests.PlaceO
 	}
 	return response, nil
 }
+
+func (o *TradeClient) CancelOrder(ctx context.Context, req *bitgetrequests.CancelOrderRequest) (*bitgetresponses.CancelOrderResponse, error) {
+	response := &bitgetresponses.CancelOrderResponse{}
+	p := postCancelOrderEndpoint
+	m := S2M(req)
+	err := o.client.Do(ctx, http.MethodPost, p, true, response, m)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
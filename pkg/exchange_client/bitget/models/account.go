@@ -3,6 +3,16 @@ package models
 
 import "github.com/shopspring/decimal"
 
+type AccountInfo struct {
+	UserID      string   `json:"userId"`
+	InviterID   string   `json:"inviterId"`
+	IPs         string   `json:"ips"`
+	Authorities []string `json:"authorities"`
+	ParentID    int64    `json:"parentId"`
+	TraderType  string   `json:"traderType"`
+	RegisTime   string   `json:"regisTime"`
+}
+
 type AccountAsset struct {
 	Coin           string          `json:"coin"`
 	Available      decimal.Decimal `json:"available"`
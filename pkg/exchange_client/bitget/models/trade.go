@@ -9,6 +9,16 @@ const (
 	OrderTypeMarket OrderType = "market"
 )
 
+type OrderForce string
+
+func (o OrderForce) String() string { return string(o) }
+
+const (
+	OrderForceGTC OrderForce = "gtc"
+	OrderForceIOC OrderForce = "ioc"
+	OrderForceFOK OrderForce = "fok"
+)
+
 type OrderStatus string
 
 func (o OrderStatus) String() string { return string(o) }
@@ -11,10 +11,17 @@ type (
 		ReceiveWindow string `json:"receiveWindow,omitempty" url:"receiveWindow,omitempty"`
 	}
 	PlaceOrderRequest struct {
-		Symbol    string           `json:"symbol"`
-		Side      models.OrderSide `json:"side"`
-		OrderType models.OrderType `json:"orderType"`
-		Size      string           `json:"size,omitempty"`
-		ClientOID string           `json:"clientOid,omitempty"`
+		Symbol    string            `json:"symbol"`
+		Side      models.OrderSide  `json:"side"`
+		OrderType models.OrderType  `json:"orderType"`
+		Force     models.OrderForce `json:"force,omitempty"`
+		Price     string            `json:"price,omitempty"`
+		Size      string            `json:"size,omitempty"`
+		ClientOID string            `json:"clientOid,omitempty"`
+	}
+	CancelOrderRequest struct {
+		Symbol    string `json:"symbol"`
+		OrderID   string `json:"orderId,omitempty"`
+		ClientOID string `json:"clientOid,omitempty"`
 	}
 )
@@ -3,6 +3,10 @@ package responses
 import "github.com/dv-net/dv-merchant/pkg/exchange_client/bitget/models"
 
 type (
+	AccountInfoResponse struct {
+		CommonResponse
+		Data *models.AccountInfo `json:"data,omitempty"`
+	}
 	AccountAssetsResponse struct {
 		CommonResponse
 		Data []*models.AccountAsset `json:"data,omitempty"`
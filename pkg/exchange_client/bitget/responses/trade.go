@@ -13,4 +13,8 @@ type (
 		CommonResponse
 		Data *bitgetmodels.PlacedOrder `json:"data,omitempty"`
 	}
+	CancelOrderResponse struct {
+		CommonResponse
+		Data *bitgetmodels.PlacedOrder `json:"data,omitempty"`
+	}
 )
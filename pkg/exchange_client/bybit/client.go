@@ -203,17 +203,18 @@ func (o *Client) DoPlain(ctx context.Context, method, path string, private bool,
 	duration := time.Since(startTime)
 
 	if res.StatusCode >= http.StatusBadRequest {
+		err := errorFromStatus(res.StatusCode, bb.Bytes())
 		if o.log != nil {
 			o.log.Errorln("[EXCHANGE-API]: API error response",
 				"exchange", "bybit",
 				"method", method,
 				"endpoint", path,
 				"status_code", res.StatusCode,
-				"error", exchangeclient.ErrInvalidAPICredentials.Error(),
+				"error", err.Error(),
 				"duration_ms", duration.Milliseconds(),
 			)
 		}
-		return exchangeclient.ErrInvalidAPICredentials
+		return err
 	}
 
 	var apiErr APIError
@@ -320,6 +321,24 @@ func sanitizeBody(body string) string {
 	return body
 }
 
+// errorFromStatus maps HTTP errors, bybit answers 401 for rejected key or signature
+// and 403 when request limit of IP is breached
+func errorFromStatus(status int, body []byte) error {
+	var apiErr APIError
+	if err := json.Unmarshal(body, &apiErr); err == nil && apiErr.Code != 0 {
+		return errorFromResponse(&apiErr)
+	}
+
+	switch status {
+	case http.StatusUnauthorized:
+		return exchangeclient.ErrInvalidAPICredentials
+	case http.StatusForbidden, http.StatusTooManyRequests:
+		return fmt.Errorf("bybit error: status %d: %w", status, exchangeclient.ErrRateLimited)
+	default:
+		return fmt.Errorf("bybit error: status %d, body: %s", status, sanitizeBody(string(body)))
+	}
+}
+
 func errorFromResponse(errRes *APIError) error {
 	isCredentialError := func(code int64) bool {
 		return (code >= ErrAPIKeyInvalid && code <= ErrAPISecretInvalid)
@@ -336,6 +355,9 @@ func errorFromResponse(errRes *APIError) error {
 		if isWhitelistError(errRes.Code) {
 			return exchangeclient.ErrInvalidIPAddress
 		}
+		if mapped := errRes.Unwrap(); mapped != nil {
+			return fmt.Errorf("bybit error: %s (%d): %w", errRes.Message, errRes.Code, mapped)
+		}
 		return fmt.Errorf("bybit error: %s (%d)", errRes.Message, errRes.Code)
 	}
 
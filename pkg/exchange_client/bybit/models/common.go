@@ -117,6 +117,7 @@ func (o OrderType) String() string { return string(o) }
 
 const (
 	OrderTypeMarket OrderType = "Market"
+	OrderTypeLimit  OrderType = "Limit"
 )
 
 // Select the unit for qty when create Spot market orders for UTA account
@@ -21,6 +21,15 @@ type PlaceOrderRequest struct {
 	Side        string `json:"side" url:"side"`
 	OrderType   string `json:"orderType" url:"orderType"`
 	Qty         string `json:"qty" url:"qty"`
+	Price       string `json:"price,omitempty" url:"price,omitempty"`
+	TimeInForce string `json:"timeInForce,omitempty" url:"timeInForce,omitempty"`
 	MarketUnit  string `json:"marketUnit,omitempty" url:"marketUnit,omitempty"`
 	OrderLinkID string `json:"orderLinkId,omitempty" url:"orderLinkId,omitempty"`
 }
+
+type CancelOrderRequest struct {
+	Category    string `json:"category" url:"category"`
+	Symbol      string `json:"symbol" url:"symbol"`
+	OrderID     string `json:"orderId,omitempty" url:"orderId,omitempty"`
+	OrderLinkID string `json:"orderLinkId,omitempty" url:"orderLinkId,omitempty"`
+}
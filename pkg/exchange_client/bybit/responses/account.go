@@ -22,6 +22,9 @@ type GetAPIKeyInfoResponse struct {
 	APIKey      string              `json:"apiKey,omitempty"`
 	ReadOnly    int                 `json:"readOnly"`
 	Permissions map[string][]string `json:"permissions,omitempty"`
+	IPs         []string            `json:"ips,omitempty"`
+	DeadlineDay int                 `json:"deadlineDay"`
+	ExpiredAt   string              `json:"expiredAt,omitempty"`
 }
 
 type GetAccountInfoResponse struct {
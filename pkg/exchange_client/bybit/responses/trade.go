@@ -17,3 +17,8 @@ type PlaceOrderResponse struct {
 	OrderID     string `json:"orderId"`
 	OrderLinkID string `json:"orderLinkId,omitempty"`
 }
+
+type CancelOrderResponse struct {
+	OrderID     string `json:"orderId"`
+	OrderLinkID string `json:"orderLinkId,omitempty"`
+}
@@ -14,6 +14,7 @@ type ITradeClient interface {
 	GetActiveOrders(ctx context.Context, req *requests.GetActiveOrdersRequest) (*responses.BaseResponse[responses.GetActiveOrdersResponse], error)
 	GetOrderHistory(ctx context.Context, req *requests.GetOrderHistoryRequest) (*responses.BaseResponse[responses.GetOrderHistoryResponse], error)
 	PlaceOrder(ctx context.Context, req *requests.PlaceOrderRequest) (*responses.BaseResponse[responses.PlaceOrderResponse], error)
+	CancelOrder(ctx context.Context, req *requests.CancelOrderRequest) (*responses.BaseResponse[responses.CancelOrderResponse], error)
 }
 
 type TradeClient struct {
@@ -65,3 +66,16 @@ func (o *TradeClient) PlaceOrder(ctx context.Context, req *requests.PlaceOrderRe
 
 	return &resp, nil
 }
+
+func (o *TradeClient) CancelOrder(ctx context.Context, req *requests.CancelOrderRequest) (*responses.BaseResponse[responses.CancelOrderResponse], error) {
+	endpoint := "/v5/order/cancel"
+
+	params := S2M(req)
+	var resp responses.BaseResponse[responses.CancelOrderResponse]
+	err := o.client.Do(ctx, http.MethodPost, endpoint, true, &resp, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
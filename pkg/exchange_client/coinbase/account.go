@@ -0,0 +1,76 @@
+package coinbase
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ulule/limiter/v3"
+
+	"github.com/dv-net/dv-merchant/pkg/exchange_client/coinbase/responses"
+)
+
+const (
+	getAccountsEndpoint       = "/api/v3/brokerage/accounts"
+	getKeyPermissionsEndpoint = "/api/v3/brokerage/key_permissions"
+
+	accountsPageSize = 250
+)
+
+type ICoinbaseAccount interface {
+	GetAccounts(ctx context.Context) ([]responses.Account, error)
+	GetKeyPermissions(ctx context.Context) (*responses.GetKeyPermissionsResponse, error)
+}
+
+var _ ICoinbaseAccount = (*AccountClient)(nil)
+
+type AccountClient struct {
+	client *Client
+}
+
+func NewAccountClient(opt *ClientOptions, store limiter.Store, opts ...ClientOption) *AccountClient {
+	account := &AccountClient{
+		client: NewClient(opt, store, opts...),
+	}
+	account.initLimiters()
+	return account
+}
+
+func (o *AccountClient) initLimiters() {
+	o.client.limiters = map[string]*limiter.Limiter{
+		getAccountsEndpoint:       limiter.New(o.client.store, limiter.Rate{Limit: 10, Period: time.Second}),
+		getKeyPermissionsEndpoint: limiter.New(o.client.store, limiter.Rate{Limit: 10, Period: time.Second}),
+	}
+}
+
+// GetAccounts walks through every page, coinbase keeps a separate account per currency
+func (o *AccountClient) GetAccounts(ctx context.Context) ([]responses.Account, error) {
+	accounts := make([]responses.Account, 0)
+	cursor := ""
+	for {
+		query := map[string]string{"limit": strconv.Itoa(accountsPageSize)}
+		if cursor != "" {
+			query["cursor"] = cursor
+		}
+
+		res := &responses.GetAccountsResponse{}
+		if err := o.client.Do(ctx, http.MethodGet, getAccountsEndpoint, getAccountsEndpoint, true, res, query, nil); err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, res.Accounts...)
+
+		if !res.HasNext || res.Cursor == "" {
+			return accounts, nil
+		}
+		cursor = res.Cursor
+	}
+}
+
+func (o *AccountClient) GetKeyPermissions(ctx context.Context) (*responses.GetKeyPermissionsResponse, error) {
+	res := &responses.GetKeyPermissionsResponse{}
+	if err := o.client.Do(ctx, http.MethodGet, getKeyPermissionsEndpoint, getKeyPermissionsEndpoint, true, res, nil, nil); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,211 @@
+package coinbase
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/ulule/limiter/v3"
+
+	"github.com/dv-net/dv-merchant/pkg/exchange_client/utils"
+	"github.com/dv-net/dv-merchant/pkg/logger"
+)
+
+type ICoinbaseClient interface {
+	Account() ICoinbaseAccount
+	Market() ICoinbaseMarket
+	Order() ICoinbaseOrder
+	Wallet() ICoinbaseWallet
+}
+
+func NewBaseClient(opt *ClientOptions, store limiter.Store, opts ...ClientOption) (*BaseClient, error) {
+	return &BaseClient{
+		accountClient: NewAccountClient(opt, store, opts...),
+		marketClient:  NewMarketClient(opt, store, opts...),
+		orderClient:   NewOrderClient(opt, store, opts...),
+		walletClient:  NewWalletClient(opt, store, opts...),
+	}, nil
+}
+
+type BaseClient struct {
+	accountClient ICoinbaseAccount
+	marketClient  ICoinbaseMarket
+	orderClient   ICoinbaseOrder
+	walletClient  ICoinbaseWallet
+}
+
+func (o *BaseClient) Account() ICoinbaseAccount { return o.accountClient }
+func (o *BaseClient) Market() ICoinbaseMarket   { return o.marketClient }
+func (o *BaseClient) Order() ICoinbaseOrder     { return o.orderClient }
+func (o *BaseClient) Wallet() ICoinbaseWallet   { return o.walletClient }
+
+type ClientOption func(c *Client)
+
+func WithLogger(log logger.Logger) ClientOption {
+	return func(c *Client) {
+		c.log = log
+	}
+}
+
+type ClientOptions struct {
+	APIKey    string
+	SecretKey string
+	BaseURL   *url.URL
+}
+
+func NewClient(opt *ClientOptions, store limiter.Store, opts ...ClientOption) *Client {
+	c := &Client{
+		apiKey:     opt.APIKey,
+		secretKey:  opt.SecretKey,
+		baseURL:    opt.BaseURL,
+		httpClient: http.DefaultClient,
+		signer:     NewSigner(opt.APIKey, opt.SecretKey),
+		store:      store,
+	}
+	for _, o := range opts {
+		o(c)
+	}
+	return c
+}
+
+type Client struct {
+	apiKey     string
+	secretKey  string
+	baseURL    *url.URL
+	httpClient *http.Client
+	store      limiter.Store
+	limiters   map[string]*limiter.Limiter
+	signer     ISigner
+	log        logger.Logger
+}
+
+// Do waits for the limiter of the route, route differs from path for endpoints carrying ids
+func (o *Client) Do(ctx context.Context, method, route, path string, private bool, dest interface{}, query map[string]string, body interface{}) error {
+	if l, exists := o.limiters[route]; exists {
+		for {
+			r, err := l.Get(ctx, utils.HashLimiterKey(route, o.apiKey, o.secretKey))
+			if err != nil {
+				return err
+			}
+			if !r.Reached {
+				break
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Until(time.Unix(r.Reset, 0).Add(time.Second))):
+			}
+		}
+	}
+	return o.DoPlain(ctx, method, path, private, dest, query, body)
+}
+
+func (o *Client) DoPlain(ctx context.Context, method, endpoint string, private bool, dest interface{}, query map[string]string, body interface{}) error {
+	startTime := time.Now()
+
+	if o.log != nil {
+		o.log.Debugln(
+			"[EXCHANGE-API]: Preparing request",
+			"exchange", "coinbase",
+			"method", method,
+			"endpoint", endpoint,
+			"private", private,
+		)
+	}
+
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = json.Marshal(body); err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, o.baseURL.String()+endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	if len(query) > 0 {
+		q := req.URL.Query()
+		for k, v := range query {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if private {
+		if req, err = o.signer.SignRequest(ctx, req); err != nil {
+			return err
+		}
+	}
+
+	if o.log != nil {
+		o.log.Debugln(
+			"[EXCHANGE-API]: Sending request",
+			"exchange", "coinbase",
+			"method", method,
+			"url", o.baseURL.String()+endpoint,
+			"query", sanitizeBody(req.URL.RawQuery),
+			"body", sanitizeBody(string(payload)),
+			"headers", sanitizeHeaders(req.Header),
+		)
+	}
+
+	res, err := o.httpClient.Do(req)
+	if err != nil {
+		if o.log != nil {
+			o.log.Errorln(
+				"[EXCHANGE-API]: Request failed",
+				"exchange", "coinbase",
+				"method", method,
+				"endpoint", endpoint,
+				"error", err.Error(),
+				"duration_ms", time.Since(startTime).Milliseconds(),
+			)
+		}
+		return err
+	}
+	defer res.Body.Close()
+
+	bb := new(bytes.Buffer)
+	if _, err = io.Copy(bb, res.Body); err != nil {
+		return err
+	}
+
+	duration := time.Since(startTime)
+
+	if res.StatusCode >= 400 {
+		apiErr := errorFromResponse(res.StatusCode, bb.Bytes())
+		if o.log != nil {
+			o.log.Errorln(
+				"[EXCHANGE-API]: API error response",
+				"exchange", "coinbase",
+				"method", method,
+				"endpoint", endpoint,
+				"status_code", res.StatusCode,
+				"error", apiErr.Error(),
+				"duration_ms", duration.Milliseconds(),
+			)
+		}
+		return apiErr
+	}
+
+	if o.log != nil {
+		o.log.Debugln(
+			"[EXCHANGE-API]: Request completed",
+			"exchange", "coinbase",
+			"method", method,
+			"endpoint", endpoint,
+			"status_code", res.StatusCode,
+			"duration_ms", duration.Milliseconds(),
+		)
+	}
+
+	return json.Unmarshal(bb.Bytes(), dest)
+}
@@ -0,0 +1,63 @@
+package coinbase_test
+
+import (
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ulule/limiter/v3/drivers/store/memory"
+
+	"github.com/dv-net/dv-merchant/pkg/exchange_client/coinbase"
+)
+
+const (
+	testAPIKey    = ""
+	testSecretKey = ""
+)
+
+func Test_BaseClient(t *testing.T) {
+	if os.Getenv("CI") == "true" {
+		t.Skip("Skipping Coinbase tests in CI environment")
+	}
+	if testAPIKey == "" || testSecretKey == "" {
+		t.Skip("testAPIKey/testSecretKey are not set")
+	}
+
+	bURL, err := url.Parse("https://api.coinbase.com")
+	require.NoError(t, err)
+
+	client, err := coinbase.NewBaseClient(&coinbase.ClientOptions{
+		APIKey:    testAPIKey,
+		SecretKey: testSecretKey,
+		BaseURL:   bURL,
+	}, memory.NewStore())
+	require.NoError(t, err)
+	require.NotNil(t, client)
+
+	t.Run("AccountClient", func(t *testing.T) {
+		t.Run("GetAccounts", func(t *testing.T) {
+			accounts, err := client.Account().GetAccounts(t.Context())
+			require.NoError(t, err)
+
+			for _, account := range accounts {
+				if account.AvailableBalance.Value.IsZero() {
+					continue
+				}
+				t.Logf("currency=%s available=%s hold=%s", account.Currency, account.AvailableBalance.Value.String(), account.Hold.Value.String())
+			}
+		})
+
+		t.Run("GetKeyPermissions", func(t *testing.T) {
+			permissions, err := client.Account().GetKeyPermissions(t.Context())
+			require.NoError(t, err)
+			require.True(t, permissions.CanView)
+		})
+	})
+
+	t.Run("MarketClient", func(t *testing.T) {
+		product, err := client.Market().GetProduct(t.Context(), "BTC-USDT")
+		require.NoError(t, err)
+		require.Equal(t, "BTC-USDT", product.ProductID)
+	})
+}
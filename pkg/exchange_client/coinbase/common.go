@@ -0,0 +1,141 @@
+package coinbase
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/goccy/go-json"
+
+	exchangeclient "github.com/dv-net/dv-merchant/pkg/exchange_client"
+	"github.com/dv-net/dv-merchant/pkg/exchange_client/coinbase/responses"
+)
+
+// ErrorResponse covers both advanced trade ({error, message}) and v2 ({errors: [{id, message}]}) answers
+type ErrorResponse struct {
+	Error        string `json:"error"`
+	Message      string `json:"message"`
+	ErrorDetails string `json:"error_details"` //nolint:tagliatelle
+	Errors       []struct {
+		ID      string `json:"id"`
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+var ErrAccountNotFound = errors.New("coinbase: account not found")
+
+func errorFromResponse(status int, body []byte) error {
+	errRes := ErrorResponse{}
+	if err := json.Unmarshal(body, &errRes); err != nil {
+		return errorFromStatus(status, string(body))
+	}
+
+	code, msg := errRes.Error, cmp.Or(errRes.Message, errRes.ErrorDetails)
+	if len(errRes.Errors) > 0 {
+		code, msg = errRes.Errors[0].ID, errRes.Errors[0].Message
+	}
+	if code == "" && msg == "" {
+		return errorFromStatus(status, string(body))
+	}
+
+	if sentinel := sentinelByCode(code, msg); sentinel != nil {
+		return fmt.Errorf("coinbase error: %s (%s): %w", msg, code, sentinel)
+	}
+	if sentinel := sentinelByStatus(status); sentinel != nil {
+		return fmt.Errorf("coinbase error: %s (%s): %w", msg, code, sentinel)
+	}
+
+	return fmt.Errorf("coinbase error: %s (%s)", msg, code)
+}
+
+func errorFromStatus(status int, body string) error {
+	if sentinel := sentinelByStatus(status); sentinel != nil {
+		return fmt.Errorf("coinbase error: status %d, body: %s: %w", status, sanitizeBody(body), sentinel)
+	}
+
+	return fmt.Errorf("coinbase error: status %d, body: %s", status, sanitizeBody(body))
+}
+
+func sentinelByStatus(status int) error {
+	switch status {
+	case http.StatusTooManyRequests:
+		return exchangeclient.ErrRateLimited
+	case http.StatusUnauthorized:
+		return exchangeclient.ErrInvalidAPICredentials
+	case http.StatusForbidden:
+		return exchangeclient.ErrIncorrectAPIPermissions
+	default:
+		return nil
+	}
+}
+
+func sentinelByCode(code, msg string) error {
+	switch code {
+	case "UNAUTHENTICATED", "authentication_error", "invalid_token", "expired_token", "revoked_token":
+		return exchangeclient.ErrInvalidAPICredentials
+	case "PERMISSION_DENIED", "invalid_scope":
+		if strings.Contains(strings.ToLower(msg), "ip") {
+			return exchangeclient.ErrInvalidIPAddress
+		}
+		return exchangeclient.ErrIncorrectAPIPermissions
+	case "RESOURCE_EXHAUSTED", "rate_limit_exceeded":
+		return exchangeclient.ErrRateLimited
+	case "INSUFFICIENT_FUND", "PREVIEW_INSUFFICIENT_FUND":
+		return exchangeclient.ErrInsufficientBalance
+	case "PREVIEW_INVALID_BASE_SIZE_TOO_SMALL", "PREVIEW_INVALID_QUOTE_SIZE_TOO_SMALL":
+		return exchangeclient.ErrMinOrderValue
+	case "PREVIEW_PRODUCT_CANCEL_ONLY", "PREVIEW_PRODUCT_VIEW_ONLY", "PREVIEW_TRADING_DISABLED":
+		return exchangeclient.ErrSymbolTradingHalted
+	case "two_factor_required":
+		return exchangeclient.ErrSoftLockByUserSecurityAction
+	case "validation_error":
+		lower := strings.ToLower(msg)
+		switch {
+		case strings.Contains(lower, "insufficient funds"):
+			return exchangeclient.ErrInsufficientBalance
+		case strings.Contains(lower, "address"):
+			return exchangeclient.ErrInvalidAddress
+		}
+	}
+	return nil
+}
+
+// errorFromOrderFailure maps rejected orders, coinbase answers them with 200 and success=false
+func errorFromOrderFailure(res *responses.OrderErrorResponse) error {
+	code := cmp.Or(res.PreviewFailureReason, res.NewOrderFailureReason, res.Error)
+	if code == "UNKNOWN_FAILURE_REASON" {
+		code = res.Error
+	}
+	msg := cmp.Or(res.Message, res.ErrorDetails, code)
+
+	if sentinel := sentinelByCode(code, msg); sentinel != nil {
+		return fmt.Errorf("coinbase error: %s (%s): %w", msg, code, sentinel)
+	}
+	return fmt.Errorf("coinbase error: %s (%s)", msg, code)
+}
+
+func sanitizeHeaders(headers http.Header) map[string]string {
+	sanitized := make(map[string]string)
+	for k, v := range headers {
+		if strings.Contains(strings.ToLower(k), "authorization") ||
+			strings.Contains(strings.ToLower(k), "key") {
+			sanitized[k] = "***REDACTED***"
+		} else {
+			sanitized[k] = strings.Join(v, ",")
+		}
+	}
+	return sanitized
+}
+
+// sanitizeBody truncates long request payloads for logging
+func sanitizeBody(body string) string {
+	if len(body) == 0 {
+		return "(empty)"
+	}
+	if len(body) > 500 {
+		return body[:500] + "... (truncated)"
+	}
+	return body
+}
@@ -0,0 +1,91 @@
+package coinbase
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/ulule/limiter/v3"
+
+	"github.com/dv-net/dv-merchant/pkg/exchange_client/coinbase/responses"
+)
+
+const (
+	getProductsEndpoint         = "/api/v3/brokerage/market/products"
+	getProductEndpoint          = "/api/v3/brokerage/market/products/{product_id}"
+	getProductBookEndpoint      = "/api/v3/brokerage/market/product_book"
+	getCryptoCurrenciesEndpoint = "/v2/currencies/crypto"
+
+	ProductTypeSpot = "SPOT"
+
+	ProductStatusOnline = "online"
+)
+
+type ICoinbaseMarket interface {
+	GetProducts(ctx context.Context) (*responses.GetProductsResponse, error)
+	GetProduct(ctx context.Context, productID string) (*responses.Product, error)
+	GetProductBook(ctx context.Context, productID string) (*responses.GetProductBookResponse, error)
+	GetCryptoCurrencies(ctx context.Context) (*responses.GetCryptoCurrenciesResponse, error)
+}
+
+var _ ICoinbaseMarket = (*MarketClient)(nil)
+
+// MarketClient uses public market endpoints, they do not require a key
+type MarketClient struct {
+	client *Client
+}
+
+func NewMarketClient(opt *ClientOptions, store limiter.Store, opts ...ClientOption) *MarketClient {
+	market := &MarketClient{
+		client: NewClient(opt, store, opts...),
+	}
+	market.initLimiters()
+	return market
+}
+
+func (o *MarketClient) initLimiters() {
+	o.client.limiters = map[string]*limiter.Limiter{
+		getProductsEndpoint:         limiter.New(o.client.store, limiter.Rate{Limit: 10, Period: time.Second}),
+		getProductEndpoint:          limiter.New(o.client.store, limiter.Rate{Limit: 10, Period: time.Second}),
+		getProductBookEndpoint:      limiter.New(o.client.store, limiter.Rate{Limit: 10, Period: time.Second}),
+		getCryptoCurrenciesEndpoint: limiter.New(o.client.store, limiter.Rate{Limit: 10, Period: time.Second}),
+	}
+}
+
+func (o *MarketClient) GetProducts(ctx context.Context) (*responses.GetProductsResponse, error) {
+	res := &responses.GetProductsResponse{}
+	query := map[string]string{"product_type": ProductTypeSpot}
+	if err := o.client.Do(ctx, http.MethodGet, getProductsEndpoint, getProductsEndpoint, false, res, query, nil); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (o *MarketClient) GetProduct(ctx context.Context, productID string) (*responses.Product, error) {
+	res := &responses.Product{}
+	path := getProductsEndpoint + "/" + productID
+	if err := o.client.Do(ctx, http.MethodGet, getProductEndpoint, path, false, res, nil, nil); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (o *MarketClient) GetProductBook(ctx context.Context, productID string) (*responses.GetProductBookResponse, error) {
+	res := &responses.GetProductBookResponse{}
+	query := map[string]string{
+		"product_id": productID,
+		"limit":      "1",
+	}
+	if err := o.client.Do(ctx, http.MethodGet, getProductBookEndpoint, getProductBookEndpoint, false, res, query, nil); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (o *MarketClient) GetCryptoCurrencies(ctx context.Context) (*responses.GetCryptoCurrenciesResponse, error) {
+	res := &responses.GetCryptoCurrenciesResponse{}
+	if err := o.client.Do(ctx, http.MethodGet, getCryptoCurrenciesEndpoint, getCryptoCurrenciesEndpoint, false, res, nil, nil); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
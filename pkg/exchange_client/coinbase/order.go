@@ -0,0 +1,77 @@
+package coinbase
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/ulule/limiter/v3"
+
+	"github.com/dv-net/dv-merchant/pkg/exchange_client/coinbase/requests"
+	"github.com/dv-net/dv-merchant/pkg/exchange_client/coinbase/responses"
+)
+
+const (
+	createOrderEndpoint  = "/api/v3/brokerage/orders"
+	getOrderEndpoint     = "/api/v3/brokerage/orders/historical/{order_id}"
+	cancelOrdersEndpoint = "/api/v3/brokerage/orders/batch_cancel"
+
+	OrderSideBuy  = "BUY"
+	OrderSideSell = "SELL"
+)
+
+type ICoinbaseOrder interface {
+	CreateOrder(ctx context.Context, req *requests.CreateOrderRequest) (*responses.OrderSuccessResponse, error)
+	GetOrder(ctx context.Context, orderID string) (*responses.Order, error)
+	CancelOrders(ctx context.Context, req *requests.CancelOrdersRequest) (*responses.CancelOrdersResponse, error)
+}
+
+var _ ICoinbaseOrder = (*OrderClient)(nil)
+
+type OrderClient struct {
+	client *Client
+}
+
+func NewOrderClient(opt *ClientOptions, store limiter.Store, opts ...ClientOption) *OrderClient {
+	order := &OrderClient{
+		client: NewClient(opt, store, opts...),
+	}
+	order.initLimiters()
+	return order
+}
+
+func (o *OrderClient) initLimiters() {
+	o.client.limiters = map[string]*limiter.Limiter{
+		createOrderEndpoint:  limiter.New(o.client.store, limiter.Rate{Limit: 10, Period: time.Second}),
+		getOrderEndpoint:     limiter.New(o.client.store, limiter.Rate{Limit: 10, Period: time.Second}),
+		cancelOrdersEndpoint: limiter.New(o.client.store, limiter.Rate{Limit: 10, Period: time.Second}),
+	}
+}
+
+func (o *OrderClient) CreateOrder(ctx context.Context, req *requests.CreateOrderRequest) (*responses.OrderSuccessResponse, error) {
+	res := &responses.CreateOrderResponse{}
+	if err := o.client.Do(ctx, http.MethodPost, createOrderEndpoint, createOrderEndpoint, true, res, nil, req); err != nil {
+		return nil, err
+	}
+	if !res.Success {
+		return nil, errorFromOrderFailure(&res.ErrorResponse)
+	}
+	return &res.SuccessResponse, nil
+}
+
+func (o *OrderClient) GetOrder(ctx context.Context, orderID string) (*responses.Order, error) {
+	res := &responses.GetOrderResponse{}
+	path := "/api/v3/brokerage/orders/historical/" + orderID
+	if err := o.client.Do(ctx, http.MethodGet, getOrderEndpoint, path, true, res, nil, nil); err != nil {
+		return nil, err
+	}
+	return &res.Order, nil
+}
+
+func (o *OrderClient) CancelOrders(ctx context.Context, req *requests.CancelOrdersRequest) (*responses.CancelOrdersResponse, error) {
+	res := &responses.CancelOrdersResponse{}
+	if err := o.client.Do(ctx, http.MethodPost, cancelOrdersEndpoint, cancelOrdersEndpoint, true, res, nil, req); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
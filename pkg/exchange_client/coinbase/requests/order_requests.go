@@ -0,0 +1,31 @@
+//nolint:tagliatelle
+package requests
+
+type MarketIOC struct {
+	QuoteSize string `json:"quote_size,omitempty"`
+	BaseSize  string `json:"base_size,omitempty"`
+}
+
+type LimitOrder struct {
+	BaseSize   string `json:"base_size"`
+	LimitPrice string `json:"limit_price"`
+	PostOnly   *bool  `json:"post_only,omitempty"`
+}
+
+type OrderConfiguration struct {
+	MarketMarketIOC *MarketIOC  `json:"market_market_ioc,omitempty"`
+	LimitLimitGTC   *LimitOrder `json:"limit_limit_gtc,omitempty"`
+	SorLimitIOC     *LimitOrder `json:"sor_limit_ioc,omitempty"`
+	LimitLimitFOK   *LimitOrder `json:"limit_limit_fok,omitempty"`
+}
+
+type CreateOrderRequest struct {
+	ClientOrderID      string             `json:"client_order_id"`
+	ProductID          string             `json:"product_id"`
+	Side               string             `json:"side"`
+	OrderConfiguration OrderConfiguration `json:"order_configuration"`
+}
+
+type CancelOrdersRequest struct {
+	OrderIDs []string `json:"order_ids"`
+}
@@ -0,0 +1,16 @@
+package requests
+
+type CreateAddressRequest struct {
+	Name    string `json:"name,omitempty"`
+	Network string `json:"network,omitempty"`
+}
+
+type SendMoneyRequest struct {
+	Type           string `json:"type"`
+	To             string `json:"to"`
+	Amount         string `json:"amount"`
+	Currency       string `json:"currency"`
+	Network        string `json:"network,omitempty"`
+	DestinationTag string `json:"destination_tag,omitempty"`
+	Idem           string `json:"idem,omitempty"`
+}
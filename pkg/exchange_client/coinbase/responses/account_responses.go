@@ -0,0 +1,35 @@
+//nolint:tagliatelle
+package responses
+
+import "github.com/shopspring/decimal"
+
+type Balance struct {
+	Value    decimal.Decimal `json:"value"`
+	Currency string          `json:"currency"`
+}
+
+type Account struct {
+	UUID             string  `json:"uuid"`
+	Name             string  `json:"name"`
+	Currency         string  `json:"currency"`
+	AvailableBalance Balance `json:"available_balance"`
+	Hold             Balance `json:"hold"`
+	Active           bool    `json:"active"`
+	Type             string  `json:"type"`
+	Ready            bool    `json:"ready"`
+}
+
+type GetAccountsResponse struct {
+	Accounts []Account `json:"accounts"`
+	HasNext  bool      `json:"has_next"`
+	Cursor   string    `json:"cursor"`
+	Size     int       `json:"size"`
+}
+
+type GetKeyPermissionsResponse struct {
+	CanView       bool   `json:"can_view"`
+	CanTrade      bool   `json:"can_trade"`
+	CanTransfer   bool   `json:"can_transfer"`
+	PortfolioUUID string `json:"portfolio_uuid"`
+	PortfolioType string `json:"portfolio_type"`
+}
@@ -0,0 +1,47 @@
+//nolint:tagliatelle
+package responses
+
+import "github.com/shopspring/decimal"
+
+type Product struct {
+	ProductID       string          `json:"product_id"`
+	Price           decimal.Decimal `json:"price"`
+	BaseCurrencyID  string          `json:"base_currency_id"`
+	QuoteCurrencyID string          `json:"quote_currency_id"`
+	BaseIncrement   decimal.Decimal `json:"base_increment"`
+	QuoteIncrement  decimal.Decimal `json:"quote_increment"`
+	PriceIncrement  decimal.Decimal `json:"price_increment"`
+	BaseMinSize     decimal.Decimal `json:"base_min_size"`
+	BaseMaxSize     decimal.Decimal `json:"base_max_size"`
+	QuoteMinSize    decimal.Decimal `json:"quote_min_size"`
+	QuoteMaxSize    decimal.Decimal `json:"quote_max_size"`
+	Status          string          `json:"status"`
+	ProductType     string          `json:"product_type"`
+	TradingDisabled bool            `json:"trading_disabled"`
+	IsDisabled      bool            `json:"is_disabled"`
+	CancelOnly      bool            `json:"cancel_only"`
+	LimitOnly       bool            `json:"limit_only"`
+	PostOnly        bool            `json:"post_only"`
+	ViewOnly        bool            `json:"view_only"`
+	AuctionMode     bool            `json:"auction_mode"`
+}
+
+type GetProductsResponse struct {
+	Products    []Product `json:"products"`
+	NumProducts int       `json:"num_products"`
+}
+
+type PriceLevel struct {
+	Price decimal.Decimal `json:"price"`
+	Size  decimal.Decimal `json:"size"`
+}
+
+type PriceBook struct {
+	ProductID string       `json:"product_id"`
+	Bids      []PriceLevel `json:"bids"`
+	Asks      []PriceLevel `json:"asks"`
+}
+
+type GetProductBookResponse struct {
+	PriceBook PriceBook `json:"pricebook"`
+}
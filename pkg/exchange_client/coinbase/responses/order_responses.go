@@ -0,0 +1,62 @@
+//nolint:tagliatelle
+package responses
+
+import "github.com/shopspring/decimal"
+
+const (
+	OrderStatusPending      = "PENDING"
+	OrderStatusQueued       = "QUEUED"
+	OrderStatusOpen         = "OPEN"
+	OrderStatusFilled       = "FILLED"
+	OrderStatusCancelled    = "CANCELLED"
+	OrderStatusCancelQueued = "CANCEL_QUEUED"
+	OrderStatusExpired      = "EXPIRED"
+	OrderStatusFailed       = "FAILED"
+)
+
+type OrderSuccessResponse struct {
+	OrderID       string `json:"order_id"`
+	ProductID     string `json:"product_id"`
+	Side          string `json:"side"`
+	ClientOrderID string `json:"client_order_id"`
+}
+
+type OrderErrorResponse struct {
+	Error                 string `json:"error"`
+	Message               string `json:"message"`
+	ErrorDetails          string `json:"error_details"`
+	PreviewFailureReason  string `json:"preview_failure_reason"`
+	NewOrderFailureReason string `json:"new_order_failure_reason"`
+}
+
+type CreateOrderResponse struct {
+	Success         bool                 `json:"success"`
+	SuccessResponse OrderSuccessResponse `json:"success_response"`
+	ErrorResponse   OrderErrorResponse   `json:"error_response"`
+}
+
+type Order struct {
+	OrderID            string          `json:"order_id"`
+	ProductID          string          `json:"product_id"`
+	ClientOrderID      string          `json:"client_order_id"`
+	Side               string          `json:"side"`
+	Status             string          `json:"status"`
+	FilledSize         decimal.Decimal `json:"filled_size"`
+	FilledValue        decimal.Decimal `json:"filled_value"`
+	AverageFilledPrice decimal.Decimal `json:"average_filled_price"`
+	TotalFees          decimal.Decimal `json:"total_fees"`
+}
+
+type GetOrderResponse struct {
+	Order Order `json:"order"`
+}
+
+type CancelResult struct {
+	Success       bool   `json:"success"`
+	FailureReason string `json:"failure_reason"`
+	OrderID       string `json:"order_id"`
+}
+
+type CancelOrdersResponse struct {
+	Results []CancelResult `json:"results"`
+}
@@ -0,0 +1,69 @@
+//nolint:tagliatelle
+package responses
+
+import "github.com/shopspring/decimal"
+
+type AddressInfo struct {
+	Address        string `json:"address"`
+	DestinationTag string `json:"destination_tag"`
+}
+
+type Address struct {
+	ID          string      `json:"id"`
+	Address     string      `json:"address"`
+	Name        string      `json:"name"`
+	Network     string      `json:"network"`
+	AddressInfo AddressInfo `json:"address_info"`
+}
+
+type ListAddressesResponse struct {
+	Data []Address `json:"data"`
+}
+
+type CreateAddressResponse struct {
+	Data Address `json:"data"`
+}
+
+const (
+	TransactionStatusPending             = "pending"
+	TransactionStatusCompleted           = "completed"
+	TransactionStatusFailed              = "failed"
+	TransactionStatusExpired             = "expired"
+	TransactionStatusCanceled            = "canceled"
+	TransactionStatusWaitingForSignature = "waiting_for_signature"
+	TransactionStatusWaitingForClearing  = "waiting_for_clearing"
+)
+
+type Amount struct {
+	Amount   decimal.Decimal `json:"amount"`
+	Currency string          `json:"currency"`
+}
+
+type TransactionNetwork struct {
+	Status string `json:"status"`
+	Hash   string `json:"hash"`
+	Name   string `json:"name"`
+}
+
+type Transaction struct {
+	ID      string             `json:"id"`
+	Type    string             `json:"type"`
+	Status  string             `json:"status"`
+	Amount  Amount             `json:"amount"`
+	Network TransactionNetwork `json:"network"`
+}
+
+type TransactionResponse struct {
+	Data Transaction `json:"data"`
+}
+
+type CryptoCurrency struct {
+	Code     string `json:"code"`
+	Name     string `json:"name"`
+	Exponent int    `json:"exponent"`
+	Type     string `json:"type"`
+}
+
+type GetCryptoCurrenciesResponse struct {
+	Data []CryptoCurrency `json:"data"`
+}
@@ -0,0 +1,133 @@
+package coinbase
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+)
+
+const jwtLifetime = 2 * time.Minute
+
+var ErrInvalidPrivateKey = errors.New("coinbase: api secret is not an EC private key")
+
+type ISigner interface {
+	SignRequest(ctx context.Context, req *http.Request) (*http.Request, error)
+}
+
+// Signer issues CDP bearer tokens, the key name is organizations/{org}/apiKeys/{id}
+// and the secret is PEM encoded EC private key as shown on key creation
+type Signer struct {
+	keyName   string
+	secretKey string
+}
+
+func NewSigner(keyName, secretKey string) ISigner {
+	return &Signer{
+		keyName:   keyName,
+		secretKey: secretKey,
+	}
+}
+
+func (s *Signer) SignRequest(_ context.Context, req *http.Request) (*http.Request, error) {
+	key, err := ParsePrivateKey(s.secretKey)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := BuildJWT(key, s.keyName, req.Method+" "+req.URL.Host+req.URL.Path, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	return req, nil
+}
+
+// ParsePrivateKey accepts SEC1 and PKCS8 PEM blocks, escaped newlines are allowed
+// since the key is often pasted from a single line JSON
+func ParsePrivateKey(secret string) (*ecdsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(strings.ReplaceAll(secret, `\n`, "\n")))
+	if block == nil {
+		return nil, ErrInvalidPrivateKey
+	}
+
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPrivateKey, err)
+	}
+	key, ok := parsed.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, ErrInvalidPrivateKey
+	}
+
+	return key, nil
+}
+
+type jwtHeader struct {
+	Alg   string `json:"alg"`
+	Kid   string `json:"kid"`
+	Nonce string `json:"nonce"`
+	Typ   string `json:"typ"`
+}
+
+type jwtClaims struct {
+	Sub string `json:"sub"`
+	Iss string `json:"iss"`
+	Nbf int64  `json:"nbf"`
+	Exp int64  `json:"exp"`
+	URI string `json:"uri"`
+}
+
+// BuildJWT signs ES256 token bound to a single request uri, e.g. "GET api.coinbase.com/api/v3/brokerage/accounts"
+func BuildJWT(key *ecdsa.PrivateKey, keyName, uri string, now time.Time) (string, error) {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	header, err := json.Marshal(jwtHeader{Alg: "ES256", Kid: keyName, Nonce: hex.EncodeToString(nonce), Typ: "JWT"})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(jwtClaims{
+		Sub: keyName,
+		Iss: "cdp",
+		Nbf: now.Unix(),
+		Exp: now.Add(jwtLifetime).Unix(),
+		URI: uri,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(signingInput))
+
+	r, sig, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	if err != nil {
+		return "", err
+	}
+
+	// JWS carries fixed size big endian R || S instead of ASN.1
+	signature := make([]byte, 64)
+	r.FillBytes(signature[:32])
+	sig.FillBytes(signature[32:])
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
@@ -0,0 +1,86 @@
+package coinbase
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/ulule/limiter/v3"
+
+	"github.com/dv-net/dv-merchant/pkg/exchange_client/coinbase/requests"
+	"github.com/dv-net/dv-merchant/pkg/exchange_client/coinbase/responses"
+)
+
+const (
+	addressesEndpoint      = "/v2/accounts/{account_id}/addresses"
+	transactionsEndpoint   = "/v2/accounts/{account_id}/transactions"
+	getTransactionEndpoint = "/v2/accounts/{account_id}/transactions/{transaction_id}"
+
+	TransactionTypeSend = "send"
+)
+
+type ICoinbaseWallet interface {
+	ListAddresses(ctx context.Context, accountID string) (*responses.ListAddressesResponse, error)
+	CreateAddress(ctx context.Context, accountID string, req *requests.CreateAddressRequest) (*responses.Address, error)
+	SendMoney(ctx context.Context, accountID string, req *requests.SendMoneyRequest) (*responses.Transaction, error)
+	GetTransaction(ctx context.Context, accountID, transactionID string) (*responses.Transaction, error)
+}
+
+var _ ICoinbaseWallet = (*WalletClient)(nil)
+
+// WalletClient covers deposits and withdrawals, they are only available in the v2 api
+type WalletClient struct {
+	client *Client
+}
+
+func NewWalletClient(opt *ClientOptions, store limiter.Store, opts ...ClientOption) *WalletClient {
+	wallet := &WalletClient{
+		client: NewClient(opt, store, opts...),
+	}
+	wallet.initLimiters()
+	return wallet
+}
+
+func (o *WalletClient) initLimiters() {
+	o.client.limiters = map[string]*limiter.Limiter{
+		addressesEndpoint:      limiter.New(o.client.store, limiter.Rate{Limit: 10, Period: time.Second}),
+		transactionsEndpoint:   limiter.New(o.client.store, limiter.Rate{Limit: 5, Period: time.Second}),
+		getTransactionEndpoint: limiter.New(o.client.store, limiter.Rate{Limit: 10, Period: time.Second}),
+	}
+}
+
+func (o *WalletClient) ListAddresses(ctx context.Context, accountID string) (*responses.ListAddressesResponse, error) {
+	res := &responses.ListAddressesResponse{}
+	path := "/v2/accounts/" + accountID + "/addresses"
+	if err := o.client.Do(ctx, http.MethodGet, addressesEndpoint, path, true, res, nil, nil); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (o *WalletClient) CreateAddress(ctx context.Context, accountID string, req *requests.CreateAddressRequest) (*responses.Address, error) {
+	res := &responses.CreateAddressResponse{}
+	path := "/v2/accounts/" + accountID + "/addresses"
+	if err := o.client.Do(ctx, http.MethodPost, addressesEndpoint, path, true, res, nil, req); err != nil {
+		return nil, err
+	}
+	return &res.Data, nil
+}
+
+func (o *WalletClient) SendMoney(ctx context.Context, accountID string, req *requests.SendMoneyRequest) (*responses.Transaction, error) {
+	res := &responses.TransactionResponse{}
+	path := "/v2/accounts/" + accountID + "/transactions"
+	if err := o.client.Do(ctx, http.MethodPost, transactionsEndpoint, path, true, res, nil, req); err != nil {
+		return nil, err
+	}
+	return &res.Data, nil
+}
+
+func (o *WalletClient) GetTransaction(ctx context.Context, accountID, transactionID string) (*responses.Transaction, error) {
+	res := &responses.TransactionResponse{}
+	path := "/v2/accounts/" + accountID + "/transactions/" + transactionID
+	if err := o.client.Do(ctx, http.MethodGet, getTransactionEndpoint, path, true, res, nil, nil); err != nil {
+		return nil, err
+	}
+	return &res.Data, nil
+}
@@ -43,6 +43,8 @@ type CurrencyPair struct {
 type TickerInfo struct {
 	CurrencyPair string          `json:"currency_pair"`
 	Last         decimal.Decimal `json:"last"`
+	LowestAsk    decimal.Decimal `json:"lowest_ask"`
+	HighestBid   decimal.Decimal `json:"highest_bid"`
 }
 
 type DepositAddress struct {
@@ -210,6 +212,8 @@ type SpotOrder struct {
 	Account      string          `json:"account"`
 	Side         OrderSide       `json:"side"`
 	Amount       string          `json:"amount"`
+	Price        string          `json:"price,omitempty"`
+	FilledAmount string          `json:"filled_amount,omitempty"`
 	Fee          string          `json:"fee"`
 	FeeCurrency  string          `json:"fee_currency"`
 	FinishAs     OrderFinishedAs `json:"finish_as"`
@@ -38,9 +38,14 @@ type CreateSpotOrderRequest struct {
 	Type         string `json:"type"`   // "limit", "market", etc.
 	Side         string `json:"side"`   // "buy" or "sell"
 	Amount       string `json:"amount"` // Amount to buy/sell
+	Price        string `json:"price,omitempty"`
 	TimeInForce  string `json:"time_in_force"`
 }
 
+type CancelSpotOrderRequest struct {
+	CurrencyPair string `json:"currency_pair" url:"currency_pair"`
+}
+
 type CreateWithdrawalRequest struct {
 	Amount   string `json:"amount"`
 	Currency string `json:"currency"`
@@ -52,6 +52,10 @@ type GetSpotOrderResponse struct {
 	Data *SpotOrder `json:"data"`
 }
 
+type CancelSpotOrderResponse struct {
+	Data *SpotOrder `json:"data"`
+}
+
 type CreateWithdrawalResponse struct {
 	Data *Withdrawal `json:"data"`
 }
@@ -18,6 +18,7 @@ const (
 	getSpotAccountBalancesEndpoint    = "/api/v4/spot/accounts"
 	createSpotOrderEndpoint           = "/api/v4/spot/orders"
 	getSpotOrderEndpoint              = "/api/v4/spot/orders/%s"
+	cancelSpotOrderEndpoint           = "/api/v4/spot/orders/%s"
 )
 
 var _ IGateSpot = (*SpotClient)(nil)
@@ -31,6 +32,7 @@ type IGateSpot interface {
 	GetSpotAccountBalances(ctx context.Context, dto *GetSpotAccountBalancesRequest) (*GetSpotAccountBalancesResponse, error)
 	CreateSpotOrder(ctx context.Context, dto *CreateSpotOrderRequest) (*CreateSpotOrderResponse, error)
 	GetSpotOrder(ctx context.Context, orderID string) (*GetSpotOrderResponse, error)
+	CancelSpotOrder(ctx context.Context, orderID string, dto *CancelSpotOrderRequest) (*CancelSpotOrderResponse, error)
 }
 
 type SpotClient struct {
@@ -136,3 +138,14 @@ func (o *SpotClient) GetSpotOrder(ctx context.Context, orderID string) (*GetSpot
 	}
 	return response, nil
 }
+
+func (o *SpotClient) CancelSpotOrder(ctx context.Context, orderID string, dto *CancelSpotOrderRequest) (*CancelSpotOrderResponse, error) {
+	p := fmt.Sprintf(cancelSpotOrderEndpoint, orderID)
+	response := &CancelSpotOrderResponse{}
+	m := S2M(dto)
+	err := o.client.Do(ctx, http.MethodDelete, p, true, &response.Data, m)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
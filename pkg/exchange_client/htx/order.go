@@ -17,6 +17,7 @@ const (
 	getOrderDetailsByClientIDEndpoint = "/v1/order/orders/getClientOrder"
 	getOrderHistoryEndpoint           = "/v1/order/orders"
 	postOrderPlaceEndpoint            = "/v1/order/orders/place"
+	postOrderCancelEndpoint           = "/v1/order/orders/%d/submitcancel"
 )
 
 type IHTXOrder interface {
@@ -24,6 +25,7 @@ type IHTXOrder interface {
 	GetOrderDetailsByClientID(ctx context.Context, dto *htxrequests.GetOrderByClientIDRequest) (*htxresponses.GetOrder, error)
 	GetOrdersHistory(ctx context.Context, dto *htxrequests.GetOrderHistoryRequest) (*htxresponses.GetOrdersHistory, error)
 	PlaceOrder(ctx context.Context, dto *htxrequests.PlaceOrderRequest) (*htxresponses.PlaceOrder, error)
+	CancelOrder(ctx context.Context, orderID int64) (*htxresponses.CancelOrder, error)
 }
 
 type OrderClient struct {
@@ -36,6 +38,7 @@ func (o *OrderClient) initLimiters() {
 		getOrderDetailsByClientIDEndpoint: limiter.New(o.client.store, limiter.Rate{Limit: 50, Period: 2 * time.Second}),
 		getOrderHistoryEndpoint:           limiter.New(o.client.store, limiter.Rate{Limit: 50, Period: 2 * time.Second}),
 		postOrderPlaceEndpoint:            limiter.New(o.client.store, limiter.Rate{Limit: 100, Period: 2 * time.Second}),
+		postOrderCancelEndpoint:           limiter.New(o.client.store, limiter.Rate{Limit: 100, Period: 2 * time.Second}),
 	}
 }
 
@@ -82,6 +85,16 @@ func (o *OrderClient) PlaceOrder(ctx context.Context, dto *htxrequests.PlaceOrde
 	return response, nil
 }
 
+func (o *OrderClient) CancelOrder(ctx context.Context, orderID int64) (*htxresponses.CancelOrder, error) {
+	response := &htxresponses.CancelOrder{}
+	p := fmt.Sprintf(postOrderCancelEndpoint, orderID)
+	err := o.client.Do(ctx, http.MethodPost, p, true, &response, nil)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func NewOrderClient(opt *ClientOptions, store limiter.Store, opts ...ClientOption) *OrderClient {
 	order := &OrderClient{
 		client: NewClient(opt, store, opts...),
@@ -3,5 +3,5 @@ package requests
 
 type GetAPIKeyInformationRequest struct {
 	UID       string `json:"uid" url:"uid"`
-	AccessKey string `json:"access-key,omitempty" url:"access-key,omitempty"`
+	AccessKey string `json:"accessKey,omitempty" url:"accessKey,omitempty"`
 }
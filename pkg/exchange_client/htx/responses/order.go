@@ -18,4 +18,8 @@ type (
 		Basic
 		OrderID string `json:"data,omitempty"`
 	}
+	CancelOrder struct {
+		Basic
+		OrderID string `json:"data,omitempty"`
+	}
 )
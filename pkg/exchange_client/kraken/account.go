@@ -0,0 +1,45 @@
+package kraken
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/ulule/limiter/v3"
+
+	"github.com/dv-net/dv-merchant/pkg/exchange_client/kraken/responses"
+)
+
+const getBalanceExEndpoint = "/0/private/BalanceEx"
+
+type IKrakenAccount interface {
+	GetBalanceEx(ctx context.Context) (responses.GetBalanceExResponse, error)
+}
+
+var _ IKrakenAccount = (*AccountClient)(nil)
+
+type AccountClient struct {
+	client *Client
+}
+
+func NewAccountClient(opt *ClientOptions, store limiter.Store, opts ...ClientOption) *AccountClient {
+	account := &AccountClient{
+		client: NewClient(opt, store, opts...),
+	}
+	account.initLimiters()
+	return account
+}
+
+func (o *AccountClient) initLimiters() {
+	o.client.limiters = map[string]*limiter.Limiter{
+		getBalanceExEndpoint: limiter.New(o.client.store, limiter.Rate{Limit: 1, Period: time.Second}),
+	}
+}
+
+func (o *AccountClient) GetBalanceEx(ctx context.Context) (responses.GetBalanceExResponse, error) {
+	res := responses.GetBalanceExResponse{}
+	if err := o.client.Do(ctx, http.MethodPost, getBalanceExEndpoint, true, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
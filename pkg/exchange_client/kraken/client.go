@@ -0,0 +1,240 @@
+package kraken
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-json"
+	"github.com/ulule/limiter/v3"
+
+	"github.com/dv-net/dv-merchant/pkg/exchange_client/utils"
+	"github.com/dv-net/dv-merchant/pkg/logger"
+)
+
+type IKrakenClient interface {
+	Account() IKrakenAccount
+	Funding() IKrakenFunding
+	Market() IKrakenMarket
+	Trade() IKrakenTrade
+}
+
+func NewBaseClient(opt *ClientOptions, store limiter.Store, opts ...ClientOption) (*BaseClient, error) {
+	return &BaseClient{
+		accountClient: NewAccountClient(opt, store, opts...),
+		fundingClient: NewFundingClient(opt, store, opts...),
+		marketClient:  NewMarketClient(opt, store, opts...),
+		tradeClient:   NewTradeClient(opt, store, opts...),
+	}, nil
+}
+
+type BaseClient struct {
+	accountClient IKrakenAccount
+	fundingClient IKrakenFunding
+	marketClient  IKrakenMarket
+	tradeClient   IKrakenTrade
+}
+
+func (o *BaseClient) Account() IKrakenAccount { return o.accountClient }
+func (o *BaseClient) Funding() IKrakenFunding { return o.fundingClient }
+func (o *BaseClient) Market() IKrakenMarket   { return o.marketClient }
+func (o *BaseClient) Trade() IKrakenTrade     { return o.tradeClient }
+
+type ClientOption func(c *Client)
+
+func WithLogger(log logger.Logger) ClientOption {
+	return func(c *Client) {
+		c.log = log
+	}
+}
+
+type ClientOptions struct {
+	APIKey    string
+	SecretKey string
+	BaseURL   *url.URL
+}
+
+func NewClient(opt *ClientOptions, store limiter.Store, opts ...ClientOption) *Client {
+	c := &Client{
+		apiKey:     opt.APIKey,
+		secretKey:  opt.SecretKey,
+		baseURL:    opt.BaseURL,
+		httpClient: http.DefaultClient,
+		signer:     NewSigner(opt.APIKey, opt.SecretKey),
+		store:      store,
+	}
+	for _, o := range opts {
+		o(c)
+	}
+	return c
+}
+
+type Client struct {
+	apiKey     string
+	secretKey  string
+	baseURL    *url.URL
+	httpClient *http.Client
+	store      limiter.Store
+	limiters   map[string]*limiter.Limiter
+	signer     ISigner
+	log        logger.Logger
+}
+
+func (o *Client) Do(ctx context.Context, method, endpoint string, private bool, dest interface{}, params ...map[string]string) error {
+	if l, exists := o.limiters[endpoint]; exists {
+		for {
+			r, err := l.Get(ctx, utils.HashLimiterKey(endpoint, o.apiKey, o.secretKey))
+			if err != nil {
+				return err
+			}
+			if !r.Reached {
+				break
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Until(time.Unix(r.Reset, 0).Add(time.Second))):
+			}
+		}
+	}
+	return o.DoPlain(ctx, method, endpoint, private, dest, params...)
+}
+
+// DoPlain sends public calls as query string, private calls are form encoded
+// POST requests carrying the nonce, kraken answers 200 with an error list on failures
+func (o *Client) DoPlain(ctx context.Context, method, endpoint string, private bool, dest interface{}, params ...map[string]string) error {
+	startTime := time.Now()
+
+	if o.log != nil {
+		o.log.Debugln(
+			"[EXCHANGE-API]: Preparing request",
+			"exchange", "kraken",
+			"method", method,
+			"endpoint", endpoint,
+			"private", private,
+		)
+	}
+
+	values := url.Values{}
+	if len(params) > 0 {
+		for k, v := range params[0] {
+			values.Set(k, strings.ReplaceAll(v, "\"", ""))
+		}
+	}
+
+	var (
+		req  *http.Request
+		err  error
+		body string
+	)
+	if private {
+		nonce := nextNonce()
+		values.Set("nonce", nonce)
+		body = values.Encode()
+
+		req, err = http.NewRequestWithContext(ctx, method, o.baseURL.String()+endpoint, strings.NewReader(body))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		if req, err = o.signer.SignRequest(ctx, req, nonce, body); err != nil {
+			return err
+		}
+	} else {
+		req, err = http.NewRequestWithContext(ctx, method, o.baseURL.String()+endpoint, http.NoBody)
+		if err != nil {
+			return err
+		}
+		req.URL.RawQuery = values.Encode()
+	}
+	req.Header.Set("Accept", "application/json")
+
+	if o.log != nil {
+		o.log.Debugln(
+			"[EXCHANGE-API]: Sending request",
+			"exchange", "kraken",
+			"method", method,
+			"url", o.baseURL.String()+endpoint,
+			"query", sanitizeBody(req.URL.RawQuery),
+			"body", sanitizeBody(body),
+			"headers", sanitizeHeaders(req.Header),
+		)
+	}
+
+	res, err := o.httpClient.Do(req)
+	if err != nil {
+		if o.log != nil {
+			o.log.Errorln(
+				"[EXCHANGE-API]: Request failed",
+				"exchange", "kraken",
+				"method", method,
+				"endpoint", endpoint,
+				"error", err.Error(),
+				"duration_ms", time.Since(startTime).Milliseconds(),
+			)
+		}
+		return err
+	}
+	defer res.Body.Close()
+
+	bb := new(bytes.Buffer)
+	if _, err = io.Copy(bb, res.Body); err != nil {
+		return err
+	}
+
+	duration := time.Since(startTime)
+
+	envelope := Response{}
+	if err = json.Unmarshal(bb.Bytes(), &envelope); err != nil || res.StatusCode >= 400 {
+		apiErr := errorFromStatus(res.StatusCode, bb.String())
+		if len(envelope.Error) > 0 {
+			apiErr = errorFromResponse(envelope.Error)
+		}
+		if o.log != nil {
+			o.log.Errorln(
+				"[EXCHANGE-API]: API error response",
+				"exchange", "kraken",
+				"method", method,
+				"endpoint", endpoint,
+				"status_code", res.StatusCode,
+				"error", apiErr.Error(),
+				"duration_ms", duration.Milliseconds(),
+			)
+		}
+		return apiErr
+	}
+
+	if len(envelope.Error) > 0 {
+		apiErr := errorFromResponse(envelope.Error)
+		if o.log != nil {
+			o.log.Errorln(
+				"[EXCHANGE-API]: API error response",
+				"exchange", "kraken",
+				"method", method,
+				"endpoint", endpoint,
+				"status_code", res.StatusCode,
+				"error", apiErr.Error(),
+				"duration_ms", duration.Milliseconds(),
+			)
+		}
+		return apiErr
+	}
+
+	if o.log != nil {
+		o.log.Debugln(
+			"[EXCHANGE-API]: Request completed",
+			"exchange", "kraken",
+			"method", method,
+			"endpoint", endpoint,
+			"status_code", res.StatusCode,
+			"duration_ms", duration.Milliseconds(),
+		)
+	}
+
+	return json.Unmarshal(envelope.Result, dest)
+}
@@ -0,0 +1,60 @@
+package kraken_test
+
+import (
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ulule/limiter/v3/drivers/store/memory"
+
+	"github.com/dv-net/dv-merchant/pkg/exchange_client/kraken"
+)
+
+const (
+	testAPIKey    = ""
+	testSecretKey = ""
+)
+
+func Test_BaseClient(t *testing.T) {
+	if os.Getenv("CI") == "true" {
+		t.Skip("Skipping Kraken tests in CI environment")
+	}
+	if testAPIKey == "" || testSecretKey == "" {
+		t.Skip("testAPIKey/testSecretKey are not set")
+	}
+
+	bURL, err := url.Parse("https://api.kraken.com")
+	require.NoError(t, err)
+
+	client, err := kraken.NewBaseClient(&kraken.ClientOptions{
+		APIKey:    testAPIKey,
+		SecretKey: testSecretKey,
+		BaseURL:   bURL,
+	}, memory.NewStore())
+	require.NoError(t, err)
+	require.NotNil(t, client)
+
+	t.Run("AccountClient", func(t *testing.T) {
+		accountClient := client.Account()
+		require.NotNil(t, accountClient)
+
+		t.Run("GetBalanceEx", func(t *testing.T) {
+			balances, err := accountClient.GetBalanceEx(t.Context())
+			require.NoError(t, err)
+
+			for asset, balance := range balances {
+				if balance.Balance.IsZero() {
+					continue
+				}
+				t.Logf("asset=%s balance=%s hold=%s", asset, balance.Balance.String(), balance.HoldTrade.String())
+			}
+		})
+	})
+
+	t.Run("MarketClient", func(t *testing.T) {
+		pairs, err := client.Market().GetAssetPairs(t.Context(), "XBTUSDT")
+		require.NoError(t, err)
+		require.NotEmpty(t, pairs)
+	})
+}
@@ -0,0 +1,136 @@
+package kraken
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/goccy/go-json"
+
+	exchangeclient "github.com/dv-net/dv-merchant/pkg/exchange_client"
+)
+
+// Response wraps every kraken answer, errors are reported as "<severity><category>:<message>"
+type Response struct {
+	Error  []string        `json:"error"`
+	Result json.RawMessage `json:"result"`
+}
+
+var ErrDepositAddressLimit = errors.New("kraken: deposit address limit reached")
+
+func errorFromResponse(messages []string) error {
+	for _, msg := range messages {
+		if sentinel := sentinelByMessage(msg); sentinel != nil {
+			return fmt.Errorf("kraken error: %s: %w", strings.Join(messages, "; "), sentinel)
+		}
+	}
+
+	return fmt.Errorf("kraken error: %s", strings.Join(messages, "; "))
+}
+
+func errorFromStatus(status int, body string) error {
+	if sentinel := sentinelByStatus(status); sentinel != nil {
+		return fmt.Errorf("kraken error: status %d, body: %s: %w", status, sanitizeBody(body), sentinel)
+	}
+
+	return fmt.Errorf("kraken error: status %d, body: %s", status, sanitizeBody(body))
+}
+
+func sentinelByStatus(status int) error {
+	switch status {
+	case http.StatusTooManyRequests:
+		return exchangeclient.ErrRateLimited
+	case http.StatusUnauthorized:
+		return exchangeclient.ErrInvalidAPICredentials
+	case http.StatusForbidden:
+		return exchangeclient.ErrIncorrectAPIPermissions
+	default:
+		return nil
+	}
+}
+
+var sentinelPrefixes = []struct {
+	prefix   string
+	sentinel error
+}{
+	{"EAPI:Invalid key", exchangeclient.ErrInvalidAPICredentials},
+	{"EAPI:Invalid signature", exchangeclient.ErrInvalidAPICredentials},
+	{"EAPI:Invalid nonce", exchangeclient.ErrInvalidAPICredentials},
+	{"EGeneral:Permission denied", exchangeclient.ErrIncorrectAPIPermissions},
+	{"EAPI:Feature disabled", exchangeclient.ErrIncorrectAPIPermissions},
+	{"EAPI:Rate limit exceeded", exchangeclient.ErrRateLimited},
+	{"EOrder:Rate limit exceeded", exchangeclient.ErrRateLimited},
+	{"EGeneral:Too many requests", exchangeclient.ErrRateLimited},
+	{"EGeneral:Temporary lockout", exchangeclient.ErrRateLimited},
+	{"EService:Busy", exchangeclient.ErrRateLimited},
+	{"EService:Throttled", exchangeclient.ErrRateLimited},
+	{"EOrder:Insufficient funds", exchangeclient.ErrInsufficientBalance},
+	{"EFunding:Insufficient funds", exchangeclient.ErrInsufficientBalance},
+	{"EOrder:Order minimum not met", exchangeclient.ErrMinOrderValue},
+	{"EOrder:Cost minimum not met", exchangeclient.ErrMinOrderValue},
+	{"EService:Market in cancel_only mode", exchangeclient.ErrSymbolTradingHalted},
+	{"EService:Market in post_only mode", exchangeclient.ErrSymbolTradingHalted},
+	{"EService:Market in limit_only mode", exchangeclient.ErrSymbolTradingHalted},
+	{"EService:Unavailable", exchangeclient.ErrSymbolTradingHalted},
+	{"EFunding:Unknown withdraw key", exchangeclient.ErrWithdrawalAddressNotWhitelisted},
+	{"EFunding:Invalid withdraw address", exchangeclient.ErrWithdrawalAddressNotWhitelisted},
+	{"EFunding:Too many addresses", ErrDepositAddressLimit},
+}
+
+func sentinelByMessage(msg string) error {
+	for _, item := range sentinelPrefixes {
+		if strings.HasPrefix(msg, item.prefix) {
+			return item.sentinel
+		}
+	}
+	return nil
+}
+
+func sanitizeHeaders(headers http.Header) map[string]string {
+	sanitized := make(map[string]string)
+	for k, v := range headers {
+		if strings.Contains(strings.ToLower(k), "key") ||
+			strings.Contains(strings.ToLower(k), "sign") {
+			sanitized[k] = "***REDACTED***"
+		} else {
+			sanitized[k] = strings.Join(v, ",")
+		}
+	}
+	return sanitized
+}
+
+// sanitizeBody truncates long request payloads for logging
+func sanitizeBody(body string) string {
+	if len(body) == 0 {
+		return "(empty)"
+	}
+	if len(body) > 500 {
+		return body[:500] + "... (truncated)"
+	}
+	return body
+}
+
+// kraken keeps legacy codes for a few assets, the rest of the system uses common tickers
+var legacyAssets = map[string]string{
+	"XBT": "BTC",
+	"XDG": "DOGE",
+}
+
+// NormalizeAsset converts kraken asset altname into the common ticker
+func NormalizeAsset(altname string) string {
+	if code, ok := legacyAssets[altname]; ok {
+		return code
+	}
+	return altname
+}
+
+// ExchangeAsset converts common ticker into kraken asset altname
+func ExchangeAsset(code string) string {
+	for altname, common := range legacyAssets {
+		if common == code {
+			return altname
+		}
+	}
+	return code
+}
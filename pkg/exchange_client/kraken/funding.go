@@ -0,0 +1,136 @@
+package kraken
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/ulule/limiter/v3"
+
+	"github.com/dv-net/dv-merchant/pkg/exchange_client/kraken/requests"
+	"github.com/dv-net/dv-merchant/pkg/exchange_client/kraken/responses"
+)
+
+const (
+	getDepositMethodsEndpoint    = "/0/private/DepositMethods"
+	getDepositAddressesEndpoint  = "/0/private/DepositAddresses"
+	getWithdrawMethodsEndpoint   = "/0/private/WithdrawMethods"
+	getWithdrawAddressesEndpoint = "/0/private/WithdrawAddresses"
+	withdrawEndpoint             = "/0/private/Withdraw"
+	getWithdrawStatusEndpoint    = "/0/private/WithdrawStatus"
+)
+
+type IKrakenFunding interface {
+	GetDepositMethods(ctx context.Context, req *requests.GetDepositMethodsRequest) (responses.GetDepositMethodsResponse, error)
+	GetDepositAddresses(ctx context.Context, req *requests.GetDepositAddressesRequest) (responses.GetDepositAddressesResponse, error)
+	GetWithdrawMethods(ctx context.Context, req *requests.GetWithdrawMethodsRequest) (responses.GetWithdrawMethodsResponse, error)
+	GetWithdrawAddresses(ctx context.Context, req *requests.GetWithdrawAddressesRequest) (responses.GetWithdrawAddressesResponse, error)
+	Withdraw(ctx context.Context, req *requests.WithdrawRequest) (*responses.WithdrawResponse, error)
+	GetWithdrawStatus(ctx context.Context, req *requests.GetWithdrawStatusRequest) (responses.GetWithdrawStatusResponse, error)
+}
+
+var _ IKrakenFunding = (*FundingClient)(nil)
+
+type FundingClient struct {
+	client *Client
+}
+
+func NewFundingClient(opt *ClientOptions, store limiter.Store, opts ...ClientOption) *FundingClient {
+	funding := &FundingClient{
+		client: NewClient(opt, store, opts...),
+	}
+	funding.initLimiters()
+	return funding
+}
+
+func (o *FundingClient) initLimiters() {
+	o.client.limiters = map[string]*limiter.Limiter{
+		getDepositMethodsEndpoint:    limiter.New(o.client.store, limiter.Rate{Limit: 1, Period: time.Second}),
+		getDepositAddressesEndpoint:  limiter.New(o.client.store, limiter.Rate{Limit: 1, Period: time.Second}),
+		getWithdrawMethodsEndpoint:   limiter.New(o.client.store, limiter.Rate{Limit: 1, Period: time.Second}),
+		getWithdrawAddressesEndpoint: limiter.New(o.client.store, limiter.Rate{Limit: 1, Period: time.Second}),
+		withdrawEndpoint:             limiter.New(o.client.store, limiter.Rate{Limit: 1, Period: time.Second}),
+		getWithdrawStatusEndpoint:    limiter.New(o.client.store, limiter.Rate{Limit: 1, Period: time.Second}),
+	}
+}
+
+func (o *FundingClient) GetDepositMethods(ctx context.Context, req *requests.GetDepositMethodsRequest) (responses.GetDepositMethodsResponse, error) {
+	res := responses.GetDepositMethodsResponse{}
+	if err := o.client.Do(ctx, http.MethodPost, getDepositMethodsEndpoint, true, &res, map[string]string{"asset": req.Asset}); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (o *FundingClient) GetDepositAddresses(ctx context.Context, req *requests.GetDepositAddressesRequest) (responses.GetDepositAddressesResponse, error) {
+	params := map[string]string{
+		"asset":  req.Asset,
+		"method": req.Method,
+	}
+	if req.New {
+		params["new"] = "true"
+	}
+
+	res := responses.GetDepositAddressesResponse{}
+	if err := o.client.Do(ctx, http.MethodPost, getDepositAddressesEndpoint, true, &res, params); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (o *FundingClient) GetWithdrawMethods(ctx context.Context, req *requests.GetWithdrawMethodsRequest) (responses.GetWithdrawMethodsResponse, error) {
+	res := responses.GetWithdrawMethodsResponse{}
+	if err := o.client.Do(ctx, http.MethodPost, getWithdrawMethodsEndpoint, true, &res, map[string]string{"asset": req.Asset}); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (o *FundingClient) GetWithdrawAddresses(ctx context.Context, req *requests.GetWithdrawAddressesRequest) (responses.GetWithdrawAddressesResponse, error) {
+	params := map[string]string{"asset": req.Asset}
+	if req.Method != "" {
+		params["method"] = req.Method
+	}
+	if req.Verified {
+		params["verified"] = "true"
+	}
+
+	res := responses.GetWithdrawAddressesResponse{}
+	if err := o.client.Do(ctx, http.MethodPost, getWithdrawAddressesEndpoint, true, &res, params); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (o *FundingClient) Withdraw(ctx context.Context, req *requests.WithdrawRequest) (*responses.WithdrawResponse, error) {
+	params := map[string]string{
+		"asset":  req.Asset,
+		"key":    req.Key,
+		"amount": req.Amount,
+	}
+	if req.Address != "" {
+		params["address"] = req.Address
+	}
+
+	res := &responses.WithdrawResponse{}
+	if err := o.client.Do(ctx, http.MethodPost, withdrawEndpoint, true, res, params); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (o *FundingClient) GetWithdrawStatus(ctx context.Context, req *requests.GetWithdrawStatusRequest) (responses.GetWithdrawStatusResponse, error) {
+	params := map[string]string{}
+	if req.Asset != "" {
+		params["asset"] = req.Asset
+	}
+	if req.Method != "" {
+		params["method"] = req.Method
+	}
+
+	res := responses.GetWithdrawStatusResponse{}
+	if err := o.client.Do(ctx, http.MethodPost, getWithdrawStatusEndpoint, true, &res, params); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
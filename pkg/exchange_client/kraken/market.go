@@ -0,0 +1,82 @@
+package kraken
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/ulule/limiter/v3"
+
+	"github.com/dv-net/dv-merchant/pkg/exchange_client/kraken/responses"
+)
+
+const (
+	getAssetsEndpoint     = "/0/public/Assets"
+	getAssetPairsEndpoint = "/0/public/AssetPairs"
+	getTickerEndpoint     = "/0/public/Ticker"
+)
+
+const (
+	PairStatusOnline     = "online"
+	PairStatusCancelOnly = "cancel_only"
+	PairStatusPostOnly   = "post_only"
+	PairStatusLimitOnly  = "limit_only"
+	PairStatusReduceOnly = "reduce_only"
+)
+
+type IKrakenMarket interface {
+	GetAssets(ctx context.Context) (responses.GetAssetsResponse, error)
+	GetAssetPairs(ctx context.Context, pair string) (responses.GetAssetPairsResponse, error)
+	GetTicker(ctx context.Context, pair string) (responses.GetTickerResponse, error)
+}
+
+var _ IKrakenMarket = (*MarketClient)(nil)
+
+type MarketClient struct {
+	client *Client
+}
+
+func NewMarketClient(opt *ClientOptions, store limiter.Store, opts ...ClientOption) *MarketClient {
+	market := &MarketClient{
+		client: NewClient(opt, store, opts...),
+	}
+	market.initLimiters()
+	return market
+}
+
+func (o *MarketClient) initLimiters() {
+	o.client.limiters = map[string]*limiter.Limiter{
+		getAssetsEndpoint:     limiter.New(o.client.store, limiter.Rate{Limit: 1, Period: time.Second}),
+		getAssetPairsEndpoint: limiter.New(o.client.store, limiter.Rate{Limit: 1, Period: time.Second}),
+		getTickerEndpoint:     limiter.New(o.client.store, limiter.Rate{Limit: 1, Period: time.Second}),
+	}
+}
+
+func (o *MarketClient) GetAssets(ctx context.Context) (responses.GetAssetsResponse, error) {
+	res := responses.GetAssetsResponse{}
+	if err := o.client.Do(ctx, http.MethodGet, getAssetsEndpoint, false, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (o *MarketClient) GetAssetPairs(ctx context.Context, pair string) (responses.GetAssetPairsResponse, error) {
+	params := map[string]string{}
+	if pair != "" {
+		params["pair"] = pair
+	}
+
+	res := responses.GetAssetPairsResponse{}
+	if err := o.client.Do(ctx, http.MethodGet, getAssetPairsEndpoint, false, &res, params); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (o *MarketClient) GetTicker(ctx context.Context, pair string) (responses.GetTickerResponse, error) {
+	res := responses.GetTickerResponse{}
+	if err := o.client.Do(ctx, http.MethodGet, getTickerEndpoint, false, &res, map[string]string{"pair": pair}); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
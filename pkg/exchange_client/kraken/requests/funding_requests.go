@@ -0,0 +1,33 @@
+package requests
+
+type GetDepositMethodsRequest struct {
+	Asset string
+}
+
+type GetDepositAddressesRequest struct {
+	Asset  string
+	Method string
+	New    bool
+}
+
+type GetWithdrawMethodsRequest struct {
+	Asset string
+}
+
+type GetWithdrawAddressesRequest struct {
+	Asset    string
+	Method   string
+	Verified bool
+}
+
+type WithdrawRequest struct {
+	Asset   string
+	Key     string
+	Address string
+	Amount  string
+}
+
+type GetWithdrawStatusRequest struct {
+	Asset  string
+	Method string
+}
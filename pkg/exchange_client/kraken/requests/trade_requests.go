@@ -0,0 +1,21 @@
+package requests
+
+type AddOrderRequest struct {
+	Pair        string
+	Type        string
+	OrderType   string
+	Volume      string
+	Price       string
+	TimeInForce string
+	OFlags      string
+	ClOrdID     string
+}
+
+type QueryOrdersRequest struct {
+	TxID string
+}
+
+type CancelOrderRequest struct {
+	TxID    string
+	ClOrdID string
+}
@@ -0,0 +1,12 @@
+//nolint:tagliatelle
+package responses
+
+import "github.com/shopspring/decimal"
+
+type BalanceEx struct {
+	Balance   decimal.Decimal `json:"balance"`
+	HoldTrade decimal.Decimal `json:"hold_trade"`
+}
+
+// GetBalanceExResponse is keyed by kraken asset id, e.g. XXBT or USDT
+type GetBalanceExResponse map[string]BalanceEx
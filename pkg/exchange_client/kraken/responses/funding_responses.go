@@ -0,0 +1,85 @@
+//nolint:tagliatelle
+package responses
+
+import "github.com/shopspring/decimal"
+
+type DepositMethod struct {
+	Method     string          `json:"method"`
+	Fee        decimal.Decimal `json:"fee"`
+	GenAddress bool            `json:"gen-address"`
+	Minimum    decimal.Decimal `json:"minimum"`
+}
+
+type GetDepositMethodsResponse []DepositMethod
+
+type DepositAddress struct {
+	Address  string `json:"address"`
+	Expiretm string `json:"expiretm"`
+	New      bool   `json:"new"`
+	Tag      string `json:"tag"`
+	Memo     string `json:"memo"`
+}
+
+type GetDepositAddressesResponse []DepositAddress
+
+type WithdrawFee struct {
+	Aclass string          `json:"aclass"`
+	Asset  string          `json:"asset"`
+	Fee    decimal.Decimal `json:"fee"`
+}
+
+type WithdrawMethod struct {
+	Asset   string          `json:"asset"`
+	Method  string          `json:"method"`
+	Network string          `json:"network"`
+	Minimum decimal.Decimal `json:"minimum"`
+	Fee     WithdrawFee     `json:"fee"`
+}
+
+type GetWithdrawMethodsResponse []WithdrawMethod
+
+type WithdrawAddress struct {
+	Address  string `json:"address"`
+	Asset    string `json:"asset"`
+	Method   string `json:"method"`
+	Key      string `json:"key"`
+	Memo     string `json:"memo"`
+	Verified bool   `json:"verified"`
+}
+
+type GetWithdrawAddressesResponse []WithdrawAddress
+
+type WithdrawResponse struct {
+	RefID string `json:"refid"`
+}
+
+const (
+	WithdrawalStatusInitial = "Initial"
+	WithdrawalStatusPending = "Pending"
+	WithdrawalStatusSettled = "Settled"
+	WithdrawalStatusSuccess = "Success"
+	WithdrawalStatusFailure = "Failure"
+
+	// Status properties override the status when set
+	WithdrawalStatusCancelPending = "cancel-pending"
+	WithdrawalStatusCanceled      = "canceled"
+	WithdrawalStatusCancelDenied  = "cancel-denied"
+	WithdrawalStatusReturn        = "return"
+	WithdrawalStatusOnHold        = "onhold"
+)
+
+type WithdrawStatusItem struct {
+	Method     string          `json:"method"`
+	Aclass     string          `json:"aclass"`
+	Asset      string          `json:"asset"`
+	RefID      string          `json:"refid"`
+	TxID       string          `json:"txid"`
+	Info       string          `json:"info"`
+	Amount     decimal.Decimal `json:"amount"`
+	Fee        decimal.Decimal `json:"fee"`
+	Time       int64           `json:"time"`
+	Status     string          `json:"status"`
+	StatusProp string          `json:"status-prop"`
+}
+
+type GetWithdrawStatusResponse []WithdrawStatusItem
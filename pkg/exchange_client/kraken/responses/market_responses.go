@@ -0,0 +1,39 @@
+//nolint:tagliatelle
+package responses
+
+import "github.com/shopspring/decimal"
+
+type AssetInfo struct {
+	Aclass          string `json:"aclass"`
+	Altname         string `json:"altname"`
+	Decimals        int    `json:"decimals"`
+	DisplayDecimals int    `json:"display_decimals"`
+	Status          string `json:"status"`
+}
+
+type GetAssetsResponse map[string]AssetInfo
+
+type AssetPair struct {
+	Altname      string          `json:"altname"`
+	Wsname       string          `json:"wsname"`
+	Base         string          `json:"base"`
+	Quote        string          `json:"quote"`
+	PairDecimals int             `json:"pair_decimals"`
+	CostDecimals int             `json:"cost_decimals"`
+	LotDecimals  int             `json:"lot_decimals"`
+	OrderMin     decimal.Decimal `json:"ordermin"`
+	CostMin      decimal.Decimal `json:"costmin"`
+	TickSize     decimal.Decimal `json:"tick_size"`
+	Status       string          `json:"status"`
+}
+
+type GetAssetPairsResponse map[string]AssetPair
+
+// Ticker holds [price, whole lot volume, lot volume] for ask and bid, [price, lot volume] for last trade
+type Ticker struct {
+	Ask  []decimal.Decimal `json:"a"`
+	Bid  []decimal.Decimal `json:"b"`
+	Last []decimal.Decimal `json:"c"`
+}
+
+type GetTickerResponse map[string]Ticker
@@ -0,0 +1,47 @@
+//nolint:tagliatelle
+package responses
+
+import "github.com/shopspring/decimal"
+
+const (
+	OrderStatusPending  = "pending"
+	OrderStatusOpen     = "open"
+	OrderStatusClosed   = "closed"
+	OrderStatusCanceled = "canceled"
+	OrderStatusExpired  = "expired"
+)
+
+type AddOrderDescription struct {
+	Order string `json:"order"`
+}
+
+type AddOrderResponse struct {
+	Descr AddOrderDescription `json:"descr"`
+	TxID  []string            `json:"txid"`
+}
+
+type OrderDescription struct {
+	Pair      string          `json:"pair"`
+	Type      string          `json:"type"`
+	OrderType string          `json:"ordertype"`
+	Price     decimal.Decimal `json:"price"`
+}
+
+type OrderInfo struct {
+	Status  string           `json:"status"`
+	ClOrdID string           `json:"cl_ord_id"`
+	Vol     decimal.Decimal  `json:"vol"`
+	VolExec decimal.Decimal  `json:"vol_exec"`
+	Cost    decimal.Decimal  `json:"cost"`
+	Fee     decimal.Decimal  `json:"fee"`
+	Price   decimal.Decimal  `json:"price"`
+	Descr   OrderDescription `json:"descr"`
+}
+
+// QueryOrdersResponse is keyed by transaction id of the order
+type QueryOrdersResponse map[string]OrderInfo
+
+type CancelOrderResponse struct {
+	Count   int  `json:"count"`
+	Pending bool `json:"pending"`
+}
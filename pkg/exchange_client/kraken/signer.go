@@ -0,0 +1,73 @@
+package kraken
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+type ISigner interface {
+	SignRequest(ctx context.Context, req *http.Request, nonce, body string) (*http.Request, error)
+}
+
+type Signer struct {
+	apiKey    string
+	secretKey string
+}
+
+func NewSigner(apiKey, secretKey string) ISigner {
+	return &Signer{
+		apiKey:    apiKey,
+		secretKey: secretKey,
+	}
+}
+
+// SignRequest sets API-Sign to base64 HMAC-SHA512 of the path and SHA256 of nonce with
+// the form body, keyed with the base64 decoded secret
+func (s *Signer) SignRequest(_ context.Context, req *http.Request, nonce, body string) (*http.Request, error) {
+	secret, err := base64.StdEncoding.DecodeString(s.secretKey)
+	if err != nil {
+		return nil, fmt.Errorf("kraken secret key is not base64 encoded: %w", err)
+	}
+
+	req.Header.Set("API-Key", s.apiKey)
+	req.Header.Set("API-Sign", generateSignature(secret, req.URL.Path, nonce, body))
+
+	return req, nil
+}
+
+func generateSignature(secret []byte, path, nonce, body string) string {
+	payload := sha256.Sum256([]byte(nonce + body))
+
+	h := hmac.New(sha512.New, secret)
+	h.Write([]byte(path))
+	h.Write(payload[:])
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+var (
+	nonceMu   sync.Mutex
+	lastNonce int64
+)
+
+// nextNonce returns strictly increasing microsecond timestamps, kraken rejects
+// a nonce that is not greater than the previous one of the same key
+func nextNonce() string {
+	nonceMu.Lock()
+	defer nonceMu.Unlock()
+
+	nonce := time.Now().UnixMicro()
+	if nonce <= lastNonce {
+		nonce = lastNonce + 1
+	}
+	lastNonce = nonce
+
+	return strconv.FormatInt(nonce, 10)
+}
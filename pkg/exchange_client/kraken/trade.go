@@ -0,0 +1,111 @@
+package kraken
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/ulule/limiter/v3"
+
+	"github.com/dv-net/dv-merchant/pkg/exchange_client/kraken/requests"
+	"github.com/dv-net/dv-merchant/pkg/exchange_client/kraken/responses"
+)
+
+const (
+	addOrderEndpoint    = "/0/private/AddOrder"
+	queryOrdersEndpoint = "/0/private/QueryOrders"
+	cancelOrderEndpoint = "/0/private/CancelOrder"
+)
+
+const (
+	OrderSideBuy  = "buy"
+	OrderSideSell = "sell"
+
+	OrderTypeMarket = "market"
+	OrderTypeLimit  = "limit"
+
+	TimeInForceGTC = "GTC"
+	TimeInForceIOC = "IOC"
+
+	// OrderFlagVolumeInQuote makes market buy volume denominated in quote currency
+	OrderFlagVolumeInQuote = "viqc"
+)
+
+type IKrakenTrade interface {
+	AddOrder(ctx context.Context, req *requests.AddOrderRequest) (*responses.AddOrderResponse, error)
+	QueryOrders(ctx context.Context, req *requests.QueryOrdersRequest) (responses.QueryOrdersResponse, error)
+	CancelOrder(ctx context.Context, req *requests.CancelOrderRequest) (*responses.CancelOrderResponse, error)
+}
+
+var _ IKrakenTrade = (*TradeClient)(nil)
+
+type TradeClient struct {
+	client *Client
+}
+
+func NewTradeClient(opt *ClientOptions, store limiter.Store, opts ...ClientOption) *TradeClient {
+	trade := &TradeClient{
+		client: NewClient(opt, store, opts...),
+	}
+	trade.initLimiters()
+	return trade
+}
+
+func (o *TradeClient) initLimiters() {
+	o.client.limiters = map[string]*limiter.Limiter{
+		addOrderEndpoint:    limiter.New(o.client.store, limiter.Rate{Limit: 1, Period: time.Second}),
+		queryOrdersEndpoint: limiter.New(o.client.store, limiter.Rate{Limit: 1, Period: time.Second}),
+		cancelOrderEndpoint: limiter.New(o.client.store, limiter.Rate{Limit: 1, Period: time.Second}),
+	}
+}
+
+func (o *TradeClient) AddOrder(ctx context.Context, req *requests.AddOrderRequest) (*responses.AddOrderResponse, error) {
+	params := map[string]string{
+		"pair":      req.Pair,
+		"type":      req.Type,
+		"ordertype": req.OrderType,
+		"volume":    req.Volume,
+	}
+	if req.Price != "" {
+		params["price"] = req.Price
+	}
+	if req.TimeInForce != "" {
+		params["timeinforce"] = req.TimeInForce
+	}
+	if req.OFlags != "" {
+		params["oflags"] = req.OFlags
+	}
+	if req.ClOrdID != "" {
+		params["cl_ord_id"] = req.ClOrdID
+	}
+
+	res := &responses.AddOrderResponse{}
+	if err := o.client.Do(ctx, http.MethodPost, addOrderEndpoint, true, res, params); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (o *TradeClient) QueryOrders(ctx context.Context, req *requests.QueryOrdersRequest) (responses.QueryOrdersResponse, error) {
+	res := responses.QueryOrdersResponse{}
+	if err := o.client.Do(ctx, http.MethodPost, queryOrdersEndpoint, true, &res, map[string]string{"txid": req.TxID}); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (o *TradeClient) CancelOrder(ctx context.Context, req *requests.CancelOrderRequest) (*responses.CancelOrderResponse, error) {
+	params := map[string]string{}
+	if req.TxID != "" {
+		params["txid"] = req.TxID
+	}
+	if req.ClOrdID != "" {
+		params["cl_ord_id"] = req.ClOrdID
+	}
+
+	res := &responses.CancelOrderResponse{}
+	if err := o.client.Do(ctx, http.MethodPost, cancelOrderEndpoint, true, res, params); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -149,8 +149,8 @@ func (o *Client) DoPlain(ctx context.Context, method, path string, private bool,
 	}
 
 	switch method {
-	case http.MethodGet:
-		req, err = http.NewRequestWithContext(ctx, http.MethodGet, baseURL, nil)
+	case http.MethodGet, http.MethodDelete:
+		req, err = http.NewRequestWithContext(ctx, method, baseURL, nil)
 		if err != nil {
 			return err
 		}
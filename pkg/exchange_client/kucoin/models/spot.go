@@ -8,6 +8,7 @@ type Order struct {
 	ClientOID   string          `json:"clientOid,omitempty"`
 	Symbol      string          `json:"symbol"`
 	Active      bool            `json:"active"`
+	CancelExist bool            `json:"cancelExist"`
 	Type        OrderType       `json:"type"`
 	DealFunds   decimal.Decimal `json:"dealFunds"`
 	DealSize    decimal.Decimal `json:"dealSize"`
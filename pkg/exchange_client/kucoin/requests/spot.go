@@ -15,12 +15,19 @@ type GetOrderByClientOID struct {
 	Symbol         string `json:"symbol" url:"symbol"`
 }
 
+type CancelOrderByOrderID struct {
+	OrderID string `json:"-"`
+	Symbol  string `json:"symbol" url:"symbol"`
+}
+
 type CreateOrder struct {
-	ClientOID string                            `json:"clientOid,omitempty"`
-	Symbol    string                            `json:"symbol"`
-	Type      kucoinmodels.OrderType            `json:"type"`
-	Side      kucoinmodels.OrderSide            `json:"side"`
-	Stp       kucoinmodels.SelfTradePreventType `json:"stp,omitempty"`
-	Funds     string                            `json:"funds,omitempty"`
-	Size      string                            `json:"size,omitempty"`
+	ClientOID   string                            `json:"clientOid,omitempty"`
+	Symbol      string                            `json:"symbol"`
+	Type        kucoinmodels.OrderType            `json:"type"`
+	Side        kucoinmodels.OrderSide            `json:"side"`
+	Stp         kucoinmodels.SelfTradePreventType `json:"stp,omitempty"`
+	Funds       string                            `json:"funds,omitempty"`
+	Size        string                            `json:"size,omitempty"`
+	Price       string                            `json:"price,omitempty"`
+	TimeInForce kucoinmodels.TimeInForce          `json:"timeInForce,omitempty"`
 }
@@ -22,3 +22,10 @@ type CreateOrder struct {
 		OrderID   string `json:"orderId"`
 	}
 }
+
+type CancelOrderByOrderID struct {
+	Basic
+	Data struct {
+		OrderID string `json:"orderId"`
+	}
+}
@@ -16,6 +16,7 @@ const (
 	createOrder         = "/api/v1/hf/orders"
 	getOrderByOrderID   = "/api/v1/hf/orders/{orderId}"
 	getOrderByClientOID = "/api/v1/hf/orders/client-order/{clientOid}"
+	cancelOrderByID     = "/api/v1/hf/orders/{orderId}"
 )
 
 var _ = IKucoinSpot((*Spot)(nil))
@@ -24,6 +25,7 @@ type IKucoinSpot interface {
 	CreateOrder(ctx context.Context, req kucoinrequests.CreateOrder) (*kucoinresponses.CreateOrder, error)
 	GetOrderByOrderID(ctx context.Context, req kucoinrequests.GetOrderByOrderID) (*kucoinresponses.GetOrderByOrderID, error)
 	GetOrderByClientOID(ctx context.Context, req kucoinrequests.GetOrderByClientOID) (*kucoinresponses.GetOrderByClientOID, error)
+	CancelOrderByOrderID(ctx context.Context, req kucoinrequests.CancelOrderByOrderID) (*kucoinresponses.CancelOrderByOrderID, error)
 }
 
 type Spot struct {
@@ -90,3 +92,17 @@ func (o *Spot) GetOrderByClientOID(ctx context.Context, req kucoinrequests.GetOr
 	}
 	return response, nil
 }
+
+func (o *Spot) CancelOrderByOrderID(ctx context.Context, req kucoinrequests.CancelOrderByOrderID) (*kucoinresponses.CancelOrderByOrderID, error) {
+	response := &kucoinresponses.CancelOrderByOrderID{}
+	p := cancelOrderByID
+	m := S2M(req)
+	if req.OrderID != "" {
+		p = strings.ReplaceAll(p, "{orderId}", req.OrderID)
+	}
+	err := o.client.Do(ctx, http.MethodDelete, p, true, response, m)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
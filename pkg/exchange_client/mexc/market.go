@@ -13,6 +13,7 @@ import (
 const (
 	getExchangeInfoEndpoint = "/api/v3/exchangeInfo"
 	getTickerPriceEndpoint  = "/api/v3/ticker/price"
+	getBookTickerEndpoint   = "/api/v3/ticker/bookTicker"
 )
 
 const (
@@ -20,7 +21,10 @@ const (
 	SymbolStatusPaused  = "2"
 	SymbolStatusOffline = "3"
 
-	OrderTypeMarket = "MARKET"
+	OrderTypeMarket            = "MARKET"
+	OrderTypeLimit             = "LIMIT"
+	OrderTypeImmediateOrCancel = "IMMEDIATE_OR_CANCEL"
+	OrderTypeFillOrKill        = "FILL_OR_KILL"
 )
 
 const (
@@ -33,6 +37,7 @@ const (
 type IMexcMarket interface {
 	GetExchangeInfo(ctx context.Context, symbol string) (*responses.GetExchangeInfoResponse, error)
 	GetTickerPrice(ctx context.Context, symbol string) (*responses.GetTickerPriceResponse, error)
+	GetBookTicker(ctx context.Context, symbol string) (*responses.GetBookTickerResponse, error)
 }
 
 var _ IMexcMarket = (*MarketClient)(nil)
@@ -53,6 +58,7 @@ func (o *MarketClient) initLimiters() {
 	o.client.limiters = map[string]*limiter.Limiter{
 		getExchangeInfoEndpoint: limiter.New(o.client.store, limiter.Rate{Limit: 2, Period: time.Second}),
 		getTickerPriceEndpoint:  limiter.New(o.client.store, limiter.Rate{Limit: 2, Period: time.Second}),
+		getBookTickerEndpoint:   limiter.New(o.client.store, limiter.Rate{Limit: 2, Period: time.Second}),
 	}
 }
 
@@ -76,3 +82,11 @@ func (o *MarketClient) GetTickerPrice(ctx context.Context, symbol string) (*resp
 	}
 	return res, nil
 }
+
+func (o *MarketClient) GetBookTicker(ctx context.Context, symbol string) (*responses.GetBookTickerResponse, error) {
+	res := &responses.GetBookTickerResponse{}
+	if err := o.client.Do(ctx, http.MethodGet, getBookTickerEndpoint, false, res, map[string]string{"symbol": symbol}); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
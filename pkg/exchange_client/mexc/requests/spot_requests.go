@@ -6,9 +6,16 @@ type PlaceOrderRequest struct {
 	Type             string
 	Quantity         string
 	QuoteOrderQty    string
+	Price            string
 	NewClientOrderID string
 }
 
+type CancelOrderRequest struct {
+	Symbol            string
+	OrderID           string
+	OrigClientOrderID string
+}
+
 type QueryOrderRequest struct {
 	Symbol            string
 	OrderID           string
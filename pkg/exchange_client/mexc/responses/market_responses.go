@@ -29,3 +29,11 @@ type GetTickerPriceResponse struct {
 	Symbol string          `json:"symbol"`
 	Price  decimal.Decimal `json:"price"`
 }
+
+type GetBookTickerResponse struct {
+	Symbol   string          `json:"symbol"`
+	BidPrice decimal.Decimal `json:"bidPrice"`
+	BidQty   decimal.Decimal `json:"bidQty"`
+	AskPrice decimal.Decimal `json:"askPrice"`
+	AskQty   decimal.Decimal `json:"askQty"`
+}
@@ -34,3 +34,10 @@ type QueryOrderResponse struct {
 	Type                string          `json:"type"`
 	Side                string          `json:"side"`
 }
+
+type CancelOrderResponse struct {
+	Symbol        string `json:"symbol"`
+	OrderID       string `json:"orderId"`
+	ClientOrderID string `json:"clientOrderId"`
+	Status        string `json:"status"`
+}
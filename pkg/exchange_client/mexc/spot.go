@@ -21,6 +21,7 @@ const (
 type IMexcSpot interface {
 	PlaceOrder(ctx context.Context, req *requests.PlaceOrderRequest) (*responses.PlaceOrderResponse, error)
 	QueryOrder(ctx context.Context, req *requests.QueryOrderRequest) (*responses.QueryOrderResponse, error)
+	CancelOrder(ctx context.Context, req *requests.CancelOrderRequest) (*responses.CancelOrderResponse, error)
 }
 
 var _ IMexcSpot = (*SpotClient)(nil)
@@ -55,6 +56,9 @@ func (o *SpotClient) PlaceOrder(ctx context.Context, req *requests.PlaceOrderReq
 	if req.QuoteOrderQty != "" {
 		params["quoteOrderQty"] = req.QuoteOrderQty
 	}
+	if req.Price != "" {
+		params["price"] = req.Price
+	}
 	if req.NewClientOrderID != "" {
 		params["newClientOrderId"] = req.NewClientOrderID
 	}
@@ -81,3 +85,19 @@ func (o *SpotClient) QueryOrder(ctx context.Context, req *requests.QueryOrderReq
 	}
 	return res, nil
 }
+
+func (o *SpotClient) CancelOrder(ctx context.Context, req *requests.CancelOrderRequest) (*responses.CancelOrderResponse, error) {
+	params := map[string]string{"symbol": req.Symbol}
+	if req.OrderID != "" {
+		params["orderId"] = req.OrderID
+	}
+	if req.OrigClientOrderID != "" {
+		params["origClientOrderId"] = req.OrigClientOrderID
+	}
+
+	res := &responses.CancelOrderResponse{}
+	if err := o.client.Do(ctx, http.MethodDelete, orderEndpoint, true, res, params); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -16,12 +16,14 @@ const (
 	accountBalanceEndpoint = "/api/v5/account/balance"
 	maxWithdrawalEndpoint  = "/api/v5/account/max-withdrawal"
 	accountRiskEndpoint    = "/api/v5/account/account-position-risk"
+	accountConfigEndpoint  = "/api/v5/account/config"
 )
 
 type IOKXAccount interface {
 	GetBalance(ctx context.Context, req okxrequests.GetAccountBalance) (*okxresponses.GetAccountBalance, error)
 	GetMaxWithdrawals(ctx context.Context, req okxrequests.GetMaxWithdrawal) (*okxresponses.GetMaxWithdrawals, error)
 	GetAccountAndRisks(ctx context.Context, req okxrequests.GetAccountAndPositionRisk) (*okxresponses.GetAccountAndPositionRisk, error)
+	GetConfig(ctx context.Context) (*okxresponses.GetConfig, error)
 }
 
 type Account struct {
@@ -41,6 +43,7 @@ func (o *Account) initLimiters() {
 		accountBalanceEndpoint: limiter.New(o.client.store, limiter.Rate{Limit: 10, Period: 2 * time.Second}),
 		maxWithdrawalEndpoint:  limiter.New(o.client.store, limiter.Rate{Limit: 20, Period: time.Second}),
 		accountRiskEndpoint:    limiter.New(o.client.store, limiter.Rate{Limit: 10, Period: 2 * time.Second}),
+		accountConfigEndpoint:  limiter.New(o.client.store, limiter.Rate{Limit: 5, Period: 2 * time.Second}),
 	}
 }
 
@@ -83,3 +86,12 @@ func (o *Account) GetAccountAndRisks(ctx context.Context, req okxrequests.GetAcc
 	}
 	return response, nil
 }
+
+func (o *Account) GetConfig(ctx context.Context) (*okxresponses.GetConfig, error) {
+	response := &okxresponses.GetConfig{}
+	err := o.client.Do(ctx, http.MethodGet, accountConfigEndpoint, true, response)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
@@ -317,29 +317,43 @@ func errorFromResponse(errRes *okxresponses.Basic) error {
 		return code == okxresponses.ErrorCodeIPWhitelist
 	}
 
-	if strCode, ok := errRes.Code.(string); ok && strCode != "0" { //nolint:nestif
-		if iCode, err := strconv.Atoi(strCode); err == nil {
-			if isCredentialError(iCode) {
-				return exchangeclient.ErrInvalidAPICredentials
-			}
-			if isWhitelistError(iCode) {
-				return exchangeclient.ErrInvalidIPAddress
-			}
+	// code is a string in most responses, numeric one is decoded as float64
+	var (
+		code    int
+		numeric bool
+	)
+	switch v := errRes.Code.(type) {
+	case string:
+		if v == "0" {
+			return nil
+		}
+		code, numeric = parseCode(v)
+	case float64:
+		if v == 0 {
+			return nil
 		}
-		return wrapOKXError(errRes.Msg, fmt.Sprintf("%v", errRes.Code))
+		code, numeric = int(v), true
+	default:
+		return nil
 	}
 
-	if intCode, ok := errRes.Code.(int); ok && intCode != 0 {
-		if isCredentialError(intCode) {
+	if numeric {
+		switch {
+		case isCredentialError(code):
 			return exchangeclient.ErrInvalidAPICredentials
-		}
-		if isWhitelistError(intCode) {
+		case isWhitelistError(code):
 			return exchangeclient.ErrInvalidIPAddress
+		case code == okxresponses.ErrorCodeRateLimited:
+			return fmt.Errorf("okx error: %s (%d): %w", errRes.Msg, code, exchangeclient.ErrRateLimited)
 		}
-		return wrapOKXError(errRes.Msg, fmt.Sprintf("%v", errRes.Code))
 	}
 
-	return nil
+	return wrapOKXError(errRes.Msg, fmt.Sprintf("%v", errRes.Code))
+}
+
+func parseCode(code string) (int, bool) {
+	v, err := strconv.Atoi(code)
+	return v, err == nil
 }
 
 // wrapOKXError wraps OKX errors with centralized errors when applicable
@@ -135,6 +135,9 @@ type (
 		UID        string `json:"uid"`
 		GreeksType string `json:"greeksType"`
 		PosMode    string `json:"posMode"`
+		Label      string `json:"label"`
+		Perm       string `json:"perm"`
+		IP         string `json:"ip"`
 	}
 	PositionMode struct {
 		PosMode string `json:"posMode"`
@@ -15,6 +15,9 @@ func (o OrderType) String() string { return string(o) }
 
 const (
 	OrderTypeMarket OrderType = "market"
+	OrderTypeLimit  OrderType = "limit"
+	OrderTypeIOC    OrderType = "ioc"
+	OrderTypeFOK    OrderType = "fok"
 )
 
 type OrderSide string
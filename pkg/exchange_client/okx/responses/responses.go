@@ -17,6 +17,7 @@ const (
 	ErrorCodeInvalidSignature       ErrorCode = 50113
 	ErrorCodeAPIKeyNotExists        ErrorCode = 50119
 	ErrorCodeInvalidTimestamp       ErrorCode = 50107
+	ErrorCodeRateLimited            ErrorCode = 50011
 )
 
 // 	50103	401	Request header "OK-ACCESS-KEY" cannot be empty.
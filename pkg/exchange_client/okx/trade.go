@@ -14,6 +14,7 @@ import (
 type IOKXTrade interface {
 	PlaceOrder(ctx context.Context, req []okxrequests.PlaceOrder) (*okxresponses.PlaceOrder, error)
 	PlaceMultipleOrders(ctx context.Context, req []okxrequests.PlaceOrder) (*okxresponses.PlaceOrder, error)
+	CancelOrder(ctx context.Context, req okxrequests.CancelOrder) (*okxresponses.CancelOrder, error)
 	ClosePosition(ctx context.Context, req okxrequests.ClosePosition) (*okxresponses.ClosePosition, error)
 	GetOrderDetail(ctx context.Context, req okxrequests.OrderDetails) (*okxresponses.OrderList, error)
 	GetOrderList(ctx context.Context, req okxrequests.OrderList) (*okxresponses.OrderList, error)
@@ -29,6 +30,7 @@ type Trade struct {
 const (
 	orderEndpoint                        = "/api/v5/trade/order"
 	batchPlaceOrderEndpoint              = "/api/v5/trade/batch-orders"
+	cancelOrderEndpoint                  = "/api/v5/trade/cancel-order"
 	closePositionEndpoint                = "/api/v5/trade/close-position"
 	getOrderDetainsEndpoint              = "/api/v5/trade/order" // TODO: fix this, since its the same route as POST order
 	getOrderListEndpoint                 = "/api/v5/trade/orders-pending"
@@ -51,6 +53,7 @@ func (o *Trade) initLimiters() {
 	o.client.limiters = map[string]*limiter.Limiter{
 		orderEndpoint:                        limiter.New(o.client.store, limiter.Rate{Limit: 60, Period: 2 * time.Second}),
 		batchPlaceOrderEndpoint:              limiter.New(o.client.store, limiter.Rate{Limit: 20, Period: 2 * time.Second}),
+		cancelOrderEndpoint:                  limiter.New(o.client.store, limiter.Rate{Limit: 60, Period: 2 * time.Second}),
 		getOrderListEndpoint:                 limiter.New(o.client.store, limiter.Rate{Limit: 20, Period: 2 * time.Second}),
 		placeAlgoOrderEnpdint:                limiter.New(o.client.store, limiter.Rate{Limit: 20, Period: 2 * time.Second}),
 		getTransactionDetailsEndpoint:        limiter.New(o.client.store, limiter.Rate{Limit: 20, Period: 2 * time.Second}),
@@ -88,6 +91,17 @@ func (o *Trade) PlaceMultipleOrders(ctx context.Context, req []okxrequests.Place
 	return response, nil
 }
 
+func (o *Trade) CancelOrder(ctx context.Context, req okxrequests.CancelOrder) (*okxresponses.CancelOrder, error) {
+	response := &okxresponses.CancelOrder{}
+	p := cancelOrderEndpoint
+	m := S2M(req)
+	err := o.client.Do(ctx, http.MethodPost, p, true, response, m)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (o *Trade) ClosePosition(ctx context.Context, req okxrequests.ClosePosition) (*okxresponses.ClosePosition, error) {
 	response := &okxresponses.ClosePosition{}
 	p := closePositionEndpoint
@@ -28,6 +28,22 @@ func ConvertPrecision(withdrawIntegerMultiple string) decimal.Decimal {
 	return precision
 }
 
+// FloorToStep rounds value down to a multiple of step, value is kept as is for non-positive step
+func FloorToStep(value, step decimal.Decimal) decimal.Decimal {
+	if !step.IsPositive() {
+		return value
+	}
+	return value.Div(step).Floor().Mul(step)
+}
+
+// CeilToStep rounds value up to a multiple of step, value is kept as is for non-positive step
+func CeilToStep(value, step decimal.Decimal) decimal.Decimal {
+	if !step.IsPositive() {
+		return value
+	}
+	return value.Div(step).Ceil().Mul(step)
+}
+
 func ExtractMarketFilters(filters []interface{}) (*binancemodels.MarketFilters, error) {
 	marketFilters := &binancemodels.MarketFilters{}
 	for _, filter := range filters {
@@ -41,6 +57,10 @@ func ExtractMarketFilters(filters []interface{}) (*binancemodels.MarketFilters,
 				if err := i2s(filter, &marketFilters.LotSizeFilter); err != nil {
 					return nil, err
 				}
+			case "PRICE_FILTER":
+				if err := i2s(filter, &marketFilters.PriceFilter); err != nil {
+					return nil, err
+				}
 			}
 		}
 	}
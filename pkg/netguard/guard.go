@@ -0,0 +1,96 @@
+// Package netguard rejects outgoing connections to denied networks.
+//
+// The check runs in net.Dialer.Control, i.e. against the address the socket is about to
+// connect to after name resolution, so a host re-resolving to a private address between
+// validation and dialing (DNS rebinding) is still rejected.
+package netguard
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"syscall"
+)
+
+var ErrDeniedAddress = errors.New("destination address is not allowed")
+
+// privateNetworks are loopback, private, link-local, shared, reserved and multicast ranges
+var privateNetworks = []string{
+	"0.0.0.0/8",
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"127.0.0.0/8",
+	"169.254.0.0/16",
+	"172.16.0.0/12",
+	"192.0.0.0/24",
+	"192.0.2.0/24",
+	"192.168.0.0/16",
+	"198.18.0.0/15",
+	"198.51.100.0/24",
+	"203.0.113.0/24",
+	"224.0.0.0/4",
+	"240.0.0.0/4",
+	"::/128",
+	"::1/128",
+	"64:ff9b::/96",
+	"100::/64",
+	"2001:db8::/32",
+	"fc00::/7",
+	"fe80::/10",
+	"ff00::/8",
+}
+
+type Guard struct {
+	denied []netip.Prefix
+}
+
+// New creates guard denying given CIDRs, private networks are added when blockPrivate is set
+func New(blockPrivate bool, denied ...string) (*Guard, error) {
+	cidrs := denied
+	if blockPrivate {
+		cidrs = append(append([]string{}, privateNetworks...), denied...)
+	}
+
+	g := &Guard{denied: make([]netip.Prefix, 0, len(cidrs))}
+	for _, cidr := range cidrs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("parse denied network %q: %w", cidr, err)
+		}
+		g.denied = append(g.denied, prefix.Masked())
+	}
+
+	return g, nil
+}
+
+// Allowed reports whether connections to ip are permitted
+func (g *Guard) Allowed(ip netip.Addr) bool {
+	if !ip.IsValid() {
+		return false
+	}
+
+	ip = ip.Unmap()
+	for _, prefix := range g.denied {
+		if prefix.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Control is meant for net.Dialer.Control, address is already resolved there
+func (g *Guard) Control(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrDeniedAddress, address)
+	}
+
+	ip, err := netip.ParseAddr(host)
+	if err != nil || !g.Allowed(ip) {
+		return fmt.Errorf("%w: %s", ErrDeniedAddress, host)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package netguard_test
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/dv-net/dv-merchant/pkg/netguard"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGuard_Allowed(t *testing.T) {
+	g, err := netguard.New(true, "8.8.4.0/24")
+	require.NoError(t, err)
+
+	for addr, allowed := range map[string]bool{
+		"1.1.1.1":          true,
+		"2606:4700::1111":  true,
+		"8.8.4.4":          false,
+		"127.0.0.1":        false,
+		"10.1.2.3":         false,
+		"172.20.0.1":       false,
+		"192.168.1.1":      false,
+		"169.254.169.254":  false,
+		"100.64.0.1":       false,
+		"::1":              false,
+		"fd00::1":          false,
+		"::ffff:127.0.0.1": false,
+	} {
+		assert.Equal(t, allowed, g.Allowed(netip.MustParseAddr(addr)), addr)
+	}
+
+	open, err := netguard.New(false)
+	require.NoError(t, err)
+	assert.True(t, open.Allowed(netip.MustParseAddr("127.0.0.1")))
+
+	_, err = netguard.New(false, "not-a-cidr")
+	assert.Error(t, err)
+}
+
+func TestGuard_Control(t *testing.T) {
+	g, err := netguard.New(true)
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	dialer := net.Dialer{Control: g.Control}
+	_, err = dialer.DialContext(context.Background(), "tcp", listener.Addr().String())
+	assert.ErrorIs(t, err, netguard.ErrDeniedAddress)
+}
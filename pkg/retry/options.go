@@ -35,6 +35,20 @@ func WithDelay(delay time.Duration) Option {
 	}
 }
 
+// WithMaxDelay caps the delay between retries, zero means no cap
+func WithMaxDelay(maxDelay time.Duration) Option {
+	return func(r *Retry) {
+		r.maxDelay = maxDelay
+	}
+}
+
+// WithSchedule sets the delays for Custom policy retry
+func WithSchedule(schedule ...time.Duration) Option {
+	return func(r *Retry) {
+		r.schedule = schedule
+	}
+}
+
 // WithContext sets the ctx for Infinite policy retry
 func WithContext(ctx context.Context) Option {
 	return func(r *Retry) {
@@ -80,6 +94,18 @@ func (r *Retry) SetDelay(delay time.Duration) *Retry {
 	return r
 }
 
+// SetMaxDelay caps the delay between retries, zero means no cap
+func (r *Retry) SetMaxDelay(maxDelay time.Duration) *Retry {
+	r.maxDelay = maxDelay
+	return r
+}
+
+// SetSchedule sets the delays for Custom policy retry
+func (r *Retry) SetSchedule(schedule ...time.Duration) *Retry {
+	r.schedule = schedule
+	return r
+}
+
 // SetContext sets the ctx for Infinite policy retry
 func (r *Retry) SetContext(ctx context.Context) *Retry {
 	r.ctx = ctx
@@ -8,12 +8,14 @@ const (
 	PolicyLinear Policy = iota
 	PolicyBackoff
 	PolicyInfinite
+	// PolicyCustom waits delays from the schedule set with WithSchedule, repeating the last one
+	PolicyCustom
 )
 
 // Validate validates the retry policy
 func (r Policy) Validate() error {
 	switch r {
-	case PolicyLinear, PolicyBackoff, PolicyInfinite:
+	case PolicyLinear, PolicyBackoff, PolicyInfinite, PolicyCustom:
 		return nil
 	default:
 		return fmt.Errorf("invalid retry policy")
@@ -14,6 +14,8 @@ type Retry struct {
 	maxAttempts int
 	policy      Policy
 	delay       time.Duration
+	maxDelay    time.Duration
+	schedule    []time.Duration
 	onFailedFn  func()
 	onSuccessFn func()
 }
@@ -49,6 +51,8 @@ func (r *Retry) Do(fn func() error) error {
 		err = r.linearRetry(fn)
 	case PolicyBackoff:
 		err = r.backoffRetry(fn)
+	case PolicyCustom:
+		err = r.customRetry(fn)
 	case PolicyInfinite:
 		err = r.infiniteRetry(fn)
 	default:
@@ -105,7 +109,7 @@ func (r *Retry) backoffRetry(fn func() error) error {
 		}
 
 		if attempt < r.maxAttempts {
-			delay := r.delay * (1 << (attempt - 1)) // Increase a delay of 2 times by each attempt
+			delay := r.delayFor(attempt)
 			if r.logger != nil {
 				r.logger.Infof("backoff retry attempt %d failed, retrying in %s...", attempt, delay)
 			}
@@ -115,6 +119,37 @@ func (r *Retry) backoffRetry(fn func() error) error {
 	return fmt.Errorf("backoff retry failed after %d attempts", r.maxAttempts)
 }
 
+// customRetry - performs a retry mechanism with delays taken from the schedule
+func (r *Retry) customRetry(fn func() error) error {
+	if len(r.schedule) == 0 {
+		return fmt.Errorf("custom retry cannot be initialized without schedule")
+	}
+
+	for attempt := 1; attempt <= r.maxAttempts; attempt++ {
+		err := fn()
+		if err == nil {
+			return nil
+		}
+
+		if r.onFailedFn != nil {
+			r.onFailedFn()
+		}
+
+		if errors.Is(err, ErrExit) {
+			return err
+		}
+
+		if attempt < r.maxAttempts {
+			delay := r.delayFor(attempt)
+			if r.logger != nil {
+				r.logger.Infof("custom retry attempt %d failed, retrying in %s...", attempt, delay)
+			}
+			time.Sleep(delay)
+		}
+	}
+	return fmt.Errorf("custom retry failed after %d attempts", r.maxAttempts)
+}
+
 func (r *Retry) infiniteRetry(fn func() error) error {
 	if r.ctx == nil {
 		return fmt.Errorf("infinite retry cannot be initialized without ctx")
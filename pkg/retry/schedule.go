@@ -0,0 +1,50 @@
+package retry
+
+import (
+	"math"
+	"time"
+)
+
+// NextDelay returns the delay after the given number of failed attempts
+// and false once no attempts are left. It lets callers that persist retry
+// state themselves, e.g. in a queue table, share the policies of Do.
+func (r *Retry) NextDelay(attempt int) (time.Duration, bool) {
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	if r.policy != PolicyInfinite && attempt >= r.maxAttempts {
+		return 0, false
+	}
+
+	if r.policy == PolicyCustom && len(r.schedule) == 0 {
+		return 0, false
+	}
+
+	return r.delayFor(attempt), true
+}
+
+// delayFor returns the delay after the given failed attempt according to the policy
+func (r *Retry) delayFor(attempt int) time.Duration {
+	delay := r.delay
+	switch r.policy {
+	case PolicyBackoff:
+		// Increase a delay of 2 times by each attempt, stopping at the cap or before overflow
+		for i := 1; i < attempt; i++ {
+			if (r.maxDelay > 0 && delay >= r.maxDelay) || delay > math.MaxInt64/2 {
+				break
+			}
+			delay *= 2
+		}
+	case PolicyCustom:
+		if len(r.schedule) > 0 {
+			delay = r.schedule[min(attempt, len(r.schedule))-1]
+		}
+	}
+
+	if r.maxDelay > 0 && delay > r.maxDelay {
+		delay = r.maxDelay
+	}
+
+	return delay
+}
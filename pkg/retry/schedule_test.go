@@ -0,0 +1,38 @@
+package retry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetry_NextDelay(t *testing.T) {
+	tests := []struct {
+		name     string
+		retry    *Retry
+		attempt  int
+		expected time.Duration
+		ok       bool
+	}{
+		{name: "linear", retry: New(WithPolicy(PolicyLinear), WithDelay(time.Minute)), attempt: 3, expected: time.Minute, ok: true},
+		{name: "linear exhausted", retry: New(WithPolicy(PolicyLinear), WithDelay(time.Minute)), attempt: 5, ok: false},
+		{name: "backoff first", retry: New(WithPolicy(PolicyBackoff), WithDelay(time.Minute), WithMaxAttempts(10)), attempt: 1, expected: time.Minute, ok: true},
+		{name: "backoff grows", retry: New(WithPolicy(PolicyBackoff), WithDelay(time.Minute), WithMaxAttempts(10)), attempt: 4, expected: 8 * time.Minute, ok: true},
+		{name: "backoff capped", retry: New(WithPolicy(PolicyBackoff), WithDelay(time.Minute), WithMaxAttempts(100), WithMaxDelay(time.Hour)), attempt: 60, expected: time.Hour, ok: true},
+		{name: "custom", retry: New(WithPolicy(PolicyCustom), WithSchedule(time.Second, time.Minute, time.Hour)), attempt: 2, expected: time.Minute, ok: true},
+		{name: "custom repeats last", retry: New(WithPolicy(PolicyCustom), WithSchedule(time.Second, time.Minute), WithMaxAttempts(10)), attempt: 7, expected: time.Minute, ok: true},
+		{name: "custom without schedule", retry: New(WithPolicy(PolicyCustom)), attempt: 1, ok: false},
+		{name: "infinite", retry: New(WithPolicy(PolicyInfinite), WithDelay(time.Second)), attempt: 1000, expected: time.Second, ok: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delay, ok := tt.retry.NextDelay(tt.attempt)
+			require.Equal(t, tt.ok, ok)
+			if ok {
+				require.Equal(t, tt.expected, delay)
+			}
+		})
+	}
+}
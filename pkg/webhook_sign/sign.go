@@ -0,0 +1,69 @@
+// Package webhook_sign signs store webhooks and verifies them on the receiver side.
+//
+// Every delivery carries the X-Signature header:
+//
+//	X-Signature: t=1700000000,v1=<key id>:<hex signature>[,v1=<key id>:<hex signature>]
+//
+// where the signature is HMAC-SHA256 of "<t>.<raw body>" keyed with the store secret and
+// the key id is derived from the secret by KeyID. While a store rotates its secret the
+// header holds one signature per active secret, so receivers may switch secrets at any
+// moment of the rotation window.
+package webhook_sign
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	HeaderName = "X-Signature"
+	Version    = "v1"
+
+	timestampKey = "t"
+	keyIDLength  = 16
+)
+
+type Key struct {
+	ID     string
+	Secret string
+}
+
+// NewKey returns signing key with id derived from the secret
+func NewKey(secret string) Key {
+	return Key{
+		ID:     KeyID(secret),
+		Secret: secret,
+	}
+}
+
+// KeyID returns public identifier of the secret, it does not disclose the secret itself
+func KeyID(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])[:keyIDLength]
+}
+
+// Sign returns X-Signature header value for the payload sent at the given time
+func Sign(payload []byte, timestamp time.Time, keys ...Key) string {
+	ts := timestamp.Unix()
+
+	parts := make([]string, 0, len(keys)+1)
+	parts = append(parts, timestampKey+"="+strconv.FormatInt(ts, 10))
+	for _, key := range keys {
+		parts = append(parts, Version+"="+key.ID+":"+ComputeSignature(payload, ts, key.Secret))
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// ComputeSignature returns hex encoded HMAC-SHA256 of the signed payload
+func ComputeSignature(payload []byte, timestamp int64, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
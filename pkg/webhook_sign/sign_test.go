@@ -0,0 +1,39 @@
+package webhook_sign_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dv-net/dv-merchant/pkg/webhook_sign"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	payload := []byte(`{"type":"PaymentReceived"}`)
+	now := time.Unix(1700000000, 0)
+	clock := webhook_sign.WithClock(func() time.Time { return now })
+
+	header := webhook_sign.Sign(payload, now, webhook_sign.NewKey("new-secret"), webhook_sign.NewKey("old-secret"))
+
+	// both secrets are accepted during rotation
+	require.NoError(t, webhook_sign.NewVerifier([]string{"old-secret"}, clock).Verify(header, payload))
+	require.NoError(t, webhook_sign.NewVerifier([]string{"new-secret"}, clock).Verify(header, payload))
+
+	assert.ErrorIs(t, webhook_sign.NewVerifier([]string{"other"}, clock).Verify(header, payload), webhook_sign.ErrSignatureMismatch)
+	assert.ErrorIs(t, webhook_sign.NewVerifier([]string{"new-secret"}, clock).Verify(header, []byte(`{}`)), webhook_sign.ErrSignatureMismatch)
+	assert.ErrorIs(t, webhook_sign.NewVerifier([]string{"new-secret"}, clock).Verify("v1=abc", payload), webhook_sign.ErrInvalidHeader)
+
+	late := webhook_sign.WithClock(func() time.Time { return now.Add(webhook_sign.DefaultTolerance + time.Second) })
+	assert.ErrorIs(t, webhook_sign.NewVerifier([]string{"new-secret"}, late).Verify(header, payload), webhook_sign.ErrTimestampExpired)
+}
+
+func TestVerifyReplay(t *testing.T) {
+	payload := []byte(`{}`)
+	header := webhook_sign.Sign(payload, time.Now(), webhook_sign.NewKey("secret"))
+	verifier := webhook_sign.NewVerifier([]string{"secret"}, webhook_sign.WithReplayCache(webhook_sign.NewMemoryReplayCache()))
+
+	require.NoError(t, verifier.Verify(header, payload))
+	assert.ErrorIs(t, verifier.Verify(header, payload), webhook_sign.ErrReplayed)
+}
@@ -0,0 +1,206 @@
+package webhook_sign
+
+import (
+	"crypto/hmac"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const DefaultTolerance = 5 * time.Minute
+
+var (
+	ErrInvalidHeader     = errors.New("invalid webhook signature header")
+	ErrNoSecrets         = errors.New("no secrets to verify webhook signature")
+	ErrTimestampExpired  = errors.New("webhook signature timestamp is outside of tolerance")
+	ErrSignatureMismatch = errors.New("webhook signature does not match any secret")
+	ErrReplayed          = errors.New("webhook delivery was already received")
+)
+
+// ReplayCache remembers verified deliveries until they expire
+type ReplayCache interface {
+	// Remember stores the delivery and returns false when it is already stored
+	Remember(signature string, expiresAt time.Time) bool
+}
+
+type Verifier struct {
+	keys      []Key
+	tolerance time.Duration
+	replay    ReplayCache
+	now       func() time.Time
+}
+
+type Option func(*Verifier)
+
+// WithTolerance sets max allowed difference between delivery timestamp and local clock
+func WithTolerance(tolerance time.Duration) Option {
+	return func(v *Verifier) {
+		v.tolerance = tolerance
+	}
+}
+
+// WithReplayCache rejects deliveries that were already verified within tolerance
+func WithReplayCache(cache ReplayCache) Option {
+	return func(v *Verifier) {
+		v.replay = cache
+	}
+}
+
+// WithClock overrides current time source
+func WithClock(now func() time.Time) Option {
+	return func(v *Verifier) {
+		v.now = now
+	}
+}
+
+// NewVerifier creates verifier for the given store secrets, pass both secrets during rotation
+func NewVerifier(secrets []string, opts ...Option) *Verifier {
+	v := &Verifier{
+		keys:      make([]Key, 0, len(secrets)),
+		tolerance: DefaultTolerance,
+		now:       time.Now,
+	}
+	for _, secret := range secrets {
+		if secret != "" {
+			v.keys = append(v.keys, NewKey(secret))
+		}
+	}
+
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	return v
+}
+
+// Verify checks X-Signature header value against the raw request body
+func (v *Verifier) Verify(header string, payload []byte) error {
+	if len(v.keys) == 0 {
+		return ErrNoSecrets
+	}
+
+	ts, signatures, err := parseHeader(header)
+	if err != nil {
+		return err
+	}
+
+	signedAt := time.Unix(ts, 0)
+	if v.tolerance > 0 {
+		diff := v.now().Sub(signedAt)
+		if diff < 0 {
+			diff = -diff
+		}
+		if diff > v.tolerance {
+			return ErrTimestampExpired
+		}
+	}
+
+	for _, sig := range signatures {
+		for _, key := range v.keys {
+			// key id is optional for receivers that do not track it
+			if sig.keyID != "" && sig.keyID != key.ID {
+				continue
+			}
+
+			expected, _ := hex.DecodeString(ComputeSignature(payload, ts, key.Secret))
+			if !hmac.Equal(expected, sig.value) {
+				continue
+			}
+
+			if v.replay != nil && !v.replay.Remember(hex.EncodeToString(sig.value), signedAt.Add(v.tolerance)) {
+				return ErrReplayed
+			}
+
+			return nil
+		}
+	}
+
+	return ErrSignatureMismatch
+}
+
+// Verify checks X-Signature header with default tolerance and without replay cache
+func Verify(header string, payload []byte, secrets ...string) error {
+	return NewVerifier(secrets).Verify(header, payload)
+}
+
+type signature struct {
+	keyID string
+	value []byte
+}
+
+func parseHeader(header string) (int64, []signature, error) {
+	var (
+		ts         int64
+		hasTS      bool
+		signatures []signature
+	)
+
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return 0, nil, ErrInvalidHeader
+		}
+
+		switch key {
+		case timestampKey:
+			parsed, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return 0, nil, ErrInvalidHeader
+			}
+			ts, hasTS = parsed, true
+		case Version:
+			keyID, sigHex, found := strings.Cut(value, ":")
+			if !found {
+				keyID, sigHex = "", value
+			}
+			decoded, err := hex.DecodeString(sigHex)
+			if err != nil {
+				return 0, nil, ErrInvalidHeader
+			}
+			signatures = append(signatures, signature{keyID: keyID, value: decoded})
+		}
+		// unknown schemes are skipped so newer versions may be added alongside v1
+	}
+
+	if !hasTS || len(signatures) == 0 {
+		return 0, nil, ErrInvalidHeader
+	}
+
+	return ts, signatures, nil
+}
+
+// MemoryReplayCache is in-process ReplayCache, use shared storage when receiver runs several instances
+type MemoryReplayCache struct {
+	mu   sync.Mutex
+	seen map[string]time.Time
+	now  func() time.Time
+}
+
+func NewMemoryReplayCache() *MemoryReplayCache {
+	return &MemoryReplayCache{
+		seen: make(map[string]time.Time),
+		now:  time.Now,
+	}
+}
+
+func (c *MemoryReplayCache) Remember(signature string, expiresAt time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	for sig, exp := range c.seen {
+		if now.After(exp) {
+			delete(c.seen, sig)
+		}
+	}
+
+	if _, ok := c.seen[signature]; ok {
+		return false
+	}
+	c.seen[signature] = expiresAt
+
+	return true
+}
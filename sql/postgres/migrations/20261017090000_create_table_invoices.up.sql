@@ -0,0 +1,55 @@
+CREATE TABLE IF NOT EXISTS invoices
+(
+    id              uuid PRIMARY KEY         DEFAULT gen_random_uuid(),
+    store_id        uuid            NOT NULL REFERENCES stores (id),
+    wallet_id       uuid            NOT NULL REFERENCES wallets (id),
+    currency_id     varchar(255)    NOT NULL REFERENCES currencies (id),
+    address         varchar(255)    NOT NULL,
+    order_id        varchar(255)             DEFAULT NULL,
+    description     text                     DEFAULT NULL,
+    status          varchar(50)     NOT NULL DEFAULT 'pending',
+    amount          numeric(90, 50) NOT NULL CHECK (amount > 0),
+    amount_usd      numeric(28, 4)  NOT NULL,
+    paid_amount     numeric(90, 50) NOT NULL DEFAULT 0,
+    paid_amount_usd numeric(28, 4)  NOT NULL DEFAULT 0,
+    expires_at      timestamp       NOT NULL,
+    paid_at         timestamp                DEFAULT NULL,
+    created_at      timestamp       NOT NULL DEFAULT now(),
+    updated_at      timestamp                DEFAULT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_invoices_store_id_created_at ON invoices (store_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_invoices_status_expires_at ON invoices (status, expires_at);
+
+-- only one open invoice may listen on a deposit address for a given currency
+CREATE UNIQUE INDEX IF NOT EXISTS uq_invoices_open_address_currency ON invoices (address, currency_id)
+    WHERE status IN ('pending', 'partially_paid');
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_invoices_store_order_id ON invoices (store_id, order_id)
+    WHERE order_id IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS invoice_status_history
+(
+    id             uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    invoice_id     uuid        NOT NULL REFERENCES invoices (id) ON DELETE CASCADE,
+    status_from    varchar(50) NOT NULL,
+    status_to      varchar(50) NOT NULL,
+    transaction_id uuid                 DEFAULT NULL REFERENCES transactions (id),
+    created_at     timestamp   NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_invoice_status_history_invoice_id ON invoice_status_history (invoice_id);
+
+-- every deposit applied to an invoice, partial payments included
+CREATE TABLE IF NOT EXISTS invoice_payments
+(
+    id             uuid PRIMARY KEY         DEFAULT gen_random_uuid(),
+    invoice_id     uuid            NOT NULL REFERENCES invoices (id) ON DELETE CASCADE,
+    transaction_id uuid            NOT NULL REFERENCES transactions (id),
+    amount         numeric(90, 50) NOT NULL,
+    amount_usd     numeric(28, 4)  NOT NULL,
+    created_at     timestamp       NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_invoice_payments_invoice_id ON invoice_payments (invoice_id);
+CREATE UNIQUE INDEX IF NOT EXISTS uq_invoice_payments_transaction_id ON invoice_payments (transaction_id);
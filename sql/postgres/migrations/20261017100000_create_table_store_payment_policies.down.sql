@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_invoices_wallet_id_unused_credit;
+
+ALTER TABLE invoices
+    DROP COLUMN IF EXISTS credited_to_invoice_id,
+    DROP COLUMN IF EXISTS credit_amount_usd,
+    DROP COLUMN IF EXISTS leftover_action,
+    DROP COLUMN IF EXISTS leftover_amount_usd;
+
+DROP TABLE IF EXISTS store_payment_policies;
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS store_payment_policies
+(
+    id              uuid PRIMARY KEY        DEFAULT gen_random_uuid(),
+    store_id        uuid           NOT NULL UNIQUE REFERENCES stores (id),
+    threshold_type  varchar(50)    NOT NULL DEFAULT 'percent', -- 'absolute' | 'percent'
+    threshold       numeric(28, 8) NOT NULL DEFAULT 0 CHECK (threshold >= 0),
+    rounding        varchar(50)    NOT NULL DEFAULT 'half',    -- 'up' | 'down' | 'half'
+    leftover_action varchar(50)    NOT NULL DEFAULT 'ignore',  -- 'refund_request' | 'credit_next_invoice' | 'ignore'
+    created_at      timestamp      NOT NULL DEFAULT now(),
+    updated_at      timestamp               DEFAULT NULL
+);
+
+ALTER TABLE invoices
+    ADD COLUMN IF NOT EXISTS leftover_amount_usd    numeric(28, 4) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS leftover_action        varchar(50)             DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS credit_amount_usd      numeric(28, 4) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS credited_to_invoice_id uuid                    DEFAULT NULL REFERENCES invoices (id);
+
+CREATE INDEX IF NOT EXISTS idx_invoices_wallet_id_unused_credit ON invoices (wallet_id)
+    WHERE leftover_action = 'credit_next_invoice' AND credited_to_invoice_id IS NULL;
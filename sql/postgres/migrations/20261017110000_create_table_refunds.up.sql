@@ -0,0 +1,42 @@
+CREATE TABLE IF NOT EXISTS refunds
+(
+    id               uuid PRIMARY KEY         DEFAULT gen_random_uuid(),
+    store_id         uuid            NOT NULL REFERENCES stores (id),
+    transaction_id   uuid            NOT NULL REFERENCES transactions (id),
+    invoice_id       uuid                     DEFAULT NULL REFERENCES invoices (id),
+    currency_id      varchar(255)    NOT NULL REFERENCES currencies (id),
+    reason           varchar(50)     NOT NULL,
+    status           varchar(50)     NOT NULL DEFAULT 'pending_approval',
+    amount           numeric(90, 50) NOT NULL CHECK (amount > 0),
+    amount_usd       numeric(28, 4)  NOT NULL DEFAULT 0,
+    address_to       varchar(255)    NOT NULL,
+    withdrawal_id    uuid                     DEFAULT NULL REFERENCES withdrawal_from_processing_wallets (id),
+    refund_tx_hash   varchar(255)             DEFAULT NULL,
+    requested_by     uuid                     DEFAULT NULL REFERENCES users (id),
+    decided_by       uuid                     DEFAULT NULL REFERENCES users (id),
+    decided_at       timestamp                DEFAULT NULL,
+    rejection_reason text                     DEFAULT NULL,
+    failure_reason   text                     DEFAULT NULL,
+    created_at       timestamp       NOT NULL DEFAULT now(),
+    updated_at       timestamp                DEFAULT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_refunds_store_id_created_at ON refunds (store_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_refunds_status ON refunds (status);
+CREATE INDEX IF NOT EXISTS idx_refunds_withdrawal_id ON refunds (withdrawal_id);
+
+-- only one refund of a transaction may be in flight at a time
+CREATE UNIQUE INDEX IF NOT EXISTS uq_refunds_active_transaction_id ON refunds (transaction_id)
+    WHERE status IN ('pending_approval', 'approved', 'processing');
+
+CREATE TABLE IF NOT EXISTS refund_status_history
+(
+    id          uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    refund_id   uuid        NOT NULL REFERENCES refunds (id) ON DELETE CASCADE,
+    status_from varchar(50)          DEFAULT NULL,
+    status_to   varchar(50) NOT NULL,
+    user_id     uuid                 DEFAULT NULL REFERENCES users (id),
+    created_at  timestamp   NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_refund_status_history_refund_id ON refund_status_history (refund_id);
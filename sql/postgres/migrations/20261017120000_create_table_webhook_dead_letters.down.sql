@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS webhook_dead_letters;
+DROP TABLE IF EXISTS store_webhook_retry_policies;
+
+ALTER TABLE webhook_send_queue ALTER COLUMN seconds_delay TYPE smallint USING least(seconds_delay, 32767);
@@ -0,0 +1,35 @@
+-- custom schedules may wait longer than smallint seconds allow
+ALTER TABLE webhook_send_queue ALTER COLUMN seconds_delay TYPE integer;
+
+CREATE TABLE IF NOT EXISTS store_webhook_retry_policies
+(
+    id                uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    store_id          uuid        NOT NULL UNIQUE REFERENCES stores (id) ON DELETE CASCADE,
+    policy_type       varchar(50) NOT NULL,
+    max_attempts      integer     NOT NULL CHECK (max_attempts > 0),
+    delay_seconds     integer     NOT NULL DEFAULT 0 CHECK (delay_seconds >= 0),
+    max_delay_seconds integer     NOT NULL DEFAULT 0 CHECK (max_delay_seconds >= 0),
+    schedule_seconds  integer[]   NOT NULL DEFAULT '{}',
+    created_at        timestamp   NOT NULL DEFAULT now(),
+    updated_at        timestamp            DEFAULT NULL
+);
+
+CREATE TABLE IF NOT EXISTS webhook_dead_letters
+(
+    id                        uuid PRIMARY KEY      DEFAULT gen_random_uuid(),
+    send_queue_job_id         uuid         NOT NULL,
+    webhook_id                uuid         NOT NULL REFERENCES store_webhooks (id) ON DELETE CASCADE,
+    store_id                  uuid         NOT NULL REFERENCES stores (id) ON DELETE CASCADE,
+    transaction_id            uuid         NOT NULL,
+    event                     varchar(50)  NOT NULL,
+    url                       varchar(255) NOT NULL,
+    payload                   json         NOT NULL,
+    signature                 varchar(255) NOT NULL,
+    attempts                  integer      NOT NULL,
+    last_response             text                  DEFAULT NULL,
+    last_response_status_code integer      NOT NULL DEFAULT 0,
+    requeued_at               timestamp             DEFAULT NULL,
+    created_at                timestamp    NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_dead_letters_store_id_created_at ON webhook_dead_letters (store_id, created_at);
@@ -0,0 +1,3 @@
+ALTER TABLE store_secrets
+    DROP COLUMN IF EXISTS previous_secret,
+    DROP COLUMN IF EXISTS previous_expires_at;
@@ -0,0 +1,4 @@
+-- previous secret keeps signing webhooks until rotation window ends
+ALTER TABLE store_secrets
+    ADD COLUMN IF NOT EXISTS previous_secret     varchar(255) DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS previous_expires_at timestamp    DEFAULT NULL;
@@ -0,0 +1,6 @@
+ALTER TABLE store_webhooks
+    DROP COLUMN IF EXISTS success_mode,
+    DROP COLUMN IF EXISTS success_status_codes,
+    DROP COLUMN IF EXISTS success_json_path,
+    DROP COLUMN IF EXISTS success_json_value,
+    DROP COLUMN IF EXISTS timeout_seconds;
@@ -0,0 +1,7 @@
+ALTER TABLE store_webhooks
+    ADD COLUMN IF NOT EXISTS success_mode         varchar(50)  NOT NULL DEFAULT 'json_body',
+    ADD COLUMN IF NOT EXISTS success_status_codes integer[]    NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS success_json_path    varchar(255) NOT NULL DEFAULT 'success',
+    ADD COLUMN IF NOT EXISTS success_json_value   varchar(255) NOT NULL DEFAULT 'true',
+    -- zero falls back to web_hook.request_timeout config
+    ADD COLUMN IF NOT EXISTS timeout_seconds      integer      NOT NULL DEFAULT 0 CHECK (timeout_seconds >= 0);
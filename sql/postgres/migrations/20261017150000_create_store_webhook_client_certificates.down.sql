@@ -0,0 +1 @@
+DROP TABLE IF EXISTS store_webhook_client_certificates;
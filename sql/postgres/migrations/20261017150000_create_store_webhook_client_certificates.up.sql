@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS store_webhook_client_certificates
+(
+    id          uuid PRIMARY KEY      DEFAULT gen_random_uuid(),
+    store_id    uuid         NOT NULL UNIQUE REFERENCES stores (id) ON DELETE CASCADE,
+    certificate text         NOT NULL,
+    private_key text         NOT NULL,
+    fingerprint varchar(64)  NOT NULL,
+    subject     varchar(255) NOT NULL,
+    expires_at  timestamp    NOT NULL,
+    created_at  timestamp    NOT NULL DEFAULT now(),
+    updated_at  timestamp             DEFAULT NULL
+);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS webhook_send_queue_created_at_idx;
+
+ALTER TABLE webhook_send_queue DROP COLUMN IF EXISTS locked_until;
@@ -0,0 +1,4 @@
+-- replica claiming a message owns it until the lease expires
+ALTER TABLE webhook_send_queue ADD COLUMN IF NOT EXISTS locked_until timestamp DEFAULT NULL;
+
+CREATE INDEX IF NOT EXISTS webhook_send_queue_created_at_idx ON webhook_send_queue (created_at);
@@ -0,0 +1 @@
+ALTER TABLE store_webhooks DROP COLUMN IF EXISTS schema_version;
@@ -0,0 +1,2 @@
+-- 1 keeps legacy flat payloads, 2 wraps them into a versioned envelope
+ALTER TABLE store_webhooks ADD COLUMN IF NOT EXISTS schema_version integer NOT NULL DEFAULT 1 CHECK (schema_version IN (1, 2));
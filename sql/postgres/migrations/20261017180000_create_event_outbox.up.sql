@@ -0,0 +1,23 @@
+-- domain events written in the same database transaction as the change they describe,
+-- the relay publishes them to in-process handlers at least once
+CREATE TABLE IF NOT EXISTS event_outbox
+(
+    id              uuid PRIMARY KEY      DEFAULT gen_random_uuid(),
+    event_type      varchar(100) NOT NULL,
+    idempotency_key varchar(255) NOT NULL UNIQUE,
+    payload         jsonb        NOT NULL,
+    status          varchar(20)  NOT NULL DEFAULT 'pending',
+    attempts        integer      NOT NULL DEFAULT 0,
+    last_error      text                  DEFAULT NULL,
+    available_at    timestamp    NOT NULL DEFAULT now(),
+    locked_until    timestamp             DEFAULT NULL,
+    created_at      timestamp    NOT NULL DEFAULT now(),
+    processed_at    timestamp             DEFAULT NULL
+);
+
+CREATE INDEX IF NOT EXISTS event_outbox_pending_idx ON event_outbox (available_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS event_outbox_created_at_idx ON event_outbox (created_at);
+
+-- replayed deposit events are matched against invoice payments already recorded
+CREATE INDEX IF NOT EXISTS idx_invoice_status_history_transaction_id ON invoice_status_history (transaction_id)
+    WHERE transaction_id IS NOT NULL;
@@ -0,0 +1,12 @@
+-- rates snapshotted once per configured resolution, used for point-in-time lookups
+CREATE TABLE IF NOT EXISTS exchange_rate_history
+(
+    source        varchar(50) NOT NULL,
+    from_currency varchar(50) NOT NULL,
+    to_currency   varchar(50) NOT NULL,
+    value         numeric     NOT NULL,
+    snapshot_at   timestamp   NOT NULL,
+    PRIMARY KEY (source, from_currency, to_currency, snapshot_at)
+);
+
+CREATE INDEX IF NOT EXISTS exchange_rate_history_snapshot_at_idx ON exchange_rate_history (snapshot_at);
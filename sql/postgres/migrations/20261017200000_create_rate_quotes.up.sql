@@ -0,0 +1,19 @@
+-- rate locked for the payer of a wallet, deposits received before expiry are valued at it
+CREATE TABLE IF NOT EXISTS rate_quotes
+(
+    id          uuid PRIMARY KEY         DEFAULT gen_random_uuid(),
+    store_id    uuid            NOT NULL REFERENCES stores (id),
+    wallet_id   uuid            NOT NULL REFERENCES wallets (id),
+    invoice_id  uuid                     DEFAULT NULL REFERENCES invoices (id) ON DELETE CASCADE,
+    currency_id varchar(255)    NOT NULL REFERENCES currencies (id),
+    source      varchar(50)     NOT NULL,
+    rate        numeric(90, 50) NOT NULL CHECK (rate > 0),
+    amount      numeric(90, 50)          DEFAULT NULL,
+    amount_usd  numeric(28, 4)           DEFAULT NULL,
+    -- part of the amount already valued at the rate by received deposits
+    used_amount numeric(90, 50) NOT NULL DEFAULT 0,
+    expires_at  timestamp       NOT NULL,
+    created_at  timestamp       NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_rate_quotes_wallet_currency_expires_at ON rate_quotes (wallet_id, currency_id, expires_at);
@@ -0,0 +1,2 @@
+ALTER TABLE IF EXISTS exchange_orders
+    DROP COLUMN IF EXISTS route;
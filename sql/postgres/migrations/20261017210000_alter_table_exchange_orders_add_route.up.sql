@@ -0,0 +1,2 @@
+ALTER TABLE IF EXISTS exchange_orders
+    ADD COLUMN IF NOT EXISTS route jsonb NULL;
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS exchange_orders_parent_id_idx;
+
+ALTER TABLE IF EXISTS exchange_orders
+    DROP COLUMN IF EXISTS expires_at,
+    DROP COLUMN IF EXISTS scheduled_at,
+    DROP COLUMN IF EXISTS parent_id,
+    DROP COLUMN IF EXISTS time_in_force,
+    DROP COLUMN IF EXISTS price,
+    DROP COLUMN IF EXISTS order_type;
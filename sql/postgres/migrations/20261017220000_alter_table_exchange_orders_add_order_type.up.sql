@@ -0,0 +1,9 @@
+ALTER TABLE IF EXISTS exchange_orders
+    ADD COLUMN IF NOT EXISTS order_type varchar(20) NOT NULL DEFAULT 'market',
+    ADD COLUMN IF NOT EXISTS price numeric(90, 30) NULL,
+    ADD COLUMN IF NOT EXISTS time_in_force varchar(10) NULL,
+    ADD COLUMN IF NOT EXISTS parent_id uuid NULL REFERENCES exchange_orders (id) ON DELETE CASCADE,
+    ADD COLUMN IF NOT EXISTS scheduled_at timestamp NULL,
+    ADD COLUMN IF NOT EXISTS expires_at timestamp NULL;
+
+CREATE INDEX IF NOT EXISTS exchange_orders_parent_id_idx ON exchange_orders (parent_id);
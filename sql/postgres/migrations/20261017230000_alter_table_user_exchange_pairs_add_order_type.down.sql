@@ -0,0 +1,6 @@
+ALTER TABLE IF EXISTS user_exchange_pairs
+    DROP COLUMN IF EXISTS twap_window_seconds,
+    DROP COLUMN IF EXISTS twap_slices,
+    DROP COLUMN IF EXISTS time_in_force,
+    DROP COLUMN IF EXISTS price_offset,
+    DROP COLUMN IF EXISTS order_type;
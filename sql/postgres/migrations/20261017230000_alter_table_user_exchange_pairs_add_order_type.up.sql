@@ -0,0 +1,6 @@
+ALTER TABLE IF EXISTS user_exchange_pairs
+    ADD COLUMN IF NOT EXISTS order_type varchar(20) NOT NULL DEFAULT 'market',
+    ADD COLUMN IF NOT EXISTS price_offset numeric(10, 4) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS time_in_force varchar(10) NOT NULL DEFAULT 'gtc',
+    ADD COLUMN IF NOT EXISTS twap_slices integer NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS twap_window_seconds integer NOT NULL DEFAULT 0;
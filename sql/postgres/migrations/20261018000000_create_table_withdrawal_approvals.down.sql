@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS withdrawal_approval_decisions;
+DROP TABLE IF EXISTS withdrawal_approvals;
+DROP TABLE IF EXISTS withdrawal_approval_rules;
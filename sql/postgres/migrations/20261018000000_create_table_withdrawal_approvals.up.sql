@@ -0,0 +1,53 @@
+CREATE TABLE IF NOT EXISTS withdrawal_approval_rules
+(
+    id                 uuid PRIMARY KEY        DEFAULT gen_random_uuid(),
+    currency_id        varchar(255)            DEFAULT NULL REFERENCES currencies (id),
+    address_to         varchar(255)            DEFAULT NULL,
+    min_amount_usd     numeric(28, 4)          DEFAULT NULL,
+    required_approvals integer        NOT NULL CHECK (required_approvals > 0),
+    created_by         uuid                    DEFAULT NULL REFERENCES users (id),
+    created_at         timestamp      NOT NULL DEFAULT now(),
+    updated_at         timestamp               DEFAULT NULL
+);
+
+CREATE TABLE IF NOT EXISTS withdrawal_approvals
+(
+    id                 uuid PRIMARY KEY         DEFAULT gen_random_uuid(),
+    user_id            uuid            NOT NULL REFERENCES users (id),
+    kind               varchar(50)     NOT NULL,
+    status             varchar(50)     NOT NULL DEFAULT 'pending',
+    currency_id        varchar(255)    NOT NULL REFERENCES currencies (id),
+    address_to         varchar(255)    NOT NULL,
+    amount             numeric(90, 50) NOT NULL,
+    amount_usd         numeric(28, 4)  NOT NULL DEFAULT 0,
+    payload            jsonb           NOT NULL,
+    required_approvals integer         NOT NULL CHECK (required_approvals > 0),
+    rule_id            uuid                     DEFAULT NULL REFERENCES withdrawal_approval_rules (id) ON DELETE SET NULL,
+    initiated_by       uuid                     DEFAULT NULL REFERENCES users (id),
+    store_id           uuid                     DEFAULT NULL REFERENCES stores (id),
+    request_id         varchar(255)             DEFAULT NULL,
+    expires_at         timestamp       NOT NULL,
+    executed_at        timestamp                DEFAULT NULL,
+    failure_reason     text                     DEFAULT NULL,
+    created_at         timestamp       NOT NULL DEFAULT now(),
+    updated_at         timestamp                DEFAULT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_withdrawal_approvals_status_expires_at ON withdrawal_approvals (status, expires_at);
+CREATE INDEX IF NOT EXISTS idx_withdrawal_approvals_user_id_created_at ON withdrawal_approvals (user_id, created_at);
+-- request id of a held withdrawal is reserved until the request is closed
+CREATE UNIQUE INDEX IF NOT EXISTS uq_withdrawal_approvals_kind_store_id_request_id ON withdrawal_approvals (kind, store_id, request_id)
+    WHERE request_id IS NOT NULL AND status IN ('pending', 'approved');
+
+CREATE TABLE IF NOT EXISTS withdrawal_approval_decisions
+(
+    id          uuid PRIMARY KEY     DEFAULT gen_random_uuid(),
+    approval_id uuid        NOT NULL REFERENCES withdrawal_approvals (id) ON DELETE CASCADE,
+    user_id     uuid        NOT NULL REFERENCES users (id),
+    decision    varchar(50) NOT NULL,
+    comment     text                 DEFAULT NULL,
+    created_at  timestamp   NOT NULL DEFAULT now()
+);
+
+-- every approver signs a request at most once
+CREATE UNIQUE INDEX IF NOT EXISTS uq_withdrawal_approval_decisions_approval_id_user_id ON withdrawal_approval_decisions (approval_id, user_id);
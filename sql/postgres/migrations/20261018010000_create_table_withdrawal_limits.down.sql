@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS withdrawal_limit_usages;
+DROP TABLE IF EXISTS withdrawal_limits;
@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS withdrawal_limits
+(
+    id             uuid PRIMARY KEY        DEFAULT gen_random_uuid(),
+    user_id        uuid           NOT NULL REFERENCES users (id),
+    scope          varchar(50)    NOT NULL,
+    scope_value    varchar(255)            DEFAULT NULL,
+    window_seconds integer        NOT NULL CHECK (window_seconds > 0 AND window_seconds <= 2592000),
+    max_amount_usd numeric(28, 4)          DEFAULT NULL CHECK (max_amount_usd > 0),
+    max_count      integer                 DEFAULT NULL CHECK (max_count > 0),
+    created_at     timestamp      NOT NULL DEFAULT now(),
+    updated_at     timestamp               DEFAULT NULL,
+    CHECK (max_amount_usd IS NOT NULL OR max_count IS NOT NULL)
+);
+
+CREATE INDEX IF NOT EXISTS idx_withdrawal_limits_user_id ON withdrawal_limits (user_id);
+
+-- withdrawals accepted by limits, windows are evaluated against this ledger
+CREATE TABLE IF NOT EXISTS withdrawal_limit_usages
+(
+    id          uuid PRIMARY KEY        DEFAULT gen_random_uuid(),
+    user_id     uuid           NOT NULL REFERENCES users (id),
+    store_id    uuid                    DEFAULT NULL REFERENCES stores (id),
+    currency_id varchar(255)   NOT NULL REFERENCES currencies (id),
+    address_to  varchar(255)   NOT NULL,
+    amount_usd  numeric(28, 4) NOT NULL DEFAULT 0,
+    created_at  timestamp      NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_withdrawal_limit_usages_user_id_created_at ON withdrawal_limit_usages (user_id, created_at);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_user_address_book_revoke_token;
+
+ALTER TABLE user_address_book
+    DROP COLUMN IF EXISTS revoke_token_expires_at,
+    DROP COLUMN IF EXISTS revoke_token,
+    DROP COLUMN IF EXISTS active_at;
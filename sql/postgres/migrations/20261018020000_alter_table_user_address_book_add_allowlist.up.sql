@@ -0,0 +1,6 @@
+ALTER TABLE user_address_book
+    ADD COLUMN IF NOT EXISTS active_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS revoke_token uuid DEFAULT NULL,
+    ADD COLUMN IF NOT EXISTS revoke_token_expires_at TIMESTAMP WITHOUT TIME ZONE DEFAULT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_user_address_book_revoke_token ON user_address_book(revoke_token);
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS withdrawal_wallet_schedules
+(
+    id                   uuid           DEFAULT gen_random_uuid() NOT NULL PRIMARY KEY,
+    withdrawal_wallet_id uuid                                     NOT NULL UNIQUE
+        REFERENCES withdrawal_wallets (id) ON DELETE CASCADE,
+    cron_expression      varchar(255)                             NULL,
+    timezone             varchar(64)    DEFAULT 'UTC'             NOT NULL,
+    min_balance_usd      numeric(28, 4)                           NULL CHECK (min_balance_usd > 0),
+    max_gas_price_gwei   numeric(28, 9)                           NULL CHECK (max_gas_price_gwei > 0),
+    keep_float_usd       numeric(28, 4)                           NULL CHECK (keep_float_usd > 0),
+    run_started_at       timestamp                                NULL,
+    last_run_at          timestamp                                NULL,
+    created_at           timestamp      DEFAULT now()             NOT NULL,
+    updated_at           timestamp                                NULL,
+    CHECK (cron_expression IS NOT NULL OR min_balance_usd IS NOT NULL OR max_gas_price_gwei IS NOT NULL OR keep_float_usd IS NOT NULL)
+);
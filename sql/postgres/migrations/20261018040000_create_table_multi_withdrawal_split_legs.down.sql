@@ -0,0 +1 @@
+DROP TABLE IF EXISTS multi_withdrawal_split_legs;
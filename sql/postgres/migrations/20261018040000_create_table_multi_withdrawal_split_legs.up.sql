@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS multi_withdrawal_split_legs
+(
+    id                       uuid           DEFAULT gen_random_uuid() NOT NULL PRIMARY KEY,
+    multi_withdrawal_rule_id uuid                                     NOT NULL
+        REFERENCES multi_withdrawal_rules (id) ON DELETE CASCADE,
+    address                  varchar(255)                             NOT NULL,
+    percent                  numeric(7, 4)                            NOT NULL CHECK (percent > 0 AND percent <= 100),
+    min_amount_usd           numeric(28, 4)                           NULL CHECK (min_amount_usd > 0),
+    receives_remainder       boolean        DEFAULT false             NOT NULL,
+    created_at               timestamp      DEFAULT now()             NOT NULL,
+    UNIQUE (multi_withdrawal_rule_id, address)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS multi_withdrawal_split_legs_remainder_idx
+    ON multi_withdrawal_split_legs (multi_withdrawal_rule_id)
+    WHERE receives_remainder;
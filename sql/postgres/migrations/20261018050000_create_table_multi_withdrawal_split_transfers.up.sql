@@ -0,0 +1,21 @@
+-- planned legs of a split sweep, a partially sent batch is resumed instead of being split again
+CREATE TABLE IF NOT EXISTS multi_withdrawal_split_transfers
+(
+    id                       uuid           DEFAULT gen_random_uuid() NOT NULL PRIMARY KEY,
+    batch_id                 uuid                                     NOT NULL,
+    multi_withdrawal_rule_id uuid                                     NOT NULL
+        REFERENCES multi_withdrawal_rules (id) ON DELETE CASCADE,
+    address                  varchar(255)                             NOT NULL,
+    amount                   numeric(90, 50)                          NOT NULL,
+    amount_usd               numeric(28, 4)                           NOT NULL,
+    receives_remainder       boolean        DEFAULT false             NOT NULL,
+    -- hot wallet addresses the batch was planned from, every leg is sent from them only
+    from_addresses           varchar(255)[]                           NOT NULL,
+    -- id the leg transfer is requested with, set before the request so a lost response is retried with the same id
+    transfer_id              uuid                                     NULL,
+    created_at               timestamp      DEFAULT now()             NOT NULL,
+    UNIQUE (batch_id, address)
+);
+
+CREATE INDEX IF NOT EXISTS multi_withdrawal_split_transfers_rule_idx
+    ON multi_withdrawal_split_transfers (multi_withdrawal_rule_id, created_at);
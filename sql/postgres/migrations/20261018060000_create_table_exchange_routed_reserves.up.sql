@@ -0,0 +1,11 @@
+-- funds of the pair exchange which were already swapped on another exchange by routed orders,
+-- later swaps of the pair leave them out
+CREATE TABLE IF NOT EXISTS exchange_routed_reserves
+(
+    user_id     uuid            NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    exchange_id uuid            NOT NULL REFERENCES exchanges (id) ON DELETE CASCADE,
+    currency    varchar(255)    NOT NULL,
+    amount      numeric(90, 50) NOT NULL DEFAULT 0 CHECK (amount >= 0),
+    updated_at  timestamp       NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, exchange_id, currency)
+);
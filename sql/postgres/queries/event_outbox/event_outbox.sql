@@ -0,0 +1,66 @@
+-- name: Enqueue :execrows
+-- events already stored under the same idempotency key are skipped
+INSERT INTO event_outbox (event_type, idempotency_key, payload, created_at)
+VALUES ($1, $2, $3, now())
+ON CONFLICT (idempotency_key) DO NOTHING;
+
+-- name: Claim :many
+-- leases due events to the caller, rows claimed by other replicas are skipped
+WITH claimed AS (SELECT id
+                 FROM event_outbox
+                 WHERE status = 'pending'
+                   AND available_at <= now()
+                   AND (locked_until is null or locked_until < now())
+                 ORDER BY created_at
+                 LIMIT sqlc.arg(batch_size) FOR UPDATE SKIP LOCKED)
+UPDATE event_outbox eo
+SET locked_until = now() + sqlc.arg(lease_seconds)::integer * interval '1 second'
+FROM claimed
+WHERE eo.id = claimed.id
+RETURNING eo.*;
+
+-- name: LockPending :one
+SELECT *
+FROM event_outbox
+WHERE id = $1
+  AND status = 'pending'
+FOR UPDATE;
+
+-- name: MarkProcessed :exec
+UPDATE event_outbox
+SET status       = 'processed',
+    attempts     = attempts + 1,
+    last_error   = null,
+    locked_until = null,
+    processed_at = now()
+WHERE id = $1;
+
+-- name: MarkAttemptFailed :exec
+UPDATE event_outbox
+SET status       = sqlc.arg(status),
+    attempts     = attempts + 1,
+    last_error   = sqlc.arg(last_error),
+    available_at = now() + sqlc.arg(delay_seconds)::integer * interval '1 second',
+    locked_until = null
+WHERE id = sqlc.arg(id);
+
+-- name: Replay :execrows
+-- publishes matching events again, handlers are expected to be idempotent
+UPDATE event_outbox
+SET status       = 'pending',
+    attempts     = 0,
+    last_error   = null,
+    available_at = now(),
+    locked_until = null,
+    processed_at = null
+WHERE status = ANY (sqlc.arg(statuses)::varchar[])
+  AND (sqlc.narg(id)::uuid IS NULL OR id = sqlc.narg(id)::uuid)
+  AND (sqlc.narg(event_type)::varchar IS NULL OR event_type = sqlc.narg(event_type)::varchar)
+  AND (sqlc.narg(created_from)::timestamp IS NULL OR created_at >= sqlc.narg(created_from)::timestamp)
+  AND (sqlc.narg(created_to)::timestamp IS NULL OR created_at < sqlc.narg(created_to)::timestamp);
+
+-- name: DeleteProcessedBefore :execrows
+DELETE
+FROM event_outbox
+WHERE status = 'processed'
+  AND processed_at < $1;
@@ -7,5 +7,29 @@ SET updated_at        = now(),
     amount_usd        = COALESCE(sqlc.narg(amount_usd), amount_usd),
     exchange_order_id = COALESCE(sqlc.narg(exchange_order_id), exchange_order_id),
     client_order_id   = COALESCE(sqlc.narg(client_order_id), client_order_id),
-    exchange_connection_hash = COALESCE(sqlc.narg(exchange_connection_hash), exchange_connection_hash)
-WHERE id = sqlc.arg(id);
\ No newline at end of file
+    exchange_connection_hash = COALESCE(sqlc.narg(exchange_connection_hash), exchange_connection_hash),
+    price             = COALESCE(sqlc.narg(price), price)
+WHERE id = sqlc.arg(id);
+
+-- name: HasActiveBySymbol :one
+SELECT EXISTS (SELECT 1
+              FROM exchange_orders
+              WHERE user_id = sqlc.arg(user_id)
+                AND exchange_id = sqlc.arg(exchange_id)
+                AND symbol = sqlc.arg(symbol)
+                AND parent_id IS NULL
+                AND status IN ('new', 'in_progress'));
+
+-- name: GetDueSlices :many
+SELECT *
+FROM exchange_orders
+WHERE status = 'new'
+  AND parent_id IS NOT NULL
+  AND scheduled_at <= now()
+ORDER BY scheduled_at;
+
+-- name: GetChildren :many
+SELECT *
+FROM exchange_orders
+WHERE parent_id = sqlc.arg(parent_id)
+ORDER BY scheduled_at;
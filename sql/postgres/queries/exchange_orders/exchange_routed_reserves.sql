@@ -0,0 +1,23 @@
+-- name: AddRoutedReserve :exec
+-- negative amount releases the reserve, it never goes below zero
+INSERT INTO exchange_routed_reserves (user_id, exchange_id, currency, amount, updated_at)
+VALUES ($1, $2, $3, GREATEST(sqlc.arg(amount)::numeric, 0), now())
+ON CONFLICT (user_id, exchange_id, currency) DO UPDATE
+    SET amount     = GREATEST(exchange_routed_reserves.amount + sqlc.arg(amount)::numeric, 0),
+        updated_at = now();
+
+-- name: CapRoutedReserve :exec
+-- reserve can not exceed the balance left on the exchange
+UPDATE exchange_routed_reserves
+SET amount     = LEAST(amount, sqlc.arg(balance)::numeric),
+    updated_at = now()
+WHERE user_id = $1
+  AND exchange_id = $2
+  AND currency = $3;
+
+-- name: GetRoutedReserve :one
+SELECT amount
+FROM exchange_routed_reserves
+WHERE user_id = $1
+  AND exchange_id = $2
+  AND currency = $3;
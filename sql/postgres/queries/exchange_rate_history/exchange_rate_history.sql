@@ -0,0 +1,26 @@
+-- name: Snapshot :execrows
+-- rates already stored for the snapshot time are skipped
+INSERT INTO exchange_rate_history (source, from_currency, to_currency, value, snapshot_at)
+SELECT unnest(sqlc.arg(sources)::varchar[]),
+       unnest(sqlc.arg(from_currencies)::varchar[]),
+       unnest(sqlc.arg(to_currencies)::varchar[]),
+       unnest(sqlc.arg(values)::text[])::numeric,
+       sqlc.arg(snapshot_at)::timestamp
+ON CONFLICT (source, from_currency, to_currency, snapshot_at) DO NOTHING;
+
+-- name: GetRateAt :one
+-- latest snapshot taken at or before the requested time
+SELECT *
+FROM exchange_rate_history
+WHERE source = $1
+  AND from_currency = $2
+  AND to_currency = $3
+  AND snapshot_at <= sqlc.arg(at)::timestamp
+  AND snapshot_at >= sqlc.arg(not_before)::timestamp
+ORDER BY snapshot_at DESC
+LIMIT 1;
+
+-- name: DeleteBefore :execrows
+DELETE
+FROM exchange_rate_history
+WHERE snapshot_at < $1;
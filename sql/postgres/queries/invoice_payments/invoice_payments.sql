@@ -0,0 +1,4 @@
+-- name: ExistsByTransactionID :one
+SELECT EXISTS(SELECT 1
+              FROM invoice_payments
+              WHERE transaction_id = $1);
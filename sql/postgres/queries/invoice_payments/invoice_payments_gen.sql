@@ -0,0 +1,4 @@
+-- name: Create :one
+INSERT INTO invoice_payments (invoice_id, transaction_id, amount, amount_usd, created_at)
+	VALUES ($1, $2, $3, $4, now())
+	RETURNING *;
@@ -0,0 +1,5 @@
+-- name: GetByInvoiceID :many
+SELECT *
+FROM invoice_status_history
+WHERE invoice_id = $1
+ORDER BY created_at;
@@ -0,0 +1,4 @@
+-- name: Create :one
+INSERT INTO invoice_status_history (invoice_id, status_from, status_to, transaction_id, created_at)
+	VALUES ($1, $2, $3, $4, now())
+	RETURNING *;
@@ -0,0 +1,93 @@
+-- name: GetByDepositTx :one
+-- invoice the deposit belongs to: either already applied to it or still waiting on its address
+SELECT sqlc.embed(invoices),
+       EXISTS (SELECT 1
+               FROM invoice_payments p
+               WHERE p.invoice_id = invoices.id
+                 AND p.transaction_id = sqlc.arg(transaction_id)::uuid)::bool AS payment_applied
+FROM invoices
+WHERE invoices.address = sqlc.arg(address)
+  AND invoices.currency_id = sqlc.arg(currency_id)
+  AND (
+    (invoices.status IN ('pending', 'partially_paid') AND invoices.expires_at > now())
+        OR EXISTS (SELECT 1
+                   FROM invoice_payments p
+                   WHERE p.invoice_id = invoices.id
+                     AND p.transaction_id = sqlc.arg(transaction_id)::uuid)
+    )
+ORDER BY invoices.created_at DESC
+LIMIT 1;
+
+-- name: GetByIDForUpdate :one
+SELECT *
+FROM invoices
+WHERE id = $1
+LIMIT 1 FOR UPDATE;
+
+-- name: GetOpenByAddressForUpdate :one
+SELECT *
+FROM invoices
+WHERE address = $1
+  AND currency_id = $2
+  AND status IN ('pending', 'partially_paid')
+  AND expires_at > now()
+LIMIT 1 FOR UPDATE;
+
+-- name: GetOverdueByAddressForUpdate :many
+SELECT *
+FROM invoices
+WHERE address = $1
+  AND currency_id = $2
+  AND status IN ('pending', 'partially_paid')
+  AND expires_at <= now()
+FOR UPDATE;
+
+-- name: GetOverdueForUpdate :many
+SELECT *
+FROM invoices
+WHERE status IN ('pending', 'partially_paid')
+  AND expires_at <= now()
+ORDER BY expires_at
+LIMIT $1 FOR UPDATE SKIP LOCKED;
+
+-- name: GetUnusedCreditsForUpdate :many
+SELECT *
+FROM invoices
+WHERE wallet_id = $1
+  AND leftover_action = 'credit_next_invoice'
+  AND leftover_amount_usd > 0
+  AND credited_to_invoice_id IS NULL
+ORDER BY created_at
+FOR UPDATE;
+
+-- name: MarkCredited :exec
+UPDATE invoices
+SET credited_to_invoice_id = $1,
+    updated_at             = now()
+WHERE id = ANY ($2::uuid[]);
+
+-- name: SetCredit :one
+UPDATE invoices
+SET credit_amount_usd = $1,
+    updated_at        = now()
+WHERE id = $2
+RETURNING *;
+
+-- name: UpdatePayment :one
+UPDATE invoices
+SET paid_amount         = $1,
+    paid_amount_usd     = $2,
+    status              = $3,
+    paid_at             = $4,
+    leftover_amount_usd = $5,
+    leftover_action     = $6,
+    updated_at          = now()
+WHERE id = $7
+RETURNING *;
+
+-- name: UpdateStatus :one
+UPDATE invoices
+SET status     = $1,
+    updated_at = now()
+WHERE id = $2
+RETURNING *;
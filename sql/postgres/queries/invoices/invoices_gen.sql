@@ -0,0 +1,7 @@
+-- name: Create :one
+INSERT INTO invoices (store_id, wallet_id, currency_id, address, order_id, description, status, amount, amount_usd, expires_at, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, now())
+	RETURNING *;
+
+-- name: GetByID :one
+SELECT * FROM invoices WHERE id=$1 LIMIT 1;
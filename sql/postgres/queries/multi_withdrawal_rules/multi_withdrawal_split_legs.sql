@@ -0,0 +1,15 @@
+-- name: CreateSplitLeg :one
+INSERT INTO multi_withdrawal_split_legs (multi_withdrawal_rule_id, address, percent, min_amount_usd, receives_remainder, created_at)
+VALUES ($1, $2, $3, $4, $5, now())
+RETURNING *;
+
+-- name: GetSplitLegsByRuleID :many
+SELECT *
+FROM multi_withdrawal_split_legs
+WHERE multi_withdrawal_rule_id = $1
+ORDER BY receives_remainder, percent DESC, created_at;
+
+-- name: RemoveSplitLegsByRuleID :exec
+DELETE
+FROM multi_withdrawal_split_legs
+WHERE multi_withdrawal_rule_id = $1;
@@ -0,0 +1,21 @@
+-- name: CreateSplitTransfer :one
+INSERT INTO multi_withdrawal_split_transfers (batch_id, multi_withdrawal_rule_id, address, amount, amount_usd, receives_remainder, from_addresses, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, now())
+RETURNING *;
+
+-- name: GetLatestSplitBatch :many
+-- planned transfers of the latest split batch of the rule with status of their transfers, empty when there is none
+SELECT sqlc.embed(mwst), COALESCE(t.status, '')::varchar AS transfer_status
+FROM multi_withdrawal_split_transfers mwst
+         LEFT JOIN transfers t ON t.id = mwst.transfer_id
+WHERE mwst.batch_id = (SELECT batch_id
+                       FROM multi_withdrawal_split_transfers
+                       WHERE multi_withdrawal_rule_id = $1
+                       ORDER BY created_at DESC
+                       LIMIT 1)
+ORDER BY mwst.receives_remainder, mwst.amount DESC, mwst.address;
+
+-- name: SetSplitTransferTransferID :exec
+UPDATE multi_withdrawal_split_transfers
+SET transfer_id = $2
+WHERE id = $1;
@@ -0,0 +1,16 @@
+-- name: AddUsedAmount :exec
+UPDATE rate_quotes
+SET used_amount = used_amount + sqlc.arg(amount)
+WHERE id = sqlc.arg(id);
+
+-- name: GetLocked :one
+-- latest quote of the wallet currency which was active at the requested time, the row is locked
+-- so parallel deposits use up the quoted amount one by one
+SELECT *
+FROM rate_quotes
+WHERE wallet_id = $1
+  AND currency_id = $2
+  AND created_at <= sqlc.arg(at)::timestamp
+  AND expires_at >= sqlc.arg(at)::timestamp
+ORDER BY created_at DESC
+LIMIT 1 FOR UPDATE;
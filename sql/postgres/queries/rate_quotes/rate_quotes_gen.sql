@@ -0,0 +1,7 @@
+-- name: Create :one
+INSERT INTO rate_quotes (store_id, wallet_id, invoice_id, currency_id, source, rate, amount, amount_usd, expires_at, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, now())
+	RETURNING *;
+
+-- name: GetByID :one
+SELECT * FROM rate_quotes WHERE id=$1 LIMIT 1;
@@ -0,0 +1,5 @@
+-- name: GetByRefundID :many
+SELECT *
+FROM refund_status_history
+WHERE refund_id = $1
+ORDER BY created_at;
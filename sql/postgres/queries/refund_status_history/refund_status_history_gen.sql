@@ -0,0 +1,4 @@
+-- name: Create :one
+INSERT INTO refund_status_history (refund_id, status_from, status_to, user_id, created_at)
+	VALUES ($1, $2, $3, $4, now())
+	RETURNING *;
@@ -0,0 +1,82 @@
+-- name: GetByIDForUpdate :one
+SELECT *
+FROM refunds
+WHERE id = $1
+LIMIT 1 FOR UPDATE;
+
+-- name: GetByWithdrawalIDForUpdate :one
+SELECT *
+FROM refunds
+WHERE withdrawal_id = $1
+LIMIT 1 FOR UPDATE;
+
+-- name: GetRefundedAmount :one
+-- amount of the transaction that is refunded or is being refunded
+SELECT COALESCE(SUM(amount), 0)::numeric AS refunded_amount
+FROM refunds
+WHERE transaction_id = $1
+  AND status NOT IN ('rejected', 'failed', 'cancelled');
+
+-- name: GetApprovedWithoutWithdrawal :many
+-- approved refunds whose withdrawal is not linked yet
+SELECT *
+FROM refunds
+WHERE status = 'approved'
+  AND withdrawal_id IS NULL
+ORDER BY decided_at
+LIMIT $1;
+
+-- name: GetProcessingForUpdate :many
+SELECT *
+FROM refunds
+WHERE status = 'processing'
+ORDER BY updated_at
+LIMIT $1 FOR UPDATE SKIP LOCKED;
+
+-- name: UpdateStatus :one
+UPDATE refunds
+SET status     = $1,
+    updated_at = now()
+WHERE id = $2
+RETURNING *;
+
+-- name: SetDecision :one
+UPDATE refunds
+SET status           = $1,
+    decided_by       = $2,
+    rejection_reason = $3,
+    decided_at       = now(),
+    updated_at       = now()
+WHERE id = $4
+RETURNING *;
+
+-- name: SetWithdrawal :one
+UPDATE refunds
+SET status        = 'processing',
+    withdrawal_id = $1,
+    updated_at    = now()
+WHERE id = $2
+RETURNING *;
+
+-- name: SetFailed :one
+UPDATE refunds
+SET status         = 'failed',
+    failure_reason = $1,
+    updated_at     = now()
+WHERE id = $2
+RETURNING *;
+
+-- name: SetCompleted :one
+UPDATE refunds
+SET status         = 'completed',
+    refund_tx_hash = $1,
+    updated_at     = now()
+WHERE id = $2
+RETURNING *;
+
+-- name: UpdateAddress :one
+UPDATE refunds
+SET address_to = $1,
+    updated_at = now()
+WHERE id = $2
+RETURNING *;
@@ -0,0 +1,7 @@
+-- name: Create :one
+INSERT INTO refunds (store_id, transaction_id, invoice_id, currency_id, reason, status, amount, amount_usd, address_to, requested_by, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, now())
+	RETURNING *;
+
+-- name: GetByID :one
+SELECT * FROM refunds WHERE id=$1 LIMIT 1;
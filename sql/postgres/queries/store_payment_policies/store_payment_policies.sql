@@ -0,0 +1,16 @@
+-- name: GetByStoreID :one
+SELECT *
+FROM store_payment_policies
+WHERE store_id = $1
+LIMIT 1;
+
+-- name: Upsert :one
+INSERT INTO store_payment_policies (store_id, threshold_type, threshold, rounding, leftover_action, created_at)
+VALUES ($1, $2, $3, $4, $5, now())
+ON CONFLICT (store_id) DO UPDATE
+    SET threshold_type  = EXCLUDED.threshold_type,
+        threshold       = EXCLUDED.threshold,
+        rounding        = EXCLUDED.rounding,
+        leftover_action = EXCLUDED.leftover_action,
+        updated_at      = now()
+RETURNING *;
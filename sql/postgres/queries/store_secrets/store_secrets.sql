@@ -14,3 +14,27 @@ UPDATE store_secrets
 SET secret=$1, updated_at=now()
 WHERE store_id=$2
     RETURNING *;
+
+-- name: GetByStoreID :one
+SELECT *
+FROM store_secrets
+WHERE store_id = $1
+LIMIT 1;
+
+-- name: Rotate :one
+-- keeps replaced secret active until the rotation window ends
+UPDATE store_secrets
+SET previous_secret     = secret,
+    previous_expires_at = sqlc.arg(previous_expires_at),
+    secret              = sqlc.arg(secret),
+    updated_at          = now()
+WHERE store_id = sqlc.arg(store_id)
+RETURNING *;
+
+-- name: RevokePrevious :one
+UPDATE store_secrets
+SET previous_secret     = NULL,
+    previous_expires_at = NULL,
+    updated_at          = now()
+WHERE store_id = $1
+RETURNING *;
@@ -0,0 +1,22 @@
+-- name: GetByStoreID :one
+SELECT *
+FROM store_webhook_client_certificates
+WHERE store_id = $1
+LIMIT 1;
+
+-- name: Upsert :one
+INSERT INTO store_webhook_client_certificates (store_id, certificate, private_key, fingerprint, subject, expires_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, now())
+ON CONFLICT (store_id) DO UPDATE
+    SET certificate = EXCLUDED.certificate,
+        private_key = EXCLUDED.private_key,
+        fingerprint = EXCLUDED.fingerprint,
+        subject     = EXCLUDED.subject,
+        expires_at  = EXCLUDED.expires_at,
+        updated_at  = now()
+RETURNING *;
+
+-- name: DeleteByStoreID :execrows
+DELETE
+FROM store_webhook_client_certificates
+WHERE store_id = $1;
@@ -0,0 +1,17 @@
+-- name: GetByStoreID :one
+SELECT *
+FROM store_webhook_retry_policies
+WHERE store_id = $1
+LIMIT 1;
+
+-- name: Upsert :one
+INSERT INTO store_webhook_retry_policies (store_id, policy_type, max_attempts, delay_seconds, max_delay_seconds, schedule_seconds, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, now())
+ON CONFLICT (store_id) DO UPDATE
+    SET policy_type       = EXCLUDED.policy_type,
+        max_attempts      = EXCLUDED.max_attempts,
+        delay_seconds     = EXCLUDED.delay_seconds,
+        max_delay_seconds = EXCLUDED.max_delay_seconds,
+        schedule_seconds  = EXCLUDED.schedule_seconds,
+        updated_at        = now()
+RETURNING *;
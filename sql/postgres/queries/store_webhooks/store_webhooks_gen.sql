@@ -1,6 +1,6 @@
 -- name: Create :one
-INSERT INTO store_webhooks (store_id, url, enabled, events, created_at)
-	VALUES ($1, $2, $3, $4, now())
+INSERT INTO store_webhooks (store_id, url, enabled, events, success_mode, success_status_codes, success_json_path, success_json_value, timeout_seconds, schema_version, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, now())
 	RETURNING *;
 
 -- name: Delete :exec
@@ -11,6 +11,6 @@ SELECT * FROM store_webhooks WHERE id=$1 LIMIT 1;
 
 -- name: Update :one
 UPDATE store_webhooks
-	SET url=$1, enabled=$2, events=$3, updated_at=$4
-WHERE id=$5
+	SET url=$1, enabled=$2, events=$3, updated_at=$4, success_mode=$5, success_status_codes=$6, success_json_path=$7, success_json_value=$8, timeout_seconds=$9, schema_version=$10
+WHERE id=$11
 	RETURNING *;
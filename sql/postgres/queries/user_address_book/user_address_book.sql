@@ -30,8 +30,12 @@ WHERE user_id = $1 AND blockchain = $2 AND deleted_at IS NULL
 ORDER BY submitted_at DESC;
 
 -- name: Create :one
-INSERT INTO user_address_book (user_id, address, currency_id, name, tag, blockchain, type, submitted_at, created_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+INSERT INTO user_address_book (user_id, address, currency_id, name, tag, blockchain, type, active_at, revoke_token, revoke_token_expires_at, submitted_at, created_at)
+VALUES (
+    sqlc.arg(user_id), sqlc.arg(address), sqlc.arg(currency_id), sqlc.arg(name), sqlc.arg(tag), sqlc.arg(blockchain), sqlc.arg(type),
+    CURRENT_TIMESTAMP + make_interval(secs => sqlc.arg(cooling_seconds)::int), sqlc.arg(revoke_token),
+    CURRENT_TIMESTAMP + make_interval(secs => sqlc.arg(revoke_ttl_seconds)::int), CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+)
 RETURNING *;
 
 -- name: Update :one
@@ -67,6 +71,30 @@ WHERE user_id = $1 AND address = $2 AND currency_id = $3 AND deleted_at IS NOT N
 
 -- name: RestoreFromTrash :one
 UPDATE user_address_book 
-SET deleted_at = NULL, name = $5, tag = $6, updated_at = CURRENT_TIMESTAMP
-WHERE user_id = $1 AND address = $2 AND currency_id = $3 AND type = $4 AND deleted_at IS NOT NULL
-RETURNING *;
\ No newline at end of file
+SET deleted_at = NULL, name = sqlc.arg(name), tag = sqlc.arg(tag),
+    active_at = CURRENT_TIMESTAMP + make_interval(secs => sqlc.arg(cooling_seconds)::int), revoke_token = sqlc.arg(revoke_token),
+    revoke_token_expires_at = CURRENT_TIMESTAMP + make_interval(secs => sqlc.arg(revoke_ttl_seconds)::int),
+    updated_at = CURRENT_TIMESTAMP
+WHERE user_id = sqlc.arg(user_id) AND address = sqlc.arg(address) AND currency_id = sqlc.arg(currency_id) AND type = sqlc.arg(type) AND deleted_at IS NOT NULL
+RETURNING *;
+
+-- name: GetByRevokeToken :many
+SELECT * FROM user_address_book
+WHERE revoke_token = $1 AND deleted_at IS NULL AND revoke_token_expires_at > CURRENT_TIMESTAMP;
+
+-- name: RevokeByToken :many
+-- revoke token is cleared so the link can be used once
+UPDATE user_address_book
+SET deleted_at = CURRENT_TIMESTAMP, revoke_token = NULL, revoke_token_expires_at = NULL, updated_at = CURRENT_TIMESTAMP
+WHERE revoke_token = $1 AND deleted_at IS NULL AND revoke_token_expires_at > CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: GetDestination :one
+SELECT active_at, active_at <= CURRENT_TIMESTAMP AS active FROM user_address_book
+WHERE user_id = $1 AND currency_id = $2 AND address = $3 AND deleted_at IS NULL
+ORDER BY active_at
+LIMIT 1;
+
+-- name: GetActiveAddressesByCurrency :many
+SELECT DISTINCT address FROM user_address_book
+WHERE user_id = $1 AND currency_id = $2 AND deleted_at IS NULL AND active_at <= CURRENT_TIMESTAMP;
\ No newline at end of file
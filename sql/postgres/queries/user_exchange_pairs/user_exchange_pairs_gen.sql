@@ -1,6 +1,6 @@
 -- name: Create :one
-INSERT INTO user_exchange_pairs (exchange_id, user_id, currency_from, currency_to, symbol, type)
-	VALUES ($1, $2, $3, $4, $5, $6)
+INSERT INTO user_exchange_pairs (exchange_id, user_id, currency_from, currency_to, symbol, type, order_type, price_offset, time_in_force, twap_slices, twap_window_seconds)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 	RETURNING *;
 
 -- name: Find :many
@@ -177,6 +177,20 @@ WHERE user_id = $1
   AND amount > 0
   AND deleted_at IS NULL;
 
+-- name: GetTotalAmountByCurrency :one
+SELECT COALESCE(SUM(amount), 0)::numeric as total_amount
+FROM wallet_addresses
+WHERE user_id = $1
+  AND currency_id = $2
+  AND deleted_at IS NULL
+  AND address not in (select unnest(from_addresses)
+                      from transfers
+                      where user_id = $1
+                        and kind = 'from_address'
+                        and transfers.currency_id = $2
+                        and (transfers.stage = 'in_progress'
+                          or (transfers.stage = 'completed' and updated_at > (now() - interval '5 minutes'))));
+
 -- name: GetHotWalletsTotalBalanceWithDust :one
 SELECT COALESCE(SUM(wallet_addresses.amount * rate.exchange_rate), 0)::numeric as total_usd,
        COALESCE(SUM(CASE
@@ -0,0 +1,21 @@
+-- name: GetPendingByIDsForUpdate :many
+SELECT *
+FROM webhook_dead_letters
+WHERE store_id = $1
+  AND id = ANY (sqlc.arg(ids)::uuid[])
+  AND requeued_at IS NULL
+ORDER BY created_at
+FOR UPDATE SKIP LOCKED;
+
+-- name: GetPendingByStoreForUpdate :many
+SELECT *
+FROM webhook_dead_letters
+WHERE store_id = $1
+  AND requeued_at IS NULL
+ORDER BY created_at
+LIMIT $2 FOR UPDATE SKIP LOCKED;
+
+-- name: MarkRequeued :exec
+UPDATE webhook_dead_letters
+SET requeued_at = now()
+WHERE id = $1;
@@ -0,0 +1,7 @@
+-- name: Create :one
+INSERT INTO webhook_dead_letters (send_queue_job_id, webhook_id, store_id, transaction_id, event, url, payload, signature, attempts, last_response, last_response_status_code, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, now())
+	RETURNING *;
+
+-- name: GetByID :one
+SELECT * FROM webhook_dead_letters WHERE id=$1 LIMIT 1;
@@ -1,32 +1,67 @@
--- name: GetQueuedWebhooks :many
-SELECT whsq.id,
-       whsq.webhook_id,
-       whsq.seconds_delay,
-       whsq.transaction_id,
-       whsq.event,
-       whsq.payload,
-       whsq.signature,
-       whsq.created_at,
-       whsq.last_sent_at,
-       sw.store_id,
-       sw.url,
-       (select count(distinct id)
-        from webhook_send_histories
-        where webhook_send_histories.status = 'failed'
-          and webhook_send_histories.send_queue_job_id = whsq.id) as retries_count
-FROM webhook_send_queue whsq
-         join store_webhooks sw on whsq.webhook_id = sw.id and sw.enabled = true
-         left join webhook_send_histories whsh on whsh.send_queue_job_id = whsq.id and whsh.status = 'success'
-WHERE whsh.id is null
-ORDER BY whsq.created_at
-LIMIT 500;
+-- name: ClaimQueuedWebhooks :many
+-- leases due messages to the caller, rows claimed by other replicas are skipped
+WITH claimed AS (SELECT whsq.id
+                 FROM webhook_send_queue whsq
+                          join store_webhooks sw on whsq.webhook_id = sw.id and sw.enabled = true
+                 WHERE (whsq.locked_until is null or whsq.locked_until < now())
+                   AND (whsq.last_sent_at is null or whsq.last_sent_at + whsq.seconds_delay * interval '1 second' <= now())
+                   AND NOT EXISTS (select 1
+                                   from webhook_send_histories whsh
+                                   where whsh.send_queue_job_id = whsq.id
+                                     and whsh.status = 'success')
+                 ORDER BY whsq.created_at
+                 LIMIT sqlc.arg(batch_size) FOR UPDATE OF whsq SKIP LOCKED)
+UPDATE webhook_send_queue whsq
+SET locked_until = now() + sqlc.arg(lease_seconds)::integer * interval '1 second'
+FROM claimed,
+     store_webhooks sw
+WHERE whsq.id = claimed.id
+  AND sw.id = whsq.webhook_id
+RETURNING whsq.id,
+    whsq.webhook_id,
+    whsq.seconds_delay,
+    whsq.transaction_id,
+    whsq.event,
+    whsq.payload,
+    whsq.signature,
+    whsq.created_at,
+    whsq.last_sent_at,
+    sw.store_id,
+    sw.url,
+    sw.success_mode,
+    sw.success_status_codes,
+    sw.success_json_path,
+    sw.success_json_value,
+    sw.timeout_seconds,
+    (select count(distinct id)
+     from webhook_send_histories
+     where webhook_send_histories.status = 'failed'
+       and webhook_send_histories.send_queue_job_id = whsq.id) as retries_count;
 
 -- name: UpdateDelay :exec
 UPDATE webhook_send_queue
-set seconds_delay=sqlc.arg(delay), last_sent_at = now()
+set seconds_delay=sqlc.arg(delay), last_sent_at = now(), locked_until = null
+where id = $1;
+
+-- name: Release :exec
+-- gives claimed message back to the queue, it is not claimed again until the delay passes
+UPDATE webhook_send_queue
+set locked_until = now() + sqlc.arg(delay)::integer * interval '1 second'
 where id = $1;
 
 -- name: Delete :exec
 DELETE
 FROM webhook_send_queue
-WHERE id = $1;
\ No newline at end of file
+WHERE id = $1;
+
+-- name: Requeue :execrows
+-- puts message back to the queue unless the same webhook is already queued for the transaction
+INSERT INTO webhook_send_queue (webhook_id, seconds_delay, transaction_id, payload, signature, event, created_at)
+VALUES ($1, 0, $2, $3, $4, $5, now())
+ON CONFLICT (webhook_id, transaction_id) DO NOTHING;
+
+-- name: Enqueue :execrows
+-- queues message unless the same webhook is already queued for the transaction
+INSERT INTO webhook_send_queue (webhook_id, seconds_delay, transaction_id, payload, signature, event, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, now())
+ON CONFLICT (webhook_id, transaction_id) DO NOTHING;
@@ -0,0 +1,11 @@
+-- name: GetByApprovalID :many
+SELECT *
+FROM withdrawal_approval_decisions
+WHERE approval_id = $1
+ORDER BY created_at;
+
+-- name: CountApprovals :one
+SELECT COUNT(*)
+FROM withdrawal_approval_decisions
+WHERE approval_id = $1
+  AND decision = 'approve';
@@ -0,0 +1,4 @@
+-- name: Create :one
+INSERT INTO withdrawal_approval_decisions (approval_id, user_id, decision, comment, created_at)
+	VALUES ($1, $2, $3, $4, now())
+	RETURNING *;
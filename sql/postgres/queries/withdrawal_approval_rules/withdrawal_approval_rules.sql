@@ -0,0 +1,9 @@
+-- name: GetAll :many
+SELECT *
+FROM withdrawal_approval_rules
+ORDER BY created_at;
+
+-- name: Delete :execrows
+DELETE
+FROM withdrawal_approval_rules
+WHERE id = $1;
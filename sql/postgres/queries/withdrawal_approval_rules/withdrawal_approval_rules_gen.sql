@@ -0,0 +1,4 @@
+-- name: Create :one
+INSERT INTO withdrawal_approval_rules (currency_id, address_to, min_amount_usd, required_approvals, created_by, created_at)
+	VALUES ($1, $2, $3, $4, $5, now())
+	RETURNING *;
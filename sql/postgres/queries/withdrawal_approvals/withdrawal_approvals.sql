@@ -0,0 +1,45 @@
+-- name: GetByIDForUpdate :one
+SELECT *
+FROM withdrawal_approvals
+WHERE id = $1
+LIMIT 1 FOR UPDATE;
+
+-- name: GetLatestByRequestID :one
+SELECT *
+FROM withdrawal_approvals
+WHERE kind = $1
+  AND store_id = $2
+  AND request_id = $3
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: UpdateStatus :one
+UPDATE withdrawal_approvals
+SET status     = $1,
+    updated_at = now()
+WHERE id = $2
+RETURNING *;
+
+-- name: SetExecuted :one
+UPDATE withdrawal_approvals
+SET status      = 'executed',
+    executed_at = now(),
+    updated_at  = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: SetFailed :one
+UPDATE withdrawal_approvals
+SET status         = 'failed',
+    failure_reason = $1,
+    updated_at     = now()
+WHERE id = $2
+RETURNING *;
+
+-- name: ExpirePending :many
+UPDATE withdrawal_approvals
+SET status     = 'expired',
+    updated_at = now()
+WHERE status = 'pending'
+  AND expires_at <= sqlc.arg(at)::timestamp
+RETURNING *;
@@ -0,0 +1,7 @@
+-- name: Create :one
+INSERT INTO withdrawal_approvals (user_id, kind, currency_id, address_to, amount, amount_usd, payload, required_approvals, rule_id, initiated_by, store_id, request_id, expires_at, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, now())
+	RETURNING *;
+
+-- name: GetByID :one
+SELECT * FROM withdrawal_approvals WHERE id=$1 LIMIT 1;
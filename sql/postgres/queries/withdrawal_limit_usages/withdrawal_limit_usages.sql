@@ -0,0 +1,22 @@
+-- name: LockUser :exec
+SELECT pg_advisory_xact_lock(hashtextextended(sqlc.arg(user_id)::text, 0));
+
+-- name: GetWindowUsage :one
+SELECT count(*)                              AS count,
+       coalesce(sum(amount_usd), 0)::numeric AS amount_usd
+FROM withdrawal_limit_usages
+WHERE user_id = sqlc.arg(user_id)
+  AND created_at >= sqlc.arg(since)::timestamp
+  AND (sqlc.narg(store_id)::uuid IS NULL OR store_id = sqlc.narg(store_id)::uuid)
+  AND (sqlc.narg(currency_id)::varchar IS NULL OR currency_id = sqlc.narg(currency_id)::varchar)
+  AND (sqlc.narg(address_to)::varchar IS NULL OR lower(address_to) = lower(sqlc.narg(address_to)::varchar));
+
+-- name: DeleteBefore :execrows
+DELETE
+FROM withdrawal_limit_usages
+WHERE created_at < sqlc.arg(at)::timestamp;
+
+-- name: DeleteByID :exec
+DELETE
+FROM withdrawal_limit_usages
+WHERE id = sqlc.arg(id);
@@ -0,0 +1,4 @@
+-- name: Create :one
+INSERT INTO withdrawal_limit_usages (user_id, store_id, currency_id, address_to, amount_usd, created_at)
+	VALUES ($1, $2, $3, $4, $5, now())
+	RETURNING *;
@@ -0,0 +1,11 @@
+-- name: GetByUserID :many
+SELECT *
+FROM withdrawal_limits
+WHERE user_id = $1
+ORDER BY created_at;
+
+-- name: Delete :execrows
+DELETE
+FROM withdrawal_limits
+WHERE id = $1
+  AND user_id = $2;
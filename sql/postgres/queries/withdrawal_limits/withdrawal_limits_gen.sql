@@ -0,0 +1,4 @@
+-- name: Create :one
+INSERT INTO withdrawal_limits (user_id, scope, scope_value, window_seconds, max_amount_usd, max_count, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, now())
+	RETURNING *;
@@ -0,0 +1,34 @@
+-- name: Upsert :one
+INSERT INTO withdrawal_wallet_schedules (withdrawal_wallet_id, cron_expression, timezone, min_balance_usd, max_gas_price_gwei,
+                                         keep_float_usd, last_run_at, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, now())
+ON CONFLICT (withdrawal_wallet_id) DO UPDATE SET cron_expression    = excluded.cron_expression,
+                                                 timezone           = excluded.timezone,
+                                                 min_balance_usd    = excluded.min_balance_usd,
+                                                 max_gas_price_gwei = excluded.max_gas_price_gwei,
+                                                 keep_float_usd     = excluded.keep_float_usd,
+                                                 run_started_at     = NULL,
+                                                 last_run_at        = excluded.last_run_at,
+                                                 updated_at         = now()
+RETURNING *;
+
+-- name: GetByWithdrawalWalletID :one
+SELECT *
+FROM withdrawal_wallet_schedules
+WHERE withdrawal_wallet_id = $1;
+
+-- name: DeleteByWithdrawalWalletID :execrows
+DELETE
+FROM withdrawal_wallet_schedules
+WHERE withdrawal_wallet_id = $1;
+
+-- name: StartRun :exec
+UPDATE withdrawal_wallet_schedules
+SET run_started_at = $2
+WHERE id = $1;
+
+-- name: FinishRun :exec
+UPDATE withdrawal_wallet_schedules
+SET run_started_at = NULL,
+    last_run_at    = $2
+WHERE id = $1;
@@ -233,5 +233,26 @@ values
     ('mexc', 'SHIB.BNBSmartChain', 'SHIB', 'BSC'),
     ('mexc', 'ETH.Arbitrum', 'ETH', 'ARB'),
     ('mexc', 'USDT.Arbitrum', 'USDT', 'ARB'),
-    ('mexc', 'USDC.Arbitrum', 'USDC', 'ARB')
+    ('mexc', 'USDC.Arbitrum', 'USDC', 'ARB'),
+    ('kraken', 'BTC.Bitcoin', 'BTC', 'Bitcoin'),
+    ('kraken', 'ETH.Ethereum', 'ETH', 'Ether'),
+    ('kraken', 'LTC.Litecoin', 'LTC', 'Litecoin'),
+    ('kraken', 'BCH.Bitcoincash', 'BCH', 'Bitcoin Cash'),
+    ('kraken', 'DOGE.Dogecoin', 'DOGE', 'Dogecoin'),
+    ('kraken', 'TRX.Tron', 'TRX', 'Tron'),
+    ('kraken', 'USDT.Ethereum', 'USDT', 'Tether USD (ERC20)'),
+    ('kraken', 'USDT.Tron', 'USDT', 'Tether USD (TRC20)'),
+    ('kraken', 'USDC.Ethereum', 'USDC', 'USDC'),
+    ('kraken', 'POL.Polygon', 'POL', 'Polygon'),
+    ('coinbase', 'BTC.Bitcoin', 'BTC', 'bitcoin'),
+    ('coinbase', 'ETH.Ethereum', 'ETH', 'ethereum'),
+    ('coinbase', 'LTC.Litecoin', 'LTC', 'litecoin'),
+    ('coinbase', 'BCH.Bitcoincash', 'BCH', 'bitcoincash'),
+    ('coinbase', 'DOGE.Dogecoin', 'DOGE', 'dogecoin'),
+    ('coinbase', 'USDT.Ethereum', 'USDT', 'ethereum'),
+    ('coinbase', 'USDC.Ethereum', 'USDC', 'ethereum'),
+    ('coinbase', 'USDC.Polygon', 'USDC', 'polygon'),
+    ('coinbase', 'USDC.Arbitrum', 'USDC', 'arbitrum'),
+    ('coinbase', 'ETH.Arbitrum', 'ETH', 'arbitrum'),
+    ('coinbase', 'POL.Polygon', 'POL', 'polygon')
 ON CONFLICT DO NOTHING;
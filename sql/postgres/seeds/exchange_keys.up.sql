@@ -18,5 +18,9 @@ values
     ('2495d8a2-435d-4031-8930-10e82aad9c64', 'secret_key', 'Secret key', now(), now()),
     ('2495d8a2-435d-4031-8930-10e82aad9c64', 'access_key', 'Access key', now(), now()),
     ('2d22f266-c927-4833-bd6c-c38a965dde0f', 'api_key', 'Api key', now(), now()),
-    ('2d22f266-c927-4833-bd6c-c38a965dde0f', 'secret_key', 'Secret key', now(), now())
+    ('2d22f266-c927-4833-bd6c-c38a965dde0f', 'secret_key', 'Secret key', now(), now()),
+    ('7c1f4b2e-9a3d-4e8f-b6c5-2d7a9e1f3b40', 'api_key', 'Api key', now(), now()),
+    ('7c1f4b2e-9a3d-4e8f-b6c5-2d7a9e1f3b40', 'secret_key', 'Secret key', now(), now()),
+    ('e3a8d5f1-6b2c-4f97-8d4e-1a5c7b9e2f63', 'api_key', 'Api key', now(), now()),
+    ('e3a8d5f1-6b2c-4f97-8d4e-1a5c7b9e2f63', 'secret_key', 'Secret key', now(), now())
 ON CONFLICT DO NOTHING;
\ No newline at end of file
@@ -1 +1 @@
-DELETE FROM exchanges WHERE slug in ('htx', 'okx', 'binance', 'bitget', 'kucoin', 'bybit', 'gate', 'mexc');
\ No newline at end of file
+DELETE FROM exchanges WHERE slug in ('htx', 'okx', 'binance', 'bitget', 'kucoin', 'bybit', 'gate', 'mexc', 'kraken', 'coinbase');
\ No newline at end of file
@@ -7,5 +7,7 @@ values
     ('2e07b084-ff69-4c09-bc9d-e93578260f67', 'kucoin', 'KUCOIN', 'https://api.kucoin.com', now(), now(), true),
     ('b868db0c-4d51-4842-ab6b-677f62c454fb', 'bybit', 'BYBIT', 'https://api.bybit.com', now(), now(), true),
     ('2495d8a2-435d-4031-8930-10e82aad9c64', 'gate', 'GATE', 'https://api.gateio.ws', now(), now(), true),
-    ('2d22f266-c927-4833-bd6c-c38a965dde0f', 'mexc', 'MEXC', 'https://api.mexc.com', now(), now(), true)
+    ('2d22f266-c927-4833-bd6c-c38a965dde0f', 'mexc', 'MEXC', 'https://api.mexc.com', now(), now(), true),
+    ('7c1f4b2e-9a3d-4e8f-b6c5-2d7a9e1f3b40', 'kraken', 'KRAKEN', 'https://api.kraken.com', now(), now(), true),
+    ('e3a8d5f1-6b2c-4f97-8d4e-1a5c7b9e2f63', 'coinbase', 'COINBASE', 'https://api.coinbase.com', now(), now(), true)
 ON CONFLICT DO NOTHING;
\ No newline at end of file
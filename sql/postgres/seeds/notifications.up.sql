@@ -10,6 +10,10 @@ VALUES (null, 'user_verification'),
        (null, 'user_remind_verification'),
        (null, 'user_access_key_changed'),
        (null, 'user_crypto_receipt'),
+       (null, 'exrate_source_stale'),
+       (null, 'withdrawal_limit_exceeded'),
+       (null, 'address_book_entry_added'),
+       (null, 'withdrawal_allowlist_disabling'),
        ('system', 'system_error'),
        ('system', 'webhook_error'),
        ('event', 'payment_received'),